@@ -0,0 +1,84 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import "strings"
+
+// ClientType is the type of consensus client software run by a beacon node.
+type ClientType uint64
+
+const (
+	// ClientTypeUnknown is a client that could not be identified.
+	ClientTypeUnknown ClientType = iota
+	// ClientTypeGrandine is the Grandine client.
+	ClientTypeGrandine
+	// ClientTypeLighthouse is the Lighthouse client.
+	ClientTypeLighthouse
+	// ClientTypeLodestar is the Lodestar client.
+	ClientTypeLodestar
+	// ClientTypeNimbus is the Nimbus client.
+	ClientTypeNimbus
+	// ClientTypePrysm is the Prysm client.
+	ClientTypePrysm
+	// ClientTypeTeku is the Teku client.
+	ClientTypeTeku
+	// ClientTypeCharon is the Charon distributed validator middleware.
+	ClientTypeCharon
+)
+
+var clientTypeStrings = [...]string{
+	"unknown",
+	"grandine",
+	"lighthouse",
+	"lodestar",
+	"nimbus",
+	"prysm",
+	"teku",
+	"charon",
+}
+
+// String returns a string representation of the client type.
+func (c ClientType) String() string {
+	if int(c) >= len(clientTypeStrings) {
+		return "unknown"
+	}
+
+	return clientTypeStrings[c]
+}
+
+// ParseNodeVersion parses the node version, as returned by /eth/v1/node/version, to obtain
+// the type and version of the client.
+// Node versions are conventionally of the form "<client>/<version>/<platform>", for example
+// "Lighthouse/v4.5.0-441fc16/x86_64-linux", although some are prefixed with an organisation,
+// for example "obolnetwork/charon/v0.17.0".  If the version cannot be found it is returned
+// as an empty string.
+func ParseNodeVersion(nodeVersion string) (ClientType, string) {
+	parts := strings.Split(strings.TrimSpace(nodeVersion), "/")
+	for i, part := range parts {
+		name := strings.ToLower(part)
+		for j, clientTypeString := range clientTypeStrings {
+			if j == int(ClientTypeUnknown) || !strings.HasPrefix(name, clientTypeString) {
+				continue
+			}
+			version := ""
+			if i+1 < len(parts) {
+				version = parts[i+1]
+			}
+
+			return ClientType(j), version
+		}
+	}
+
+	return ClientTypeUnknown, ""
+}
@@ -0,0 +1,91 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNodeVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		nodeVersion string
+		clientType  api.ClientType
+		version     string
+	}{
+		{
+			name:        "Empty",
+			nodeVersion: "",
+			clientType:  api.ClientTypeUnknown,
+		},
+		{
+			name:        "Lighthouse",
+			nodeVersion: "Lighthouse/v4.5.0-441fc16/x86_64-linux",
+			clientType:  api.ClientTypeLighthouse,
+			version:     "v4.5.0-441fc16",
+		},
+		{
+			name:        "Teku",
+			nodeVersion: "teku/v23.10.0/linux-x86_64/-eclipseadoptium-openjdk64bitservervm-java-17",
+			clientType:  api.ClientTypeTeku,
+			version:     "v23.10.0",
+		},
+		{
+			name:        "Prysm",
+			nodeVersion: "Prysm/v4.1.1 (linux amd64)",
+			clientType:  api.ClientTypePrysm,
+			version:     "v4.1.1 (linux amd64)",
+		},
+		{
+			name:        "Nimbus",
+			nodeVersion: "Nimbus/v23.10.0-8b07f4-stateofus",
+			clientType:  api.ClientTypeNimbus,
+			version:     "v23.10.0-8b07f4-stateofus",
+		},
+		{
+			name:        "Lodestar",
+			nodeVersion: "Lodestar/v1.12.0/4b0ee3b",
+			clientType:  api.ClientTypeLodestar,
+			version:     "v1.12.0",
+		},
+		{
+			name:        "Charon",
+			nodeVersion: "obolnetwork/charon/v0.17.0",
+			clientType:  api.ClientTypeCharon,
+			version:     "v0.17.0",
+		},
+		{
+			name:        "Unknown",
+			nodeVersion: "other",
+			clientType:  api.ClientTypeUnknown,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clientType, version := api.ParseNodeVersion(test.nodeVersion)
+			require.Equal(t, test.clientType, clientType)
+			require.Equal(t, test.version, version)
+		})
+	}
+}
+
+func TestClientTypeString(t *testing.T) {
+	require.Equal(t, "lighthouse", api.ClientTypeLighthouse.String())
+	require.Equal(t, "unknown", api.ClientTypeUnknown.String())
+	require.Equal(t, "unknown", api.ClientType(99).String())
+}
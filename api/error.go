@@ -0,0 +1,90 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var (
+	// ErrNotFound is matched by errors where the beacon node could not find the requested item.
+	ErrNotFound = errors.New("not found")
+	// ErrNotSynced is matched by errors where the beacon node is unable to service the request
+	// because it is not synced.
+	ErrNotSynced = errors.New("not synced")
+	// ErrRateLimited is matched by errors where the beacon node has rejected the request due to
+	// rate limiting.
+	ErrRateLimited = errors.New("rate limited")
+)
+
+// Error is an error returned by a beacon node in response to a request.
+// Callers can use errors.Is with the ErrNotFound, ErrNotSynced and ErrRateLimited
+// sentinels to check the category of the error, or errors.As to obtain the details.
+type Error struct {
+	// Method is the HTTP method of the request.
+	Method string
+	// Endpoint is the endpoint of the request.
+	Endpoint string
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Message is the message supplied by the beacon node, if any.
+	Message string
+	// Data is the body of the response.
+	Data []byte
+}
+
+// nodeErrorJSON is the spec representation of an error returned by a beacon node.
+type nodeErrorJSON struct {
+	Code    json.Number `json:"code"`
+	Message string      `json:"message"`
+}
+
+// NewError creates an error for a failed request, extracting the beacon node's message
+// from the response body if present.
+func NewError(method string, endpoint string, statusCode int, data []byte) Error {
+	e := Error{
+		Method:     method,
+		Endpoint:   endpoint,
+		StatusCode: statusCode,
+		Data:       data,
+	}
+
+	var nodeErr nodeErrorJSON
+	if err := json.Unmarshal(data, &nodeErr); err == nil {
+		e.Message = nodeErr.Message
+	}
+
+	return e
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("%s failed with status %d: %s", e.Method, e.StatusCode, e.Data)
+}
+
+// Is returns true if the error falls in to the category of the target sentinel error.
+func (e Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrNotSynced:
+		return e.StatusCode == http.StatusServiceUnavailable
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	default:
+		return false
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewError(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		message string
+	}{
+		{
+			name: "Empty",
+		},
+		{
+			name: "NotJSON",
+			data: []byte("bad gateway"),
+		},
+		{
+			name:    "NumericCode",
+			data:    []byte(`{"code":404,"message":"Block not found"}`),
+			message: "Block not found",
+		},
+		{
+			name:    "StringCode",
+			data:    []byte(`{"code":"404","message":"Block not found"}`),
+			message: "Block not found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := api.NewError(http.MethodGet, "/eth/v1/test", http.StatusNotFound, test.data)
+			require.Equal(t, test.message, err.Message)
+			require.Equal(t, test.data, err.Data)
+			require.Equal(t, http.StatusNotFound, err.StatusCode)
+		})
+	}
+}
+
+func TestErrorIs(t *testing.T) {
+	tests := []struct {
+		name        string
+		statusCode  int
+		notFound    bool
+		notSynced   bool
+		rateLimited bool
+	}{
+		{
+			name:       "BadRequest",
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "NotFound",
+			statusCode: http.StatusNotFound,
+			notFound:   true,
+		},
+		{
+			name:       "ServiceUnavailable",
+			statusCode: http.StatusServiceUnavailable,
+			notSynced:  true,
+		},
+		{
+			name:        "TooManyRequests",
+			statusCode:  http.StatusTooManyRequests,
+			rateLimited: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := pkgerrors.Wrap(api.NewError(http.MethodPost, "/eth/v1/test", test.statusCode, nil), "failed")
+			require.Equal(t, test.notFound, errors.Is(err, api.ErrNotFound))
+			require.Equal(t, test.notSynced, errors.Is(err, api.ErrNotSynced))
+			require.Equal(t, test.rateLimited, errors.Is(err, api.ErrRateLimited))
+
+			var apiErr api.Error
+			require.True(t, errors.As(err, &apiErr))
+			require.Equal(t, test.statusCode, apiErr.StatusCode)
+		})
+	}
+}
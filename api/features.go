@@ -0,0 +1,87 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import "sort"
+
+// Feature is an optional capability of a client service that can be enabled when the service is created.
+// Features allow experimental or non-default behaviour to be shipped without altering the default
+// behaviour of the service.
+type Feature string
+
+const (
+	// FeatureV3Proposals obtains block proposals from the v3 proposal endpoint, where available.
+	FeatureV3Proposals Feature = "v3-proposals"
+	// FeatureReducedMemory reduces the memory used when handling large responses, at the cost of speed.
+	FeatureReducedMemory Feature = "reduced-memory"
+	// FeatureValidatorsPOST obtains validators with the POST variant of the validators endpoint, avoiding URL length limits.
+	FeatureValidatorsPOST Feature = "validators-post"
+)
+
+// FeatureInfo provides information about a feature.
+type FeatureInfo struct {
+	// Feature is the feature.
+	Feature Feature
+	// Description is a human-readable description of the feature.
+	Description string
+	// Experimental is true if the feature is experimental and its behaviour may change.
+	Experimental bool
+	// Deprecated is set to a human-readable explanation if the feature is deprecated, for example
+	// because its behaviour has become the default.  Deprecated features will be removed in a later release.
+	Deprecated string
+}
+
+// knownFeatures are the features known to this version of the library.
+var knownFeatures = map[Feature]*FeatureInfo{
+	FeatureV3Proposals: {
+		Feature:      FeatureV3Proposals,
+		Description:  "obtain block proposals from the v3 proposal endpoint",
+		Experimental: true,
+	},
+	FeatureReducedMemory: {
+		Feature:     FeatureReducedMemory,
+		Description: "reduce memory usage when handling large responses",
+	},
+	FeatureValidatorsPOST: {
+		Feature:     FeatureValidatorsPOST,
+		Description: "obtain validators with the POST validators endpoint",
+	},
+}
+
+// KnownFeatures returns information about all features known to this version of the library.
+// The returned information is a copy, so can be modified by the caller.
+func KnownFeatures() []*FeatureInfo {
+	res := make([]*FeatureInfo, 0, len(knownFeatures))
+	for _, info := range knownFeatures {
+		infoCopy := *info
+		res = append(res, &infoCopy)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Feature < res[j].Feature
+	})
+
+	return res
+}
+
+// FeatureInformation returns information about the given feature, and false if the feature is not known.
+// The returned information is a copy, so can be modified by the caller.
+func FeatureInformation(feature Feature) (*FeatureInfo, bool) {
+	info, exists := knownFeatures[feature]
+	if !exists {
+		return nil, false
+	}
+	infoCopy := *info
+
+	return &infoCopy, true
+}
@@ -0,0 +1,50 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKnownFeatures(t *testing.T) {
+	features := api.KnownFeatures()
+	require.Len(t, features, 3)
+	require.Equal(t, api.FeatureReducedMemory, features[0].Feature)
+	require.Equal(t, api.FeatureV3Proposals, features[1].Feature)
+	require.Equal(t, api.FeatureValidatorsPOST, features[2].Feature)
+
+	info, exists := api.FeatureInformation(api.FeatureV3Proposals)
+	require.True(t, exists)
+	require.True(t, info.Experimental)
+
+	_, exists = api.FeatureInformation("unknown")
+	require.False(t, exists)
+}
+
+func TestKnownFeaturesCopied(t *testing.T) {
+	features := api.KnownFeatures()
+	features[0].Description = "modified"
+	features[0].Deprecated = "modified"
+	require.NotEqual(t, "modified", api.KnownFeatures()[0].Description)
+
+	info, exists := api.FeatureInformation(api.FeatureV3Proposals)
+	require.True(t, exists)
+	info.Experimental = false
+	info, exists = api.FeatureInformation(api.FeatureV3Proposals)
+	require.True(t, exists)
+	require.True(t, info.Experimental)
+}
@@ -0,0 +1,172 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/preset"
+	"github.com/pkg/errors"
+)
+
+// NetworkProfile contains the parameters of a network that differ between networks,
+// such as mainnet and Gnosis chain.
+type NetworkProfile struct {
+	// Name is the name of the network.
+	Name                 string
+	DepositChainID       uint64
+	SecondsPerSlot       time.Duration
+	GenesisForkVersion   phase0.Version
+	AltairForkVersion    phase0.Version
+	BellatrixForkVersion phase0.Version
+	CapellaForkVersion   phase0.Version
+	DenebForkVersion     phase0.Version
+	Preset               *preset.Preset
+}
+
+// MainnetNetworkProfile returns the profile of Ethereum mainnet.
+func MainnetNetworkProfile() *NetworkProfile {
+	return &NetworkProfile{
+		Name:                 "mainnet",
+		DepositChainID:       1,
+		SecondsPerSlot:       12 * time.Second,
+		GenesisForkVersion:   phase0.Version{0x00, 0x00, 0x00, 0x00},
+		AltairForkVersion:    phase0.Version{0x01, 0x00, 0x00, 0x00},
+		BellatrixForkVersion: phase0.Version{0x02, 0x00, 0x00, 0x00},
+		CapellaForkVersion:   phase0.Version{0x03, 0x00, 0x00, 0x00},
+		DenebForkVersion:     phase0.Version{0x04, 0x00, 0x00, 0x00},
+		Preset:               preset.Mainnet(),
+	}
+}
+
+// GnosisNetworkProfile returns the profile of Gnosis chain.
+func GnosisNetworkProfile() *NetworkProfile {
+	return &NetworkProfile{
+		Name:                 "gnosis",
+		DepositChainID:       100,
+		SecondsPerSlot:       5 * time.Second,
+		GenesisForkVersion:   phase0.Version{0x00, 0x00, 0x00, 0x64},
+		AltairForkVersion:    phase0.Version{0x01, 0x00, 0x00, 0x64},
+		BellatrixForkVersion: phase0.Version{0x02, 0x00, 0x00, 0x64},
+		CapellaForkVersion:   phase0.Version{0x03, 0x00, 0x00, 0x64},
+		DenebForkVersion:     phase0.Version{0x04, 0x00, 0x00, 0x64},
+		Preset:               preset.Gnosis(),
+	}
+}
+
+// ChiadoNetworkProfile returns the profile of the Chiado Gnosis chain testnet.
+func ChiadoNetworkProfile() *NetworkProfile {
+	return &NetworkProfile{
+		Name:                 "chiado",
+		DepositChainID:       10200,
+		SecondsPerSlot:       5 * time.Second,
+		GenesisForkVersion:   phase0.Version{0x00, 0x00, 0x00, 0x6f},
+		AltairForkVersion:    phase0.Version{0x01, 0x00, 0x00, 0x6f},
+		BellatrixForkVersion: phase0.Version{0x02, 0x00, 0x00, 0x6f},
+		CapellaForkVersion:   phase0.Version{0x03, 0x00, 0x00, 0x6f},
+		DenebForkVersion:     phase0.Version{0x04, 0x00, 0x00, 0x6f},
+		Preset:               preset.Gnosis(),
+	}
+}
+
+// KnownNetworkProfile returns the profile of the known network with the given genesis fork version.
+func KnownNetworkProfile(genesisForkVersion phase0.Version) (*NetworkProfile, bool) {
+	for _, profile := range []*NetworkProfile{
+		MainnetNetworkProfile(),
+		GnosisNetworkProfile(),
+		ChiadoNetworkProfile(),
+	} {
+		if profile.GenesisForkVersion == genesisForkVersion {
+			return profile, true
+		}
+	}
+
+	return nil, false
+}
+
+// IsGnosis returns true if the network is Gnosis chain or one of its testnets.
+func (p *NetworkProfile) IsGnosis() bool {
+	return p.Preset != nil && p.Preset.Name == "gnosis"
+}
+
+// CheckContainerSizes ensures that the container sizes of the network match those of the given preset,
+// which is usually the preset used to decode containers as returned by preset.Current().
+func (p *NetworkProfile) CheckContainerSizes(clientPreset *preset.Preset) error {
+	if p.Preset == nil {
+		return fmt.Errorf("network %s has no preset", p.Name)
+	}
+	if clientPreset == nil {
+		return errors.New("no preset supplied")
+	}
+
+	differences := p.Preset.Differences(clientPreset)
+	if len(differences) > 0 {
+		return fmt.Errorf("network %s uses the %s preset but the client uses the %s preset: %s",
+			p.Name,
+			p.Preset.Name,
+			clientPreset.Name,
+			strings.Join(differences, ", "),
+		)
+	}
+
+	return nil
+}
+
+// NetworkProfile returns the profile of the network described by the chain specification.
+// Values not provided by the node are taken from the known network with the same genesis fork
+// version, if any.
+func (c *SpecConfig) NetworkProfile() *NetworkProfile {
+	res, known := KnownNetworkProfile(c.GenesisForkVersion)
+	if !known {
+		res = &NetworkProfile{
+			Name:               c.ConfigName,
+			GenesisForkVersion: c.GenesisForkVersion,
+		}
+	}
+	if c.ConfigName != "" {
+		res.Name = c.ConfigName
+	}
+
+	if c.DepositChainID != 0 {
+		res.DepositChainID = c.DepositChainID
+	}
+	if c.SecondsPerSlot != 0 {
+		res.SecondsPerSlot = c.SecondsPerSlot
+	}
+	for _, version := range []struct {
+		value  phase0.Version
+		target *phase0.Version
+	}{
+		{c.AltairForkVersion, &res.AltairForkVersion},
+		{c.BellatrixForkVersion, &res.BellatrixForkVersion},
+		{c.CapellaForkVersion, &res.CapellaForkVersion},
+		{c.DenebForkVersion, &res.DenebForkVersion},
+	} {
+		if version.value != (phase0.Version{}) {
+			*version.target = version.value
+		}
+	}
+
+	presetConfig := *c
+	if known && presetConfig.PresetBase == "" {
+		// Use the preset of the known network in preference to mainnet as the base.
+		presetConfig.PresetBase = res.Preset.Name
+	}
+	res.Preset = presetConfig.Preset()
+
+	return res
+}
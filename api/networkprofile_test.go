@@ -0,0 +1,75 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/preset"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKnownNetworkProfile(t *testing.T) {
+	profile, known := api.KnownNetworkProfile(phase0.Version{0x00, 0x00, 0x00, 0x64})
+	require.True(t, known)
+	require.Equal(t, api.GnosisNetworkProfile(), profile)
+	require.True(t, profile.IsGnosis())
+
+	profile, known = api.KnownNetworkProfile(phase0.Version{0x00, 0x00, 0x00, 0x00})
+	require.True(t, known)
+	require.Equal(t, api.MainnetNetworkProfile(), profile)
+	require.False(t, profile.IsGnosis())
+
+	_, known = api.KnownNetworkProfile(phase0.Version{0x10, 0x00, 0x00, 0x00})
+	require.False(t, known)
+}
+
+func TestSpecConfigNetworkProfile(t *testing.T) {
+	config, err := api.NewSpecConfig(map[string]interface{}{
+		"CONFIG_NAME":          "gnosis",
+		"PRESET_BASE":          "gnosis",
+		"DEPOSIT_CHAIN_ID":     uint64(100),
+		"SECONDS_PER_SLOT":     5 * time.Second,
+		"SLOTS_PER_EPOCH":      uint64(16),
+		"GENESIS_FORK_VERSION": phase0.Version{0x00, 0x00, 0x00, 0x64},
+		"ALTAIR_FORK_VERSION":  phase0.Version{0x01, 0x00, 0x00, 0x64},
+	})
+	require.NoError(t, err)
+	require.Equal(t, api.GnosisNetworkProfile(), config.NetworkProfile())
+
+	// Unknown network, values from the configuration.
+	config, err = api.NewSpecConfig(map[string]interface{}{
+		"CONFIG_NAME":          "devnet",
+		"PRESET_BASE":          "minimal",
+		"SECONDS_PER_SLOT":     6 * time.Second,
+		"GENESIS_FORK_VERSION": phase0.Version{0x10, 0x00, 0x00, 0x00},
+	})
+	require.NoError(t, err)
+	profile := config.NetworkProfile()
+	require.Equal(t, "devnet", profile.Name)
+	require.Equal(t, 6*time.Second, profile.SecondsPerSlot)
+	require.Equal(t, preset.Minimal(), profile.Preset)
+	require.False(t, profile.IsGnosis())
+}
+
+func TestNetworkProfileCheckContainerSizes(t *testing.T) {
+	require.NoError(t, api.MainnetNetworkProfile().CheckContainerSizes(preset.Mainnet()))
+	require.NoError(t, api.GnosisNetworkProfile().CheckContainerSizes(preset.Gnosis()))
+	require.EqualError(t, api.GnosisNetworkProfile().CheckContainerSizes(preset.Mainnet()),
+		"network gnosis uses the gnosis preset but the client uses the mainnet preset: SlotsPerEpoch is 16 rather than 32, MaxWithdrawalsPerPayload is 8 rather than 16")
+	require.EqualError(t, (&api.NetworkProfile{Name: "test"}).CheckContainerSizes(preset.Mainnet()), "network test has no preset")
+}
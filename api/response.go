@@ -0,0 +1,39 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ResponseMetadata is the metadata returned by a beacon node alongside the data in a response.
+type ResponseMetadata struct {
+	// Version is the fork version of the data.  It is only meaningful for versioned data.
+	Version spec.DataVersion
+	// ExecutionOptimistic is true if the data references an execution payload that has not
+	// been fully verified by the execution client.
+	ExecutionOptimistic bool
+	// Finalized is true if the data is from the finalized portion of the chain.
+	Finalized bool
+	// DependentRoot is the block root on which the data depends, for responses such as duties
+	// that are tied to a particular chain.  It is nil if not supplied by the beacon node.
+	DependentRoot *phase0.Root
+}
+
+// Response is a response from a beacon node, containing the data and its metadata.
+type Response[T any] struct {
+	Data     T
+	Metadata *ResponseMetadata
+}
@@ -0,0 +1,324 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/preset"
+	"github.com/pkg/errors"
+)
+
+// SpecConfig is a strongly-typed representation of the chain specification, as returned by a SpecProvider.
+// Values not provided by the node are left as their zero value.
+type SpecConfig struct {
+	// Configuration.
+	PresetBase                       string         `spec:"PRESET_BASE"`
+	ConfigName                       string         `spec:"CONFIG_NAME"`
+	TerminalTotalDifficulty          *big.Int       `spec:"TERMINAL_TOTAL_DIFFICULTY"`
+	TerminalBlockHash                []byte         `spec:"TERMINAL_BLOCK_HASH"`
+	TerminalBlockHashActivationEpoch phase0.Epoch   `spec:"TERMINAL_BLOCK_HASH_ACTIVATION_EPOCH"`
+	MinGenesisActiveValidatorCount   uint64         `spec:"MIN_GENESIS_ACTIVE_VALIDATOR_COUNT"`
+	MinGenesisTime                   time.Time      `spec:"MIN_GENESIS_TIME"`
+	GenesisForkVersion               phase0.Version `spec:"GENESIS_FORK_VERSION"`
+	GenesisDelay                     time.Duration  `spec:"GENESIS_DELAY"`
+	AltairForkVersion                phase0.Version `spec:"ALTAIR_FORK_VERSION"`
+	AltairForkEpoch                  phase0.Epoch   `spec:"ALTAIR_FORK_EPOCH"`
+	BellatrixForkVersion             phase0.Version `spec:"BELLATRIX_FORK_VERSION"`
+	BellatrixForkEpoch               phase0.Epoch   `spec:"BELLATRIX_FORK_EPOCH"`
+	CapellaForkVersion               phase0.Version `spec:"CAPELLA_FORK_VERSION"`
+	CapellaForkEpoch                 phase0.Epoch   `spec:"CAPELLA_FORK_EPOCH"`
+	DenebForkVersion                 phase0.Version `spec:"DENEB_FORK_VERSION"`
+	DenebForkEpoch                   phase0.Epoch   `spec:"DENEB_FORK_EPOCH"`
+	SecondsPerSlot                   time.Duration  `spec:"SECONDS_PER_SLOT"`
+	SecondsPerETH1Block              time.Duration  `spec:"SECONDS_PER_ETH1_BLOCK"`
+	MinValidatorWithdrawabilityDelay uint64         `spec:"MIN_VALIDATOR_WITHDRAWABILITY_DELAY"`
+	ShardCommitteePeriod             uint64         `spec:"SHARD_COMMITTEE_PERIOD"`
+	ETH1FollowDistance               uint64         `spec:"ETH1_FOLLOW_DISTANCE"`
+	InactivityScoreBias              uint64         `spec:"INACTIVITY_SCORE_BIAS"`
+	InactivityScoreRecoveryRate      uint64         `spec:"INACTIVITY_SCORE_RECOVERY_RATE"`
+	EjectionBalance                  phase0.Gwei    `spec:"EJECTION_BALANCE"`
+	MinPerEpochChurnLimit            uint64         `spec:"MIN_PER_EPOCH_CHURN_LIMIT"`
+	ChurnLimitQuotient               uint64         `spec:"CHURN_LIMIT_QUOTIENT"`
+	MaxPerEpochActivationChurnLimit  uint64         `spec:"MAX_PER_EPOCH_ACTIVATION_CHURN_LIMIT"`
+	DepositChainID                   uint64         `spec:"DEPOSIT_CHAIN_ID"`
+	DepositNetworkID                 uint64         `spec:"DEPOSIT_NETWORK_ID"`
+	DepositContractAddress           []byte         `spec:"DEPOSIT_CONTRACT_ADDRESS"`
+
+	// Preset.
+	MaxCommitteesPerSlot                 uint64      `spec:"MAX_COMMITTEES_PER_SLOT"`
+	TargetCommitteeSize                  uint64      `spec:"TARGET_COMMITTEE_SIZE"`
+	MaxValidatorsPerCommittee            uint64      `spec:"MAX_VALIDATORS_PER_COMMITTEE"`
+	ShuffleRoundCount                    uint64      `spec:"SHUFFLE_ROUND_COUNT"`
+	HysteresisQuotient                   uint64      `spec:"HYSTERESIS_QUOTIENT"`
+	HysteresisDownwardMultiplier         uint64      `spec:"HYSTERESIS_DOWNWARD_MULTIPLIER"`
+	HysteresisUpwardMultiplier           uint64      `spec:"HYSTERESIS_UPWARD_MULTIPLIER"`
+	MinDepositAmount                     phase0.Gwei `spec:"MIN_DEPOSIT_AMOUNT"`
+	MaxEffectiveBalance                  phase0.Gwei `spec:"MAX_EFFECTIVE_BALANCE"`
+	EffectiveBalanceIncrement            phase0.Gwei `spec:"EFFECTIVE_BALANCE_INCREMENT"`
+	MinAttestationInclusionDelay         uint64      `spec:"MIN_ATTESTATION_INCLUSION_DELAY"`
+	SlotsPerEpoch                        uint64      `spec:"SLOTS_PER_EPOCH"`
+	MinSeedLookahead                     uint64      `spec:"MIN_SEED_LOOKAHEAD"`
+	MaxSeedLookahead                     uint64      `spec:"MAX_SEED_LOOKAHEAD"`
+	EpochsPerETH1VotingPeriod            uint64      `spec:"EPOCHS_PER_ETH1_VOTING_PERIOD"`
+	SlotsPerHistoricalRoot               uint64      `spec:"SLOTS_PER_HISTORICAL_ROOT"`
+	MinEpochsToInactivityPenalty         uint64      `spec:"MIN_EPOCHS_TO_INACTIVITY_PENALTY"`
+	EpochsPerHistoricalVector            uint64      `spec:"EPOCHS_PER_HISTORICAL_VECTOR"`
+	EpochsPerSlashingsVector             uint64      `spec:"EPOCHS_PER_SLASHINGS_VECTOR"`
+	HistoricalRootsLimit                 uint64      `spec:"HISTORICAL_ROOTS_LIMIT"`
+	ValidatorRegistryLimit               uint64      `spec:"VALIDATOR_REGISTRY_LIMIT"`
+	BaseRewardFactor                     uint64      `spec:"BASE_REWARD_FACTOR"`
+	WhistleblowerRewardQuotient          uint64      `spec:"WHISTLEBLOWER_REWARD_QUOTIENT"`
+	ProposerRewardQuotient               uint64      `spec:"PROPOSER_REWARD_QUOTIENT"`
+	InactivityPenaltyQuotient            uint64      `spec:"INACTIVITY_PENALTY_QUOTIENT"`
+	MinSlashingPenaltyQuotient           uint64      `spec:"MIN_SLASHING_PENALTY_QUOTIENT"`
+	ProportionalSlashingMultiplier       uint64      `spec:"PROPORTIONAL_SLASHING_MULTIPLIER"`
+	MaxProposerSlashings                 uint64      `spec:"MAX_PROPOSER_SLASHINGS"`
+	MaxAttesterSlashings                 uint64      `spec:"MAX_ATTESTER_SLASHINGS"`
+	MaxAttestations                      uint64      `spec:"MAX_ATTESTATIONS"`
+	MaxDeposits                          uint64      `spec:"MAX_DEPOSITS"`
+	MaxVoluntaryExits                    uint64      `spec:"MAX_VOLUNTARY_EXITS"`
+	SyncCommitteeSize                    uint64      `spec:"SYNC_COMMITTEE_SIZE"`
+	EpochsPerSyncCommitteePeriod         uint64      `spec:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD"`
+	MaxBLSToExecutionChanges             uint64      `spec:"MAX_BLS_TO_EXECUTION_CHANGES"`
+	MaxWithdrawalsPerPayload             uint64      `spec:"MAX_WITHDRAWALS_PER_PAYLOAD"`
+	MaxValidatorsPerWithdrawalsSweep     uint64      `spec:"MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP"`
+	FieldElementsPerBlob                 uint64      `spec:"FIELD_ELEMENTS_PER_BLOB"`
+	MaxBlobCommitmentsPerBlock           uint64      `spec:"MAX_BLOB_COMMITMENTS_PER_BLOCK"`
+	MaxBlobsPerBlock                     uint64      `spec:"MAX_BLOBS_PER_BLOCK"`
+	TargetAggregatorsPerCommittee        uint64      `spec:"TARGET_AGGREGATORS_PER_COMMITTEE"`
+	TargetAggregatorsPerSyncSubcommittee uint64      `spec:"TARGET_AGGREGATORS_PER_SYNC_SUBCOMMITTEE"`
+	SyncCommitteeSubnetCount             uint64      `spec:"SYNC_COMMITTEE_SUBNET_COUNT"`
+
+	// Domain types.
+	DomainBeaconProposer              phase0.DomainType `spec:"DOMAIN_BEACON_PROPOSER"`
+	DomainBeaconAttester              phase0.DomainType `spec:"DOMAIN_BEACON_ATTESTER"`
+	DomainRandao                      phase0.DomainType `spec:"DOMAIN_RANDAO"`
+	DomainDeposit                     phase0.DomainType `spec:"DOMAIN_DEPOSIT"`
+	DomainVoluntaryExit               phase0.DomainType `spec:"DOMAIN_VOLUNTARY_EXIT"`
+	DomainSelectionProof              phase0.DomainType `spec:"DOMAIN_SELECTION_PROOF"`
+	DomainAggregateAndProof           phase0.DomainType `spec:"DOMAIN_AGGREGATE_AND_PROOF"`
+	DomainSyncCommittee               phase0.DomainType `spec:"DOMAIN_SYNC_COMMITTEE"`
+	DomainSyncCommitteeSelectionProof phase0.DomainType `spec:"DOMAIN_SYNC_COMMITTEE_SELECTION_PROOF"`
+	DomainContributionAndProof        phase0.DomainType `spec:"DOMAIN_CONTRIBUTION_AND_PROOF"`
+	DomainApplicationMask             phase0.DomainType `spec:"DOMAIN_APPLICATION_MASK"`
+	DomainApplicationBuilder          phase0.DomainType `spec:"DOMAIN_APPLICATION_BUILDER"`
+	DomainBLSToExecutionChange        phase0.DomainType `spec:"DOMAIN_BLS_TO_EXECUTION_CHANGE"`
+	DomainBlobSidecar                 phase0.DomainType `spec:"DOMAIN_BLOB_SIDECAR"`
+
+	// Raw contains all values supplied by the node that do not have a typed field above.
+	Raw map[string]interface{}
+}
+
+// GenesisConfig contains the configuration values that relate to genesis of the chain.
+type GenesisConfig struct {
+	MinGenesisActiveValidatorCount uint64
+	MinGenesisTime                 time.Time
+	GenesisForkVersion             phase0.Version
+	GenesisDelay                   time.Duration
+}
+
+// Genesis returns the genesis-related configuration values.
+func (c *SpecConfig) Genesis() *GenesisConfig {
+	return &GenesisConfig{
+		MinGenesisActiveValidatorCount: c.MinGenesisActiveValidatorCount,
+		MinGenesisTime:                 c.MinGenesisTime,
+		GenesisForkVersion:             c.GenesisForkVersion,
+		GenesisDelay:                   c.GenesisDelay,
+	}
+}
+
+// Preset returns the preset values of the chain specification.
+// Values not provided by the node are taken from the base preset, or mainnet if the base
+// preset is unknown.
+func (c *SpecConfig) Preset() *preset.Preset {
+	res, err := preset.ByName(c.PresetBase)
+	if err != nil {
+		res = preset.Mainnet()
+	}
+	if c.PresetBase != "" {
+		res.Name = c.PresetBase
+	}
+
+	overrides := []struct {
+		value  uint64
+		target *uint64
+	}{
+		{c.SlotsPerEpoch, &res.SlotsPerEpoch},
+		{c.MaxCommitteesPerSlot, &res.MaxCommitteesPerSlot},
+		{c.MaxValidatorsPerCommittee, &res.MaxValidatorsPerCommittee},
+		{c.EpochsPerETH1VotingPeriod, &res.EpochsPerETH1VotingPeriod},
+		{c.SlotsPerHistoricalRoot, &res.SlotsPerHistoricalRoot},
+		{c.EpochsPerHistoricalVector, &res.EpochsPerHistoricalVector},
+		{c.EpochsPerSlashingsVector, &res.EpochsPerSlashingsVector},
+		{c.HistoricalRootsLimit, &res.HistoricalRootsLimit},
+		{c.ValidatorRegistryLimit, &res.ValidatorRegistryLimit},
+		{c.MaxProposerSlashings, &res.MaxProposerSlashings},
+		{c.MaxAttesterSlashings, &res.MaxAttesterSlashings},
+		{c.MaxAttestations, &res.MaxAttestations},
+		{c.MaxDeposits, &res.MaxDeposits},
+		{c.MaxVoluntaryExits, &res.MaxVoluntaryExits},
+		{c.SyncCommitteeSize, &res.SyncCommitteeSize},
+		{c.SyncCommitteeSubnetCount, &res.SyncCommitteeSubnetCount},
+		{c.MaxBLSToExecutionChanges, &res.MaxBLSToExecutionChanges},
+		{c.MaxWithdrawalsPerPayload, &res.MaxWithdrawalsPerPayload},
+		{c.MaxBlobCommitmentsPerBlock, &res.MaxBlobCommitmentsPerBlock},
+		{c.FieldElementsPerBlob, &res.FieldElementsPerBlob},
+	}
+	for _, override := range overrides {
+		if override.value != 0 {
+			*override.target = override.value
+		}
+	}
+
+	return res
+}
+
+var (
+	bigIntType     = reflect.TypeOf((*big.Int)(nil))
+	durationType   = reflect.TypeOf(time.Duration(0))
+	timeType       = reflect.TypeOf(time.Time{})
+	bytesType      = reflect.TypeOf([]byte{})
+	versionType    = reflect.TypeOf(phase0.Version{})
+	domainTypeType = reflect.TypeOf(phase0.DomainType{})
+)
+
+// ChurnConfig returns the churn configuration of the chain.
+func (c *SpecConfig) ChurnConfig() *apiv1.ChurnConfig {
+	return &apiv1.ChurnConfig{
+		MinPerEpochChurnLimit:           c.MinPerEpochChurnLimit,
+		ChurnLimitQuotient:              c.ChurnLimitQuotient,
+		MaxPerEpochActivationChurnLimit: c.MaxPerEpochActivationChurnLimit,
+	}
+}
+
+// NewSpecConfig creates a typed spec configuration from the values returned by a SpecProvider.
+func NewSpecConfig(values map[string]interface{}) (*SpecConfig, error) {
+	if values == nil {
+		return nil, errors.New("no spec values supplied")
+	}
+
+	config := &SpecConfig{
+		Raw: make(map[string]interface{}),
+	}
+	known := make(map[string]struct{})
+
+	configValue := reflect.ValueOf(config).Elem()
+	configType := configValue.Type()
+	for i := 0; i < configType.NumField(); i++ {
+		key := configType.Field(i).Tag.Get("spec")
+		if key == "" {
+			continue
+		}
+		known[key] = struct{}{}
+		value, exists := values[key]
+		if !exists {
+			continue
+		}
+		if err := setSpecField(configValue.Field(i), value); err != nil {
+			return nil, errors.Wrap(err, key)
+		}
+	}
+
+	for k, v := range values {
+		if _, exists := known[k]; !exists {
+			config.Raw[k] = v
+		}
+	}
+
+	return config, nil
+}
+
+// setSpecField sets a field from a spec value, converting between the representations used by SpecProvider as required.
+func setSpecField(field reflect.Value, value interface{}) error {
+	switch field.Type() {
+	case bigIntType:
+		switch v := value.(type) {
+		case uint64:
+			field.Set(reflect.ValueOf(new(big.Int).SetUint64(v)))
+		case string:
+			res, success := new(big.Int).SetString(v, 10)
+			if !success {
+				return fmt.Errorf("invalid integer %s", v)
+			}
+			field.Set(reflect.ValueOf(res))
+		default:
+			return fmt.Errorf("unexpected type %T", value)
+		}
+	case durationType:
+		switch v := value.(type) {
+		case time.Duration:
+			field.SetInt(int64(v))
+		case uint64:
+			// Zero durations are provided as integers.
+			field.SetInt(int64(time.Duration(v) * time.Second))
+		default:
+			return fmt.Errorf("unexpected type %T", value)
+		}
+	case timeType:
+		switch v := value.(type) {
+		case time.Time:
+			field.Set(reflect.ValueOf(v))
+		case uint64:
+			// Zero times are provided as integers.
+			field.Set(reflect.ValueOf(time.Unix(int64(v), 0)))
+		default:
+			return fmt.Errorf("unexpected type %T", value)
+		}
+	case bytesType:
+		v, isBytes := value.([]byte)
+		if !isBytes {
+			return fmt.Errorf("unexpected type %T", value)
+		}
+		field.SetBytes(v)
+	case versionType, domainTypeType:
+		switch v := value.(type) {
+		case phase0.Version:
+			reflect.Copy(field, reflect.ValueOf(v[:]))
+		case phase0.DomainType:
+			reflect.Copy(field, reflect.ValueOf(v[:]))
+		case []byte:
+			if len(v) != field.Len() {
+				return fmt.Errorf("incorrect length %d", len(v))
+			}
+			reflect.Copy(field, reflect.ValueOf(v))
+		default:
+			return fmt.Errorf("unexpected type %T", value)
+		}
+	default:
+		switch field.Kind() {
+		case reflect.String:
+			v, isString := value.(string)
+			if !isString {
+				return fmt.Errorf("unexpected type %T", value)
+			}
+			field.SetString(v)
+		case reflect.Uint64:
+			v, isUint64 := value.(uint64)
+			if !isUint64 {
+				return fmt.Errorf("unexpected type %T", value)
+			}
+			field.SetUint(v)
+		default:
+			return fmt.Errorf("unhandled field type %v", field.Type())
+		}
+	}
+
+	return nil
+}
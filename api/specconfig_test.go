@@ -0,0 +1,149 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/preset"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSpecConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		values map[string]interface{}
+		config *api.SpecConfig
+		err    string
+	}{
+		{
+			name: "Nil",
+			err:  "no spec values supplied",
+		},
+		{
+			name:   "Empty",
+			values: map[string]interface{}{},
+			config: &api.SpecConfig{
+				Raw: map[string]interface{}{},
+			},
+		},
+		{
+			name: "Good",
+			values: map[string]interface{}{
+				"CONFIG_NAME":               "mainnet",
+				"TERMINAL_TOTAL_DIFFICULTY": "58750000000000000000000",
+				"MIN_GENESIS_TIME":          time.Unix(1606824000, 0),
+				"GENESIS_FORK_VERSION":      phase0.Version{0x00, 0x00, 0x00, 0x00},
+				"GENESIS_DELAY":             604800 * time.Second,
+				"DENEB_FORK_EPOCH":          uint64(269568),
+				"SECONDS_PER_SLOT":          12 * time.Second,
+				"SLOTS_PER_EPOCH":           uint64(32),
+				"MAX_EFFECTIVE_BALANCE":     uint64(32000000000),
+				"DEPOSIT_CONTRACT_ADDRESS":  []byte{0x00, 0x00, 0x00, 0x00, 0x21, 0x9a},
+				"DOMAIN_VOLUNTARY_EXIT":     phase0.DomainType{0x04, 0x00, 0x00, 0x00},
+				"UNKNOWN_VALUE":             uint64(12),
+			},
+			config: &api.SpecConfig{
+				ConfigName:              "mainnet",
+				TerminalTotalDifficulty: big.NewInt(0).Mul(big.NewInt(58750), big.NewInt(1000000000000000000)),
+				MinGenesisTime:          time.Unix(1606824000, 0),
+				GenesisDelay:            604800 * time.Second,
+				DenebForkEpoch:          269568,
+				SecondsPerSlot:          12 * time.Second,
+				SlotsPerEpoch:           32,
+				MaxEffectiveBalance:     32000000000,
+				DepositContractAddress:  []byte{0x00, 0x00, 0x00, 0x00, 0x21, 0x9a},
+				DomainVoluntaryExit:     phase0.DomainType{0x04, 0x00, 0x00, 0x00},
+				Raw: map[string]interface{}{
+					"UNKNOWN_VALUE": uint64(12),
+				},
+			},
+		},
+		{
+			name: "ZeroValues",
+			values: map[string]interface{}{
+				"GENESIS_DELAY":             uint64(0),
+				"MIN_GENESIS_TIME":          uint64(0),
+				"TERMINAL_TOTAL_DIFFICULTY": uint64(0),
+				"ALTAIR_FORK_VERSION":       []byte{0x01, 0x00, 0x00, 0x00},
+			},
+			config: &api.SpecConfig{
+				MinGenesisTime:          time.Unix(0, 0),
+				TerminalTotalDifficulty: big.NewInt(0),
+				AltairForkVersion:       phase0.Version{0x01, 0x00, 0x00, 0x00},
+				Raw:                     map[string]interface{}{},
+			},
+		},
+		{
+			name: "WrongType",
+			values: map[string]interface{}{
+				"SLOTS_PER_EPOCH": "32",
+			},
+			err: "SLOTS_PER_EPOCH: unexpected type string",
+		},
+		{
+			name: "WrongLength",
+			values: map[string]interface{}{
+				"ALTAIR_FORK_VERSION": []byte{0x01, 0x00, 0x00},
+			},
+			err: "ALTAIR_FORK_VERSION: incorrect length 3",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config, err := api.NewSpecConfig(test.values)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.config, config)
+			}
+		})
+	}
+}
+
+func TestSpecConfigGenesis(t *testing.T) {
+	config := &api.SpecConfig{
+		MinGenesisActiveValidatorCount: 16384,
+		MinGenesisTime:                 time.Unix(1606824000, 0),
+		GenesisForkVersion:             phase0.Version{0x00, 0x00, 0x10, 0x20},
+		GenesisDelay:                   604800 * time.Second,
+	}
+	require.Equal(t, &api.GenesisConfig{
+		MinGenesisActiveValidatorCount: 16384,
+		MinGenesisTime:                 time.Unix(1606824000, 0),
+		GenesisForkVersion:             phase0.Version{0x00, 0x00, 0x10, 0x20},
+		GenesisDelay:                   604800 * time.Second,
+	}, config.Genesis())
+}
+
+func TestSpecConfigPreset(t *testing.T) {
+	require.Equal(t, preset.Mainnet(), (&api.SpecConfig{}).Preset())
+	require.Equal(t, preset.Minimal(), (&api.SpecConfig{PresetBase: "minimal"}).Preset())
+
+	custom := (&api.SpecConfig{
+		PresetBase:        "custom",
+		SlotsPerEpoch:     16,
+		SyncCommitteeSize: 128,
+	}).Preset()
+	require.Equal(t, "custom", custom.Name)
+	require.Equal(t, uint64(16), custom.SlotsPerEpoch)
+	require.Equal(t, uint64(128), custom.SyncCommitteeSize)
+	require.Equal(t, preset.Mainnet().EpochsPerHistoricalVector, custom.EpochsPerHistoricalVector)
+}
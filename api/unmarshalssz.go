@@ -0,0 +1,159 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// UnmarshalProposalSSZ unmarshals SSZ-encoded data in to a versioned proposal of the given version.
+// blinded states if the data contains a blinded block, as would be supplied alongside the data in an
+// Eth-Execution-Payload-Blinded header.  Deneb proposals contain block contents rather than a bare block.
+// The execution and consensus values are not part of the SSZ data, so are not set.
+func UnmarshalProposalSSZ(version spec.DataVersion, blinded bool, data []byte) (*VersionedProposal, error) {
+	res := &VersionedProposal{
+		Version: version,
+		Blinded: blinded,
+	}
+
+	var err error
+	switch {
+	case version == spec.DataVersionPhase0 && !blinded:
+		res.Phase0 = &phase0.BeaconBlock{}
+		err = res.Phase0.UnmarshalSSZ(data)
+	case version == spec.DataVersionAltair && !blinded:
+		res.Altair = &altair.BeaconBlock{}
+		err = res.Altair.UnmarshalSSZ(data)
+	case version == spec.DataVersionBellatrix && !blinded:
+		res.Bellatrix = &bellatrix.BeaconBlock{}
+		err = res.Bellatrix.UnmarshalSSZ(data)
+	case version == spec.DataVersionBellatrix && blinded:
+		res.BellatrixBlinded = &apiv1bellatrix.BlindedBeaconBlock{}
+		err = res.BellatrixBlinded.UnmarshalSSZ(data)
+	case version == spec.DataVersionCapella && !blinded:
+		res.Capella = &capella.BeaconBlock{}
+		err = res.Capella.UnmarshalSSZ(data)
+	case version == spec.DataVersionCapella && blinded:
+		res.CapellaBlinded = &apiv1capella.BlindedBeaconBlock{}
+		err = res.CapellaBlinded.UnmarshalSSZ(data)
+	case version == spec.DataVersionDeneb && !blinded:
+		res.Deneb = &apiv1deneb.BlockContents{}
+		err = res.Deneb.UnmarshalSSZ(data)
+	case version == spec.DataVersionDeneb && blinded:
+		res.DenebBlinded = &apiv1deneb.BlindedBlockContents{}
+		err = res.DenebBlinded.UnmarshalSSZ(data)
+	default:
+		return nil, fmt.Errorf("unsupported proposal version %s (blinded %t)", version, blinded)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal %s proposal", version)
+	}
+
+	return res, nil
+}
+
+// UnmarshalBlindedBeaconBlockSSZ unmarshals SSZ-encoded data in to a versioned blinded beacon block of the given version.
+func UnmarshalBlindedBeaconBlockSSZ(version spec.DataVersion, data []byte) (*VersionedBlindedBeaconBlock, error) {
+	res := &VersionedBlindedBeaconBlock{
+		Version: version,
+	}
+
+	var err error
+	switch version {
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &apiv1bellatrix.BlindedBeaconBlock{}
+		err = res.Bellatrix.UnmarshalSSZ(data)
+	case spec.DataVersionCapella:
+		res.Capella = &apiv1capella.BlindedBeaconBlock{}
+		err = res.Capella.UnmarshalSSZ(data)
+	case spec.DataVersionDeneb:
+		res.Deneb = &apiv1deneb.BlindedBeaconBlock{}
+		err = res.Deneb.UnmarshalSSZ(data)
+	default:
+		return nil, fmt.Errorf("unsupported blinded beacon block version %s", version)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal %s blinded beacon block", version)
+	}
+
+	return res, nil
+}
+
+// UnmarshalSignedBlindedBeaconBlockSSZ unmarshals SSZ-encoded data in to a versioned signed blinded beacon
+// block of the given version.
+func UnmarshalSignedBlindedBeaconBlockSSZ(version spec.DataVersion, data []byte) (*VersionedSignedBlindedBeaconBlock, error) {
+	res := &VersionedSignedBlindedBeaconBlock{
+		Version: version,
+	}
+
+	var err error
+	switch version {
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &apiv1bellatrix.SignedBlindedBeaconBlock{}
+		err = res.Bellatrix.UnmarshalSSZ(data)
+	case spec.DataVersionCapella:
+		res.Capella = &apiv1capella.SignedBlindedBeaconBlock{}
+		err = res.Capella.UnmarshalSSZ(data)
+	case spec.DataVersionDeneb:
+		res.Deneb = &apiv1deneb.SignedBlindedBeaconBlock{}
+		err = res.Deneb.UnmarshalSSZ(data)
+	default:
+		return nil, fmt.Errorf("unsupported signed blinded beacon block version %s", version)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal %s signed blinded beacon block", version)
+	}
+
+	return res, nil
+}
+
+// UnmarshalSignedBlockContentsSSZ unmarshals SSZ-encoded data in to signed block contents of the given version.
+// Block contents, containing both the block and its blob sidecars, are only present from deneb onwards.
+func UnmarshalSignedBlockContentsSSZ(version spec.DataVersion, data []byte) (*apiv1deneb.SignedBlockContents, error) {
+	if version != spec.DataVersionDeneb {
+		return nil, fmt.Errorf("unsupported signed block contents version %s", version)
+	}
+
+	res := &apiv1deneb.SignedBlockContents{}
+	if err := res.UnmarshalSSZ(data); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal %s signed block contents", version)
+	}
+
+	return res, nil
+}
+
+// UnmarshalSignedBlindedBlockContentsSSZ unmarshals SSZ-encoded data in to signed blinded block contents of the
+// given version.  Block contents are only present from deneb onwards.
+func UnmarshalSignedBlindedBlockContentsSSZ(version spec.DataVersion, data []byte) (*apiv1deneb.SignedBlindedBlockContents, error) {
+	if version != spec.DataVersionDeneb {
+		return nil, fmt.Errorf("unsupported signed blinded block contents version %s", version)
+	}
+
+	res := &apiv1deneb.SignedBlindedBlockContents{}
+	if err := res.UnmarshalSSZ(data); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal %s signed blinded block contents", version)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,86 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/gencorpus"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalProposalSSZ(t *testing.T) {
+	block := &capella.BeaconBlock{}
+	require.NoError(t, gencorpus.Fill(block, gencorpus.VariantZero))
+	block.Slot = 10
+	blockData, err := block.MarshalSSZ()
+	require.NoError(t, err)
+
+	blindedBlock := &apiv1capella.BlindedBeaconBlock{}
+	require.NoError(t, gencorpus.Fill(blindedBlock, gencorpus.VariantZero))
+	blindedBlock.Slot = 11
+	blindedBlockData, err := blindedBlock.MarshalSSZ()
+	require.NoError(t, err)
+
+	proposal, err := api.UnmarshalProposalSSZ(spec.DataVersionCapella, false, blockData)
+	require.NoError(t, err)
+	require.False(t, proposal.Blinded)
+	slot, err := proposal.Slot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(10), slot)
+
+	proposal, err = api.UnmarshalProposalSSZ(spec.DataVersionCapella, true, blindedBlockData)
+	require.NoError(t, err)
+	require.True(t, proposal.Blinded)
+	slot, err = proposal.Slot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(11), slot)
+
+	_, err = api.UnmarshalProposalSSZ(spec.DataVersionPhase0, true, blockData)
+	require.EqualError(t, err, "unsupported proposal version phase0 (blinded true)")
+
+	_, err = api.UnmarshalProposalSSZ(spec.DataVersionCapella, false, blockData[:100])
+	require.ErrorContains(t, err, "failed to unmarshal capella proposal")
+}
+
+func TestUnmarshalBlindedBeaconBlockSSZ(t *testing.T) {
+	block := &apiv1bellatrix.SignedBlindedBeaconBlock{}
+	require.NoError(t, gencorpus.Fill(block, gencorpus.VariantZero))
+	block.Message.Slot = 12
+	signedData, err := block.MarshalSSZ()
+	require.NoError(t, err)
+	data, err := block.Message.MarshalSSZ()
+	require.NoError(t, err)
+
+	signed, err := api.UnmarshalSignedBlindedBeaconBlockSSZ(spec.DataVersionBellatrix, signedData)
+	require.NoError(t, err)
+	slot, err := signed.Slot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(12), slot)
+
+	unsigned, err := api.UnmarshalBlindedBeaconBlockSSZ(spec.DataVersionBellatrix, data)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(12), unsigned.Bellatrix.Slot)
+
+	_, err = api.UnmarshalBlindedBeaconBlockSSZ(spec.DataVersionAltair, data)
+	require.EqualError(t, err, "unsupported blinded beacon block version altair")
+	_, err = api.UnmarshalSignedBlockContentsSSZ(spec.DataVersionCapella, data)
+	require.EqualError(t, err, "unsupported signed block contents version capella")
+}
@@ -0,0 +1,306 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// AttestationRewards contains the attestation rewards for a set of validators for an epoch,
+// along with the ideal rewards that a validator could have obtained.
+type AttestationRewards struct {
+	// IdealRewards are the rewards for a perfectly performing validator, by effective balance.
+	IdealRewards []*IdealAttestationRewards
+	// TotalRewards are the rewards obtained by each validator.
+	TotalRewards []*ValidatorAttestationRewards
+}
+
+// attestationRewardsJSON is the spec representation of the struct.
+type attestationRewardsJSON struct {
+	IdealRewards []*IdealAttestationRewards     `json:"ideal_rewards"`
+	TotalRewards []*ValidatorAttestationRewards `json:"total_rewards"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a *AttestationRewards) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&attestationRewardsJSON{
+		IdealRewards: a.IdealRewards,
+		TotalRewards: a.TotalRewards,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *AttestationRewards) UnmarshalJSON(input []byte) error {
+	var data attestationRewardsJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if data.IdealRewards == nil {
+		return errors.New("ideal rewards missing")
+	}
+	for i := range data.IdealRewards {
+		if data.IdealRewards[i] == nil {
+			return fmt.Errorf("ideal rewards entry %d missing", i)
+		}
+	}
+	a.IdealRewards = data.IdealRewards
+	if data.TotalRewards == nil {
+		return errors.New("total rewards missing")
+	}
+	for i := range data.TotalRewards {
+		if data.TotalRewards[i] == nil {
+			return fmt.Errorf("total rewards entry %d missing", i)
+		}
+	}
+	a.TotalRewards = data.TotalRewards
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (a *AttestationRewards) String() string {
+	data, err := json.Marshal(a)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
+
+// IdealAttestationRewards contains the rewards a perfectly performing validator with the given
+// effective balance would have obtained for its attestation.
+type IdealAttestationRewards struct {
+	EffectiveBalance phase0.Gwei
+	Head             phase0.Gwei
+	Target           phase0.Gwei
+	Source           phase0.Gwei
+	// InclusionDelay is only present for phase 0.
+	InclusionDelay *phase0.Gwei
+	Inactivity     phase0.Gwei
+}
+
+// idealAttestationRewardsJSON is the spec representation of the struct.
+type idealAttestationRewardsJSON struct {
+	EffectiveBalance string `json:"effective_balance"`
+	Head             string `json:"head"`
+	Target           string `json:"target"`
+	Source           string `json:"source"`
+	InclusionDelay   string `json:"inclusion_delay,omitempty"`
+	Inactivity       string `json:"inactivity"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i *IdealAttestationRewards) MarshalJSON() ([]byte, error) {
+	data := &idealAttestationRewardsJSON{
+		EffectiveBalance: fmt.Sprintf("%d", i.EffectiveBalance),
+		Head:             fmt.Sprintf("%d", i.Head),
+		Target:           fmt.Sprintf("%d", i.Target),
+		Source:           fmt.Sprintf("%d", i.Source),
+		Inactivity:       fmt.Sprintf("%d", i.Inactivity),
+	}
+	if i.InclusionDelay != nil {
+		data.InclusionDelay = fmt.Sprintf("%d", *i.InclusionDelay)
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *IdealAttestationRewards) UnmarshalJSON(input []byte) error {
+	var data idealAttestationRewardsJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	var err error
+	if i.EffectiveBalance, err = parseGwei(data.EffectiveBalance, "effective balance"); err != nil {
+		return err
+	}
+	if i.Head, err = parseGwei(data.Head, "head"); err != nil {
+		return err
+	}
+	if i.Target, err = parseGwei(data.Target, "target"); err != nil {
+		return err
+	}
+	if i.Source, err = parseGwei(data.Source, "source"); err != nil {
+		return err
+	}
+	i.InclusionDelay = nil
+	if data.InclusionDelay != "" {
+		inclusionDelay, err := parseGwei(data.InclusionDelay, "inclusion delay")
+		if err != nil {
+			return err
+		}
+		i.InclusionDelay = &inclusionDelay
+	}
+	if i.Inactivity, err = parseGwei(data.Inactivity, "inactivity"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (i *IdealAttestationRewards) String() string {
+	data, err := json.Marshal(i)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
+
+// ValidatorAttestationRewards contains the rewards obtained by a validator for its attestation.
+// Rewards can be negative, in which case they are penalties.
+type ValidatorAttestationRewards struct {
+	ValidatorIndex phase0.ValidatorIndex
+	Head           int64
+	Target         int64
+	Source         int64
+	// InclusionDelay is only present for phase 0.
+	InclusionDelay *phase0.Gwei
+	Inactivity     int64
+}
+
+// validatorAttestationRewardsJSON is the spec representation of the struct.
+type validatorAttestationRewardsJSON struct {
+	ValidatorIndex string `json:"validator_index"`
+	Head           string `json:"head"`
+	Target         string `json:"target"`
+	Source         string `json:"source"`
+	InclusionDelay string `json:"inclusion_delay,omitempty"`
+	Inactivity     string `json:"inactivity"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v *ValidatorAttestationRewards) MarshalJSON() ([]byte, error) {
+	data := &validatorAttestationRewardsJSON{
+		ValidatorIndex: fmt.Sprintf("%d", v.ValidatorIndex),
+		Head:           fmt.Sprintf("%d", v.Head),
+		Target:         fmt.Sprintf("%d", v.Target),
+		Source:         fmt.Sprintf("%d", v.Source),
+		Inactivity:     fmt.Sprintf("%d", v.Inactivity),
+	}
+	if v.InclusionDelay != nil {
+		data.InclusionDelay = fmt.Sprintf("%d", *v.InclusionDelay)
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *ValidatorAttestationRewards) UnmarshalJSON(input []byte) error {
+	var data validatorAttestationRewardsJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.ValidatorIndex == "" {
+		return errors.New("validator index missing")
+	}
+	validatorIndex, err := strconv.ParseUint(data.ValidatorIndex, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for validator index")
+	}
+	v.ValidatorIndex = phase0.ValidatorIndex(validatorIndex)
+	if v.Head, err = parseReward(data.Head, "head"); err != nil {
+		return err
+	}
+	if v.Target, err = parseReward(data.Target, "target"); err != nil {
+		return err
+	}
+	if v.Source, err = parseReward(data.Source, "source"); err != nil {
+		return err
+	}
+	v.InclusionDelay = nil
+	if data.InclusionDelay != "" {
+		inclusionDelay, err := parseGwei(data.InclusionDelay, "inclusion delay")
+		if err != nil {
+			return err
+		}
+		v.InclusionDelay = &inclusionDelay
+	}
+	if v.Inactivity, err = parseReward(data.Inactivity, "inactivity"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (v *ValidatorAttestationRewards) String() string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
+
+// Total returns the net reward obtained by the validator for its attestation.
+func (v *ValidatorAttestationRewards) Total() int64 {
+	total := v.Head + v.Target + v.Source + v.Inactivity
+	if v.InclusionDelay != nil {
+		total += int64(*v.InclusionDelay)
+	}
+
+	return total
+}
+
+// SumAttestationRewards returns the net attestation reward obtained by each validator across
+// the given rewards, for example the rewards for a number of epochs.
+func SumAttestationRewards(rewards []*AttestationRewards) map[phase0.ValidatorIndex]int64 {
+	res := make(map[phase0.ValidatorIndex]int64)
+	for _, epochRewards := range rewards {
+		if epochRewards == nil {
+			continue
+		}
+		for _, validatorRewards := range epochRewards.TotalRewards {
+			if validatorRewards == nil {
+				continue
+			}
+			res[validatorRewards.ValidatorIndex] += validatorRewards.Total()
+		}
+	}
+
+	return res
+}
+
+// parseGwei parses a required Gwei value.
+func parseGwei(input string, name string) (phase0.Gwei, error) {
+	if input == "" {
+		return 0, fmt.Errorf("%s missing", name)
+	}
+	value, err := strconv.ParseUint(input, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid value for %s", name)
+	}
+
+	return phase0.Gwei(value), nil
+}
+
+// parseReward parses a required reward, which can be negative.
+func parseReward(input string, name string) (int64, error) {
+	if input == "" {
+		return 0, fmt.Errorf("%s missing", name)
+	}
+	value, err := strconv.ParseInt(input, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid value for %s", name)
+	}
+
+	return value, nil
+}
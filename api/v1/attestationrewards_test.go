@@ -0,0 +1,135 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestAttestationRewardsJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.attestationRewardsJSON",
+		},
+		{
+			name:  "IdealRewardsMissing",
+			input: []byte(`{"total_rewards":[{"validator_index":"0","head":"2000","target":"2000","source":"4000","inactivity":"0"}]}`),
+			err:   "ideal rewards missing",
+		},
+		{
+			name:  "IdealRewardsEntryMissing",
+			input: []byte(`{"ideal_rewards":[null],"total_rewards":[]}`),
+			err:   "ideal rewards entry 0 missing",
+		},
+		{
+			name:  "EffectiveBalanceMissing",
+			input: []byte(`{"ideal_rewards":[{"head":"2500","target":"5000","source":"5000","inactivity":"0"}],"total_rewards":[]}`),
+			err:   "invalid JSON: effective balance missing",
+		},
+		{
+			name:  "EffectiveBalanceInvalid",
+			input: []byte(`{"ideal_rewards":[{"effective_balance":"-1","head":"2500","target":"5000","source":"5000","inactivity":"0"}],"total_rewards":[]}`),
+			err:   "invalid JSON: invalid value for effective balance: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "TotalRewardsMissing",
+			input: []byte(`{"ideal_rewards":[]}`),
+			err:   "total rewards missing",
+		},
+		{
+			name:  "ValidatorIndexMissing",
+			input: []byte(`{"ideal_rewards":[],"total_rewards":[{"head":"2000","target":"2000","source":"4000","inactivity":"0"}]}`),
+			err:   "invalid JSON: validator index missing",
+		},
+		{
+			name:  "HeadMissing",
+			input: []byte(`{"ideal_rewards":[],"total_rewards":[{"validator_index":"0","target":"2000","source":"4000","inactivity":"0"}]}`),
+			err:   "invalid JSON: head missing",
+		},
+		{
+			name:  "TargetInvalid",
+			input: []byte(`{"ideal_rewards":[],"total_rewards":[{"validator_index":"0","head":"2000","target":"x","source":"4000","inactivity":"0"}]}`),
+			err:   "invalid JSON: invalid value for target: strconv.ParseInt: parsing \"x\": invalid syntax",
+		},
+		{
+			name:  "InclusionDelayNegative",
+			input: []byte(`{"ideal_rewards":[],"total_rewards":[{"validator_index":"0","head":"2000","target":"2000","source":"4000","inclusion_delay":"-1","inactivity":"0"}]}`),
+			err:   "invalid JSON: invalid value for inclusion delay: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"ideal_rewards":[{"effective_balance":"32000000000","head":"2500","target":"5000","source":"5000","inactivity":"0"}],"total_rewards":[{"validator_index":"0","head":"2000","target":"-2000","source":"4000","inactivity":"-100"}]}`),
+		},
+		{
+			name:  "GoodPhase0",
+			input: []byte(`{"ideal_rewards":[{"effective_balance":"32000000000","head":"2500","target":"5000","source":"5000","inclusion_delay":"5000","inactivity":"0"}],"total_rewards":[{"validator_index":"0","head":"2000","target":"2000","source":"4000","inclusion_delay":"2000","inactivity":"0"}]}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.AttestationRewards
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
+
+func TestSumAttestationRewards(t *testing.T) {
+	inclusionDelay := phase0.Gwei(1000)
+	rewards := []*api.AttestationRewards{
+		{
+			TotalRewards: []*api.ValidatorAttestationRewards{
+				{ValidatorIndex: 1, Head: 2000, Target: 2000, Source: 4000},
+				{ValidatorIndex: 2, Head: 0, Target: -2000, Source: -4000, Inactivity: -500},
+			},
+		},
+		nil,
+		{
+			TotalRewards: []*api.ValidatorAttestationRewards{
+				{ValidatorIndex: 1, Head: 2000, Target: 2000, Source: 4000, InclusionDelay: &inclusionDelay},
+				nil,
+			},
+		},
+	}
+
+	require.Equal(t, map[phase0.ValidatorIndex]int64{
+		1: 17000,
+		2: -6500,
+	}, api.SumAttestationRewards(rewards))
+}
@@ -0,0 +1,92 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ChainHead is a head of the chain as known to the node's fork choice.
+type ChainHead struct {
+	// Slot is the slot of the head block.
+	Slot phase0.Slot
+	// Root is the root of the head block.
+	Root phase0.Root
+	// ExecutionOptimistic is true if the head block references an execution
+	// payload that has not been fully verified.
+	ExecutionOptimistic bool
+}
+
+// chainHeadJSON is the spec representation of the struct.
+type chainHeadJSON struct {
+	Slot                string `json:"slot"`
+	Root                string `json:"root"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c *ChainHead) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&chainHeadJSON{
+		Slot:                fmt.Sprintf("%d", c.Slot),
+		Root:                fmt.Sprintf("%#x", c.Root),
+		ExecutionOptimistic: c.ExecutionOptimistic,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *ChainHead) UnmarshalJSON(input []byte) error {
+	var data chainHeadJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := strconv.ParseUint(data.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	c.Slot = phase0.Slot(slot)
+	if data.Root == "" {
+		return errors.New("root missing")
+	}
+	root, err := hex.DecodeString(strings.TrimPrefix(data.Root, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for root")
+	}
+	if len(root) != rootLength {
+		return fmt.Errorf("incorrect length %d for root", len(root))
+	}
+	copy(c.Root[:], root)
+	c.ExecutionOptimistic = data.ExecutionOptimistic
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (c *ChainHead) String() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
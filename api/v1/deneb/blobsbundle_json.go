@@ -0,0 +1,65 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb
+
+import (
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/pkg/errors"
+)
+
+// blobsBundleJSON is the spec representation of the struct.
+type blobsBundleJSON struct {
+	Commitments []deneb.KzgCommitment `json:"commitments"`
+	Proofs      []deneb.KzgProof      `json:"proofs"`
+	Blobs       []deneb.Blob          `json:"blobs"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *BlobsBundle) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&blobsBundleJSON{
+		Commitments: b.Commitments,
+		Proofs:      b.Proofs,
+		Blobs:       b.Blobs,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BlobsBundle) UnmarshalJSON(input []byte) error {
+	var data blobsBundleJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	return b.unpack(&data)
+}
+
+func (b *BlobsBundle) unpack(data *blobsBundleJSON) error {
+	if data.Commitments == nil {
+		return errors.New("commitments missing")
+	}
+	b.Commitments = data.Commitments
+
+	if data.Proofs == nil {
+		return errors.New("proofs missing")
+	}
+	b.Proofs = data.Proofs
+
+	if data.Blobs == nil {
+		return errors.New("blobs missing")
+	}
+	b.Blobs = data.Blobs
+
+	return nil
+}
@@ -0,0 +1,160 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 661effb55f127bd608d0d929fa34964bb43330f286a889a42e4d5ba57b9c26aa
+// Version: 0.1.2
+package deneb
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the BlockContents object
+func (b *BlockContents) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BlockContents object to a target array
+func (b *BlockContents) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'Block'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.Block == nil {
+		b.Block = new(deneb.BeaconBlock)
+	}
+	offset += b.Block.SizeSSZ()
+
+	// Offset (1) 'BlobSidecars'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlobSidecars) * 131256
+
+	// Field (0) 'Block'
+	if dst, err = b.Block.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'BlobSidecars'
+	if size := len(b.BlobSidecars); size > 6 {
+		err = ssz.ErrListTooBigFn("BlockContents.BlobSidecars", size, 6)
+		return
+	}
+	for ii := 0; ii < len(b.BlobSidecars); ii++ {
+		if dst, err = b.BlobSidecars[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BlockContents object
+func (b *BlockContents) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Block'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'BlobSidecars'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Block'
+	{
+		buf = tail[o0:o1]
+		if b.Block == nil {
+			b.Block = new(deneb.BeaconBlock)
+		}
+		if err = b.Block.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'BlobSidecars'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 131256, 6)
+		if err != nil {
+			return err
+		}
+		b.BlobSidecars = make([]*deneb.BlobSidecar, num)
+		for ii := 0; ii < num; ii++ {
+			if b.BlobSidecars[ii] == nil {
+				b.BlobSidecars[ii] = new(deneb.BlobSidecar)
+			}
+			if err = b.BlobSidecars[ii].UnmarshalSSZ(buf[ii*131256 : (ii+1)*131256]); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BlockContents object
+func (b *BlockContents) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'Block'
+	if b.Block == nil {
+		b.Block = new(deneb.BeaconBlock)
+	}
+	size += b.Block.SizeSSZ()
+
+	// Field (1) 'BlobSidecars'
+	size += len(b.BlobSidecars) * 131256
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BlockContents object
+func (b *BlockContents) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BlockContents object with a hasher
+func (b *BlockContents) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Block'
+	if err = b.Block.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'BlobSidecars'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.BlobSidecars))
+		if num > 6 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.BlobSidecars {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 6)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BlockContents object
+func (b *BlockContents) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
@@ -0,0 +1,180 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+)
+
+// Unblind combines the signed blinded block contents with the execution payload and blobs bundle
+// returned by the builder to create the signed block contents for submission.
+// The execution payload and blobs are checked against the blinded block and blob sidecars; because
+// a blinded blob sidecar has the same root as its unblinded equivalent, the signatures carry over.
+func (s *SignedBlindedBlockContents) Unblind(executionPayload *deneb.ExecutionPayload,
+	blobsBundle *BlobsBundle,
+) (
+	*SignedBlockContents,
+	error,
+) {
+	if s.SignedBlindedBlock == nil ||
+		s.SignedBlindedBlock.Message == nil ||
+		s.SignedBlindedBlock.Message.Body == nil ||
+		s.SignedBlindedBlock.Message.Body.ExecutionPayloadHeader == nil {
+		return nil, errors.New("signed blinded block missing")
+	}
+	if executionPayload == nil {
+		return nil, errors.New("execution payload missing")
+	}
+	if blobsBundle == nil {
+		return nil, errors.New("blobs bundle missing")
+	}
+
+	blindedBlock := s.SignedBlindedBlock.Message
+	if err := verifyExecutionPayload(blindedBlock.Body.ExecutionPayloadHeader, executionPayload); err != nil {
+		return nil, err
+	}
+	if err := verifyBlobsBundle(blindedBlock.Body.BlobKzgCommitments, s.SignedBlindedBlobSidecars, blobsBundle); err != nil {
+		return nil, err
+	}
+
+	contents := &SignedBlockContents{
+		SignedBlock: &deneb.SignedBeaconBlock{
+			Message: &deneb.BeaconBlock{
+				Slot:          blindedBlock.Slot,
+				ProposerIndex: blindedBlock.ProposerIndex,
+				ParentRoot:    blindedBlock.ParentRoot,
+				StateRoot:     blindedBlock.StateRoot,
+				Body: &deneb.BeaconBlockBody{
+					RANDAOReveal:          blindedBlock.Body.RANDAOReveal,
+					ETH1Data:              blindedBlock.Body.ETH1Data,
+					Graffiti:              blindedBlock.Body.Graffiti,
+					ProposerSlashings:     blindedBlock.Body.ProposerSlashings,
+					AttesterSlashings:     blindedBlock.Body.AttesterSlashings,
+					Attestations:          blindedBlock.Body.Attestations,
+					Deposits:              blindedBlock.Body.Deposits,
+					VoluntaryExits:        blindedBlock.Body.VoluntaryExits,
+					SyncAggregate:         blindedBlock.Body.SyncAggregate,
+					ExecutionPayload:      executionPayload,
+					BLSToExecutionChanges: blindedBlock.Body.BLSToExecutionChanges,
+					BlobKzgCommitments:    blindedBlock.Body.BlobKzgCommitments,
+				},
+			},
+			Signature: s.SignedBlindedBlock.Signature,
+		},
+		SignedBlobSidecars: make([]*deneb.SignedBlobSidecar, len(s.SignedBlindedBlobSidecars)),
+	}
+
+	for i, signedBlindedSidecar := range s.SignedBlindedBlobSidecars {
+		blindedSidecar := signedBlindedSidecar.Message
+		contents.SignedBlobSidecars[i] = &deneb.SignedBlobSidecar{
+			Message: &deneb.BlobSidecar{
+				BlockRoot:       blindedSidecar.BlockRoot,
+				Index:           blindedSidecar.Index,
+				Slot:            blindedSidecar.Slot,
+				BlockParentRoot: blindedSidecar.BlockParentRoot,
+				ProposerIndex:   blindedSidecar.ProposerIndex,
+				Blob:            blobsBundle.Blobs[blindedSidecar.Index],
+				KzgCommitment:   blindedSidecar.KzgCommitment,
+				KzgProof:        blindedSidecar.KzgProof,
+			},
+			Signature: signedBlindedSidecar.Signature,
+		}
+	}
+
+	return contents, nil
+}
+
+// verifyExecutionPayload ensures that the execution payload matches the header.
+// The hash tree root of an execution payload is the same as that of its header, as the
+// header holds the roots of the payload's transactions and withdrawals in their place.
+func verifyExecutionPayload(header *deneb.ExecutionPayloadHeader, payload *deneb.ExecutionPayload) error {
+	payloadRoot, err := payload.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate execution payload root")
+	}
+	headerRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate execution payload header root")
+	}
+	if payloadRoot != headerRoot {
+		return fmt.Errorf("execution payload %#x does not match header %#x", payload.BlockHash, header.BlockHash)
+	}
+
+	return nil
+}
+
+// verifyBlobsBundle ensures that the blobs bundle matches the block commitments and blinded blob sidecars.
+func verifyBlobsBundle(commitments []deneb.KzgCommitment,
+	sidecars []*SignedBlindedBlobSidecar,
+	bundle *BlobsBundle,
+) error {
+	if len(bundle.Commitments) != len(commitments) {
+		return fmt.Errorf("blobs bundle has %d commitments but block has %d", len(bundle.Commitments), len(commitments))
+	}
+	if len(bundle.Proofs) != len(commitments) || len(bundle.Blobs) != len(commitments) {
+		return errors.New("blobs bundle has inconsistent number of commitments, proofs and blobs")
+	}
+	if len(sidecars) != len(commitments) {
+		return fmt.Errorf("block has %d blinded blob sidecars but %d commitments", len(sidecars), len(commitments))
+	}
+
+	for i := range commitments {
+		if !bytes.Equal(bundle.Commitments[i][:], commitments[i][:]) {
+			return fmt.Errorf("blobs bundle commitment %d does not match block", i)
+		}
+	}
+
+	for i, sidecar := range sidecars {
+		if sidecar == nil || sidecar.Message == nil {
+			return fmt.Errorf("blinded blob sidecar %d missing", i)
+		}
+		index := int(sidecar.Message.Index)
+		if index >= len(commitments) {
+			return fmt.Errorf("blinded blob sidecar %d has invalid index %d", i, index)
+		}
+		if !bytes.Equal(sidecar.Message.KzgCommitment[:], bundle.Commitments[index][:]) {
+			return fmt.Errorf("blinded blob sidecar %d commitment does not match blobs bundle", i)
+		}
+		if !bytes.Equal(sidecar.Message.KzgProof[:], bundle.Proofs[index][:]) {
+			return fmt.Errorf("blinded blob sidecar %d proof does not match blobs bundle", i)
+		}
+		blobRoot, err := blobHashTreeRoot(&bundle.Blobs[index])
+		if err != nil {
+			return err
+		}
+		if blobRoot != sidecar.Message.BlobRoot {
+			return fmt.Errorf("blinded blob sidecar %d blob root does not match blobs bundle", i)
+		}
+	}
+
+	return nil
+}
+
+// blobHashTreeRoot calculates the hash tree root of a blob.
+func blobHashTreeRoot(blob *deneb.Blob) (phase0.Root, error) {
+	hh := ssz.NewHasher()
+	hh.PutBytes(blob[:])
+	root, err := hh.HashRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to calculate blob root")
+	}
+
+	return root, nil
+}
@@ -0,0 +1,213 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb_test
+
+import (
+	"testing"
+
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	utilbellatrix "github.com/attestantio/go-eth2-client/util/bellatrix"
+	utilcapella "github.com/attestantio/go-eth2-client/util/capella"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/holiman/uint256"
+	"github.com/prysmaticlabs/go-bitfield"
+	require "github.com/stretchr/testify/require"
+)
+
+// unblindFixtures returns matching signed blinded block contents, execution payload and blobs bundle.
+func unblindFixtures(t *testing.T) (*apiv1deneb.SignedBlindedBlockContents, *deneb.ExecutionPayload, *apiv1deneb.BlobsBundle) {
+	t.Helper()
+
+	payload := &deneb.ExecutionPayload{
+		BlockNumber:   100,
+		BaseFeePerGas: uint256.NewInt(7),
+		BlockHash:     phase0.Hash32{0x01},
+		Transactions:  []bellatrix.Transaction{{0x01, 0x02, 0x03}},
+		Withdrawals:   []*capella.Withdrawal{{Index: 1, ValidatorIndex: 2, Amount: 3}},
+	}
+	header := &deneb.ExecutionPayloadHeader{
+		BlockNumber:   100,
+		BaseFeePerGas: uint256.NewInt(7),
+		BlockHash:     phase0.Hash32{0x01},
+	}
+	header.TransactionsRoot, header.WithdrawalsRoot = payloadRoots(t, payload)
+
+	bundle := &apiv1deneb.BlobsBundle{
+		Commitments: []deneb.KzgCommitment{{0x01}, {0x02}},
+		Proofs:      []deneb.KzgProof{{0x11}, {0x12}},
+		Blobs:       make([]deneb.Blob, 2),
+	}
+	bundle.Blobs[0][0] = 0x21
+	bundle.Blobs[1][0] = 0x22
+
+	sidecars := make([]*apiv1deneb.SignedBlindedBlobSidecar, 2)
+	for i := range sidecars {
+		sidecar := &deneb.BlobSidecar{
+			Index:         deneb.BlobIndex(i),
+			Slot:          1,
+			Blob:          bundle.Blobs[i],
+			KzgCommitment: bundle.Commitments[i],
+			KzgProof:      bundle.Proofs[i],
+		}
+		sidecars[i] = &apiv1deneb.SignedBlindedBlobSidecar{
+			Message: &apiv1deneb.BlindedBlobSidecar{
+				Index:         sidecar.Index,
+				Slot:          sidecar.Slot,
+				BlobRoot:      blobRoot(t, &sidecar.Blob),
+				KzgCommitment: sidecar.KzgCommitment,
+				KzgProof:      sidecar.KzgProof,
+			},
+			Signature: phase0.BLSSignature{byte(i)},
+		}
+	}
+
+	contents := &apiv1deneb.SignedBlindedBlockContents{
+		SignedBlindedBlock: &apiv1deneb.SignedBlindedBeaconBlock{
+			Message: &apiv1deneb.BlindedBeaconBlock{
+				Slot: 1,
+				Body: &apiv1deneb.BlindedBeaconBlockBody{
+					ETH1Data: &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+					SyncAggregate: &altair.SyncAggregate{
+						SyncCommitteeBits: bitfield.NewBitvector512(),
+					},
+					ExecutionPayloadHeader: header,
+					BlobKzgCommitments:     bundle.Commitments,
+				},
+			},
+			Signature: phase0.BLSSignature{0xff},
+		},
+		SignedBlindedBlobSidecars: sidecars,
+	}
+
+	return contents, payload, bundle
+}
+
+func payloadRoots(t *testing.T, payload *deneb.ExecutionPayload) (phase0.Root, phase0.Root) {
+	t.Helper()
+
+	transactionsRoot, err := (&utilbellatrix.ExecutionPayloadTransactions{Transactions: payload.Transactions}).HashTreeRoot()
+	require.NoError(t, err)
+	withdrawalsRoot, err := (&utilcapella.ExecutionPayloadWithdrawals{Withdrawals: payload.Withdrawals}).HashTreeRoot()
+	require.NoError(t, err)
+
+	return transactionsRoot, withdrawalsRoot
+}
+
+func blobRoot(t *testing.T, blob *deneb.Blob) phase0.Root {
+	t.Helper()
+
+	hh := ssz.NewHasher()
+	hh.PutBytes(blob[:])
+	root, err := hh.HashRoot()
+	require.NoError(t, err)
+
+	return root
+}
+
+func TestUnblind(t *testing.T) {
+	contents, payload, bundle := unblindFixtures(t)
+
+	res, err := contents.Unblind(payload, bundle)
+	require.NoError(t, err)
+	require.Equal(t, payload, res.SignedBlock.Message.Body.ExecutionPayload)
+	require.Equal(t, contents.SignedBlindedBlock.Signature, res.SignedBlock.Signature)
+	require.Len(t, res.SignedBlobSidecars, 2)
+
+	// The block and sidecar roots must be unchanged by unblinding, so that signatures remain valid.
+	blindedBlockRoot, err := contents.SignedBlindedBlock.Message.HashTreeRoot()
+	require.NoError(t, err)
+	blockRoot, err := res.SignedBlock.Message.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, blindedBlockRoot, blockRoot)
+	for i := range res.SignedBlobSidecars {
+		blindedRoot, err := contents.SignedBlindedBlobSidecars[i].Message.HashTreeRoot()
+		require.NoError(t, err)
+		root, err := res.SignedBlobSidecars[i].Message.HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, blindedRoot, root)
+		require.Equal(t, bundle.Blobs[i], res.SignedBlobSidecars[i].Message.Blob)
+	}
+}
+
+func TestUnblindMismatches(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*apiv1deneb.SignedBlindedBlockContents, *deneb.ExecutionPayload, *apiv1deneb.BlobsBundle)
+		err    string
+	}{
+		{
+			name: "PayloadMismatch",
+			mutate: func(_ *apiv1deneb.SignedBlindedBlockContents, payload *deneb.ExecutionPayload, _ *apiv1deneb.BlobsBundle) {
+				payload.Transactions = append(payload.Transactions, bellatrix.Transaction{0x04})
+			},
+			err: "execution payload 0x0100000000000000000000000000000000000000000000000000000000000000 does not match header 0x0100000000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			name: "CommitmentCount",
+			mutate: func(_ *apiv1deneb.SignedBlindedBlockContents, _ *deneb.ExecutionPayload, bundle *apiv1deneb.BlobsBundle) {
+				bundle.Commitments = bundle.Commitments[:1]
+			},
+			err: "blobs bundle has 1 commitments but block has 2",
+		},
+		{
+			name: "BlobMismatch",
+			mutate: func(_ *apiv1deneb.SignedBlindedBlockContents, _ *deneb.ExecutionPayload, bundle *apiv1deneb.BlobsBundle) {
+				bundle.Blobs[1][1] = 0x01
+			},
+			err: "blinded blob sidecar 1 blob root does not match blobs bundle",
+		},
+		{
+			name: "ProofMismatch",
+			mutate: func(_ *apiv1deneb.SignedBlindedBlockContents, _ *deneb.ExecutionPayload, bundle *apiv1deneb.BlobsBundle) {
+				bundle.Proofs[0] = deneb.KzgProof{0x99}
+			},
+			err: "blinded blob sidecar 0 proof does not match blobs bundle",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			contents, payload, bundle := unblindFixtures(t)
+			test.mutate(contents, payload, bundle)
+			_, err := contents.Unblind(payload, bundle)
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestBlobsBundleSSZ(t *testing.T) {
+	_, _, bundle := unblindFixtures(t)
+
+	data, err := bundle.MarshalSSZ()
+	require.NoError(t, err)
+	res := &apiv1deneb.BlobsBundle{}
+	require.NoError(t, res.UnmarshalSSZ(data))
+	require.Equal(t, bundle, res)
+}
+
+func TestSignedBlindedBlobSidecarSSZ(t *testing.T) {
+	contents, _, _ := unblindFixtures(t)
+	sidecar := contents.SignedBlindedBlobSidecars[1]
+
+	data, err := sidecar.MarshalSSZ()
+	require.NoError(t, err)
+	res := &apiv1deneb.SignedBlindedBlobSidecar{}
+	require.NoError(t, res.UnmarshalSSZ(data))
+	require.Equal(t, sidecar, res)
+}
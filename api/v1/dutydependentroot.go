@@ -0,0 +1,59 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import "github.com/attestantio/go-eth2-client/spec/phase0"
+
+// ProposerDutiesValid returns true if proposer duties for the given epoch, obtained with the
+// given dependent root, are confirmed to still be valid after the head event.
+//
+// Proposer duties for an epoch depend on the block root at the last slot of the prior epoch,
+// which is only known once the head is in the duties' epoch or the epoch after.  If the head
+// is in any other epoch the duties cannot be confirmed as valid and this returns false.
+func (e *HeadEvent) ProposerDutiesValid(slotsPerEpoch uint64, epoch phase0.Epoch, dependentRoot phase0.Root) bool {
+	if slotsPerEpoch == 0 {
+		return false
+	}
+
+	switch headEpoch := phase0.Epoch(uint64(e.Slot) / slotsPerEpoch); {
+	case headEpoch == epoch:
+		return e.CurrentDutyDependentRoot == dependentRoot
+	case headEpoch == epoch+1:
+		return e.PreviousDutyDependentRoot == dependentRoot
+	default:
+		return false
+	}
+}
+
+// AttesterDutiesValid returns true if attester duties for the given epoch, obtained with the
+// given dependent root, are confirmed to still be valid after the head event.
+//
+// Attester duties for an epoch depend on the block root at the last slot of the epoch two
+// before, which is known once the head is in the epoch before the duties' epoch or the
+// duties' epoch itself.  If the head is in any other epoch the duties cannot be confirmed
+// as valid and this returns false.
+func (e *HeadEvent) AttesterDutiesValid(slotsPerEpoch uint64, epoch phase0.Epoch, dependentRoot phase0.Root) bool {
+	if slotsPerEpoch == 0 {
+		return false
+	}
+
+	switch headEpoch := phase0.Epoch(uint64(e.Slot) / slotsPerEpoch); {
+	case headEpoch == epoch:
+		return e.PreviousDutyDependentRoot == dependentRoot
+	case headEpoch+1 == epoch:
+		return e.CurrentDutyDependentRoot == dependentRoot
+	default:
+		return false
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDutiesValid(t *testing.T) {
+	previous := phase0.Root{0x01}
+	current := phase0.Root{0x02}
+	other := phase0.Root{0x03}
+	// Head in epoch 10.
+	head := &api.HeadEvent{
+		Slot:                      325,
+		PreviousDutyDependentRoot: previous,
+		CurrentDutyDependentRoot:  current,
+	}
+
+	tests := []struct {
+		name          string
+		slotsPerEpoch uint64
+		epoch         phase0.Epoch
+		dependentRoot phase0.Root
+		proposer      bool
+		attester      bool
+	}{
+		{
+			name:          "SlotsPerEpochZero",
+			epoch:         10,
+			dependentRoot: current,
+		},
+		{
+			name:          "PreviousEpochPreviousRoot",
+			slotsPerEpoch: 32,
+			epoch:         9,
+			dependentRoot: previous,
+			proposer:      true,
+		},
+		{
+			name:          "PreviousEpochOtherRoot",
+			slotsPerEpoch: 32,
+			epoch:         9,
+			dependentRoot: other,
+		},
+		{
+			name:          "CurrentEpochCurrentRoot",
+			slotsPerEpoch: 32,
+			epoch:         10,
+			dependentRoot: current,
+			proposer:      true,
+		},
+		{
+			name:          "CurrentEpochPreviousRoot",
+			slotsPerEpoch: 32,
+			epoch:         10,
+			dependentRoot: previous,
+			attester:      true,
+		},
+		{
+			name:          "NextEpochCurrentRoot",
+			slotsPerEpoch: 32,
+			epoch:         11,
+			dependentRoot: current,
+			attester:      true,
+		},
+		{
+			name:          "NextEpochOtherRoot",
+			slotsPerEpoch: 32,
+			epoch:         11,
+			dependentRoot: other,
+		},
+		{
+			name:          "FarEpoch",
+			slotsPerEpoch: 32,
+			epoch:         12,
+			dependentRoot: current,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.proposer, head.ProposerDutiesValid(test.slotsPerEpoch, test.epoch, test.dependentRoot))
+			require.Equal(t, test.attester, head.AttesterDutiesValid(test.slotsPerEpoch, test.epoch, test.dependentRoot))
+		})
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// NetworkID identifies a well-known network.
+type NetworkID uint64
+
+const (
+	// NetworkUnknown is a network that is not well-known.
+	NetworkUnknown NetworkID = iota
+	// NetworkMainnet is the Ethereum mainnet.
+	NetworkMainnet
+	// NetworkHolesky is the Holesky testnet.
+	NetworkHolesky
+	// NetworkSepolia is the Sepolia testnet.
+	NetworkSepolia
+	// NetworkGnosis is the Gnosis chain.
+	NetworkGnosis
+)
+
+var networkIDStrings = [...]string{
+	"unknown",
+	"mainnet",
+	"holesky",
+	"sepolia",
+	"gnosis",
+}
+
+// String returns a string representation of the network ID.
+func (n NetworkID) String() string {
+	if int(n) >= len(networkIDStrings) {
+		return "unknown"
+	}
+	return networkIDStrings[n]
+}
+
+// knownNetwork contains the identifying details of a well-known network.
+type knownNetwork struct {
+	genesisValidatorsRoot phase0.Root
+	depositChainID        uint64
+}
+
+var knownNetworks = map[NetworkID]knownNetwork{
+	NetworkMainnet: {
+		genesisValidatorsRoot: phase0.Root{
+			0x4b, 0x36, 0x3d, 0xb9, 0x4e, 0x28, 0x61, 0x20, 0xd7, 0x6e, 0xb9, 0x05, 0x34, 0x0f, 0xdd, 0x4e,
+			0x54, 0xbf, 0xe9, 0xf0, 0x6b, 0xf3, 0x3f, 0xf6, 0xcf, 0x5a, 0xd2, 0x7f, 0x51, 0x1b, 0xfe, 0x95,
+		},
+		depositChainID: 1,
+	},
+	NetworkHolesky: {
+		genesisValidatorsRoot: phase0.Root{
+			0x91, 0x43, 0xaa, 0x7c, 0x61, 0x5a, 0x7f, 0x71, 0x15, 0xe2, 0xb6, 0xaa, 0xc3, 0x19, 0xc0, 0x35,
+			0x29, 0xdf, 0x82, 0x42, 0xae, 0x70, 0x5f, 0xba, 0x9d, 0xf3, 0x9b, 0x79, 0xc5, 0x9f, 0xa8, 0xb1,
+		},
+		depositChainID: 17000,
+	},
+	NetworkSepolia: {
+		genesisValidatorsRoot: phase0.Root{
+			0xd8, 0xea, 0x17, 0x1f, 0x3c, 0x94, 0xae, 0xa2, 0x1e, 0xbc, 0x42, 0xa1, 0xed, 0x61, 0x05, 0x2a,
+			0xcf, 0x3f, 0x92, 0x09, 0xc0, 0x0e, 0x4e, 0xfb, 0xaa, 0xdd, 0xac, 0x09, 0xed, 0x9b, 0x80, 0x78,
+		},
+		depositChainID: 11155111,
+	},
+	NetworkGnosis: {
+		genesisValidatorsRoot: phase0.Root{
+			0xf5, 0xdc, 0xb5, 0x56, 0x4e, 0x82, 0x9a, 0xab, 0x27, 0x26, 0x4b, 0x9b, 0xec, 0xd5, 0xdf, 0xaa,
+			0x01, 0x70, 0x85, 0x61, 0x12, 0x24, 0xcb, 0x30, 0x36, 0xf5, 0x73, 0x36, 0x8d, 0xbb, 0x9d, 0x47,
+		},
+		depositChainID: 100,
+	},
+}
+
+// Network is the network to which a node is connected.
+type Network struct {
+	// ID is the identifier of the network, or NetworkUnknown if the network is not well-known.
+	ID NetworkID
+	// GenesisValidatorsRoot is the genesis validators root of the network.
+	GenesisValidatorsRoot phase0.Root
+	// DepositChainID is the chain ID of the network's deposit contract.
+	DepositChainID uint64
+}
+
+// NewNetwork identifies a network from its genesis validators root and deposit chain ID.
+// A deposit chain ID of 0 is treated as unknown, and the network is identified solely by
+// its genesis validators root.
+func NewNetwork(genesisValidatorsRoot phase0.Root, depositChainID uint64) *Network {
+	network := &Network{
+		ID:                    NetworkUnknown,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+		DepositChainID:        depositChainID,
+	}
+
+	for id, known := range knownNetworks {
+		if known.genesisValidatorsRoot != genesisValidatorsRoot {
+			continue
+		}
+		if depositChainID != 0 && known.depositChainID != depositChainID {
+			// Same genesis but different deposit chain, so not the known network.
+			break
+		}
+		network.ID = id
+		break
+	}
+
+	return network
+}
+
+// String returns a string version of the structure.
+func (n *Network) String() string {
+	if n.ID == NetworkUnknown {
+		return fmt.Sprintf("unknown(%#x)", n.GenesisValidatorsRoot)
+	}
+	return n.ID.String()
+}
@@ -0,0 +1,102 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	require "github.com/stretchr/testify/require"
+)
+
+func rootFromString(t *testing.T, input string) phase0.Root {
+	t.Helper()
+
+	data, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	require.NoError(t, err)
+	var root phase0.Root
+	copy(root[:], data)
+
+	return root
+}
+
+func TestNewNetwork(t *testing.T) {
+	tests := []struct {
+		name                  string
+		genesisValidatorsRoot string
+		depositChainID        uint64
+		id                    api.NetworkID
+		str                   string
+	}{
+		{
+			name:                  "Mainnet",
+			genesisValidatorsRoot: "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95",
+			depositChainID:        1,
+			id:                    api.NetworkMainnet,
+			str:                   "mainnet",
+		},
+		{
+			name:                  "MainnetNoChainID",
+			genesisValidatorsRoot: "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95",
+			id:                    api.NetworkMainnet,
+			str:                   "mainnet",
+		},
+		{
+			name:                  "MainnetWrongChainID",
+			genesisValidatorsRoot: "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95",
+			depositChainID:        5,
+			id:                    api.NetworkUnknown,
+			str:                   "unknown(0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95)",
+		},
+		{
+			name:                  "Holesky",
+			genesisValidatorsRoot: "0x9143aa7c615a7f7115e2b6aac319c03529df8242ae705fba9df39b79c59fa8b1",
+			depositChainID:        17000,
+			id:                    api.NetworkHolesky,
+			str:                   "holesky",
+		},
+		{
+			name:                  "Sepolia",
+			genesisValidatorsRoot: "0xd8ea171f3c94aea21ebc42a1ed61052acf3f9209c00e4efbaaddac09ed9b8078",
+			depositChainID:        11155111,
+			id:                    api.NetworkSepolia,
+			str:                   "sepolia",
+		},
+		{
+			name:                  "Gnosis",
+			genesisValidatorsRoot: "0xf5dcb5564e829aab27264b9becd5dfaa017085611224cb3036f573368dbb9d47",
+			depositChainID:        100,
+			id:                    api.NetworkGnosis,
+			str:                   "gnosis",
+		},
+		{
+			name:                  "Unknown",
+			genesisValidatorsRoot: "0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+			depositChainID:        1,
+			id:                    api.NetworkUnknown,
+			str:                   "unknown(0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			network := api.NewNetwork(rootFromString(t, test.genesisValidatorsRoot), test.depositChainID)
+			require.Equal(t, test.id, network.ID)
+			require.Equal(t, test.str, network.String())
+		})
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// NodeIdentity contains the network identity of the node.
+type NodeIdentity struct {
+	// PeerID is the libp2p peer ID of the node.
+	PeerID string
+	// ENR is the Ethereum node record of the node.
+	ENR string
+	// P2PAddresses are the multiaddresses on which the node listens for libp2p connections.
+	P2PAddresses []string
+	// DiscoveryAddresses are the multiaddresses on which the node listens for discovery.
+	DiscoveryAddresses []string
+	// Metadata is the node's metadata as advertised to its peers.
+	Metadata *NodeMetadata
+}
+
+// NodeMetadata contains the metadata advertised by the node to its peers.
+type NodeMetadata struct {
+	// SeqNumber is the sequence number of the metadata, incremented each time it changes.
+	SeqNumber uint64
+	// Attnets is the bitvector of attestation subnets to which the node is subscribed.
+	Attnets bitfield.Bitvector64
+	// Syncnets is the bitvector of sync committee subnets to which the node is subscribed.
+	// This is nil for nodes that do not advertise it.
+	Syncnets bitfield.Bitvector4
+}
+
+// nodeIdentityJSON is the spec representation of the struct.
+type nodeIdentityJSON struct {
+	PeerID             string            `json:"peer_id"`
+	ENR                string            `json:"enr"`
+	P2PAddresses       []string          `json:"p2p_addresses"`
+	DiscoveryAddresses []string          `json:"discovery_addresses"`
+	Metadata           *nodeMetadataJSON `json:"metadata"`
+}
+
+// nodeMetadataJSON is the spec representation of the node metadata.
+type nodeMetadataJSON struct {
+	SeqNumber string `json:"seq_number"`
+	Attnets   string `json:"attnets"`
+	Syncnets  string `json:"syncnets,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n *NodeIdentity) MarshalJSON() ([]byte, error) {
+	var metadata *nodeMetadataJSON
+	if n.Metadata != nil {
+		metadata = &nodeMetadataJSON{
+			SeqNumber: fmt.Sprintf("%d", n.Metadata.SeqNumber),
+			Attnets:   fmt.Sprintf("%#x", []byte(n.Metadata.Attnets)),
+		}
+		if n.Metadata.Syncnets != nil {
+			metadata.Syncnets = fmt.Sprintf("%#x", []byte(n.Metadata.Syncnets))
+		}
+	}
+
+	return json.Marshal(&nodeIdentityJSON{
+		PeerID:             n.PeerID,
+		ENR:                n.ENR,
+		P2PAddresses:       n.P2PAddresses,
+		DiscoveryAddresses: n.DiscoveryAddresses,
+		Metadata:           metadata,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *NodeIdentity) UnmarshalJSON(input []byte) error {
+	var data nodeIdentityJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	return n.unpack(&data)
+}
+
+func (n *NodeIdentity) unpack(data *nodeIdentityJSON) error {
+	if data.PeerID == "" {
+		return errors.New("peer ID missing")
+	}
+	n.PeerID = data.PeerID
+	if data.ENR == "" {
+		return errors.New("ENR missing")
+	}
+	n.ENR = data.ENR
+	if data.P2PAddresses == nil {
+		return errors.New("p2p addresses missing")
+	}
+	n.P2PAddresses = data.P2PAddresses
+	if data.DiscoveryAddresses == nil {
+		return errors.New("discovery addresses missing")
+	}
+	n.DiscoveryAddresses = data.DiscoveryAddresses
+
+	if data.Metadata == nil {
+		return errors.New("metadata missing")
+	}
+	n.Metadata = &NodeMetadata{}
+	if data.Metadata.SeqNumber == "" {
+		return errors.New("sequence number missing")
+	}
+	seqNumber, err := strconv.ParseUint(data.Metadata.SeqNumber, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for sequence number")
+	}
+	n.Metadata.SeqNumber = seqNumber
+
+	if data.Metadata.Attnets == "" {
+		return errors.New("attnets missing")
+	}
+	attnets, err := hex.DecodeString(strings.TrimPrefix(data.Metadata.Attnets, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for attnets")
+	}
+	if len(attnets) != len(bitfield.NewBitvector64()) {
+		return errors.New("incorrect length for attnets")
+	}
+	n.Metadata.Attnets = attnets
+
+	if data.Metadata.Syncnets != "" {
+		syncnets, err := hex.DecodeString(strings.TrimPrefix(data.Metadata.Syncnets, "0x"))
+		if err != nil {
+			return errors.Wrap(err, "invalid value for syncnets")
+		}
+		if len(syncnets) != len(bitfield.NewBitvector4()) {
+			return errors.New("incorrect length for syncnets")
+		}
+		n.Metadata.Syncnets = syncnets
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (n *NodeIdentity) String() string {
+	data, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,78 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SlotBeaconBlockHeader is the canonical beacon block header for a slot, if any.
+type SlotBeaconBlockHeader struct {
+	// Slot is the slot.
+	Slot phase0.Slot
+	// Header is the canonical beacon block header for the slot; nil if the slot was missed.
+	Header *BeaconBlockHeader
+}
+
+// slotBeaconBlockHeaderJSON is the spec representation of the struct.
+type slotBeaconBlockHeaderJSON struct {
+	Slot   string             `json:"slot"`
+	Header *BeaconBlockHeader `json:"header,omitempty"`
+}
+
+// Missed returns true if there is no canonical block for the slot.
+func (s *SlotBeaconBlockHeader) Missed() bool {
+	return s.Header == nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *SlotBeaconBlockHeader) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&slotBeaconBlockHeaderJSON{
+		Slot:   fmt.Sprintf("%d", s.Slot),
+		Header: s.Header,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *SlotBeaconBlockHeader) UnmarshalJSON(input []byte) error {
+	var slotBeaconBlockHeaderJSON slotBeaconBlockHeaderJSON
+	if err := json.Unmarshal(input, &slotBeaconBlockHeaderJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if slotBeaconBlockHeaderJSON.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := strconv.ParseUint(slotBeaconBlockHeaderJSON.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	s.Slot = phase0.Slot(slot)
+	s.Header = slotBeaconBlockHeaderJSON.Header
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (s *SlotBeaconBlockHeader) String() string {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,116 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// EventsFunc is a function that feeds events with the given topics to the supplied handler,
+// until the context is done.
+type EventsFunc func(ctx context.Context, topics []string, handler func(*Event)) error
+
+// Subscription is a cancellable subscription to the events of a single topic, delivered
+// through a buffered channel.
+// If the channel buffer is full when an event arrives the event is dropped rather than
+// blocking the event stream; the number of dropped events is available from Dropped.
+type Subscription[T any] struct {
+	topic   string
+	events  chan T
+	cancel  context.CancelFunc
+	mu      sync.Mutex
+	closed  bool
+	dropped atomic.Uint64
+}
+
+// Subscribe subscribes to events of the given topic, with data of type T, using the supplied events function.
+// The subscription ends when the context is done or the subscription is cancelled, at which point
+// the events channel is closed.
+func Subscribe[T any](ctx context.Context, events EventsFunc, topic string, bufferSize int) (*Subscription[T], error) {
+	if events == nil {
+		return nil, errors.New("no events function supplied")
+	}
+	if bufferSize < 0 {
+		return nil, fmt.Errorf("invalid buffer size %d", bufferSize)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	sub := &Subscription[T]{
+		topic:  topic,
+		events: make(chan T, bufferSize),
+		cancel: cancel,
+	}
+
+	if err := events(ctx, []string{topic}, sub.handleEvent); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		sub.mu.Lock()
+		sub.closed = true
+		close(sub.events)
+		sub.mu.Unlock()
+	}()
+
+	return sub, nil
+}
+
+// Topic returns the topic of the subscription.
+func (s *Subscription[T]) Topic() string {
+	return s.topic
+}
+
+// Events returns the channel on which events are delivered.
+func (s *Subscription[T]) Events() <-chan T {
+	return s.events
+}
+
+// Dropped returns the number of events dropped because the channel buffer was full.
+func (s *Subscription[T]) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Cancel ends the subscription.
+func (s *Subscription[T]) Cancel() {
+	s.cancel()
+}
+
+// handleEvent delivers the event to the channel if it is of the expected topic and type.
+func (s *Subscription[T]) handleEvent(event *Event) {
+	if event == nil || event.Topic != s.topic {
+		return
+	}
+	data, isData := event.Data.(T)
+	if !isData {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	select {
+	case s.events <- data:
+	default:
+		s.dropped.Add(1)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// testEvents is an events function that captures the handler for the test to drive.
+type testEvents struct {
+	topics  []string
+	handler func(*api.Event)
+	err     error
+}
+
+func (e *testEvents) events(_ context.Context, topics []string, handler func(*api.Event)) error {
+	if e.err != nil {
+		return e.err
+	}
+	e.topics = topics
+	e.handler = handler
+
+	return nil
+}
+
+func TestSubscribe(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := api.Subscribe[*api.HeadEvent](ctx, nil, "head", 1)
+	require.EqualError(t, err, "no events function supplied")
+
+	_, err = api.Subscribe[*api.HeadEvent](ctx, (&testEvents{}).events, "head", -1)
+	require.EqualError(t, err, "invalid buffer size -1")
+
+	_, err = api.Subscribe[*api.HeadEvent](ctx, (&testEvents{err: errors.New("mock error")}).events, "head", 1)
+	require.EqualError(t, err, "mock error")
+
+	events := &testEvents{}
+	sub, err := api.Subscribe[*api.HeadEvent](ctx, events.events, "head", 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"head"}, events.topics)
+	require.Equal(t, "head", sub.Topic())
+
+	// Events of other topics or types are ignored.
+	events.handler(nil)
+	events.handler(&api.Event{Topic: "block", Data: &api.BlockEvent{}})
+	events.handler(&api.Event{Topic: "head", Data: &api.BlockEvent{}})
+
+	events.handler(&api.Event{Topic: "head", Data: &api.HeadEvent{Slot: 1}})
+	events.handler(&api.Event{Topic: "head", Data: &api.HeadEvent{Slot: 2}})
+	// Buffer is full, so this is dropped.
+	events.handler(&api.Event{Topic: "head", Data: &api.HeadEvent{Slot: 3}})
+	require.Equal(t, uint64(1), sub.Dropped())
+
+	require.Equal(t, phase0.Slot(1), (<-sub.Events()).Slot)
+	require.Equal(t, phase0.Slot(2), (<-sub.Events()).Slot)
+
+	sub.Cancel()
+	select {
+	case _, open := <-sub.Events():
+		require.False(t, open)
+	case <-time.After(time.Second):
+		require.Fail(t, "channel not closed")
+	}
+	// Events after cancellation are ignored.
+	events.handler(&api.Event{Topic: "head", Data: &api.HeadEvent{Slot: 4}})
+}
+
+func TestSubscribeContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	sub, err := api.Subscribe[*api.HeadEvent](ctx, (&testEvents{}).events, "head", 1)
+	require.NoError(t, err)
+
+	cancel()
+	select {
+	case _, open := <-sub.Events():
+		require.False(t, open)
+	case <-time.After(time.Second):
+		require.Fail(t, "channel not closed")
+	}
+}
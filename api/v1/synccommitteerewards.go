@@ -0,0 +1,91 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SyncCommitteeReward contains the reward obtained by a sync committee member for a block.
+// The reward can be negative, in which case it is a penalty.
+type SyncCommitteeReward struct {
+	ValidatorIndex phase0.ValidatorIndex
+	Reward         int64
+}
+
+// syncCommitteeRewardJSON is the spec representation of the struct.
+type syncCommitteeRewardJSON struct {
+	ValidatorIndex string `json:"validator_index"`
+	Reward         string `json:"reward"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *SyncCommitteeReward) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&syncCommitteeRewardJSON{
+		ValidatorIndex: fmt.Sprintf("%d", s.ValidatorIndex),
+		Reward:         fmt.Sprintf("%d", s.Reward),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *SyncCommitteeReward) UnmarshalJSON(input []byte) error {
+	var data syncCommitteeRewardJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.ValidatorIndex == "" {
+		return errors.New("validator index missing")
+	}
+	validatorIndex, err := strconv.ParseUint(data.ValidatorIndex, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for validator index")
+	}
+	s.ValidatorIndex = phase0.ValidatorIndex(validatorIndex)
+	if s.Reward, err = parseReward(data.Reward, "reward"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (s *SyncCommitteeReward) String() string {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
+
+// SumSyncCommitteeRewards returns the net sync committee reward obtained by each validator
+// across the given rewards, for example the rewards for each block in a number of epochs.
+func SumSyncCommitteeRewards(rewards [][]*SyncCommitteeReward) map[phase0.ValidatorIndex]int64 {
+	res := make(map[phase0.ValidatorIndex]int64)
+	for _, blockRewards := range rewards {
+		for _, reward := range blockRewards {
+			if reward == nil {
+				continue
+			}
+			res[reward.ValidatorIndex] += reward.Reward
+		}
+	}
+
+	return res
+}
@@ -32,6 +32,8 @@ type SyncState struct {
 	IsOptimistic bool
 	// IsSyncing is true if the node is syncing.
 	IsSyncing bool
+	// ELOffline is true if the node's execution client is offline.
+	ELOffline bool
 }
 
 // syncStateJSON is the spec representation of the struct.
@@ -40,6 +42,7 @@ type syncStateJSON struct {
 	SyncDistance string `json:"sync_distance"`
 	IsOptimistic bool   `json:"is_optimistic"`
 	IsSyncing    bool   `json:"is_syncing"`
+	ELOffline    bool   `json:"el_offline"`
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -49,6 +52,7 @@ func (s *SyncState) MarshalJSON() ([]byte, error) {
 		SyncDistance: fmt.Sprintf("%d", s.SyncDistance),
 		IsOptimistic: s.IsOptimistic,
 		IsSyncing:    s.IsSyncing,
+		ELOffline:    s.ELOffline,
 	})
 }
 
@@ -78,10 +82,17 @@ func (s *SyncState) UnmarshalJSON(input []byte) error {
 	s.SyncDistance = phase0.Slot(syncDistance)
 	s.IsOptimistic = syncStateJSON.IsOptimistic
 	s.IsSyncing = syncStateJSON.IsSyncing
+	s.ELOffline = syncStateJSON.ELOffline
 
 	return nil
 }
 
+// FullyValidated returns true if the node is synced and has validated its head with its execution
+// client, as opposed to following the chain optimistically or without an execution client.
+func (s *SyncState) FullyValidated() bool {
+	return !s.IsSyncing && !s.IsOptimistic && !s.ELOffline
+}
+
 // String returns a string version of the structure.
 func (s *SyncState) String() string {
 	data, err := json.Marshal(s)
@@ -18,8 +18,8 @@ import (
 	"testing"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
-	require "github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
 )
 
 func TestSyncStateJSON(t *testing.T) {
@@ -69,7 +69,11 @@ func TestSyncStateJSON(t *testing.T) {
 		},
 		{
 			name:  "Good",
-			input: []byte(`{"head_slot":"1","sync_distance":"2","is_optimistic":false,"is_syncing":true}`),
+			input: []byte(`{"head_slot":"1","sync_distance":"2","is_optimistic":false,"is_syncing":true,"el_offline":false}`),
+		},
+		{
+			name:  "ELOffline",
+			input: []byte(`{"head_slot":"1","sync_distance":"0","is_optimistic":true,"is_syncing":false,"el_offline":true}`),
 		},
 	}
 
@@ -89,3 +93,35 @@ func TestSyncStateJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncStateFullyValidated(t *testing.T) {
+	tests := []struct {
+		name  string
+		state *api.SyncState
+		res   bool
+	}{
+		{
+			name:  "Synced",
+			state: &api.SyncState{},
+			res:   true,
+		},
+		{
+			name:  "Syncing",
+			state: &api.SyncState{IsSyncing: true, SyncDistance: 5},
+		},
+		{
+			name:  "Optimistic",
+			state: &api.SyncState{IsOptimistic: true},
+		},
+		{
+			name:  "ELOffline",
+			state: &api.SyncState{ELOffline: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.res, test.state.FullyValidated())
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// farFutureEpoch is the spec value of FAR_FUTURE_EPOCH.
+const farFutureEpoch = phase0.Epoch(0xffffffffffffffff)
+
+// ChurnConfig contains the spec values required to calculate the validator churn limit.
+type ChurnConfig struct {
+	// MinPerEpochChurnLimit is the spec value MIN_PER_EPOCH_CHURN_LIMIT.
+	MinPerEpochChurnLimit uint64
+	// ChurnLimitQuotient is the spec value CHURN_LIMIT_QUOTIENT.
+	ChurnLimitQuotient uint64
+	// MaxPerEpochActivationChurnLimit is the spec value MAX_PER_EPOCH_ACTIVATION_CHURN_LIMIT.
+	// This is only present from deneb onwards; if 0 the activation churn limit is not capped.
+	MaxPerEpochActivationChurnLimit uint64
+}
+
+// ChurnLimit returns the number of validators that can enter or leave the active set
+// in an epoch, given the number of active validators.
+func (c *ChurnConfig) ChurnLimit(activeValidators uint64) uint64 {
+	limit := c.MinPerEpochChurnLimit
+	if c.ChurnLimitQuotient > 0 && activeValidators/c.ChurnLimitQuotient > limit {
+		limit = activeValidators / c.ChurnLimitQuotient
+	}
+
+	return limit
+}
+
+// ActivationChurnLimit returns the number of validators that can enter the active set
+// in an epoch, given the number of active validators.
+func (c *ChurnConfig) ActivationChurnLimit(activeValidators uint64) uint64 {
+	limit := c.ChurnLimit(activeValidators)
+	if c.MaxPerEpochActivationChurnLimit > 0 && limit > c.MaxPerEpochActivationChurnLimit {
+		limit = c.MaxPerEpochActivationChurnLimit
+	}
+
+	return limit
+}
+
+// ValidatorStatistics contains aggregate statistics for a set of validators at a given epoch.
+type ValidatorStatistics struct {
+	// Epoch is the epoch at which the statistics were calculated.
+	Epoch phase0.Epoch
+	// States is the number of validators in each state.
+	States map[ValidatorState]uint64
+	// ActiveValidators is the number of active validators.
+	ActiveValidators uint64
+	// TotalActiveEffectiveBalance is the sum of the effective balances of active validators.
+	TotalActiveEffectiveBalance phase0.Gwei
+	// TotalBalance is the sum of the balances of all validators.
+	TotalBalance phase0.Gwei
+	// EntryQueue is the number of validators waiting to activate.
+	EntryQueue uint64
+	// ExitQueue is the number of active validators waiting to exit.
+	ExitQueue uint64
+	// ActivationChurnLimit is the number of validators that can activate per epoch.
+	ActivationChurnLimit uint64
+	// ExitChurnLimit is the number of validators that can exit per epoch.
+	ExitChurnLimit uint64
+	// EntryQueueEpochs is the estimated number of epochs required to clear the entry queue.
+	EntryQueueEpochs uint64
+	// ExitQueueEpochs is the estimated number of epochs required to clear the exit queue.
+	ExitQueueEpochs uint64
+}
+
+// StateAt returns the state of the validator at the given epoch.
+func (v *Validator) StateAt(epoch phase0.Epoch) ValidatorState {
+	if v == nil || v.Validator == nil {
+		return ValidatorStateUnknown
+	}
+
+	state := ValidatorToState(v.Validator, epoch, farFutureEpoch)
+	if state == ValidatorStateWithdrawalPossible && v.Balance == 0 {
+		state = ValidatorStateWithdrawalDone
+	}
+
+	return state
+}
+
+// ValidatorsByState returns the validators that are in any of the given states at the given epoch.
+func ValidatorsByState(validators map[phase0.ValidatorIndex]*Validator,
+	epoch phase0.Epoch,
+	states ...ValidatorState,
+) map[phase0.ValidatorIndex]*Validator {
+	res := make(map[phase0.ValidatorIndex]*Validator)
+	for index, validator := range validators {
+		state := validator.StateAt(epoch)
+		for _, wanted := range states {
+			if state == wanted {
+				res[index] = validator
+				break
+			}
+		}
+	}
+
+	return res
+}
+
+// CalculateValidatorStatistics calculates aggregate statistics for the given validators at the given epoch.
+// The churn configuration is used to estimate the time taken to clear the entry and exit queues.
+func CalculateValidatorStatistics(validators map[phase0.ValidatorIndex]*Validator,
+	epoch phase0.Epoch,
+	churnConfig *ChurnConfig,
+) *ValidatorStatistics {
+	stats := &ValidatorStatistics{
+		Epoch:  epoch,
+		States: make(map[ValidatorState]uint64),
+	}
+
+	for _, validator := range validators {
+		state := validator.StateAt(epoch)
+		stats.States[state]++
+		if validator == nil {
+			continue
+		}
+		stats.TotalBalance += validator.Balance
+		if state.IsActive() {
+			stats.ActiveValidators++
+			stats.TotalActiveEffectiveBalance += validator.Validator.EffectiveBalance
+		}
+		switch state {
+		case ValidatorStatePendingQueued:
+			stats.EntryQueue++
+		case ValidatorStateActiveExiting, ValidatorStateActiveSlashed:
+			stats.ExitQueue++
+		default:
+		}
+	}
+
+	if churnConfig != nil {
+		stats.ActivationChurnLimit = churnConfig.ActivationChurnLimit(stats.ActiveValidators)
+		stats.ExitChurnLimit = churnConfig.ChurnLimit(stats.ActiveValidators)
+		stats.EntryQueueEpochs = queueEpochs(stats.EntryQueue, stats.ActivationChurnLimit)
+		stats.ExitQueueEpochs = queueEpochs(stats.ExitQueue, stats.ExitChurnLimit)
+	}
+
+	return stats
+}
+
+// queueEpochs returns the number of epochs required to clear a queue of the given length.
+func queueEpochs(length uint64, churnLimit uint64) uint64 {
+	if churnLimit == 0 {
+		return 0
+	}
+
+	return (length + churnLimit - 1) / churnLimit
+}
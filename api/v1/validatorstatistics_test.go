@@ -0,0 +1,135 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+const farFuture = phase0.Epoch(0xffffffffffffffff)
+
+func testStatsValidator(index phase0.ValidatorIndex,
+	balance phase0.Gwei,
+	eligibility phase0.Epoch,
+	activation phase0.Epoch,
+	exit phase0.Epoch,
+	withdrawable phase0.Epoch,
+	slashed bool,
+) *api.Validator {
+	return &api.Validator{
+		Index:   index,
+		Balance: balance,
+		Validator: &phase0.Validator{
+			EffectiveBalance:           32000000000,
+			ActivationEligibilityEpoch: eligibility,
+			ActivationEpoch:            activation,
+			ExitEpoch:                  exit,
+			WithdrawableEpoch:          withdrawable,
+			Slashed:                    slashed,
+		},
+	}
+}
+
+func testStatsValidators() map[phase0.ValidatorIndex]*api.Validator {
+	return map[phase0.ValidatorIndex]*api.Validator{
+		0: testStatsValidator(0, 32000000000, farFuture, farFuture, farFuture, farFuture, false),
+		1: testStatsValidator(1, 32000000000, 90, farFuture, farFuture, farFuture, false),
+		2: testStatsValidator(2, 32000000000, 90, 105, farFuture, farFuture, false),
+		3: testStatsValidator(3, 32000000000, 0, 0, farFuture, farFuture, false),
+		4: testStatsValidator(4, 32000000000, 0, 0, 110, 366, false),
+		5: testStatsValidator(5, 31000000000, 0, 0, 110, 8292, true),
+		6: testStatsValidator(6, 32000000000, 0, 0, 50, 306, false),
+		7: testStatsValidator(7, 32000000000, 0, 0, 20, 50, false),
+		8: testStatsValidator(8, 0, 0, 0, 20, 50, false),
+	}
+}
+
+func TestValidatorStateAt(t *testing.T) {
+	validators := testStatsValidators()
+	expected := map[phase0.ValidatorIndex]api.ValidatorState{
+		0: api.ValidatorStatePendingInitialized,
+		1: api.ValidatorStatePendingQueued,
+		2: api.ValidatorStatePendingQueued,
+		3: api.ValidatorStateActiveOngoing,
+		4: api.ValidatorStateActiveExiting,
+		5: api.ValidatorStateActiveSlashed,
+		6: api.ValidatorStateExitedUnslashed,
+		7: api.ValidatorStateWithdrawalPossible,
+		8: api.ValidatorStateWithdrawalDone,
+	}
+	for index, state := range expected {
+		require.Equal(t, state, validators[index].StateAt(100), "validator %d", index)
+	}
+
+	require.Equal(t, api.ValidatorStateUnknown, (*api.Validator)(nil).StateAt(100))
+	require.Equal(t, api.ValidatorStateUnknown, (&api.Validator{}).StateAt(100))
+}
+
+func TestValidatorsByState(t *testing.T) {
+	validators := testStatsValidators()
+
+	res := api.ValidatorsByState(validators, 100, api.ValidatorStatePendingQueued, api.ValidatorStateActiveOngoing)
+	require.Len(t, res, 3)
+	require.Contains(t, res, phase0.ValidatorIndex(1))
+	require.Contains(t, res, phase0.ValidatorIndex(2))
+	require.Contains(t, res, phase0.ValidatorIndex(3))
+
+	require.Empty(t, api.ValidatorsByState(validators, 100))
+}
+
+func TestCalculateValidatorStatistics(t *testing.T) {
+	validators := testStatsValidators()
+
+	stats := api.CalculateValidatorStatistics(validators, 100, &api.ChurnConfig{
+		MinPerEpochChurnLimit: 1,
+		ChurnLimitQuotient:    65536,
+	})
+	require.Equal(t, phase0.Epoch(100), stats.Epoch)
+	require.Equal(t, uint64(3), stats.ActiveValidators)
+	require.Equal(t, phase0.Gwei(96000000000), stats.TotalActiveEffectiveBalance)
+	require.Equal(t, phase0.Gwei(255000000000), stats.TotalBalance)
+	require.Equal(t, uint64(2), stats.States[api.ValidatorStatePendingQueued])
+	require.Equal(t, uint64(1), stats.States[api.ValidatorStateWithdrawalDone])
+	require.Equal(t, uint64(2), stats.EntryQueue)
+	require.Equal(t, uint64(2), stats.ExitQueue)
+	require.Equal(t, uint64(1), stats.ActivationChurnLimit)
+	require.Equal(t, uint64(1), stats.ExitChurnLimit)
+	require.Equal(t, uint64(2), stats.EntryQueueEpochs)
+	require.Equal(t, uint64(2), stats.ExitQueueEpochs)
+
+	noChurn := api.CalculateValidatorStatistics(validators, 100, nil)
+	require.Equal(t, uint64(0), noChurn.EntryQueueEpochs)
+}
+
+func TestChurnConfig(t *testing.T) {
+	config := &api.ChurnConfig{
+		MinPerEpochChurnLimit:           4,
+		ChurnLimitQuotient:              65536,
+		MaxPerEpochActivationChurnLimit: 8,
+	}
+	require.Equal(t, uint64(4), config.ChurnLimit(100000))
+	require.Equal(t, uint64(15), config.ChurnLimit(1000000))
+	require.Equal(t, uint64(4), config.ActivationChurnLimit(100000))
+	require.Equal(t, uint64(8), config.ActivationChurnLimit(1000000))
+
+	uncapped := &api.ChurnConfig{
+		MinPerEpochChurnLimit: 4,
+		ChurnLimitQuotient:    65536,
+	}
+	require.Equal(t, uint64(15), uncapped.ActivationChurnLimit(1000000))
+}
@@ -0,0 +1,47 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+)
+
+// VersionedBlobSidecars contains versioned blob sidecars.
+type VersionedBlobSidecars struct {
+	Version spec.DataVersion
+	Deneb   []*deneb.BlobSidecar
+}
+
+// IsEmpty returns true if there are no blob sidecars.
+func (v *VersionedBlobSidecars) IsEmpty() bool {
+	return len(v.Deneb) == 0
+}
+
+// Indices returns the indices of the blob sidecars.
+func (v *VersionedBlobSidecars) Indices() ([]deneb.BlobIndex, error) {
+	switch v.Version {
+	case spec.DataVersionDeneb:
+		res := make([]deneb.BlobIndex, len(v.Deneb))
+		for i := range v.Deneb {
+			res[i] = v.Deneb[i].Index
+		}
+
+		return res, nil
+	default:
+		return nil, errors.New("unsupported version")
+	}
+}
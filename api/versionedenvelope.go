@@ -0,0 +1,165 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+// VersionedEnvelope is the standard wrapper used by the beacon API for versioned data, of the form
+// {"version":"deneb","execution_optimistic":false,"finalized":false,"data":{...}}.
+//
+// Data is a versioned container, for example *spec.VersionedSignedBeaconBlock: a pointer to a struct
+// with a Version field and a pointer field for each fork, named after the fork.  If the container has
+// a Blinded field, as with *VersionedProposal, blinded data is held in the fork field with a Blinded suffix.
+//
+// The optional metadata fields are passed through as supplied, so that a response can be decoded and
+// re-encoded without change.  If ExecutionPayloadBlinded is not supplied for a container with a Blinded
+// field it is taken from the container.
+type VersionedEnvelope[T any] struct {
+	Data                    T
+	ExecutionOptimistic     *bool
+	Finalized               *bool
+	ExecutionPayloadBlinded *bool
+}
+
+// versionedEnvelopeJSON is the spec representation of the struct.
+type versionedEnvelopeJSON struct {
+	Version                 *spec.DataVersion `json:"version"`
+	ExecutionPayloadBlinded *bool             `json:"execution_payload_blinded,omitempty"`
+	ExecutionOptimistic     *bool             `json:"execution_optimistic,omitempty"`
+	Finalized               *bool             `json:"finalized,omitempty"`
+	Data                    json.RawMessage   `json:"data"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e *VersionedEnvelope[T]) MarshalJSON() ([]byte, error) {
+	container, err := versionedContainer(reflect.ValueOf(e.Data))
+	if err != nil {
+		return nil, err
+	}
+	version, _ := container.FieldByName("Version").Interface().(spec.DataVersion)
+
+	field, err := versionedField(container, version)
+	if err != nil {
+		return nil, err
+	}
+	if field.IsNil() {
+		return nil, fmt.Errorf("no %s data", version)
+	}
+	data, err := json.Marshal(field.Interface())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal data")
+	}
+
+	executionPayloadBlinded := e.ExecutionPayloadBlinded
+	if blinded := container.FieldByName("Blinded"); executionPayloadBlinded == nil && blinded.IsValid() && blinded.Kind() == reflect.Bool {
+		// Ensure that the blinded state of the container survives a round trip.
+		value := blinded.Bool()
+		executionPayloadBlinded = &value
+	}
+
+	return json.Marshal(&versionedEnvelopeJSON{
+		Version:                 &version,
+		ExecutionPayloadBlinded: executionPayloadBlinded,
+		ExecutionOptimistic:     e.ExecutionOptimistic,
+		Finalized:               e.Finalized,
+		Data:                    data,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *VersionedEnvelope[T]) UnmarshalJSON(input []byte) error {
+	var data versionedEnvelopeJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if data.Version == nil {
+		return errors.New("version missing")
+	}
+	if len(data.Data) == 0 || bytes.Equal(data.Data, []byte("null")) {
+		return errors.New("data missing")
+	}
+
+	dataType := reflect.TypeOf(e.Data)
+	if dataType == nil || dataType.Kind() != reflect.Pointer {
+		return fmt.Errorf("unsupported container type %v", dataType)
+	}
+	ptr := reflect.New(dataType.Elem())
+	container, err := versionedContainer(ptr)
+	if err != nil {
+		return err
+	}
+	container.FieldByName("Version").Set(reflect.ValueOf(*data.Version))
+	if data.ExecutionPayloadBlinded != nil {
+		if blinded := container.FieldByName("Blinded"); blinded.IsValid() && blinded.Kind() == reflect.Bool {
+			blinded.SetBool(*data.ExecutionPayloadBlinded)
+		}
+	}
+
+	field, err := versionedField(container, *data.Version)
+	if err != nil {
+		return err
+	}
+	field.Set(reflect.New(field.Type().Elem()))
+	if err := json.Unmarshal(data.Data, field.Interface()); err != nil {
+		return errors.Wrapf(err, "invalid %s data", *data.Version)
+	}
+
+	e.Data, _ = ptr.Interface().(T)
+	e.ExecutionOptimistic = data.ExecutionOptimistic
+	e.Finalized = data.Finalized
+	e.ExecutionPayloadBlinded = data.ExecutionPayloadBlinded
+
+	return nil
+}
+
+// versionedContainer returns the struct behind a pointer to a versioned container.
+func versionedContainer(ptr reflect.Value) (reflect.Value, error) {
+	if ptr.Kind() != reflect.Pointer || ptr.Type().Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("unsupported container type %v", ptr.Type())
+	}
+	if ptr.IsNil() {
+		return reflect.Value{}, errors.New("no data")
+	}
+	container := ptr.Elem()
+	if version := container.FieldByName("Version"); !version.IsValid() || version.Type() != reflect.TypeOf(spec.DataVersion(0)) {
+		return reflect.Value{}, fmt.Errorf("container type %v has no version", ptr.Type())
+	}
+
+	return container, nil
+}
+
+// versionedField returns the field of the container that holds data for the given version.
+func versionedField(container reflect.Value, version spec.DataVersion) (reflect.Value, error) {
+	name := version.String()
+	name = strings.ToUpper(name[:1]) + name[1:]
+	if blinded := container.FieldByName("Blinded"); blinded.IsValid() && blinded.Kind() == reflect.Bool && blinded.Bool() {
+		name += "Blinded"
+	}
+
+	field := container.FieldByName(name)
+	if !field.IsValid() || field.Kind() != reflect.Pointer {
+		return reflect.Value{}, fmt.Errorf("container type %v does not support version %s", container.Type(), version)
+	}
+
+	return field, nil
+}
@@ -0,0 +1,152 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/gencorpus"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedEnvelopeRoundTrip(t *testing.T) {
+	block := &capella.SignedBeaconBlock{}
+	require.NoError(t, gencorpus.Fill(block, gencorpus.VariantZero))
+	block.Message.Slot = 123
+	blockJSON, err := json.Marshal(block)
+	require.NoError(t, err)
+
+	input := `{"version":"capella","execution_optimistic":false,"finalized":true,"data":` + string(blockJSON) + `}`
+
+	var envelope api.VersionedEnvelope[*spec.VersionedSignedBeaconBlock]
+	require.NoError(t, json.Unmarshal([]byte(input), &envelope))
+	require.Equal(t, spec.DataVersionCapella, envelope.Data.Version)
+	require.True(t, block.Equal(envelope.Data.Capella))
+	require.NotNil(t, envelope.ExecutionOptimistic)
+	require.False(t, *envelope.ExecutionOptimistic)
+	require.NotNil(t, envelope.Finalized)
+	require.True(t, *envelope.Finalized)
+	require.Nil(t, envelope.ExecutionPayloadBlinded)
+
+	output, err := json.Marshal(&envelope)
+	require.NoError(t, err)
+	require.JSONEq(t, input, string(output))
+}
+
+func TestVersionedEnvelopeMetadataAbsent(t *testing.T) {
+	block := &phase0.BeaconBlock{}
+	require.NoError(t, gencorpus.Fill(block, gencorpus.VariantZero))
+
+	envelope := &api.VersionedEnvelope[*spec.VersionedBeaconBlock]{
+		Data: &spec.VersionedBeaconBlock{
+			Version: spec.DataVersionPhase0,
+			Phase0:  block,
+		},
+	}
+	output, err := json.Marshal(envelope)
+	require.NoError(t, err)
+
+	var generic map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(output, &generic))
+	require.Len(t, generic, 2)
+	require.Equal(t, `"phase0"`, string(generic["version"]))
+}
+
+func TestVersionedEnvelopeBlinded(t *testing.T) {
+	block := &apiv1capella.BlindedBeaconBlock{}
+	require.NoError(t, gencorpus.Fill(block, gencorpus.VariantZero))
+	block.Slot = 5
+
+	envelope := &api.VersionedEnvelope[*api.VersionedProposal]{
+		Data: &api.VersionedProposal{
+			Version:        spec.DataVersionCapella,
+			Blinded:        true,
+			CapellaBlinded: block,
+		},
+	}
+	output, err := json.Marshal(envelope)
+	require.NoError(t, err)
+
+	var res api.VersionedEnvelope[*api.VersionedProposal]
+	require.NoError(t, json.Unmarshal(output, &res))
+	require.True(t, res.Data.Blinded)
+	require.NotNil(t, res.Data.CapellaBlinded)
+	require.Nil(t, res.Data.Capella)
+	slot, err := res.Data.Slot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(5), slot)
+}
+
+func TestVersionedEnvelopeErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "VersionMissing",
+			input: `{"data":{}}`,
+			err:   "version missing",
+		},
+		{
+			name:  "VersionInvalid",
+			input: `{"version":"unknown","data":{}}`,
+			err:   "invalid JSON: unrecognised data version \"unknown\"",
+		},
+		{
+			name:  "DataMissing",
+			input: `{"version":"capella"}`,
+			err:   "data missing",
+		},
+		{
+			name:  "DataNull",
+			input: `{"version":"capella","data":null}`,
+			err:   "data missing",
+		},
+		{
+			name:  "DataInvalid",
+			input: `{"version":"capella","data":{"message":true}}`,
+			err:   "invalid capella data: invalid JSON: invalid JSON: json: cannot unmarshal bool into Go value of type capella.beaconBlockJSON",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.VersionedEnvelope[*spec.VersionedSignedBeaconBlock]
+			err := json.Unmarshal([]byte(test.input), &res)
+			require.EqualError(t, err, test.err)
+		})
+	}
+
+	_, err := json.Marshal(&api.VersionedEnvelope[*spec.VersionedSignedBeaconBlock]{
+		Data: &spec.VersionedSignedBeaconBlock{Version: spec.DataVersionDeneb},
+	})
+	require.ErrorContains(t, err, "no deneb data")
+
+	_, err = json.Marshal(&api.VersionedEnvelope[*api.VersionedBlindedBeaconBlock]{
+		Data: &api.VersionedBlindedBeaconBlock{Version: spec.DataVersionPhase0},
+	})
+	require.ErrorContains(t, err, "does not support version phase0")
+
+	_, err = json.Marshal(&api.VersionedEnvelope[*phase0.Fork]{
+		Data: &phase0.Fork{},
+	})
+	require.ErrorContains(t, err, "has no version")
+}
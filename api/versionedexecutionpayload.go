@@ -0,0 +1,85 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// VersionedExecutionPayload contains a versioned execution payload, as revealed when a
+// blinded beacon block is submitted.
+// From deneb onwards the execution payload is accompanied by its blobs bundle.
+type VersionedExecutionPayload struct {
+	Version   spec.DataVersion
+	Bellatrix *bellatrix.ExecutionPayload
+	Capella   *capella.ExecutionPayload
+	Deneb     *apiv1deneb.ExecutionPayloadAndBlobsBundle
+}
+
+// IsEmpty returns true if there is no execution payload.
+func (v *VersionedExecutionPayload) IsEmpty() bool {
+	return v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil
+}
+
+// BlockHash returns the block hash of the execution payload.
+func (v *VersionedExecutionPayload) BlockHash() (phase0.Hash32, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return phase0.Hash32{}, errors.New("no bellatrix execution payload")
+		}
+		return v.Bellatrix.BlockHash, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.Hash32{}, errors.New("no capella execution payload")
+		}
+		return v.Capella.BlockHash, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.ExecutionPayload == nil {
+			return phase0.Hash32{}, errors.New("no deneb execution payload")
+		}
+		return v.Deneb.ExecutionPayload.BlockHash, nil
+	default:
+		return phase0.Hash32{}, errors.New("unsupported version")
+	}
+}
+
+// String returns a string version of the structure.
+func (v *VersionedExecutionPayload) String() string {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return ""
+		}
+		return v.Bellatrix.String()
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return ""
+		}
+		return v.Capella.String()
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return ""
+		}
+		return v.Deneb.String()
+	default:
+		return "unsupported version"
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+)
+
+// VersionedProposal contains a versioned proposal, which may be either a full or a blinded block.
+type VersionedProposal struct {
+	Version spec.DataVersion
+	// Blinded is true if the proposal contains a blinded block.
+	Blinded bool
+	// ExecutionValue is the value of the execution payload to the proposer, in Wei.
+	ExecutionValue *big.Int
+	// ConsensusValue is the value of the consensus rewards in the block to the proposer, in Wei.
+	ConsensusValue   *big.Int
+	Phase0           *phase0.BeaconBlock
+	Altair           *altair.BeaconBlock
+	Bellatrix        *bellatrix.BeaconBlock
+	BellatrixBlinded *apiv1bellatrix.BlindedBeaconBlock
+	Capella          *capella.BeaconBlock
+	CapellaBlinded   *apiv1capella.BlindedBeaconBlock
+	Deneb            *apiv1deneb.BlockContents
+	DenebBlinded     *apiv1deneb.BlindedBlockContents
+}
+
+// IsEmpty returns true if there is no proposal.
+func (v *VersionedProposal) IsEmpty() bool {
+	return v.Phase0 == nil &&
+		v.Altair == nil &&
+		v.Bellatrix == nil &&
+		v.BellatrixBlinded == nil &&
+		v.Capella == nil &&
+		v.CapellaBlinded == nil &&
+		v.Deneb == nil &&
+		v.DenebBlinded == nil
+}
+
+// Value returns the total value of the proposal to the proposer, in Wei.
+func (v *VersionedProposal) Value() *big.Int {
+	value := new(big.Int)
+	if v.ExecutionValue != nil {
+		value.Add(value, v.ExecutionValue)
+	}
+	if v.ConsensusValue != nil {
+		value.Add(value, v.ConsensusValue)
+	}
+
+	return value
+}
+
+// Slot returns the slot of the proposal.
+func (v *VersionedProposal) Slot() (phase0.Slot, error) {
+	if err := v.assertPresent(); err != nil {
+		return 0, err
+	}
+
+	switch v.Version {
+	case spec.DataVersionPhase0:
+		return v.Phase0.Slot, nil
+	case spec.DataVersionAltair:
+		return v.Altair.Slot, nil
+	case spec.DataVersionBellatrix:
+		if v.Blinded {
+			return v.BellatrixBlinded.Slot, nil
+		}
+		return v.Bellatrix.Slot, nil
+	case spec.DataVersionCapella:
+		if v.Blinded {
+			return v.CapellaBlinded.Slot, nil
+		}
+		return v.Capella.Slot, nil
+	case spec.DataVersionDeneb:
+		if v.Blinded {
+			return v.DenebBlinded.BlindedBlock.Slot, nil
+		}
+		return v.Deneb.Block.Slot, nil
+	default:
+		return 0, errors.New("unsupported version")
+	}
+}
+
+// RandaoReveal returns the RANDAO reveal of the proposal.
+func (v *VersionedProposal) RandaoReveal() (phase0.BLSSignature, error) {
+	if err := v.assertBodyPresent(); err != nil {
+		return phase0.BLSSignature{}, err
+	}
+
+	switch v.Version {
+	case spec.DataVersionPhase0:
+		return v.Phase0.Body.RANDAOReveal, nil
+	case spec.DataVersionAltair:
+		return v.Altair.Body.RANDAOReveal, nil
+	case spec.DataVersionBellatrix:
+		if v.Blinded {
+			return v.BellatrixBlinded.Body.RANDAOReveal, nil
+		}
+		return v.Bellatrix.Body.RANDAOReveal, nil
+	case spec.DataVersionCapella:
+		if v.Blinded {
+			return v.CapellaBlinded.Body.RANDAOReveal, nil
+		}
+		return v.Capella.Body.RANDAOReveal, nil
+	case spec.DataVersionDeneb:
+		if v.Blinded {
+			return v.DenebBlinded.BlindedBlock.Body.RANDAOReveal, nil
+		}
+		return v.Deneb.Block.Body.RANDAOReveal, nil
+	default:
+		return phase0.BLSSignature{}, errors.New("unsupported version")
+	}
+}
+
+// Graffiti returns the graffiti of the proposal.
+func (v *VersionedProposal) Graffiti() ([32]byte, error) {
+	if err := v.assertBodyPresent(); err != nil {
+		return [32]byte{}, err
+	}
+
+	switch v.Version {
+	case spec.DataVersionPhase0:
+		return v.Phase0.Body.Graffiti, nil
+	case spec.DataVersionAltair:
+		return v.Altair.Body.Graffiti, nil
+	case spec.DataVersionBellatrix:
+		if v.Blinded {
+			return v.BellatrixBlinded.Body.Graffiti, nil
+		}
+		return v.Bellatrix.Body.Graffiti, nil
+	case spec.DataVersionCapella:
+		if v.Blinded {
+			return v.CapellaBlinded.Body.Graffiti, nil
+		}
+		return v.Capella.Body.Graffiti, nil
+	case spec.DataVersionDeneb:
+		if v.Blinded {
+			return v.DenebBlinded.BlindedBlock.Body.Graffiti, nil
+		}
+		return v.Deneb.Block.Body.Graffiti, nil
+	default:
+		return [32]byte{}, errors.New("unsupported version")
+	}
+}
+
+// String returns a string version of the structure.
+func (v *VersionedProposal) String() string {
+	var data interface{}
+	switch v.Version {
+	case spec.DataVersionPhase0:
+		data = v.Phase0
+	case spec.DataVersionAltair:
+		data = v.Altair
+	case spec.DataVersionBellatrix:
+		if v.Blinded {
+			data = v.BellatrixBlinded
+		} else {
+			data = v.Bellatrix
+		}
+	case spec.DataVersionCapella:
+		if v.Blinded {
+			data = v.CapellaBlinded
+		} else {
+			data = v.Capella
+		}
+	case spec.DataVersionDeneb:
+		if v.Blinded {
+			data = v.DenebBlinded
+		} else {
+			data = v.Deneb
+		}
+	default:
+		return "unsupported version"
+	}
+
+	res, err := yaml.Marshal(data)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(res)
+}
+
+// assertPresent returns an error if the block for the proposal's version is not present.
+func (v *VersionedProposal) assertPresent() error {
+	missing := false
+	switch v.Version {
+	case spec.DataVersionPhase0:
+		missing = v.Phase0 == nil
+	case spec.DataVersionAltair:
+		missing = v.Altair == nil
+	case spec.DataVersionBellatrix:
+		missing = (v.Blinded && v.BellatrixBlinded == nil) || (!v.Blinded && v.Bellatrix == nil)
+	case spec.DataVersionCapella:
+		missing = (v.Blinded && v.CapellaBlinded == nil) || (!v.Blinded && v.Capella == nil)
+	case spec.DataVersionDeneb:
+		missing = (v.Blinded && (v.DenebBlinded == nil || v.DenebBlinded.BlindedBlock == nil)) ||
+			(!v.Blinded && (v.Deneb == nil || v.Deneb.Block == nil))
+	default:
+		return errors.New("unsupported version")
+	}
+	if missing {
+		return fmt.Errorf("no %s block", v.Version)
+	}
+
+	return nil
+}
+
+// assertBodyPresent returns an error if the block body for the proposal's version is not present.
+func (v *VersionedProposal) assertBodyPresent() error {
+	if err := v.assertPresent(); err != nil {
+		return err
+	}
+
+	missing := false
+	switch v.Version {
+	case spec.DataVersionPhase0:
+		missing = v.Phase0.Body == nil
+	case spec.DataVersionAltair:
+		missing = v.Altair.Body == nil
+	case spec.DataVersionBellatrix:
+		missing = (v.Blinded && v.BellatrixBlinded.Body == nil) || (!v.Blinded && v.Bellatrix.Body == nil)
+	case spec.DataVersionCapella:
+		missing = (v.Blinded && v.CapellaBlinded.Body == nil) || (!v.Blinded && v.Capella.Body == nil)
+	case spec.DataVersionDeneb:
+		missing = (v.Blinded && v.DenebBlinded.BlindedBlock.Body == nil) || (!v.Blinded && v.Deneb.Block.Body == nil)
+	}
+	if missing {
+		return fmt.Errorf("no %s block body", v.Version)
+	}
+
+	return nil
+}
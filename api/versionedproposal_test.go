@@ -0,0 +1,64 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedProposal(t *testing.T) {
+	proposal := &api.VersionedProposal{
+		Version: spec.DataVersionCapella,
+		Blinded: true,
+		CapellaBlinded: &apiv1capella.BlindedBeaconBlock{
+			Slot: 12,
+			Body: &apiv1capella.BlindedBeaconBlockBody{
+				RANDAOReveal: phase0.BLSSignature{0x01},
+				Graffiti:     [32]byte{0x02},
+			},
+		},
+		ExecutionValue: big.NewInt(100),
+		ConsensusValue: big.NewInt(20),
+	}
+	require.False(t, proposal.IsEmpty())
+	require.Equal(t, big.NewInt(120), proposal.Value())
+
+	slot, err := proposal.Slot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(12), slot)
+	randaoReveal, err := proposal.RandaoReveal()
+	require.NoError(t, err)
+	require.Equal(t, phase0.BLSSignature{0x01}, randaoReveal)
+	graffiti, err := proposal.Graffiti()
+	require.NoError(t, err)
+	require.Equal(t, [32]byte{0x02}, graffiti)
+
+	// The full block is not present.
+	proposal.Blinded = false
+	_, err = proposal.Slot()
+	require.EqualError(t, err, "no capella block")
+
+	empty := &api.VersionedProposal{Version: spec.DataVersionPhase0}
+	require.True(t, empty.IsEmpty())
+	require.Equal(t, big.NewInt(0), empty.Value())
+	_, err = empty.Graffiti()
+	require.EqualError(t, err, "no phase0 block")
+}
@@ -54,6 +54,29 @@ func (v *VersionedSignedBlindedBeaconBlock) Slot() (phase0.Slot, error) {
 	}
 }
 
+// ExecutionBlockHash returns the block hash of the execution payload header of the beacon block.
+func (v *VersionedSignedBlindedBeaconBlock) ExecutionBlockHash() (phase0.Hash32, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil || v.Bellatrix.Message.Body.ExecutionPayloadHeader == nil {
+			return phase0.Hash32{}, errors.New("no bellatrix block")
+		}
+		return v.Bellatrix.Message.Body.ExecutionPayloadHeader.BlockHash, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil || v.Capella.Message.Body.ExecutionPayloadHeader == nil {
+			return phase0.Hash32{}, errors.New("no capella block")
+		}
+		return v.Capella.Message.Body.ExecutionPayloadHeader.BlockHash, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Message == nil || v.Deneb.Message.Body == nil || v.Deneb.Message.Body.ExecutionPayloadHeader == nil {
+			return phase0.Hash32{}, errors.New("no deneb block")
+		}
+		return v.Deneb.Message.Body.ExecutionPayloadHeader.BlockHash, nil
+	default:
+		return phase0.Hash32{}, errors.New("unsupported version")
+	}
+}
+
 // Attestations returns the attestations of the beacon block.
 func (v *VersionedSignedBlindedBeaconBlock) Attestations() ([]*phase0.Attestation, error) {
 	switch v.Version {
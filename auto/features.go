@@ -0,0 +1,106 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"strings"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// farFutureEpoch is the epoch used by nodes to denote a fork that is not scheduled.
+const farFutureEpoch = phase0.Epoch(0xffffffffffffffff)
+
+// Features describes the capabilities of the node to which the service is connected.
+type Features struct {
+	// NodeVersion is the version string reported by the node.
+	NodeVersion string
+	// Forks are the forks known to the node, keyed by lower-case fork name, with the
+	// epoch at which they activate.  Forks that are known but not yet scheduled have
+	// an epoch of FAR_FUTURE_EPOCH.
+	Forks map[string]phase0.Epoch
+
+	// SyncCommittees is true if the node is aware of Altair and the service provides
+	// sync committee duties and contributions.
+	SyncCommittees bool
+	// BlindedBlocks is true if the node is aware of Bellatrix and the service provides
+	// blinded proposals and submits blinded blocks.
+	BlindedBlocks bool
+	// BLSToExecutionChanges is true if the node is aware of Capella and the service submits
+	// BLS to execution changes.
+	BLSToExecutionChanges bool
+	// Blobs is true if the node is aware of Deneb and the service provides blob sidecars.
+	Blobs bool
+	// Electra is true if the node is aware of the Electra fork.
+	Electra bool
+}
+
+// SupportsFork returns true if the node is aware of the named fork.
+func (f *Features) SupportsFork(name string) bool {
+	_, exists := f.Forks[strings.ToLower(name)]
+	return exists
+}
+
+// ForkScheduled returns true if the node is aware of the named fork and it has
+// an activation epoch.
+func (f *Features) ForkScheduled(name string) bool {
+	epoch, exists := f.Forks[strings.ToLower(name)]
+	return exists && epoch != farFutureEpoch
+}
+
+// detectFeatures detects the features of a node given the service connected to it, its spec and its version.
+// A provider feature requires both that the node is aware of the fork that introduced it, and that the
+// service implements the providers for it.
+func detectFeatures(service client.Service, spec map[string]interface{}, nodeVersion string) *Features {
+	features := &Features{
+		NodeVersion: nodeVersion,
+		Forks: map[string]phase0.Epoch{
+			"phase0": 0,
+		},
+	}
+
+	for k, v := range spec {
+		if !strings.HasSuffix(k, "_FORK_EPOCH") {
+			continue
+		}
+		epoch, isEpoch := v.(uint64)
+		if !isEpoch {
+			continue
+		}
+		features.Forks[strings.ToLower(strings.TrimSuffix(k, "_FORK_EPOCH"))] = phase0.Epoch(epoch)
+	}
+
+	_, isSyncCommitteeDutiesProvider := service.(client.SyncCommitteeDutiesProvider)
+	_, isSyncCommitteeContributionProvider := service.(client.SyncCommitteeContributionProvider)
+	features.SyncCommittees = features.SupportsFork("altair") &&
+		isSyncCommitteeDutiesProvider &&
+		isSyncCommitteeContributionProvider
+
+	_, isBlindedProposalProvider := service.(client.BlindedBeaconBlockProposalProvider)
+	_, isBlindedBlockSubmitter := service.(client.BlindedBeaconBlockSubmitter)
+	features.BlindedBlocks = features.SupportsFork("bellatrix") &&
+		isBlindedProposalProvider &&
+		isBlindedBlockSubmitter
+
+	_, isBLSToExecutionChangesSubmitter := service.(client.BLSToExecutionChangesSubmitter)
+	features.BLSToExecutionChanges = features.SupportsFork("capella") && isBLSToExecutionChangesSubmitter
+
+	_, isBlobSidecarsProvider := service.(client.BlobSidecarsProvider)
+	features.Blobs = features.SupportsFork("deneb") && isBlobSidecarsProvider
+
+	features.Electra = features.SupportsFork("electra")
+
+	return features
+}
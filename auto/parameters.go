@@ -16,14 +16,18 @@ package auto
 import (
 	"time"
 
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/logging"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 )
 
 type parameters struct {
 	logLevel zerolog.Level
+	logger   logging.Logger
 	address  string
 	timeout  time.Duration
+	features map[api.Feature]bool
 }
 
 // Parameter is the interface for service parameters.
@@ -44,6 +48,14 @@ func WithLogLevel(logLevel zerolog.Level) Parameter {
 	})
 }
 
+// WithLogger sets a logger to which the module sends its logs, in place of the default zerolog logger.
+// If a logger is supplied the log level is obtained from it, and WithLogLevel is ignored.
+func WithLogger(logger logging.Logger) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logger = logger
+	})
+}
+
 // WithAddress provides the address for the endpoint.
 func WithAddress(address string) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -58,11 +70,35 @@ func WithTimeout(timeout time.Duration) Parameter {
 	})
 }
 
+// WithFeature enables or disables the given feature.
+func WithFeature(feature api.Feature, enabled bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.features[feature] = enabled
+	})
+}
+
+// WithEnableV3Proposals enables obtaining block proposals from the v3 proposal endpoint.
+func WithEnableV3Proposals(enabled bool) Parameter {
+	return WithFeature(api.FeatureV3Proposals, enabled)
+}
+
+// WithReducedMemory reduces the memory used when handling large responses, at the cost of speed.
+func WithReducedMemory(enabled bool) Parameter {
+	return WithFeature(api.FeatureReducedMemory, enabled)
+}
+
+// WithValidatorsPOST obtains validators with the POST variant of the validators endpoint, allowing
+// large numbers of validators to be requested without chunking.
+func WithValidatorsPOST(enabled bool) Parameter {
+	return WithFeature(api.FeatureValidatorsPOST, enabled)
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
 		logLevel: zerolog.GlobalLevel(),
 		timeout:  2 * time.Minute,
+		features: make(map[api.Feature]bool),
 	}
 	for _, p := range params {
 		if params != nil {
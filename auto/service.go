@@ -18,6 +18,7 @@ import (
 
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/logging"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
@@ -26,8 +27,16 @@ import (
 // log is a service-wide logger.
 var log zerolog.Logger
 
-// New creates a new Ethereum 2 client service, trying different implementations at the given address.
-// Deprecated.  Use the `http` module instead.
+// Service is an Ethereum 2 client service connected with the standard beacon API, along
+// with the features detected on the node when the service was created.
+type Service struct {
+	*http.Service
+
+	features *Features
+}
+
+// New creates a new Ethereum 2 client service, probing the standard beacon API at the given address.
+// The returned service is a *Service, which provides the features detected on the node.
 func New(ctx context.Context, params ...Parameter) (client.Service, error) {
 	parameters, err := parseAndCheckParameters(params...)
 	if err != nil {
@@ -36,29 +45,75 @@ func New(ctx context.Context, params ...Parameter) (client.Service, error) {
 
 	// Set logging.
 	log = zerologger.With().Str("service", "client").Str("impl", "auto").Logger()
-	if parameters.logLevel != log.GetLevel() {
+	if parameters.logger != nil {
+		log = logging.Zerolog(parameters.logger).With().Str("service", "client").Str("impl", "auto").Logger()
+	} else if parameters.logLevel != log.GetLevel() {
 		log = log.Level(parameters.logLevel)
 	}
 
 	// Try HTTP.
 	httpClient, err := tryHTTP(ctx, parameters)
-	if err == nil {
-		return httpClient, nil
+	if err != nil {
+		log.Trace().Err(err).Msg("Attempt to connect via HTTP API failed")
+		return nil, errors.New("failed to connect to Ethereum 2 client with any known method")
 	}
-	log.Trace().Err(err).Msg("Attempt to connect via HTTP API failed")
 
-	// No luck
-	return nil, errors.New("failed to connect to Ethereum 2 client with any known method")
+	features, err := DetectFeatures(ctx, httpClient)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to detect node features")
+	}
+	log.Trace().Str("version", features.NodeVersion).Interface("forks", features.Forks).Msg("Detected node features")
+
+	return &Service{
+		Service:  httpClient,
+		features: features,
+	}, nil
+}
+
+// Features provides the features detected on the node when the service was created.
+func (s *Service) Features() *Features {
+	return s.features
 }
 
-func tryHTTP(ctx context.Context, parameters *parameters) (client.Service, error) {
+// DetectFeatures detects the features supported by the node to which the service is connected.
+func DetectFeatures(ctx context.Context, service client.Service) (*Features, error) {
+	specProvider, isProvider := service.(client.SpecProvider)
+	if !isProvider {
+		return nil, errors.New("service does not provide spec")
+	}
+	spec, err := specProvider.Spec(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain spec")
+	}
+
+	nodeVersionProvider, isProvider := service.(client.NodeVersionProvider)
+	if !isProvider {
+		return nil, errors.New("service does not provide node version")
+	}
+	nodeVersion, err := nodeVersionProvider.NodeVersion(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain node version")
+	}
+
+	return detectFeatures(service, spec, nodeVersion), nil
+}
+
+func tryHTTP(ctx context.Context, parameters *parameters) (*http.Service, error) {
 	httpParameters := make([]http.Parameter, 0)
 	httpParameters = append(httpParameters, http.WithLogLevel(parameters.logLevel))
+	httpParameters = append(httpParameters, http.WithLogger(parameters.logger))
 	httpParameters = append(httpParameters, http.WithAddress(parameters.address))
 	httpParameters = append(httpParameters, http.WithTimeout(parameters.timeout))
+	for feature, enabled := range parameters.features {
+		httpParameters = append(httpParameters, http.WithFeature(feature, enabled))
+	}
 	client, err := http.New(ctx, httpParameters...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed when trying to open connection with standard API")
 	}
-	return client, nil
+	httpClient, isHTTPClient := client.(*http.Service)
+	if !isHTTPClient {
+		return nil, errors.New("standard API connection is not an HTTP service")
+	}
+	return httpClient, nil
 }
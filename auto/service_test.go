@@ -15,6 +15,8 @@ package auto_test
 
 import (
 	"context"
+	nethttp "net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
@@ -55,6 +57,9 @@ func TestService(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" && test.address == "" {
+				t.Skip("no address for live node")
+			}
 			service, err := auto.New(context.Background(),
 				auto.WithLogLevel(zerolog.Disabled),
 				auto.WithTimeout(60*time.Second),
@@ -67,7 +72,63 @@ func TestService(t *testing.T) {
 				version, err := service.(eth2client.NodeVersionProvider).NodeVersion(context.Background())
 				require.NoError(t, err)
 				require.Contains(t, version, test.version)
+				autoService, isAutoService := service.(*auto.Service)
+				require.True(t, isAutoService)
+				require.True(t, autoService.Features().SupportsFork("phase0"))
 			}
 		})
 	}
 }
+
+func TestServiceFeatures(t *testing.T) {
+	responses := map[string]string{
+		"/eth/v1/beacon/genesis":          `{"data":{"genesis_time":"1606824023","genesis_validators_root":"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95","genesis_fork_version":"0x00000000"}}`,
+		"/eth/v1/config/spec":             `{"data":{"SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32","ALTAIR_FORK_EPOCH":"74240","BELLATRIX_FORK_EPOCH":"144896","CAPELLA_FORK_EPOCH":"18446744073709551615"}}`,
+		"/eth/v1/config/deposit_contract": `{"data":{"chain_id":"1","address":"0x00000000219ab540356cbb839cbe05303d7705fa"}}`,
+		"/eth/v1/config/fork_schedule":    `{"data":[{"previous_version":"0x00000000","current_version":"0x00000000","epoch":"0"}]}`,
+		"/eth/v1/node/version":            `{"data":{"version":"Lighthouse/v4.5.0"}}`,
+	}
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		response, exists := responses[r.URL.Path]
+		if !exists {
+			w.WriteHeader(nethttp.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":404,"message":"Not found"}`))
+
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service, err := auto.New(ctx,
+		auto.WithLogLevel(zerolog.Disabled),
+		auto.WithAddress(srv.URL),
+	)
+	require.NoError(t, err)
+
+	autoService, isAutoService := service.(*auto.Service)
+	require.True(t, isAutoService)
+	features := autoService.Features()
+	require.NotNil(t, features)
+	require.Equal(t, "Lighthouse/v4.5.0", features.NodeVersion)
+	require.True(t, features.SupportsFork("phase0"))
+	require.True(t, features.ForkScheduled("altair"))
+	require.True(t, features.ForkScheduled("bellatrix"))
+	require.True(t, features.SupportsFork("capella"))
+	require.False(t, features.ForkScheduled("capella"))
+	require.False(t, features.SupportsFork("deneb"))
+	require.True(t, features.SyncCommittees)
+	require.True(t, features.BlindedBlocks)
+	require.True(t, features.BLSToExecutionChanges)
+	require.False(t, features.Blobs)
+	require.False(t, features.Electra)
+
+	// The service still provides the underlying API.
+	nodeVersion, err := service.(eth2client.NodeVersionProvider).NodeVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "Lighthouse/v4.5.0", nodeVersion)
+}
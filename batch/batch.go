@@ -0,0 +1,163 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package batch issues multiple independent calls to a consensus client concurrently.
+//
+// Calls are added to a batch, each with a destination for its result, and are issued when
+// the batch is executed:
+//
+//	var header *apiv1.BeaconBlockHeader
+//	var validators map[phase0.ValidatorIndex]*apiv1.Validator
+//	b, err := batch.New(ctx, batch.WithService(client))
+//	...
+//	err = b.BeaconBlockHeader("head", &header).
+//		Validators("head", indices, nil, &validators).
+//		Execute()
+//
+// Results are written to their destinations only if the call succeeds.
+package batch
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+)
+
+// call is a single call in a batch.
+type call struct {
+	name string
+	run  func(ctx context.Context, service consensusclient.Service) error
+}
+
+// Batch is a set of independent calls to be issued together.
+type Batch struct {
+	ctx         context.Context
+	service     consensusclient.Service
+	parallelism int
+
+	mu    sync.Mutex
+	calls []*call
+}
+
+// Error is returned from Execute when one or more calls in a batch fail.
+type Error struct {
+	// Errors are the errors for the failed calls, keyed by call description.
+	Errors map[string]error
+}
+
+// Error implements error.
+func (e *Error) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for name, err := range e.Errors {
+		msgs = append(msgs, fmt.Sprintf("%s: %v", name, err))
+	}
+	// Sort for a stable message.
+	sort.Strings(msgs)
+
+	return fmt.Sprintf("%d calls failed: %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// New creates a new batch.
+func New(ctx context.Context, params ...Parameter) (*Batch, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	return &Batch{
+		ctx:         ctx,
+		service:     parameters.service,
+		parallelism: parameters.parallelism,
+		calls:       make([]*call, 0),
+	}, nil
+}
+
+// Add adds an arbitrary call to the batch.  The result of the call is written to res if the call succeeds.
+func Add[T any](b *Batch, name string, fn func(ctx context.Context, service consensusclient.Service) (T, error), res *T) *Batch {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.calls = append(b.calls, &call{
+		name: name,
+		run: func(ctx context.Context, service consensusclient.Service) error {
+			value, err := fn(ctx, service)
+			if err != nil {
+				return err
+			}
+			*res = value
+
+			return nil
+		},
+	})
+
+	return b
+}
+
+// Len returns the number of calls waiting to be executed.
+func (b *Batch) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.calls)
+}
+
+// Execute issues all of the calls in the batch, with at most the configured number in flight at any time,
+// and waits for them to complete.  If any calls fail an *Error is returned detailing the failures; the
+// results of successful calls are available regardless.  Once executed the batch is empty, and can be reused.
+func (b *Batch) Execute() error {
+	b.mu.Lock()
+	calls := b.calls
+	b.calls = make([]*call, 0)
+	b.mu.Unlock()
+
+	sem := make(chan struct{}, b.parallelism)
+	var wg sync.WaitGroup
+	var errsMu sync.Mutex
+	errs := make(map[string]error)
+	for i := range calls {
+		wg.Add(1)
+		go func(c *call) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+			case <-b.ctx.Done():
+				errsMu.Lock()
+				errs[c.name] = b.ctx.Err()
+				errsMu.Unlock()
+				return
+			}
+			defer func() { <-sem }()
+
+			if err := c.run(b.ctx, b.service); err != nil {
+				errsMu.Lock()
+				errs[c.name] = err
+				errsMu.Unlock()
+			}
+		}(calls[i])
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return &Error{
+			Errors: errs,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package batch_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/batch"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testclients"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []batch.Parameter
+		err    string
+	}{
+		{
+			name: "ServiceMissing",
+			err:  "problem with parameters: no service specified",
+		},
+		{
+			name: "ParallelismZero",
+			params: []batch.Parameter{
+				batch.WithService(client),
+				batch.WithParallelism(0),
+			},
+			err: "problem with parameters: parallelism must be at least 1",
+		},
+		{
+			name: "Good",
+			params: []batch.Parameter{
+				batch.WithService(client),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := batch.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestExecute(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	b, err := batch.New(ctx, batch.WithService(client))
+	require.NoError(t, err)
+
+	var header *apiv1.BeaconBlockHeader
+	var genesis *apiv1.Genesis
+	var fork *phase0.Fork
+	var duties []*apiv1.ProposerDuty
+	b.BeaconBlockHeader("head", &header).
+		Genesis(&genesis).
+		Fork("head", &fork).
+		ProposerDuties(1, nil, &duties)
+	require.Equal(t, 4, b.Len())
+
+	require.NoError(t, b.Execute())
+	require.NotNil(t, header)
+	require.NotNil(t, genesis)
+	require.NotNil(t, fork)
+	require.Equal(t, 0, b.Len())
+}
+
+func TestExecuteErrors(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+	erroring, err := testclients.NewErroring(ctx, 1, client)
+	require.NoError(t, err)
+
+	b, err := batch.New(ctx, batch.WithService(erroring))
+	require.NoError(t, err)
+
+	var header *apiv1.BeaconBlockHeader
+	var genesis *apiv1.Genesis
+	err = b.BeaconBlockHeader("head", &header).
+		Genesis(&genesis).
+		Execute()
+	require.Error(t, err)
+	require.IsType(t, &batch.Error{}, err)
+	require.Len(t, err.(*batch.Error).Errors, 2)
+	require.Contains(t, err.(*batch.Error).Errors, "beacon block header head")
+	require.Contains(t, err.(*batch.Error).Errors, "genesis")
+	require.Nil(t, header)
+	require.Nil(t, genesis)
+}
+
+func TestExecuteParallelism(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+	sleepy, err := testclients.NewSleepy(ctx, 100*time.Millisecond, 110*time.Millisecond, client)
+	require.NoError(t, err)
+
+	b, err := batch.New(ctx, batch.WithService(sleepy), batch.WithParallelism(4))
+	require.NoError(t, err)
+
+	forks := make([]*phase0.Fork, 8)
+	for i := range forks {
+		b.Fork("head", &forks[i])
+	}
+
+	started := time.Now()
+	require.NoError(t, b.Execute())
+	duration := time.Since(started)
+	// 8 calls with a parallelism of 4 should take 2 rounds.
+	require.GreaterOrEqual(t, duration, 200*time.Millisecond)
+	require.Less(t, duration, 330*time.Millisecond)
+	for i := range forks {
+		require.NotNil(t, forks[i])
+	}
+}
+
+func TestExecuteAdd(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	b, err := batch.New(ctx, batch.WithService(client))
+	require.NoError(t, err)
+
+	var name string
+	require.NoError(t, batch.Add(b, "name", func(_ context.Context, service consensusclient.Service) (string, error) {
+		return service.Name(), nil
+	}, &name).Execute())
+	require.Equal(t, client.Name(), name)
+}
@@ -0,0 +1,169 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package batch
+
+import (
+	"context"
+	"fmt"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// BeaconBlockHeader adds a call to obtain the header of the given block to the batch.
+func (b *Batch) BeaconBlockHeader(blockID string, res **apiv1.BeaconBlockHeader) *Batch {
+	return Add(b, fmt.Sprintf("beacon block header %s", blockID), func(ctx context.Context, service consensusclient.Service) (*apiv1.BeaconBlockHeader, error) {
+		provider, isProvider := service.(consensusclient.BeaconBlockHeadersProvider)
+		if !isProvider {
+			return nil, errors.New("service does not provide BeaconBlockHeader")
+		}
+
+		return provider.BeaconBlockHeader(ctx, blockID)
+	}, res)
+}
+
+// SignedBeaconBlock adds a call to obtain the given signed block to the batch.
+func (b *Batch) SignedBeaconBlock(blockID string, res **spec.VersionedSignedBeaconBlock) *Batch {
+	return Add(b, fmt.Sprintf("signed beacon block %s", blockID), func(ctx context.Context, service consensusclient.Service) (*spec.VersionedSignedBeaconBlock, error) {
+		provider, isProvider := service.(consensusclient.SignedBeaconBlockProvider)
+		if !isProvider {
+			return nil, errors.New("service does not provide SignedBeaconBlock")
+		}
+
+		return provider.SignedBeaconBlock(ctx, blockID)
+	}, res)
+}
+
+// BeaconState adds a call to obtain the given state to the batch.
+func (b *Batch) BeaconState(stateID string, res **spec.VersionedBeaconState) *Batch {
+	return Add(b, fmt.Sprintf("beacon state %s", stateID), func(ctx context.Context, service consensusclient.Service) (*spec.VersionedBeaconState, error) {
+		provider, isProvider := service.(consensusclient.BeaconStateProvider)
+		if !isProvider {
+			return nil, errors.New("service does not provide BeaconState")
+		}
+
+		return provider.BeaconState(ctx, stateID)
+	}, res)
+}
+
+// Validators adds a call to obtain validators at the given state to the batch.
+func (b *Batch) Validators(stateID string, validatorIndices []phase0.ValidatorIndex, validatorStates []apiv1.ValidatorState, res *map[phase0.ValidatorIndex]*apiv1.Validator) *Batch {
+	return Add(b, fmt.Sprintf("validators at %s", stateID), func(ctx context.Context, service consensusclient.Service) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+		provider, isProvider := service.(consensusclient.ValidatorsProvider)
+		if !isProvider {
+			return nil, errors.New("service does not provide Validators")
+		}
+
+		return provider.Validators(ctx, stateID, validatorIndices, validatorStates)
+	}, res)
+}
+
+// ValidatorBalances adds a call to obtain validator balances at the given state to the batch.
+func (b *Batch) ValidatorBalances(stateID string, validatorIndices []phase0.ValidatorIndex, res *map[phase0.ValidatorIndex]phase0.Gwei) *Batch {
+	return Add(b, fmt.Sprintf("validator balances at %s", stateID), func(ctx context.Context, service consensusclient.Service) (map[phase0.ValidatorIndex]phase0.Gwei, error) {
+		provider, isProvider := service.(consensusclient.ValidatorBalancesProvider)
+		if !isProvider {
+			return nil, errors.New("service does not provide ValidatorBalances")
+		}
+
+		return provider.ValidatorBalances(ctx, stateID, validatorIndices)
+	}, res)
+}
+
+// Finality adds a call to obtain finality at the given state to the batch.
+func (b *Batch) Finality(stateID string, res **apiv1.Finality) *Batch {
+	return Add(b, fmt.Sprintf("finality at %s", stateID), func(ctx context.Context, service consensusclient.Service) (*apiv1.Finality, error) {
+		provider, isProvider := service.(consensusclient.FinalityProvider)
+		if !isProvider {
+			return nil, errors.New("service does not provide Finality")
+		}
+
+		return provider.Finality(ctx, stateID)
+	}, res)
+}
+
+// Fork adds a call to obtain the fork at the given state to the batch.
+func (b *Batch) Fork(stateID string, res **phase0.Fork) *Batch {
+	return Add(b, fmt.Sprintf("fork at %s", stateID), func(ctx context.Context, service consensusclient.Service) (*phase0.Fork, error) {
+		provider, isProvider := service.(consensusclient.ForkProvider)
+		if !isProvider {
+			return nil, errors.New("service does not provide Fork")
+		}
+
+		return provider.Fork(ctx, stateID)
+	}, res)
+}
+
+// Genesis adds a call to obtain the chain genesis to the batch.
+func (b *Batch) Genesis(res **apiv1.Genesis) *Batch {
+	return Add(b, "genesis", func(ctx context.Context, service consensusclient.Service) (*apiv1.Genesis, error) {
+		provider, isProvider := service.(consensusclient.GenesisProvider)
+		if !isProvider {
+			return nil, errors.New("service does not provide Genesis")
+		}
+
+		return provider.Genesis(ctx)
+	}, res)
+}
+
+// NodeSyncing adds a call to obtain the node's sync state to the batch.
+func (b *Batch) NodeSyncing(res **apiv1.SyncState) *Batch {
+	return Add(b, "node syncing", func(ctx context.Context, service consensusclient.Service) (*apiv1.SyncState, error) {
+		provider, isProvider := service.(consensusclient.NodeSyncingProvider)
+		if !isProvider {
+			return nil, errors.New("service does not provide NodeSyncing")
+		}
+
+		return provider.NodeSyncing(ctx)
+	}, res)
+}
+
+// BeaconCommittees adds a call to obtain the beacon committees at the given state to the batch.
+func (b *Batch) BeaconCommittees(stateID string, res *[]*apiv1.BeaconCommittee) *Batch {
+	return Add(b, fmt.Sprintf("beacon committees at %s", stateID), func(ctx context.Context, service consensusclient.Service) ([]*apiv1.BeaconCommittee, error) {
+		provider, isProvider := service.(consensusclient.BeaconCommitteesProvider)
+		if !isProvider {
+			return nil, errors.New("service does not provide BeaconCommittees")
+		}
+
+		return provider.BeaconCommittees(ctx, stateID)
+	}, res)
+}
+
+// ProposerDuties adds a call to obtain proposer duties for the given epoch to the batch.
+func (b *Batch) ProposerDuties(epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex, res *[]*apiv1.ProposerDuty) *Batch {
+	return Add(b, fmt.Sprintf("proposer duties for epoch %d", epoch), func(ctx context.Context, service consensusclient.Service) ([]*apiv1.ProposerDuty, error) {
+		provider, isProvider := service.(consensusclient.ProposerDutiesProvider)
+		if !isProvider {
+			return nil, errors.New("service does not provide ProposerDuties")
+		}
+
+		return provider.ProposerDuties(ctx, epoch, validatorIndices)
+	}, res)
+}
+
+// AttesterDuties adds a call to obtain attester duties for the given epoch to the batch.
+func (b *Batch) AttesterDuties(epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex, res *[]*apiv1.AttesterDuty) *Batch {
+	return Add(b, fmt.Sprintf("attester duties for epoch %d", epoch), func(ctx context.Context, service consensusclient.Service) ([]*apiv1.AttesterDuty, error) {
+		provider, isProvider := service.(consensusclient.AttesterDutiesProvider)
+		if !isProvider {
+			return nil, errors.New("service does not provide AttesterDuties")
+		}
+
+		return provider.AttesterDuties(ctx, epoch, validatorIndices)
+	}, res)
+}
@@ -0,0 +1,70 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package batch
+
+import (
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+)
+
+type parameters struct {
+	service     consensusclient.Service
+	parallelism int
+}
+
+// Parameter is the interface for batch parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithService sets the service through which the batch's calls are made.
+func WithService(service consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.service = service
+	})
+}
+
+// WithParallelism sets the maximum number of calls in the batch that can be in flight at once.
+func WithParallelism(parallelism int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.parallelism = parallelism
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		parallelism: 8,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.service == nil {
+		return nil, errors.New("no service specified")
+	}
+	if parameters.parallelism < 1 {
+		return nil, errors.New("parallelism must be at least 1")
+	}
+
+	return &parameters, nil
+}
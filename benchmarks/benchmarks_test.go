@@ -0,0 +1,100 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmarks_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/benchmarks"
+	"github.com/stretchr/testify/require"
+)
+
+// inconsistentSSZ are fixtures whose SSZ encoding is known to be out of step with their
+// definition, and so cannot round trip through SSZ.
+var inconsistentSSZ = map[string]string{
+	"phase0/BeaconState":        "generated encoding omits ETH1DepositIndex",
+	"deneb/BeaconState":         "generated encoding lags container definition",
+	"deneb/SignedBeaconBlock":   "generated encoding lags container definition",
+	"deneb/SignedBlockContents": "generated encoding lags container definition",
+}
+
+// TestFixtures ensures that the fixtures round-trip through both codecs.
+func TestFixtures(t *testing.T) {
+	blocks, err := benchmarks.Blocks()
+	require.NoError(t, err)
+	contents, err := benchmarks.BlockContents()
+	require.NoError(t, err)
+	states, err := benchmarks.BeaconStates(100)
+	require.NoError(t, err)
+
+	fixtures := append(append(blocks, contents), states...)
+	for _, fixture := range fixtures {
+		t.Run(fixture.Name, func(t *testing.T) {
+			root, err := fixture.Container.HashTreeRoot()
+			require.NoError(t, err)
+
+			jsonData, err := fixture.Container.MarshalJSON()
+			require.NoError(t, err)
+			fromJSON := fixture.New()
+			require.NoError(t, fromJSON.UnmarshalJSON(jsonData))
+			jsonRoot, err := fromJSON.HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, root, jsonRoot)
+
+			sszData, err := fixture.Container.MarshalSSZ()
+			require.NoError(t, err)
+			if reason, exists := inconsistentSSZ[fixture.Name]; exists {
+				t.Skip(reason)
+			}
+			fromSSZ := fixture.New()
+			require.NoError(t, fromSSZ.UnmarshalSSZ(sszData))
+			sszRoot, err := fromSSZ.HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, root, sszRoot)
+		})
+	}
+}
+
+func TestBeaconStatesNegative(t *testing.T) {
+	_, err := benchmarks.BeaconStates(-1)
+	require.EqualError(t, err, "number of validators cannot be negative")
+}
+
+func BenchmarkBlocks(b *testing.B) {
+	fixtures, err := benchmarks.Blocks()
+	if err != nil {
+		b.Fatal(err)
+	}
+	benchmarks.RunCodecBenchmarks(b, fixtures...)
+}
+
+func BenchmarkBlockContents(b *testing.B) {
+	fixture, err := benchmarks.BlockContents()
+	if err != nil {
+		b.Fatal(err)
+	}
+	benchmarks.RunCodecBenchmarks(b, fixture)
+}
+
+func BenchmarkBeaconStates(b *testing.B) {
+	validators := benchmarks.MainnetValidators
+	if testing.Short() {
+		validators = benchmarks.SmallValidators
+	}
+	fixtures, err := benchmarks.BeaconStates(validators)
+	if err != nil {
+		b.Fatal(err)
+	}
+	benchmarks.RunCodecBenchmarks(b, fixtures...)
+}
@@ -0,0 +1,258 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package benchmarks provides fixtures and benchmarks for the JSON and SSZ codecs and the hash tree
+// root calculations of the spec containers, allowing performance regressions to be measured and
+// users to size their systems.
+//
+// The benchmarks can be run with:
+//
+//	go test -bench . -benchmem ./benchmarks
+//
+// Beacon states are created with MainnetValidators validators, or SmallValidators in short mode.
+package benchmarks
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/gencorpus"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MainnetValidators is the number of validators in a mainnet-sized beacon state.
+	MainnetValidators = 1000000
+	// SmallValidators is the number of validators in a beacon state for quick runs.
+	SmallValidators = 10000
+	// blockTransactions is the number of transactions in an execution payload.
+	blockTransactions = 200
+	// transactionSize is the size in bytes of each transaction in an execution payload.
+	transactionSize = 300
+	// blobsPerBlock is the number of blobs in a full blob set.
+	blobsPerBlock = 6
+)
+
+// Container is a spec container that can be encoded with JSON and SSZ.
+type Container interface {
+	json.Marshaler
+	json.Unmarshaler
+	MarshalSSZ() ([]byte, error)
+	UnmarshalSSZ(buf []byte) error
+	HashTreeRoot() ([32]byte, error)
+}
+
+// Fixture is a populated container to benchmark.
+type Fixture struct {
+	// Name is the name of the fixture.
+	Name string
+	// Container is the populated container.
+	Container Container
+	// New returns an empty container of the same type, into which the fixture can be decoded.
+	New func() Container
+}
+
+// Blocks returns fixtures for a mainnet-sized signed beacon block of each fork, with a full set
+// of 128 attestations and, where applicable, an execution payload with transactions.
+func Blocks() ([]*Fixture, error) {
+	phase0Block := &phase0.SignedBeaconBlock{}
+	altairBlock := &altair.SignedBeaconBlock{}
+	bellatrixBlock := &bellatrix.SignedBeaconBlock{}
+	capellaBlock := &capella.SignedBeaconBlock{}
+	denebBlock := &deneb.SignedBeaconBlock{}
+	res := []*Fixture{
+		{
+			Name:      fmt.Sprintf("%s/SignedBeaconBlock", spec.DataVersionPhase0),
+			Container: phase0Block,
+			New:       func() Container { return &phase0.SignedBeaconBlock{} },
+		},
+		{
+			Name:      fmt.Sprintf("%s/SignedBeaconBlock", spec.DataVersionAltair),
+			Container: altairBlock,
+			New:       func() Container { return &altair.SignedBeaconBlock{} },
+		},
+		{
+			Name:      fmt.Sprintf("%s/SignedBeaconBlock", spec.DataVersionBellatrix),
+			Container: bellatrixBlock,
+			New:       func() Container { return &bellatrix.SignedBeaconBlock{} },
+		},
+		{
+			Name:      fmt.Sprintf("%s/SignedBeaconBlock", spec.DataVersionCapella),
+			Container: capellaBlock,
+			New:       func() Container { return &capella.SignedBeaconBlock{} },
+		},
+		{
+			Name:      fmt.Sprintf("%s/SignedBeaconBlock", spec.DataVersionDeneb),
+			Container: denebBlock,
+			New:       func() Container { return &deneb.SignedBeaconBlock{} },
+		},
+	}
+	for _, fixture := range res {
+		if err := gencorpus.Fill(fixture.Container, gencorpus.VariantMax); err != nil {
+			return nil, errors.Wrapf(err, "failed to populate %s", fixture.Name)
+		}
+	}
+
+	bellatrixBlock.Message.Body.ExecutionPayload.Transactions = transactions()
+	capellaBlock.Message.Body.ExecutionPayload.Transactions = transactions()
+	denebBlock.Message.Body.ExecutionPayload.Transactions = transactions()
+	denebBlock.Message.Body.BlobKzgCommitments = denebBlock.Message.Body.BlobKzgCommitments[:0]
+	for i := 0; i < blobsPerBlock; i++ {
+		denebBlock.Message.Body.BlobKzgCommitments = append(denebBlock.Message.Body.BlobKzgCommitments, deneb.KzgCommitment{byte(i)})
+	}
+
+	return res, nil
+}
+
+// BlockContents returns a fixture for signed Deneb block contents with a full set of blob sidecars.
+func BlockContents() (*Fixture, error) {
+	blocks, err := Blocks()
+	if err != nil {
+		return nil, err
+	}
+	block, isBlock := blocks[len(blocks)-1].Container.(*deneb.SignedBeaconBlock)
+	if !isBlock {
+		return nil, errors.New("final block fixture is not a deneb block")
+	}
+
+	contents := &apiv1deneb.SignedBlockContents{}
+	if err := gencorpus.Fill(contents, gencorpus.VariantMax); err != nil {
+		return nil, errors.Wrap(err, "failed to populate block contents")
+	}
+	contents.SignedBlock = block
+	contents.SignedBlobSidecars = contents.SignedBlobSidecars[:blobsPerBlock]
+
+	return &Fixture{
+		Name:      fmt.Sprintf("%s/SignedBlockContents", spec.DataVersionDeneb),
+		Container: contents,
+		New:       func() Container { return &apiv1deneb.SignedBlockContents{} },
+	}, nil
+}
+
+// BeaconStates returns fixtures for a beacon state of each fork with the given number of validators.
+// States are large, so callers should consider using SmallValidators when running quickly.
+func BeaconStates(validators int) ([]*Fixture, error) {
+	if validators < 0 {
+		return nil, errors.New("number of validators cannot be negative")
+	}
+
+	phase0State := &phase0.BeaconState{}
+	altairState := &altair.BeaconState{}
+	bellatrixState := &bellatrix.BeaconState{}
+	capellaState := &capella.BeaconState{}
+	denebState := &deneb.BeaconState{}
+	res := []*Fixture{
+		{
+			Name:      fmt.Sprintf("%s/BeaconState", spec.DataVersionPhase0),
+			Container: phase0State,
+			New:       func() Container { return &phase0.BeaconState{} },
+		},
+		{
+			Name:      fmt.Sprintf("%s/BeaconState", spec.DataVersionAltair),
+			Container: altairState,
+			New:       func() Container { return &altair.BeaconState{} },
+		},
+		{
+			Name:      fmt.Sprintf("%s/BeaconState", spec.DataVersionBellatrix),
+			Container: bellatrixState,
+			New:       func() Container { return &bellatrix.BeaconState{} },
+		},
+		{
+			Name:      fmt.Sprintf("%s/BeaconState", spec.DataVersionCapella),
+			Container: capellaState,
+			New:       func() Container { return &capella.BeaconState{} },
+		},
+		{
+			Name:      fmt.Sprintf("%s/BeaconState", spec.DataVersionDeneb),
+			Container: denebState,
+			New:       func() Container { return &deneb.BeaconState{} },
+		},
+	}
+	for _, fixture := range res {
+		if err := gencorpus.Fill(fixture.Container, gencorpus.VariantZero); err != nil {
+			return nil, errors.Wrapf(err, "failed to populate %s", fixture.Name)
+		}
+	}
+
+	// The validator registry and associated lists dominate the size of the state.  The validators
+	// themselves are shared between the states, as they are only read.
+	validatorList, balances := registry(validators)
+	phase0State.Validators, phase0State.Balances = validatorList, balances
+	altairState.Validators, altairState.Balances = validatorList, balances
+	altairState.PreviousEpochParticipation, altairState.CurrentEpochParticipation = participation(validators), participation(validators)
+	altairState.InactivityScores = make([]uint64, validators)
+	bellatrixState.Validators, bellatrixState.Balances = validatorList, balances
+	bellatrixState.PreviousEpochParticipation, bellatrixState.CurrentEpochParticipation = participation(validators), participation(validators)
+	bellatrixState.InactivityScores = make([]uint64, validators)
+	capellaState.Validators, capellaState.Balances = validatorList, balances
+	capellaState.PreviousEpochParticipation, capellaState.CurrentEpochParticipation = participation(validators), participation(validators)
+	capellaState.InactivityScores = make([]uint64, validators)
+	denebState.Validators, denebState.Balances = validatorList, balances
+	denebState.PreviousEpochParticipation, denebState.CurrentEpochParticipation = participation(validators), participation(validators)
+	denebState.InactivityScores = make([]uint64, validators)
+
+	return res, nil
+}
+
+// registry returns a validator registry and balances with the given number of validators.
+func registry(validators int) ([]*phase0.Validator, []phase0.Gwei) {
+	validatorList := make([]*phase0.Validator, validators)
+	balances := make([]phase0.Gwei, validators)
+	for i := range validatorList {
+		validator := &phase0.Validator{
+			EffectiveBalance:           32000000000,
+			ActivationEligibilityEpoch: phase0.Epoch(i / 4),
+			ActivationEpoch:            phase0.Epoch(i/4 + 5),
+			ExitEpoch:                  0xffffffffffffffff,
+			WithdrawableEpoch:          0xffffffffffffffff,
+		}
+		binary.LittleEndian.PutUint64(validator.PublicKey[:], uint64(i))
+		validator.WithdrawalCredentials = make([]byte, 32)
+		validator.WithdrawalCredentials[0] = 0x01
+		binary.LittleEndian.PutUint64(validator.WithdrawalCredentials[12:], uint64(i))
+		validatorList[i] = validator
+		balances[i] = phase0.Gwei(32000000000 + uint64(i%1000)*1000)
+	}
+
+	return validatorList, balances
+}
+
+// participation returns participation flags with the given number of validators.
+func participation(validators int) []altair.ParticipationFlags {
+	res := make([]altair.ParticipationFlags, validators)
+	for i := range res {
+		res[i] = altair.ParticipationFlags(i % 8)
+	}
+
+	return res
+}
+
+// transactions returns the transactions for an execution payload.
+func transactions() []bellatrix.Transaction {
+	res := make([]bellatrix.Transaction, blockTransactions)
+	for i := range res {
+		tx := make([]byte, transactionSize)
+		binary.LittleEndian.PutUint64(tx, uint64(i))
+		res[i] = tx
+	}
+
+	return res
+}
@@ -0,0 +1,89 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmarks
+
+import (
+	"testing"
+)
+
+// RunCodecBenchmarks runs JSON and SSZ marshal and unmarshal benchmarks, and hash tree root
+// benchmarks, for each of the fixtures as sub-benchmarks of the supplied benchmark.
+// Throughput is reported against the size of the encoded fixture.
+func RunCodecBenchmarks(b *testing.B, fixtures ...*Fixture) {
+	b.Helper()
+
+	for _, fixture := range fixtures {
+		fixture := fixture
+		jsonData, err := fixture.Container.MarshalJSON()
+		if err != nil {
+			b.Fatalf("failed to marshal %s to JSON: %v", fixture.Name, err)
+		}
+		sszData, err := fixture.Container.MarshalSSZ()
+		if err != nil {
+			b.Fatalf("failed to marshal %s to SSZ: %v", fixture.Name, err)
+		}
+		// Some generated SSZ encodings lag their container definitions and cannot be
+		// decoded; skip rather than fail their unmarshal benchmarks.
+		sszDecodeErr := fixture.New().UnmarshalSSZ(sszData)
+
+		b.Run(fixture.Name+"/MarshalJSON", func(b *testing.B) {
+			b.SetBytes(int64(len(jsonData)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := fixture.Container.MarshalJSON(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(fixture.Name+"/UnmarshalJSON", func(b *testing.B) {
+			b.SetBytes(int64(len(jsonData)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := fixture.New().UnmarshalJSON(jsonData); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(fixture.Name+"/MarshalSSZ", func(b *testing.B) {
+			b.SetBytes(int64(len(sszData)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := fixture.Container.MarshalSSZ(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(fixture.Name+"/UnmarshalSSZ", func(b *testing.B) {
+			if sszDecodeErr != nil {
+				b.Skipf("SSZ encoding of %s does not round trip: %v", fixture.Name, sszDecodeErr)
+			}
+			b.SetBytes(int64(len(sszData)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := fixture.New().UnmarshalSSZ(sszData); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(fixture.Name+"/HashTreeRoot", func(b *testing.B) {
+			b.SetBytes(int64(len(sszData)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := fixture.Container.HashTreeRoot(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import "reflect"
+
+// As returns the service as the provider interface T, and true if the service implements it.
+// For example:
+//
+//	if provider, isProvider := client.As[client.GenesisProvider](service); isProvider {
+//		genesis, err := provider.Genesis(ctx)
+//		...
+//	}
+//
+// Note that multi-node clients implement all provider interfaces, regardless of the interfaces
+// implemented by their underlying clients.
+func As[T any](service Service) (T, bool) {
+	provider, isProvider := service.(T)
+
+	return provider, isProvider
+}
+
+// Supports returns true if the service implements the given interface type, for example
+//
+//	client.Supports(service, reflect.TypeOf((*client.GenesisProvider)(nil)).Elem())
+//
+// This is useful when the interfaces to check are not known at compile time; otherwise As
+// should be preferred.
+func Supports(service Service, iface reflect.Type) bool {
+	if service == nil || iface == nil || iface.Kind() != reflect.Interface {
+		return false
+	}
+
+	return reflect.TypeOf(service).Implements(iface)
+}
@@ -0,0 +1,57 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// nameOnly is a service that implements no provider interfaces.
+type nameOnly struct{}
+
+func (*nameOnly) Name() string    { return "name only" }
+func (*nameOnly) Address() string { return "" }
+
+func TestAs(t *testing.T) {
+	service, err := mock.New(context.Background())
+	require.NoError(t, err)
+
+	provider, isProvider := client.As[client.GenesisProvider](service)
+	require.True(t, isProvider)
+	require.NotNil(t, provider)
+
+	_, isProvider = client.As[client.GenesisProvider](&nameOnly{})
+	require.False(t, isProvider)
+
+	_, isProvider = client.As[client.FullService](&nameOnly{})
+	require.False(t, isProvider)
+}
+
+func TestSupports(t *testing.T) {
+	service, err := mock.New(context.Background())
+	require.NoError(t, err)
+
+	genesisProvider := reflect.TypeOf((*client.GenesisProvider)(nil)).Elem()
+	require.True(t, client.Supports(service, genesisProvider))
+	require.False(t, client.Supports(&nameOnly{}, genesisProvider))
+	require.False(t, client.Supports(nil, genesisProvider))
+	require.False(t, client.Supports(service, nil))
+	require.False(t, client.Supports(service, reflect.TypeOf(1)))
+}
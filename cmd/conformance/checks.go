@@ -0,0 +1,342 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// errNotSupported is returned by a check if the service does not implement its provider.
+var errNotSupported = errors.New("provider not supported")
+
+// metadata are the metadata fields that a response is expected to contain.
+type metadata struct {
+	// Version requires the version field and matching Eth-Consensus-Version header.
+	Version bool
+	// Status requires the execution_optimistic and finalized fields.
+	Status bool
+	// ExecutionOptimistic requires the execution_optimistic field only.
+	ExecutionOptimistic bool
+}
+
+// check is a single call made against the beacon node.
+type check struct {
+	name     string
+	provider string
+	metadata metadata
+	run      func(ctx context.Context, service consensusclient.Service) error
+}
+
+// checks are the calls made against the beacon node, in order.
+// All calls are read-only.
+var checks = []*check{
+	{
+		name:     "Genesis",
+		provider: "GenesisProvider",
+		run: func(ctx context.Context, service consensusclient.Service) error {
+			provider, isProvider := service.(consensusclient.GenesisProvider)
+			if !isProvider {
+				return errNotSupported
+			}
+			_, err := provider.Genesis(ctx)
+
+			return err
+		},
+	},
+	{
+		name:     "Spec",
+		provider: "SpecProvider",
+		run: func(ctx context.Context, service consensusclient.Service) error {
+			provider, isProvider := service.(consensusclient.SpecProvider)
+			if !isProvider {
+				return errNotSupported
+			}
+			_, err := provider.Spec(ctx)
+
+			return err
+		},
+	},
+	{
+		name:     "DepositContract",
+		provider: "DepositContractProvider",
+		run: func(ctx context.Context, service consensusclient.Service) error {
+			provider, isProvider := service.(consensusclient.DepositContractProvider)
+			if !isProvider {
+				return errNotSupported
+			}
+			_, err := provider.DepositContract(ctx)
+
+			return err
+		},
+	},
+	{
+		name:     "ForkSchedule",
+		provider: "ForkScheduleProvider",
+		run: func(ctx context.Context, service consensusclient.Service) error {
+			provider, isProvider := service.(consensusclient.ForkScheduleProvider)
+			if !isProvider {
+				return errNotSupported
+			}
+			_, err := provider.ForkSchedule(ctx)
+
+			return err
+		},
+	},
+	{
+		name:     "NodeVersion",
+		provider: "NodeVersionProvider",
+		run: func(ctx context.Context, service consensusclient.Service) error {
+			provider, isProvider := service.(consensusclient.NodeVersionProvider)
+			if !isProvider {
+				return errNotSupported
+			}
+			_, err := provider.NodeVersion(ctx)
+
+			return err
+		},
+	},
+	{
+		name:     "NodeSyncing",
+		provider: "NodeSyncingProvider",
+		run: func(ctx context.Context, service consensusclient.Service) error {
+			provider, isProvider := service.(consensusclient.NodeSyncingProvider)
+			if !isProvider {
+				return errNotSupported
+			}
+			_, err := provider.NodeSyncing(ctx)
+
+			return err
+		},
+	},
+	{
+		name:     "NodeIdentity",
+		provider: "NodeIdentityProvider",
+		run: func(ctx context.Context, service consensusclient.Service) error {
+			provider, isProvider := service.(consensusclient.NodeIdentityProvider)
+			if !isProvider {
+				return errNotSupported
+			}
+			_, err := provider.NodeIdentity(ctx)
+
+			return err
+		},
+	},
+	{
+		name:     "Fork",
+		provider: "ForkProvider",
+		metadata: metadata{Status: true},
+		run: func(ctx context.Context, service consensusclient.Service) error {
+			provider, isProvider := service.(consensusclient.ForkProvider)
+			if !isProvider {
+				return errNotSupported
+			}
+			_, err := provider.Fork(ctx, "head")
+
+			return err
+		},
+	},
+	{
+		name:     "Finality",
+		provider: "FinalityProvider",
+		metadata: metadata{Status: true},
+		run: func(ctx context.Context, service consensusclient.Service) error {
+			provider, isProvider := service.(consensusclient.FinalityProvider)
+			if !isProvider {
+				return errNotSupported
+			}
+			_, err := provider.Finality(ctx, "head")
+
+			return err
+		},
+	},
+	{
+		name:     "BeaconStateRoot",
+		provider: "BeaconStateRootProvider",
+		metadata: metadata{Status: true},
+		run: func(ctx context.Context, service consensusclient.Service) error {
+			provider, isProvider := service.(consensusclient.BeaconStateRootProvider)
+			if !isProvider {
+				return errNotSupported
+			}
+			_, err := provider.BeaconStateRoot(ctx, "head")
+
+			return err
+		},
+	},
+	{
+		name:     "BeaconBlockHeader",
+		provider: "BeaconBlockHeadersProvider",
+		metadata: metadata{Status: true},
+		run: func(ctx context.Context, service consensusclient.Service) error {
+			provider, isProvider := service.(consensusclient.BeaconBlockHeadersProvider)
+			if !isProvider {
+				return errNotSupported
+			}
+			_, err := provider.BeaconBlockHeader(ctx, "head")
+
+			return err
+		},
+	},
+	{
+		name:     "BeaconBlockRoot",
+		provider: "BeaconBlockRootProvider",
+		metadata: metadata{Status: true},
+		run: func(ctx context.Context, service consensusclient.Service) error {
+			provider, isProvider := service.(consensusclient.BeaconBlockRootProvider)
+			if !isProvider {
+				return errNotSupported
+			}
+			_, err := provider.BeaconBlockRoot(ctx, "head")
+
+			return err
+		},
+	},
+	{
+		name:     "SignedBeaconBlock",
+		provider: "SignedBeaconBlockProvider",
+		metadata: metadata{Version: true, Status: true},
+		run: func(ctx context.Context, service consensusclient.Service) error {
+			provider, isProvider := service.(consensusclient.SignedBeaconBlockProvider)
+			if !isProvider {
+				return errNotSupported
+			}
+			_, err := provider.SignedBeaconBlock(ctx, "head")
+
+			return err
+		},
+	},
+	{
+		name:     "Validators",
+		provider: "ValidatorsProvider",
+		metadata: metadata{Status: true},
+		run: func(ctx context.Context, service consensusclient.Service) error {
+			provider, isProvider := service.(consensusclient.ValidatorsProvider)
+			if !isProvider {
+				return errNotSupported
+			}
+			_, err := provider.Validators(ctx, "head", []phase0.ValidatorIndex{0}, nil)
+
+			return err
+		},
+	},
+	{
+		name:     "ValidatorBalances",
+		provider: "ValidatorBalancesProvider",
+		metadata: metadata{Status: true},
+		run: func(ctx context.Context, service consensusclient.Service) error {
+			provider, isProvider := service.(consensusclient.ValidatorBalancesProvider)
+			if !isProvider {
+				return errNotSupported
+			}
+			_, err := provider.ValidatorBalances(ctx, "head", []phase0.ValidatorIndex{0})
+
+			return err
+		},
+	},
+	{
+		name:     "SyncCommittee",
+		provider: "SyncCommitteesProvider",
+		metadata: metadata{Status: true},
+		run: func(ctx context.Context, service consensusclient.Service) error {
+			provider, isProvider := service.(consensusclient.SyncCommitteesProvider)
+			if !isProvider {
+				return errNotSupported
+			}
+			_, err := provider.SyncCommittee(ctx, "head")
+
+			return err
+		},
+	},
+	{
+		name:     "ProposerDuties",
+		provider: "ProposerDutiesProvider",
+		metadata: metadata{ExecutionOptimistic: true},
+		run: func(ctx context.Context, service consensusclient.Service) error {
+			provider, isProvider := service.(consensusclient.ProposerDutiesProvider)
+			if !isProvider {
+				return errNotSupported
+			}
+			epoch, err := currentEpoch(ctx, service)
+			if err != nil {
+				return err
+			}
+			_, err = provider.ProposerDuties(ctx, epoch, nil)
+
+			return err
+		},
+	},
+	{
+		name:     "AttesterDuties",
+		provider: "AttesterDutiesProvider",
+		metadata: metadata{ExecutionOptimistic: true},
+		run: func(ctx context.Context, service consensusclient.Service) error {
+			provider, isProvider := service.(consensusclient.AttesterDutiesProvider)
+			if !isProvider {
+				return errNotSupported
+			}
+			epoch, err := currentEpoch(ctx, service)
+			if err != nil {
+				return err
+			}
+			_, err = provider.AttesterDuties(ctx, epoch, []phase0.ValidatorIndex{0})
+
+			return err
+		},
+	},
+	{
+		name:     "VoluntaryExitPool",
+		provider: "VoluntaryExitPoolProvider",
+		run: func(ctx context.Context, service consensusclient.Service) error {
+			provider, isProvider := service.(consensusclient.VoluntaryExitPoolProvider)
+			if !isProvider {
+				return errNotSupported
+			}
+			_, err := provider.VoluntaryExitPool(ctx)
+
+			return err
+		},
+	},
+}
+
+// currentEpoch returns the epoch of the node's head.
+func currentEpoch(ctx context.Context, service consensusclient.Service) (phase0.Epoch, error) {
+	headerProvider, isProvider := service.(consensusclient.BeaconBlockHeadersProvider)
+	if !isProvider {
+		return 0, errNotSupported
+	}
+	specProvider, isProvider := service.(consensusclient.SlotsPerEpochProvider)
+	if !isProvider {
+		return 0, errNotSupported
+	}
+	header, err := headerProvider.BeaconBlockHeader(ctx, "head")
+	if err != nil {
+		return 0, err
+	}
+	if header == nil || header.Header == nil || header.Header.Message == nil {
+		return 0, errors.New("no head header")
+	}
+	slotsPerEpoch, err := specProvider.SlotsPerEpoch(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if slotsPerEpoch == 0 {
+		return 0, errors.New("slots per epoch is 0")
+	}
+
+	return phase0.Epoch(uint64(header.Header.Message.Slot) / slotsPerEpoch), nil
+}
@@ -0,0 +1,79 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command conformance runs a suite of read-only calls against a beacon node and reports which
+// providers work, their latencies and any deviations of the responses from the beacon API spec.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/rs/zerolog"
+)
+
+func main() {
+	address := flag.String("address", "", "address of the beacon node")
+	timeout := flag.Duration("timeout", 30*time.Second, "timeout for each call")
+	jsonOutput := flag.Bool("json", false, "output the report as JSON")
+	failOnError := flag.Bool("fail-on-error", false, "exit with status 2 if any check fails or deviates from the spec")
+	flag.Parse()
+
+	if *address == "" {
+		fmt.Fprintln(os.Stderr, "No address supplied")
+		os.Exit(1)
+	}
+
+	ctx := context.Background()
+	service, err := http.New(ctx,
+		http.WithAddress(*address),
+		http.WithTimeout(*timeout),
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithResponseHook(recordResponse),
+	)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to connect to beacon node: %v\n", err)
+		os.Exit(1)
+	}
+
+	report := &report{
+		Address: *address,
+		Results: runChecks(ctx, service, checks),
+	}
+	if provider, isProvider := service.(consensusclient.NodeVersionProvider); isProvider {
+		if nodeVersion, err := provider.NodeVersion(ctx); err == nil {
+			report.NodeVersion = nodeVersion
+		}
+	}
+
+	if *jsonOutput {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to encode report: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(data))
+	} else {
+		report.write(os.Stdout)
+	}
+
+	if *failOnError && report.hasFailures() {
+		os.Exit(2)
+	}
+}
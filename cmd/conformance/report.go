@@ -0,0 +1,229 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+)
+
+// Check statuses.
+const (
+	statusOK          = "ok"
+	statusFailed      = "failed"
+	statusUnsupported = "unsupported"
+)
+
+// report is the result of running the checks against a beacon node.
+type report struct {
+	Address     string    `json:"address"`
+	NodeVersion string    `json:"node_version,omitempty"`
+	Results     []*result `json:"results"`
+}
+
+// result is the result of a single check.
+type result struct {
+	Name       string   `json:"name"`
+	Provider   string   `json:"provider"`
+	Status     string   `json:"status"`
+	Error      string   `json:"error,omitempty"`
+	LatencyMS  int64    `json:"latency_ms"`
+	Endpoints  []string `json:"endpoints,omitempty"`
+	Deviations []string `json:"deviations,omitempty"`
+}
+
+// hasFailures returns true if any check failed or deviated from the spec.
+func (r *report) hasFailures() bool {
+	for _, result := range r.Results {
+		if result.Status == statusFailed || len(result.Deviations) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// write writes the report in human-readable form.
+func (r *report) write(w io.Writer) {
+	fmt.Fprintf(w, "Address: %s\n", r.Address)
+	if r.NodeVersion != "" {
+		fmt.Fprintf(w, "Node version: %s\n", r.NodeVersion)
+	}
+	fmt.Fprintln(w)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CHECK\tSTATUS\tLATENCY\tENDPOINTS")
+	for _, result := range r.Results {
+		fmt.Fprintf(tw, "%s\t%s\t%dms\t%v\n", result.Name, result.Status, result.LatencyMS, result.Endpoints)
+	}
+	_ = tw.Flush()
+
+	for _, result := range r.Results {
+		if result.Error != "" {
+			fmt.Fprintf(w, "%s: %s\n", result.Name, result.Error)
+		}
+		for _, deviation := range result.Deviations {
+			fmt.Fprintf(w, "%s: deviation: %s\n", result.Name, deviation)
+		}
+	}
+}
+
+// response is a response from the beacon node captured during a check.
+type response struct {
+	endpoint         string
+	statusCode       int
+	contentType      string
+	consensusVersion string
+	body             []byte
+}
+
+// recorder captures the responses made during a check.
+type recorder struct {
+	mu        sync.Mutex
+	responses []*response
+}
+
+type recorderKey struct{}
+
+// recordResponse is the response hook that captures responses for the recorder in the context, if any.
+func recordResponse(ctx context.Context, resp *http.Response, body []byte) {
+	rec, isRecorder := ctx.Value(recorderKey{}).(*recorder)
+	if !isRecorder {
+		return
+	}
+
+	endpoint := ""
+	if resp.Request != nil && resp.Request.URL != nil {
+		endpoint = fmt.Sprintf("%s %s", resp.Request.Method, resp.Request.URL.Path)
+	}
+
+	rec.mu.Lock()
+	rec.responses = append(rec.responses, &response{
+		endpoint:         endpoint,
+		statusCode:       resp.StatusCode,
+		contentType:      resp.Header.Get("Content-Type"),
+		consensusVersion: resp.Header.Get("Eth-Consensus-Version"),
+		body:             body,
+	})
+	rec.mu.Unlock()
+}
+
+// runChecks runs the checks against the service.
+func runChecks(ctx context.Context, service consensusclient.Service, checks []*check) []*result {
+	results := make([]*result, 0, len(checks))
+	for _, check := range checks {
+		results = append(results, runCheck(ctx, service, check))
+	}
+
+	return results
+}
+
+// runCheck runs a single check against the service.
+func runCheck(ctx context.Context, service consensusclient.Service, check *check) *result {
+	rec := &recorder{}
+	ctx = context.WithValue(ctx, recorderKey{}, rec)
+
+	started := time.Now()
+	err := check.run(ctx, service)
+	res := &result{
+		Name:      check.name,
+		Provider:  check.provider,
+		Status:    statusOK,
+		LatencyMS: time.Since(started).Milliseconds(),
+	}
+	switch {
+	case errors.Is(err, errNotSupported):
+		res.Status = statusUnsupported
+	case err != nil:
+		res.Status = statusFailed
+		res.Error = err.Error()
+	}
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	endpoints := make(map[string]bool)
+	for _, resp := range rec.responses {
+		if !endpoints[resp.endpoint] {
+			endpoints[resp.endpoint] = true
+			res.Endpoints = append(res.Endpoints, resp.endpoint)
+		}
+		res.Deviations = append(res.Deviations, deviations(resp, check.metadata)...)
+	}
+	sort.Strings(res.Endpoints)
+
+	return res
+}
+
+// deviations returns the ways in which a response deviates from the spec.
+func deviations(resp *response, expected metadata) []string {
+	if resp.statusCode/100 != 2 || len(resp.body) == 0 {
+		return nil
+	}
+
+	res := make([]string, 0)
+	mediaType, _, err := mime.ParseMediaType(resp.contentType)
+	if err != nil || mediaType != "application/json" {
+		res = append(res, fmt.Sprintf("%s: content type %q is not application/json", resp.endpoint, resp.contentType))
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(resp.body, &fields); err != nil {
+		return append(res, fmt.Sprintf("%s: response is not a JSON object", resp.endpoint))
+	}
+	if _, exists := fields["data"]; !exists {
+		res = append(res, fmt.Sprintf("%s: missing data field", resp.endpoint))
+	}
+
+	required := make([]string, 0)
+	if expected.Version {
+		required = append(required, "version")
+	}
+	if expected.Status || expected.ExecutionOptimistic {
+		required = append(required, "execution_optimistic")
+	}
+	if expected.Status {
+		required = append(required, "finalized")
+	}
+	for _, field := range required {
+		if _, exists := fields[field]; !exists {
+			res = append(res, fmt.Sprintf("%s: missing %s field", resp.endpoint, field))
+		}
+	}
+
+	if expected.Version {
+		var version string
+		if raw, exists := fields["version"]; exists {
+			_ = json.Unmarshal(raw, &version)
+		}
+		switch {
+		case resp.consensusVersion == "":
+			res = append(res, fmt.Sprintf("%s: missing Eth-Consensus-Version header", resp.endpoint))
+		case version != "" && version != resp.consensusVersion:
+			res = append(res, fmt.Sprintf("%s: Eth-Consensus-Version header %q does not match version %q", resp.endpoint, resp.consensusVersion, version))
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,161 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviations(t *testing.T) {
+	tests := []struct {
+		name       string
+		response   *response
+		metadata   metadata
+		deviations []string
+	}{
+		{
+			name: "Good",
+			response: &response{
+				endpoint:    "GET /eth/v1/beacon/genesis",
+				statusCode:  200,
+				contentType: "application/json; charset=utf-8",
+				body:        []byte(`{"data":{}}`),
+			},
+			deviations: []string{},
+		},
+		{
+			name: "NotFound",
+			response: &response{
+				endpoint:   "GET /eth/v1/beacon/genesis",
+				statusCode: 404,
+				body:       []byte(`not found`),
+			},
+		},
+		{
+			name: "WrongContentType",
+			response: &response{
+				endpoint:    "GET /eth/v1/beacon/genesis",
+				statusCode:  200,
+				contentType: "text/plain",
+				body:        []byte(`{"data":{}}`),
+			},
+			deviations: []string{`GET /eth/v1/beacon/genesis: content type "text/plain" is not application/json`},
+		},
+		{
+			name: "NotObject",
+			response: &response{
+				endpoint:    "GET /eth/v1/beacon/genesis",
+				statusCode:  200,
+				contentType: "application/json",
+				body:        []byte(`[]`),
+			},
+			deviations: []string{"GET /eth/v1/beacon/genesis: response is not a JSON object"},
+		},
+		{
+			name: "MissingStatus",
+			response: &response{
+				endpoint:    "GET /eth/v1/beacon/states/head/fork",
+				statusCode:  200,
+				contentType: "application/json",
+				body:        []byte(`{"execution_optimistic":false}`),
+			},
+			metadata: metadata{Status: true},
+			deviations: []string{
+				"GET /eth/v1/beacon/states/head/fork: missing data field",
+				"GET /eth/v1/beacon/states/head/fork: missing finalized field",
+			},
+		},
+		{
+			name: "MissingVersionHeader",
+			response: &response{
+				endpoint:    "GET /eth/v2/beacon/blocks/head",
+				statusCode:  200,
+				contentType: "application/json",
+				body:        []byte(`{"version":"capella","data":{}}`),
+			},
+			metadata:   metadata{Version: true},
+			deviations: []string{"GET /eth/v2/beacon/blocks/head: missing Eth-Consensus-Version header"},
+		},
+		{
+			name: "VersionMismatch",
+			response: &response{
+				endpoint:         "GET /eth/v2/beacon/blocks/head",
+				statusCode:       200,
+				contentType:      "application/json",
+				consensusVersion: "deneb",
+				body:             []byte(`{"version":"capella","data":{}}`),
+			},
+			metadata:   metadata{Version: true},
+			deviations: []string{`GET /eth/v2/beacon/blocks/head: Eth-Consensus-Version header "deneb" does not match version "capella"`},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.deviations, deviations(test.response, test.metadata))
+		})
+	}
+}
+
+func TestRunCheck(t *testing.T) {
+	ctx := context.Background()
+	service, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	results := runChecks(ctx, service, checks)
+	require.Len(t, results, len(checks))
+	for _, result := range results {
+		require.NotEqual(t, statusFailed, result.Status, result.Name)
+	}
+
+	res := runCheck(ctx, service, &check{
+		name:     "Recorded",
+		metadata: metadata{Status: true},
+		run: func(ctx context.Context, _ consensusclient.Service) error {
+			recordResponse(ctx, &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Request:    &http.Request{Method: http.MethodGet, URL: &url.URL{Path: "/eth/v1/beacon/states/head/fork"}},
+			}, []byte(`{"data":{}}`))
+
+			return nil
+		},
+	})
+	require.Equal(t, []string{"GET /eth/v1/beacon/states/head/fork"}, res.Endpoints)
+	require.Len(t, res.Deviations, 2)
+
+	report := &report{Address: "mock", Results: []*result{res}}
+	require.True(t, report.hasFailures())
+	var buf bytes.Buffer
+	report.write(&buf)
+	require.Contains(t, buf.String(), "Recorded: deviation: GET /eth/v1/beacon/states/head/fork: missing execution_optimistic field")
+}
+
+func TestRunCheckUnsupported(t *testing.T) {
+	res := runCheck(context.Background(), nil, &check{
+		name: "Unsupported",
+		run: func(context.Context, consensusclient.Service) error {
+			return errNotSupported
+		},
+	})
+	require.Equal(t, statusUnsupported, res.Status)
+}
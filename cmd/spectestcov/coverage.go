@@ -0,0 +1,306 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// forks are the forks with spec packages, in order.
+// Types defined in a fork's package are available to all later forks.
+var forks = []string{"phase0", "altair", "bellatrix", "capella", "deneb"}
+
+// testedTypePatterns match the references to ssz_static types in spec tests, covering both
+// the per-type tests and the table-driven consensus spec tests.
+var testedTypePatterns = []*regexp.Regexp{
+	regexp.MustCompile(`"ssz_static",\s*"(\w+)"`),
+	regexp.MustCompile(`[Nn]ame:\s*"(\w+)"`),
+}
+
+// specTestMarkers identify the test files that contain spec tests.
+var specTestMarkers = [][]byte{
+	[]byte("ssz_static"),
+	[]byte("RunSSZStatic"),
+}
+
+// report is the coverage report for a set of spec tests.
+type report struct {
+	Preset string        `json:"preset"`
+	Forks  []*forkReport `json:"forks"`
+}
+
+// forkReport is the coverage report for a single fork.
+type forkReport struct {
+	Fork string `json:"fork"`
+	// Supported is false if the module has no spec package for the fork.
+	Supported bool     `json:"supported"`
+	Tested    []string `json:"tested"`
+	Untested  []string `json:"untested"`
+	Missing   []string `json:"missing"`
+}
+
+// hasGaps returns true if any fork has missing or untested types.
+func (r *report) hasGaps() bool {
+	for _, fork := range r.Forks {
+		if len(fork.Untested) > 0 || len(fork.Missing) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// write writes the report in human-readable form.
+func (r *report) write(w io.Writer) {
+	for _, fork := range r.Forks {
+		if !fork.Supported {
+			fmt.Fprintf(w, "%s: fork not supported; %d types missing\n", fork.Fork, len(fork.Missing))
+			continue
+		}
+		fmt.Fprintf(w, "%s: %d types; %d tested, %d untested, %d missing\n",
+			fork.Fork,
+			len(fork.Tested)+len(fork.Untested)+len(fork.Missing),
+			len(fork.Tested),
+			len(fork.Untested),
+			len(fork.Missing),
+		)
+		if len(fork.Untested) > 0 {
+			fmt.Fprintf(w, "  untested: %s\n", strings.Join(fork.Untested, ", "))
+		}
+		if len(fork.Missing) > 0 {
+			fmt.Fprintf(w, "  missing: %s\n", strings.Join(fork.Missing, ", "))
+		}
+	}
+}
+
+// generateReport generates the coverage report for the spec tests in the given directory
+// against the spec packages in the given module source.
+func generateReport(dir string, preset string, source string) (*report, error) {
+	presetDir := filepath.Join(dir, "tests", preset)
+	specForks, err := subdirectories(presetDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spec tests for preset %s: %w", preset, err)
+	}
+	sort.Strings(specForks)
+
+	res := &report{
+		Preset: preset,
+		Forks:  make([]*forkReport, 0, len(specForks)),
+	}
+	// defined holds the lower-case names of the types defined by the spec packages
+	// of the forks up to and including the current fork.
+	defined := make(map[string]bool)
+	for _, fork := range forks {
+		forkDefined, err := definedTypes(filepath.Join(source, "spec", fork))
+		if err != nil {
+			return nil, err
+		}
+		for name := range forkDefined {
+			defined[name] = true
+		}
+
+		forkReport, err := generateForkReport(presetDir, source, fork, defined)
+		if err != nil {
+			return nil, err
+		}
+		if forkReport != nil {
+			res.Forks = append(res.Forks, forkReport)
+		}
+	}
+	for _, fork := range specForks {
+		if isKnownFork(fork) {
+			continue
+		}
+		forkReport, err := generateForkReport(presetDir, source, fork, nil)
+		if err != nil {
+			return nil, err
+		}
+		if forkReport != nil {
+			res.Forks = append(res.Forks, forkReport)
+		}
+	}
+
+	return res, nil
+}
+
+// generateForkReport generates the coverage report for a single fork, returning nil if the
+// spec tests have no ssz_static tests for the fork.
+// If defined is nil the fork is unsupported, and all of its types are missing.
+func generateForkReport(presetDir string, source string, fork string, defined map[string]bool) (*forkReport, error) {
+	specTypes, err := subdirectories(filepath.Join(presetDir, fork, "ssz_static"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read ssz_static tests for %s: %w", fork, err)
+	}
+	if len(specTypes) == 0 {
+		return nil, nil
+	}
+	sort.Strings(specTypes)
+
+	res := &forkReport{
+		Fork:      fork,
+		Supported: defined != nil,
+		Tested:    make([]string, 0),
+		Untested:  make([]string, 0),
+		Missing:   make([]string, 0),
+	}
+	if defined == nil {
+		res.Missing = append(res.Missing, specTypes...)
+		return res, nil
+	}
+
+	tested, err := testedTypes(filepath.Join(source, "spec", fork))
+	if err != nil {
+		return nil, err
+	}
+	for _, specType := range specTypes {
+		switch {
+		case !defined[strings.ToLower(specType)]:
+			res.Missing = append(res.Missing, specType)
+		case tested[strings.ToLower(specType)]:
+			res.Tested = append(res.Tested, specType)
+		default:
+			res.Untested = append(res.Untested, specType)
+		}
+	}
+
+	return res, nil
+}
+
+// definedTypes returns the lower-case names of the exported types defined in the package in
+// the given directory.
+// Names are compared in lower case as the spec and this module capitalise some names
+// differently, for example Eth1Data and ETH1Data.
+func definedTypes(dir string) (map[string]bool, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]bool)
+	fset := token.NewFileSet()
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, name, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+		}
+		for _, decl := range file.Decls {
+			genDecl, isGenDecl := decl.(*ast.GenDecl)
+			if !isGenDecl || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec, isTypeSpec := spec.(*ast.TypeSpec)
+				if isTypeSpec && typeSpec.Name.IsExported() {
+					res[strings.ToLower(typeSpec.Name.Name)] = true
+				}
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// testedTypes returns the lower-case names of the ssz_static types referenced by the spec
+// tests in the package in the given directory.
+// Commented-out references are ignored.
+func testedTypes(dir string) (map[string]bool, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*_test.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]bool)
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		if !isSpecTestFile(data) {
+			continue
+		}
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if strings.HasPrefix(line, "//") {
+				continue
+			}
+			for _, pattern := range testedTypePatterns {
+				for _, match := range pattern.FindAllStringSubmatch(line, -1) {
+					res[strings.ToLower(match[1])] = true
+				}
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to scan %s: %w", file, err)
+		}
+	}
+
+	return res, nil
+}
+
+// isSpecTestFile returns true if the test file contains spec tests.
+func isSpecTestFile(data []byte) bool {
+	for _, marker := range specTestMarkers {
+		if bytes.Contains(data, marker) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// subdirectories returns the names of the subdirectories of the given directory.
+func subdirectories(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			res = append(res, entry.Name())
+		}
+	}
+
+	return res, nil
+}
+
+// isKnownFork returns true if the fork has a spec package.
+func isKnownFork(fork string) bool {
+	for _, knownFork := range forks {
+		if fork == knownFork {
+			return true
+		}
+	}
+
+	return false
+}
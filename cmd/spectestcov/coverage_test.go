@@ -0,0 +1,125 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, path string, data string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+}
+
+func TestGenerateReport(t *testing.T) {
+	specDir := t.TempDir()
+	for _, dir := range []string{
+		"phase0/ssz_static/Eth1Data",
+		"phase0/ssz_static/Fork",
+		"phase0/ssz_static/HistoricalBatch",
+		"phase0/ssz_static/SigningData",
+		"altair/ssz_static/Fork",
+		"altair/ssz_static/LightClientUpdate",
+		"altair/ssz_static/SyncAggregate",
+		"electra/ssz_static/BeaconBlock",
+		"capella/operations",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Join(specDir, "tests", "mainnet", dir), 0o755))
+	}
+
+	source := t.TempDir()
+	writeFile(t, filepath.Join(source, "spec", "phase0", "types.go"), `package phase0
+
+type ETH1Data struct{}
+
+type Fork struct{}
+
+type SigningData struct{}
+
+type unexported struct{}
+`)
+	writeFile(t, filepath.Join(source, "spec", "phase0", "eth1data_test.go"), `package phase0_test
+
+var dir = filepath.Join("tests", "mainnet", "phase0", "ssz_static", "ETH1Data", "ssz_random")
+`)
+	writeFile(t, filepath.Join(source, "spec", "phase0", "fork_test.go"), `package phase0_test
+
+var dir = filepath.Join("tests", "mainnet", "phase0", "ssz_static", "Fork", "ssz_random")
+`)
+	writeFile(t, filepath.Join(source, "spec", "altair", "types.go"), `package altair
+
+type SyncAggregate struct{}
+`)
+	writeFile(t, filepath.Join(source, "spec", "altair", "consensusspec_test.go"), `package altair_test
+
+func TestConsensusSpec(t *testing.T) {
+	testutil.RunSSZStatic(t, "altair", []*testutil.SSZStaticTest{
+		{Name: "SyncAggregate"},
+		// {Name: "Fork"},
+	})
+}
+`)
+	for _, fork := range []string{"bellatrix", "capella", "deneb"} {
+		writeFile(t, filepath.Join(source, "spec", fork, "types.go"), "package "+fork+"\n")
+	}
+
+	res, err := generateReport(specDir, "mainnet", source)
+	require.NoError(t, err)
+	require.Equal(t, &report{
+		Preset: "mainnet",
+		Forks: []*forkReport{
+			{
+				Fork:      "phase0",
+				Supported: true,
+				Tested:    []string{"Eth1Data", "Fork"},
+				Untested:  []string{"SigningData"},
+				Missing:   []string{"HistoricalBatch"},
+			},
+			{
+				Fork:      "altair",
+				Supported: true,
+				Tested:    []string{"SyncAggregate"},
+				Untested:  []string{"Fork"},
+				Missing:   []string{"LightClientUpdate"},
+			},
+			{
+				Fork:     "electra",
+				Tested:   []string{},
+				Untested: []string{},
+				Missing:  []string{"BeaconBlock"},
+			},
+		},
+	}, res)
+	require.True(t, res.hasGaps())
+
+	var out bytes.Buffer
+	res.write(&out)
+	require.Equal(t, `phase0: 4 types; 2 tested, 1 untested, 1 missing
+  untested: SigningData
+  missing: HistoricalBatch
+altair: 3 types; 1 tested, 1 untested, 1 missing
+  untested: Fork
+  missing: LightClientUpdate
+electra: fork not supported; 1 types missing
+`, out.String())
+
+	_, err = generateReport(specDir, "minimal", source)
+	require.ErrorContains(t, err, "failed to read spec tests for preset minimal")
+}
@@ -0,0 +1,67 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command spectestcov reports the consensus spec test ssz_static types that are missing from,
+// or untested by, the spec packages of this module for each fork.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	dir := flag.String("dir", defaultSpecTestsDir(), "consensus spec tests directory (defaults to $ETH2_SPEC_TESTS_DIR or $CONSENSUS_SPEC_TESTS_DIR)")
+	preset := flag.String("preset", "mainnet", "preset of the spec tests to scan")
+	source := flag.String("source", ".", "root directory of the module source")
+	jsonOutput := flag.Bool("json", false, "output the report as JSON")
+	failOnGaps := flag.Bool("fail-on-gaps", false, "exit with status 2 if any type is missing or untested")
+	flag.Parse()
+
+	if *dir == "" {
+		fmt.Fprintln(os.Stderr, "No spec tests directory supplied")
+		os.Exit(1)
+	}
+
+	report, err := generateReport(*dir, *preset, *source)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to generate report: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *jsonOutput {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to encode report: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(data))
+	} else {
+		report.write(os.Stdout)
+	}
+
+	if *failOnGaps && report.hasGaps() {
+		os.Exit(2)
+	}
+}
+
+// defaultSpecTestsDir returns the spec tests directory from the environment.
+func defaultSpecTestsDir() string {
+	if dir := os.Getenv("ETH2_SPEC_TESTS_DIR"); dir != "" {
+		return dir
+	}
+
+	return os.Getenv("CONSENSUS_SPEC_TESTS_DIR")
+}
@@ -0,0 +1,55 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package duties
+
+import (
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Notification is a notification of new or updated duties.  It is one of
+// *AttesterDutiesNotification, *ProposerDutiesNotification or *SyncCommitteeDutiesNotification.
+type Notification interface {
+	isNotification()
+}
+
+// AttesterDutiesNotification is sent when attester duties for an epoch are obtained.
+type AttesterDutiesNotification struct {
+	Epoch  phase0.Epoch
+	Duties []*apiv1.AttesterDuty
+	// Reorg is true if the duties replace those previously sent for the epoch, due to a chain reorganisation.
+	Reorg bool
+}
+
+func (*AttesterDutiesNotification) isNotification() {}
+
+// ProposerDutiesNotification is sent when proposer duties for an epoch are obtained.
+type ProposerDutiesNotification struct {
+	Epoch  phase0.Epoch
+	Duties []*apiv1.ProposerDuty
+	// Reorg is true if the duties replace those previously sent for the epoch, due to a chain reorganisation.
+	Reorg bool
+}
+
+func (*ProposerDutiesNotification) isNotification() {}
+
+// SyncCommitteeDutiesNotification is sent when sync committee duties for a sync committee period are obtained.
+type SyncCommitteeDutiesNotification struct {
+	Period uint64
+	// Epoch is the first epoch of the period.
+	Epoch  phase0.Epoch
+	Duties []*apiv1.SyncCommitteeDuty
+}
+
+func (*SyncCommitteeDutiesNotification) isNotification() {}
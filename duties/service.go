@@ -0,0 +1,479 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package duties tracks the attester, proposer and sync committee duties of a set of validators.
+//
+// Duties are obtained for the current and next epochs when the service starts, and for each
+// subsequent epoch as head events show the chain moving in to it.  Head events are also used to
+// detect chain reorganisations: if the dependent root for a set of duties changes the duties are
+// obtained again.  New and updated duties are sent on the channel returned by Notifications().
+package duties
+
+import (
+	"context"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/chaintime"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service tracks validator duties.
+type Service struct {
+	log                         zerolog.Logger
+	attesterDutiesProvider      consensusclient.AttesterDutiesProvider
+	proposerDutiesProvider      consensusclient.ProposerDutiesProvider
+	syncCommitteeDutiesProvider consensusclient.SyncCommitteeDutiesProvider
+	chainTime                   *chaintime.ChainTime
+	altairForkEpoch             phase0.Epoch
+
+	done          <-chan struct{}
+	notifications chan Notification
+	headEvents    chan *apiv1.HeadEvent
+	refresh       chan struct{}
+
+	mu                    sync.RWMutex
+	validatorIndices      []phase0.ValidatorIndex
+	epoch                 phase0.Epoch
+	previousDependentRoot phase0.Root
+	currentDependentRoot  phase0.Root
+	attesterDuties        map[phase0.Epoch][]*apiv1.AttesterDuty
+	proposerDuties        map[phase0.Epoch][]*apiv1.ProposerDuty
+	syncCommitteeDuties   map[uint64][]*apiv1.SyncCommitteeDuty
+}
+
+// New creates a new duties service.  Duties for the current and next epochs are obtained
+// before it returns.  The service runs until the context is cancelled, at which point the
+// notifications channel is closed.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	log := zerologger.With().Str("service", "duties").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	s := &Service{
+		log:                    log,
+		attesterDutiesProvider: parameters.client.(consensusclient.AttesterDutiesProvider),
+		proposerDutiesProvider: parameters.client.(consensusclient.ProposerDutiesProvider),
+		done:                   ctx.Done(),
+		notifications:          make(chan Notification, parameters.bufferSize),
+		headEvents:             make(chan *apiv1.HeadEvent, 16),
+		refresh:                make(chan struct{}, 1),
+		validatorIndices:       parameters.validatorIndices,
+		attesterDuties:         make(map[phase0.Epoch][]*apiv1.AttesterDuty),
+		proposerDuties:         make(map[phase0.Epoch][]*apiv1.ProposerDuty),
+		syncCommitteeDuties:    make(map[uint64][]*apiv1.SyncCommitteeDuty),
+	}
+	if provider, isProvider := parameters.client.(consensusclient.SyncCommitteeDutiesProvider); isProvider {
+		s.syncCommitteeDutiesProvider = provider
+	}
+
+	if err := s.obtainChainConfig(ctx, parameters.client); err != nil {
+		return nil, err
+	}
+
+	s.epoch = s.chainTime.CurrentEpoch()
+	notifications, err := s.obtainDuties(ctx, s.epoch, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain initial duties")
+	}
+
+	if err := parameters.client.(consensusclient.EventsProvider).Events(ctx, []string{"head"}, s.handleEvent); err != nil {
+		return nil, errors.Wrap(err, "failed to subscribe to head events")
+	}
+
+	go s.run(ctx, notifications)
+
+	return s, nil
+}
+
+// Notifications returns the channel on which duty notifications are sent.
+// Notifications should be read promptly, as the service waits for space in the channel
+// before processing further events.
+func (s *Service) Notifications() <-chan Notification {
+	return s.notifications
+}
+
+// AttesterDuties returns the attester duties for the given epoch, if known.
+func (s *Service) AttesterDuties(epoch phase0.Epoch) ([]*apiv1.AttesterDuty, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	duties, exists := s.attesterDuties[epoch]
+
+	return duties, exists
+}
+
+// ProposerDuties returns the proposer duties for the given epoch, if known.
+func (s *Service) ProposerDuties(epoch phase0.Epoch) ([]*apiv1.ProposerDuty, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	duties, exists := s.proposerDuties[epoch]
+
+	return duties, exists
+}
+
+// SyncCommitteeDuties returns the sync committee duties for the sync committee period containing the given epoch, if known.
+func (s *Service) SyncCommitteeDuties(epoch phase0.Epoch) ([]*apiv1.SyncCommitteeDuty, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	duties, exists := s.syncCommitteeDuties[s.chainTime.EpochToSyncCommitteePeriod(epoch)]
+
+	return duties, exists
+}
+
+// UpdateValidatorIndices changes the validators for which duties are tracked.  Duties for the
+// current and next epochs are obtained again, and sent as notifications.
+func (s *Service) UpdateValidatorIndices(validatorIndices []phase0.ValidatorIndex) {
+	s.mu.Lock()
+	s.validatorIndices = validatorIndices
+	s.mu.Unlock()
+
+	select {
+	case s.refresh <- struct{}{}:
+	default:
+		// Refresh already pending.
+	}
+}
+
+// obtainChainConfig obtains the chain configuration required to track epochs.
+func (s *Service) obtainChainConfig(ctx context.Context, client consensusclient.Service) error {
+	genesis, err := client.(consensusclient.GenesisProvider).Genesis(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain genesis")
+	}
+
+	spec, err := client.(consensusclient.SpecProvider).Spec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain spec")
+	}
+
+	chainTimeParams, err := chaintime.ParametersFromSpec(genesis.GenesisTime, spec)
+	if err != nil {
+		return err
+	}
+	s.chainTime, err = chaintime.New(chainTimeParams)
+	if err != nil {
+		return errors.Wrap(err, "failed to create chain time")
+	}
+
+	if tmp, exists := spec["ALTAIR_FORK_EPOCH"]; exists {
+		altairForkEpoch, isUint := tmp.(uint64)
+		if !isUint {
+			return errors.New("ALTAIR_FORK_EPOCH of unexpected type")
+		}
+		s.altairForkEpoch = phase0.Epoch(altairForkEpoch)
+	}
+
+	return nil
+}
+
+// handleEvent handles events from the client.
+func (s *Service) handleEvent(event *apiv1.Event) {
+	data, isHeadEvent := event.Data.(*apiv1.HeadEvent)
+	if !isHeadEvent {
+		return
+	}
+	select {
+	case s.headEvents <- data:
+	case <-s.done:
+	}
+}
+
+// run processes events until the context is done.
+func (s *Service) run(ctx context.Context, initial []Notification) {
+	defer close(s.notifications)
+
+	for _, notification := range initial {
+		if !s.notify(ctx, notification) {
+			return
+		}
+	}
+
+	for {
+		var notifications []Notification
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-s.headEvents:
+			notifications = s.handleHeadEvent(ctx, event)
+		case <-s.refresh:
+			s.mu.RLock()
+			epoch := s.epoch
+			s.mu.RUnlock()
+			var err error
+			notifications, err = s.obtainDuties(ctx, epoch, true)
+			if err != nil {
+				s.log.Warn().Err(err).Msg("Failed to obtain duties for updated validators")
+			}
+		}
+		for _, notification := range notifications {
+			if !s.notify(ctx, notification) {
+				return
+			}
+		}
+	}
+}
+
+// notify sends a notification, returning false if the context is done.
+func (s *Service) notify(ctx context.Context, notification Notification) bool {
+	select {
+	case s.notifications <- notification:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// handleHeadEvent handles a head event, obtaining duties for a new epoch or refreshing
+// duties for the current epoch if the event shows a chain reorganisation.
+func (s *Service) handleHeadEvent(ctx context.Context, event *apiv1.HeadEvent) []Notification {
+	epoch := s.chainTime.SlotToEpoch(event.Slot)
+	log := s.log.With().Uint64("slot", uint64(event.Slot)).Uint64("epoch", uint64(epoch)).Logger()
+
+	s.mu.Lock()
+	if epoch < s.epoch {
+		s.mu.Unlock()
+		log.Trace().Msg("Head event for earlier epoch; ignoring")
+		return nil
+	}
+	newEpoch := epoch > s.epoch
+	previousReorg := !newEpoch && rootChanged(s.previousDependentRoot, event.PreviousDutyDependentRoot)
+	currentReorg := !newEpoch && rootChanged(s.currentDependentRoot, event.CurrentDutyDependentRoot)
+	// On moving to the next epoch the previous dependent root should be the prior current root,
+	// which governed the attester duties already obtained for this epoch.
+	boundaryReorg := epoch == s.epoch+1 && rootChanged(s.currentDependentRoot, event.PreviousDutyDependentRoot)
+	if newEpoch {
+		s.epoch = epoch
+		s.prune()
+	}
+	if !zeroRoot(event.PreviousDutyDependentRoot) {
+		s.previousDependentRoot = event.PreviousDutyDependentRoot
+	}
+	if !zeroRoot(event.CurrentDutyDependentRoot) {
+		s.currentDependentRoot = event.CurrentDutyDependentRoot
+	}
+	s.mu.Unlock()
+
+	if newEpoch {
+		log.Trace().Msg("New epoch; obtaining duties")
+		notifications, err := s.obtainDuties(ctx, epoch, false)
+		if err != nil {
+			log.Warn().Err(err).Msg("Failed to obtain duties for new epoch")
+		}
+		if boundaryReorg {
+			log.Debug().Msg("Previous duty dependent root does not match prior current root; obtaining attester duties")
+			notification, err := s.obtainAttesterDuties(ctx, epoch, true)
+			if err != nil {
+				log.Warn().Err(err).Msg("Failed to obtain attester duties after reorg")
+			} else {
+				notifications = append(notifications, notification)
+			}
+		}
+
+		return notifications
+	}
+
+	notifications := make([]Notification, 0)
+	if previousReorg {
+		// The previous dependent root governs attester duties for this epoch.
+		log.Debug().Msg("Previous duty dependent root changed; obtaining attester duties")
+		notification, err := s.obtainAttesterDuties(ctx, epoch, true)
+		if err != nil {
+			log.Warn().Err(err).Msg("Failed to obtain attester duties after reorg")
+		} else {
+			notifications = append(notifications, notification)
+		}
+	}
+	if currentReorg {
+		// The current dependent root governs proposer duties for this epoch and attester duties for the next.
+		log.Debug().Msg("Current duty dependent root changed; obtaining proposer and attester duties")
+		notification, err := s.obtainProposerDuties(ctx, epoch, true)
+		if err != nil {
+			log.Warn().Err(err).Msg("Failed to obtain proposer duties after reorg")
+		} else {
+			notifications = append(notifications, notification)
+		}
+		notification, err = s.obtainAttesterDuties(ctx, epoch+1, true)
+		if err != nil {
+			log.Warn().Err(err).Msg("Failed to obtain next epoch attester duties after reorg")
+		} else {
+			notifications = append(notifications, notification)
+		}
+	}
+
+	return notifications
+}
+
+// obtainDuties obtains any duties not already known for the given epoch, or all duties if refresh is set.
+func (s *Service) obtainDuties(ctx context.Context, epoch phase0.Epoch, refresh bool) ([]Notification, error) {
+	notifications := make([]Notification, 0, 5)
+
+	s.mu.RLock()
+	_, haveCurrentAttesterDuties := s.attesterDuties[epoch]
+	_, haveProposerDuties := s.proposerDuties[epoch]
+	_, haveCurrentSyncCommitteeDuties := s.syncCommitteeDuties[s.chainTime.EpochToSyncCommitteePeriod(epoch)]
+	_, haveNextSyncCommitteeDuties := s.syncCommitteeDuties[s.chainTime.EpochToSyncCommitteePeriod(epoch+1)]
+	s.mu.RUnlock()
+
+	if refresh || !haveCurrentAttesterDuties {
+		notification, err := s.obtainAttesterDuties(ctx, epoch, refresh)
+		if err != nil {
+			return notifications, err
+		}
+		notifications = append(notifications, notification)
+	}
+	notification, err := s.obtainAttesterDuties(ctx, epoch+1, refresh)
+	if err != nil {
+		return notifications, err
+	}
+	notifications = append(notifications, notification)
+
+	if refresh || !haveProposerDuties {
+		notification, err := s.obtainProposerDuties(ctx, epoch, refresh)
+		if err != nil {
+			return notifications, err
+		}
+		notifications = append(notifications, notification)
+	}
+
+	if refresh || !haveCurrentSyncCommitteeDuties {
+		notification, err := s.obtainSyncCommitteeDuties(ctx, epoch)
+		if err != nil {
+			return notifications, err
+		}
+		if notification != nil {
+			notifications = append(notifications, notification)
+		}
+	}
+	if s.chainTime.EpochToSyncCommitteePeriod(epoch+1) != s.chainTime.EpochToSyncCommitteePeriod(epoch) && (refresh || !haveNextSyncCommitteeDuties) {
+		notification, err := s.obtainSyncCommitteeDuties(ctx, epoch+1)
+		if err != nil {
+			return notifications, err
+		}
+		if notification != nil {
+			notifications = append(notifications, notification)
+		}
+	}
+
+	return notifications, nil
+}
+
+func (s *Service) obtainAttesterDuties(ctx context.Context, epoch phase0.Epoch, reorg bool) (Notification, error) {
+	s.mu.RLock()
+	validatorIndices := s.validatorIndices
+	s.mu.RUnlock()
+
+	duties, err := s.attesterDutiesProvider.AttesterDuties(ctx, epoch, validatorIndices)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to obtain attester duties for epoch %d", epoch)
+	}
+
+	s.mu.Lock()
+	s.attesterDuties[epoch] = duties
+	s.mu.Unlock()
+
+	return &AttesterDutiesNotification{
+		Epoch:  epoch,
+		Duties: duties,
+		Reorg:  reorg,
+	}, nil
+}
+
+func (s *Service) obtainProposerDuties(ctx context.Context, epoch phase0.Epoch, reorg bool) (Notification, error) {
+	s.mu.RLock()
+	validatorIndices := s.validatorIndices
+	s.mu.RUnlock()
+
+	duties, err := s.proposerDutiesProvider.ProposerDuties(ctx, epoch, validatorIndices)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to obtain proposer duties for epoch %d", epoch)
+	}
+
+	s.mu.Lock()
+	s.proposerDuties[epoch] = duties
+	s.mu.Unlock()
+
+	return &ProposerDutiesNotification{
+		Epoch:  epoch,
+		Duties: duties,
+		Reorg:  reorg,
+	}, nil
+}
+
+// obtainSyncCommitteeDuties obtains sync committee duties for the period containing the given epoch.
+// It returns nil if sync committee duties are not available.
+func (s *Service) obtainSyncCommitteeDuties(ctx context.Context, epoch phase0.Epoch) (Notification, error) {
+	if s.syncCommitteeDutiesProvider == nil || epoch < s.altairForkEpoch {
+		return nil, nil
+	}
+
+	s.mu.RLock()
+	validatorIndices := s.validatorIndices
+	s.mu.RUnlock()
+
+	period := s.chainTime.EpochToSyncCommitteePeriod(epoch)
+	duties, err := s.syncCommitteeDutiesProvider.SyncCommitteeDuties(ctx, epoch, validatorIndices)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to obtain sync committee duties for period %d", period)
+	}
+
+	s.mu.Lock()
+	s.syncCommitteeDuties[period] = duties
+	s.mu.Unlock()
+
+	return &SyncCommitteeDutiesNotification{
+		Period: period,
+		Epoch:  s.chainTime.FirstEpochOfSyncCommitteePeriod(period),
+		Duties: duties,
+	}, nil
+}
+
+// prune removes duties for epochs before the previous epoch.  It must be called with the lock held.
+func (s *Service) prune() {
+	for epoch := range s.attesterDuties {
+		if epoch+1 < s.epoch {
+			delete(s.attesterDuties, epoch)
+		}
+	}
+	for epoch := range s.proposerDuties {
+		if epoch+1 < s.epoch {
+			delete(s.proposerDuties, epoch)
+		}
+	}
+	for period := range s.syncCommitteeDuties {
+		if period < s.chainTime.EpochToSyncCommitteePeriod(s.epoch) {
+			delete(s.syncCommitteeDuties, period)
+		}
+	}
+}
+
+// rootChanged returns true if both roots are set and they differ.
+func rootChanged(previous phase0.Root, current phase0.Root) bool {
+	return !zeroRoot(previous) && !zeroRoot(current) && previous != current
+}
+
+func zeroRoot(root phase0.Root) bool {
+	return root == phase0.Root{}
+}
@@ -0,0 +1,259 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package duties_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/duties"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// eventsClient is a mock client that allows events to be sent to its subscribers.
+type eventsClient struct {
+	*mock.Service
+	mu       sync.Mutex
+	handlers []consensusclient.EventHandlerFunc
+}
+
+func (c *eventsClient) Events(_ context.Context, _ []string, handler consensusclient.EventHandlerFunc) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.handlers = append(c.handlers, handler)
+
+	return nil
+}
+
+func (c *eventsClient) sendHead(event *apiv1.HeadEvent) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, handler := range c.handlers {
+		handler(&apiv1.Event{Topic: "head", Data: event})
+	}
+}
+
+func newEventsClient(ctx context.Context, t *testing.T, epoch phase0.Epoch) *eventsClient {
+	t.Helper()
+
+	// Genesis is set such that we are part way through the requested epoch.
+	genesisTime := time.Now().Add(-time.Duration(uint64(epoch)*32+5) * 12 * time.Second)
+	client, err := mock.New(ctx, mock.WithGenesisTime(genesisTime))
+	require.NoError(t, err)
+
+	return &eventsClient{Service: client}
+}
+
+func TestNew(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := newEventsClient(ctx, t, 10)
+
+	tests := []struct {
+		name   string
+		params []duties.Parameter
+		err    string
+	}{
+		{
+			name: "ClientMissing",
+			params: []duties.Parameter{
+				duties.WithValidatorIndices([]phase0.ValidatorIndex{1}),
+			},
+			err: "problem with parameters: no client specified",
+		},
+		{
+			name: "ValidatorIndicesMissing",
+			params: []duties.Parameter{
+				duties.WithClient(client),
+			},
+			err: "problem with parameters: no validator indices specified",
+		},
+		{
+			name: "BufferSizeNegative",
+			params: []duties.Parameter{
+				duties.WithClient(client),
+				duties.WithValidatorIndices([]phase0.ValidatorIndex{1}),
+				duties.WithBufferSize(-1),
+			},
+			err: "problem with parameters: buffer size cannot be negative",
+		},
+		{
+			name: "Good",
+			params: []duties.Parameter{
+				duties.WithClient(client),
+				duties.WithValidatorIndices([]phase0.ValidatorIndex{1}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := duties.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func next(t *testing.T, s *duties.Service) duties.Notification {
+	t.Helper()
+
+	select {
+	case notification := <-s.Notifications():
+		return notification
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for notification")
+	}
+
+	return nil
+}
+
+func requireNoNotification(t *testing.T, s *duties.Service) {
+	t.Helper()
+
+	select {
+	case notification := <-s.Notifications():
+		require.FailNow(t, "unexpected notification", "%T", notification)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestNotifications(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := newEventsClient(ctx, t, 10)
+	s, err := duties.New(ctx,
+		duties.WithClient(client),
+		duties.WithValidatorIndices([]phase0.ValidatorIndex{1, 2}),
+	)
+	require.NoError(t, err)
+
+	// Initial duties.
+	attester := next(t, s).(*duties.AttesterDutiesNotification)
+	require.Equal(t, phase0.Epoch(10), attester.Epoch)
+	require.Len(t, attester.Duties, 2)
+	require.False(t, attester.Reorg)
+	require.Equal(t, phase0.Epoch(11), next(t, s).(*duties.AttesterDutiesNotification).Epoch)
+	require.Equal(t, phase0.Epoch(10), next(t, s).(*duties.ProposerDutiesNotification).Epoch)
+	syncCommittee := next(t, s).(*duties.SyncCommitteeDutiesNotification)
+	require.Equal(t, uint64(0), syncCommittee.Period)
+	require.Equal(t, phase0.Epoch(0), syncCommittee.Epoch)
+	requireNoNotification(t, s)
+
+	_, exists := s.AttesterDuties(11)
+	require.True(t, exists)
+	_, exists = s.ProposerDuties(11)
+	require.False(t, exists)
+	_, exists = s.SyncCommitteeDuties(10)
+	require.True(t, exists)
+
+	// Head event in the same epoch does not result in notifications.
+	client.sendHead(&apiv1.HeadEvent{
+		Slot:                      325,
+		PreviousDutyDependentRoot: phase0.Root{0x01},
+		CurrentDutyDependentRoot:  phase0.Root{0x02},
+	})
+	requireNoNotification(t, s)
+
+	// Change of current dependent root results in refreshed proposer and next epoch attester duties.
+	client.sendHead(&apiv1.HeadEvent{
+		Slot:                      326,
+		PreviousDutyDependentRoot: phase0.Root{0x01},
+		CurrentDutyDependentRoot:  phase0.Root{0x03},
+	})
+	proposer := next(t, s).(*duties.ProposerDutiesNotification)
+	require.Equal(t, phase0.Epoch(10), proposer.Epoch)
+	require.True(t, proposer.Reorg)
+	attester = next(t, s).(*duties.AttesterDutiesNotification)
+	require.Equal(t, phase0.Epoch(11), attester.Epoch)
+	require.True(t, attester.Reorg)
+	requireNoNotification(t, s)
+
+	// Change of previous dependent root results in refreshed current epoch attester duties.
+	client.sendHead(&apiv1.HeadEvent{
+		Slot:                      327,
+		PreviousDutyDependentRoot: phase0.Root{0x04},
+		CurrentDutyDependentRoot:  phase0.Root{0x03},
+	})
+	attester = next(t, s).(*duties.AttesterDutiesNotification)
+	require.Equal(t, phase0.Epoch(10), attester.Epoch)
+	require.True(t, attester.Reorg)
+	requireNoNotification(t, s)
+
+	// New epoch.
+	client.sendHead(&apiv1.HeadEvent{
+		Slot:                      352,
+		PreviousDutyDependentRoot: phase0.Root{0x03},
+		CurrentDutyDependentRoot:  phase0.Root{0x05},
+	})
+	attester = next(t, s).(*duties.AttesterDutiesNotification)
+	require.Equal(t, phase0.Epoch(12), attester.Epoch)
+	require.False(t, attester.Reorg)
+	require.Equal(t, phase0.Epoch(11), next(t, s).(*duties.ProposerDutiesNotification).Epoch)
+	requireNoNotification(t, s)
+
+	// New epoch with a previous dependent root that does not match the prior current root
+	// results in refreshed attester duties for the new epoch.
+	client.sendHead(&apiv1.HeadEvent{
+		Slot:                      384,
+		PreviousDutyDependentRoot: phase0.Root{0x08},
+		CurrentDutyDependentRoot:  phase0.Root{0x09},
+	})
+	attester = next(t, s).(*duties.AttesterDutiesNotification)
+	require.Equal(t, phase0.Epoch(13), attester.Epoch)
+	require.False(t, attester.Reorg)
+	require.Equal(t, phase0.Epoch(12), next(t, s).(*duties.ProposerDutiesNotification).Epoch)
+	attester = next(t, s).(*duties.AttesterDutiesNotification)
+	require.Equal(t, phase0.Epoch(12), attester.Epoch)
+	require.True(t, attester.Reorg)
+	requireNoNotification(t, s)
+
+	// Earlier epoch is ignored.
+	client.sendHead(&apiv1.HeadEvent{
+		Slot:                      340,
+		PreviousDutyDependentRoot: phase0.Root{0x06},
+		CurrentDutyDependentRoot:  phase0.Root{0x07},
+	})
+	requireNoNotification(t, s)
+
+	// Updating validator indices refreshes duties.
+	s.UpdateValidatorIndices([]phase0.ValidatorIndex{1, 2, 3})
+	attester = next(t, s).(*duties.AttesterDutiesNotification)
+	require.Equal(t, phase0.Epoch(12), attester.Epoch)
+	require.Len(t, attester.Duties, 3)
+	require.Equal(t, phase0.Epoch(13), next(t, s).(*duties.AttesterDutiesNotification).Epoch)
+	require.Equal(t, phase0.Epoch(12), next(t, s).(*duties.ProposerDutiesNotification).Epoch)
+	require.Equal(t, uint64(0), next(t, s).(*duties.SyncCommitteeDutiesNotification).Period)
+	requireNoNotification(t, s)
+
+	// Notifications channel is closed when the context is cancelled.
+	cancel()
+	select {
+	case _, open := <-s.Notifications():
+		require.False(t, open)
+	case <-time.After(time.Second):
+		require.FailNow(t, "notifications channel not closed")
+	}
+}
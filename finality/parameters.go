@@ -0,0 +1,88 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package finality
+
+import (
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel   zerolog.Level
+	client     consensusclient.Service
+	bufferSize int
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithClient sets the client from which finality is obtained.
+// The client must provide events and finality.
+func WithClient(client consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.client = client
+	})
+}
+
+// WithBufferSize sets the number of updates that can be queued before
+// the tracker waits for them to be read.
+func WithBufferSize(bufferSize int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.bufferSize = bufferSize
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:   zerolog.GlobalLevel(),
+		bufferSize: 16,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.client == nil {
+		return nil, errors.New("no client specified")
+	}
+	if _, isProvider := parameters.client.(consensusclient.EventsProvider); !isProvider {
+		return nil, errors.New("client does not provide events")
+	}
+	if _, isProvider := parameters.client.(consensusclient.FinalityProvider); !isProvider {
+		return nil, errors.New("client does not provide finality")
+	}
+	if parameters.bufferSize < 0 {
+		return nil, errors.New("buffer size cannot be negative")
+	}
+
+	return &parameters, nil
+}
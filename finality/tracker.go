@@ -0,0 +1,218 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package finality tracks the justified and finalized checkpoints of the chain.
+//
+// Finality is obtained when the tracker starts, and again whenever events show that it may
+// have changed: a finalized checkpoint event, a head event that crosses an epoch boundary or a
+// chain reorganisation.  Updates are only sent on the channel returned by Updates() when the
+// justified or finalized checkpoint actually changes.
+package finality
+
+import (
+	"context"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Update is a change in the justified or finalized checkpoint.
+type Update struct {
+	// Justified is the current justified checkpoint.
+	Justified *phase0.Checkpoint
+	// Finalized is the finalized checkpoint.
+	Finalized *phase0.Checkpoint
+	// JustifiedChanged is true if the justified checkpoint differs from that in the previous update.
+	JustifiedChanged bool
+	// FinalizedChanged is true if the finalized checkpoint differs from that in the previous update.
+	FinalizedChanged bool
+}
+
+// Tracker tracks the justified and finalized checkpoints.
+type Tracker struct {
+	log              zerolog.Logger
+	finalityProvider consensusclient.FinalityProvider
+
+	done    <-chan struct{}
+	updates chan *Update
+	refresh chan struct{}
+
+	mu        sync.RWMutex
+	justified *phase0.Checkpoint
+	finalized *phase0.Checkpoint
+}
+
+// New creates a new finality tracker.  Finality is obtained before it returns, and sent
+// as the first update.  The tracker runs until the context is cancelled, at which point
+// the updates channel is closed.
+func New(ctx context.Context, params ...Parameter) (*Tracker, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	log := zerologger.With().Str("service", "finality").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	t := &Tracker{
+		log:              log,
+		finalityProvider: parameters.client.(consensusclient.FinalityProvider),
+		done:             ctx.Done(),
+		updates:          make(chan *Update, parameters.bufferSize),
+		refresh:          make(chan struct{}, 1),
+	}
+
+	initial, err := t.obtainFinality(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain initial finality")
+	}
+
+	topics := []string{"head", "finalized_checkpoint", "chain_reorg"}
+	if err := parameters.client.(consensusclient.EventsProvider).Events(ctx, topics, t.handleEvent); err != nil {
+		return nil, errors.Wrap(err, "failed to subscribe to events")
+	}
+
+	go t.run(ctx, initial)
+
+	return t, nil
+}
+
+// Updates returns the channel on which finality updates are sent.
+// Updates should be read promptly, as the tracker waits for space in the channel
+// before processing further events.
+func (t *Tracker) Updates() <-chan *Update {
+	return t.updates
+}
+
+// Justified returns the latest known justified checkpoint.
+func (t *Tracker) Justified() *phase0.Checkpoint {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.justified
+}
+
+// Finalized returns the latest known finalized checkpoint.
+func (t *Tracker) Finalized() *phase0.Checkpoint {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.finalized
+}
+
+// handleEvent handles events from the client.
+func (t *Tracker) handleEvent(event *apiv1.Event) {
+	switch data := event.Data.(type) {
+	case *apiv1.HeadEvent:
+		if !data.EpochTransition {
+			return
+		}
+	case *apiv1.FinalizedCheckpointEvent, *apiv1.ChainReorgEvent:
+	default:
+		return
+	}
+
+	select {
+	case t.refresh <- struct{}{}:
+	default:
+		// Refresh already pending.
+	}
+}
+
+// run processes refreshes until the context is done.
+func (t *Tracker) run(ctx context.Context, initial *Update) {
+	defer close(t.updates)
+
+	if !t.send(ctx, initial) {
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.refresh:
+			update, err := t.obtainFinality(ctx)
+			if err != nil {
+				t.log.Warn().Err(err).Msg("Failed to obtain finality")
+				continue
+			}
+			if update == nil {
+				continue
+			}
+			if !t.send(ctx, update) {
+				return
+			}
+		}
+	}
+}
+
+// send sends an update, returning false if the context is done.
+func (t *Tracker) send(ctx context.Context, update *Update) bool {
+	select {
+	case t.updates <- update:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// obtainFinality obtains finality from the client, returning an update if it has changed.
+func (t *Tracker) obtainFinality(ctx context.Context) (*Update, error) {
+	finality, err := t.finalityProvider.Finality(ctx, "head")
+	if err != nil {
+		return nil, err
+	}
+	if finality == nil || finality.Justified == nil || finality.Finalized == nil {
+		return nil, errors.New("finality not returned")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.finalized != nil && finality.Finalized.Epoch < t.finalized.Epoch {
+		// Finality does not go backwards; this is a stale response.
+		t.log.Trace().Uint64("epoch", uint64(finality.Finalized.Epoch)).Msg("Finalized checkpoint earlier than current; ignoring")
+		return nil, nil
+	}
+
+	update := &Update{
+		Justified:        finality.Justified,
+		Finalized:        finality.Finalized,
+		JustifiedChanged: !checkpointsEqual(t.justified, finality.Justified),
+		FinalizedChanged: !checkpointsEqual(t.finalized, finality.Finalized),
+	}
+	if !update.JustifiedChanged && !update.FinalizedChanged {
+		return nil, nil
+	}
+	t.justified = finality.Justified
+	t.finalized = finality.Finalized
+
+	return update, nil
+}
+
+// checkpointsEqual returns true if the two checkpoints are equal.
+func checkpointsEqual(a *phase0.Checkpoint, b *phase0.Checkpoint) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Epoch == b.Epoch && a.Root == b.Root
+}
@@ -0,0 +1,207 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package finality_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/finality"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// finalityClient is a mock client that allows events to be sent to its subscribers
+// and the returned finality to be set.
+type finalityClient struct {
+	*mock.Service
+	mu       sync.Mutex
+	handlers []consensusclient.EventHandlerFunc
+	finality *apiv1.Finality
+}
+
+func (c *finalityClient) Events(_ context.Context, _ []string, handler consensusclient.EventHandlerFunc) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.handlers = append(c.handlers, handler)
+
+	return nil
+}
+
+func (c *finalityClient) Finality(_ context.Context, _ string) (*apiv1.Finality, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.finality, nil
+}
+
+func (c *finalityClient) setFinality(justified phase0.Epoch, finalized phase0.Epoch) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.finality = &apiv1.Finality{
+		Justified:         &phase0.Checkpoint{Epoch: justified, Root: phase0.Root{byte(justified)}},
+		PreviousJustified: &phase0.Checkpoint{Epoch: finalized, Root: phase0.Root{byte(finalized)}},
+		Finalized:         &phase0.Checkpoint{Epoch: finalized, Root: phase0.Root{byte(finalized)}},
+	}
+}
+
+func (c *finalityClient) send(event *apiv1.Event) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, handler := range c.handlers {
+		handler(event)
+	}
+}
+
+func newFinalityClient(ctx context.Context, t *testing.T) *finalityClient {
+	t.Helper()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+	c := &finalityClient{Service: client}
+	c.setFinality(10, 9)
+
+	return c
+}
+
+func TestNew(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := newFinalityClient(ctx, t)
+
+	tests := []struct {
+		name   string
+		params []finality.Parameter
+		err    string
+	}{
+		{
+			name: "ClientMissing",
+			err:  "problem with parameters: no client specified",
+		},
+		{
+			name: "BufferSizeNegative",
+			params: []finality.Parameter{
+				finality.WithClient(client),
+				finality.WithBufferSize(-1),
+			},
+			err: "problem with parameters: buffer size cannot be negative",
+		},
+		{
+			name: "Good",
+			params: []finality.Parameter{
+				finality.WithClient(client),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := finality.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func next(t *testing.T, tracker *finality.Tracker) *finality.Update {
+	t.Helper()
+
+	select {
+	case update := <-tracker.Updates():
+		return update
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for update")
+	}
+
+	return nil
+}
+
+func requireNoUpdate(t *testing.T, tracker *finality.Tracker) {
+	t.Helper()
+
+	select {
+	case update := <-tracker.Updates():
+		require.FailNow(t, "unexpected update", "%v", update)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestUpdates(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := newFinalityClient(ctx, t)
+	tracker, err := finality.New(ctx, finality.WithClient(client))
+	require.NoError(t, err)
+
+	// Initial update.
+	update := next(t, tracker)
+	require.Equal(t, phase0.Epoch(10), update.Justified.Epoch)
+	require.Equal(t, phase0.Epoch(9), update.Finalized.Epoch)
+	require.True(t, update.JustifiedChanged)
+	require.True(t, update.FinalizedChanged)
+	requireNoUpdate(t, tracker)
+
+	// Head event without an epoch transition does not trigger a refresh.
+	client.setFinality(11, 10)
+	client.send(&apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{Slot: 353}})
+	requireNoUpdate(t, tracker)
+	require.Equal(t, phase0.Epoch(10), tracker.Justified().Epoch)
+
+	// Head event with an epoch transition does.
+	client.send(&apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{Slot: 384, EpochTransition: true}})
+	update = next(t, tracker)
+	require.Equal(t, phase0.Epoch(11), update.Justified.Epoch)
+	require.Equal(t, phase0.Epoch(10), update.Finalized.Epoch)
+	require.True(t, update.JustifiedChanged)
+	require.True(t, update.FinalizedChanged)
+	requireNoUpdate(t, tracker)
+
+	// Unchanged finality is deduplicated.
+	client.send(&apiv1.Event{Topic: "finalized_checkpoint", Data: &apiv1.FinalizedCheckpointEvent{Epoch: 10}})
+	requireNoUpdate(t, tracker)
+
+	// Change of justified checkpoint alone.
+	client.setFinality(12, 10)
+	client.send(&apiv1.Event{Topic: "chain_reorg", Data: &apiv1.ChainReorgEvent{Slot: 390}})
+	update = next(t, tracker)
+	require.Equal(t, phase0.Epoch(12), update.Justified.Epoch)
+	require.True(t, update.JustifiedChanged)
+	require.False(t, update.FinalizedChanged)
+	requireNoUpdate(t, tracker)
+
+	// Finality going backwards is ignored.
+	client.setFinality(12, 8)
+	client.send(&apiv1.Event{Topic: "finalized_checkpoint", Data: &apiv1.FinalizedCheckpointEvent{Epoch: 8}})
+	requireNoUpdate(t, tracker)
+	require.Equal(t, phase0.Epoch(10), tracker.Finalized().Epoch)
+
+	// Updates channel is closed when the context is cancelled.
+	cancel()
+	select {
+	case _, open := <-tracker.Updates():
+		require.False(t, open)
+	case <-time.After(time.Second):
+		require.FailNow(t, "updates channel not closed")
+	}
+}
@@ -0,0 +1,93 @@
+// Code generated by genfullservice. DO NOT EDIT.
+
+package client
+
+// FullService is the interface for a client that provides all of the provider interfaces.
+// Most clients provide only a subset of the interfaces, so this is generally used to
+// check the completeness of a client implementation rather than as a type assertion;
+// use As or Supports to check for individual interfaces.
+type FullService interface {
+	Service
+	EpochFromStateIDProvider
+	SlotFromStateIDProvider
+	NodeVersionProvider
+	SlotDurationProvider
+	SlotsPerEpochProvider
+	FarFutureEpochProvider
+	GenesisValidatorsRootProvider
+	TargetAggregatorsPerCommitteeProvider
+	ValidatorIndexProvider
+	ValidatorPubKeyProvider
+	ValidatorIDProvider
+	DepositContractProvider
+	SignedBeaconBlockProvider
+	SignedBeaconBlockWithMetaProvider
+	BeaconBlockBlobsProvider
+	BlobSidecarsProvider
+	BeaconCommitteesProvider
+	SyncCommitteesProvider
+	AggregateAttestationProvider
+	AggregateAttestationsSubmitter
+	AggregateAttestationProviderV2
+	AggregateAttestationsSubmitterV2
+	AttestationDataProvider
+	AttestationPoolProvider
+	AttestationsSubmitter
+	AttestationsSubmitterV2
+	AttesterSlashingSubmitterV2
+	AttesterDutiesProvider
+	AttesterDutiesWithMetaProvider
+	SyncCommitteeDutiesProvider
+	SyncCommitteeMessagesSubmitter
+	SyncCommitteeSubscriptionsSubmitter
+	SyncCommitteeContributionProvider
+	SyncCommitteeContributionsSubmitter
+	BLSToExecutionChangesSubmitter
+	BeaconBlockHeadersProvider
+	BeaconBlockHeadersByFilterProvider
+	BeaconBlockHeadersWithMetaProvider
+	BeaconBlockHeadersBySlotRangeProvider
+	BeaconBlockProposalProvider
+	BeaconBlockRootProvider
+	BeaconBlockSubmitter
+	BeaconBlockSubmitterV2
+	BlockContentsSubmitter
+	BeaconCommitteeSubscriptionsSubmitter
+	BeaconStateProvider
+	BeaconStateRandaoProvider
+	BeaconStateRootProvider
+	StateRootProvider
+	HistoricalSummariesProvider
+	BlindedBeaconBlockProposalProvider
+	BlindedBeaconBlockSubmitter
+	BlindedBeaconBlockSubmitterV2
+	ValidatorRegistrationsSubmitter
+	DebugBeaconHeadsProvider
+	EventsProvider
+	TypedEventsProvider
+	FinalityProvider
+	FinalityWithMetaProvider
+	ForkProvider
+	ForkScheduleProvider
+	GenesisProvider
+	NodeIdentityProvider
+	NodeSyncingProvider
+	ProposalProvider
+	ProposalPreparationsSubmitter
+	ProposerDutiesProvider
+	ProposerDutiesWithMetaProvider
+	SpecProvider
+	SyncStateProvider
+	ValidatorBalancesProvider
+	ValidatorsProvider
+	VoluntaryExitSubmitter
+	VoluntaryExitPoolProvider
+	DomainProvider
+	GenesisTimeProvider
+	FeaturesProvider
+	NetworkProvider
+	SpecConfigProvider
+	NetworkProfileProvider
+	NodeClientProvider
+	ClientTypeProvider
+}
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
@@ -32,11 +33,11 @@ type aggregateAttestationDataJSON struct {
 func (s *Service) AggregateAttestation(ctx context.Context, slot phase0.Slot, attestationDataRoot phase0.Root) (*phase0.Attestation, error) {
 	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/validator/aggregate_attestation?slot=%d&attestation_data_root=%#x", slot, attestationDataRoot))
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			return nil, nil
+		}
 		return nil, errors.Wrap(err, "failed to request aggregate attestation")
 	}
-	if respBodyReader == nil {
-		return nil, nil
-	}
 
 	var aggregateAttestationDataJSON aggregateAttestationDataJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&aggregateAttestationDataJSON); err != nil {
@@ -47,17 +48,28 @@ func (s *Service) AggregateAttestation(ctx context.Context, slot phase0.Slot, at
 		return nil, nil
 	}
 
-	// Ensure the data returned to us is as expected given our input.
-	if aggregateAttestationDataJSON.Data.Data.Slot != slot {
-		return nil, errors.New("aggregate attestation not for requested slot")
+	if err := checkAggregateAttestation(aggregateAttestationDataJSON.Data, slot, attestationDataRoot); err != nil {
+		return nil, err
 	}
-	dataRoot, err := aggregateAttestationDataJSON.Data.Data.HashTreeRoot()
+
+	return aggregateAttestationDataJSON.Data, nil
+}
+
+// checkAggregateAttestation ensures the aggregate attestation returned to us is as expected given our input.
+func checkAggregateAttestation(attestation *phase0.Attestation, slot phase0.Slot, attestationDataRoot phase0.Root) error {
+	if attestation.Data == nil {
+		return errors.New("aggregate attestation missing data")
+	}
+	if attestation.Data.Slot != slot {
+		return errors.New("aggregate attestation not for requested slot")
+	}
+	dataRoot, err := attestation.Data.HashTreeRoot()
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to obtain hash tree root of aggregate attestation data")
+		return errors.Wrap(err, "failed to obtain hash tree root of aggregate attestation data")
 	}
 	if !bytes.Equal(dataRoot[:], attestationDataRoot[:]) {
-		return nil, errors.New("aggregate attestation not for requested data root")
+		return errors.New("aggregate attestation not for requested data root")
 	}
 
-	return aggregateAttestationDataJSON.Data, nil
+	return nil
 }
@@ -0,0 +1,131 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type aggregateAttestationV2JSON struct {
+	Data json.RawMessage `json:"data"`
+}
+
+// AggregateAttestationV2 fetches the aggregate attestation for the given attestation data root and committee.
+// If the beacon node does not provide the v2 endpoint the v1 endpoint is used, with the version
+// obtained from the fork schedule.
+// N.B if an aggregate attestation for the attestation is not available this will return nil without an error.
+func (s *Service) AggregateAttestationV2(ctx context.Context,
+	slot phase0.Slot,
+	attestationDataRoot phase0.Root,
+	committeeIndex phase0.CommitteeIndex,
+) (
+	*spec.VersionedAttestation,
+	error,
+) {
+	url := fmt.Sprintf("/eth/v2/validator/aggregate_attestation?slot=%d&attestation_data_root=%#x&committee_index=%d", slot, attestationDataRoot, committeeIndex)
+	respBodyReader, err := s.get(ctx, url)
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			// Either the beacon node does not provide the v2 endpoint or it has no aggregate; try v1.
+			return s.aggregateAttestationV1(ctx, slot, attestationDataRoot, committeeIndex)
+		}
+		return nil, errors.Wrap(err, "failed to request aggregate attestation")
+	}
+
+	var dataBodyReader bytes.Buffer
+	metadataReader := io.TeeReader(respBodyReader, &dataBodyReader)
+	var metadata responseMetadata
+	if err := json.NewDecoder(metadataReader).Decode(&metadata); err != nil {
+		return nil, errors.Wrap(err, "failed to parse response")
+	}
+	var resp aggregateAttestationV2JSON
+	if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse aggregate attestation")
+	}
+	if len(resp.Data) == 0 || bytes.Equal(resp.Data, []byte("null")) {
+		// Empty response is returned by some nodes if there is no matching aggregate.
+		return nil, nil
+	}
+
+	res := &spec.VersionedAttestation{
+		Version: metadata.Version,
+	}
+	switch metadata.Version {
+	case spec.DataVersionPhase0,
+		spec.DataVersionAltair,
+		spec.DataVersionBellatrix,
+		spec.DataVersionCapella,
+		spec.DataVersionDeneb:
+		attestation := &phase0.Attestation{}
+		if err := json.Unmarshal(resp.Data, attestation); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s aggregate attestation", metadata.Version)
+		}
+		if err := checkAggregateAttestation(attestation, slot, attestationDataRoot); err != nil {
+			return nil, err
+		}
+		if attestation.Data.Index != committeeIndex {
+			return nil, errors.New("aggregate attestation not for requested committee")
+		}
+		if err := res.SetAttestation(attestation); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported aggregate attestation version %s", metadata.Version)
+	}
+
+	return res, nil
+}
+
+// aggregateAttestationV1 fetches the aggregate attestation from the v1 endpoint and versions it.
+func (s *Service) aggregateAttestationV1(ctx context.Context,
+	slot phase0.Slot,
+	attestationDataRoot phase0.Root,
+	committeeIndex phase0.CommitteeIndex,
+) (
+	*spec.VersionedAttestation,
+	error,
+) {
+	attestation, err := s.AggregateAttestation(ctx, slot, attestationDataRoot)
+	if err != nil {
+		return nil, err
+	}
+	if attestation == nil {
+		return nil, nil
+	}
+	if attestation.Data.Index != committeeIndex {
+		return nil, errors.New("aggregate attestation not for requested committee")
+	}
+
+	version, err := s.dataVersionAtSlot(ctx, slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain aggregate attestation version")
+	}
+	res := &spec.VersionedAttestation{
+		Version: version,
+	}
+	if err := res.SetAttestation(attestation); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
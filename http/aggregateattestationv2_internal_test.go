@@ -0,0 +1,200 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateAttestationV2(t *testing.T) {
+	attestation := testSlotAttestation(40)
+	attestation.Data.Index = 2
+	attestation.Data.BeaconBlockRoot = phase0.Root{}
+	dataRoot, err := attestation.Data.HashTreeRoot()
+	require.NoError(t, err)
+	attestationJSON, err := json.Marshal(attestation)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		v2             string
+		v1             string
+		committeeIndex phase0.CommitteeIndex
+		version        spec.DataVersion
+		empty          bool
+		err            string
+	}{
+		{
+			name:           "V2",
+			v2:             fmt.Sprintf(`{"version":"altair","data":%s}`, attestationJSON),
+			committeeIndex: 2,
+			version:        spec.DataVersionAltair,
+		},
+		{
+			name:           "V2Empty",
+			v2:             `{"version":"altair","data":null}`,
+			committeeIndex: 2,
+			empty:          true,
+		},
+		{
+			name:           "V2WrongCommittee",
+			v2:             fmt.Sprintf(`{"version":"altair","data":%s}`, attestationJSON),
+			committeeIndex: 3,
+			err:            "aggregate attestation not for requested committee",
+		},
+		{
+			name:           "V2UnknownVersion",
+			v2:             fmt.Sprintf(`{"version":"future","data":%s}`, attestationJSON),
+			committeeIndex: 2,
+			err:            "failed to parse response: unrecognised data version \"future\"",
+		},
+		{
+			name:           "V1Fallback",
+			v1:             fmt.Sprintf(`{"data":%s}`, attestationJSON),
+			committeeIndex: 2,
+			version:        spec.DataVersionAltair,
+		},
+		{
+			name:           "NotFound",
+			committeeIndex: 2,
+			empty:          true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var response string
+				switch r.URL.Path {
+				case "/eth/v1/config/spec":
+					response = forkScheduleSpecJSON
+				case "/eth/v2/validator/aggregate_attestation":
+					require.Equal(t, fmt.Sprintf("%d", test.committeeIndex), r.URL.Query().Get("committee_index"))
+					response = test.v2
+				case "/eth/v1/validator/aggregate_attestation":
+					response = test.v1
+				}
+				if response == "" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = io.WriteString(w, response)
+			}))
+			defer srv.Close()
+
+			s := testService(t, srv)
+
+			res, err := s.AggregateAttestationV2(context.Background(), 40, dataRoot, test.committeeIndex)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			if test.empty {
+				require.Nil(t, res)
+				return
+			}
+			require.Equal(t, test.version, res.Version)
+			data, err := res.Data()
+			require.NoError(t, err)
+			require.Equal(t, phase0.Slot(40), data.Slot)
+		})
+	}
+}
+
+func TestSubmitAggregateAttestationsV2(t *testing.T) {
+	aggregateAndProof := &spec.VersionedSignedAggregateAndProof{
+		Version: spec.DataVersionDeneb,
+		Deneb: &phase0.SignedAggregateAndProof{
+			Message: &phase0.AggregateAndProof{
+				Aggregate: testSlotAttestation(40),
+			},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		v2                 bool
+		aggregateAndProofs []*spec.VersionedSignedAggregateAndProof
+		endpoints          []string
+		err                string
+	}{
+		{
+			name: "Empty",
+			err:  "no aggregate and proofs supplied",
+		},
+		{
+			name: "VersionMismatch",
+			aggregateAndProofs: []*spec.VersionedSignedAggregateAndProof{
+				aggregateAndProof,
+				{Version: spec.DataVersionCapella},
+			},
+			err: "aggregate and proof 1 has version capella; expected deneb",
+		},
+		{
+			name:               "WrongVersionField",
+			aggregateAndProofs: []*spec.VersionedSignedAggregateAndProof{{Version: spec.DataVersionDeneb}},
+			err:                "invalid aggregate and proof 0: no signed aggregate and proof",
+		},
+		{
+			name:               "V2",
+			v2:                 true,
+			aggregateAndProofs: []*spec.VersionedSignedAggregateAndProof{aggregateAndProof},
+			endpoints:          []string{"/eth/v2/validator/aggregate_and_proofs"},
+		},
+		{
+			name:               "V1Fallback",
+			aggregateAndProofs: []*spec.VersionedSignedAggregateAndProof{aggregateAndProof},
+			endpoints:          []string{"/eth/v2/validator/aggregate_and_proofs", "/eth/v1/validator/aggregate_and_proofs"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			endpoints := make([]string, 0)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				endpoints = append(endpoints, r.URL.Path)
+				if r.URL.Path == "/eth/v2/validator/aggregate_and_proofs" {
+					require.Equal(t, "deneb", r.Header.Get("Eth-Consensus-Version"))
+					if !test.v2 {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+				}
+			}))
+			defer srv.Close()
+
+			s := testService(t, srv)
+
+			err := s.SubmitAggregateAttestationsV2(context.Background(), test.aggregateAndProofs)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.Empty(t, endpoints)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.endpoints, endpoints)
+		})
+	}
+}
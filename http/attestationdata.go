@@ -27,14 +27,30 @@ type attestationDataJSON struct {
 }
 
 // AttestationData obtains attestation data for a slot.
+// If the attestation data cache is enabled, as set with WithAttestationDataCache, cached data is returned where available.
 func (s *Service) AttestationData(ctx context.Context, slot phase0.Slot, committeeIndex phase0.CommitteeIndex) (*phase0.AttestationData, error) {
+	if s.attestationDataCache == nil {
+		return s.attestationData(ctx, slot, committeeIndex)
+	}
+
+	if data := s.attestationDataCache.get(slot, committeeIndex); data != nil {
+		return data, nil
+	}
+	data, err := s.attestationData(ctx, slot, committeeIndex)
+	if err != nil {
+		return nil, err
+	}
+	s.attestationDataCache.set(slot, committeeIndex, data)
+
+	return data, nil
+}
+
+// attestationData obtains attestation data for a slot from the beacon node.
+func (s *Service) attestationData(ctx context.Context, slot phase0.Slot, committeeIndex phase0.CommitteeIndex) (*phase0.AttestationData, error) {
 	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/validator/attestation_data?slot=%d&committee_index=%d", slot, committeeIndex))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request attestation data")
 	}
-	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain attestation data")
-	}
 
 	var attestationDataJSON attestationDataJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&attestationDataJSON); err != nil {
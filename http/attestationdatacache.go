@@ -0,0 +1,102 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// attestationDataKey is the key for cached attestation data.
+type attestationDataKey struct {
+	slot           phase0.Slot
+	committeeIndex phase0.CommitteeIndex
+}
+
+// attestationDataEntry is an entry in the attestation data cache.
+type attestationDataEntry struct {
+	data    *phase0.AttestationData
+	expires time.Time
+}
+
+// attestationDataCache is a short-lived cache of attestation data.
+type attestationDataCache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[attestationDataKey]*attestationDataEntry
+}
+
+// newAttestationDataCache creates a new attestation data cache.
+func newAttestationDataCache(ttl time.Duration) *attestationDataCache {
+	return &attestationDataCache{
+		ttl:     ttl,
+		entries: make(map[attestationDataKey]*attestationDataEntry),
+	}
+}
+
+// get returns a copy of the cached attestation data for the slot and committee index, or nil if not present.
+func (c *attestationDataCache) get(slot phase0.Slot, committeeIndex phase0.CommitteeIndex) *phase0.AttestationData {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, exists := c.entries[attestationDataKey{slot: slot, committeeIndex: committeeIndex}]
+	if !exists || time.Now().After(entry.expires) {
+		return nil
+	}
+
+	return copyAttestationData(entry.data)
+}
+
+// set caches attestation data for the slot and committee index, removing any expired entries.
+func (c *attestationDataCache) set(slot phase0.Slot, committeeIndex phase0.CommitteeIndex, data *phase0.AttestationData) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for key, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, key)
+		}
+	}
+	c.entries[attestationDataKey{slot: slot, committeeIndex: committeeIndex}] = &attestationDataEntry{
+		data:    copyAttestationData(data),
+		expires: now.Add(c.ttl),
+	}
+}
+
+// clear removes all entries from the cache.
+func (c *attestationDataCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[attestationDataKey]*attestationDataEntry)
+}
+
+// copyAttestationData returns a copy of the attestation data, so that cached data cannot be
+// altered by callers.
+func copyAttestationData(data *phase0.AttestationData) *phase0.AttestationData {
+	res := *data
+	if data.Source != nil {
+		source := *data.Source
+		res.Source = &source
+	}
+	if data.Target != nil {
+		target := *data.Target
+		res.Target = &target
+	}
+
+	return &res
+}
@@ -0,0 +1,108 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttestationDataCache(t *testing.T) {
+	var requests atomic.Int32
+	heads := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/validator/attestation_data":
+			requests.Add(1)
+			_, _ = fmt.Fprintf(w, `{"data":{"slot":"%s","index":"%s","beacon_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","source":{"epoch":"1","root":"0x0202020202020202020202020202020202020202020202020202020202020202"},"target":{"epoch":"2","root":"0x0303030303030303030303030303030303030303030303030303030303030303"}}}`,
+				r.URL.Query().Get("slot"),
+				r.URL.Query().Get("committee_index"),
+			)
+		case "/eth/v1/events":
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+			for {
+				select {
+				case <-heads:
+					_, _ = fmt.Fprint(w, "event: head\ndata: {\"slot\":\"100\",\"block\":\"0x0404040404040404040404040404040404040404040404040404040404040404\",\"state\":\"0x0505050505050505050505050505050505050505050505050505050505050505\",\"epoch_transition\":false,\"previous_duty_dependent_root\":\"0x0606060606060606060606060606060606060606060606060606060606060606\",\"current_duty_dependent_root\":\"0x0707070707070707070707070707070707070707070707070707070707070707\"}\n\n")
+					w.(http.Flusher).Flush()
+				case <-r.Context().Done():
+					return
+				}
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := testService(t, srv)
+	s.attestationDataCache = newAttestationDataCache(time.Minute)
+	s.invalidationBus = newInvalidationBus(nil)
+	s.invalidationBus.onHead(s.attestationDataCache.clear)
+	require.NoError(t, s.invalidationBus.start(ctx, s))
+
+	data, err := s.AttestationData(ctx, 100, 2)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(100), data.Slot)
+	require.Equal(t, int32(1), requests.Load())
+
+	// Second request is served from the cache, and is not affected by changes to the first.
+	data.Source.Epoch = 10
+	data, err = s.AttestationData(ctx, 100, 2)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(1), data.Source.Epoch)
+	require.Equal(t, int32(1), requests.Load())
+
+	// Different committee index is not served from the cache.
+	_, err = s.AttestationData(ctx, 100, 3)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), requests.Load())
+
+	// Head event clears the cache.
+	heads <- struct{}{}
+	require.Eventually(t, func() bool {
+		s.attestationDataCache.mu.Lock()
+		defer s.attestationDataCache.mu.Unlock()
+		return len(s.attestationDataCache.entries) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	_, err = s.AttestationData(ctx, 100, 2)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), requests.Load())
+}
+
+func TestAttestationDataCacheExpiry(t *testing.T) {
+	cache := newAttestationDataCache(time.Millisecond)
+	cache.set(1, 0, &phase0.AttestationData{Slot: 1})
+	require.NotNil(t, cache.get(1, 0))
+
+	time.Sleep(5 * time.Millisecond)
+	require.Nil(t, cache.get(1, 0))
+
+	// Setting a new entry removes expired entries.
+	cache.set(2, 0, &phase0.AttestationData{Slot: 2})
+	require.Len(t, cache.entries, 1)
+}
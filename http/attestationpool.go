@@ -32,9 +32,6 @@ func (s *Service) AttestationPool(ctx context.Context, slot phase0.Slot) ([]*pha
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request attestation pool")
 	}
-	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain attestation pool")
-	}
 
 	var attestationPoolJSON attestationPoolJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&attestationPoolJSON); err != nil {
@@ -19,17 +19,85 @@ import (
 	"encoding/json"
 	"fmt"
 
-	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
 
 type attesterDutiesJSON struct {
-	Data []*api.AttesterDuty `json:"data"`
+	responseMetadata
+	Data []*apiv1.AttesterDuty `json:"data"`
 }
 
 // AttesterDuties obtains attester duties.
-func (s *Service) AttesterDuties(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*api.AttesterDuty, error) {
+// Requests with more validator indices than the duties index chunk size are split in to multiple
+// requests.  If some of these requests fail the duties from the successful requests are returned
+// without error, and a *PartialFailureError detailing the failed indices is passed to the partial
+// failure handlers.
+func (s *Service) AttesterDuties(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.AttesterDuty, error) {
+	resp, err := s.AttesterDutiesWithMeta(ctx, epoch, validatorIndices)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+// AttesterDutiesWithMeta obtains attester duties and their metadata.
+// Requests with more validator indices than the duties index chunk size are split in to multiple
+// requests.  If some of these requests fail the duties from the successful requests are returned
+// without error, and a *PartialFailureError detailing the failed indices is passed to the partial
+// failure handlers.  Requests for chunks with a
+// dependent root that differs from that of the first successful chunk are treated as failed, so
+// that all returned duties share the dependent root in the metadata.
+func (s *Service) AttesterDutiesWithMeta(ctx context.Context,
+	epoch phase0.Epoch,
+	validatorIndices []phase0.ValidatorIndex,
+) (
+	*api.Response[[]*apiv1.AttesterDuty],
+	error,
+) {
+	chunkSize := s.dutiesIndexChunkSize()
+	if len(validatorIndices) <= chunkSize {
+		return s.attesterDuties(ctx, epoch, validatorIndices)
+	}
+
+	var metadata *api.ResponseMetadata
+	duties, partialErr, err := chunkedIndexRequest(ctx, validatorIndices, chunkSize, func(ctx context.Context, chunk []phase0.ValidatorIndex) ([]*apiv1.AttesterDuty, error) {
+		resp, err := s.attesterDuties(ctx, epoch, chunk)
+		if err != nil {
+			return nil, err
+		}
+		if metadata == nil {
+			metadata = resp.Metadata
+		} else if !dependentRootsEqual(metadata.DependentRoot, resp.Metadata.DependentRoot) {
+			return nil, errors.New("dependent root changed between requests")
+		}
+
+		return resp.Data, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if partialErr != nil {
+		s.reportPartialFailure(ctx, partialErr)
+	}
+
+	return &api.Response[[]*apiv1.AttesterDuty]{
+		Data:     duties,
+		Metadata: metadata,
+	}, nil
+}
+
+// attesterDuties obtains attester duties with a single request.
+func (s *Service) attesterDuties(ctx context.Context,
+	epoch phase0.Epoch,
+	validatorIndices []phase0.ValidatorIndex,
+) (
+	*api.Response[[]*apiv1.AttesterDuty],
+	error,
+) {
 	var reqBodyReader bytes.Buffer
 	if _, err := reqBodyReader.WriteString(`[`); err != nil {
 		return nil, errors.Wrap(err, "failed to write validator index array start")
@@ -61,5 +129,17 @@ func (s *Service) AttesterDuties(ctx context.Context, epoch phase0.Epoch, valida
 		return nil, errors.Wrap(err, "failed to parse attester duties response")
 	}
 
-	return resp.Data, nil
+	return &api.Response[[]*apiv1.AttesterDuty]{
+		Data:     resp.Data,
+		Metadata: resp.apiMetadata(),
+	}, nil
+}
+
+// dependentRootsEqual returns true if the two dependent roots are equal, or both absent.
+func dependentRootsEqual(root1 *phase0.Root, root2 *phase0.Root) bool {
+	if root1 == nil || root2 == nil {
+		return root1 == root2
+	}
+
+	return *root1 == *root2
 }
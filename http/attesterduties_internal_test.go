@@ -0,0 +1,106 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttesterDutiesWithMeta(t *testing.T) {
+	tests := []struct {
+		name string
+		// dependentRoots are the dependent roots returned for each request.
+		dependentRoots []string
+		indices        []phase0.ValidatorIndex
+		duties         int
+		failed         []phase0.ValidatorIndex
+	}{
+		{
+			name:           "Single",
+			dependentRoots: []string{"0x01"},
+			indices:        []phase0.ValidatorIndex{1, 2},
+			duties:         2,
+		},
+		{
+			name:           "ChunkedMatching",
+			dependentRoots: []string{"0x01", "0x01", "0x01"},
+			indices:        []phase0.ValidatorIndex{1, 2, 3, 4, 5},
+			duties:         5,
+		},
+		{
+			name:           "ChunkedMismatch",
+			dependentRoots: []string{"0x01", "0x02", "0x01"},
+			indices:        []phase0.ValidatorIndex{1, 2, 3, 4, 5},
+			duties:         3,
+			failed:         []phase0.ValidatorIndex{3, 4},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var requests int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/eth/v1/validator/duties/attester/10", r.URL.Path)
+				request := atomic.AddInt32(&requests, 1)
+				var indices []string
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&indices))
+				duties := make([]string, 0, len(indices))
+				for _, index := range indices {
+					duties = append(duties, fmt.Sprintf(`{"pubkey":"0x%s","validator_index":"%s","committee_index":"0","committee_length":"1","committees_at_slot":"1","validator_committee_index":"0","slot":"320"}`, strings.Repeat("00", 48), index))
+				}
+				dependentRoot := test.dependentRoots[request-1] + strings.Repeat("00", 31)
+				_, _ = fmt.Fprintf(w, `{"dependent_root":"%s","execution_optimistic":false,"data":[%s]}`, dependentRoot, strings.Join(duties, ","))
+			}))
+			defer srv.Close()
+
+			var partialErr *PartialFailureError
+			s := testService(t, srv)
+			s.userDutiesIndexChunkSize = 2
+			s.partialFailureHandlers = []PartialFailureHandlerFunc{
+				func(_ context.Context, err *PartialFailureError) {
+					partialErr = err
+				},
+			}
+
+			// Partial failures return the available duties without error.
+			resp, err := s.AttesterDutiesWithMeta(context.Background(), 10, test.indices)
+			require.NoError(t, err)
+			if test.failed == nil {
+				require.Nil(t, partialErr)
+			} else {
+				require.NotNil(t, partialErr)
+				require.Equal(t, test.failed, partialErr.FailedIndices())
+			}
+			require.NotNil(t, resp)
+			require.Len(t, resp.Data, test.duties)
+			require.Equal(t, phase0.Root{0x01}, *resp.Metadata.DependentRoot)
+
+			// The data-only call returns the same duties.
+			atomic.StoreInt32(&requests, 0)
+			duties, err := s.AttesterDuties(context.Background(), 10, test.indices)
+			require.NoError(t, err)
+			require.Equal(t, resp.Data, duties)
+		})
+	}
+}
@@ -19,8 +19,10 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type beaconBlockBlobsJSON struct {
@@ -29,13 +31,16 @@ type beaconBlockBlobsJSON struct {
 
 // BeaconBlockBlobs fetches the blobs given a block ID.
 func (s *Service) BeaconBlockBlobs(ctx context.Context, blockID string) ([]*deneb.BlobSidecar, error) {
+	ctx, span := s.startSpan(ctx, "BeaconBlockBlobs", attribute.String("block_id", blockID))
+	defer span.End()
+
 	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/blob_sidecars/%s", blockID))
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			return nil, nil
+		}
 		return nil, errors.Wrap(err, "failed to request blobs")
 	}
-	if respBodyReader == nil {
-		return nil, nil
-	}
 
 	var resp beaconBlockBlobsJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
@@ -18,28 +18,55 @@ import (
 	"encoding/json"
 	"fmt"
 
-	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type beaconBlockHeaderJSON struct {
-	Data *api.BeaconBlockHeader `json:"data"`
+	responseMetadata
+	Data *apiv1.BeaconBlockHeader `json:"data"`
 }
 
 // BeaconBlockHeader provides the block header of a given block ID.
-func (s *Service) BeaconBlockHeader(ctx context.Context, blockID string) (*api.BeaconBlockHeader, error) {
-	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/headers/%s", blockID))
+func (s *Service) BeaconBlockHeader(ctx context.Context, blockID string) (*apiv1.BeaconBlockHeader, error) {
+	resp, err := s.BeaconBlockHeaderWithMeta(ctx, blockID)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to request beacon block header")
+		return nil, err
 	}
-	if respBodyReader == nil {
+	if resp == nil {
 		return nil, nil
 	}
 
+	return resp.Data, nil
+}
+
+// BeaconBlockHeaderWithMeta provides the block header and its metadata of a given block ID.
+func (s *Service) BeaconBlockHeaderWithMeta(ctx context.Context,
+	blockID string,
+) (
+	*api.Response[*apiv1.BeaconBlockHeader],
+	error,
+) {
+	ctx, span := s.startSpan(ctx, "BeaconBlockHeaderWithMeta", attribute.String("block_id", blockID))
+	defer span.End()
+
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/headers/%s", blockID))
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to request beacon block header")
+	}
+
 	var resp beaconBlockHeaderJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
 		return nil, errors.Wrap(err, "failed to parse beacon block header")
 	}
 
-	return resp.Data, nil
+	return &api.Response[*apiv1.BeaconBlockHeader]{
+		Data:     resp.Data,
+		Metadata: resp.apiMetadata(),
+	}, nil
 }
@@ -0,0 +1,98 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type beaconBlockHeadersJSON struct {
+	Data []*apiv1.BeaconBlockHeader `json:"data"`
+}
+
+// BeaconBlockHeaders provides the block headers matching the given filters.
+// If slot is supplied only headers for that slot are returned; if parentRoot is supplied only
+// headers of the children of that block are returned.  If neither is supplied the header of the
+// canonical head is returned.
+func (s *Service) BeaconBlockHeaders(ctx context.Context,
+	slot *phase0.Slot,
+	parentRoot *phase0.Root,
+) (
+	[]*apiv1.BeaconBlockHeader,
+	error,
+) {
+	ctx, span := s.startSpan(ctx, "BeaconBlockHeaders")
+	defer span.End()
+	if slot != nil {
+		span.SetAttributes(attribute.Int64("slot", int64(*slot)))
+	}
+	if parentRoot != nil {
+		span.SetAttributes(attribute.String("parent_root", fmt.Sprintf("%#x", *parentRoot)))
+	}
+
+	return s.beaconBlockHeaders(ctx, slot, parentRoot)
+}
+
+// beaconBlockHeaders fetches the block headers matching the given filters.
+func (s *Service) beaconBlockHeaders(ctx context.Context,
+	slot *phase0.Slot,
+	parentRoot *phase0.Root,
+) (
+	[]*apiv1.BeaconBlockHeader,
+	error,
+) {
+	query := url.Values{}
+	if slot != nil {
+		query.Set("slot", fmt.Sprintf("%d", *slot))
+	}
+	if parentRoot != nil {
+		query.Set("parent_root", fmt.Sprintf("%#x", *parentRoot))
+	}
+	endpoint := "/eth/v1/beacon/headers"
+	if len(query) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, query.Encode())
+	}
+
+	respBodyReader, err := s.get(ctx, endpoint)
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			return []*apiv1.BeaconBlockHeader{}, nil
+		}
+		return nil, errors.Wrap(err, "failed to request beacon block headers")
+	}
+
+	var resp beaconBlockHeadersJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse beacon block headers")
+	}
+	for _, header := range resp.Data {
+		if header == nil || header.Header == nil || header.Header.Message == nil {
+			return nil, errors.New("beacon block header missing message")
+		}
+	}
+	if resp.Data == nil {
+		return []*apiv1.BeaconBlockHeader{}, nil
+	}
+
+	return resp.Data, nil
+}
@@ -0,0 +1,126 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconBlockHeaders(t *testing.T) {
+	parentRoot := phase0.Root{0x01, 0x02}
+	slot := phase0.Slot(5)
+
+	tests := []struct {
+		name       string
+		slot       *phase0.Slot
+		parentRoot *phase0.Root
+		query      string
+		status     int
+		headers    int
+		err        string
+	}{
+		{
+			name:    "NoFilter",
+			query:   "",
+			headers: 1,
+		},
+		{
+			name:    "Slot",
+			slot:    &slot,
+			query:   "slot=5",
+			headers: 1,
+		},
+		{
+			name:       "ParentRoot",
+			parentRoot: &parentRoot,
+			query:      "parent_root=0x0102000000000000000000000000000000000000000000000000000000000000",
+			headers:    2,
+		},
+		{
+			name:       "SlotAndParentRoot",
+			slot:       &slot,
+			parentRoot: &parentRoot,
+			query:      "parent_root=0x0102000000000000000000000000000000000000000000000000000000000000&slot=5",
+			headers:    2,
+		},
+		{
+			name:       "NotFound",
+			parentRoot: &parentRoot,
+			query:      "parent_root=0x0102000000000000000000000000000000000000000000000000000000000000",
+			status:     http.StatusNotFound,
+		},
+		{
+			name:   "Error",
+			status: http.StatusInternalServerError,
+			err:    "failed to request beacon block headers: GET failed with status 500: ",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				require.Equal(t, "/eth/v1/beacon/headers", req.URL.Path)
+				require.Equal(t, test.query, req.URL.RawQuery)
+				if test.status != 0 {
+					w.WriteHeader(test.status)
+					return
+				}
+
+				headers := make([]*apiv1.BeaconBlockHeader, 0, test.headers)
+				for i := 0; i < test.headers; i++ {
+					header := testHeader(slot, i == 0)
+					header.Header.Message.ParentRoot = parentRoot
+					headers = append(headers, header)
+				}
+				data, err := json.Marshal(headers)
+				require.NoError(t, err)
+				_, _ = w.Write([]byte(`{"data":`))
+				_, _ = w.Write(data)
+				_, _ = w.Write([]byte(`}`))
+			}))
+			defer srv.Close()
+
+			s := testService(t, srv)
+			headers, err := s.BeaconBlockHeaders(context.Background(), test.slot, test.parentRoot)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, headers, test.headers)
+			for _, header := range headers {
+				require.Equal(t, parentRoot, header.Header.Message.ParentRoot)
+			}
+		})
+	}
+}
+
+func TestBeaconBlockHeadersMissingMessage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[{"root":"0x0000000000000000000000000000000000000000000000000000000000000000","canonical":true}]}`))
+	}))
+	defer srv.Close()
+
+	s := testService(t, srv)
+	_, err := s.BeaconBlockHeaders(context.Background(), nil, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,121 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"sync"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// BeaconBlockHeadersBySlotRange provides the canonical block headers for the slots from startSlot to endSlot inclusive.
+// The result contains an entry for every slot in the range, in slot order; slots without a block are marked as missed.
+// Headers are fetched concurrently, up to the limit set with WithHeaderRangeConcurrency.
+func (s *Service) BeaconBlockHeadersBySlotRange(ctx context.Context,
+	startSlot phase0.Slot,
+	endSlot phase0.Slot,
+) (
+	[]*apiv1.SlotBeaconBlockHeader,
+	error,
+) {
+	if endSlot < startSlot {
+		return nil, errors.New("end slot before start slot")
+	}
+
+	ctx, span := s.startSpan(ctx, "BeaconBlockHeadersBySlotRange",
+		attribute.Int64("start_slot", int64(startSlot)),
+		attribute.Int64("end_slot", int64(endSlot)),
+	)
+	defer span.End()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	res := make([]*apiv1.SlotBeaconBlockHeader, int(endSlot-startSlot)+1)
+	slots := make(chan phase0.Slot)
+	var firstErr error
+	var errMu sync.Mutex
+	var wg sync.WaitGroup
+	workers := s.headerRangeConcurrency
+	if workers > len(res) {
+		workers = len(res)
+	}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for slot := range slots {
+				header, err := s.canonicalBeaconBlockHeader(ctx, slot)
+				if err != nil {
+					errMu.Lock()
+					if firstErr == nil {
+						firstErr = errors.Wrapf(err, "failed to obtain header for slot %d", slot)
+						cancel()
+					}
+					errMu.Unlock()
+					continue
+				}
+				res[slot-startSlot] = &apiv1.SlotBeaconBlockHeader{
+					Slot:   slot,
+					Header: header,
+				}
+			}
+		}()
+	}
+
+	for slot := startSlot; slot <= endSlot && ctx.Err() == nil; slot++ {
+		slots <- slot
+		if slot == endSlot {
+			// Avoid overflow at the maximum slot.
+			break
+		}
+	}
+	close(slots)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// canonicalBeaconBlockHeader returns the canonical block header for a slot, or nil if the slot was missed.
+func (s *Service) canonicalBeaconBlockHeader(ctx context.Context, slot phase0.Slot) (*apiv1.BeaconBlockHeader, error) {
+	headers, err := s.beaconBlockHeaders(ctx, &slot, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, header := range headers {
+		if !header.Canonical {
+			continue
+		}
+		if header.Header.Message.Slot != slot {
+			// Nodes can return the header of the most recent block for a missed slot.
+			continue
+		}
+
+		return header, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,147 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// headersServer is a test server that serves headers by slot.
+// Every third slot is missed, and the slot after a missed slot also has a non-canonical header.
+type headersServer struct {
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+	// failSlot is a slot for which the server returns an error, if set.
+	failSlot *phase0.Slot
+}
+
+func testHeader(slot phase0.Slot, canonical bool) *apiv1.BeaconBlockHeader {
+	return &apiv1.BeaconBlockHeader{
+		Root:      phase0.Root{byte(slot), byte(slot >> 8)},
+		Canonical: canonical,
+		Header: &phase0.SignedBeaconBlockHeader{
+			Message: &phase0.BeaconBlockHeader{
+				Slot: slot,
+			},
+		},
+	}
+}
+
+func (h *headersServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	h.mu.Lock()
+	h.inFlight++
+	if h.inFlight > h.maxInFlight {
+		h.maxInFlight = h.inFlight
+	}
+	h.mu.Unlock()
+	defer func() {
+		h.mu.Lock()
+		h.inFlight--
+		h.mu.Unlock()
+	}()
+	// Give other requests a chance to arrive.
+	time.Sleep(5 * time.Millisecond)
+
+	tmp, err := strconv.ParseUint(req.URL.Query().Get("slot"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	slot := phase0.Slot(tmp)
+	if h.failSlot != nil && *h.failSlot == slot {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	headers := make([]*apiv1.BeaconBlockHeader, 0)
+	switch {
+	case slot%3 == 0:
+		// Missed slot; some nodes return the header of the previous block.
+		if slot > 0 {
+			headers = append(headers, testHeader(slot-1, true))
+		}
+	case slot%3 == 1:
+		headers = append(headers, testHeader(slot, false), testHeader(slot, true))
+	default:
+		headers = append(headers, testHeader(slot, true))
+	}
+
+	data, err := json.Marshal(&beaconBlockHeadersJSON{Data: headers})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	_, _ = w.Write(data)
+}
+
+func TestBeaconBlockHeadersBySlotRange(t *testing.T) {
+	ctx := context.Background()
+
+	handler := &headersServer{}
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+	s := testService(t, srv)
+	s.headerRangeConcurrency = 4
+
+	headers, err := s.BeaconBlockHeadersBySlotRange(ctx, 10, 29)
+	require.NoError(t, err)
+	require.Len(t, headers, 20)
+	for i, header := range headers {
+		slot := phase0.Slot(10 + i)
+		require.Equal(t, slot, header.Slot)
+		if slot%3 == 0 {
+			require.True(t, header.Missed())
+			continue
+		}
+		require.False(t, header.Missed())
+		require.True(t, header.Header.Canonical)
+		require.Equal(t, slot, header.Header.Header.Message.Slot)
+	}
+	require.LessOrEqual(t, handler.maxInFlight, 4)
+	require.Greater(t, handler.maxInFlight, 1)
+
+	// Single slot.
+	headers, err = s.BeaconBlockHeadersBySlotRange(ctx, 11, 11)
+	require.NoError(t, err)
+	require.Len(t, headers, 1)
+	require.False(t, headers[0].Missed())
+}
+
+func TestBeaconBlockHeadersBySlotRangeErrors(t *testing.T) {
+	ctx := context.Background()
+
+	failSlot := phase0.Slot(15)
+	srv := httptest.NewServer(&headersServer{failSlot: &failSlot})
+	defer srv.Close()
+	s := testService(t, srv)
+	s.headerRangeConcurrency = 4
+
+	_, err := s.BeaconBlockHeadersBySlotRange(ctx, 20, 10)
+	require.EqualError(t, err, "end slot before start slot")
+
+	_, err = s.BeaconBlockHeadersBySlotRange(ctx, 10, 29)
+	require.ErrorContains(t, err, "failed to obtain header for slot 15")
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
@@ -50,10 +51,25 @@ type denebBeaconBlockProposalJSON struct {
 }
 
 // BeaconBlockProposal fetches a proposed beacon block for signing.
+// If graffiti is nil the service default graffiti, as set with WithGraffiti, is used.
+// If the v3 proposals feature is enabled the v3 proposal endpoint is used where it returns a full block.
 func (s *Service) BeaconBlockProposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*spec.VersionedBeaconBlock, error) {
-	// Graffiti should be 32 bytes.
-	fixedGraffiti := make([]byte, 32)
-	copy(fixedGraffiti, graffiti)
+	fixedGraffiti, err := s.proposalGraffiti(graffiti)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.FeatureEnabled(api.FeatureV3Proposals) {
+		proposal, err := s.proposal(ctx, slot, randaoReveal, fixedGraffiti)
+		switch {
+		case err != nil:
+			s.log.Debug().Err(err).Msg("Failed to obtain v3 proposal; falling back to v2")
+		case proposal.Blinded:
+			s.log.Debug().Msg("v3 proposal is blinded; falling back to v2")
+		default:
+			return beaconBlockFromProposal(proposal), nil
+		}
+	}
 
 	return s.beaconBlockProposal(ctx, slot, randaoReveal, fixedGraffiti)
 }
@@ -65,9 +81,6 @@ func (s *Service) beaconBlockProposal(ctx context.Context, slot phase0.Slot, ran
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request beacon block proposal")
 	}
-	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain beacon block proposal")
-	}
 
 	var dataBodyReader bytes.Buffer
 	metadataReader := io.TeeReader(respBodyReader, &dataBodyReader)
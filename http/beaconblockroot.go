@@ -20,8 +20,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type beaconBlockRootJSON struct {
@@ -35,13 +37,16 @@ type beaconBlockRootDataJSON struct {
 // BeaconBlockRoot fetches a block's root given a block ID.
 // N.B if a signed beacon block for the block ID is not available this will return nil without an error.
 func (s *Service) BeaconBlockRoot(ctx context.Context, blockID string) (*phase0.Root, error) {
+	ctx, span := s.startSpan(ctx, "BeaconBlockRoot", attribute.String("block_id", blockID))
+	defer span.End()
+
 	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/blocks/%s/root", blockID))
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			return nil, nil
+		}
 		return nil, errors.Wrap(err, "failed to request beacon block root")
 	}
-	if respBodyReader == nil {
-		return nil, nil
-	}
 
 	var beaconBlockRootJSON beaconBlockRootJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&beaconBlockRootJSON); err != nil {
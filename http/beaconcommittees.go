@@ -21,6 +21,7 @@ import (
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type beaconCommitteesJSON struct {
@@ -29,14 +30,14 @@ type beaconCommitteesJSON struct {
 
 // BeaconCommittees fetches all beacon committees for the epoch at the given state.
 func (s *Service) BeaconCommittees(ctx context.Context, stateID string) ([]*api.BeaconCommittee, error) {
+	ctx, span := s.startSpan(ctx, "BeaconCommittees", attribute.String("state_id", stateID))
+	defer span.End()
+
 	url := fmt.Sprintf("/eth/v1/beacon/states/%s/committees", stateID)
 	respBodyReader, err := s.get(ctx, url)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request beacon committees")
 	}
-	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain beacon committees")
-	}
 
 	var resp beaconCommitteesJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
@@ -48,14 +49,14 @@ func (s *Service) BeaconCommittees(ctx context.Context, stateID string) ([]*api.
 
 // BeaconCommitteesAtEpoch fetches all beacon committees for the given epoch at the given state.
 func (s *Service) BeaconCommitteesAtEpoch(ctx context.Context, stateID string, epoch phase0.Epoch) ([]*api.BeaconCommittee, error) {
+	ctx, span := s.startSpan(ctx, "BeaconCommitteesAtEpoch", attribute.String("state_id", stateID))
+	defer span.End()
+
 	url := fmt.Sprintf("/eth/v1/beacon/states/%s/committees?epoch=%d", stateID, epoch)
 	respBodyReader, err := s.get(ctx, url)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request beacon committees")
 	}
-	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain beacon committees")
-	}
 
 	var resp beaconCommitteesJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
@@ -14,12 +14,14 @@
 package http
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime"
+	"net/http"
 
+	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
@@ -27,82 +29,205 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
-type phase0BeaconStateJSON struct {
-	Data *phase0.BeaconState `json:"data"`
-}
+// beaconStateAccept is the Accept header for beacon state requests that prefer SSZ.
+const beaconStateAccept = "application/octet-stream;q=1,application/json;q=0.9"
 
-type altairBeaconStateJSON struct {
-	Data *altair.BeaconState `json:"data"`
+// sszUnmarshaler is implemented by containers that can be decoded from SSZ.
+type sszUnmarshaler interface {
+	UnmarshalSSZ(buf []byte) error
 }
 
-type bellatrixBeaconStateJSON struct {
-	Data *bellatrix.BeaconState `json:"data"`
-}
+// BeaconState fetches a beacon state.
+// N.B if the requested beacon state is not available this will return nil without an error.
+// If a state store has been supplied with WithStateStore it is used to serve the state where possible.
+func (s *Service) BeaconState(ctx context.Context, stateID string) (*spec.VersionedBeaconState, error) {
+	ctx, span := s.startSpan(ctx, "BeaconState", attribute.String("state_id", stateID))
+	defer span.End()
 
-type capellaBeaconStateJSON struct {
-	Data *capella.BeaconState `json:"data"`
-}
+	if s.stateStore != nil {
+		return s.storedBeaconState(ctx, stateID)
+	}
 
-type denebBeaconStateJSON struct {
-	Data *deneb.BeaconState `json:"data"`
+	return s.beaconState(ctx, stateID)
 }
 
-// BeaconState fetches a beacon state.
-// N.B if the requested beacon state is not available this will return nil without an error.
-func (s *Service) BeaconState(ctx context.Context, stateID string) (*spec.VersionedBeaconState, error) {
+// beaconState fetches a beacon state from the beacon node.
+// SSZ is requested unless JSON is enforced or reduced memory is enabled, and in either case the
+// state is decoded directly from the response body rather than from an in-memory copy of it.
+func (s *Service) beaconState(ctx context.Context, stateID string) (*spec.VersionedBeaconState, error) {
 	url := fmt.Sprintf("/eth/v2/debug/beacon/states/%s", stateID)
-	respBodyReader, err := s.get(ctx, url)
+	accept := beaconStateAccept
+	if s.enforceJSON || s.FeatureEnabled(api.FeatureReducedMemory) {
+		// SSZ requires the full response in memory before it can be decoded, whereas JSON can be
+		// decoded as it arrives.
+		accept = contentTypeJSON.MediaType()
+	}
+
+	var res *spec.VersionedBeaconState
+	err := s.getStream(ctx, url, accept, func(resp *http.Response) error {
+		var err error
+		if isSSZResponse(resp) {
+			res, err = decodeBeaconStateSSZ(resp)
+		} else {
+			res, err = decodeBeaconStateJSON(resp.Body)
+		}
+
+		return err
+	})
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			return nil, nil
+		}
 		return nil, errors.Wrap(err, "failed to request beacon state")
 	}
-	if respBodyReader == nil {
-		return nil, nil
+
+	return res, nil
+}
+
+// isSSZResponse returns true if the response body is SSZ-encoded.
+func isSSZResponse(resp *http.Response) bool {
+	mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil {
+		return false
 	}
 
-	var dataBodyReader bytes.Buffer
-	metadataReader := io.TeeReader(respBodyReader, &dataBodyReader)
-	var metadata responseMetadata
-	if err := json.NewDecoder(metadataReader).Decode(&metadata); err != nil {
-		return nil, errors.Wrap(err, "failed to parse response")
+	return mediaType == contentTypeSSZ.MediaType()
+}
+
+// decodeBeaconStateSSZ decodes an SSZ-encoded beacon state, taking its version from the response headers.
+func decodeBeaconStateSSZ(resp *http.Response) (*spec.VersionedBeaconState, error) {
+	versionHeader := resp.Header.Get("Eth-Consensus-Version")
+	if versionHeader == "" {
+		return nil, errors.New("SSZ response does not contain a consensus version")
 	}
-	res := &spec.VersionedBeaconState{
-		Version: metadata.Version,
+	var version spec.DataVersion
+	if err := json.Unmarshal([]byte(fmt.Sprintf("%q", versionHeader)), &version); err != nil {
+		return nil, errors.Wrap(err, "failed to parse consensus version")
 	}
 
-	switch metadata.Version {
-	case spec.DataVersionPhase0:
-		var resp phase0BeaconStateJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
-			return nil, errors.Wrap(err, "failed to parse phase 0 beacon state")
+	res, container, err := newVersionedBeaconState(version)
+	if err != nil {
+		return nil, err
+	}
+
+	// Size the buffer up front where possible, to avoid repeated growth for a large state.
+	var data []byte
+	if resp.ContentLength > 0 {
+		data = make([]byte, resp.ContentLength)
+		_, err = io.ReadFull(resp.Body, data)
+	} else {
+		data, err = io.ReadAll(resp.Body)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read beacon state")
+	}
+
+	unmarshaler, isUnmarshaler := container.(sszUnmarshaler)
+	if !isUnmarshaler {
+		return nil, fmt.Errorf("%s beacon state does not support SSZ", version)
+	}
+	if err := unmarshaler.UnmarshalSSZ(data); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s beacon state", version)
+	}
+
+	return res, nil
+}
+
+// decodeBeaconStateJSON decodes a JSON-encoded beacon state response as it is read.
+// If the version precedes the data, as is usual, the data is decoded directly into the state;
+// otherwise the data is held until the version is known.
+func decodeBeaconStateJSON(body io.Reader) (*spec.VersionedBeaconState, error) {
+	decoder := json.NewDecoder(body)
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return nil, errors.New("failed to parse response: not a JSON object")
+	}
+
+	var version spec.DataVersion
+	haveVersion := false
+	var res *spec.VersionedBeaconState
+	var rawData json.RawMessage
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse response")
 		}
-		res.Phase0 = resp.Data
-	case spec.DataVersionAltair:
-		var resp altairBeaconStateJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
-			return nil, errors.Wrap(err, "failed to parse altair beacon state")
+		key, isString := token.(string)
+		if !isString {
+			return nil, errors.New("failed to parse response: invalid key")
 		}
-		res.Altair = resp.Data
-	case spec.DataVersionBellatrix:
-		var resp bellatrixBeaconStateJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
-			return nil, errors.Wrap(err, "failed to parse bellatrix beacon state")
+
+		switch {
+		case key == "version":
+			if err := decoder.Decode(&version); err != nil {
+				return nil, errors.Wrap(err, "failed to parse response")
+			}
+			haveVersion = true
+		case key == "data" && haveVersion:
+			var container any
+			res, container, err = newVersionedBeaconState(version)
+			if err != nil {
+				return nil, err
+			}
+			if err := decoder.Decode(container); err != nil {
+				return nil, errors.Wrapf(err, "failed to parse %s beacon state", version)
+			}
+		case key == "data":
+			if err := decoder.Decode(&rawData); err != nil {
+				return nil, errors.Wrap(err, "failed to parse response")
+			}
+		default:
+			if err := decoder.Decode(&json.RawMessage{}); err != nil {
+				return nil, errors.Wrap(err, "failed to parse response")
+			}
 		}
-		res.Bellatrix = resp.Data
-	case spec.DataVersionCapella:
-		var resp capellaBeaconStateJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
-			return nil, errors.Wrap(err, "failed to parse capella beacon state")
+	}
+
+	if res == nil && rawData != nil {
+		// Data arrived before the version.  A missing version is treated as phase 0.
+		res, container, err := newVersionedBeaconState(version)
+		if err != nil {
+			return nil, err
 		}
-		res.Capella = resp.Data
-	case spec.DataVersionDeneb:
-		var resp denebBeaconStateJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
-			return nil, errors.Wrap(err, "failed to parse deneb beacon state")
+		if err := json.Unmarshal(rawData, container); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s beacon state", version)
 		}
-		res.Deneb = resp.Data
+
+		return res, nil
+	}
+	if res == nil {
+		return nil, errors.New("beacon state response contains no data")
 	}
 
 	return res, nil
 }
+
+// newVersionedBeaconState returns a versioned beacon state with an empty state container
+// for the given version, along with that container for decoding.
+func newVersionedBeaconState(version spec.DataVersion) (*spec.VersionedBeaconState, any, error) {
+	res := &spec.VersionedBeaconState{
+		Version: version,
+	}
+
+	switch version {
+	case spec.DataVersionPhase0:
+		res.Phase0 = &phase0.BeaconState{}
+		return res, res.Phase0, nil
+	case spec.DataVersionAltair:
+		res.Altair = &altair.BeaconState{}
+		return res, res.Altair, nil
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &bellatrix.BeaconState{}
+		return res, res.Bellatrix, nil
+	case spec.DataVersionCapella:
+		res.Capella = &capella.BeaconState{}
+		return res, res.Capella, nil
+	case spec.DataVersionDeneb:
+		res.Deneb = &deneb.BeaconState{}
+		return res, res.Deneb, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported beacon state version %s", version)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/gencorpus"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconStateEncodings(t *testing.T) {
+	ctx := context.Background()
+
+	state := &altair.BeaconState{}
+	require.NoError(t, gencorpus.Fill(state, gencorpus.VariantZero))
+	state.Slot = 12
+	sszData, err := state.MarshalSSZ()
+	require.NoError(t, err)
+	jsonData, err := json.Marshal(state)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		enforceJSON bool
+		handler     func(w http.ResponseWriter, r *http.Request)
+		err         string
+		slot        phase0.Slot
+	}{
+		{
+			name: "SSZ",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if !strings.HasPrefix(r.Header.Get("Accept"), "application/octet-stream") {
+					w.WriteHeader(http.StatusNotAcceptable)
+					return
+				}
+				w.Header().Set("Content-Type", "application/octet-stream")
+				w.Header().Set("Eth-Consensus-Version", "altair")
+				_, _ = w.Write(sszData)
+			},
+			slot: 12,
+		},
+		{
+			name: "SSZMissingVersion",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/octet-stream")
+				_, _ = w.Write(sszData)
+			},
+			err: "SSZ response does not contain a consensus version",
+		},
+		{
+			name: "SSZTruncated",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/octet-stream")
+				w.Header().Set("Eth-Consensus-Version", "altair")
+				_, _ = w.Write(sszData[:len(sszData)/2])
+			},
+			err: "failed to parse altair beacon state",
+		},
+		{
+			name: "JSONFallback",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"version":"altair","execution_optimistic":false,"data":%s}`, jsonData)
+			},
+			slot: 12,
+		},
+		{
+			name:        "JSONEnforced",
+			enforceJSON: true,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Accept") != "application/json" {
+					w.WriteHeader(http.StatusNotAcceptable)
+					return
+				}
+				_, _ = fmt.Fprintf(w, `{"version":"altair","data":%s}`, jsonData)
+			},
+			slot: 12,
+		},
+		{
+			name: "JSONDataBeforeVersion",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = fmt.Fprintf(w, `{"data":%s,"version":"altair"}`, jsonData)
+			},
+			slot: 12,
+		},
+		{
+			name: "JSONNoData",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = fmt.Fprint(w, `{"version":"altair"}`)
+			},
+			err: "beacon state response contains no data",
+		},
+		{
+			name: "JSONUnsupportedVersion",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = fmt.Fprint(w, `{"version":"unknown","data":{}}`)
+			},
+			err: "failed to parse response",
+		},
+		{
+			name: "NotFound",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			},
+		},
+		{
+			name: "ServerError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = fmt.Fprint(w, `{"code":500,"message":"internal error"}`)
+			},
+			err: "failed to request beacon state",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(test.handler))
+			defer srv.Close()
+
+			s := testService(t, srv)
+			s.enforceJSON = test.enforceJSON
+
+			res, err := s.beaconState(ctx, "head")
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			if test.slot == 0 {
+				require.Nil(t, res)
+				return
+			}
+			require.Equal(t, spec.DataVersionAltair, res.Version)
+			require.Equal(t, test.slot, res.Altair.Slot)
+		})
+	}
+}
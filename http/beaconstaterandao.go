@@ -20,8 +20,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type stateRandaoJSON struct {
@@ -34,17 +36,20 @@ type stateRandaoDataJSON struct {
 
 // BeaconStateRandao fetches a beacon state RANDAO given a state ID.
 func (s *Service) BeaconStateRandao(ctx context.Context, stateID string) (*phase0.Root, error) {
+	ctx, span := s.startSpan(ctx, "BeaconStateRandao", attribute.String("state_id", stateID))
+	defer span.End()
+
 	if stateID == "" {
 		return nil, errors.New("no state ID specified")
 	}
 
 	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/states/%s/randao", stateID))
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			return nil, nil
+		}
 		return nil, errors.Wrap(err, "failed to request state RANDAO")
 	}
-	if respBodyReader == nil {
-		return nil, nil
-	}
 
 	var data stateRandaoJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&data); err != nil {
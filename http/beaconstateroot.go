@@ -20,12 +20,16 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/api"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type stateRootJSON struct {
-	Data *stateRootDataJSON `json:"data"`
+	ExecutionOptimistic bool               `json:"execution_optimistic"`
+	Finalized           bool               `json:"finalized"`
+	Data                *stateRootDataJSON `json:"data"`
 }
 
 type stateRootDataJSON struct {
@@ -34,22 +38,41 @@ type stateRootDataJSON struct {
 
 // BeaconStateRoot fetches a beacon state root given a state ID.
 func (s *Service) BeaconStateRoot(ctx context.Context, stateID string) (*spec.Root, error) {
+	resp, err := s.StateRoot(ctx, stateID)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, nil
+	}
+
+	return resp.Data, nil
+}
+
+// StateRoot fetches a beacon state root and its metadata given a state ID.
+func (s *Service) StateRoot(ctx context.Context, stateID string) (*api.Response[*spec.Root], error) {
+	ctx, span := s.startSpan(ctx, "StateRoot", attribute.String("state_id", stateID))
+	defer span.End()
+
 	if stateID == "" {
 		return nil, errors.New("no state ID specified")
 	}
 
 	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/states/%s/root", stateID))
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			return nil, nil
+		}
 		return nil, errors.Wrap(err, "failed to request state root")
 	}
-	if respBodyReader == nil {
-		return nil, nil
-	}
 
 	var stateRootJSON stateRootJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&stateRootJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to parse state root")
 	}
+	if stateRootJSON.Data == nil {
+		return nil, errors.New("state root data missing")
+	}
 
 	bytes, err := hex.DecodeString(strings.TrimPrefix(stateRootJSON.Data.Root, "0x"))
 	if err != nil {
@@ -58,5 +81,11 @@ func (s *Service) BeaconStateRoot(ctx context.Context, stateID string) (*spec.Ro
 	var stateRoot spec.Root
 	copy(stateRoot[:], bytes)
 
-	return &stateRoot, nil
+	return &api.Response[*spec.Root]{
+		Data: &stateRoot,
+		Metadata: &api.ResponseMetadata{
+			ExecutionOptimistic: stateRootJSON.ExecutionOptimistic,
+			Finalized:           stateRootJSON.Finalized,
+		},
+	}, nil
 }
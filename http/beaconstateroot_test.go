@@ -74,3 +74,56 @@ func TestBeaconStateRoot(t *testing.T) {
 		})
 	}
 }
+
+func TestStateRoot(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name              string
+		stateID           string
+		expectedErrorCode int
+	}{
+		{
+			name:              "Invalid",
+			stateID:           "current",
+			expectedErrorCode: 400,
+		},
+		{
+			name:    "Zero",
+			stateID: "0",
+		},
+		{
+			name:    "Head",
+			stateID: "head",
+		},
+		{
+			name:    "Finalized",
+			stateID: "finalized",
+		},
+		{
+			name:    "Justified",
+			stateID: "justified",
+		},
+	}
+
+	service, err := http.New(ctx,
+		http.WithTimeout(timeout),
+		http.WithAddress(os.Getenv("HTTP_ADDRESS")),
+	)
+	require.NoError(t, err)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stateRoot, err := service.(client.StateRootProvider).StateRoot(ctx, test.stateID)
+			if test.expectedErrorCode != 0 {
+				require.Contains(t, err.Error(), fmt.Sprintf("%d", test.expectedErrorCode))
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, stateRoot)
+				require.NotNil(t, stateRoot.Data)
+				require.NotNil(t, stateRoot.Metadata)
+			}
+		})
+	}
+}
@@ -42,10 +42,25 @@ type denebBlindedBeaconBlockProposalJSON struct {
 }
 
 // BlindedBeaconBlockProposal fetches a proposed beacon block for signing.
+// If graffiti is nil the service default graffiti, as set with WithGraffiti, is used.
+// If the v3 proposals feature is enabled the v3 proposal endpoint is used where it returns a blinded block.
 func (s *Service) BlindedBeaconBlockProposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*api.VersionedBlindedBeaconBlock, error) {
-	// Graffiti should be 32 bytes.
-	fixedGraffiti := make([]byte, 32)
-	copy(fixedGraffiti, graffiti)
+	fixedGraffiti, err := s.proposalGraffiti(graffiti)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.FeatureEnabled(api.FeatureV3Proposals) {
+		proposal, err := s.proposal(ctx, slot, randaoReveal, fixedGraffiti)
+		switch {
+		case err != nil:
+			s.log.Debug().Err(err).Msg("Failed to obtain v3 proposal; falling back to v1")
+		case !proposal.Blinded:
+			s.log.Debug().Msg("v3 proposal is not blinded; falling back to v1")
+		default:
+			return blindedBeaconBlockFromProposal(proposal), nil
+		}
+	}
 
 	return s.blindedBeaconBlockProposal(ctx, slot, randaoReveal, fixedGraffiti)
 }
@@ -57,9 +72,6 @@ func (s *Service) blindedBeaconBlockProposal(ctx context.Context, slot phase0.Sl
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request blinded beacon block proposal")
 	}
-	if respBodyReader == nil {
-		return nil, errors.New("blinded beacon block proposal response empty")
-	}
 
 	var dataBodyReader bytes.Buffer
 	metadataReader := io.TeeReader(respBodyReader, &dataBodyReader)
@@ -0,0 +1,102 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type blobSidecarsJSON struct {
+	// Version is optional, as earlier beacon nodes do not supply it.
+	Version             *spec.DataVersion `json:"version"`
+	ExecutionOptimistic bool              `json:"execution_optimistic"`
+	Finalized           bool              `json:"finalized"`
+	Data                json.RawMessage   `json:"data"`
+}
+
+// BlobSidecars fetches the blob sidecars given a block ID, along with their metadata.
+// If indices is empty all blob sidecars for the block are returned, otherwise only those
+// with the given indices.
+func (s *Service) BlobSidecars(ctx context.Context,
+	blockID string,
+	indices []deneb.BlobIndex,
+) (
+	*api.Response[*api.VersionedBlobSidecars],
+	error,
+) {
+	ctx, span := s.startSpan(ctx, "BlobSidecars", attribute.String("block_id", blockID), attribute.Int("indices", len(indices)))
+	defer span.End()
+
+	url := fmt.Sprintf("/eth/v1/beacon/blob_sidecars/%s", blockID)
+	if len(indices) > 0 {
+		indexStrs := make([]string, len(indices))
+		for i := range indices {
+			indexStrs[i] = fmt.Sprintf("%d", indices[i])
+		}
+		url = fmt.Sprintf("%s?indices=%s", url, strings.Join(indexStrs, ","))
+	}
+
+	respBodyReader, err := s.get(ctx, url)
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to request blob sidecars")
+	}
+
+	var resp blobSidecarsJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse blob sidecars")
+	}
+
+	// Blob sidecars were introduced in Deneb, so that is the version if none is supplied.
+	version := spec.DataVersionDeneb
+	if resp.Version != nil {
+		version = *resp.Version
+	}
+	data := &api.VersionedBlobSidecars{
+		Version: version,
+	}
+	switch version {
+	case spec.DataVersionDeneb:
+		if err := json.Unmarshal(resp.Data, &data.Deneb); err != nil {
+			return nil, errors.Wrap(err, "failed to parse deneb blob sidecars")
+		}
+		// Data is not guaranteed to be returned in index order, so fix that.
+		sort.Slice(data.Deneb, func(i int, j int) bool {
+			return data.Deneb[i].Index < data.Deneb[j].Index
+		})
+	default:
+		return nil, fmt.Errorf("unsupported blob sidecars version %s", version)
+	}
+
+	return &api.Response[*api.VersionedBlobSidecars]{
+		Data: data,
+		Metadata: &api.ResponseMetadata{
+			Version:             version,
+			ExecutionOptimistic: resp.ExecutionOptimistic,
+			Finalized:           resp.Finalized,
+		},
+	}, nil
+}
@@ -0,0 +1,77 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func testBlobSidecar(index deneb.BlobIndex) *deneb.BlobSidecar {
+	return &deneb.BlobSidecar{
+		BlockRoot:     phase0.Root{0x01},
+		Index:         index,
+		Slot:          1,
+		KzgCommitment: deneb.KzgCommitment{0x02},
+		KzgProof:      deneb.KzgProof{0x03},
+	}
+}
+
+func TestBlobSidecars(t *testing.T) {
+	sidecars, err := json.Marshal([]*deneb.BlobSidecar{testBlobSidecar(3), testBlobSidecar(1)})
+	require.NoError(t, err)
+
+	var query string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		_, err := w.Write([]byte(fmt.Sprintf(`{"execution_optimistic":true,"finalized":false,"data":%s}`, sidecars)))
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	resp, err := testService(t, srv).BlobSidecars(context.Background(), "head", []deneb.BlobIndex{1, 3})
+	require.NoError(t, err)
+	require.Equal(t, "indices=1,3", query)
+	require.Equal(t, spec.DataVersionDeneb, resp.Data.Version)
+	indices, err := resp.Data.Indices()
+	require.NoError(t, err)
+	require.Equal(t, []deneb.BlobIndex{1, 3}, indices)
+	require.Equal(t, spec.DataVersionDeneb, resp.Metadata.Version)
+	require.True(t, resp.Metadata.ExecutionOptimistic)
+	require.False(t, resp.Metadata.Finalized)
+
+	_, err = testService(t, srv).BlobSidecars(context.Background(), "head", nil)
+	require.NoError(t, err)
+	require.Equal(t, "", query)
+}
+
+func TestBlobSidecarsVersion(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write([]byte(`{"version":"capella","execution_optimistic":false,"finalized":true,"data":[]}`))
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	_, err := testService(t, srv).BlobSidecars(context.Background(), "head", nil)
+	require.EqualError(t, err, "unsupported blob sidecars version capella")
+}
@@ -0,0 +1,149 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// defaultDutiesIndexChunkSize is the default maximum number of validator indices
+// to send in each duties request.
+const defaultDutiesIndexChunkSize = 10000
+
+// ChunkError is the error for a single chunk of a chunked request.
+type ChunkError struct {
+	// Indices are the validator indices in the failed chunk.
+	Indices []phase0.ValidatorIndex
+	// Err is the error returned for the chunk.
+	Err error
+}
+
+// Error implements error.
+func (e *ChunkError) Error() string {
+	return fmt.Sprintf("chunk of %d indices failed: %v", len(e.Indices), e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ChunkError) Unwrap() error {
+	return e.Err
+}
+
+// PartialFailureError describes the failed chunks when some, but not all, chunks of a chunked request fail.
+// Requests that return data return the results from the successful chunks without error, and pass this
+// to any partial failure handlers; requests that only submit data return it as their error.
+type PartialFailureError struct {
+	// Chunks is the total number of chunks in the request.
+	Chunks int
+	// Failures are the errors for the failed chunks.
+	Failures []*ChunkError
+}
+
+// Error implements error.
+func (e *PartialFailureError) Error() string {
+	msgs := make([]string, len(e.Failures))
+	for i := range e.Failures {
+		msgs[i] = e.Failures[i].Error()
+	}
+	return fmt.Sprintf("%d of %d chunks failed: %s", len(e.Failures), e.Chunks, strings.Join(msgs, "; "))
+}
+
+// FailedIndices returns the validator indices for which the request failed.
+func (e *PartialFailureError) FailedIndices() []phase0.ValidatorIndex {
+	res := make([]phase0.ValidatorIndex, 0)
+	for _, failure := range e.Failures {
+		res = append(res, failure.Indices...)
+	}
+	return res
+}
+
+// PartialFailureHandlerFunc is called when some, but not all, chunks of a chunked request for data fail.
+// The request itself returns the data from the successful chunks without error.
+type PartialFailureHandlerFunc func(ctx context.Context, err *PartialFailureError)
+
+// reportPartialFailure logs a partial failure and passes it to the partial failure handlers.
+func (s *Service) reportPartialFailure(ctx context.Context, err *PartialFailureError) {
+	s.log.Warn().Err(err).Ints64("failed_indices", indicesToInt64s(err.FailedIndices())).Msg("Some chunks of request failed; returning partial results")
+	for _, handler := range s.partialFailureHandlers {
+		handler(ctx, err)
+	}
+}
+
+// indicesToInt64s converts validator indices to int64s for logging.
+func indicesToInt64s(indices []phase0.ValidatorIndex) []int64 {
+	res := make([]int64, len(indices))
+	for i := range indices {
+		res[i] = int64(indices[i])
+	}
+	return res
+}
+
+// dutiesIndexChunkSize is the maximum number of validator indices to send in each duties request.
+func (s *Service) dutiesIndexChunkSize() int {
+	if s.userDutiesIndexChunkSize > 0 {
+		return s.userDutiesIndexChunkSize
+	}
+	return defaultDutiesIndexChunkSize
+}
+
+// chunkedIndexRequest splits the validator indices in to chunks of the given size,
+// calls the supplied function for each chunk and merges the results.
+// If all chunks fail the first error is returned.  If some chunks fail the results
+// of the successful chunks are returned along with a *PartialFailureError describing
+// the failures, and a nil error.
+func chunkedIndexRequest[T any](ctx context.Context,
+	validatorIndices []phase0.ValidatorIndex,
+	chunkSize int,
+	fetch func(context.Context, []phase0.ValidatorIndex) ([]T, error),
+) (
+	[]T,
+	*PartialFailureError,
+	error,
+) {
+	res := make([]T, 0, len(validatorIndices))
+	failures := make([]*ChunkError, 0)
+	chunks := 0
+	for i := 0; i < len(validatorIndices); i += chunkSize {
+		chunkEnd := i + chunkSize
+		if len(validatorIndices) < chunkEnd {
+			chunkEnd = len(validatorIndices)
+		}
+		chunk := validatorIndices[i:chunkEnd]
+		chunks++
+		chunkRes, err := fetch(ctx, chunk)
+		if err != nil {
+			failures = append(failures, &ChunkError{
+				Indices: chunk,
+				Err:     err,
+			})
+			continue
+		}
+		res = append(res, chunkRes...)
+	}
+
+	switch {
+	case len(failures) == 0:
+		return res, nil, nil
+	case len(failures) == chunks:
+		return nil, nil, failures[0].Err
+	default:
+		return res, &PartialFailureError{
+			Chunks:   chunks,
+			Failures: failures,
+		}, nil
+	}
+}
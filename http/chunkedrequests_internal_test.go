@@ -0,0 +1,62 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkedIndexRequest(t *testing.T) {
+	ctx := context.Background()
+
+	indices := make([]phase0.ValidatorIndex, 10)
+	for i := range indices {
+		indices[i] = phase0.ValidatorIndex(i)
+	}
+
+	echo := func(_ context.Context, chunk []phase0.ValidatorIndex) ([]phase0.ValidatorIndex, error) {
+		return chunk, nil
+	}
+	failFirst := func(_ context.Context, chunk []phase0.ValidatorIndex) ([]phase0.ValidatorIndex, error) {
+		if chunk[0] == 0 {
+			return nil, errors.New("chunk failed")
+		}
+		return chunk, nil
+	}
+	failAll := func(_ context.Context, _ []phase0.ValidatorIndex) ([]phase0.ValidatorIndex, error) {
+		return nil, errors.New("chunk failed")
+	}
+
+	res, partialErr, err := chunkedIndexRequest(ctx, indices, 3, echo)
+	require.NoError(t, err)
+	require.Nil(t, partialErr)
+	require.Equal(t, indices, res)
+
+	res, partialErr, err = chunkedIndexRequest(ctx, indices, 3, failFirst)
+	require.NoError(t, err)
+	require.Equal(t, indices[3:], res)
+	require.NotNil(t, partialErr)
+	require.Equal(t, 4, partialErr.Chunks)
+	require.Equal(t, indices[:3], partialErr.FailedIndices())
+
+	res, partialErr, err = chunkedIndexRequest(ctx, indices, 3, failAll)
+	require.EqualError(t, err, "chunk failed")
+	require.Nil(t, partialErr)
+	require.Nil(t, res)
+}
@@ -0,0 +1,96 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"net/http"
+	"sync"
+)
+
+// conditionalEndpoints are the endpoints whose responses rarely change, and so are fetched with
+// conditional requests when the beacon node supplies an ETag or Last-Modified header.
+var conditionalEndpoints = map[string]bool{
+	"/eth/v1/beacon/genesis":          true,
+	"/eth/v1/config/deposit_contract": true,
+	"/eth/v1/config/fork_schedule":    true,
+	"/eth/v1/config/spec":             true,
+}
+
+// conditionalEntry is a cached response to a conditional endpoint.
+type conditionalEntry struct {
+	etag         string
+	lastModified string
+	headers      http.Header
+	body         []byte
+}
+
+// conditionalCache holds the most recent responses to conditional endpoints.
+type conditionalCache struct {
+	mu      sync.RWMutex
+	entries map[string]*conditionalEntry
+}
+
+// newConditionalCache creates a new conditional cache.
+func newConditionalCache() *conditionalCache {
+	return &conditionalCache{
+		entries: make(map[string]*conditionalEntry),
+	}
+}
+
+// addHeaders adds conditional headers to the request if there is a cached response for the endpoint.
+// It returns the cached response, or nil if there is none.
+func (c *conditionalCache) addHeaders(endpoint string, req *http.Request) *conditionalEntry {
+	if c == nil || !conditionalEndpoints[endpoint] {
+		return nil
+	}
+
+	c.mu.RLock()
+	entry := c.entries[endpoint]
+	c.mu.RUnlock()
+	if entry == nil {
+		return nil
+	}
+
+	if entry.etag != "" {
+		req.Header.Set("If-None-Match", entry.etag)
+	}
+	if entry.lastModified != "" {
+		req.Header.Set("If-Modified-Since", entry.lastModified)
+	}
+
+	return entry
+}
+
+// store stores a successful response to the endpoint if it can be used for conditional requests.
+func (c *conditionalCache) store(endpoint string, headers http.Header, body []byte) {
+	if c == nil || !conditionalEndpoints[endpoint] {
+		return
+	}
+
+	entry := &conditionalEntry{
+		etag:         headers.Get("ETag"),
+		lastModified: headers.Get("Last-Modified"),
+		headers:      headers,
+		body:         body,
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry.etag == "" && entry.lastModified == "" {
+		// The node does not support conditional requests for this endpoint.
+		delete(c.entries, endpoint)
+		return
+	}
+	c.entries[endpoint] = entry
+}
@@ -0,0 +1,121 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionalRequests(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		etag         string
+		lastModified string
+		conditional  bool
+		notModified  int
+	}{
+		{
+			name:        "ETag",
+			etag:        `"abc"`,
+			conditional: true,
+			notModified: 1,
+		},
+		{
+			name:         "LastModified",
+			lastModified: "Wed, 01 Nov 2023 00:00:00 GMT",
+			conditional:  true,
+			notModified:  1,
+		},
+		{
+			name:        "NotSupported",
+			conditional: true,
+		},
+		{
+			name: "Disabled",
+			etag: `"abc"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var mu sync.Mutex
+			requests := 0
+			notModified := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				requests++
+				if (test.etag != "" && r.Header.Get("If-None-Match") == test.etag) ||
+					(test.lastModified != "" && r.Header.Get("If-Modified-Since") == test.lastModified) {
+					notModified++
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				if test.etag != "" {
+					w.Header().Set("ETag", test.etag)
+				}
+				if test.lastModified != "" {
+					w.Header().Set("Last-Modified", test.lastModified)
+				}
+				_, _ = w.Write([]byte(`{"data":[{"previous_version":"0x00000000","current_version":"0x00000000","epoch":"0"}]}`))
+			}))
+			defer srv.Close()
+
+			s := testService(t, srv)
+			if test.conditional {
+				s.conditional = newConditionalCache()
+			}
+
+			for i := 0; i < 2; i++ {
+				forkSchedule, err := s.ForkSchedule(ctx)
+				require.NoError(t, err)
+				require.Len(t, forkSchedule, 1)
+				// Clear the value, as happens periodically, so that it is refetched.
+				s.forkSchedule = nil
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			require.Equal(t, 2, requests)
+			require.Equal(t, test.notModified, notModified)
+		})
+	}
+}
+
+func TestConditionalCacheEndpoints(t *testing.T) {
+	c := newConditionalCache()
+	headers := http.Header{}
+	headers.Set("ETag", `"abc"`)
+
+	// Endpoints that change frequently are not cached.
+	c.store("/eth/v1/node/syncing", headers, []byte("{}"))
+	req, err := http.NewRequest(http.MethodGet, "http://localhost/eth/v1/node/syncing", nil)
+	require.NoError(t, err)
+	require.Nil(t, c.addHeaders("/eth/v1/node/syncing", req))
+	require.Empty(t, req.Header.Get("If-None-Match"))
+
+	c.store("/eth/v1/config/spec", headers, []byte("{}"))
+	req, err = http.NewRequest(http.MethodGet, "http://localhost/eth/v1/config/spec", nil)
+	require.NoError(t, err)
+	require.NotNil(t, c.addHeaders("/eth/v1/config/spec", req))
+	require.Equal(t, `"abc"`, req.Header.Get("If-None-Match"))
+}
@@ -0,0 +1,45 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+// contentType is the content type of a request or response body.
+type contentType int
+
+const (
+	// contentTypeJSON is JSON-encoded content.
+	contentTypeJSON contentType = iota
+	// contentTypeSSZ is SSZ-encoded content.
+	contentTypeSSZ
+)
+
+var contentTypeMediaTypes = [...]string{
+	"application/json",
+	"application/octet-stream",
+}
+
+// MediaType returns the IANA media type for the content type.
+func (c contentType) MediaType() string {
+	return contentTypeMediaTypes[c]
+}
+
+func (c contentType) String() string {
+	switch c {
+	case contentTypeJSON:
+		return "JSON"
+	case contentTypeSSZ:
+		return "SSZ"
+	default:
+		return "unknown"
+	}
+}
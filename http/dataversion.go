@@ -0,0 +1,57 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// forkEpochKeys are the spec keys for the epochs at which each fork after phase 0 activates, in order.
+var forkEpochKeys = []struct {
+	version spec.DataVersion
+	key     string
+}{
+	{spec.DataVersionAltair, "ALTAIR_FORK_EPOCH"},
+	{spec.DataVersionBellatrix, "BELLATRIX_FORK_EPOCH"},
+	{spec.DataVersionCapella, "CAPELLA_FORK_EPOCH"},
+	{spec.DataVersionDeneb, "DENEB_FORK_EPOCH"},
+}
+
+// dataVersionAtSlot returns the data version of the chain fork at the given slot.
+func (s *Service) dataVersionAtSlot(ctx context.Context, slot phase0.Slot) (spec.DataVersion, error) {
+	chainSpec, err := s.Spec(ctx)
+	if err != nil {
+		return spec.DataVersionPhase0, errors.Wrap(err, "failed to obtain spec")
+	}
+	slotsPerEpoch, isUint := chainSpec["SLOTS_PER_EPOCH"].(uint64)
+	if !isUint || slotsPerEpoch == 0 {
+		return spec.DataVersionPhase0, errors.New("slots per epoch not available")
+	}
+	epoch := phase0.Epoch(uint64(slot) / slotsPerEpoch)
+
+	res := spec.DataVersionPhase0
+	for _, forkEpochKey := range forkEpochKeys {
+		forkEpoch, exists := chainSpec[forkEpochKey.key].(uint64)
+		if !exists || epoch < phase0.Epoch(forkEpoch) {
+			break
+		}
+		res = forkEpochKey.version
+	}
+
+	return res, nil
+}
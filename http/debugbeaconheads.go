@@ -0,0 +1,44 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+type debugBeaconHeadsJSON struct {
+	Data []*api.ChainHead `json:"data"`
+}
+
+// DebugBeaconHeads provides the heads of the chain known to the node's fork choice.
+func (s *Service) DebugBeaconHeads(ctx context.Context) ([]*api.ChainHead, error) {
+	respBodyReader, err := s.get(ctx, "/eth/v2/debug/beacon/heads")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request beacon heads")
+	}
+
+	var resp debugBeaconHeadsJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse beacon heads")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("beacon heads not returned")
+	}
+
+	return resp.Data, nil
+}
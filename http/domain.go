@@ -14,7 +14,6 @@
 package http
 
 import (
-	"bytes"
 	"context"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -66,7 +65,7 @@ func (s *Service) domain(ctx context.Context,
 		CurrentVersion: forkVersion,
 	}
 
-	if !bytes.Equal(domainType[:], []byte{0x00, 0x00, 0x00, 0x01}) {
+	if domainType != phase0.DomainApplicationBuilder {
 		// Use the chain's genesis validators root for non-application domain types.
 		genesis, err := s.Genesis(ctx)
 		if err != nil {
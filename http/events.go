@@ -18,8 +18,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
-	"net"
-	"net/http"
 	"net/url"
 	"strings"
 	"time"
@@ -54,24 +52,16 @@ func (s *Service) Events(ctx context.Context, topics []string, handler client.Ev
 	if err != nil {
 		return errors.Wrap(err, "invalid endpoint")
 	}
-	url := s.base.ResolveReference(reference).String()
-	log.Trace().Str("url", url).Msg("GET request to events stream")
-
-	client := sse.NewClient(url)
-	client.Connection.Transport = &http.Transport{
-		Dial: (&net.Dialer{
-			Timeout:   2 * time.Second,
-			KeepAlive: 2 * time.Second,
-		}).Dial,
-	}
+	url := s.base.ResolveReference(reference)
+	log.Trace().Stringer("url", url).Msg("GET request to events stream")
 
 	go func() {
 		for {
 			select {
 			case <-time.After(time.Second):
 				log.Trace().Msg("Connecting to events stream")
-				if err := client.SubscribeRawWithContext(ctx, func(msg *sse.Event) {
-					s.handleEvent(ctx, msg, handler)
+				if err := s.transport.Subscribe(ctx, url, s.extraHeaders, func(topic string, data []byte) {
+					s.handleEvent(ctx, &sse.Event{Event: []byte(topic), Data: data}, handler)
 				}); err != nil {
 					log.Error().Err(err).Msg("Failed to subscribe to event stream")
 				}
@@ -159,6 +149,14 @@ func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler clien
 			return
 		}
 		event.Data = contributionAndProofEvent
+	case "payload_attributes":
+		payloadAttributesEvent := &api.PayloadAttributesEvent{}
+		err := json.Unmarshal(msg.Data, payloadAttributesEvent)
+		if err != nil {
+			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse payload attributes event")
+			return
+		}
+		event.Data = payloadAttributesEvent
 	case "":
 		// Used as keepalive.  Ignore.
 		return
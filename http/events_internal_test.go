@@ -134,6 +134,24 @@ func TestEventHandler(t *testing.T) {
 			handler: handler,
 			handled: true,
 		},
+		{
+			name: "PayloadAttributesGood",
+			message: &sse.Event{
+				Event: []byte("payload_attributes"),
+				Data:  []byte(`{"version":"deneb","data":{"proposer_index":"123","proposal_slot":"10","parent_block_number":"9","parent_block_root":"0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2","parent_block_hash":"0x9a2fefd2fdb57f74993c7780ea5b9030d2897b615b89f808011ca5aebed54eaf","payload_attributes":{"timestamp":"123456","prev_randao":"0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2","suggested_fee_recipient":"0x0000000000000000000000000000000000000000","withdrawals":[],"parent_beacon_block_root":"0x0c4c5b8d0f4c3b1e2a9f7e6d5c4b3a29181716151413121110090807060504ff"}}}`),
+			},
+			handler: handler,
+			handled: true,
+		},
+		{
+			name: "PayloadAttributesBad",
+			message: &sse.Event{
+				Event: []byte("payload_attributes"),
+				Data:  []byte(`{"version":"deneb","data":{}}`),
+			},
+			handler: handler,
+			handled: false,
+		},
 	}
 
 	s, err := New(ctx,
@@ -0,0 +1,65 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
+)
+
+// enabledFeatures returns the set of enabled features, logging any that are experimental or deprecated.
+func enabledFeatures(log zerolog.Logger, features map[api.Feature]bool) map[api.Feature]struct{} {
+	res := make(map[api.Feature]struct{})
+	for feature, enabled := range features {
+		if !enabled {
+			continue
+		}
+		res[feature] = struct{}{}
+
+		info, exists := api.FeatureInformation(feature)
+		if !exists {
+			continue
+		}
+		if info.Deprecated != "" {
+			log.Warn().Str("feature", string(feature)).Str("reason", info.Deprecated).Msg("Deprecated feature enabled")
+		}
+		if info.Experimental {
+			log.Debug().Str("feature", string(feature)).Msg("Experimental feature enabled")
+		}
+	}
+
+	return res
+}
+
+// FeatureEnabled returns true if the given feature is enabled.
+func (s *Service) FeatureEnabled(feature api.Feature) bool {
+	_, exists := s.features[feature]
+
+	return exists
+}
+
+// EnabledFeatures returns the features enabled in the service.
+func (s *Service) EnabledFeatures() []api.Feature {
+	res := make([]api.Feature, 0, len(s.features))
+	for feature := range s.features {
+		res = append(res, feature)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i] < res[j]
+	})
+
+	return res
+}
@@ -0,0 +1,35 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeatures(t *testing.T) {
+	s := &Service{
+		features: enabledFeatures(zerolog.Nop(), map[api.Feature]bool{
+			api.FeatureV3Proposals:   false,
+			api.FeatureReducedMemory: true,
+		}),
+	}
+
+	require.False(t, s.FeatureEnabled(api.FeatureV3Proposals))
+	require.True(t, s.FeatureEnabled(api.FeatureReducedMemory))
+	require.Equal(t, []api.Feature{api.FeatureReducedMemory}, s.EnabledFeatures())
+}
@@ -18,16 +18,32 @@ import (
 	"encoding/json"
 	"fmt"
 
-	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type finalityJSON struct {
-	Data *api.Finality `json:"data"`
+	responseMetadata
+	Data *apiv1.Finality `json:"data"`
 }
 
 // Finality provides the finality given a state ID.
-func (s *Service) Finality(ctx context.Context, stateID string) (*api.Finality, error) {
+func (s *Service) Finality(ctx context.Context, stateID string) (*apiv1.Finality, error) {
+	resp, err := s.FinalityWithMeta(ctx, stateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+// FinalityWithMeta provides the finality and its metadata given a state ID.
+func (s *Service) FinalityWithMeta(ctx context.Context, stateID string) (*api.Response[*apiv1.Finality], error) {
+	ctx, span := s.startSpan(ctx, "FinalityWithMeta", attribute.String("state_id", stateID))
+	defer span.End()
+
 	if stateID == "" {
 		return nil, errors.New("no state ID specified")
 	}
@@ -36,9 +52,6 @@ func (s *Service) Finality(ctx context.Context, stateID string) (*api.Finality,
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request finality checkpoints")
 	}
-	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain finality checkpoints")
-	}
 
 	var finalityJSON finalityJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&finalityJSON); err != nil {
@@ -48,5 +61,8 @@ func (s *Service) Finality(ctx context.Context, stateID string) (*api.Finality,
 		return nil, errors.New("no finality returned")
 	}
 
-	return finalityJSON.Data, nil
+	return &api.Response[*apiv1.Finality]{
+		Data:     finalityJSON.Data,
+		Metadata: finalityJSON.apiMetadata(),
+	}, nil
 }
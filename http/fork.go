@@ -20,6 +20,7 @@ import (
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type forkJSON struct {
@@ -28,6 +29,9 @@ type forkJSON struct {
 
 // Fork fetches fork information for the given state.
 func (s *Service) Fork(ctx context.Context, stateID string) (*phase0.Fork, error) {
+	ctx, span := s.startSpan(ctx, "Fork", attribute.String("state_id", stateID))
+	defer span.End()
+
 	if stateID == "" {
 		return nil, errors.New("no state ID specified")
 	}
@@ -36,9 +40,6 @@ func (s *Service) Fork(ctx context.Context, stateID string) (*phase0.Fork, error
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request fork")
 	}
-	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain fork")
-	}
 
 	var forkJSON forkJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&forkJSON); err != nil {
@@ -46,9 +46,6 @@ func (s *Service) ForkSchedule(ctx context.Context) ([]*phase0.Fork, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request fork schedule")
 	}
-	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain fork schedule")
-	}
 
 	var resp forkScheduleJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
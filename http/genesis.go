@@ -46,9 +46,6 @@ func (s *Service) Genesis(ctx context.Context) (*api.Genesis, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request genesis")
 	}
-	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain genesis")
-	}
 
 	var resp genesisJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
@@ -0,0 +1,38 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"fmt"
+)
+
+// graffitiLength is the length of graffiti in a beacon block.
+const graffitiLength = 32
+
+// proposalGraffiti returns the graffiti to use for a proposal, padded to the required length.
+// If the caller does not supply graffiti the service's default graffiti is used; to propose
+// with empty graffiti when a default is set, supply an empty non-nil slice.
+func (s *Service) proposalGraffiti(graffiti []byte) ([]byte, error) {
+	if graffiti == nil {
+		graffiti = s.graffiti
+	}
+	if len(graffiti) > graffitiLength {
+		return nil, fmt.Errorf("graffiti cannot be longer than %d bytes", graffitiLength)
+	}
+
+	fixedGraffiti := make([]byte, graffitiLength)
+	copy(fixedGraffiti, graffiti)
+
+	return fixedGraffiti, nil
+}
@@ -0,0 +1,77 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposalGraffiti(t *testing.T) {
+	tests := []struct {
+		name            string
+		serviceGraffiti []byte
+		graffiti        []byte
+		expected        []byte
+		err             string
+	}{
+		{
+			name:     "NoDefault",
+			expected: make([]byte, 32),
+		},
+		{
+			name:            "Default",
+			serviceGraffiti: []byte("default"),
+			expected:        append([]byte("default"), make([]byte, 25)...),
+		},
+		{
+			name:            "Override",
+			serviceGraffiti: []byte("default"),
+			graffiti:        []byte("override"),
+			expected:        append([]byte("override"), make([]byte, 24)...),
+		},
+		{
+			name:            "OverrideEmpty",
+			serviceGraffiti: []byte("default"),
+			graffiti:        []byte{},
+			expected:        make([]byte, 32),
+		},
+		{
+			name:     "Full",
+			graffiti: []byte("0123456789abcdef0123456789abcdef"),
+			expected: []byte("0123456789abcdef0123456789abcdef"),
+		},
+		{
+			name:     "TooLong",
+			graffiti: []byte("0123456789abcdef0123456789abcdef0"),
+			err:      "graffiti cannot be longer than 32 bytes",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				graffiti: test.serviceGraffiti,
+			}
+			graffiti, err := s.proposalGraffiti(test.graffiti)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, graffiti)
+			}
+		})
+	}
+}
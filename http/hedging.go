@@ -0,0 +1,157 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	// hedgeSamples is the number of recent request latencies used to calculate the hedge delay.
+	hedgeSamples = 100
+	// hedgeMinSamples is the number of latencies required before requests are hedged.
+	hedgeMinSamples = 20
+)
+
+// hedgePolicy defines when idempotent requests are hedged.
+type hedgePolicy struct {
+	// quantile is the quantile of recent request latencies after which a hedged request is sent.
+	quantile float64
+	// minDelay is the minimum delay before a hedged request is sent.
+	minDelay time.Duration
+}
+
+// hedger tracks recent request latencies to decide when to send hedged requests.
+type hedger struct {
+	policy *hedgePolicy
+
+	mu        sync.Mutex
+	latencies []time.Duration
+	next      int
+}
+
+// newHedger creates a hedger for the given policy.
+func newHedger(policy *hedgePolicy) *hedger {
+	return &hedger{
+		policy:    policy,
+		latencies: make([]time.Duration, 0, hedgeSamples),
+	}
+}
+
+// record records the latency of a successful request.
+func (h *hedger) record(latency time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.latencies) < hedgeSamples {
+		h.latencies = append(h.latencies, latency)
+		return
+	}
+	h.latencies[h.next] = latency
+	h.next = (h.next + 1) % hedgeSamples
+}
+
+// delay returns the time after which a hedged request should be sent, and false if there
+// are not yet enough latencies recorded to hedge requests.
+func (h *hedger) delay() (time.Duration, bool) {
+	h.mu.Lock()
+	if len(h.latencies) < hedgeMinSamples {
+		h.mu.Unlock()
+		return 0, false
+	}
+	latencies := make([]time.Duration, len(h.latencies))
+	copy(latencies, h.latencies)
+	h.mu.Unlock()
+
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	delay := latencies[int(h.policy.quantile*float64(len(latencies)-1))]
+	if delay < h.policy.minDelay {
+		delay = h.policy.minDelay
+	}
+
+	return delay, true
+}
+
+// hedgeResult is the result of a single attempt of a hedged request.
+type hedgeResult struct {
+	res *httpResponse
+	err error
+}
+
+// getHedgedAttempt makes an attempt at an HTTP get request.  If hedging is enabled and the
+// request has not completed within the hedge delay a duplicate request is sent, and the first
+// successful response is returned.
+func (s *Service) getHedgedAttempt(ctx context.Context, log zerolog.Logger, endpoint string) (*httpResponse, error) {
+	if s.hedger == nil {
+		return s.getAttempt(ctx, log, endpoint)
+	}
+
+	delay, hedge := s.hedger.delay()
+	if !hedge {
+		started := time.Now()
+		res, err := s.getAttempt(ctx, log, endpoint)
+		if err == nil {
+			s.hedger.record(time.Since(started))
+		}
+		return res, err
+	}
+
+	// Cancel the outstanding request once we have a result.
+	hedgeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Buffered so that the outstanding request does not block when it completes.
+	results := make(chan *hedgeResult, 2)
+	attempt := func() {
+		started := time.Now()
+		res, err := s.getAttempt(hedgeCtx, log, endpoint)
+		if err == nil {
+			s.hedger.record(time.Since(started))
+		}
+		results <- &hedgeResult{res: res, err: err}
+	}
+	go attempt()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	outstanding := 1
+	var firstErr error
+	for {
+		select {
+		case <-timer.C:
+			log.Trace().Dur("delay", delay).Msg("GET slow; sending hedged request")
+			outstanding++
+			go attempt()
+		case result := <-results:
+			outstanding--
+			if result.err == nil {
+				return result.res, nil
+			}
+			if firstErr == nil {
+				firstErr = result.err
+			}
+			if outstanding == 0 {
+				// Either all requests have failed, or the original request failed before the
+				// hedge delay; failures are left to the retry policy.
+				return nil, firstErr
+			}
+		}
+	}
+}
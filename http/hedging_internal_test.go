@@ -0,0 +1,97 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHedgerDelay(t *testing.T) {
+	h := newHedger(&hedgePolicy{quantile: 0.95, minDelay: 5 * time.Millisecond})
+
+	// Not enough samples.
+	for i := 1; i < hedgeMinSamples; i++ {
+		h.record(time.Duration(i) * time.Millisecond)
+	}
+	_, hedge := h.delay()
+	require.False(t, hedge)
+
+	// Samples 1ms to 100ms.
+	for i := hedgeMinSamples; i <= hedgeSamples; i++ {
+		h.record(time.Duration(i) * time.Millisecond)
+	}
+	delay, hedge := h.delay()
+	require.True(t, hedge)
+	require.Equal(t, 95*time.Millisecond, delay)
+
+	// Older samples are replaced.
+	for i := 0; i < hedgeSamples; i++ {
+		h.record(time.Millisecond)
+	}
+	delay, hedge = h.delay()
+	require.True(t, hedge)
+	require.Equal(t, 5*time.Millisecond, delay)
+}
+
+func TestGetHedged(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(500 * time.Millisecond):
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	s := testService(t, srv)
+	s.hedger = newHedger(&hedgePolicy{quantile: 0.95, minDelay: 20 * time.Millisecond})
+	for i := 0; i < hedgeMinSamples; i++ {
+		s.hedger.record(time.Millisecond)
+	}
+
+	started := time.Now()
+	_, err := s.get(context.Background(), "/test")
+	require.NoError(t, err)
+	require.Less(t, time.Since(started), 400*time.Millisecond)
+	require.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestGetHedgedFailure(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	s := testService(t, srv)
+	s.hedger = newHedger(&hedgePolicy{quantile: 0.95, minDelay: 100 * time.Millisecond})
+	for i := 0; i < hedgeMinSamples; i++ {
+		s.hedger.record(time.Millisecond)
+	}
+
+	_, err := s.get(context.Background(), "/test")
+	require.EqualError(t, err, "GET failed with status 400: ")
+	require.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
@@ -0,0 +1,69 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type historicalSummariesJSON struct {
+	ExecutionOptimistic bool                         `json:"execution_optimistic"`
+	Finalized           bool                         `json:"finalized"`
+	Data                *historicalSummariesDataJSON `json:"data"`
+}
+
+type historicalSummariesDataJSON struct {
+	HistoricalSummaries []*capella.HistoricalSummary `json:"historical_summaries"`
+}
+
+// HistoricalSummaries fetches the historical summaries and their metadata given a state ID.
+func (s *Service) HistoricalSummaries(ctx context.Context, stateID string) (*api.Response[[]*capella.HistoricalSummary], error) {
+	ctx, span := s.startSpan(ctx, "HistoricalSummaries", attribute.String("state_id", stateID))
+	defer span.End()
+
+	if stateID == "" {
+		return nil, errors.New("no state ID specified")
+	}
+
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/states/%s/historical_summaries", stateID))
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to request historical summaries")
+	}
+
+	var resp historicalSummariesJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse historical summaries")
+	}
+	if resp.Data == nil || resp.Data.HistoricalSummaries == nil {
+		return nil, errors.New("historical summaries missing")
+	}
+
+	return &api.Response[[]*capella.HistoricalSummary]{
+		Data: resp.Data.HistoricalSummaries,
+		Metadata: &api.ResponseMetadata{
+			ExecutionOptimistic: resp.ExecutionOptimistic,
+			Finalized:           resp.Finalized,
+		},
+	}, nil
+}
@@ -0,0 +1,44 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+)
+
+// RequestHookFunc is called with each request before it is sent to the beacon node.
+// It can modify the request, for example to add headers or sign it.
+type RequestHookFunc func(ctx context.Context, req *http.Request)
+
+// ResponseHookFunc is called with each response received from the beacon node, along with its body.
+// The response body has already been read, so the hook must use the supplied body rather than
+// reading from the response.  The body must not be modified.
+// Very large responses, such as beacon states, are decoded directly from the connection; for these
+// the body is nil and the hook must not read from the response.
+type ResponseHookFunc func(ctx context.Context, resp *http.Response, body []byte)
+
+// runRequestHooks runs the request hooks, in the order in which they were supplied.
+func (s *Service) runRequestHooks(ctx context.Context, req *http.Request) {
+	for _, hook := range s.requestHooks {
+		hook(ctx, req)
+	}
+}
+
+// runResponseHooks runs the response hooks, in the order in which they were supplied.
+func (s *Service) runResponseHooks(ctx context.Context, resp *http.Response, body []byte) {
+	for _, hook := range s.responseHooks {
+		hook(ctx, resp, body)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHooks(t *testing.T) {
+	ctx := context.Background()
+
+	var receivedHeader string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		receivedHeader = req.Header.Get("X-Signature")
+		if req.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":"ok"}`))
+	}))
+	defer srv.Close()
+
+	requests := make([]string, 0)
+	responses := make([]string, 0)
+	s := testService(t, srv)
+	s.requestHooks = []RequestHookFunc{
+		func(_ context.Context, req *http.Request) {
+			requests = append(requests, req.Method+" "+req.URL.Path)
+		},
+		func(_ context.Context, req *http.Request) {
+			req.Header.Set("X-Signature", "signed")
+		},
+	}
+	s.responseHooks = []ResponseHookFunc{
+		func(_ context.Context, resp *http.Response, body []byte) {
+			responses = append(responses, resp.Status+" "+string(body))
+		},
+	}
+
+	// The response body is still available to the caller after the hook.
+	res, err := s.get(ctx, "/present")
+	require.NoError(t, err)
+	data, err := io.ReadAll(res)
+	require.NoError(t, err)
+	require.Equal(t, `{"data":"ok"}`, string(data))
+	require.Equal(t, "signed", receivedHeader)
+
+	// Hooks are also called for not found responses.
+	res, err = s.get(ctx, "/missing")
+	require.ErrorIs(t, err, api.ErrNotFound)
+	require.Nil(t, res)
+
+	_, err = s.post(ctx, "/submit", bytes.NewReader([]byte("{}")))
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"GET /present", "GET /missing", "POST /submit"}, requests)
+	require.Equal(t, []string{
+		`200 OK {"data":"ok"}`,
+		`404 Not Found {"message":"not found"}`,
+		`200 OK {"data":"ok"}`,
+	}, responses)
+}
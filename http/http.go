@@ -16,6 +16,7 @@ package http
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"math/rand"
@@ -23,27 +24,87 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Error represents an http error.
-type Error struct {
-	Method     string
-	Endpoint   string
-	StatusCode int
-	Data       []byte
-}
+// It is an alias for api.Error, which can be matched against the api sentinel errors
+// such as api.ErrNotSynced with errors.Is.
+type Error = api.Error
 
-func (e Error) Error() string {
-	return fmt.Sprintf("%s failed with status %d: %s", e.Method, e.StatusCode, e.Data)
+// httpResponse is a successful response from the server.
+type httpResponse struct {
+	statusCode int
+	headers    http.Header
+	body       []byte
 }
 
 // get sends an HTTP get request and returns the body.
-// If the response from the server is a 404 this will return nil for both the reader and the error.
+// If the response from the server is a 404 this will return an error matching api.ErrNotFound.
+// Requests that fail with a retryable error are retried according to the service's retry policy.
 func (s *Service) get(ctx context.Context, endpoint string) (io.Reader, error) {
+	res, err := s.getResponse(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.NewReader(res.body), nil
+}
+
+// getResponse sends an HTTP get request and returns the response, including its headers.
+// If the response from the server is a 404 this will return an error matching api.ErrNotFound.
+// Requests that fail with a retryable error are retried according to the service's retry policy,
+// and slow requests are hedged according to the service's hedging policy.
+func (s *Service) getResponse(ctx context.Context, endpoint string) (*httpResponse, error) {
 	// #nosec G404
 	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
+
+	ctx, span := s.startSpan(ctx, "GET", attribute.String("address", s.address), attribute.String("endpoint", endpoint))
+	defer span.End()
+
+	attemptErrs := make([]error, 0, 1)
+	for attempt := 1; ; attempt++ {
+		res, err := s.getHedgedAttempt(ctx, log, endpoint)
+		if err == nil {
+			return res, nil
+		}
+		attemptErrs = append(attemptErrs, err)
+
+		if attempt >= s.retry.attempts() || !s.retry.retryable(ctx, err) {
+			break
+		}
+		delay := s.retry.delay(attempt)
+		log.Trace().Err(err).Int("attempt", attempt).Dur("delay", delay).Msg("GET failed; retrying")
+		if !sleepCtx(ctx, delay) {
+			attemptErrs = append(attemptErrs, ctx.Err())
+			break
+		}
+	}
+
+	span.SetAttributes(attribute.Int("attempts", len(attemptErrs)))
+	if len(attemptErrs) == 1 {
+		spanError(ctx, attemptErrs[0])
+		return nil, attemptErrs[0]
+	}
+
+	err := &RetryError{
+		Method:   http.MethodGet,
+		Endpoint: endpoint,
+		Errors:   attemptErrs,
+	}
+	spanError(ctx, err)
+
+	return nil, err
+}
+
+// getAttempt makes a single attempt at an HTTP get request.
+func (s *Service) getAttempt(ctx context.Context, log zerolog.Logger, endpoint string) (*httpResponse, error) {
 	log.Trace().Msg("GET request")
 
 	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), endpoint))
@@ -59,48 +120,89 @@ func (s *Service) get(ctx context.Context, endpoint string) (io.Reader, error) {
 	}
 	s.addExtraHeaders(req)
 	req.Header.Set("Accept", "application/json")
+	cached := s.conditional.addHeaders(endpoint, req)
+	s.runRequestHooks(ctx, req)
 
-	resp, err := s.client.Do(req)
+	resp, err := s.transport.Do(req)
 	if err != nil {
 		cancel()
 		return nil, errors.Wrap(err, "failed to call GET endpoint")
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		// Nothing found.  This is not an error, so we return nil on both counts.
-		cancel()
-		return nil, nil
-	}
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Int("status_code", resp.StatusCode))
 
 	data, err := io.ReadAll(resp.Body)
 	if err != nil {
 		cancel()
 		return nil, errors.Wrap(err, "failed to read GET response")
 	}
+	s.runResponseHooks(ctx, resp, data)
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		cancel()
+		log.Trace().Msg("GET response not modified; using cached response")
+		span.SetAttributes(attribute.Bool("cached", true))
+
+		return &httpResponse{
+			statusCode: http.StatusOK,
+			headers:    cached.headers,
+			body:       cached.body,
+		}, nil
+	}
+	span.SetAttributes(attribute.Int("response_size", len(data)))
+	if version := resp.Header.Get("Eth-Consensus-Version"); version != "" {
+		span.SetAttributes(attribute.String("consensus_version", version))
+	}
 
 	statusFamily := resp.StatusCode / 100
 	if statusFamily != 2 {
 		cancel()
 		log.Trace().Int("status_code", resp.StatusCode).Str("data", string(data)).Msg("GET failed")
-		return nil, Error{
-			Method:     http.MethodGet,
-			StatusCode: resp.StatusCode,
-			Endpoint:   endpoint,
-			Data:       data,
-		}
+		return nil, api.NewError(http.MethodGet, endpoint, resp.StatusCode, data)
 	}
 	cancel()
 
 	log.Trace().Str("response", string(data)).Msg("GET response")
+	s.conditional.store(endpoint, resp.Header, data)
 
-	return bytes.NewReader(data), nil
+	return &httpResponse{
+		statusCode: resp.StatusCode,
+		headers:    resp.Header,
+		body:       data,
+	}, nil
 }
 
-// post sends an HTTP post request and returns the body.
+// post sends an HTTP post request with a JSON body and returns the body.
 func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io.Reader, error) {
+	return s.postWithContent(ctx, endpoint, body, contentTypeJSON, nil)
+}
+
+// postWithContent sends an HTTP post request with a body of the given content type and
+// additional headers, and returns the body.
+func (s *Service) postWithContent(ctx context.Context,
+	endpoint string,
+	body io.Reader,
+	bodyType contentType,
+	headers map[string]string,
+) (
+	io.Reader,
+	error,
+) {
 	// #nosec G404
 	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
+
+	ctx, span := s.startSpan(ctx, "POST",
+		attribute.String("address", s.address),
+		attribute.String("endpoint", endpoint),
+		attribute.String("content_type", bodyType.MediaType()),
+	)
+	defer span.End()
+	if version, exists := headers["Eth-Consensus-Version"]; exists {
+		span.SetAttributes(attribute.String("consensus_version", version))
+	}
+
 	if e := log.Trace(); e.Enabled() {
 		bodyBytes, err := io.ReadAll(body)
 		if err != nil {
@@ -108,7 +210,11 @@ func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io
 		}
 		body = bytes.NewReader(bodyBytes)
 
-		e.Str("body", string(bodyBytes)).Msg("POST request")
+		if bodyType == contentTypeJSON {
+			e.Str("body", string(bodyBytes)).Msg("POST request")
+		} else {
+			e.Str("body", fmt.Sprintf("%#x", bodyBytes)).Msg("POST request")
+		}
 	}
 
 	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), endpoint))
@@ -123,35 +229,40 @@ func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io
 		return nil, errors.Wrap(err, "failed to create POST request")
 	}
 	s.addExtraHeaders(req)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
-	if req.Header.Get("User-Agent") == "" {
-		req.Header.Set("User-Agent", "go-eth2-client/0.17.0")
+	for k, v := range headers {
+		req.Header.Set(k, v)
 	}
+	req.Header.Set("Content-Type", bodyType.MediaType())
+	req.Header.Set("Accept", "application/json")
+	s.runRequestHooks(ctx, req)
 
-	resp, err := s.client.Do(req)
+	resp, err := s.transport.Do(req)
 	if err != nil {
 		cancel()
-		return nil, errors.Wrap(err, "failed to call POST endpoint")
+		err = errors.Wrap(err, "failed to call POST endpoint")
+		spanError(ctx, err)
+		return nil, err
 	}
 	defer resp.Body.Close()
+	span.SetAttributes(attribute.Int("status_code", resp.StatusCode))
 
 	data, err := io.ReadAll(resp.Body)
 	if err != nil {
 		cancel()
-		return nil, errors.Wrap(err, "failed to read POST response")
+		err = errors.Wrap(err, "failed to read POST response")
+		spanError(ctx, err)
+		return nil, err
 	}
+	s.runResponseHooks(ctx, resp, data)
+	span.SetAttributes(attribute.Int("response_size", len(data)))
 
 	statusFamily := resp.StatusCode / 100
 	if statusFamily != 2 {
 		log.Trace().Int("status_code", resp.StatusCode).Str("data", string(data)).Msg("POST failed")
 		cancel()
-		return nil, Error{
-			Method:     http.MethodPost,
-			StatusCode: resp.StatusCode,
-			Endpoint:   endpoint,
-			Data:       data,
-		}
+		err := api.NewError(http.MethodPost, endpoint, resp.StatusCode, data)
+		spanError(ctx, err)
+		return nil, err
 	}
 	cancel()
 
@@ -160,6 +271,40 @@ func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io
 	return bytes.NewReader(data), nil
 }
 
+// requestHeaders returns the headers to send with each request, combining the extra
+// headers with the user agent and any authorization supplied in the parameters.
+func requestHeaders(parameters *parameters) map[string]string {
+	headers := make(map[string]string, len(parameters.extraHeaders)+2)
+	hasUserAgent := false
+	for k, v := range parameters.extraHeaders {
+		headers[k] = v
+		if http.CanonicalHeaderKey(k) == "User-Agent" {
+			hasUserAgent = true
+		}
+	}
+	if !hasUserAgent {
+		headers["User-Agent"] = userAgent(parameters.userAgent)
+	}
+
+	if parameters.bearerToken != "" || parameters.basicAuth != nil {
+		// Explicit authorization replaces any supplied in the extra headers.
+		for k := range headers {
+			if http.CanonicalHeaderKey(k) == "Authorization" {
+				delete(headers, k)
+			}
+		}
+	}
+	switch {
+	case parameters.bearerToken != "":
+		headers["Authorization"] = fmt.Sprintf("Bearer %s", parameters.bearerToken)
+	case parameters.basicAuth != nil:
+		credentials := fmt.Sprintf("%s:%s", parameters.basicAuth.username, parameters.basicAuth.password)
+		headers["Authorization"] = fmt.Sprintf("Basic %s", base64.StdEncoding.EncodeToString([]byte(credentials)))
+	}
+
+	return headers
+}
+
 func (s *Service) addExtraHeaders(req *http.Request) {
 	for k, v := range s.extraHeaders {
 		req.Header.Add(k, v)
@@ -168,5 +313,18 @@ func (s *Service) addExtraHeaders(req *http.Request) {
 
 // responseMetadata returns metadata related to responses.
 type responseMetadata struct {
-	Version spec.DataVersion `json:"version"`
+	Version             spec.DataVersion `json:"version"`
+	ExecutionOptimistic bool             `json:"execution_optimistic"`
+	Finalized           bool             `json:"finalized"`
+	DependentRoot       *phase0.Root     `json:"dependent_root"`
+}
+
+// apiMetadata returns the API representation of the response metadata.
+func (m *responseMetadata) apiMetadata() *api.ResponseMetadata {
+	return &api.ResponseMetadata{
+		Version:             m.Version,
+		ExecutionOptimistic: m.ExecutionOptimistic,
+		Finalized:           m.Finalized,
+		DependentRoot:       m.DependentRoot,
+	}
 }
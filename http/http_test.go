@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/http"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -35,6 +36,51 @@ func TestError(t *testing.T) {
 	require.Equal(t, "/eth/v1/beacon/genesis", httpError.Endpoint)
 }
 
+func TestErrorNodeMessage(t *testing.T) {
+	data := []byte(`{"code":503,"message":"Beacon node is currently syncing"}`)
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		w.WriteHeader(nethttp.StatusServiceUnavailable)
+		_, _ = w.Write(data)
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, err := http.New(ctx, http.WithAddress(srv.URL))
+	require.Error(t, err)
+	require.True(t, errors.Is(err, api.ErrNotSynced))
+	require.False(t, errors.Is(err, api.ErrNotFound))
+
+	var apiErr api.Error
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, nethttp.StatusServiceUnavailable, apiErr.StatusCode)
+	require.Equal(t, "Beacon node is currently syncing", apiErr.Message)
+	require.Equal(t, "/eth/v1/beacon/genesis", apiErr.Endpoint)
+}
+
+func TestErrorNotFound(t *testing.T) {
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		w.WriteHeader(nethttp.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":404,"message":"Not found"}`))
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, err := http.New(ctx, http.WithAddress(srv.URL))
+	require.Error(t, err)
+	require.True(t, errors.Is(err, api.ErrNotFound))
+	require.False(t, errors.Is(err, api.ErrNotSynced))
+
+	var apiErr api.Error
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, nethttp.MethodGet, apiErr.Method)
+	require.Equal(t, nethttp.StatusNotFound, apiErr.StatusCode)
+	require.Equal(t, "/eth/v1/beacon/genesis", apiErr.Endpoint)
+}
+
 func TestClientShouldSendExtraHeadersWhenProvided(t *testing.T) {
 	authorizationHeader := "Authorization"
 	authorizationToken := "Bearer token"
@@ -62,3 +108,124 @@ func TestClientShouldSendExtraHeadersWhenProvided(t *testing.T) {
 	require.True(t, errors.As(err, &httpError))
 	require.Equal(t, nethttp.StatusTeapot, httpError.StatusCode)
 }
+
+func TestAuthorization(t *testing.T) {
+	tests := []struct {
+		name          string
+		params        []http.Parameter
+		authorization string
+		err           string
+	}{
+		{
+			name: "BearerToken",
+			params: []http.Parameter{
+				http.WithBearerToken("token"),
+			},
+			authorization: "Bearer token",
+		},
+		{
+			name: "BasicAuth",
+			params: []http.Parameter{
+				http.WithBasicAuth("user", "pass"),
+			},
+			authorization: "Basic dXNlcjpwYXNz",
+		},
+		{
+			name: "BearerTokenOverridesExtraHeaders",
+			params: []http.Parameter{
+				http.WithExtraHeaders(map[string]string{"authorization": "Bearer other"}),
+				http.WithBearerToken("token"),
+			},
+			authorization: "Bearer token",
+		},
+		{
+			name: "BothSpecified",
+			params: []http.Parameter{
+				http.WithBearerToken("token"),
+				http.WithBasicAuth("user", "pass"),
+			},
+			err: "problem with parameters: cannot specify both bearer token and basic auth",
+		},
+		{
+			name: "BasicAuthUsernameMissing",
+			params: []http.Parameter{
+				http.WithBasicAuth("", "pass"),
+			},
+			err: "problem with parameters: no basic auth username specified",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				if len(r.Header.Values("Authorization")) != 1 || r.Header.Get("Authorization") != test.authorization {
+					w.WriteHeader(nethttp.StatusUnauthorized)
+					return
+				}
+				w.WriteHeader(nethttp.StatusTeapot)
+			}))
+			defer srv.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			_, err := http.New(ctx, append([]http.Parameter{http.WithAddress(srv.URL)}, test.params...)...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			var httpError http.Error
+			require.True(t, errors.As(err, &httpError))
+			require.Equal(t, nethttp.StatusTeapot, httpError.StatusCode)
+		})
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	tests := []struct {
+		name      string
+		params    []http.Parameter
+		userAgent string
+	}{
+		{
+			name:      "Default",
+			userAgent: "go-eth2-client/dev",
+		},
+		{
+			name: "Supplied",
+			params: []http.Parameter{
+				http.WithUserAgent("validator/1.0.0"),
+			},
+			userAgent: "validator/1.0.0 go-eth2-client/dev",
+		},
+		{
+			name: "ExtraHeaders",
+			params: []http.Parameter{
+				http.WithUserAgent("validator/1.0.0"),
+				http.WithExtraHeaders(map[string]string{"user-agent": "other"}),
+			},
+			userAgent: "other",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				if len(r.Header.Values("User-Agent")) != 1 || r.Header.Get("User-Agent") != test.userAgent {
+					w.WriteHeader(nethttp.StatusBadRequest)
+					return
+				}
+				w.WriteHeader(nethttp.StatusTeapot)
+			}))
+			defer srv.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			_, err := http.New(ctx, append([]http.Parameter{http.WithAddress(srv.URL)}, test.params...)...)
+			var httpError http.Error
+			require.True(t, errors.As(err, &httpError))
+			require.Equal(t, nethttp.StatusTeapot, httpError.StatusCode)
+		})
+	}
+}
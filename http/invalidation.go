@@ -0,0 +1,160 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"sync"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Invalidation provides information about a change to the chain that invalidates data
+// derived from it, such as duties.
+type Invalidation struct {
+	// Slot is the slot of the new head.
+	Slot phase0.Slot
+	// Reorg is true if the invalidation is the result of a chain reorganisation event.
+	Reorg bool
+	// Depth is the depth of the chain reorganisation, if Reorg is true.
+	Depth uint64
+	// PreviousDutyDependentRoot is the previous duty dependent root of the new head.
+	// This is not known for chain reorganisation events, in which case it is zero.
+	PreviousDutyDependentRoot phase0.Root
+	// CurrentDutyDependentRoot is the current duty dependent root of the new head.
+	// This is not known for chain reorganisation events, in which case it is zero.
+	CurrentDutyDependentRoot phase0.Root
+}
+
+// InvalidationHandlerFunc is called when a change to the chain invalidates data derived from it,
+// allowing users to drop their own derived data.  A single change can result in the handler being
+// called more than once, for example for a chain reorganisation event and the subsequent head event,
+// so handlers should be idempotent.
+type InvalidationHandlerFunc func(ctx context.Context, invalidation *Invalidation)
+
+// invalidationBus purges cached data when the beacon node reports changes to the chain.
+type invalidationBus struct {
+	handlers []InvalidationHandlerFunc
+
+	mu sync.Mutex
+	// headInvalidators are called for every new head.
+	headInvalidators []func()
+	// dependentInvalidators are called when the duty dependent roots change.
+	dependentInvalidators []func()
+	// Duty dependent roots of the most recent head.
+	haveHead                  bool
+	previousDutyDependentRoot phase0.Root
+	currentDutyDependentRoot  phase0.Root
+}
+
+// newInvalidationBus creates an invalidation bus that notifies the given handlers.
+func newInvalidationBus(handlers []InvalidationHandlerFunc) *invalidationBus {
+	return &invalidationBus{
+		handlers: handlers,
+	}
+}
+
+// onHead registers a function to be called whenever the beacon node reports a new head.
+func (b *invalidationBus) onHead(fn func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.headInvalidators = append(b.headInvalidators, fn)
+}
+
+// onDependentRootChange registers a function to be called whenever the duty dependent roots
+// change other than by the normal progression of epochs, or the chain reorganises.
+func (b *invalidationBus) onDependentRootChange(fn func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.dependentInvalidators = append(b.dependentInvalidators, fn)
+}
+
+// start subscribes the invalidation bus to the events of the beacon node.
+func (b *invalidationBus) start(ctx context.Context, s *Service) error {
+	if err := s.Events(ctx, []string{"head", "chain_reorg"}, func(event *apiv1.Event) {
+		b.handleEvent(ctx, event)
+	}); err != nil {
+		return errors.Wrap(err, "failed to subscribe to invalidation events")
+	}
+
+	return nil
+}
+
+// handleEvent handles a head or chain reorganisation event.
+func (b *invalidationBus) handleEvent(ctx context.Context, event *apiv1.Event) {
+	var invalidation *Invalidation
+
+	b.mu.Lock()
+	switch data := event.Data.(type) {
+	case *apiv1.HeadEvent:
+		if b.dependentRootsChanged(data) {
+			invalidation = &Invalidation{
+				Slot:                      data.Slot,
+				PreviousDutyDependentRoot: data.PreviousDutyDependentRoot,
+				CurrentDutyDependentRoot:  data.CurrentDutyDependentRoot,
+			}
+		}
+		b.haveHead = true
+		b.previousDutyDependentRoot = data.PreviousDutyDependentRoot
+		b.currentDutyDependentRoot = data.CurrentDutyDependentRoot
+	case *apiv1.ChainReorgEvent:
+		invalidation = &Invalidation{
+			Slot:  data.Slot,
+			Reorg: true,
+			Depth: data.Depth,
+		}
+	default:
+		b.mu.Unlock()
+		return
+	}
+	invalidators := b.headInvalidators
+	if invalidation != nil {
+		invalidators = append(invalidators[:len(invalidators):len(invalidators)], b.dependentInvalidators...)
+	}
+	b.mu.Unlock()
+
+	for _, invalidator := range invalidators {
+		invalidator()
+	}
+	if invalidation != nil {
+		for _, handler := range b.handlers {
+			handler(ctx, invalidation)
+		}
+	}
+}
+
+// dependentRootsChanged returns true if the duty dependent roots of the head event are not
+// consistent with those of the previous head.  Within an epoch the roots should not change,
+// and on moving to the next epoch the previous root should be the prior current root.
+// Must be called with the lock held.
+func (b *invalidationBus) dependentRootsChanged(event *apiv1.HeadEvent) bool {
+	if !b.haveHead {
+		return false
+	}
+
+	switch event.PreviousDutyDependentRoot {
+	case b.previousDutyDependentRoot:
+		// Same epoch.
+		return event.CurrentDutyDependentRoot != b.currentDutyDependentRoot
+	case b.currentDutyDependentRoot:
+		// Next epoch.
+		return false
+	default:
+		return true
+	}
+}
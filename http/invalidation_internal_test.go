@@ -0,0 +1,151 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func headEvent(slot phase0.Slot, previous byte, current byte) *apiv1.Event {
+	return &apiv1.Event{
+		Topic: "head",
+		Data: &apiv1.HeadEvent{
+			Slot:                      slot,
+			PreviousDutyDependentRoot: phase0.Root{previous},
+			CurrentDutyDependentRoot:  phase0.Root{current},
+		},
+	}
+}
+
+func TestInvalidationBus(t *testing.T) {
+	tests := []struct {
+		name          string
+		events        []*apiv1.Event
+		heads         int
+		invalidations []*Invalidation
+	}{
+		{
+			name:   "FirstHead",
+			events: []*apiv1.Event{headEvent(1, 0x01, 0x02)},
+			heads:  1,
+		},
+		{
+			name: "SameEpoch",
+			events: []*apiv1.Event{
+				headEvent(1, 0x01, 0x02),
+				headEvent(2, 0x01, 0x02),
+			},
+			heads: 2,
+		},
+		{
+			name: "NextEpoch",
+			events: []*apiv1.Event{
+				headEvent(31, 0x01, 0x02),
+				headEvent(32, 0x02, 0x03),
+			},
+			heads: 2,
+		},
+		{
+			name: "CurrentChanged",
+			events: []*apiv1.Event{
+				headEvent(1, 0x01, 0x02),
+				headEvent(2, 0x01, 0x04),
+			},
+			heads: 2,
+			invalidations: []*Invalidation{
+				{
+					Slot:                      2,
+					PreviousDutyDependentRoot: phase0.Root{0x01},
+					CurrentDutyDependentRoot:  phase0.Root{0x04},
+				},
+			},
+		},
+		{
+			name: "PreviousChanged",
+			events: []*apiv1.Event{
+				headEvent(33, 0x01, 0x02),
+				headEvent(34, 0x04, 0x02),
+			},
+			heads: 2,
+			invalidations: []*Invalidation{
+				{
+					Slot:                      34,
+					PreviousDutyDependentRoot: phase0.Root{0x04},
+					CurrentDutyDependentRoot:  phase0.Root{0x02},
+				},
+			},
+		},
+		{
+			name: "Reorg",
+			events: []*apiv1.Event{
+				headEvent(1, 0x01, 0x02),
+				{
+					Topic: "chain_reorg",
+					Data: &apiv1.ChainReorgEvent{
+						Slot:  2,
+						Depth: 1,
+					},
+				},
+			},
+			heads: 2,
+			invalidations: []*Invalidation{
+				{
+					Slot:  2,
+					Reorg: true,
+					Depth: 1,
+				},
+			},
+		},
+		{
+			name: "OtherEvent",
+			events: []*apiv1.Event{
+				{
+					Topic: "block",
+					Data:  &apiv1.BlockEvent{},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			invalidations := make([]*Invalidation, 0)
+			bus := newInvalidationBus([]InvalidationHandlerFunc{
+				func(_ context.Context, invalidation *Invalidation) {
+					invalidations = append(invalidations, invalidation)
+				},
+			})
+			heads := 0
+			bus.onHead(func() { heads++ })
+			dependents := 0
+			bus.onDependentRootChange(func() { dependents++ })
+
+			for _, event := range test.events {
+				bus.handleEvent(context.Background(), event)
+			}
+			require.Equal(t, test.heads, heads)
+			require.Equal(t, len(test.invalidations), dependents)
+			if test.invalidations == nil {
+				require.Empty(t, invalidations)
+			} else {
+				require.Equal(t, test.invalidations, invalidations)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/preset"
+)
+
+// NetworkProfile provides the profile of the network to which the node is connected.
+// A warning is logged if the container sizes of the network do not match the preset in use by the client.
+func (s *Service) NetworkProfile(ctx context.Context) (*api.NetworkProfile, error) {
+	config, err := s.SpecConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := config.NetworkProfile()
+	if err := profile.CheckContainerSizes(preset.Current()); err != nil {
+		s.log.Warn().Err(err).Msg("Network container sizes do not match client preset; use WithPreset to set the network preset")
+	}
+
+	return profile, nil
+}
@@ -16,6 +16,8 @@ package http
 import (
 	"context"
 	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
 )
 
 // NodeClient provides the client for the node.
@@ -40,3 +42,16 @@ func (s *Service) NodeClient(ctx context.Context) (string, error) {
 		return nodeVersion, nil
 	}
 }
+
+// ClientType provides the type and version of the client software of the node, as
+// parsed from its node version.
+func (s *Service) ClientType(ctx context.Context) (api.ClientType, string, error) {
+	nodeVersion, err := s.NodeVersion(ctx)
+	if err != nil {
+		return api.ClientTypeUnknown, "", err
+	}
+
+	clientType, version := api.ParseNodeVersion(nodeVersion)
+
+	return clientType, version, nil
+}
@@ -0,0 +1,44 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+type nodeIdentityJSON struct {
+	Data *api.NodeIdentity `json:"data"`
+}
+
+// NodeIdentity provides the network identity of the node.
+func (s *Service) NodeIdentity(ctx context.Context) (*api.NodeIdentity, error) {
+	respBodyReader, err := s.get(ctx, "/eth/v1/node/identity")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request node identity")
+	}
+
+	var resp nodeIdentityJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse node identity")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("node identity not returned")
+	}
+
+	return resp.Data, nil
+}
@@ -14,19 +14,67 @@
 package http
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/logging"
+	"github.com/attestantio/go-eth2-client/spec/preset"
+	utildeneb "github.com/attestantio/go-eth2-client/util/deneb"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// basicAuth holds the credentials for HTTP basic authentication.
+type basicAuth struct {
+	username string
+	password string
+}
+
 type parameters struct {
 	logLevel        zerolog.Level
+	logger          logging.Logger
 	address         string
 	timeout         time.Duration
 	indexChunkSize  int
 	pubKeyChunkSize int
 	extraHeaders    map[string]string
+	bearerToken     string
+	basicAuth       *basicAuth
+	enforceJSON     bool
+	preferSSZ       bool
+	retry           *retryPolicy
+	hedging         *hedgePolicy
+	conditional     bool
+	transport       Transport
+	tracerProvider  trace.TracerProvider
+	graffiti        []byte
+	stateStore      StateStore
+
+	maxIdleConnsPerHost int
+	maxConnsPerHost     int
+	keepAlive           time.Duration
+	http2               bool
+	dialContext         DialContextFunc
+
+	attestationDataCacheTTL time.Duration
+
+	dutiesIndexChunkSize int
+	features             map[api.Feature]bool
+
+	validatorRegistrationChunkSize int
+	validatorRegistrationDedup     bool
+	headerRangeConcurrency         int
+	blobPrecheck                   bool
+	kzgVerifier                    utildeneb.KZGVerifier
+
+	requestHooks           []RequestHookFunc
+	responseHooks          []ResponseHookFunc
+	invalidationHandlers   []InvalidationHandlerFunc
+	partialFailureHandlers []PartialFailureHandlerFunc
+	userAgent              string
+	preset                 *preset.Preset
 }
 
 // Parameter is the interface for service parameters.
@@ -47,6 +95,14 @@ func WithLogLevel(logLevel zerolog.Level) Parameter {
 	})
 }
 
+// WithLogger sets a logger to which the module sends its logs, in place of the default zerolog logger.
+// If a logger is supplied the log level is obtained from it, and WithLogLevel is ignored.
+func WithLogger(logger logging.Logger) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logger = logger
+	})
+}
+
 // WithAddress provides the address for the endpoint.
 func WithAddress(address string) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -75,13 +131,301 @@ func WithPubKeyChunkSize(pubKeyChunkSize int) Parameter {
 	})
 }
 
-// WithExtraHeaders sets additional headers to be sent with each HTTP request.
+// WithDutiesIndexChunkSize sets the maximum number of indices to send for individual duties requests.
+func WithDutiesIndexChunkSize(dutiesIndexChunkSize int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.dutiesIndexChunkSize = dutiesIndexChunkSize
+	})
+}
+
+// WithValidatorRegistrationChunkSize sets the maximum number of validator registrations to send in
+// a single request.  Larger submissions are split into multiple requests.  Defaults to 500.
+func WithValidatorRegistrationChunkSize(chunkSize int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validatorRegistrationChunkSize = chunkSize
+	})
+}
+
+// WithHeaderRangeConcurrency sets the maximum number of concurrent requests made when fetching
+// beacon block headers for a range of slots.  Defaults to 16.
+func WithHeaderRangeConcurrency(concurrency int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.headerRangeConcurrency = concurrency
+	})
+}
+
+// WithValidatorRegistrationDeduplication sets whether validator registrations that are unchanged since
+// they were last successfully submitted through this service are dropped from submissions.
+// Defaults to false.
+func WithValidatorRegistrationDeduplication(enabled bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validatorRegistrationDedup = enabled
+	})
+}
+
+// WithExtraHeaders sets additional headers to be sent with each HTTP request, including
+// the request for the events stream.
 func WithExtraHeaders(headers map[string]string) Parameter {
 	return parameterFunc(func(p *parameters) {
 		p.extraHeaders = headers
 	})
 }
 
+// WithBearerToken sets a token to be sent as a bearer token in the Authorization header of each HTTP request.
+// This takes precedence over any Authorization header supplied with WithExtraHeaders.
+func WithBearerToken(token string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.bearerToken = token
+	})
+}
+
+// WithBasicAuth sets credentials to be sent using HTTP basic authentication with each HTTP request.
+// This takes precedence over any Authorization header supplied with WithExtraHeaders.
+func WithBasicAuth(username string, password string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.basicAuth = &basicAuth{
+			username: username,
+			password: password,
+		}
+	})
+}
+
+// WithFeature enables or disables the given feature.
+func WithFeature(feature api.Feature, enabled bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.features[feature] = enabled
+	})
+}
+
+// WithEnableV3Proposals enables obtaining block proposals from the v3 proposal endpoint.
+func WithEnableV3Proposals(enabled bool) Parameter {
+	return WithFeature(api.FeatureV3Proposals, enabled)
+}
+
+// WithReducedMemory reduces the memory used when handling large responses, at the cost of speed.
+func WithReducedMemory(enabled bool) Parameter {
+	return WithFeature(api.FeatureReducedMemory, enabled)
+}
+
+// WithValidatorsPOST obtains validators with the POST variant of the validators endpoint, allowing
+// large numbers of validators to be requested without chunking.
+func WithValidatorsPOST(enabled bool) Parameter {
+	return WithFeature(api.FeatureValidatorsPOST, enabled)
+}
+
+// WithEnforceJSON forces all requests to send JSON bodies, rather than using SSZ where supported.
+func WithEnforceJSON(enforceJSON bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.enforceJSON = enforceJSON
+	})
+}
+
+// WithPreferSSZ sends SSZ bodies for block, blinded block and attestation submissions, falling
+// back to JSON if the beacon node does not accept SSZ.  Versioned block submissions always
+// prefer SSZ unless JSON is enforced.
+func WithPreferSSZ(preferSSZ bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.preferSSZ = preferSSZ
+	})
+}
+
+// WithBlobPrecheck verifies that the blob sidecars of block contents are consistent with the block
+// before submitting them, returning an error rather than sending them to the beacon node if not.
+func WithBlobPrecheck(enabled bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.blobPrecheck = enabled
+	})
+}
+
+// WithKZGVerifier sets the verifier used to check the KZG proofs of blob sidecars when the blob
+// pre-check is enabled.  If not supplied the KZG proofs are left to the beacon node to verify.
+func WithKZGVerifier(verifier utildeneb.KZGVerifier) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.kzgVerifier = verifier
+	})
+}
+
+// WithPreset sets the preset used when decoding containers, for example to connect to a devnet using
+// the minimal preset.  The preset applies to the whole process.
+// If the preset does not share the SSZ encoding of mainnet then all requests are sent as JSON.
+func WithPreset(chainPreset *preset.Preset) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.preset = chainPreset
+	})
+}
+
+// WithRetry sets the policy for retrying failed GET requests.  A request is attempted up to
+// maxAttempts times, with a jittered delay starting at backoff and doubling between attempts.
+// Requests are retried on timeouts and on responses with any of the supplied status codes; if no
+// status codes are supplied then all 5xx responses are retried.
+// Submissions are never retried, with the exception of individual chunks of validator registrations.
+func WithRetry(maxAttempts int, backoff time.Duration, retryableStatuses []int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.retry = &retryPolicy{
+			maxAttempts:       maxAttempts,
+			backoff:           backoff,
+			retryableStatuses: make(map[int]struct{}, len(retryableStatuses)),
+		}
+		for _, status := range retryableStatuses {
+			p.retry.retryableStatuses[status] = struct{}{}
+		}
+	})
+}
+
+// WithHedging enables hedging of GET requests: if a request has not completed after the given
+// quantile of recent request latencies, or minDelay if that is longer, a duplicate request is
+// sent and the first successful response is used.  This reduces the impact of occasional slow
+// responses from the beacon node, at the cost of additional requests.
+// For example, a quantile of 0.95 hedges the slowest 5% of requests.
+func WithHedging(quantile float64, minDelay time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.hedging = &hedgePolicy{
+			quantile: quantile,
+			minDelay: minDelay,
+		}
+	})
+}
+
+// WithConditionalRequests enables conditional requests for responses that rarely change, such as
+// the spec and genesis, when they are refetched.  If the beacon node supplies an ETag or Last-Modified
+// header with these responses it is sent back when refetching, and a cached copy of the response is
+// used if the node reports that it has not been modified.
+// Conditional requests are enabled by default.
+func WithConditionalRequests(enabled bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.conditional = enabled
+	})
+}
+
+// WithTransport sets the transport used to carry requests and event streams to the beacon node,
+// in place of the default HTTP transport.
+func WithTransport(transport Transport) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.transport = transport
+	})
+}
+
+// WithRequestHook adds a hook that is called with each request before it is sent to the beacon node,
+// for example to add custom logging or to sign requests for a proxy.  Multiple hooks can be supplied,
+// and are called in the order in which they were supplied.
+func WithRequestHook(hook RequestHookFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.requestHooks = append(p.requestHooks, hook)
+	})
+}
+
+// WithResponseHook adds a hook that is called with each response received from the beacon node,
+// for example to add custom logging or to capture responses.  Multiple hooks can be supplied,
+// and are called in the order in which they were supplied.
+func WithResponseHook(hook ResponseHookFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.responseHooks = append(p.responseHooks, hook)
+	})
+}
+
+// WithUserAgent sets the user agent sent with requests.  The module's own user agent, including
+// its version, is appended to that supplied.
+// A User-Agent header supplied with WithExtraHeaders takes precedence.
+func WithUserAgent(userAgent string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.userAgent = userAgent
+	})
+}
+
+// WithTracerProvider sets a tracer provider, used to create spans for calls to the beacon node.
+// If not supplied no spans are created.
+func WithTracerProvider(provider trace.TracerProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.tracerProvider = provider
+	})
+}
+
+// WithGraffiti sets the default graffiti for block proposals, used when a proposal call does not supply its own.
+// Graffiti can be at most 32 bytes.
+func WithGraffiti(graffiti []byte) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.graffiti = graffiti
+	})
+}
+
+// WithStateStore sets a store for beacon states.  States fetched from the beacon node are
+// added to the store, and subsequent requests for the same state are served from it.
+func WithStateStore(store StateStore) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.stateStore = store
+	})
+}
+
+// WithMaxIdleConnsPerHost sets the maximum number of idle connections to the beacon node kept
+// for reuse by the default transport.  Defaults to 64.
+func WithMaxIdleConnsPerHost(maxIdleConnsPerHost int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxIdleConnsPerHost = maxIdleConnsPerHost
+	})
+}
+
+// WithMaxConnsPerHost sets the maximum number of connections to the beacon node, including those
+// in use, opened by the default transport.  0 means no limit.  Defaults to 64.
+func WithMaxConnsPerHost(maxConnsPerHost int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxConnsPerHost = maxConnsPerHost
+	})
+}
+
+// WithKeepAlive sets the interval between keep-alive probes on connections to the beacon node
+// opened by the default transport.  A negative value disables keep-alive probes.  Defaults to 30s.
+func WithKeepAlive(keepAlive time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.keepAlive = keepAlive
+	})
+}
+
+// WithHTTP2 sets whether the default transport attempts to use HTTP/2 for connections to the beacon node.
+// HTTP/2 requires the beacon node to be served over TLS.  Defaults to false.
+func WithHTTP2(enabled bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.http2 = enabled
+	})
+}
+
+// WithDialContext sets the function used by the default transport to open connections to the
+// beacon node, for example to use a custom resolver or proxy.
+func WithDialContext(dialContext DialContextFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.dialContext = dialContext
+	})
+}
+
+// WithInvalidationHandler adds a handler that is called when the beacon node reports a change to the
+// chain that invalidates data derived from it, either a chain reorganisation or a change to the duty
+// dependent roots, so that users can drop their own derived data such as duties.
+// Handlers are called in the order in which they were supplied.
+func WithInvalidationHandler(handler InvalidationHandlerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.invalidationHandlers = append(p.invalidationHandlers, handler)
+	})
+}
+
+// WithPartialFailureHandler adds a handler that is called when some, but not all, chunks of a chunked
+// request for data such as duties fail.  The request returns the data from the successful chunks without
+// error, so this is the way to find out which validator indices are missing from the results.
+// Handlers are called in the order in which they were supplied.
+func WithPartialFailureHandler(handler PartialFailureHandlerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.partialFailureHandlers = append(p.partialFailureHandlers, handler)
+	})
+}
+
+// WithAttestationDataCache enables caching of attestation data for the given time, so that
+// multiple requests for the same slot and committee index only result in a single call to the
+// beacon node.  The cache is cleared whenever the beacon node reports a new head.
+// A TTL of 0 disables the cache, which is the default.
+func WithAttestationDataCache(ttl time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.attestationDataCacheTTL = ttl
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
@@ -90,6 +434,21 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 		indexChunkSize:  -1,
 		pubKeyChunkSize: -1,
 		extraHeaders:    make(map[string]string),
+		retry: &retryPolicy{
+			maxAttempts: 3,
+			backoff:     100 * time.Millisecond,
+		},
+		conditional: true,
+
+		maxIdleConnsPerHost: 64,
+		maxConnsPerHost:     64,
+		keepAlive:           30 * time.Second,
+
+		dutiesIndexChunkSize: -1,
+		features:             make(map[api.Feature]bool),
+
+		validatorRegistrationChunkSize: 500,
+		headerRangeConcurrency:         16,
 	}
 	for _, p := range params {
 		if params != nil {
@@ -109,6 +468,86 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.pubKeyChunkSize == 0 {
 		return nil, errors.New("no public key chunk size specified")
 	}
+	if parameters.dutiesIndexChunkSize == 0 {
+		return nil, errors.New("no duties index chunk size specified")
+	}
+	if parameters.validatorRegistrationChunkSize < 1 {
+		return nil, errors.New("validator registration chunk size must be at least 1")
+	}
+	if parameters.headerRangeConcurrency < 1 {
+		return nil, errors.New("header range concurrency must be at least 1")
+	}
+	for _, hook := range parameters.requestHooks {
+		if hook == nil {
+			return nil, errors.New("request hook cannot be nil")
+		}
+	}
+	for _, hook := range parameters.responseHooks {
+		if hook == nil {
+			return nil, errors.New("response hook cannot be nil")
+		}
+	}
+	for _, handler := range parameters.invalidationHandlers {
+		if handler == nil {
+			return nil, errors.New("invalidation handler cannot be nil")
+		}
+	}
+	for _, handler := range parameters.partialFailureHandlers {
+		if handler == nil {
+			return nil, errors.New("partial failure handler cannot be nil")
+		}
+	}
+	if parameters.preferSSZ && parameters.enforceJSON {
+		return nil, errors.New("cannot both prefer SSZ and enforce JSON")
+	}
+	if parameters.preset != nil {
+		if err := parameters.preset.Validate(); err != nil {
+			return nil, errors.Wrap(err, "invalid preset")
+		}
+		if !parameters.preset.SSZCompatible() {
+			if parameters.preferSSZ {
+				return nil, fmt.Errorf("cannot prefer SSZ with the %s preset", parameters.preset.Name)
+			}
+			parameters.enforceJSON = true
+		}
+	}
+	if parameters.retry == nil || parameters.retry.maxAttempts < 1 {
+		return nil, errors.New("retry max attempts must be at least 1")
+	}
+	if parameters.retry.backoff < 0 {
+		return nil, errors.New("retry backoff cannot be negative")
+	}
+	if parameters.hedging != nil {
+		if parameters.hedging.quantile <= 0 || parameters.hedging.quantile >= 1 {
+			return nil, errors.New("hedging quantile must be between 0 and 1")
+		}
+		if parameters.hedging.minDelay < 0 {
+			return nil, errors.New("hedging minimum delay cannot be negative")
+		}
+	}
+	if len(parameters.graffiti) > graffitiLength {
+		return nil, fmt.Errorf("graffiti cannot be longer than %d bytes", graffitiLength)
+	}
+	if parameters.bearerToken != "" && parameters.basicAuth != nil {
+		return nil, errors.New("cannot specify both bearer token and basic auth")
+	}
+	if parameters.basicAuth != nil && parameters.basicAuth.username == "" {
+		return nil, errors.New("no basic auth username specified")
+	}
+	if parameters.maxIdleConnsPerHost < 0 {
+		return nil, errors.New("max idle connections per host cannot be negative")
+	}
+	if parameters.maxConnsPerHost < 0 {
+		return nil, errors.New("max connections per host cannot be negative")
+	}
+	if parameters.attestationDataCacheTTL < 0 {
+		return nil, errors.New("attestation data cache TTL cannot be negative")
+	}
+	for feature := range parameters.features {
+		if _, exists := api.FeatureInformation(feature); !exists {
+			return nil, fmt.Errorf("unknown feature %s", feature)
+		}
+	}
 
 	return &parameters, nil
 }
@@ -0,0 +1,245 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// proposalJSON is the JSON representation of a v3 proposal response.
+type proposalJSON struct {
+	Version                 spec.DataVersion `json:"version"`
+	ExecutionPayloadBlinded bool             `json:"execution_payload_blinded"`
+	ExecutionPayloadValue   string           `json:"execution_payload_value"`
+	ConsensusBlockValue     string           `json:"consensus_block_value"`
+	Data                    json.RawMessage  `json:"data"`
+}
+
+// Proposal fetches a proposal for signing from the v3 proposal endpoint.
+// The proposal may contain either a full or a blinded block, as decided by the beacon node.
+// If graffiti is nil the service default graffiti, as set with WithGraffiti, is used.
+func (s *Service) Proposal(ctx context.Context,
+	slot phase0.Slot,
+	randaoReveal phase0.BLSSignature,
+	graffiti []byte,
+) (
+	*api.VersionedProposal,
+	error,
+) {
+	fixedGraffiti, err := s.proposalGraffiti(graffiti)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.proposal(ctx, slot, randaoReveal, fixedGraffiti)
+}
+
+func (s *Service) proposal(ctx context.Context,
+	slot phase0.Slot,
+	randaoReveal phase0.BLSSignature,
+	graffiti []byte,
+) (
+	*api.VersionedProposal,
+	error,
+) {
+	endpoint := fmt.Sprintf("/eth/v3/validator/blocks/%d?randao_reveal=%#x&graffiti=%#x", slot, randaoReveal, graffiti)
+	httpResp, err := s.getResponse(ctx, endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request proposal")
+	}
+
+	var resp proposalJSON
+	if err := json.Unmarshal(httpResp.body, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse proposal")
+	}
+
+	// Headers take precedence over the body, as they are present regardless of the content type.
+	if version := httpResp.headers.Get("Eth-Consensus-Version"); version != "" {
+		if err := json.Unmarshal([]byte(fmt.Sprintf("%q", version)), &resp.Version); err != nil {
+			return nil, errors.Wrap(err, "invalid consensus version header")
+		}
+	}
+	if blinded := httpResp.headers.Get("Eth-Execution-Payload-Blinded"); blinded != "" {
+		resp.ExecutionPayloadBlinded, err = strconv.ParseBool(blinded)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid execution payload blinded header")
+		}
+	}
+	if value := httpResp.headers.Get("Eth-Execution-Payload-Value"); value != "" {
+		resp.ExecutionPayloadValue = value
+	}
+	if value := httpResp.headers.Get("Eth-Consensus-Block-Value"); value != "" {
+		resp.ConsensusBlockValue = value
+	}
+
+	res := &api.VersionedProposal{
+		Version: resp.Version,
+		Blinded: resp.ExecutionPayloadBlinded,
+	}
+	if res.ExecutionValue, err = parseWei(resp.ExecutionPayloadValue); err != nil {
+		return nil, errors.Wrap(err, "invalid execution payload value")
+	}
+	if res.ConsensusValue, err = parseWei(resp.ConsensusBlockValue); err != nil {
+		return nil, errors.Wrap(err, "invalid consensus block value")
+	}
+
+	if err := decodeProposalData(res, resp.Data); err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyProposal(res, slot, randaoReveal, graffiti); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// decodeProposalData decodes the data of a proposal in to the appropriate field for its version.
+func decodeProposalData(res *api.VersionedProposal, data []byte) error {
+	var err error
+	switch {
+	case res.Version == spec.DataVersionPhase0 && !res.Blinded:
+		res.Phase0 = &phase0.BeaconBlock{}
+		err = json.Unmarshal(data, res.Phase0)
+	case res.Version == spec.DataVersionAltair && !res.Blinded:
+		res.Altair = &altair.BeaconBlock{}
+		err = json.Unmarshal(data, res.Altair)
+	case res.Version == spec.DataVersionBellatrix && !res.Blinded:
+		res.Bellatrix = &bellatrix.BeaconBlock{}
+		err = json.Unmarshal(data, res.Bellatrix)
+	case res.Version == spec.DataVersionBellatrix && res.Blinded:
+		res.BellatrixBlinded = &apiv1bellatrix.BlindedBeaconBlock{}
+		err = json.Unmarshal(data, res.BellatrixBlinded)
+	case res.Version == spec.DataVersionCapella && !res.Blinded:
+		res.Capella = &capella.BeaconBlock{}
+		err = json.Unmarshal(data, res.Capella)
+	case res.Version == spec.DataVersionCapella && res.Blinded:
+		res.CapellaBlinded = &apiv1capella.BlindedBeaconBlock{}
+		err = json.Unmarshal(data, res.CapellaBlinded)
+	case res.Version == spec.DataVersionDeneb && !res.Blinded:
+		res.Deneb = &apiv1deneb.BlockContents{}
+		err = json.Unmarshal(data, res.Deneb)
+	case res.Version == spec.DataVersionDeneb && res.Blinded:
+		res.DenebBlinded = &apiv1deneb.BlindedBlockContents{}
+		err = json.Unmarshal(data, res.DenebBlinded)
+	default:
+		blinded := ""
+		if res.Blinded {
+			blinded = "blinded "
+		}
+		return fmt.Errorf("unsupported %sproposal version %s", blinded, res.Version)
+	}
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to parse %s proposal", res.Version))
+	}
+
+	return nil
+}
+
+// verifyProposal ensures that the proposal returned to us is as expected given our input.
+func (s *Service) verifyProposal(proposal *api.VersionedProposal,
+	slot phase0.Slot,
+	randaoReveal phase0.BLSSignature,
+	graffiti []byte,
+) error {
+	proposalSlot, err := proposal.Slot()
+	if err != nil {
+		return err
+	}
+	if proposalSlot != slot {
+		return errors.New("proposal not for requested slot")
+	}
+
+	// Only check the RANDAO reveal and graffiti if we are not connected to DVT middleware,
+	// as the returned values will be decided by the middleware.
+	if s.connectedToDVTMiddleware {
+		return nil
+	}
+	proposalRandaoReveal, err := proposal.RandaoReveal()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(proposalRandaoReveal[:], randaoReveal[:]) {
+		return fmt.Errorf("proposal has RANDAO reveal %#x; expected %#x", proposalRandaoReveal[:], randaoReveal[:])
+	}
+	proposalGraffiti, err := proposal.Graffiti()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(proposalGraffiti[:], graffiti) {
+		return fmt.Errorf("proposal has graffiti %#x; expected %#x", proposalGraffiti[:], graffiti)
+	}
+
+	return nil
+}
+
+// beaconBlockFromProposal returns the beacon block contained in a full proposal.
+func beaconBlockFromProposal(proposal *api.VersionedProposal) *spec.VersionedBeaconBlock {
+	res := &spec.VersionedBeaconBlock{
+		Version:   proposal.Version,
+		Phase0:    proposal.Phase0,
+		Altair:    proposal.Altair,
+		Bellatrix: proposal.Bellatrix,
+		Capella:   proposal.Capella,
+	}
+	if proposal.Deneb != nil {
+		res.Deneb = proposal.Deneb.Block
+	}
+
+	return res
+}
+
+// blindedBeaconBlockFromProposal returns the blinded beacon block contained in a blinded proposal.
+func blindedBeaconBlockFromProposal(proposal *api.VersionedProposal) *api.VersionedBlindedBeaconBlock {
+	res := &api.VersionedBlindedBeaconBlock{
+		Version:   proposal.Version,
+		Bellatrix: proposal.BellatrixBlinded,
+		Capella:   proposal.CapellaBlinded,
+	}
+	if proposal.DenebBlinded != nil {
+		res.Deneb = proposal.DenebBlinded.BlindedBlock
+	}
+
+	return res
+}
+
+// parseWei parses a decimal Wei value, returning nil if the value is not present.
+func parseWei(value string) (*big.Int, error) {
+	if value == "" {
+		return nil, nil
+	}
+	res, success := new(big.Int).SetString(strings.TrimSpace(value), 10)
+	if !success || res.Sign() < 0 {
+		return nil, fmt.Errorf("invalid value %q", value)
+	}
+
+	return res, nil
+}
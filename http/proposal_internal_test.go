@@ -0,0 +1,141 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// proposalPhase0Block is a phase 0 block at slot 1 with zero RANDAO reveal and graffiti.
+const proposalPhase0Block = `{"slot":"1","proposer_index":"2","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","state_root":"0x0000000000000000000000000000000000000000000000000000000000000000","body":{"randao_reveal":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","eth1_data":{"deposit_root":"0x0000000000000000000000000000000000000000000000000000000000000000","deposit_count":"0","block_hash":"0x0000000000000000000000000000000000000000000000000000000000000000"},"graffiti":"0x0000000000000000000000000000000000000000000000000000000000000000","proposer_slashings":[],"attester_slashings":[],"attestations":[],"deposits":[],"voluntary_exits":[]}}`
+
+func TestProposal(t *testing.T) {
+	tests := []struct {
+		name           string
+		headers        map[string]string
+		body           string
+		slot           phase0.Slot
+		executionValue *big.Int
+		consensusValue *big.Int
+		err            string
+	}{
+		{
+			name:           "Body",
+			body:           fmt.Sprintf(`{"version":"phase0","execution_payload_blinded":false,"execution_payload_value":"12","consensus_block_value":"34","data":%s}`, proposalPhase0Block),
+			slot:           1,
+			executionValue: big.NewInt(12),
+			consensusValue: big.NewInt(34),
+		},
+		{
+			name: "Headers",
+			headers: map[string]string{
+				"Eth-Consensus-Version":         "phase0",
+				"Eth-Execution-Payload-Blinded": "false",
+				"Eth-Execution-Payload-Value":   "56",
+				"Eth-Consensus-Block-Value":     "78",
+			},
+			body:           fmt.Sprintf(`{"data":%s}`, proposalPhase0Block),
+			slot:           1,
+			executionValue: big.NewInt(56),
+			consensusValue: big.NewInt(78),
+		},
+		{
+			name: "ValueInvalid",
+			headers: map[string]string{
+				"Eth-Execution-Payload-Value": "-1",
+			},
+			body: fmt.Sprintf(`{"version":"phase0","data":%s}`, proposalPhase0Block),
+			slot: 1,
+			err:  `invalid execution payload value: invalid value "-1"`,
+		},
+		{
+			name: "BlindedUnsupported",
+			body: fmt.Sprintf(`{"version":"phase0","execution_payload_blinded":true,"data":%s}`, proposalPhase0Block),
+			slot: 1,
+			err:  "unsupported blinded proposal version phase0",
+		},
+		{
+			name: "SlotWrong",
+			body: fmt.Sprintf(`{"version":"phase0","data":%s}`, proposalPhase0Block),
+			slot: 2,
+			err:  "proposal not for requested slot",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, fmt.Sprintf("/eth/v3/validator/blocks/%d", test.slot), r.URL.Path)
+				for k, v := range test.headers {
+					w.Header().Set(k, v)
+				}
+				_, err := w.Write([]byte(test.body))
+				require.NoError(t, err)
+			}))
+			defer srv.Close()
+
+			proposal, err := testService(t, srv).Proposal(context.Background(), test.slot, phase0.BLSSignature{}, nil)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.DataVersionPhase0, proposal.Version)
+			require.False(t, proposal.Blinded)
+			require.Equal(t, test.executionValue, proposal.ExecutionValue)
+			require.Equal(t, test.consensusValue, proposal.ConsensusValue)
+			require.Equal(t, test.slot, proposal.Phase0.Slot)
+		})
+	}
+}
+
+func TestBeaconBlockProposalV3Feature(t *testing.T) {
+	for _, v3Available := range []bool{true, false} {
+		t.Run(fmt.Sprintf("V3Available%t", v3Available), func(t *testing.T) {
+			paths := make([]string, 0)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				paths = append(paths, r.URL.Path)
+				if r.URL.Path == "/eth/v3/validator/blocks/1" && !v3Available {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, err := w.Write([]byte(fmt.Sprintf(`{"version":"phase0","data":%s}`, proposalPhase0Block)))
+				require.NoError(t, err)
+			}))
+			defer srv.Close()
+
+			s := testService(t, srv)
+			s.features = map[api.Feature]struct{}{api.FeatureV3Proposals: {}}
+
+			block, err := s.BeaconBlockProposal(context.Background(), 1, phase0.BLSSignature{}, nil)
+			require.NoError(t, err)
+			require.Equal(t, phase0.Slot(1), block.Phase0.Slot)
+			if v3Available {
+				require.Equal(t, []string{"/eth/v3/validator/blocks/1"}, paths)
+			} else {
+				require.Equal(t, []string{"/eth/v3/validator/blocks/1", "/eth/v2/validator/blocks/1"}, paths)
+			}
+		})
+	}
+}
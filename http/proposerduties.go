@@ -18,25 +18,41 @@ import (
 	"encoding/json"
 	"fmt"
 
-	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
 
 type proposerDutiesJSON struct {
-	Data []*api.ProposerDuty `json:"data"`
+	responseMetadata
+	Data []*apiv1.ProposerDuty `json:"data"`
 }
 
 // ProposerDuties obtains proposer duties for the given epoch.
 // If validators is empty all duties are returned, otherwise only matching duties are returned.
-func (s *Service) ProposerDuties(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*api.ProposerDuty, error) {
+func (s *Service) ProposerDuties(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.ProposerDuty, error) {
+	resp, err := s.ProposerDutiesWithMeta(ctx, epoch, validatorIndices)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+// ProposerDutiesWithMeta obtains proposer duties and their metadata for the given epoch.
+// If validators is empty all duties are returned, otherwise only matching duties are returned.
+func (s *Service) ProposerDutiesWithMeta(ctx context.Context,
+	epoch phase0.Epoch,
+	validatorIndices []phase0.ValidatorIndex,
+) (
+	*api.Response[[]*apiv1.ProposerDuty],
+	error,
+) {
 	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/validator/duties/proposer/%d", epoch))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request proposer duties")
 	}
-	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain proposer duties")
-	}
 
 	var resp proposerDutiesJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
@@ -58,7 +74,10 @@ func (s *Service) ProposerDuties(ctx context.Context, epoch phase0.Epoch, valida
 
 	if len(validatorIndices) == 0 {
 		// Return all duties.
-		return resp.Data, nil
+		return &api.Response[[]*apiv1.ProposerDuty]{
+			Data:     resp.Data,
+			Metadata: resp.apiMetadata(),
+		}, nil
 	}
 
 	// Filter duties based on supplied validators.
@@ -66,12 +85,15 @@ func (s *Service) ProposerDuties(ctx context.Context, epoch phase0.Epoch, valida
 	for _, index := range validatorIndices {
 		validatorIndexMap[index] = true
 	}
-	duties := make([]*api.ProposerDuty, 0, len(resp.Data))
+	duties := make([]*apiv1.ProposerDuty, 0, len(resp.Data))
 	for _, duty := range resp.Data {
 		if _, exists := validatorIndexMap[duty.ValidatorIndex]; exists {
 			duties = append(duties, duty)
 		}
 	}
 
-	return duties, nil
+	return &api.Response[[]*apiv1.ProposerDuty]{
+		Data:     duties,
+		Metadata: resp.apiMetadata(),
+	}, nil
 }
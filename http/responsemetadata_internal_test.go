@@ -0,0 +1,55 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinalityWithMeta(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write([]byte(`{"execution_optimistic":true,"finalized":true,"data":{"previous_justified":{"epoch":"1","root":"0x0000000000000000000000000000000000000000000000000000000000000001"},"current_justified":{"epoch":"2","root":"0x0000000000000000000000000000000000000000000000000000000000000002"},"finalized":{"epoch":"1","root":"0x0000000000000000000000000000000000000000000000000000000000000001"}}}`))
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	resp, err := testService(t, srv).FinalityWithMeta(context.Background(), "head")
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(2), resp.Data.Justified.Epoch)
+	require.True(t, resp.Metadata.ExecutionOptimistic)
+	require.True(t, resp.Metadata.Finalized)
+	require.Nil(t, resp.Metadata.DependentRoot)
+}
+
+func TestSignedBeaconBlockWithMeta(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write([]byte(`{"version":"phase0","execution_optimistic":false,"finalized":true,"data":{"message":{"slot":"1","proposer_index":"2","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","state_root":"0x0000000000000000000000000000000000000000000000000000000000000000","body":{"randao_reveal":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","eth1_data":{"deposit_root":"0x0000000000000000000000000000000000000000000000000000000000000000","deposit_count":"0","block_hash":"0x0000000000000000000000000000000000000000000000000000000000000000"},"graffiti":"0x0000000000000000000000000000000000000000000000000000000000000000","proposer_slashings":[],"attester_slashings":[],"attestations":[],"deposits":[],"voluntary_exits":[]}},"signature":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}}`))
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	resp, err := testService(t, srv).SignedBeaconBlockWithMeta(context.Background(), "head")
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(1), resp.Data.Phase0.Message.Slot)
+	require.Equal(t, spec.DataVersionPhase0, resp.Metadata.Version)
+	require.False(t, resp.Metadata.ExecutionOptimistic)
+	require.True(t, resp.Metadata.Finalized)
+}
@@ -0,0 +1,123 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// retryPolicy defines how failed idempotent requests are retried.
+type retryPolicy struct {
+	// maxAttempts is the maximum number of attempts made for a request, including the first.
+	maxAttempts int
+	// backoff is the base delay between attempts, which doubles with each attempt.
+	backoff time.Duration
+	// retryableStatuses are the HTTP status codes that will be retried.
+	// If empty, all 5xx status codes are retried.
+	retryableStatuses map[int]struct{}
+}
+
+// RetryError is returned when a request has failed after multiple attempts.
+type RetryError struct {
+	Method   string
+	Endpoint string
+	// Errors are the errors returned by each attempt, in order.
+	Errors []error
+}
+
+func (e *RetryError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = fmt.Sprintf("attempt %d: %v", i+1, err)
+	}
+
+	return fmt.Sprintf("%s %s failed after %d attempts: %s", e.Method, e.Endpoint, len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the errors from the individual attempts.
+func (e *RetryError) Unwrap() []error {
+	return e.Errors
+}
+
+// attempts returns the maximum number of attempts for a request.
+func (p *retryPolicy) attempts() int {
+	if p == nil {
+		return 1
+	}
+
+	return p.maxAttempts
+}
+
+// retryable returns true if the error from an attempt may succeed if retried.
+func (p *retryPolicy) retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		// The caller has given up, so no point retrying.
+		return false
+	}
+
+	var httpErr Error
+	if errors.As(err, &httpErr) {
+		if len(p.retryableStatuses) == 0 {
+			return httpErr.StatusCode/100 == 5
+		}
+		_, exists := p.retryableStatuses[httpErr.StatusCode]
+
+		return exists
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, context.DeadlineExceeded)
+}
+
+// delay returns the delay before the next attempt, given the number of attempts made so far.
+// The delay grows exponentially, with jitter to avoid synchronised retries from multiple clients.
+func (p *retryPolicy) delay(attempt int) time.Duration {
+	if p.backoff <= 0 {
+		return 0
+	}
+	shift := attempt - 1
+	if shift > 16 {
+		shift = 16
+	}
+	delay := p.backoff << shift
+
+	// #nosec G404
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// sleepCtx sleeps for the given duration, returning false if the context is done first.
+func sleepCtx(ctx context.Context, duration time.Duration) bool {
+	if duration <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRetry(t *testing.T) {
+	tests := []struct {
+		name     string
+		retry    *retryPolicy
+		statuses []int
+		attempts int32
+		err      string
+	}{
+		{
+			name:     "NoRetry",
+			statuses: []int{http.StatusServiceUnavailable, http.StatusOK},
+			attempts: 1,
+			err:      "GET failed with status 503: ",
+		},
+		{
+			name:     "Success",
+			retry:    &retryPolicy{maxAttempts: 3},
+			statuses: []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			attempts: 3,
+		},
+		{
+			name:     "Exhausted",
+			retry:    &retryPolicy{maxAttempts: 2},
+			statuses: []int{http.StatusServiceUnavailable, http.StatusInternalServerError, http.StatusOK},
+			attempts: 2,
+			err:      "GET /test failed after 2 attempts: attempt 1: GET failed with status 503: ; attempt 2: GET failed with status 500: ",
+		},
+		{
+			name:     "NotRetryable",
+			retry:    &retryPolicy{maxAttempts: 3},
+			statuses: []int{http.StatusBadRequest, http.StatusOK},
+			attempts: 1,
+			err:      "GET failed with status 400: ",
+		},
+		{
+			name: "SpecificStatuses",
+			retry: &retryPolicy{
+				maxAttempts:       3,
+				retryableStatuses: map[int]struct{}{http.StatusTooManyRequests: {}},
+			},
+			statuses: []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusOK},
+			attempts: 2,
+			err:      "GET /test failed after 2 attempts: attempt 1: GET failed with status 429: ; attempt 2: GET failed with status 503: ",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var attempts int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				attempt := atomic.AddInt32(&attempts, 1)
+				w.WriteHeader(test.statuses[attempt-1])
+			}))
+			defer srv.Close()
+
+			s := testService(t, srv)
+			s.retry = test.retry
+
+			_, err := s.get(context.Background(), "/test")
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				var httpErr Error
+				require.True(t, errors.As(err, &httpErr))
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.attempts, atomic.LoadInt32(&attempts))
+		})
+	}
+}
+
+func TestGetRetryTimeout(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			time.Sleep(200 * time.Millisecond)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	s := testService(t, srv)
+	s.timeout = 50 * time.Millisecond
+	s.retry = &retryPolicy{maxAttempts: 2, backoff: time.Millisecond}
+
+	_, err := s.get(context.Background(), "/test")
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryDelay(t *testing.T) {
+	p := &retryPolicy{backoff: 100 * time.Millisecond}
+	for attempt := 1; attempt <= 4; attempt++ {
+		base := p.backoff << (attempt - 1)
+		delay := p.delay(attempt)
+		require.GreaterOrEqual(t, delay, base/2)
+		require.LessOrEqual(t, delay, base)
+	}
+}
@@ -16,19 +16,22 @@ package http
 import (
 	"context"
 	"fmt"
-	"net"
-	"net/http"
 	"net/url"
 	"strings"
 	"sync"
 	"time"
 
 	eth2client "github.com/attestantio/go-eth2-client"
-	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/logging"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/preset"
+	utildeneb "github.com/attestantio/go-eth2-client/util/deneb"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Service is an Ethereum 2 client service.
@@ -36,18 +39,19 @@ type Service struct {
 	// log is a service-wide logger.
 	log zerolog.Logger
 
-	base    *url.URL
-	address string
-	client  *http.Client
-	timeout time.Duration
+	base      *url.URL
+	address   string
+	transport Transport
+	timeout   time.Duration
+	tracer    trace.Tracer
 
 	// Various information from the node that does not change during the
 	// lifetime of a beacon node.
-	genesis              *api.Genesis
+	genesis              *apiv1.Genesis
 	genesisMutex         sync.RWMutex
 	spec                 map[string]interface{}
 	specMutex            sync.RWMutex
-	depositContract      *api.DepositContract
+	depositContract      *apiv1.DepositContract
 	depositContractMutex sync.RWMutex
 	forkSchedule         []*phase0.Fork
 	forkScheduleMutex    sync.RWMutex
@@ -58,6 +62,36 @@ type Service struct {
 	userIndexChunkSize  int
 	userPubKeyChunkSize int
 	extraHeaders        map[string]string
+	enforceJSON         bool
+	preferSSZ           bool
+	retry               *retryPolicy
+	hedger              *hedger
+	conditional         *conditionalCache
+	graffiti            []byte
+	stateStore          StateStore
+
+	attestationDataCache *attestationDataCache
+	invalidationBus      *invalidationBus
+
+	userDutiesIndexChunkSize int
+
+	validatorRegistrationChunkSize int
+	validatorRegistrationDedup     bool
+	submittedRegistrationsMu       sync.Mutex
+	submittedRegistrations         map[phase0.BLSPubKey]phase0.Root
+
+	headerRangeConcurrency int
+
+	blobPrecheck bool
+	kzgVerifier  utildeneb.KZGVerifier
+
+	requestHooks  []RequestHookFunc
+	responseHooks []ResponseHookFunc
+
+	partialFailureHandlers []PartialFailureHandlerFunc
+
+	// Optional features enabled for the service.
+	features map[api.Feature]struct{}
 
 	// Endpoint support.
 	connectedToDVTMiddleware bool
@@ -70,25 +104,31 @@ func New(ctx context.Context, params ...Parameter) (eth2client.Service, error) {
 		return nil, errors.Wrap(err, "problem with parameters")
 	}
 
+	if parameters.preset != nil {
+		if err := preset.Set(parameters.preset); err != nil {
+			return nil, errors.Wrap(err, "failed to set preset")
+		}
+	}
+
 	// Set logging.
 	log := zerologger.With().Str("service", "client").Str("impl", "http").Logger()
-	if parameters.logLevel != log.GetLevel() {
+	if parameters.logger != nil {
+		log = logging.Zerolog(parameters.logger).With().Str("service", "client").Str("impl", "http").Logger()
+	} else if parameters.logLevel != log.GetLevel() {
 		log = log.Level(parameters.logLevel)
 	}
 
-	client := &http.Client{
-		Timeout: parameters.timeout,
-		Transport: &http.Transport{
-			DialContext: (&net.Dialer{
-				Timeout:   parameters.timeout,
-				KeepAlive: 30 * time.Second,
-				DualStack: true,
-			}).DialContext,
-			MaxIdleConns:        64,
-			MaxConnsPerHost:     64,
-			MaxIdleConnsPerHost: 64,
-			IdleConnTimeout:     600 * time.Second,
-		},
+	transport := parameters.transport
+	if transport == nil {
+		transport = newDefaultTransport(parameters)
+	}
+	if loggingTransport, isLoggingTransport := transport.(loggingTransport); isLoggingTransport {
+		loggingTransport.setLogger(log)
+	}
+
+	tracer := noopTracer
+	if parameters.tracerProvider != nil {
+		tracer = parameters.tracerProvider.Tracer(tracerName)
 	}
 
 	address := parameters.address
@@ -107,11 +147,41 @@ func New(ctx context.Context, params ...Parameter) (eth2client.Service, error) {
 		log:                 log,
 		base:                base,
 		address:             parameters.address,
-		client:              client,
+		transport:           transport,
 		timeout:             parameters.timeout,
+		tracer:              tracer,
 		userIndexChunkSize:  parameters.indexChunkSize,
 		userPubKeyChunkSize: parameters.pubKeyChunkSize,
-		extraHeaders:        parameters.extraHeaders,
+		extraHeaders:        requestHeaders(parameters),
+		enforceJSON:         parameters.enforceJSON,
+		preferSSZ:           parameters.preferSSZ,
+		retry:               parameters.retry,
+		graffiti:            parameters.graffiti,
+		stateStore:          parameters.stateStore,
+
+		userDutiesIndexChunkSize: parameters.dutiesIndexChunkSize,
+		features:                 enabledFeatures(log, parameters.features),
+
+		validatorRegistrationChunkSize: parameters.validatorRegistrationChunkSize,
+		validatorRegistrationDedup:     parameters.validatorRegistrationDedup,
+		submittedRegistrations:         make(map[phase0.BLSPubKey]phase0.Root),
+
+		headerRangeConcurrency: parameters.headerRangeConcurrency,
+
+		blobPrecheck: parameters.blobPrecheck,
+		kzgVerifier:  parameters.kzgVerifier,
+
+		requestHooks:  parameters.requestHooks,
+		responseHooks: parameters.responseHooks,
+
+		partialFailureHandlers: parameters.partialFailureHandlers,
+	}
+
+	if parameters.hedging != nil {
+		s.hedger = newHedger(parameters.hedging)
+	}
+	if parameters.conditional {
+		s.conditional = newConditionalCache()
 	}
 
 	// Fetch static values to confirm the connection is good.
@@ -127,6 +197,18 @@ func New(ctx context.Context, params ...Parameter) (eth2client.Service, error) {
 		return nil, errors.Wrap(err, "failed to check DVT connection")
 	}
 
+	if parameters.attestationDataCacheTTL > 0 || len(parameters.invalidationHandlers) > 0 {
+		s.invalidationBus = newInvalidationBus(parameters.invalidationHandlers)
+		if parameters.attestationDataCacheTTL > 0 {
+			// Attestation data can change with every new head.
+			s.attestationDataCache = newAttestationDataCache(parameters.attestationDataCacheTTL)
+			s.invalidationBus.onHead(s.attestationDataCache.clear)
+		}
+		if err := s.invalidationBus.start(ctx, s); err != nil {
+			return nil, err
+		}
+	}
+
 	// Close the service on context done.
 	go func(s *Service) {
 		<-ctx.Done()
@@ -21,6 +21,7 @@ import (
 
 	client "github.com/attestantio/go-eth2-client"
 	v1 "github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/preset"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -54,6 +55,144 @@ func TestService(t *testing.T) {
 			},
 			err: "problem with parameters: no timeout specified",
 		},
+		{
+			name: "FeatureUnknown",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithFeature("unknown", true),
+			},
+			err: "problem with parameters: unknown feature unknown",
+		},
+		{
+			name: "MaxIdleConnsPerHostNegative",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithMaxIdleConnsPerHost(-1),
+			},
+			err: "problem with parameters: max idle connections per host cannot be negative",
+		},
+		{
+			name: "MaxConnsPerHostNegative",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithMaxConnsPerHost(-1),
+			},
+			err: "problem with parameters: max connections per host cannot be negative",
+		},
+		{
+			name: "AttestationDataCacheTTLNegative",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithAttestationDataCache(-1),
+			},
+			err: "problem with parameters: attestation data cache TTL cannot be negative",
+		},
+		{
+			name: "PreferSSZEnforceJSON",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithPreferSSZ(true),
+				v1.WithEnforceJSON(true),
+			},
+			err: "problem with parameters: cannot both prefer SSZ and enforce JSON",
+		},
+		{
+			name: "PresetInvalid",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithPreset(&preset.Preset{}),
+			},
+			err: "problem with parameters: invalid preset: preset name missing",
+		},
+		{
+			name: "PreferSSZMinimalPreset",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithPreferSSZ(true),
+				v1.WithPreset(preset.Minimal()),
+			},
+			err: "problem with parameters: cannot prefer SSZ with the minimal preset",
+		},
+		{
+			name: "ValidatorRegistrationChunkSizeZero",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithValidatorRegistrationChunkSize(0),
+			},
+			err: "problem with parameters: validator registration chunk size must be at least 1",
+		},
+		{
+			name: "HeaderRangeConcurrencyZero",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithHeaderRangeConcurrency(0),
+			},
+			err: "problem with parameters: header range concurrency must be at least 1",
+		},
+		{
+			name: "RequestHookNil",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithRequestHook(nil),
+			},
+			err: "problem with parameters: request hook cannot be nil",
+		},
+		{
+			name: "ResponseHookNil",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithResponseHook(nil),
+			},
+			err: "problem with parameters: response hook cannot be nil",
+		},
+		{
+			name: "InvalidationHandlerNil",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithInvalidationHandler(nil),
+			},
+			err: "problem with parameters: invalidation handler cannot be nil",
+		},
+		{
+			name: "RetryAttemptsZero",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithRetry(0, time.Second, nil),
+			},
+			err: "problem with parameters: retry max attempts must be at least 1",
+		},
+		{
+			name: "RetryBackoffNegative",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithRetry(3, -time.Second, nil),
+			},
+			err: "problem with parameters: retry backoff cannot be negative",
+		},
+		{
+			name: "HedgingQuantileInvalid",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithHedging(1, time.Second),
+			},
+			err: "problem with parameters: hedging quantile must be between 0 and 1",
+		},
+		{
+			name: "HedgingMinDelayNegative",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithHedging(0.95, -time.Second),
+			},
+			err: "problem with parameters: hedging minimum delay cannot be negative",
+		},
+		{
+			name: "GraffitiTooLong",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithGraffiti(make([]byte, 33)),
+			},
+			err: "problem with parameters: graffiti cannot be longer than 32 bytes",
+		},
 		{
 			name: "AddressInvalid",
 			parameters: []v1.Parameter{
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
@@ -27,6 +28,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type phase0SignedBeaconBlockJSON struct {
@@ -52,14 +54,36 @@ type denebSignedBeaconBlockJSON struct {
 // SignedBeaconBlock fetches a signed beacon block given a block ID.
 // N.B if a signed beacon block for the block ID is not available this will return nil without an error.
 func (s *Service) SignedBeaconBlock(ctx context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error) {
-	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v2/beacon/blocks/%s", blockID))
+	resp, err := s.SignedBeaconBlockWithMeta(ctx, blockID)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to request signed beacon block")
+		return nil, err
 	}
-	if respBodyReader == nil {
+	if resp == nil {
 		return nil, nil
 	}
 
+	return resp.Data, nil
+}
+
+// SignedBeaconBlockWithMeta fetches a signed beacon block and its metadata given a block ID.
+// N.B if a signed beacon block for the block ID is not available this will return nil without an error.
+func (s *Service) SignedBeaconBlockWithMeta(ctx context.Context,
+	blockID string,
+) (
+	*api.Response[*spec.VersionedSignedBeaconBlock],
+	error,
+) {
+	ctx, span := s.startSpan(ctx, "SignedBeaconBlockWithMeta", attribute.String("block_id", blockID))
+	defer span.End()
+
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v2/beacon/blocks/%s", blockID))
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to request signed beacon block")
+	}
+
 	var dataBodyReader bytes.Buffer
 	metadataReader := io.TeeReader(respBodyReader, &dataBodyReader)
 	var metadata responseMetadata
@@ -105,5 +129,8 @@ func (s *Service) SignedBeaconBlock(ctx context.Context, blockID string) (*spec.
 		return nil, fmt.Errorf("unhandled block version %s", metadata.Version)
 	}
 
-	return res, nil
+	return &api.Response[*spec.VersionedSignedBeaconBlock]{
+		Data:     res,
+		Metadata: metadata.apiMetadata(),
+	}, nil
 }
@@ -50,9 +50,6 @@ func (s *Service) Spec(ctx context.Context) (map[string]interface{}, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request spec")
 	}
-	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain spec")
-	}
 
 	var specJSON specJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&specJSON); err != nil {
@@ -127,19 +124,19 @@ func (s *Service) Spec(ctx context.Context) (map[string]interface{}, error) {
 
 	// The application mask domain type is not provided by all nodes, so add it here if not present.
 	if _, exists := config["DOMAIN_APPLICATION_MASK"]; !exists {
-		config["DOMAIN_APPLICATION_MASK"] = phase0.DomainType{0x00, 0x00, 0x00, 0x01}
+		config["DOMAIN_APPLICATION_MASK"] = phase0.DomainApplicationMask
 	}
 	// The BLS to execution change domain type is not provided by all nodes, so add it here if not present.
 	if _, exists := config["DOMAIN_BLS_TO_EXECUTION_CHANGE"]; !exists {
-		config["DOMAIN_BLS_TO_EXECUTION_CHANGE"] = phase0.DomainType{0x0a, 0x00, 0x00, 0x00}
+		config["DOMAIN_BLS_TO_EXECUTION_CHANGE"] = phase0.DomainBLSToExecutionChange
 	}
 	// The builder application domain type is not officially part of the spec, so add it here if not present.
 	if _, exists := config["DOMAIN_APPLICATION_BUILDER"]; !exists {
-		config["DOMAIN_APPLICATION_BUILDER"] = phase0.DomainType{0x00, 0x00, 0x00, 0x01}
+		config["DOMAIN_APPLICATION_BUILDER"] = phase0.DomainApplicationBuilder
 	}
 	// The blob sidecar domain type is not provided by all nodes, so add it here if not present.
 	if _, exists := config["DOMAIN_BLOB_SIDECAR"]; !exists {
-		config["DOMAIN_BLOB_SIDECAR"] = phase0.DomainType{0x0b, 0x00, 0x00, 0x00}
+		config["DOMAIN_BLOB_SIDECAR"] = phase0.DomainBlobSidecar
 	}
 
 	s.spec = config
@@ -0,0 +1,97 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// encodeFunc returns the encoding of a request body.
+type encodeFunc func() ([]byte, error)
+
+// postSSZWithFallback posts a body as SSZ if sendSSZ is true, falling back to JSON if the
+// beacon node does not accept SSZ.  Encoding is carried out lazily, so that the JSON body
+// is only generated if it is required.
+// The body of the successful response is returned.
+func (s *Service) postSSZWithFallback(ctx context.Context,
+	endpoint string,
+	headers map[string]string,
+	sendSSZ bool,
+	sszBody encodeFunc,
+	jsonBody encodeFunc,
+) (
+	io.Reader,
+	error,
+) {
+	if sendSSZ {
+		body, err := sszBody()
+		if err != nil {
+			return nil, err
+		}
+		res, err := s.postWithContent(ctx, endpoint, bytes.NewReader(body), contentTypeSSZ, headers)
+		if err == nil {
+			return res, nil
+		}
+		var apiErr Error
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnsupportedMediaType {
+			return nil, err
+		}
+		s.log.Trace().Str("endpoint", endpoint).Msg("Beacon node does not accept SSZ; retrying with JSON")
+	}
+
+	body, err := jsonBody()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.postWithContent(ctx, endpoint, bytes.NewReader(body), contentTypeJSON, headers)
+}
+
+// sszMarshaler is an item that can be marshaled to SSZ.
+type sszMarshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+// sszList returns the SSZ encoding of a list of variable-size items, being an offset
+// for each item followed by the items themselves.
+func sszList[T sszMarshaler](items []T) ([]byte, error) {
+	encoded := make([][]byte, len(items))
+	size := 4 * len(items)
+	for i := range items {
+		var err error
+		encoded[i], err = items[i].MarshalSSZ()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal SSZ for item %d", i)
+		}
+		size += len(encoded[i])
+	}
+
+	res := make([]byte, 4*len(items), size)
+	offset := 4 * len(items)
+	for i := range encoded {
+		binary.LittleEndian.PutUint32(res[4*i:], uint32(offset))
+		offset += len(encoded[i])
+	}
+	for i := range encoded {
+		res = append(res, encoded[i]...)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,134 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func testAttestation(slot phase0.Slot) *phase0.Attestation {
+	return &phase0.Attestation{
+		AggregationBits: bitfield.NewBitlist(uint64(slot) + 1),
+		Data: &phase0.AttestationData{
+			Slot:   slot,
+			Source: &phase0.Checkpoint{},
+			Target: &phase0.Checkpoint{},
+		},
+	}
+}
+
+func TestSSZList(t *testing.T) {
+	attestations := []*phase0.Attestation{testAttestation(1), testAttestation(20)}
+	first, err := attestations[0].MarshalSSZ()
+	require.NoError(t, err)
+	second, err := attestations[1].MarshalSSZ()
+	require.NoError(t, err)
+
+	res, err := sszList(attestations)
+	require.NoError(t, err)
+	require.Len(t, res, 8+len(first)+len(second))
+	require.Equal(t, uint32(8), binary.LittleEndian.Uint32(res[0:4]))
+	require.Equal(t, uint32(8+len(first)), binary.LittleEndian.Uint32(res[4:8]))
+	require.Equal(t, first, res[8:8+len(first)])
+	require.Equal(t, second, res[8+len(first):])
+
+	res, err = sszList([]*phase0.Attestation{})
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
+
+func TestSubmitAttestationsSSZ(t *testing.T) {
+	attestations := []*phase0.Attestation{testAttestation(1), testAttestation(2)}
+	attestationsSSZ, err := sszList(attestations)
+	require.NoError(t, err)
+	attestationsJSON, err := json.Marshal(attestations)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		preferSSZ   bool
+		acceptSSZ   bool
+		submissions []string
+	}{
+		{
+			name:        "JSON",
+			acceptSSZ:   true,
+			submissions: []string{"application/json"},
+		},
+		{
+			name:        "SSZ",
+			preferSSZ:   true,
+			acceptSSZ:   true,
+			submissions: []string{"application/octet-stream"},
+		},
+		{
+			name:        "SSZFallback",
+			preferSSZ:   true,
+			submissions: []string{"application/octet-stream", "application/json"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			submissions := make([]string, 0)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				contentType := r.Header.Get("Content-Type")
+				submissions = append(submissions, contentType)
+				if contentType == "application/octet-stream" {
+					require.Equal(t, attestationsSSZ, body)
+					if !test.acceptSSZ {
+						w.WriteHeader(http.StatusUnsupportedMediaType)
+					}
+				} else {
+					require.JSONEq(t, string(attestationsJSON), string(body))
+				}
+			}))
+			defer srv.Close()
+
+			s := testService(t, srv)
+			s.preferSSZ = test.preferSSZ
+
+			require.NoError(t, s.SubmitAttestations(context.Background(), attestations))
+			require.Equal(t, test.submissions, submissions)
+		})
+	}
+}
+
+func TestPostSSZWithFallbackError(t *testing.T) {
+	submissions := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		submissions++
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	s := testService(t, srv)
+	s.preferSSZ = true
+
+	// Errors other than unsupported media type are not retried as JSON.
+	require.Error(t, s.SubmitAttestations(context.Background(), []*phase0.Attestation{testAttestation(1)}))
+	require.Equal(t, 1, submissions)
+}
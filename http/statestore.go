@@ -0,0 +1,84 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// StateStore is a store of beacon states keyed by state root, used to avoid refetching
+// states from the beacon node.
+type StateStore interface {
+	// BeaconState returns the beacon state with the given root.
+	// If the state is not in the store this returns nil without an error.
+	BeaconState(ctx context.Context, root phase0.Root) (*spec.VersionedBeaconState, error)
+
+	// StoreBeaconState stores the beacon state with the given root.
+	StoreBeaconState(ctx context.Context, root phase0.Root, state *spec.VersionedBeaconState) error
+}
+
+// storedBeaconState fetches a beacon state, using the state store where possible.
+func (s *Service) storedBeaconState(ctx context.Context, stateID string) (*spec.VersionedBeaconState, error) {
+	root, err := s.stateRootFromStateID(ctx, stateID)
+	if err != nil {
+		return nil, err
+	}
+	if root == nil {
+		return nil, nil
+	}
+
+	state, err := s.stateStore.BeaconState(ctx, *root)
+	if err != nil {
+		s.log.Warn().Err(err).Str("root", fmt.Sprintf("%#x", *root)).Msg("Failed to obtain state from store")
+	}
+	if state != nil {
+		return state, nil
+	}
+
+	// Fetch by root rather than the original state ID, to ensure that the state matches the key.
+	state, err = s.beaconState(ctx, fmt.Sprintf("%#x", *root))
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, nil
+	}
+
+	if err := s.stateStore.StoreBeaconState(ctx, *root, state); err != nil {
+		s.log.Debug().Err(err).Str("root", fmt.Sprintf("%#x", *root)).Msg("Failed to store state")
+	}
+
+	return state, nil
+}
+
+// stateRootFromStateID returns the state root for a state ID, requesting it from the
+// beacon node if the state ID is not itself a root.
+func (s *Service) stateRootFromStateID(ctx context.Context, stateID string) (*phase0.Root, error) {
+	if strings.HasPrefix(stateID, "0x") {
+		var root phase0.Root
+		if err := root.UnmarshalJSON([]byte(fmt.Sprintf("%q", stateID))); err != nil {
+			return nil, errors.Wrap(err, "invalid state root")
+		}
+
+		return &root, nil
+	}
+
+	return s.BeaconStateRoot(ctx, stateID)
+}
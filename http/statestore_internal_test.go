@@ -0,0 +1,110 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/gencorpus"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryStateStore is a state store held in memory.
+type memoryStateStore struct {
+	mu     sync.Mutex
+	states map[phase0.Root]*spec.VersionedBeaconState
+}
+
+func (m *memoryStateStore) BeaconState(_ context.Context, root phase0.Root) (*spec.VersionedBeaconState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.states[root], nil
+}
+
+func (m *memoryStateStore) StoreBeaconState(_ context.Context, root phase0.Root, state *spec.VersionedBeaconState) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.states[root] = state
+
+	return nil
+}
+
+func TestStoredBeaconState(t *testing.T) {
+	ctx := context.Background()
+
+	state := &altair.BeaconState{}
+	require.NoError(t, gencorpus.Fill(state, gencorpus.VariantZero))
+	state.Slot = 12
+	root, err := state.HashTreeRoot()
+	require.NoError(t, err)
+	stateData, err := json.Marshal(state)
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	requests := make(map[string]int)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.URL.Path]++
+		mu.Unlock()
+		switch r.URL.Path {
+		case "/eth/v1/beacon/states/head/root":
+			_, _ = fmt.Fprintf(w, `{"data":{"root":"%#x"}}`, root)
+		case fmt.Sprintf("/eth/v2/debug/beacon/states/%#x", root):
+			_, _ = fmt.Fprintf(w, `{"version":"altair","data":%s}`, stateData)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	s := testService(t, srv)
+	store := &memoryStateStore{states: make(map[phase0.Root]*spec.VersionedBeaconState)}
+	s.stateStore = store
+
+	// First request fetches from the beacon node and populates the store.
+	res, err := s.BeaconState(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(12), res.Altair.Slot)
+	require.Len(t, store.states, 1)
+	require.NotNil(t, store.states[root])
+
+	// Subsequent requests, by state ID or root, are served from the store.
+	res, err = s.BeaconState(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(12), res.Altair.Slot)
+	res, err = s.BeaconState(ctx, fmt.Sprintf("%#x", root))
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(12), res.Altair.Slot)
+
+	mu.Lock()
+	require.Equal(t, 2, requests["/eth/v1/beacon/states/head/root"])
+	require.Equal(t, 1, requests[fmt.Sprintf("/eth/v2/debug/beacon/states/%#x", root)])
+	mu.Unlock()
+
+	// Unknown states are not found.
+	res, err = s.BeaconState(ctx, "finalized")
+	require.NoError(t, err)
+	require.Nil(t, res)
+}
@@ -0,0 +1,96 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// getStream sends an HTTP get request with the given Accept header and passes the response
+// to the supplied function, which can read the body directly from the connection rather than
+// from an in-memory copy.  This is intended for very large responses such as beacon states.
+// If the response from the server is a 404 this will return an error matching api.ErrNotFound.
+// Unlike get, requests are neither retried nor hedged, as the body cannot be replayed.
+// Response hooks are called with a nil body for successful responses.
+func (s *Service) getStream(ctx context.Context,
+	endpoint string,
+	accept string,
+	fn func(resp *http.Response) error,
+) error {
+	// #nosec G404
+	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
+
+	ctx, span := s.startSpan(ctx, "GET", attribute.String("address", s.address), attribute.String("endpoint", endpoint))
+	defer span.End()
+
+	log.Trace().Str("accept", accept).Msg("GET stream request")
+
+	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), endpoint))
+	if err != nil {
+		return errors.Wrap(err, "invalid endpoint")
+	}
+
+	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(opCtx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to create GET request")
+	}
+	s.addExtraHeaders(req)
+	req.Header.Set("Accept", accept)
+	s.runRequestHooks(ctx, req)
+
+	resp, err := s.transport.Do(req)
+	if err != nil {
+		err = errors.Wrap(err, "failed to call GET endpoint")
+		spanError(ctx, err)
+		return err
+	}
+	defer resp.Body.Close()
+	span.SetAttributes(attribute.Int("status_code", resp.StatusCode))
+
+	if resp.StatusCode/100 != 2 {
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return errors.Wrap(err, "failed to read GET response")
+		}
+		s.runResponseHooks(ctx, resp, data)
+		log.Trace().Int("status_code", resp.StatusCode).Str("data", string(data)).Msg("GET failed")
+		err = api.NewError(http.MethodGet, endpoint, resp.StatusCode, data)
+		spanError(ctx, err)
+		return err
+	}
+	s.runResponseHooks(ctx, resp, nil)
+	if version := resp.Header.Get("Eth-Consensus-Version"); version != "" {
+		span.SetAttributes(attribute.String("consensus_version", version))
+	}
+
+	if err := fn(resp); err != nil {
+		spanError(ctx, err)
+		return err
+	}
+	log.Trace().Msg("GET stream response")
+
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SubmitAggregateAttestationsV2 submits versioned aggregate attestations.
+// All aggregate and proofs must be of the same version.
+// If the beacon node does not provide the v2 endpoint the aggregate and proofs are submitted to the v1 endpoint.
+func (s *Service) SubmitAggregateAttestationsV2(ctx context.Context, aggregateAndProofs []*spec.VersionedSignedAggregateAndProof) error {
+	if len(aggregateAndProofs) == 0 {
+		return errors.New("no aggregate and proofs supplied")
+	}
+
+	version := aggregateAndProofs[0].Version
+	unversioned := make([]*phase0.SignedAggregateAndProof, len(aggregateAndProofs))
+	for i := range aggregateAndProofs {
+		if aggregateAndProofs[i] == nil {
+			return fmt.Errorf("aggregate and proof %d missing", i)
+		}
+		if aggregateAndProofs[i].Version != version {
+			return fmt.Errorf("aggregate and proof %d has version %s; expected %s", i, aggregateAndProofs[i].Version, version)
+		}
+		var err error
+		unversioned[i], err = aggregateAndProofs[i].SignedAggregateAndProof()
+		if err != nil {
+			return errors.Wrapf(err, "invalid aggregate and proof %d", i)
+		}
+	}
+
+	specJSON, err := json.Marshal(unversioned)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	headers := map[string]string{
+		"Eth-Consensus-Version": version.String(),
+	}
+	_, err = s.postWithContent(ctx, "/eth/v2/validator/aggregate_and_proofs", bytes.NewReader(specJSON), contentTypeJSON, headers)
+	var apiErr api.Error
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		s.log.Debug().Msg("Beacon node does not provide v2 aggregate and proof submission; falling back to v1")
+		_, err = s.post(ctx, "/eth/v1/validator/aggregate_and_proofs", bytes.NewReader(specJSON))
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to submit aggregate and proofs")
+	}
+
+	return nil
+}
@@ -14,7 +14,6 @@
 package http
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 
@@ -23,13 +22,27 @@ import (
 )
 
 // SubmitAttestations submits attestations.
+// The attestations are sent as SSZ if SSZ is preferred; if the beacon node does not accept SSZ
+// then the submission is retried as JSON.
 func (s *Service) SubmitAttestations(ctx context.Context, attestations []*phase0.Attestation) error {
-	specJSON, err := json.Marshal(attestations)
-	if err != nil {
-		return errors.Wrap(err, "failed to marshal JSON")
-	}
+	_, err := s.postSSZWithFallback(ctx, "/eth/v1/beacon/pool/attestations", nil, s.preferSSZ,
+		func() ([]byte, error) {
+			res, err := sszList(attestations)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to marshal SSZ")
+			}
+
+			return res, nil
+		},
+		func() ([]byte, error) {
+			res, err := json.Marshal(attestations)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to marshal JSON")
+			}
 
-	_, err = s.post(ctx, "/eth/v1/beacon/pool/attestations", bytes.NewBuffer(specJSON))
+			return res, nil
+		},
+	)
 	if err != nil {
 		return errors.Wrap(err, "failed to submit beacon attestations")
 	}
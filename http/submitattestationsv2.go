@@ -0,0 +1,80 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SubmitAttestationsV2 submits attestations to the v2 endpoint.
+// The consensus version of the attestations is selected from the chain fork at their slot,
+// so all attestations must be from the same fork.
+// The attestations are sent as SSZ if SSZ is preferred; if the beacon node does not accept SSZ
+// then the submission is retried as JSON.
+func (s *Service) SubmitAttestationsV2(ctx context.Context, attestations []*phase0.Attestation) error {
+	if len(attestations) == 0 {
+		return errors.New("no attestations supplied")
+	}
+	for i := range attestations {
+		if attestations[i] == nil || attestations[i].Data == nil {
+			return fmt.Errorf("attestation %d missing data", i)
+		}
+	}
+
+	version, err := s.dataVersionAtSlot(ctx, attestations[0].Data.Slot)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain attestation version")
+	}
+	for i := 1; i < len(attestations); i++ {
+		attestationVersion, err := s.dataVersionAtSlot(ctx, attestations[i].Data.Slot)
+		if err != nil {
+			return errors.Wrap(err, "failed to obtain attestation version")
+		}
+		if attestationVersion != version {
+			return fmt.Errorf("attestation %d has version %s; expected %s", i, attestationVersion, version)
+		}
+	}
+
+	headers := map[string]string{
+		"Eth-Consensus-Version": version.String(),
+	}
+	_, err = s.postSSZWithFallback(ctx, "/eth/v2/beacon/pool/attestations", headers, s.preferSSZ,
+		func() ([]byte, error) {
+			res, err := sszList(attestations)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to marshal SSZ")
+			}
+
+			return res, nil
+		},
+		func() ([]byte, error) {
+			res, err := json.Marshal(attestations)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to marshal JSON")
+			}
+
+			return res, nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to submit beacon attestations")
+	}
+
+	return nil
+}
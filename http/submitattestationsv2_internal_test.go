@@ -0,0 +1,140 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// forkScheduleSpecJSON is a spec with altair at epoch 1, bellatrix at epoch 2 and later forks unscheduled.
+const forkScheduleSpecJSON = `{"data":{"SLOTS_PER_EPOCH":"32","ALTAIR_FORK_EPOCH":"1","BELLATRIX_FORK_EPOCH":"2","CAPELLA_FORK_EPOCH":"18446744073709551615","DENEB_FORK_EPOCH":"18446744073709551615"}}`
+
+func testSlotAttestation(slot phase0.Slot) *phase0.Attestation {
+	return &phase0.Attestation{
+		AggregationBits: []byte{0x01},
+		Data: &phase0.AttestationData{
+			Slot:   slot,
+			Source: &phase0.Checkpoint{},
+			Target: &phase0.Checkpoint{},
+		},
+	}
+}
+
+func TestSubmitAttestationsV2(t *testing.T) {
+	tests := []struct {
+		name         string
+		attestations []*phase0.Attestation
+		version      string
+		err          string
+	}{
+		{
+			name: "Empty",
+			err:  "no attestations supplied",
+		},
+		{
+			name:         "MissingData",
+			attestations: []*phase0.Attestation{{}},
+			err:          "attestation 0 missing data",
+		},
+		{
+			name:         "Phase0",
+			attestations: []*phase0.Attestation{testSlotAttestation(1), testSlotAttestation(31)},
+			version:      "phase0",
+		},
+		{
+			name:         "Altair",
+			attestations: []*phase0.Attestation{testSlotAttestation(32)},
+			version:      "altair",
+		},
+		{
+			name:         "Bellatrix",
+			attestations: []*phase0.Attestation{testSlotAttestation(1000)},
+			version:      "bellatrix",
+		},
+		{
+			name:         "VersionMismatch",
+			attestations: []*phase0.Attestation{testSlotAttestation(1), testSlotAttestation(64)},
+			err:          "attestation 1 has version bellatrix; expected phase0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			submitted := false
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/eth/v1/config/spec":
+					_, _ = w.Write([]byte(forkScheduleSpecJSON))
+				case "/eth/v2/beacon/pool/attestations":
+					submitted = true
+					require.Equal(t, test.version, r.Header.Get("Eth-Consensus-Version"))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer srv.Close()
+
+			s := testService(t, srv)
+
+			err := s.SubmitAttestationsV2(context.Background(), test.attestations)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.False(t, submitted)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, submitted)
+		})
+	}
+}
+
+func TestSubmitAttesterSlashingV2(t *testing.T) {
+	submitted := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/config/spec":
+			_, _ = w.Write([]byte(forkScheduleSpecJSON))
+		case "/eth/v2/beacon/pool/attester_slashings":
+			submitted = true
+			require.Equal(t, "altair", r.Header.Get("Eth-Consensus-Version"))
+			require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	s := testService(t, srv)
+
+	require.EqualError(t, s.SubmitAttesterSlashingV2(context.Background(), nil), "no attester slashing supplied")
+
+	slashing := &phase0.AttesterSlashing{
+		Attestation1: &phase0.IndexedAttestation{
+			AttestingIndices: []uint64{1},
+			Data:             testSlotAttestation(40).Data,
+		},
+		Attestation2: &phase0.IndexedAttestation{
+			AttestingIndices: []uint64{1},
+			Data:             testSlotAttestation(40).Data,
+		},
+	}
+	require.NoError(t, s.SubmitAttesterSlashingV2(context.Background(), slashing))
+	require.True(t, submitted)
+}
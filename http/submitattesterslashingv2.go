@@ -0,0 +1,55 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SubmitAttesterSlashingV2 submits an attester slashing to the v2 endpoint.
+// The consensus version of the slashing is selected from the chain fork at the slot of its
+// first attestation.
+func (s *Service) SubmitAttesterSlashingV2(ctx context.Context, slashing *phase0.AttesterSlashing) error {
+	if slashing == nil {
+		return errors.New("no attester slashing supplied")
+	}
+	if slashing.Attestation1 == nil || slashing.Attestation1.Data == nil {
+		return errors.New("attester slashing missing first attestation data")
+	}
+
+	version, err := s.dataVersionAtSlot(ctx, slashing.Attestation1.Data.Slot)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain attester slashing version")
+	}
+
+	body, err := json.Marshal(slashing)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	headers := map[string]string{
+		"Eth-Consensus-Version": version.String(),
+	}
+	_, err = s.postWithContent(ctx, "/eth/v2/beacon/pool/attester_slashings", bytes.NewReader(body), contentTypeJSON, headers)
+	if err != nil {
+		return errors.Wrap(err, "failed to submit attester slashing")
+	}
+
+	return nil
+}
@@ -14,42 +14,27 @@
 package http
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/pkg/errors"
 )
 
 // SubmitBeaconBlock submits a beacon block.
+// The block is sent as SSZ if SSZ is preferred; if the beacon node does not accept SSZ
+// then the submission is retried as JSON.
 func (s *Service) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSignedBeaconBlock) error {
-	var specJSON []byte
-	var err error
-
 	if block == nil {
 		return errors.New("no block supplied")
 	}
 
-	switch block.Version {
-	case spec.DataVersionPhase0:
-		specJSON, err = json.Marshal(block.Phase0)
-	case spec.DataVersionAltair:
-		specJSON, err = json.Marshal(block.Altair)
-	case spec.DataVersionBellatrix:
-		specJSON, err = json.Marshal(block.Bellatrix)
-	case spec.DataVersionCapella:
-		specJSON, err = json.Marshal(block.Capella)
-	case spec.DataVersionDeneb:
-		specJSON, err = json.Marshal(block.Deneb)
-	default:
-		err = errors.New("unknown block version")
-	}
-	if err != nil {
-		return errors.Wrap(err, "failed to marshal JSON")
+	headers := map[string]string{
+		"Eth-Consensus-Version": block.Version.String(),
 	}
-
-	_, err = s.post(ctx, "/eth/v1/beacon/blocks", bytes.NewBuffer(specJSON))
+	_, err := s.postSSZWithFallback(ctx, "/eth/v1/beacon/blocks", headers, s.preferSSZ,
+		func() ([]byte, error) { return signedBeaconBlockSSZ(block) },
+		func() ([]byte, error) { return signedBeaconBlockJSON(block) },
+	)
 	if err != nil {
 		return errors.Wrap(err, "failed to submit beacon block")
 	}
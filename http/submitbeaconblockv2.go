@@ -0,0 +1,121 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+// SubmitBeaconBlockV2 submits a beacon block, with the beacon node carrying out the given
+// level of validation before broadcasting it.
+// The block is sent as SSZ unless JSON is enforced; if the beacon node does not accept SSZ
+// then the submission is retried as JSON.
+func (s *Service) SubmitBeaconBlockV2(ctx context.Context,
+	block *spec.VersionedSignedBeaconBlock,
+	broadcastValidation apiv1.BroadcastValidation,
+) error {
+	if block == nil {
+		return errors.New("no block supplied")
+	}
+
+	endpoint := fmt.Sprintf("/eth/v2/beacon/blocks?broadcast_validation=%s", broadcastValidation.String())
+	headers := map[string]string{
+		"Eth-Consensus-Version": block.Version.String(),
+	}
+
+	_, err := s.postSSZWithFallback(ctx, endpoint, headers, !s.enforceJSON,
+		func() ([]byte, error) { return signedBeaconBlockSSZ(block) },
+		func() ([]byte, error) { return signedBeaconBlockJSON(block) },
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to submit beacon block")
+	}
+
+	return nil
+}
+
+// signedBeaconBlockJSON returns the JSON encoding of the versioned signed beacon block.
+func signedBeaconBlockJSON(block *spec.VersionedSignedBeaconBlock) ([]byte, error) {
+	data, err := signedBeaconBlockData(block)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := json.Marshal(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return res, nil
+}
+
+// signedBeaconBlockSSZ returns the SSZ encoding of the versioned signed beacon block.
+func signedBeaconBlockSSZ(block *spec.VersionedSignedBeaconBlock) ([]byte, error) {
+	data, err := signedBeaconBlockData(block)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := data.MarshalSSZ()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal SSZ")
+	}
+
+	return res, nil
+}
+
+// signedBeaconBlockData returns the block for the version of the versioned signed beacon block,
+// or an error if it is not present.
+func signedBeaconBlockData(block *spec.VersionedSignedBeaconBlock) (sszMarshaler, error) {
+	switch block.Version {
+	case spec.DataVersionPhase0:
+		if block.Phase0 == nil {
+			return nil, errors.New("no phase0 block")
+		}
+
+		return block.Phase0, nil
+	case spec.DataVersionAltair:
+		if block.Altair == nil {
+			return nil, errors.New("no altair block")
+		}
+
+		return block.Altair, nil
+	case spec.DataVersionBellatrix:
+		if block.Bellatrix == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+
+		return block.Bellatrix, nil
+	case spec.DataVersionCapella:
+		if block.Capella == nil {
+			return nil, errors.New("no capella block")
+		}
+
+		return block.Capella, nil
+	case spec.DataVersionDeneb:
+		if block.Deneb == nil {
+			return nil, errors.New("no deneb block")
+		}
+
+		return block.Deneb, nil
+	default:
+		return nil, errors.New("unknown block version")
+	}
+}
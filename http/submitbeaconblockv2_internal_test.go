@@ -0,0 +1,126 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// submittedBlock is the information captured by the test server for each submission.
+type submittedBlock struct {
+	contentType         string
+	consensusVersion    string
+	broadcastValidation string
+	body                []byte
+}
+
+func TestSubmitBeaconBlockV2(t *testing.T) {
+	block := &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.SignedBeaconBlock{
+			Message: &phase0.BeaconBlock{
+				Slot: 1,
+				Body: &phase0.BeaconBlockBody{
+					ETH1Data: &phase0.ETH1Data{
+						BlockHash: make([]byte, 32),
+					},
+				},
+			},
+		},
+	}
+	blockSSZ, err := block.Phase0.MarshalSSZ()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		enforceJSON bool
+		acceptSSZ   bool
+		submissions []string
+	}{
+		{
+			name:        "SSZ",
+			acceptSSZ:   true,
+			submissions: []string{"application/octet-stream"},
+		},
+		{
+			name:        "SSZFallback",
+			submissions: []string{"application/octet-stream", "application/json"},
+		},
+		{
+			name:        "EnforceJSON",
+			enforceJSON: true,
+			acceptSSZ:   true,
+			submissions: []string{"application/json"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			submissions := make([]*submittedBlock, 0)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				submissions = append(submissions, &submittedBlock{
+					contentType:         r.Header.Get("Content-Type"),
+					consensusVersion:    r.Header.Get("Eth-Consensus-Version"),
+					broadcastValidation: r.URL.Query().Get("broadcast_validation"),
+					body:                body,
+				})
+				if r.Header.Get("Content-Type") == "application/octet-stream" && !test.acceptSSZ {
+					w.WriteHeader(http.StatusUnsupportedMediaType)
+				}
+			}))
+			defer srv.Close()
+
+			s := testService(t, srv)
+			s.enforceJSON = test.enforceJSON
+
+			require.NoError(t, s.SubmitBeaconBlockV2(context.Background(), block, apiv1.BroadcastValidationConsensusAndEquivocation))
+			require.Len(t, submissions, len(test.submissions))
+			for i, submission := range submissions {
+				require.Equal(t, test.submissions[i], submission.contentType)
+				require.Equal(t, "phase0", submission.consensusVersion)
+				require.Equal(t, "consensus_and_equivocation", submission.broadcastValidation)
+				if submission.contentType == "application/octet-stream" {
+					require.Equal(t, blockSSZ, submission.body)
+				}
+			}
+		})
+	}
+}
+
+func TestSubmitBeaconBlockV2MissingBlock(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		require.FailNow(t, "block should not be submitted")
+	}))
+	defer srv.Close()
+
+	s := testService(t, srv)
+
+	err := s.SubmitBeaconBlockV2(context.Background(), &spec.VersionedSignedBeaconBlock{Version: spec.DataVersionCapella}, apiv1.BroadcastValidationGossip)
+	require.ErrorContains(t, err, "no capella block")
+
+	s.enforceJSON = true
+	err = s.SubmitBeaconBlockV2(context.Background(), &spec.VersionedSignedBeaconBlock{Version: spec.DataVersionDeneb}, apiv1.BroadcastValidationGossip)
+	require.ErrorContains(t, err, "no deneb block")
+}
@@ -14,7 +14,6 @@
 package http
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 
@@ -24,36 +23,73 @@ import (
 )
 
 // SubmitBlindedBeaconBlock submits a blinded beacon block.
+// The block is sent as SSZ if SSZ is preferred; if the beacon node does not accept SSZ
+// then the submission is retried as JSON.
 func (s *Service) SubmitBlindedBeaconBlock(ctx context.Context, block *api.VersionedSignedBlindedBeaconBlock) error {
-	var specJSON []byte
-	var err error
-
 	if block == nil {
 		return errors.New("no blinded block supplied")
 	}
 
+	headers := map[string]string{
+		"Eth-Consensus-Version": block.Version.String(),
+	}
+	_, err := s.postSSZWithFallback(ctx, "/eth/v1/beacon/blinded_blocks", headers, s.preferSSZ,
+		func() ([]byte, error) { return signedBlindedBeaconBlockSSZ(block) },
+		func() ([]byte, error) { return signedBlindedBeaconBlockJSON(block) },
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to submit blinded beacon block")
+	}
+
+	return nil
+}
+
+// signedBlindedBeaconBlockJSON returns the JSON encoding of the versioned signed blinded beacon block.
+func signedBlindedBeaconBlockJSON(block *api.VersionedSignedBlindedBeaconBlock) ([]byte, error) {
+	var res []byte
+	var err error
 	switch block.Version {
 	case spec.DataVersionPhase0:
 		err = errors.New("blinded phase0 blocks not supported")
 	case spec.DataVersionAltair:
 		err = errors.New("blinded altair blocks not supported")
 	case spec.DataVersionBellatrix:
-		specJSON, err = json.Marshal(block.Bellatrix)
+		res, err = json.Marshal(block.Bellatrix)
 	case spec.DataVersionCapella:
-		specJSON, err = json.Marshal(block.Capella)
+		res, err = json.Marshal(block.Capella)
 	case spec.DataVersionDeneb:
-		specJSON, err = json.Marshal(block.Deneb)
+		res, err = json.Marshal(block.Deneb)
 	default:
 		err = errors.New("unknown block version")
 	}
 	if err != nil {
-		return errors.Wrap(err, "failed to marshal JSON")
+		return nil, errors.Wrap(err, "failed to marshal JSON")
 	}
 
-	_, err = s.post(ctx, "/eth/v1/beacon/blinded_blocks", bytes.NewBuffer(specJSON))
+	return res, nil
+}
+
+// signedBlindedBeaconBlockSSZ returns the SSZ encoding of the versioned signed blinded beacon block.
+func signedBlindedBeaconBlockSSZ(block *api.VersionedSignedBlindedBeaconBlock) ([]byte, error) {
+	var res []byte
+	var err error
+	switch block.Version {
+	case spec.DataVersionPhase0:
+		err = errors.New("blinded phase0 blocks not supported")
+	case spec.DataVersionAltair:
+		err = errors.New("blinded altair blocks not supported")
+	case spec.DataVersionBellatrix:
+		res, err = block.Bellatrix.MarshalSSZ()
+	case spec.DataVersionCapella:
+		res, err = block.Capella.MarshalSSZ()
+	case spec.DataVersionDeneb:
+		res, err = block.Deneb.MarshalSSZ()
+	default:
+		err = errors.New("unknown block version")
+	}
 	if err != nil {
-		return errors.Wrap(err, "failed to submit blinded beacon block")
+		return nil, errors.Wrap(err, "failed to marshal SSZ")
 	}
 
-	return nil
+	return res, nil
 }
@@ -0,0 +1,146 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/pkg/errors"
+)
+
+// revealedExecutionPayloadJSON is the response to a blinded beacon block submission that
+// reveals the execution payload.
+type revealedExecutionPayloadJSON struct {
+	Version *spec.DataVersion `json:"version"`
+	Data    json.RawMessage   `json:"data"`
+}
+
+// SubmitBlindedBeaconBlockV2 submits a blinded beacon block, with the beacon node carrying out the
+// given level of validation before broadcasting it.
+// If the beacon node does not provide the v2 endpoint the block is submitted to the v1 endpoint.
+// If the beacon node reveals the execution payload of the block it is returned, otherwise the
+// returned payload is nil.
+func (s *Service) SubmitBlindedBeaconBlockV2(ctx context.Context,
+	block *api.VersionedSignedBlindedBeaconBlock,
+	broadcastValidation apiv1.BroadcastValidation,
+) (
+	*api.VersionedExecutionPayload,
+	error,
+) {
+	if block == nil {
+		return nil, errors.New("no blinded block supplied")
+	}
+
+	headers := map[string]string{
+		"Eth-Consensus-Version": block.Version.String(),
+	}
+	sszBody := func() ([]byte, error) { return signedBlindedBeaconBlockSSZ(block) }
+	jsonBody := func() ([]byte, error) { return signedBlindedBeaconBlockJSON(block) }
+
+	endpoint := fmt.Sprintf("/eth/v2/beacon/blinded_blocks?broadcast_validation=%s", broadcastValidation.String())
+	respBodyReader, err := s.postSSZWithFallback(ctx, endpoint, headers, s.preferSSZ, sszBody, jsonBody)
+	var apiErr Error
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		s.log.Debug().Msg("Beacon node does not provide v2 blinded block submission; falling back to v1")
+		respBodyReader, err = s.postSSZWithFallback(ctx, "/eth/v1/beacon/blinded_blocks", headers, s.preferSSZ, sszBody, jsonBody)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to submit blinded beacon block")
+	}
+
+	payload, err := revealedExecutionPayload(block, respBodyReader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain revealed execution payload")
+	}
+
+	return payload, nil
+}
+
+// revealedExecutionPayload parses the execution payload revealed in response to the submission
+// of the blinded block, ensuring that it matches the block.
+// If the response does not contain an execution payload nil is returned.
+func revealedExecutionPayload(block *api.VersionedSignedBlindedBeaconBlock,
+	respBodyReader io.Reader,
+) (
+	*api.VersionedExecutionPayload,
+	error,
+) {
+	if respBodyReader == nil {
+		return nil, nil
+	}
+	respBody, err := io.ReadAll(respBodyReader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response")
+	}
+	if len(bytes.TrimSpace(respBody)) == 0 {
+		return nil, nil
+	}
+
+	var resp revealedExecutionPayloadJSON
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse response")
+	}
+	if len(resp.Data) == 0 || bytes.Equal(resp.Data, []byte("null")) {
+		return nil, nil
+	}
+
+	res := &api.VersionedExecutionPayload{
+		Version: block.Version,
+	}
+	if resp.Version != nil && *resp.Version != block.Version {
+		return nil, fmt.Errorf("execution payload version %s does not match block version %s", resp.Version, block.Version)
+	}
+
+	switch block.Version {
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &bellatrix.ExecutionPayload{}
+		err = json.Unmarshal(resp.Data, res.Bellatrix)
+	case spec.DataVersionCapella:
+		res.Capella = &capella.ExecutionPayload{}
+		err = json.Unmarshal(resp.Data, res.Capella)
+	case spec.DataVersionDeneb:
+		res.Deneb = &apiv1deneb.ExecutionPayloadAndBlobsBundle{}
+		err = json.Unmarshal(resp.Data, res.Deneb)
+	default:
+		return nil, fmt.Errorf("unsupported block version %s", block.Version)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s execution payload", block.Version)
+	}
+
+	blockHash, err := block.ExecutionBlockHash()
+	if err != nil {
+		return nil, err
+	}
+	payloadBlockHash, err := res.BlockHash()
+	if err != nil {
+		return nil, err
+	}
+	if payloadBlockHash != blockHash {
+		return nil, fmt.Errorf("execution payload has block hash %#x; expected %#x", payloadBlockHash, blockHash)
+	}
+
+	return res, nil
+}
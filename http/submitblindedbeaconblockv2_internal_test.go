@@ -0,0 +1,144 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitBlindedBeaconBlockV2(t *testing.T) {
+	blockHash := phase0.Hash32{0x01, 0x02}
+	block := &api.VersionedSignedBlindedBeaconBlock{
+		Version: spec.DataVersionCapella,
+		Capella: &apiv1capella.SignedBlindedBeaconBlock{
+			Message: &apiv1capella.BlindedBeaconBlock{
+				Slot: 1,
+				Body: &apiv1capella.BlindedBeaconBlockBody{
+					ETH1Data: &phase0.ETH1Data{
+						BlockHash: make([]byte, 32),
+					},
+					ExecutionPayloadHeader: &capella.ExecutionPayloadHeader{
+						BlockHash: blockHash,
+					},
+				},
+			},
+		},
+	}
+	payload, err := json.Marshal(&capella.ExecutionPayload{
+		BlockHash:    blockHash,
+		Transactions: []bellatrix.Transaction{},
+		Withdrawals:  []*capella.Withdrawal{},
+	})
+	require.NoError(t, err)
+	wrongPayload, err := json.Marshal(&capella.ExecutionPayload{
+		BlockHash:    phase0.Hash32{0x03},
+		Transactions: []bellatrix.Transaction{},
+		Withdrawals:  []*capella.Withdrawal{},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		v2        bool
+		response  string
+		endpoints []string
+		revealed  bool
+		err       string
+	}{
+		{
+			name:      "NoPayload",
+			v2:        true,
+			endpoints: []string{"/eth/v2/beacon/blinded_blocks"},
+		},
+		{
+			name:      "Payload",
+			v2:        true,
+			response:  fmt.Sprintf(`{"version":"capella","data":%s}`, payload),
+			endpoints: []string{"/eth/v2/beacon/blinded_blocks"},
+			revealed:  true,
+		},
+		{
+			name:      "V1Fallback",
+			response:  fmt.Sprintf(`{"version":"capella","data":%s}`, payload),
+			endpoints: []string{"/eth/v2/beacon/blinded_blocks", "/eth/v1/beacon/blinded_blocks"},
+			revealed:  true,
+		},
+		{
+			name:      "VersionMismatch",
+			v2:        true,
+			response:  fmt.Sprintf(`{"version":"bellatrix","data":%s}`, payload),
+			endpoints: []string{"/eth/v2/beacon/blinded_blocks"},
+			err:       "failed to obtain revealed execution payload: execution payload version bellatrix does not match block version capella",
+		},
+		{
+			name:      "BlockHashMismatch",
+			v2:        true,
+			response:  fmt.Sprintf(`{"version":"capella","data":%s}`, wrongPayload),
+			endpoints: []string{"/eth/v2/beacon/blinded_blocks"},
+			err:       "failed to obtain revealed execution payload: execution payload has block hash 0x0300000000000000000000000000000000000000000000000000000000000000; expected 0x0102000000000000000000000000000000000000000000000000000000000000",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			endpoints := make([]string, 0)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				endpoints = append(endpoints, r.URL.Path)
+				if r.URL.Path == "/eth/v2/beacon/blinded_blocks" {
+					require.Equal(t, "consensus", r.URL.Query().Get("broadcast_validation"))
+					if !test.v2 {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+				}
+				require.Equal(t, "capella", r.Header.Get("Eth-Consensus-Version"))
+				_, _ = w.Write([]byte(test.response))
+			}))
+			defer srv.Close()
+
+			s := testService(t, srv)
+
+			res, err := s.SubmitBlindedBeaconBlockV2(context.Background(), block, apiv1.BroadcastValidationConsensus)
+			require.Equal(t, test.endpoints, endpoints)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			if !test.revealed {
+				require.Nil(t, res)
+				return
+			}
+			require.NotNil(t, res)
+			require.Equal(t, spec.DataVersionCapella, res.Version)
+			resBlockHash, err := res.BlockHash()
+			require.NoError(t, err)
+			require.Equal(t, blockHash, resBlockHash)
+		})
+	}
+}
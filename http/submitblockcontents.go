@@ -0,0 +1,63 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	utildeneb "github.com/attestantio/go-eth2-client/util/deneb"
+	"github.com/pkg/errors"
+)
+
+// SubmitBlockContents submits a block along with its blob sidecars, with the beacon node carrying
+// out the given level of validation before broadcasting them.
+// If the blob pre-check is enabled the blob sidecars are verified against the block before they
+// are sent, and an error is returned without contacting the beacon node if they do not match.
+func (s *Service) SubmitBlockContents(ctx context.Context,
+	contents *apiv1deneb.SignedBlockContents,
+	broadcastValidation apiv1.BroadcastValidation,
+) error {
+	if contents == nil {
+		return errors.New("no block contents supplied")
+	}
+	if contents.SignedBlock == nil || contents.SignedBlock.Message == nil {
+		return errors.New("no block supplied")
+	}
+
+	if s.blobPrecheck {
+		if err := utildeneb.VerifySignedBlockContents(contents, s.kzgVerifier); err != nil {
+			return errors.Wrap(err, "blob pre-check failed")
+		}
+	}
+
+	endpoint := fmt.Sprintf("/eth/v2/beacon/blocks?broadcast_validation=%s", broadcastValidation.String())
+	headers := map[string]string{
+		"Eth-Consensus-Version": spec.DataVersionDeneb.String(),
+	}
+
+	_, err := s.postSSZWithFallback(ctx, endpoint, headers, !s.enforceJSON,
+		contents.MarshalSSZ,
+		func() ([]byte, error) { return json.Marshal(contents) },
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to submit block contents")
+	}
+
+	return nil
+}
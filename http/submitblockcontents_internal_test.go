@@ -0,0 +1,137 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func testSignedBlockContents(t *testing.T) *apiv1deneb.SignedBlockContents {
+	t.Helper()
+
+	block := &deneb.BeaconBlock{
+		Slot: 1,
+		Body: &deneb.BeaconBlockBody{
+			ETH1Data: &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+			SyncAggregate: &altair.SyncAggregate{
+				SyncCommitteeBits: bitfield.NewBitvector512(),
+			},
+			ExecutionPayload: &deneb.ExecutionPayload{
+				BaseFeePerGas: uint256.NewInt(7),
+				ExtraData:     []byte{},
+			},
+			BlobKzgCommitments: []deneb.KzgCommitment{{0x10}},
+		},
+	}
+	blockRoot, err := block.HashTreeRoot()
+	require.NoError(t, err)
+
+	return &apiv1deneb.SignedBlockContents{
+		SignedBlock: &deneb.SignedBeaconBlock{Message: block},
+		SignedBlobSidecars: []*deneb.SignedBlobSidecar{
+			{
+				Message: &deneb.BlobSidecar{
+					BlockRoot:     blockRoot,
+					Slot:          block.Slot,
+					KzgCommitment: block.Body.BlobKzgCommitments[0],
+				},
+			},
+		},
+	}
+}
+
+func TestSubmitBlockContents(t *testing.T) {
+	tests := []struct {
+		name         string
+		blobPrecheck bool
+		mismatch     bool
+		submissions  int
+		err          string
+	}{
+		{
+			name:        "Good",
+			submissions: 1,
+		},
+		{
+			name:         "GoodPrecheck",
+			blobPrecheck: true,
+			submissions:  1,
+		},
+		{
+			name:        "MismatchNoPrecheck",
+			mismatch:    true,
+			submissions: 1,
+		},
+		{
+			name:         "MismatchPrecheck",
+			blobPrecheck: true,
+			mismatch:     true,
+			err:          "blob pre-check failed: blob sidecar 0 invalid: blob sidecar commitment 0x200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000 does not match block commitment 0x100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			submissions := make([]*submittedBlock, 0)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				submissions = append(submissions, &submittedBlock{
+					contentType:         r.Header.Get("Content-Type"),
+					consensusVersion:    r.Header.Get("Eth-Consensus-Version"),
+					broadcastValidation: r.URL.Query().Get("broadcast_validation"),
+					body:                body,
+				})
+			}))
+			defer srv.Close()
+
+			s := testService(t, srv)
+			s.blobPrecheck = test.blobPrecheck
+
+			contents := testSignedBlockContents(t)
+			if test.mismatch {
+				contents.SignedBlobSidecars[0].Message.KzgCommitment = deneb.KzgCommitment{0x20}
+			}
+			contentsSSZ, err := contents.MarshalSSZ()
+			require.NoError(t, err)
+
+			err = s.SubmitBlockContents(context.Background(), contents, apiv1.BroadcastValidationGossip)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Len(t, submissions, test.submissions)
+			for _, submission := range submissions {
+				require.Equal(t, "application/octet-stream", submission.contentType)
+				require.Equal(t, "deneb", submission.consensusVersion)
+				require.Equal(t, "gossip", submission.broadcastValidation)
+				require.Equal(t, contentsSSZ, submission.body)
+			}
+		})
+	}
+}
@@ -17,13 +17,62 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
 
+// blsToExecutionChangesChunkSize is the maximum number of BLS to execution changes
+// to send in each submission.
+const blsToExecutionChangesChunkSize = 512
+
 // SubmitBLSToExecutionChanges submits BLS to execution address change operations.
+// Large batches are split and submitted in chunks; if some, but not all, chunks fail a
+// *PartialFailureError is returned as the error, detailing the validators whose changes were
+// not accepted.
 func (s *Service) SubmitBLSToExecutionChanges(ctx context.Context, blsToExecutionChanges []*capella.SignedBLSToExecutionChange) error {
+	if len(blsToExecutionChanges) == 0 {
+		return errors.New("no BLS to execution changes supplied")
+	}
+
+	changes := make(map[phase0.ValidatorIndex]*capella.SignedBLSToExecutionChange, len(blsToExecutionChanges))
+	indices := make([]phase0.ValidatorIndex, 0, len(blsToExecutionChanges))
+	for i, change := range blsToExecutionChanges {
+		if change == nil || change.Message == nil {
+			return fmt.Errorf("BLS to execution change %d is empty", i)
+		}
+		if _, exists := changes[change.Message.ValidatorIndex]; exists {
+			return fmt.Errorf("multiple BLS to execution changes for validator %d", change.Message.ValidatorIndex)
+		}
+		changes[change.Message.ValidatorIndex] = change
+		indices = append(indices, change.Message.ValidatorIndex)
+	}
+
+	_, partialErr, err := chunkedIndexRequest(ctx, indices, blsToExecutionChangesChunkSize,
+		func(ctx context.Context, chunk []phase0.ValidatorIndex) ([]struct{}, error) {
+			chunkChanges := make([]*capella.SignedBLSToExecutionChange, len(chunk))
+			for i := range chunk {
+				chunkChanges[i] = changes[chunk[i]]
+			}
+
+			return nil, s.submitBLSToExecutionChanges(ctx, chunkChanges)
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if partialErr != nil {
+		// There is no data to return alongside the failure, so return it as the error.
+		return partialErr
+	}
+
+	return nil
+}
+
+// submitBLSToExecutionChanges submits a single chunk of BLS to execution address change operations.
+func (s *Service) submitBLSToExecutionChanges(ctx context.Context, blsToExecutionChanges []*capella.SignedBLSToExecutionChange) error {
 	specJSON, err := json.Marshal(blsToExecutionChanges)
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal JSON")
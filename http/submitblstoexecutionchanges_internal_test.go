@@ -0,0 +1,65 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitBLSToExecutionChangesChunks(t *testing.T) {
+	submissions := make([]int, 0)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var changes []*capella.SignedBLSToExecutionChange
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&changes))
+		submissions = append(submissions, len(changes))
+		if changes[0].Message.ValidatorIndex >= blsToExecutionChangesChunkSize {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	s := testService(t, srv)
+	ctx := context.Background()
+
+	changes := make([]*capella.SignedBLSToExecutionChange, blsToExecutionChangesChunkSize+10)
+	for i := range changes {
+		changes[i] = &capella.SignedBLSToExecutionChange{
+			Message: &capella.BLSToExecutionChange{
+				ValidatorIndex: phase0.ValidatorIndex(i),
+			},
+		}
+	}
+
+	err := s.SubmitBLSToExecutionChanges(ctx, changes)
+	require.Equal(t, []int{blsToExecutionChangesChunkSize, 10}, submissions)
+	var partialErr *PartialFailureError
+	require.True(t, errors.As(err, &partialErr))
+	require.Len(t, partialErr.FailedIndices(), 10)
+	require.Equal(t, phase0.ValidatorIndex(blsToExecutionChangesChunkSize), partialErr.FailedIndices()[0])
+
+	require.EqualError(t, s.SubmitBLSToExecutionChanges(ctx, nil), "no BLS to execution changes supplied")
+	require.EqualError(t, s.SubmitBLSToExecutionChanges(ctx, []*capella.SignedBLSToExecutionChange{changes[0], changes[0]}),
+		"multiple BLS to execution changes for validator 0")
+	require.EqualError(t, s.SubmitBLSToExecutionChanges(ctx, []*capella.SignedBLSToExecutionChange{{}}),
+		"BLS to execution change 0 is empty")
+}
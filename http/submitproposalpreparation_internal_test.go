@@ -0,0 +1,50 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitProposalPreparations(t *testing.T) {
+	var body []byte
+	var path string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		var err error
+		body, err = io.ReadAll(r.Body)
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	s := testService(t, srv)
+
+	err := s.SubmitProposalPreparations(context.Background(), []*apiv1.ProposalPreparation{
+		{
+			ValidatorIndex: 1,
+			FeeRecipient:   bellatrix.ExecutionAddress{0x01},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "/eth/v1/validator/prepare_beacon_proposer", path)
+	require.JSONEq(t, `[{"validator_index":"1","fee_recipient":"0x0100000000000000000000000000000000000000"}]`, string(body))
+}
@@ -19,11 +19,16 @@ import (
 	"encoding/json"
 
 	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
 
-// SubmitValidatorRegistrations submits a validator registration.
+// SubmitValidatorRegistrations submits validator registrations.
+// Registrations are sent in chunks of the size set with WithValidatorRegistrationChunkSize, with each
+// chunk retried according to the service's retry policy.  If deduplication is enabled, registrations
+// that are unchanged since they were last successfully submitted are not sent again.
 func (s *Service) SubmitValidatorRegistrations(ctx context.Context, registrations []*api.VersionedSignedValidatorRegistration) error {
 	if len(registrations) == 0 {
 		return errors.New("no registrations supplied")
@@ -31,7 +36,7 @@ func (s *Service) SubmitValidatorRegistrations(ctx context.Context, registration
 
 	// Unwrap versioned registrations.
 	var version *spec.BuilderVersion
-	var unversionedRegistrations []interface{}
+	unversionedRegistrations := make([]*apiv1.SignedValidatorRegistration, 0, len(registrations))
 
 	for _, registration := range registrations {
 		if registration == nil {
@@ -54,14 +59,122 @@ func (s *Service) SubmitValidatorRegistrations(ctx context.Context, registration
 		}
 	}
 
-	specJSON, err := json.Marshal(unversionedRegistrations)
+	roots, err := registrationRoots(unversionedRegistrations)
 	if err != nil {
-		return errors.Wrap(err, "failed to marshal JSON")
+		return err
 	}
-	_, err = s.post(ctx, "/eth/v1/validator/register_validator", bytes.NewBuffer(specJSON))
-	if err != nil {
-		return errors.Wrap(err, "failed to submit validator registration")
+	if s.validatorRegistrationDedup {
+		unversionedRegistrations, roots = s.changedRegistrations(unversionedRegistrations, roots)
+		if len(unversionedRegistrations) == 0 {
+			s.log.Trace().Msg("All validator registrations unchanged; not submitting")
+			return nil
+		}
+	}
+
+	chunks := (len(unversionedRegistrations) + s.validatorRegistrationChunkSize - 1) / s.validatorRegistrationChunkSize
+	failed := 0
+	var firstErr error
+	for i := 0; i < len(unversionedRegistrations); i += s.validatorRegistrationChunkSize {
+		end := i + s.validatorRegistrationChunkSize
+		if end > len(unversionedRegistrations) {
+			end = len(unversionedRegistrations)
+		}
+		if err := s.submitValidatorRegistrationsChunk(ctx, unversionedRegistrations[i:end]); err != nil {
+			s.log.Debug().Int("start", i).Int("end", end).Err(err).Msg("Failed to submit validator registration chunk")
+			failed++
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		s.recordRegistrations(unversionedRegistrations[i:end], roots[i:end])
+	}
+
+	if failed > 0 {
+		if chunks == 1 {
+			return errors.Wrap(firstErr, "failed to submit validator registration")
+		}
+
+		return errors.Wrapf(firstErr, "failed to submit %d of %d validator registration chunks", failed, chunks)
 	}
 
 	return nil
 }
+
+// submitValidatorRegistrationsChunk submits a single chunk of validator registrations, retrying
+// according to the service's retry policy.
+func (s *Service) submitValidatorRegistrationsChunk(ctx context.Context, registrations []*apiv1.SignedValidatorRegistration) error {
+	specJSON, err := json.Marshal(registrations)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	for attempt := 1; ; attempt++ {
+		_, err = s.post(ctx, "/eth/v1/validator/register_validator", bytes.NewReader(specJSON))
+		if err == nil {
+			return nil
+		}
+		if attempt >= s.retry.attempts() || !s.retry.retryable(ctx, err) {
+			return err
+		}
+		delay := s.retry.delay(attempt)
+		s.log.Trace().Err(err).Int("attempt", attempt).Dur("delay", delay).Msg("Validator registration chunk failed; retrying")
+		if !sleepCtx(ctx, delay) {
+			return err
+		}
+	}
+}
+
+// registrationRoots returns the hash tree roots of the registration messages.
+func registrationRoots(registrations []*apiv1.SignedValidatorRegistration) ([]phase0.Root, error) {
+	roots := make([]phase0.Root, len(registrations))
+	for i, registration := range registrations {
+		if registration == nil || registration.Message == nil {
+			return nil, errors.New("nil registration supplied")
+		}
+		root, err := registration.Message.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain registration root")
+		}
+		roots[i] = root
+	}
+
+	return roots, nil
+}
+
+// changedRegistrations returns the registrations, and their roots, that differ from those
+// last successfully submitted.
+func (s *Service) changedRegistrations(registrations []*apiv1.SignedValidatorRegistration,
+	roots []phase0.Root,
+) (
+	[]*apiv1.SignedValidatorRegistration,
+	[]phase0.Root,
+) {
+	s.submittedRegistrationsMu.Lock()
+	defer s.submittedRegistrationsMu.Unlock()
+
+	changed := make([]*apiv1.SignedValidatorRegistration, 0, len(registrations))
+	changedRoots := make([]phase0.Root, 0, len(roots))
+	for i, registration := range registrations {
+		if root, exists := s.submittedRegistrations[registration.Message.Pubkey]; exists && root == roots[i] {
+			continue
+		}
+		changed = append(changed, registration)
+		changedRoots = append(changedRoots, roots[i])
+	}
+
+	return changed, changedRoots
+}
+
+// recordRegistrations records registrations as successfully submitted.
+func (s *Service) recordRegistrations(registrations []*apiv1.SignedValidatorRegistration, roots []phase0.Root) {
+	if !s.validatorRegistrationDedup {
+		return
+	}
+
+	s.submittedRegistrationsMu.Lock()
+	defer s.submittedRegistrationsMu.Unlock()
+	for i, registration := range registrations {
+		s.submittedRegistrations[registration.Message.Pubkey] = roots[i]
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// registrationsServer is a test server that records the registrations it receives.
+type registrationsServer struct {
+	mu       sync.Mutex
+	requests [][]*apiv1.SignedValidatorRegistration
+	// failures is the number of requests to fail before succeeding.
+	failures int
+	// status is the status code returned for failed requests.
+	status int
+}
+
+func (r *registrationsServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failures > 0 {
+		r.failures--
+		w.WriteHeader(r.status)
+		return
+	}
+	var registrations []*apiv1.SignedValidatorRegistration
+	if err := json.Unmarshal(body, &registrations); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	r.requests = append(r.requests, registrations)
+}
+
+func testRegistrations(count int, gasLimit uint64) []*api.VersionedSignedValidatorRegistration {
+	res := make([]*api.VersionedSignedValidatorRegistration, count)
+	for i := range res {
+		res[i] = &api.VersionedSignedValidatorRegistration{
+			Version: spec.BuilderVersionV1,
+			V1: &apiv1.SignedValidatorRegistration{
+				Message: &apiv1.ValidatorRegistration{
+					FeeRecipient: bellatrix.ExecutionAddress{0x01},
+					GasLimit:     gasLimit,
+					Pubkey:       phase0.BLSPubKey{byte(i), byte(i >> 8)},
+				},
+			},
+		}
+	}
+
+	return res
+}
+
+func TestSubmitValidatorRegistrationsChunks(t *testing.T) {
+	handler := &registrationsServer{}
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	s := testService(t, srv)
+	s.validatorRegistrationChunkSize = 4
+
+	require.NoError(t, s.SubmitValidatorRegistrations(context.Background(), testRegistrations(10, 30000000)))
+	require.Len(t, handler.requests, 3)
+	require.Len(t, handler.requests[0], 4)
+	require.Len(t, handler.requests[1], 4)
+	require.Len(t, handler.requests[2], 2)
+	require.Equal(t, phase0.BLSPubKey{9}, handler.requests[2][1].Message.Pubkey)
+}
+
+func TestSubmitValidatorRegistrationsRetry(t *testing.T) {
+	handler := &registrationsServer{
+		failures: 2,
+		status:   http.StatusServiceUnavailable,
+	}
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	s := testService(t, srv)
+	s.validatorRegistrationChunkSize = 4
+	s.retry = &retryPolicy{
+		maxAttempts: 3,
+	}
+
+	require.NoError(t, s.SubmitValidatorRegistrations(context.Background(), testRegistrations(6, 30000000)))
+	require.Len(t, handler.requests, 2)
+
+	// Fail more times than the retry policy allows.
+	handler.requests = nil
+	handler.failures = 3
+	err := s.SubmitValidatorRegistrations(context.Background(), testRegistrations(6, 30000000))
+	require.ErrorContains(t, err, "failed to submit 1 of 2 validator registration chunks")
+	require.Len(t, handler.requests, 1)
+
+	// Status not retryable.
+	handler.requests = nil
+	handler.failures = 1
+	handler.status = http.StatusBadRequest
+	err = s.SubmitValidatorRegistrations(context.Background(), testRegistrations(2, 30000000))
+	require.ErrorContains(t, err, "failed to submit validator registration")
+	require.Empty(t, handler.requests)
+}
+
+func TestSubmitValidatorRegistrationsDedup(t *testing.T) {
+	handler := &registrationsServer{}
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	s := testService(t, srv)
+	s.validatorRegistrationChunkSize = 500
+	s.validatorRegistrationDedup = true
+	s.submittedRegistrations = make(map[phase0.BLSPubKey]phase0.Root)
+
+	ctx := context.Background()
+	require.NoError(t, s.SubmitValidatorRegistrations(ctx, testRegistrations(4, 30000000)))
+	require.Len(t, handler.requests, 1)
+	require.Len(t, handler.requests[0], 4)
+
+	// Resubmission of unchanged registrations sends nothing.
+	require.NoError(t, s.SubmitValidatorRegistrations(ctx, testRegistrations(4, 30000000)))
+	require.Len(t, handler.requests, 1)
+
+	// Changed and new registrations are sent.
+	registrations := testRegistrations(6, 30000000)
+	registrations[1] = testRegistrations(2, 36000000)[1]
+	require.NoError(t, s.SubmitValidatorRegistrations(ctx, registrations))
+	require.Len(t, handler.requests, 2)
+	require.Len(t, handler.requests[1], 3)
+	require.Equal(t, uint64(36000000), handler.requests[1][0].Message.GasLimit)
+
+	// Failed submissions are not recorded.
+	handler.failures = 1
+	handler.status = http.StatusBadRequest
+	registrations = testRegistrations(7, 30000000)
+	require.Error(t, s.SubmitValidatorRegistrations(ctx, registrations))
+	require.NoError(t, s.SubmitValidatorRegistrations(ctx, registrations))
+	require.Len(t, handler.requests, 3)
+	require.Len(t, handler.requests[2], 2)
+}
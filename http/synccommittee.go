@@ -21,6 +21,7 @@ import (
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type syncCommitteeJSON struct {
@@ -29,14 +30,14 @@ type syncCommitteeJSON struct {
 
 // SyncCommittee fetches the sync committee for epoch at the given state.
 func (s *Service) SyncCommittee(ctx context.Context, stateID string) (*api.SyncCommittee, error) {
+	ctx, span := s.startSpan(ctx, "SyncCommittee", attribute.String("state_id", stateID))
+	defer span.End()
+
 	url := fmt.Sprintf("/eth/v1/beacon/states/%s/sync_committees", stateID)
 	respBodyReader, err := s.get(ctx, url)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request sync committee")
 	}
-	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain sync committee")
-	}
 
 	var resp syncCommitteeJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
@@ -48,14 +49,14 @@ func (s *Service) SyncCommittee(ctx context.Context, stateID string) (*api.SyncC
 
 // SyncCommitteeAtEpoch fetches the sync committee for the given epoch at the given state.
 func (s *Service) SyncCommitteeAtEpoch(ctx context.Context, stateID string, epoch phase0.Epoch) (*api.SyncCommittee, error) {
+	ctx, span := s.startSpan(ctx, "SyncCommitteeAtEpoch", attribute.String("state_id", stateID))
+	defer span.End()
+
 	url := fmt.Sprintf("/eth/v1/beacon/states/%s/sync_committees?epoch=%d", stateID, epoch)
 	respBodyReader, err := s.get(ctx, url)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request sync committee")
 	}
-	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain sync committee")
-	}
 
 	var resp syncCommitteeJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
@@ -41,14 +41,25 @@ func (s *Service) SyncCommitteeContribution(ctx context.Context,
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request sync committee contribution")
 	}
-	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain sync committee contribution")
-	}
 
 	var resp syncCommitteeContributionJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
 		return nil, errors.Wrap(err, "failed to parse sync committee contribution")
 	}
+	if resp.Data == nil {
+		return nil, errors.New("sync committee contribution not returned")
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	if resp.Data.Slot != slot {
+		return nil, fmt.Errorf("sync committee contribution for slot %d; expected %d", resp.Data.Slot, slot)
+	}
+	if resp.Data.SubcommitteeIndex != subcommitteeIndex {
+		return nil, fmt.Errorf("sync committee contribution for subcommittee %d; expected %d", resp.Data.SubcommitteeIndex, subcommitteeIndex)
+	}
+	if resp.Data.BeaconBlockRoot != beaconBlockRoot {
+		return nil, fmt.Errorf("sync committee contribution for beacon block root %#x; expected %#x", resp.Data.BeaconBlockRoot, beaconBlockRoot)
+	}
 
 	return resp.Data, nil
 }
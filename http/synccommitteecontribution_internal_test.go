@@ -0,0 +1,86 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncCommitteeContributionChecks(t *testing.T) {
+	root := "0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"
+	contribution := func(slot string, subcommitteeIndex string) string {
+		return `{"data":{"slot":"` + slot + `","beacon_block_root":"` + root + `","subcommittee_index":"` + subcommitteeIndex + `","aggregation_bits":"0x00000000000000000000000000000000","signature":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}}`
+	}
+
+	tests := []struct {
+		name string
+		resp string
+		root phase0.Root
+		err  string
+	}{
+		{
+			name: "Missing",
+			resp: `{}`,
+			err:  "sync committee contribution not returned",
+		},
+		{
+			name: "WrongSlot",
+			resp: contribution("2", "1"),
+			err:  "sync committee contribution for slot 2; expected 1",
+		},
+		{
+			name: "WrongSubcommittee",
+			resp: contribution("1", "2"),
+			err:  "sync committee contribution for subcommittee 2; expected 1",
+		},
+		{
+			name: "WrongRoot",
+			resp: contribution("1", "1"),
+			root: phase0.Root{0x01},
+			err:  "sync committee contribution for beacon block root 0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20; expected 0x0100000000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			name: "Good",
+			resp: contribution("1", "1"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(test.resp))
+			}))
+			defer srv.Close()
+
+			s := testService(t, srv)
+			expectedRoot := phase0.Root{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20}
+			if test.root != (phase0.Root{}) {
+				expectedRoot = test.root
+			}
+			res, err := s.SyncCommitteeContribution(context.Background(), 1, 1, expectedRoot)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, phase0.Slot(1), res.Slot)
+			}
+		})
+	}
+}
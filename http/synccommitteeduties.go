@@ -29,7 +29,31 @@ type syncCommitteeDutiesJSON struct {
 }
 
 // SyncCommitteeDuties obtains sync committee duties.
+// Requests with more validator indices than the duties index chunk size are split in to multiple
+// requests.  If some of these requests fail the duties from the successful requests are returned
+// without error, and a *PartialFailureError detailing the failed indices is passed to the partial
+// failure handlers.
 func (s *Service) SyncCommitteeDuties(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*api.SyncCommitteeDuty, error) {
+	chunkSize := s.dutiesIndexChunkSize()
+	if len(validatorIndices) <= chunkSize {
+		return s.syncCommitteeDuties(ctx, epoch, validatorIndices)
+	}
+
+	duties, partialErr, err := chunkedIndexRequest(ctx, validatorIndices, chunkSize, func(ctx context.Context, chunk []phase0.ValidatorIndex) ([]*api.SyncCommitteeDuty, error) {
+		return s.syncCommitteeDuties(ctx, epoch, chunk)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if partialErr != nil {
+		s.reportPartialFailure(ctx, partialErr)
+	}
+
+	return duties, nil
+}
+
+// syncCommitteeDuties obtains sync committee duties with a single request.
+func (s *Service) syncCommitteeDuties(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*api.SyncCommitteeDuty, error) {
 	var reqBodyReader bytes.Buffer
 	if _, err := reqBodyReader.WriteString(`[`); err != nil {
 		return nil, errors.Wrap(err, "failed to write validator index array start")
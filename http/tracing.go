@@ -0,0 +1,46 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the name of the tracer used by the service.
+const tracerName = "github.com/attestantio/go-eth2-client/http"
+
+// noopTracer is used when the service has no tracer.
+var noopTracer = trace.NewNoopTracerProvider().Tracer(tracerName)
+
+// startSpan starts a span for a call to the beacon node.
+// The span must be ended by the caller.
+func (s *Service) startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	tracer := s.tracer
+	if tracer == nil {
+		tracer = noopTracer
+	}
+
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// spanError records an error against the span, if any, in the context.
+func spanError(ctx context.Context, err error) {
+	span := trace.SpanFromContext(ctx)
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
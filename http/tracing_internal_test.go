@@ -0,0 +1,92 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	res := make(map[attribute.Key]attribute.Value)
+	for _, attr := range span.Attributes() {
+		res[attr.Key] = attr.Value
+	}
+
+	return res
+}
+
+func TestTracing(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/eth/v1/beacon/states/missing/fork" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Eth-Consensus-Version", "phase0")
+		_, err := w.Write([]byte(`{"data":{"previous_version":"0x00000000","current_version":"0x00000000","epoch":"0"}}`))
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	s := testService(t, srv)
+	s.tracer = provider.Tracer(tracerName)
+
+	_, err := s.Fork(context.Background(), "head")
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	// The GET span ends first, and is a child of the provider span.
+	require.Equal(t, "GET", spans[0].Name())
+	require.Equal(t, spans[1].SpanContext().SpanID(), spans[0].Parent().SpanID())
+	attrs := spanAttributes(spans[0])
+	require.Equal(t, "/eth/v1/beacon/states/head/fork", attrs["endpoint"].AsString())
+	require.Equal(t, int64(200), attrs["status_code"].AsInt64())
+	require.Equal(t, int64(85), attrs["response_size"].AsInt64())
+	require.Equal(t, "phase0", attrs["consensus_version"].AsString())
+
+	require.Equal(t, "Fork", spans[1].Name())
+	require.Equal(t, "head", spanAttributes(spans[1])["state_id"].AsString())
+
+	// Errors are recorded against the span.
+	_, err = s.Fork(context.Background(), "missing")
+	require.Error(t, err)
+	spans = recorder.Ended()
+	require.Len(t, spans, 4)
+	require.Equal(t, codes.Error, spans[2].Status().Code)
+}
+
+func TestTracingDisabled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write([]byte(`{"data":{"previous_version":"0x00000000","current_version":"0x00000000","epoch":"0"}}`))
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	// A service without a tracer should operate as normal.
+	_, err := testService(t, srv).Fork(context.Background(), "head")
+	require.NoError(t, err)
+}
@@ -0,0 +1,121 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/r3labs/sse/v2"
+	"github.com/rs/zerolog"
+)
+
+// EventFunc is called by a transport for each event received on an event stream.
+type EventFunc func(topic string, data []byte)
+
+// Transport carries requests and event streams between the service and the beacon node.
+type Transport interface {
+	// Do sends a request to the beacon node and returns its response.
+	// The request's context governs the lifetime of the request.
+	Do(req *http.Request) (*http.Response, error)
+
+	// Subscribe streams events from the event stream at the given URL to the handler,
+	// sending the supplied headers with the request for the stream.
+	// It blocks until the context is done or the stream is disconnected.
+	Subscribe(ctx context.Context, url *url.URL, headers map[string]string, handler EventFunc) error
+}
+
+// loggingTransport is a transport that logs, and uses the service's logger when supplied to a service.
+type loggingTransport interface {
+	setLogger(log zerolog.Logger)
+}
+
+// DialContextFunc opens a network connection to the given address.
+type DialContextFunc func(ctx context.Context, network string, address string) (net.Conn, error)
+
+// httpTransport is the default transport, using plain HTTP requests and server-sent events.
+type httpTransport struct {
+	client      *http.Client
+	dialContext DialContextFunc
+}
+
+// newDefaultTransport creates the default transport given the connection settings in the parameters.
+func newDefaultTransport(parameters *parameters) *httpTransport {
+	dialContext := parameters.dialContext
+	if dialContext == nil {
+		dialContext = (&net.Dialer{
+			Timeout:   parameters.timeout,
+			KeepAlive: parameters.keepAlive,
+		}).DialContext
+	}
+
+	transport := &http.Transport{
+		DialContext:         dialContext,
+		MaxIdleConns:        parameters.maxIdleConnsPerHost,
+		MaxConnsPerHost:     parameters.maxConnsPerHost,
+		MaxIdleConnsPerHost: parameters.maxIdleConnsPerHost,
+		IdleConnTimeout:     600 * time.Second,
+		ForceAttemptHTTP2:   parameters.http2,
+	}
+	if !parameters.http2 {
+		// A non-nil empty map stops the transport from upgrading connections to HTTP/2.
+		transport.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+	}
+
+	res := newHTTPTransport(&http.Client{
+		Timeout:   parameters.timeout,
+		Transport: transport,
+	})
+	res.dialContext = parameters.dialContext
+
+	return res
+}
+
+// newHTTPTransport creates a transport using the given HTTP client.
+func newHTTPTransport(client *http.Client) *httpTransport {
+	return &httpTransport{
+		client: client,
+	}
+}
+
+// Do sends a request to the beacon node and returns its response.
+func (t *httpTransport) Do(req *http.Request) (*http.Response, error) {
+	return t.client.Do(req)
+}
+
+// Subscribe streams events from the event stream at the given URL to the handler.
+func (t *httpTransport) Subscribe(ctx context.Context, url *url.URL, headers map[string]string, handler EventFunc) error {
+	client := sse.NewClient(url.String())
+	for k, v := range headers {
+		client.Headers[k] = v
+	}
+	dialContext := t.dialContext
+	if dialContext == nil {
+		dialContext = (&net.Dialer{
+			Timeout:   2 * time.Second,
+			KeepAlive: 2 * time.Second,
+		}).DialContext
+	}
+	client.Connection.Transport = &http.Transport{
+		DialContext: dialContext,
+	}
+
+	return client.SubscribeRawWithContext(ctx, func(msg *sse.Event) {
+		handler(string(msg.Event), msg.Data)
+	})
+}
@@ -0,0 +1,129 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscribeHeaders(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, "event: head\ndata: {}\n\n")
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	streamURL, err := url.Parse(fmt.Sprintf("%s/eth/v1/events?topics=head", srv.URL))
+	require.NoError(t, err)
+
+	topics := make(chan string, 1)
+	go func() {
+		_ = newHTTPTransport(srv.Client()).Subscribe(ctx, streamURL, map[string]string{"Authorization": "Bearer token"}, func(topic string, _ []byte) {
+			topics <- topic
+		})
+	}()
+
+	require.Equal(t, "head", <-topics)
+}
+
+func TestDefaultTransport(t *testing.T) {
+	parameters, err := parseAndCheckParameters(WithAddress("localhost:5052"))
+	require.NoError(t, err)
+	transport := newDefaultTransport(parameters).client.Transport.(*http.Transport)
+	require.Equal(t, 64, transport.MaxIdleConnsPerHost)
+	require.Equal(t, 64, transport.MaxConnsPerHost)
+	require.False(t, transport.ForceAttemptHTTP2)
+	require.NotNil(t, transport.TLSNextProto)
+
+	parameters, err = parseAndCheckParameters(WithAddress("localhost:5052"),
+		WithMaxIdleConnsPerHost(256),
+		WithMaxConnsPerHost(0),
+		WithKeepAlive(-1),
+		WithHTTP2(true),
+	)
+	require.NoError(t, err)
+	transport = newDefaultTransport(parameters).client.Transport.(*http.Transport)
+	require.Equal(t, 256, transport.MaxIdleConnsPerHost)
+	require.Equal(t, 256, transport.MaxIdleConns)
+	require.Equal(t, 0, transport.MaxConnsPerHost)
+	require.True(t, transport.ForceAttemptHTTP2)
+	require.Nil(t, transport.TLSNextProto)
+}
+
+func TestDefaultTransportDialContext(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/eth/v1/events" {
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, "event: head\ndata: {}\n\n")
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	var dials atomic.Int32
+	dialer := &net.Dialer{Timeout: time.Second}
+	parameters, err := parseAndCheckParameters(WithAddress(srv.URL),
+		WithDialContext(func(ctx context.Context, network string, address string) (net.Conn, error) {
+			dials.Add(1)
+			return dialer.DialContext(ctx, network, address)
+		}),
+	)
+	require.NoError(t, err)
+	transport := newDefaultTransport(parameters)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+	resp, err := transport.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, int32(1), dials.Load())
+
+	// Event streams use the same dialer.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	streamURL, err := url.Parse(fmt.Sprintf("%s/eth/v1/events?topics=head", srv.URL))
+	require.NoError(t, err)
+	topics := make(chan string, 1)
+	go func() {
+		_ = transport.Subscribe(ctx, streamURL, nil, func(topic string, _ []byte) {
+			topics <- topic
+		})
+	}()
+	require.Equal(t, "head", <-topics)
+	require.Equal(t, int32(2), dials.Load())
+}
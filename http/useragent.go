@@ -0,0 +1,72 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync"
+)
+
+// modulePath is the path of this module, used to find its version in the build information.
+const modulePath = "github.com/attestantio/go-eth2-client"
+
+var (
+	moduleVersionOnce sync.Once
+	moduleVersionStr  string
+)
+
+// moduleVersion returns the version of this module from the build information, or "dev"
+// if it is not available, for example when running the module's own tests.
+func moduleVersion() string {
+	moduleVersionOnce.Do(func() {
+		moduleVersionStr = "dev"
+		info, ok := debug.ReadBuildInfo()
+		if !ok {
+			return
+		}
+		module := &info.Main
+		if module.Path != modulePath {
+			module = nil
+			for _, dep := range info.Deps {
+				if dep.Path == modulePath {
+					module = dep
+					break
+				}
+			}
+		}
+		if module == nil {
+			return
+		}
+		if module.Replace != nil {
+			module = module.Replace
+		}
+		if module.Version != "" && module.Version != "(devel)" {
+			moduleVersionStr = module.Version
+		}
+	})
+
+	return moduleVersionStr
+}
+
+// userAgent returns the user agent to send with requests.
+// The module's own user agent is always included, following any supplied by the user.
+func userAgent(user string) string {
+	agent := fmt.Sprintf("go-eth2-client/%s", moduleVersion())
+	if user == "" {
+		return agent
+	}
+
+	return fmt.Sprintf("%s %s", user, agent)
+}
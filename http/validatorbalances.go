@@ -22,6 +22,7 @@ import (
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type validatorBalancesJSON struct {
@@ -33,6 +34,9 @@ type validatorBalancesJSON struct {
 // validatorIndices is a list of validator indices to restrict the returned values.  If no validators are supplied no filter
 // will be applied.
 func (s *Service) ValidatorBalances(ctx context.Context, stateID string, validatorIndices []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]phase0.Gwei, error) {
+	ctx, span := s.startSpan(ctx, "ValidatorBalances", attribute.String("state_id", stateID))
+	defer span.End()
+
 	if stateID == "" {
 		return nil, errors.New("no state ID specified")
 	}
@@ -54,9 +58,6 @@ func (s *Service) ValidatorBalances(ctx context.Context, stateID string, validat
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request validator balances")
 	}
-	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain validator balances")
-	}
 
 	var validatorBalancesJSON validatorBalancesJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&validatorBalancesJSON); err != nil {
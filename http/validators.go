@@ -14,19 +14,29 @@
 package http
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"strings"
 
-	api "github.com/attestantio/go-eth2-client/api/v1"
-	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type validatorsJSON struct {
-	Data []*api.Validator `json:"data"`
+	Data []*apiv1.Validator `json:"data"`
+}
+
+// validatorsRequestJSON is the body of a request to the POST validators endpoint.
+type validatorsRequestJSON struct {
+	IDs      []string `json:"ids,omitempty"`
+	Statuses []string `json:"statuses,omitempty"`
 }
 
 // indexChunkSizes defines the per-beacon-node size of an index chunk.
@@ -72,43 +82,120 @@ func (s *Service) indexChunkSize(ctx context.Context) int {
 // stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
 // validatorIndices is a list of validators to restrict the returned values.  If no validators are supplied no filter will be applied.
 // validatorStates is a list of validator states to restrict the returned values.  If no states are supplied no filter will be applied.
-func (s *Service) Validators(ctx context.Context, stateID string, validatorIndices []phase0.ValidatorIndex, validatorStates []v1.ValidatorState) (map[phase0.ValidatorIndex]*api.Validator, error) {
+// Both filters are applied by the beacon node.
+func (s *Service) Validators(ctx context.Context, stateID string, validatorIndices []phase0.ValidatorIndex, validatorStates []apiv1.ValidatorState) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	ctx, span := s.startSpan(ctx, "Validators", attribute.String("state_id", stateID))
+	defer span.End()
+
 	if stateID == "" {
 		return nil, errors.New("no state ID specified")
 	}
 
-	if len(validatorIndices) > s.indexChunkSize(ctx) {
-		return s.chunkedValidators(ctx, stateID, validatorIndices, validatorStates)
+	ids := make([]string, len(validatorIndices))
+	for i := range validatorIndices {
+		ids[i] = fmt.Sprintf("%d", validatorIndices[i])
 	}
 
-	url := fmt.Sprintf("/eth/v1/beacon/states/%s/validators", stateID)
-	if len(validatorIndices) != 0 {
-		ids := make([]string, len(validatorIndices))
-		for i := range validatorIndices {
-			ids[i] = fmt.Sprintf("%d", validatorIndices[i])
+	return s.validators(ctx, stateID, ids, validatorStates, s.indexChunkSize)
+}
+
+// validators obtains the validators matching the given IDs and states.
+// If the validators POST feature is enabled all IDs are sent in a single POST request, falling back to
+// chunked GET requests if the node does not support the POST endpoint.
+func (s *Service) validators(ctx context.Context,
+	stateID string,
+	ids []string,
+	validatorStates []apiv1.ValidatorState,
+	chunkSize func(context.Context) int,
+) (
+	map[phase0.ValidatorIndex]*apiv1.Validator,
+	error,
+) {
+	statuses := make([]string, len(validatorStates))
+	for i := range validatorStates {
+		statuses[i] = validatorStates[i].String()
+	}
+
+	if s.FeatureEnabled(api.FeatureValidatorsPOST) {
+		res, err := s.validatorsPOST(ctx, stateID, ids, statuses)
+		if err == nil {
+			return res, nil
+		}
+		var apiErr api.Error
+		if !errors.As(err, &apiErr) || (apiErr.StatusCode != http.StatusMethodNotAllowed && apiErr.StatusCode != http.StatusNotFound) {
+			return nil, err
 		}
-		url = fmt.Sprintf("%s?id=%s", url, strings.Join(ids, ","))
+		s.log.Debug().Err(err).Msg("POST validators endpoint not supported; falling back to GET")
+	}
+
+	if len(ids) == 0 {
+		return s.validatorsGET(ctx, stateID, ids, statuses)
 	}
-	if len(validatorStates) != 0 {
-		states := make([]string, len(validatorStates))
-		for i := range validatorStates {
-			states[i] = fmt.Sprintf("%s", validatorStates[i])
+
+	res := make(map[phase0.ValidatorIndex]*apiv1.Validator)
+	idChunkSize := chunkSize(ctx)
+	for i := 0; i < len(ids); i += idChunkSize {
+		chunkEnd := i + idChunkSize
+		if len(ids) < chunkEnd {
+			chunkEnd = len(ids)
+		}
+		chunkRes, err := s.validatorsGET(ctx, stateID, ids[i:chunkEnd], statuses)
+		if err != nil {
+			if len(ids) > idChunkSize {
+				return nil, errors.Wrap(err, "failed to obtain chunk")
+			}
+			return nil, err
 		}
-		if len(validatorIndices) != 0 {
-			url = fmt.Sprintf("%s&status=%s", url, strings.Join(states, ","))
-		} else {
-			url = fmt.Sprintf("%s?status=%s", url, strings.Join(states, ","))
+		for k, v := range chunkRes {
+			res[k] = v
 		}
 	}
 
+	return res, nil
+}
+
+// validatorsGET obtains the validators matching the given IDs and statuses with a single GET request.
+func (s *Service) validatorsGET(ctx context.Context, stateID string, ids []string, statuses []string) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	query := make([]string, 0, 2)
+	if len(ids) != 0 {
+		query = append(query, fmt.Sprintf("id=%s", strings.Join(ids, ",")))
+	}
+	if len(statuses) != 0 {
+		query = append(query, fmt.Sprintf("status=%s", strings.Join(statuses, ",")))
+	}
+	url := fmt.Sprintf("/eth/v1/beacon/states/%s/validators", stateID)
+	if len(query) != 0 {
+		url = fmt.Sprintf("%s?%s", url, strings.Join(query, "&"))
+	}
+
 	respBodyReader, err := s.get(ctx, url)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request validators")
 	}
-	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain validators")
+
+	return parseValidators(respBodyReader)
+}
+
+// validatorsPOST obtains the validators matching the given IDs and statuses with a single POST request.
+func (s *Service) validatorsPOST(ctx context.Context, stateID string, ids []string, statuses []string) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	reqBody, err := json.Marshal(&validatorsRequestJSON{
+		IDs:      ids,
+		Statuses: statuses,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal request")
 	}
 
+	respBodyReader, err := s.post(ctx, fmt.Sprintf("/eth/v1/beacon/states/%s/validators", stateID), bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request validators")
+	}
+
+	return parseValidators(respBodyReader)
+}
+
+// parseValidators parses a validators response.
+func parseValidators(respBodyReader io.Reader) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
 	var validatorsJSON validatorsJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&validatorsJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to parse validators")
@@ -117,31 +204,9 @@ func (s *Service) Validators(ctx context.Context, stateID string, validatorIndic
 		return nil, errors.New("no validators returned")
 	}
 
-	res := make(map[phase0.ValidatorIndex]*api.Validator)
+	res := make(map[phase0.ValidatorIndex]*apiv1.Validator)
 	for _, validator := range validatorsJSON.Data {
 		res[validator.Index] = validator
 	}
 	return res, nil
 }
-
-// chunkedValidators obtains the validators a chunk at a time.
-func (s *Service) chunkedValidators(ctx context.Context, stateID string, validatorIndices []phase0.ValidatorIndex, validatorStates []v1.ValidatorState) (map[phase0.ValidatorIndex]*api.Validator, error) {
-	res := make(map[phase0.ValidatorIndex]*api.Validator)
-	indexChunkSize := s.indexChunkSize(ctx)
-	for i := 0; i < len(validatorIndices); i += indexChunkSize {
-		chunkStart := i
-		chunkEnd := i + indexChunkSize
-		if len(validatorIndices) < chunkEnd {
-			chunkEnd = len(validatorIndices)
-		}
-		chunk := validatorIndices[chunkStart:chunkEnd]
-		chunkRes, err := s.Validators(ctx, stateID, chunk, validatorStates)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to obtain chunk")
-		}
-		for k, v := range chunkRes {
-			res[k] = v
-		}
-	}
-	return res, nil
-}
@@ -0,0 +1,139 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// testValidatorsHandler serves the validators endpoint, recording the requests it receives.
+// If postSupported is false POST requests are rejected with a 405.
+type testValidatorsHandler struct {
+	postSupported bool
+	gets          []string
+	posts         []*validatorsRequestJSON
+}
+
+func (h *testValidatorsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var ids []string
+	switch r.Method {
+	case http.MethodGet:
+		h.gets = append(h.gets, r.URL.RawQuery)
+		if id := r.URL.Query().Get("id"); id != "" {
+			ids = strings.Split(id, ",")
+		}
+	case http.MethodPost:
+		if !h.postSupported {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		var req validatorsRequestJSON
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		h.posts = append(h.posts, &req)
+		ids = req.IDs
+	}
+
+	validators := make([]string, 0, len(ids))
+	for i := range ids {
+		// Numeric IDs are indices; public keys are given an index by position.
+		index, err := strconv.ParseUint(ids[i], 10, 64)
+		if err != nil {
+			index = uint64(i)
+		}
+		validators = append(validators, fmt.Sprintf(`{"index":"%d","balance":"32000000000","status":"active_ongoing","validator":{"pubkey":"0x%096x","withdrawal_credentials":"0x%064x","effective_balance":"32000000000","slashed":false,"activation_eligibility_epoch":"0","activation_epoch":"0","exit_epoch":"18446744073709551615","withdrawable_epoch":"18446744073709551615"}}`, index, index, 0))
+	}
+	_, _ = fmt.Fprintf(w, `{"data":[%s]}`, strings.Join(validators, ","))
+}
+
+func TestValidatorsFilters(t *testing.T) {
+	ctx := context.Background()
+
+	handler := &testValidatorsHandler{}
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+	s := testService(t, srv)
+	s.userIndexChunkSize = 2
+	s.userPubKeyChunkSize = 2
+
+	_, err := s.Validators(ctx, "head", nil, nil)
+	require.NoError(t, err)
+	_, err = s.Validators(ctx, "head", nil, []apiv1.ValidatorState{apiv1.ValidatorStateActiveOngoing, apiv1.ValidatorStatePendingQueued})
+	require.NoError(t, err)
+	res, err := s.Validators(ctx, "head", []phase0.ValidatorIndex{1, 2, 3}, []apiv1.ValidatorState{apiv1.ValidatorStateActiveOngoing})
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	_, err = s.ValidatorsByPubKey(ctx, "head", []phase0.BLSPubKey{{0x01}}, []apiv1.ValidatorState{apiv1.ValidatorStateExitedSlashed})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"",
+		"status=active_ongoing,pending_queued",
+		"id=1,2&status=active_ongoing",
+		"id=3&status=active_ongoing",
+		fmt.Sprintf("id=%#x&status=exited_slashed", phase0.BLSPubKey{0x01}),
+	}, handler.gets)
+}
+
+func TestValidatorsPOST(t *testing.T) {
+	ctx := context.Background()
+
+	handler := &testValidatorsHandler{postSupported: true}
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+	s := testService(t, srv)
+	s.userIndexChunkSize = 2
+	s.features = map[api.Feature]struct{}{api.FeatureValidatorsPOST: {}}
+
+	res, err := s.Validators(ctx, "head", []phase0.ValidatorIndex{1, 2, 3}, []apiv1.ValidatorState{apiv1.ValidatorStateActiveOngoing})
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	require.Empty(t, handler.gets)
+	require.Equal(t, []*validatorsRequestJSON{
+		{
+			IDs:      []string{"1", "2", "3"},
+			Statuses: []string{"active_ongoing"},
+		},
+	}, handler.posts)
+}
+
+func TestValidatorsPOSTFallback(t *testing.T) {
+	ctx := context.Background()
+
+	handler := &testValidatorsHandler{}
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+	s := testService(t, srv)
+	s.userIndexChunkSize = 2
+	s.features = map[api.Feature]struct{}{api.FeatureValidatorsPOST: {}}
+
+	res, err := s.Validators(ctx, "head", []phase0.ValidatorIndex{1, 2, 3}, nil)
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	require.Equal(t, []string{"id=1,2", "id=3"}, handler.gets)
+}
@@ -31,9 +31,6 @@ func (s *Service) VoluntaryExitPool(ctx context.Context) ([]*phase0.SignedVolunt
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request voluntary exit pool")
 	}
-	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain voluntary exit pool")
-	}
 
 	var voluntaryExitPoolJSON voluntaryExitPoolJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&voluntaryExitPoolJSON); err != nil {
@@ -0,0 +1,456 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+	"golang.org/x/net/websocket"
+)
+
+// WebSocketTransport is a transport that multiplexes requests and event streams over a single
+// websocket connection to a JSON-RPC 2.0 proxy in front of the beacon node.
+//
+// Requests are sent as calls to the "beacon_request" method, with the HTTP method, path, headers
+// and body of the request as parameters; the result contains the status, headers and body of the
+// beacon node's response.  Bodies are base64-encoded.  Event streams are opened with calls to the
+// "beacon_subscribe" method, which returns a subscription ID, and events are delivered as
+// "beacon_subscription" notifications until the stream is closed with "beacon_unsubscribe".
+type WebSocketTransport struct {
+	conn    *websocket.Conn
+	writeMu sync.Mutex
+	log     atomic.Pointer[zerolog.Logger]
+
+	mu            sync.Mutex
+	nextID        uint64
+	pending       map[uint64]*wsCall
+	subscriptions map[string]*wsSubscription
+	closed        chan struct{}
+	closeErr      error
+}
+
+// wsCall is a call awaiting its response.
+type wsCall struct {
+	done     chan *wsMessage
+	onResult func(result json.RawMessage)
+}
+
+// wsSubscription is an active event subscription.
+// Events are queued by the read loop and passed to the handler by a separate goroutine, so that
+// a slow handler, or one that makes requests over the same connection, does not block the read loop.
+type wsSubscription struct {
+	handler  EventFunc
+	mu       sync.Mutex
+	queue    []*wsEvent
+	notify   chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// wsEvent is an event awaiting its handler.
+type wsEvent struct {
+	topic string
+	data  []byte
+}
+
+func newWSSubscription(handler EventFunc) *wsSubscription {
+	subscription := &wsSubscription{
+		handler: handler,
+		notify:  make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	go subscription.run()
+
+	return subscription
+}
+
+// enqueue queues an event for the handler.
+func (s *wsSubscription) enqueue(topic string, data []byte) {
+	s.mu.Lock()
+	s.queue = append(s.queue, &wsEvent{topic: topic, data: data})
+	s.mu.Unlock()
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// run passes queued events to the handler, in order, until the subscription is stopped.
+func (s *wsSubscription) run() {
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-s.notify:
+			s.mu.Lock()
+			events := s.queue
+			s.queue = nil
+			s.mu.Unlock()
+			for _, event := range events {
+				select {
+				case <-s.done:
+					return
+				default:
+				}
+				s.handler(event.topic, event.data)
+			}
+		}
+	}
+}
+
+// stop stops passing events to the handler.
+func (s *wsSubscription) stop() {
+	s.stopOnce.Do(func() {
+		close(s.done)
+	})
+}
+
+// wsMessage is a JSON-RPC 2.0 message.
+type wsMessage struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      *uint64         `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *wsError        `json:"error,omitempty"`
+}
+
+// wsError is a JSON-RPC 2.0 error.
+type wsError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// wsRequest is the parameter for a beacon_request call.
+type wsRequest struct {
+	Method  string            `json:"method"`
+	Path    string            `json:"path"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    []byte            `json:"body,omitempty"`
+}
+
+// wsResponse is the result of a beacon_request call.
+type wsResponse struct {
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    []byte            `json:"body,omitempty"`
+}
+
+// wsSubscribeRequest is the parameter for a beacon_subscribe call.
+type wsSubscribeRequest struct {
+	Topics []string `json:"topics"`
+}
+
+// wsNotification is the parameter for a beacon_subscription notification.
+type wsNotification struct {
+	Subscription string `json:"subscription"`
+	Result       struct {
+		Event string          `json:"event"`
+		Data  json.RawMessage `json:"data"`
+	} `json:"result"`
+}
+
+// NewWebSocketTransport creates a transport connected to the websocket proxy at the given address.
+// The connection is closed when the context is done.
+func NewWebSocketTransport(ctx context.Context, address string, timeout time.Duration) (*WebSocketTransport, error) {
+	if address == "" {
+		return nil, errors.New("no address specified")
+	}
+	if !strings.HasPrefix(address, "ws") {
+		address = fmt.Sprintf("ws://%s", address)
+	}
+
+	config, err := websocket.NewConfig(address, "http://localhost/")
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid websocket address")
+	}
+	config.Dialer = &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second,
+	}
+	conn, err := websocket.DialConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to websocket")
+	}
+
+	t := &WebSocketTransport{
+		conn:          conn,
+		pending:       make(map[uint64]*wsCall),
+		subscriptions: make(map[string]*wsSubscription),
+		closed:        make(chan struct{}),
+	}
+	log := zerologger.With().Str("service", "client").Str("impl", "websocket").Logger()
+	t.log.Store(&log)
+	go t.readLoop()
+	go func() {
+		select {
+		case <-ctx.Done():
+			t.Close()
+		case <-t.closed:
+		}
+	}()
+
+	return t, nil
+}
+
+// setLogger sets the logger used by the transport.  It is called by the service when the
+// transport is supplied, so that the transport logs with the service's logger.
+func (t *WebSocketTransport) setLogger(log zerolog.Logger) {
+	log = log.With().Str("transport", "websocket").Logger()
+	t.log.Store(&log)
+}
+
+// Close closes the websocket connection, failing any outstanding requests and subscriptions.
+func (t *WebSocketTransport) Close() error {
+	return t.conn.Close()
+}
+
+// Do sends a request to the beacon node and returns its response.
+func (t *WebSocketTransport) Do(req *http.Request) (*http.Response, error) {
+	params := &wsRequest{
+		Method:  req.Method,
+		Path:    req.URL.RequestURI(),
+		Headers: make(map[string]string, len(req.Header)),
+	}
+	for k := range req.Header {
+		params.Headers[k] = req.Header.Get(k)
+	}
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read request body")
+		}
+		params.Body = body
+	}
+
+	result, err := t.call(req.Context(), "beacon_request", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp wsResponse
+	if err := json.Unmarshal(result, &resp); err != nil {
+		return nil, errors.Wrap(err, "invalid beacon_request result")
+	}
+	header := make(http.Header, len(resp.Headers))
+	for k, v := range resp.Headers {
+		header.Set(k, v)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", resp.Status, http.StatusText(resp.Status)),
+		StatusCode:    resp.Status,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(resp.Body)),
+		ContentLength: int64(len(resp.Body)),
+		Request:       req,
+	}, nil
+}
+
+// Subscribe streams events from the event stream at the given URL to the handler.
+// Headers are not used, as the subscription shares the existing websocket connection.
+func (t *WebSocketTransport) Subscribe(ctx context.Context, url *url.URL, _ map[string]string, handler EventFunc) error {
+	params := &wsSubscribeRequest{
+		Topics: url.Query()["topics"],
+	}
+
+	// The subscription is registered by the read loop as soon as the result arrives, so that
+	// no notifications are lost between the result and the registration.
+	var subscriptionID string
+	result, err := t.call(ctx, "beacon_subscribe", params, func(result json.RawMessage) {
+		if err := json.Unmarshal(result, &subscriptionID); err == nil {
+			t.subscriptions[subscriptionID] = newWSSubscription(handler)
+		}
+	})
+	if err != nil {
+		// The context can be cancelled after the result was received and the subscription
+		// registered, but before the call returned; if so the subscription must not be left behind.
+		t.mu.Lock()
+		registeredID := subscriptionID
+		t.mu.Unlock()
+		if registeredID != "" {
+			t.unsubscribe(registeredID)
+		}
+
+		return err
+	}
+	if err := json.Unmarshal(result, &subscriptionID); err != nil {
+		return errors.Wrap(err, "invalid beacon_subscribe result")
+	}
+
+	select {
+	case <-ctx.Done():
+		t.unsubscribe(subscriptionID)
+
+		return nil
+	case <-t.closed:
+		return t.closeErr
+	}
+}
+
+// unsubscribe removes a subscription and stops its handler, and tells the node to stop sending
+// its notifications.
+func (t *WebSocketTransport) unsubscribe(subscriptionID string) {
+	t.mu.Lock()
+	if subscription, exists := t.subscriptions[subscriptionID]; exists {
+		subscription.stop()
+		delete(t.subscriptions, subscriptionID)
+	}
+	t.mu.Unlock()
+
+	// Best effort; the connection may already be closed.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, _ = t.call(ctx, "beacon_unsubscribe", []string{subscriptionID}, nil)
+}
+
+// call makes a JSON-RPC call and waits for its result.  If supplied, onResult is called by the
+// read loop with the transport's lock held when a successful result is received.
+func (t *WebSocketTransport) call(ctx context.Context,
+	method string,
+	params interface{},
+	onResult func(result json.RawMessage),
+) (
+	json.RawMessage,
+	error,
+) {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal parameters")
+	}
+
+	t.mu.Lock()
+	select {
+	case <-t.closed:
+		t.mu.Unlock()
+		return nil, errors.Wrap(t.closeErr, "websocket closed")
+	default:
+	}
+	t.nextID++
+	id := t.nextID
+	call := &wsCall{
+		done:     make(chan *wsMessage, 1),
+		onResult: onResult,
+	}
+	t.pending[id] = call
+	t.mu.Unlock()
+
+	t.writeMu.Lock()
+	err = websocket.JSON.Send(t.conn, &wsMessage{
+		JSONRPC: "2.0",
+		ID:      &id,
+		Method:  method,
+		Params:  data,
+	})
+	t.writeMu.Unlock()
+	if err != nil {
+		t.removePending(id)
+		return nil, errors.Wrap(err, "failed to send request")
+	}
+
+	select {
+	case msg := <-call.done:
+		if msg == nil {
+			return nil, errors.Wrap(t.closeErr, "websocket closed")
+		}
+		if msg.Error != nil {
+			return nil, fmt.Errorf("%s failed with code %d: %s", method, msg.Error.Code, msg.Error.Message)
+		}
+
+		return msg.Result, nil
+	case <-ctx.Done():
+		t.removePending(id)
+		return nil, ctx.Err()
+	}
+}
+
+func (t *WebSocketTransport) removePending(id uint64) {
+	t.mu.Lock()
+	delete(t.pending, id)
+	t.mu.Unlock()
+}
+
+// readLoop reads messages from the connection and dispatches them until the connection closes.
+func (t *WebSocketTransport) readLoop() {
+	for {
+		var msg wsMessage
+		if err := websocket.JSON.Receive(t.conn, &msg); err != nil {
+			t.shutdown(err)
+			return
+		}
+
+		switch {
+		case msg.ID != nil:
+			t.mu.Lock()
+			call, exists := t.pending[*msg.ID]
+			delete(t.pending, *msg.ID)
+			if exists && call.onResult != nil && msg.Error == nil {
+				call.onResult(msg.Result)
+			}
+			t.mu.Unlock()
+			if !exists {
+				t.log.Load().Trace().Uint64("id", *msg.ID).Msg("Received response for unknown request; ignoring")
+				continue
+			}
+			call.done <- &msg
+		case msg.Method == "beacon_subscription":
+			var notification wsNotification
+			if err := json.Unmarshal(msg.Params, &notification); err != nil {
+				t.log.Load().Debug().Err(err).Msg("Failed to parse subscription notification")
+				continue
+			}
+			t.mu.Lock()
+			subscription, exists := t.subscriptions[notification.Subscription]
+			t.mu.Unlock()
+			if !exists {
+				continue
+			}
+			subscription.enqueue(notification.Result.Event, notification.Result.Data)
+		default:
+			t.log.Load().Trace().Str("method", msg.Method).Msg("Received unexpected message; ignoring")
+		}
+	}
+}
+
+// shutdown fails all outstanding calls and subscriptions.
+func (t *WebSocketTransport) shutdown(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.closeErr = err
+	close(t.closed)
+	for id, call := range t.pending {
+		call.done <- nil
+		delete(t.pending, id)
+	}
+	for _, subscription := range t.subscriptions {
+		subscription.stop()
+	}
+	t.subscriptions = make(map[string]*wsSubscription)
+}
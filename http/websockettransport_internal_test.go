@@ -0,0 +1,226 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+)
+
+// websocketProxy is a minimal JSON-RPC proxy for testing the websocket transport.
+func websocketProxy(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(websocket.Handler(func(conn *websocket.Conn) {
+		for {
+			var msg wsMessage
+			if err := websocket.JSON.Receive(conn, &msg); err != nil {
+				return
+			}
+
+			reply := &wsMessage{
+				JSONRPC: "2.0",
+				ID:      msg.ID,
+			}
+			switch msg.Method {
+			case "beacon_request":
+				var req wsRequest
+				require.NoError(t, json.Unmarshal(msg.Params, &req))
+				var resp *wsResponse
+				switch req.Path {
+				case "/eth/v1/node/version":
+					resp = &wsResponse{
+						Status:  200,
+						Headers: map[string]string{"Content-Type": "application/json"},
+						Body:    []byte(`{"data":{"version":"test/v1.0.0"}}`),
+					}
+				default:
+					resp = &wsResponse{Status: 404}
+				}
+				reply.Result, _ = json.Marshal(resp)
+				require.NoError(t, websocket.JSON.Send(conn, reply))
+			case "beacon_subscribe":
+				reply.Result = json.RawMessage(`"sub1"`)
+				require.NoError(t, websocket.JSON.Send(conn, reply))
+				require.NoError(t, websocket.JSON.Send(conn, &wsMessage{
+					JSONRPC: "2.0",
+					Method:  "beacon_subscription",
+					Params:  json.RawMessage(`{"subscription":"sub1","result":{"event":"block","data":{"slot":"1","block":"0x0101010101010101010101010101010101010101010101010101010101010101","execution_optimistic":false}}}`),
+				}))
+			case "beacon_unsubscribe":
+				reply.Result = json.RawMessage(`true`)
+				require.NoError(t, websocket.JSON.Send(conn, reply))
+			default:
+				reply.Error = &wsError{Code: -32601, Message: "method not found"}
+				require.NoError(t, websocket.JSON.Send(conn, reply))
+			}
+		}
+	}))
+}
+
+func TestWebSocketTransport(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := websocketProxy(t)
+	defer srv.Close()
+
+	transport, err := NewWebSocketTransport(ctx, strings.Replace(srv.URL, "http://", "ws://", 1), time.Second)
+	require.NoError(t, err)
+	defer transport.Close()
+
+	s := testService(t, srv)
+	s.transport = transport
+
+	version, err := s.NodeVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "test/v1.0.0", version)
+
+	events := make(chan *api.Event, 1)
+	require.NoError(t, s.Events(ctx, []string{"block"}, func(event *api.Event) {
+		events <- event
+	}))
+	select {
+	case event := <-events:
+		require.Equal(t, "block", event.Topic)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "no event received")
+	}
+}
+
+func TestWebSocketTransportHandlerRequest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := websocketProxy(t)
+	defer srv.Close()
+
+	transport, err := NewWebSocketTransport(ctx, strings.Replace(srv.URL, "http://", "ws://", 1), time.Second)
+	require.NoError(t, err)
+	defer transport.Close()
+
+	s := testService(t, srv)
+	s.transport = transport
+
+	// Handlers run off the read loop, so can make requests over the same connection.
+	versions := make(chan string, 1)
+	require.NoError(t, s.Events(ctx, []string{"block"}, func(_ *api.Event) {
+		version, err := s.NodeVersion(ctx)
+		require.NoError(t, err)
+		versions <- version
+	}))
+	select {
+	case version := <-versions:
+		require.Equal(t, "test/v1.0.0", version)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "handler request did not complete")
+	}
+}
+
+func TestWebSocketTransportClosed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	srv := websocketProxy(t)
+	defer srv.Close()
+
+	transport, err := NewWebSocketTransport(ctx, strings.Replace(srv.URL, "http://", "ws://", 1), time.Second)
+	require.NoError(t, err)
+
+	cancel()
+	<-transport.closed
+
+	_, err = transport.call(context.Background(), "beacon_request", &wsRequest{}, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "websocket closed")
+}
+
+func TestWebSocketTransportSubscribeCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subscribeReceived := make(chan struct{})
+	sendResult := make(chan struct{})
+	unsubscribed := make(chan string, 1)
+	srv := httptest.NewServer(websocket.Handler(func(conn *websocket.Conn) {
+		for {
+			var msg wsMessage
+			if err := websocket.JSON.Receive(conn, &msg); err != nil {
+				return
+			}
+			reply := &wsMessage{
+				JSONRPC: "2.0",
+				ID:      msg.ID,
+			}
+			switch msg.Method {
+			case "beacon_subscribe":
+				close(subscribeReceived)
+				<-sendResult
+				reply.Result = json.RawMessage(`"sub1"`)
+			case "beacon_unsubscribe":
+				var ids []string
+				require.NoError(t, json.Unmarshal(msg.Params, &ids))
+				unsubscribed <- ids[0]
+				reply.Result = json.RawMessage(`true`)
+			}
+			if err := websocket.JSON.Send(conn, reply); err != nil {
+				return
+			}
+		}
+	}))
+	defer srv.Close()
+
+	transport, err := NewWebSocketTransport(ctx, strings.Replace(srv.URL, "http://", "ws://", 1), time.Second)
+	require.NoError(t, err)
+	defer transport.Close()
+
+	subscribeURL, err := url.Parse(srv.URL + "/eth/v1/events?topics=head")
+	require.NoError(t, err)
+	subscribeCtx, subscribeCancel := context.WithCancel(ctx)
+	subscribeErr := make(chan error, 1)
+	go func() {
+		subscribeErr <- transport.Subscribe(subscribeCtx, subscribeURL, nil, func(_ string, _ []byte) {})
+	}()
+
+	// Hold the transport's lock while the result arrives, so that the read loop waits to
+	// register the subscription, then cancel the context so that the call gives up while
+	// the read loop is waiting.  The read loop obtains the lock first, so the subscription
+	// is registered before the call returns its error.
+	<-subscribeReceived
+	transport.mu.Lock()
+	close(sendResult)
+	time.Sleep(100 * time.Millisecond)
+	subscribeCancel()
+	time.Sleep(100 * time.Millisecond)
+	transport.mu.Unlock()
+
+	require.ErrorIs(t, <-subscribeErr, context.Canceled)
+	transport.mu.Lock()
+	require.Empty(t, transport.subscriptions)
+	transport.mu.Unlock()
+	select {
+	case id := <-unsubscribed:
+		require.Equal(t, "sub1", id)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "subscription not unsubscribed")
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command genfullservice generates the FullService interface, which embeds all of the
+// provider interfaces in the client package.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+)
+
+func main() {
+	source := flag.String("source", "service.go", "file containing the provider interfaces")
+	output := flag.String("output", "fullservice.go", "file to which to write the FullService interface")
+	flag.Parse()
+
+	if err := generate(*source, *output); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to generate: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// generate writes the FullService interface.
+func generate(source string, output string) error {
+	ifaces, err := parse(source)
+	if err != nil {
+		return err
+	}
+
+	buf := new(bytes.Buffer)
+	buf.WriteString("// Code generated by genfullservice. DO NOT EDIT.\n\n")
+	buf.WriteString("package client\n\n")
+	buf.WriteString("// FullService is the interface for a client that provides all of the provider interfaces.\n")
+	buf.WriteString("// Most clients provide only a subset of the interfaces, so this is generally used to\n")
+	buf.WriteString("// check the completeness of a client implementation rather than as a type assertion;\n")
+	buf.WriteString("// use As or Supports to check for individual interfaces.\n")
+	buf.WriteString("type FullService interface {\n")
+	buf.WriteString("\tService\n")
+	for _, iface := range ifaces {
+		fmt.Fprintf(buf, "\t%s\n", iface)
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	//nolint:gosec
+	return os.WriteFile(output, src, 0o644)
+}
+
+// parse obtains the names of the provider interfaces, in the order in which they are defined.
+func parse(source string) ([]string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, source, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	ifaces := make([]string, 0)
+	for _, decl := range file.Decls {
+		genDecl, isGenDecl := decl.(*ast.GenDecl)
+		if !isGenDecl || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			if _, isIface := typeSpec.Type.(*ast.InterfaceType); !isIface {
+				continue
+			}
+			if typeSpec.Name.Name == "Service" || !typeSpec.Name.IsExported() {
+				continue
+			}
+			ifaces = append(ifaces, typeSpec.Name.Name)
+		}
+	}
+
+	return ifaces, nil
+}
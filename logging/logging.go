@@ -0,0 +1,141 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package logging provides a minimal logging interface that can be supplied to the services
+// in place of their default zerolog configuration, allowing consumers to route the library's
+// logs to the logging package of their choice.
+package logging
+
+import (
+	"encoding/json"
+
+	"github.com/rs/zerolog"
+)
+
+// Level is the level of a log entry.
+type Level int
+
+const (
+	// LevelTrace is for detailed tracing of requests and responses.
+	LevelTrace Level = iota
+	// LevelDebug is for debugging information.
+	LevelDebug
+	// LevelInfo is for general information.
+	LevelInfo
+	// LevelWarn is for issues that do not stop the service from operating.
+	LevelWarn
+	// LevelError is for errors.
+	LevelError
+)
+
+var levelStrings = [...]string{
+	"trace",
+	"debug",
+	"info",
+	"warn",
+	"error",
+}
+
+func (l Level) String() string {
+	if int(l) < 0 || int(l) >= len(levelStrings) {
+		return "unknown"
+	}
+
+	return levelStrings[l]
+}
+
+// Logger is the interface for a logger to which the services send their logs.
+type Logger interface {
+	// Enabled returns true if entries at the given level are logged.
+	Enabled(level Level) bool
+	// Log logs an entry with the given level, message and structured fields.
+	Log(level Level, msg string, fields map[string]any)
+}
+
+// Zerolog returns a zerolog logger that sends its entries to the supplied logger.
+// The level of the returned logger is the lowest level enabled by the supplied logger,
+// so entries that would be discarded are not generated.
+func Zerolog(logger Logger) zerolog.Logger {
+	res := zerolog.New(&levelWriter{logger: logger})
+	for _, level := range []Level{LevelTrace, LevelDebug, LevelInfo, LevelWarn, LevelError} {
+		if logger.Enabled(level) {
+			return res.Level(zerologLevel(level))
+		}
+	}
+
+	return res.Level(zerolog.Disabled)
+}
+
+// levelWriter is a zerolog level writer that decodes entries and sends them to a logger.
+type levelWriter struct {
+	logger Logger
+}
+
+// Write writes an entry without a level, which is treated as informational.
+func (w *levelWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+// WriteLevel writes an entry at the given level.
+func (w *levelWriter) WriteLevel(zlevel zerolog.Level, p []byte) (int, error) {
+	level := fromZerologLevel(zlevel)
+	if !w.logger.Enabled(level) {
+		return len(p), nil
+	}
+
+	fields := make(map[string]any)
+	if err := json.Unmarshal(p, &fields); err != nil {
+		// Not a structured entry; pass it on as-is.
+		w.logger.Log(level, string(p), nil)
+
+		return len(p), nil
+	}
+	msg, _ := fields[zerolog.MessageFieldName].(string)
+	delete(fields, zerolog.MessageFieldName)
+	delete(fields, zerolog.LevelFieldName)
+	w.logger.Log(level, msg, fields)
+
+	return len(p), nil
+}
+
+// zerologLevel returns the zerolog level for a level.
+func zerologLevel(level Level) zerolog.Level {
+	switch level {
+	case LevelTrace:
+		return zerolog.TraceLevel
+	case LevelDebug:
+		return zerolog.DebugLevel
+	case LevelInfo:
+		return zerolog.InfoLevel
+	case LevelWarn:
+		return zerolog.WarnLevel
+	default:
+		return zerolog.ErrorLevel
+	}
+}
+
+// fromZerologLevel returns the level for a zerolog level.
+func fromZerologLevel(level zerolog.Level) Level {
+	switch level {
+	case zerolog.TraceLevel:
+		return LevelTrace
+	case zerolog.DebugLevel:
+		return LevelDebug
+	case zerolog.WarnLevel:
+		return LevelWarn
+	case zerolog.ErrorLevel, zerolog.FatalLevel, zerolog.PanicLevel:
+		return LevelError
+	default:
+		return LevelInfo
+	}
+}
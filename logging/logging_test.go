@@ -0,0 +1,75 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/logging"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type entry struct {
+	level  logging.Level
+	msg    string
+	fields map[string]any
+}
+
+// recordingLogger records entries at or above a minimum level.
+type recordingLogger struct {
+	minLevel logging.Level
+	entries  []entry
+}
+
+func (l *recordingLogger) Enabled(level logging.Level) bool {
+	return level >= l.minLevel
+}
+
+func (l *recordingLogger) Log(level logging.Level, msg string, fields map[string]any) {
+	l.entries = append(l.entries, entry{level: level, msg: msg, fields: fields})
+}
+
+func TestZerolog(t *testing.T) {
+	recorder := &recordingLogger{minLevel: logging.LevelDebug}
+	log := logging.Zerolog(recorder).With().Str("service", "test").Logger()
+	require.Equal(t, zerolog.DebugLevel, log.GetLevel())
+
+	log.Trace().Msg("Not logged")
+	log.Debug().Int("count", 2).Msg("Debug entry")
+	log.Warn().Err(errors.New("bad")).Msg("Warn entry")
+	log.Error().Msg("Error entry")
+
+	require.Equal(t, []entry{
+		{level: logging.LevelDebug, msg: "Debug entry", fields: map[string]any{"service": "test", "count": float64(2)}},
+		{level: logging.LevelWarn, msg: "Warn entry", fields: map[string]any{"service": "test", "error": "bad"}},
+		{level: logging.LevelError, msg: "Error entry", fields: map[string]any{"service": "test"}},
+	}, recorder.entries)
+}
+
+func TestZerologDisabled(t *testing.T) {
+	recorder := &recordingLogger{minLevel: logging.LevelError + 1}
+	log := logging.Zerolog(recorder)
+	require.Equal(t, zerolog.Disabled, log.GetLevel())
+
+	log.Error().Msg("Not logged")
+	require.Empty(t, recorder.entries)
+}
+
+func TestLevelString(t *testing.T) {
+	require.Equal(t, "trace", logging.LevelTrace.String())
+	require.Equal(t, "error", logging.LevelError.String())
+	require.Equal(t, "unknown", logging.Level(99).String())
+}
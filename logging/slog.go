@@ -0,0 +1,75 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.21
+
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+)
+
+// LevelSlogTrace is the slog level used for trace entries, which slog does not define.
+const LevelSlogTrace = slog.Level(-8)
+
+// slogLogger is a logger that sends entries to a slog logger.
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+// NewSlog returns a logger that sends entries to the supplied slog logger.
+// Trace entries are logged at LevelSlogTrace.
+func NewSlog(logger *slog.Logger) Logger {
+	return &slogLogger{
+		logger: logger,
+	}
+}
+
+// Enabled returns true if entries at the given level are logged.
+func (l *slogLogger) Enabled(level Level) bool {
+	return l.logger.Enabled(context.Background(), slogLevel(level))
+}
+
+// Log logs an entry with the given level, message and structured fields.
+func (l *slogLogger) Log(level Level, msg string, fields map[string]any) {
+	// Sort the fields so that output is consistent.
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	attrs := make([]slog.Attr, 0, len(keys))
+	for _, k := range keys {
+		attrs = append(attrs, slog.Any(k, fields[k]))
+	}
+
+	l.logger.LogAttrs(context.Background(), slogLevel(level), msg, attrs...)
+}
+
+// slogLevel returns the slog level for a level.
+func slogLevel(level Level) slog.Level {
+	switch level {
+	case LevelTrace:
+		return LevelSlogTrace
+	case LevelDebug:
+		return slog.LevelDebug
+	case LevelInfo:
+		return slog.LevelInfo
+	case LevelWarn:
+		return slog.LevelWarn
+	default:
+		return slog.LevelError
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.21
+
+package logging_test
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/logging"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlog(t *testing.T) {
+	var buf bytes.Buffer
+	handler := slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+		ReplaceAttr: func(_ []string, attr slog.Attr) slog.Attr {
+			if attr.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return attr
+		},
+	})
+	logger := logging.NewSlog(slog.New(handler))
+	require.False(t, logger.Enabled(logging.LevelDebug))
+	require.True(t, logger.Enabled(logging.LevelInfo))
+
+	log := logging.Zerolog(logger).With().Str("service", "test").Logger()
+	require.Equal(t, zerolog.InfoLevel, log.GetLevel())
+	log.Debug().Msg("Not logged")
+	log.Info().Str("address", "http://localhost:5052").Msg("Connected")
+
+	require.Equal(t, `level=INFO msg=Connected address=http://localhost:5052 service=test`, strings.TrimSpace(buf.String()))
+}
+
+func TestSlogTrace(t *testing.T) {
+	var buf bytes.Buffer
+	logger := logging.NewSlog(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: logging.LevelSlogTrace})))
+	require.True(t, logger.Enabled(logging.LevelTrace))
+
+	log := logging.Zerolog(logger)
+	log.Trace().Msg("Trace entry")
+	require.Contains(t, buf.String(), `level=DEBUG-4 msg="Trace entry"`)
+}
@@ -16,19 +16,41 @@ package mock
 import (
 	"context"
 
-	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
 // AttesterDuties obtains attester duties.
 // If validatorIndicess is nil it will return all duties for the given epoch.
-func (s *Service) AttesterDuties(_ context.Context, _ spec.Epoch, validatorIndices []spec.ValidatorIndex) ([]*api.AttesterDuty, error) {
-	res := make([]*api.AttesterDuty, len(validatorIndices))
+func (s *Service) AttesterDuties(_ context.Context, _ spec.Epoch, validatorIndices []spec.ValidatorIndex) ([]*apiv1.AttesterDuty, error) {
+	res := make([]*apiv1.AttesterDuty, len(validatorIndices))
 	for i := range validatorIndices {
-		res[i] = &api.AttesterDuty{
+		res[i] = &apiv1.AttesterDuty{
 			ValidatorIndex: validatorIndices[i],
 		}
 	}
 
 	return res, nil
 }
+
+// AttesterDutiesWithMeta obtains attester duties and their metadata.
+func (s *Service) AttesterDutiesWithMeta(ctx context.Context,
+	epoch spec.Epoch,
+	validatorIndices []spec.ValidatorIndex,
+) (
+	*api.Response[[]*apiv1.AttesterDuty],
+	error,
+) {
+	duties, err := s.AttesterDuties(ctx, epoch, validatorIndices)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*apiv1.AttesterDuty]{
+		Data: duties,
+		Metadata: &api.ResponseMetadata{
+			DependentRoot: &spec.Root{},
+		},
+	}, nil
+}
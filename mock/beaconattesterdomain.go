@@ -21,5 +21,5 @@ import (
 
 // BeaconAttesterDomain provides the beacon attester domain.
 func (s *Service) BeaconAttesterDomain(_ context.Context) (spec.DomainType, error) {
-	return spec.DomainType{0x01, 0x00, 0x00, 0x00}, nil
+	return spec.DomainBeaconAttester, nil
 }
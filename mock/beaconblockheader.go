@@ -16,15 +16,29 @@ package mock
 import (
 	"context"
 
-	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
 // BeaconBlockHeader provides the block header of a given block ID.
-func (s *Service) BeaconBlockHeader(_ context.Context, _ string) (*api.BeaconBlockHeader, error) {
-	return &api.BeaconBlockHeader{
+func (s *Service) BeaconBlockHeader(_ context.Context, _ string) (*apiv1.BeaconBlockHeader, error) {
+	return &apiv1.BeaconBlockHeader{
 		Header: &spec.SignedBeaconBlockHeader{
 			Message: &spec.BeaconBlockHeader{},
 		},
 	}, nil
 }
+
+// BeaconBlockHeaderWithMeta provides the block header and its metadata of a given block ID.
+func (s *Service) BeaconBlockHeaderWithMeta(ctx context.Context, blockID string) (*api.Response[*apiv1.BeaconBlockHeader], error) {
+	header, err := s.BeaconBlockHeader(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[*apiv1.BeaconBlockHeader]{
+		Data:     header,
+		Metadata: &api.ResponseMetadata{},
+	}, nil
+}
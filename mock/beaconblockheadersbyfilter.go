@@ -0,0 +1,39 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// BeaconBlockHeaders provides the block headers matching the given filters.
+func (s *Service) BeaconBlockHeaders(_ context.Context, slot *spec.Slot, parentRoot *spec.Root) ([]*apiv1.BeaconBlockHeader, error) {
+	header := &apiv1.BeaconBlockHeader{
+		Canonical: true,
+		Header: &spec.SignedBeaconBlockHeader{
+			Message: &spec.BeaconBlockHeader{},
+		},
+	}
+	if slot != nil {
+		header.Header.Message.Slot = *slot
+	}
+	if parentRoot != nil {
+		header.Header.Message.ParentRoot = *parentRoot
+	}
+
+	return []*apiv1.BeaconBlockHeader{header}, nil
+}
@@ -0,0 +1,55 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"errors"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// BeaconBlockHeadersBySlotRange provides the canonical block headers for the slots from startSlot to endSlot inclusive.
+func (s *Service) BeaconBlockHeadersBySlotRange(_ context.Context,
+	startSlot phase0.Slot,
+	endSlot phase0.Slot,
+) (
+	[]*apiv1.SlotBeaconBlockHeader,
+	error,
+) {
+	if endSlot < startSlot {
+		return nil, errors.New("end slot before start slot")
+	}
+
+	res := make([]*apiv1.SlotBeaconBlockHeader, 0, int(endSlot-startSlot)+1)
+	for slot := startSlot; slot <= endSlot; slot++ {
+		res = append(res, &apiv1.SlotBeaconBlockHeader{
+			Slot: slot,
+			Header: &apiv1.BeaconBlockHeader{
+				Canonical: true,
+				Header: &phase0.SignedBeaconBlockHeader{
+					Message: &phase0.BeaconBlockHeader{
+						Slot: slot,
+					},
+				},
+			},
+		})
+		if slot == endSlot {
+			break
+		}
+	}
+
+	return res, nil
+}
@@ -21,5 +21,5 @@ import (
 
 // BeaconProposerDomain provides the beacon proposer domain.
 func (s *Service) BeaconProposerDomain(_ context.Context) (spec.DomainType, error) {
-	return spec.DomainType{0x00, 0x00, 0x00, 0x00}, nil
+	return spec.DomainBeaconProposer, nil
 }
@@ -0,0 +1,41 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+)
+
+// BlobSidecars fetches the blob sidecars given a block ID, along with their metadata.
+func (s *Service) BlobSidecars(_ context.Context,
+	_ string,
+	_ []deneb.BlobIndex,
+) (
+	*api.Response[*api.VersionedBlobSidecars],
+	error,
+) {
+	return &api.Response[*api.VersionedBlobSidecars]{
+		Data: &api.VersionedBlobSidecars{
+			Version: spec.DataVersionDeneb,
+			Deneb:   make([]*deneb.BlobSidecar, 0),
+		},
+		Metadata: &api.ResponseMetadata{
+			Version: spec.DataVersionDeneb,
+		},
+	}, nil
+}
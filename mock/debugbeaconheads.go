@@ -0,0 +1,31 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// DebugBeaconHeads provides the heads of the chain known to the node's fork choice.
+func (s *Service) DebugBeaconHeads(_ context.Context) ([]*api.ChainHead, error) {
+	return []*api.ChainHead{
+		{
+			Slot: phase0.Slot(1),
+			Root: phase0.Root{0x01},
+		},
+	}, nil
+}
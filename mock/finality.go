@@ -16,13 +16,14 @@ package mock
 import (
 	"context"
 
-	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
 // Finality provides the finality given a state ID.
-func (s *Service) Finality(_ context.Context, _ string) (*api.Finality, error) {
-	return &api.Finality{
+func (s *Service) Finality(_ context.Context, _ string) (*apiv1.Finality, error) {
+	return &apiv1.Finality{
 		Finalized: &spec.Checkpoint{
 			Epoch: 6,
 			Root: spec.Root([32]byte{
@@ -46,3 +47,16 @@ func (s *Service) Finality(_ context.Context, _ string) (*api.Finality, error) {
 		},
 	}, nil
 }
+
+// FinalityWithMeta provides the finality and its metadata given a state ID.
+func (s *Service) FinalityWithMeta(ctx context.Context, stateID string) (*api.Response[*apiv1.Finality], error) {
+	finality, err := s.Finality(ctx, stateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[*apiv1.Finality]{
+		Data:     finality,
+		Metadata: &api.ResponseMetadata{},
+	}, nil
+}
@@ -0,0 +1,35 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// NodeIdentity provides the network identity of the node.
+func (s *Service) NodeIdentity(_ context.Context) (*api.NodeIdentity, error) {
+	return &api.NodeIdentity{
+		PeerID:             "16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96",
+		ENR:                "enr:-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCCdl8",
+		P2PAddresses:       []string{"/ip4/127.0.0.1/tcp/9000/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"},
+		DiscoveryAddresses: []string{"/ip4/127.0.0.1/udp/9000/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"},
+		Metadata: &api.NodeMetadata{
+			Attnets:  bitfield.NewBitvector64(),
+			Syncnets: bitfield.NewBitvector4(),
+		},
+	}, nil
+}
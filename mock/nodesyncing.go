@@ -24,6 +24,8 @@ func (s *Service) NodeSyncing(_ context.Context) (*api.SyncState, error) {
 	return &api.SyncState{
 		HeadSlot:     s.HeadSlot,
 		SyncDistance: s.SyncDistance,
+		IsOptimistic: s.IsOptimistic,
 		IsSyncing:    s.SyncDistance > 0,
+		ELOffline:    s.ELOffline,
 	}, nil
 }
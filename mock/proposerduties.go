@@ -16,19 +16,42 @@ package mock
 import (
 	"context"
 
-	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
 // ProposerDuties obtains proposer duties for the given epoch.
 // If validatorIndices is empty all duties are returned, otherwise only matching duties are returned.
-func (s *Service) ProposerDuties(_ context.Context, _ spec.Epoch, validatorIndices []spec.ValidatorIndex) ([]*api.ProposerDuty, error) {
-	res := make([]*api.ProposerDuty, len(validatorIndices))
+func (s *Service) ProposerDuties(_ context.Context, _ spec.Epoch, validatorIndices []spec.ValidatorIndex) ([]*apiv1.ProposerDuty, error) {
+	res := make([]*apiv1.ProposerDuty, len(validatorIndices))
 	for i := range validatorIndices {
-		res[i] = &api.ProposerDuty{
+		res[i] = &apiv1.ProposerDuty{
 			ValidatorIndex: validatorIndices[i],
 		}
 	}
 
 	return res, nil
 }
+
+// ProposerDutiesWithMeta obtains proposer duties and their metadata for the given epoch.
+// If validatorIndices is empty all duties are returned, otherwise only matching duties are returned.
+func (s *Service) ProposerDutiesWithMeta(ctx context.Context,
+	epoch spec.Epoch,
+	validatorIndices []spec.ValidatorIndex,
+) (
+	*api.Response[[]*apiv1.ProposerDuty],
+	error,
+) {
+	duties, err := s.ProposerDuties(ctx, epoch, validatorIndices)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*apiv1.ProposerDuty]{
+		Data: duties,
+		Metadata: &api.ResponseMetadata{
+			DependentRoot: &spec.Root{},
+		},
+	}, nil
+}
@@ -21,5 +21,5 @@ import (
 
 // RANDAODomain provides the RANDAO domain.
 func (s *Service) RANDAODomain(_ context.Context) (spec.DomainType, error) {
-	return spec.DomainType{0x02, 0x00, 0x00, 0x00}, nil
+	return spec.DomainRandao, nil
 }
@@ -21,5 +21,5 @@ import (
 
 // SelectionProofDomain provides the selection proof domain.
 func (s *Service) SelectionProofDomain(_ context.Context) (spec.DomainType, error) {
-	return spec.DomainType{0x05, 0x00, 0x00, 0x00}, nil
+	return spec.DomainSelectionProof, nil
 }
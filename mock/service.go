@@ -41,6 +41,8 @@ type Service struct {
 	// Values that can be altered if required.
 	HeadSlot     phase0.Slot
 	SyncDistance phase0.Slot
+	IsOptimistic bool
+	ELOffline    bool
 }
 
 // log is a service-wide logger.
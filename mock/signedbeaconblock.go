@@ -16,6 +16,7 @@ package mock
 import (
 	"context"
 
+	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
@@ -33,3 +34,23 @@ func (s *Service) SignedBeaconBlock(_ context.Context, _ string) (*spec.Versione
 		},
 	}, nil
 }
+
+// SignedBeaconBlockWithMeta fetches a signed beacon block and its metadata given a block ID.
+func (s *Service) SignedBeaconBlockWithMeta(ctx context.Context,
+	blockID string,
+) (
+	*api.Response[*spec.VersionedSignedBeaconBlock],
+	error,
+) {
+	block, err := s.SignedBeaconBlock(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[*spec.VersionedSignedBeaconBlock]{
+		Data: block,
+		Metadata: &api.ResponseMetadata{
+			Version: block.Version,
+		},
+	}, nil
+}
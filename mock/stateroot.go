@@ -16,6 +16,7 @@ package mock
 import (
 	"context"
 
+	"github.com/attestantio/go-eth2-client/api"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -23,3 +24,11 @@ import (
 func (s *Service) BeaconStateRoot(_ context.Context, _ string) (*spec.Root, error) {
 	return &spec.Root{}, nil
 }
+
+// StateRoot fetches a beacon state root and its metadata given a state ID.
+func (s *Service) StateRoot(_ context.Context, _ string) (*api.Response[*spec.Root], error) {
+	return &api.Response[*spec.Root]{
+		Data:     &spec.Root{},
+		Metadata: &api.ResponseMetadata{},
+	}, nil
+}
@@ -0,0 +1,29 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec"
+)
+
+// SubmitBeaconBlockV2 submits a beacon block with broadcast validation.
+func (s *Service) SubmitBeaconBlockV2(_ context.Context,
+	_ *spec.VersionedSignedBeaconBlock,
+	_ apiv1.BroadcastValidation,
+) error {
+	return nil
+}
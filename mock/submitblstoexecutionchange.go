@@ -23,3 +23,8 @@ import (
 func (s *Service) SubmitBLSToExecutionChange(_ context.Context, _ *capella.SignedBLSToExecutionChange) error {
 	return nil
 }
+
+// SubmitBLSToExecutionChanges submits BLS to execution address change operations.
+func (s *Service) SubmitBLSToExecutionChanges(_ context.Context, _ []*capella.SignedBLSToExecutionChange) error {
+	return nil
+}
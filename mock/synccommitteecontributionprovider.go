@@ -22,14 +22,18 @@ import (
 )
 
 // SyncCommitteeContribution provides a sync committee contribution.
-func (s *Service) SyncCommitteeContribution(_ context.Context, _ phase0.Slot, _ uint64, _ phase0.Root) (*altair.SyncCommitteeContribution, error) {
+func (s *Service) SyncCommitteeContribution(_ context.Context,
+	slot phase0.Slot,
+	subcommitteeIndex uint64,
+	beaconBlockRoot phase0.Root,
+) (
+	*altair.SyncCommitteeContribution,
+	error,
+) {
 	return &altair.SyncCommitteeContribution{
-		Slot: 5,
-		BeaconBlockRoot: phase0.Root([32]byte{
-			0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f,
-			0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f,
-		}),
-		SubcommitteeIndex: 0,
+		Slot:              slot,
+		BeaconBlockRoot:   beaconBlockRoot,
+		SubcommitteeIndex: subcommitteeIndex,
 		AggregationBits:   bitfield.NewBitvector128(),
 		Signature: phase0.BLSSignature([96]byte{
 			0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f,
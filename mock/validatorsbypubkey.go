@@ -24,6 +24,8 @@ import (
 // stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
 // validatorPubKeys is a list of validator public keys to restrict the returned values.  If no validators public keys are
 // supplied no filter will be applied.
-func (s *Service) ValidatorsByPubKey(_ context.Context, _ string, _ []phase0.BLSPubKey) (map[phase0.ValidatorIndex]*api.Validator, error) {
+// validatorStates is a list of validator states to restrict the returned values.  If no validator states are supplied no filter
+// will be applied.
+func (s *Service) ValidatorsByPubKey(_ context.Context, _ string, _ []phase0.BLSPubKey, _ []api.ValidatorState) (map[phase0.ValidatorIndex]*api.Validator, error) {
 	return map[phase0.ValidatorIndex]*api.Validator{}, nil
 }
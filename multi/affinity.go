@@ -0,0 +1,119 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// proposalSourceRetention is the number of slots for which the source of a proposal is retained.
+const proposalSourceRetention = phase0.Slot(64)
+
+// recordProposalSource records the client that provided the proposal for the given slot, if
+// submission affinity is enabled.
+func (s *Service) recordProposalSource(slot phase0.Slot, client consensusclient.Service) {
+	if !s.submissionAffinity {
+		return
+	}
+
+	s.proposalSourcesMu.Lock()
+	defer s.proposalSourcesMu.Unlock()
+	s.proposalSources[slot] = client
+	for sourceSlot := range s.proposalSources {
+		if sourceSlot+proposalSourceRetention < slot {
+			delete(s.proposalSources, sourceSlot)
+		}
+	}
+}
+
+// proposalSource returns the client that provided the proposal for the given slot, or nil if not known.
+func (s *Service) proposalSource(slot phase0.Slot) consensusclient.Service {
+	s.proposalSourcesMu.RLock()
+	defer s.proposalSourcesMu.RUnlock()
+
+	return s.proposalSources[slot]
+}
+
+// slotFunc returns the slot of the item being submitted.
+type slotFunc func() (phase0.Slot, error)
+
+// doSubmissionCall carries out the submission of a signed proposal.
+// If submission affinity is enabled and the proposal was obtained through this service, the
+// submission is made to the client that provided the proposal.  If that fails, the submission
+// is broadcast to all other active clients.
+// Otherwise the submission is made to the active clients in turn until one succeeds.
+func (s *Service) doSubmissionCall(ctx context.Context, slotFn slotFunc, call callFunc) error {
+	var source consensusclient.Service
+	var slot phase0.Slot
+	if s.submissionAffinity {
+		var err error
+		if slot, err = slotFn(); err == nil {
+			source = s.proposalSource(slot)
+		}
+	}
+	if source == nil {
+		_, err := s.doFirstCall(ctx, call, nil, 1)
+		return err
+	}
+
+	log := s.log.With().Uint64("slot", uint64(slot)).Logger()
+	ctx = log.WithContext(ctx)
+
+	ctx, span := s.startCallSpan(ctx, 1)
+	defer span.End()
+
+	_, err := s.callClient(ctx, call, source)
+	if err == nil {
+		return nil
+	}
+	log.Debug().Str("client", source.Name()).Str("address", source.Address()).Err(err).Msg("Submission to proposal source failed; broadcasting")
+
+	clients := make([]consensusclient.Service, 0)
+	for _, client := range s.currentActiveClients(ctx) {
+		if client != source {
+			clients = append(clients, client)
+		}
+	}
+	if len(clients) == 0 {
+		spanError(span, err)
+		return err
+	}
+
+	errs := make([]error, len(clients))
+	var wg sync.WaitGroup
+	for i := range clients {
+		wg.Add(1)
+		go func(i int, client consensusclient.Service) {
+			defer wg.Done()
+			_, errs[i] = s.callClient(ctx, call, client)
+		}(i, clients[i])
+	}
+	wg.Wait()
+
+	for i := range errs {
+		if errs[i] == nil {
+			return nil
+		}
+		log.Debug().Str("client", clients[i].Name()).Str("address", clients[i].Address()).Err(errs[i]).Msg("Broadcast submission failed")
+	}
+	err = errors.Wrap(errs[len(errs)-1], "submission failed on all clients")
+	spanError(span, err)
+
+	return err
+}
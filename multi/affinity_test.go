@@ -0,0 +1,158 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// submissionClient is a mock client that records block submissions.
+type submissionClient struct {
+	*mock.Service
+	noProposals bool
+	failSubmits bool
+
+	mu          sync.Mutex
+	submissions int
+}
+
+func (c *submissionClient) BeaconBlockProposal(ctx context.Context,
+	slot phase0.Slot,
+	randaoReveal phase0.BLSSignature,
+	graffiti []byte,
+) (
+	*spec.VersionedBeaconBlock,
+	error,
+) {
+	if c.noProposals {
+		return nil, nil
+	}
+
+	return c.Service.BeaconBlockProposal(ctx, slot, randaoReveal, graffiti)
+}
+
+func (c *submissionClient) SubmitBeaconBlock(_ context.Context, _ *spec.VersionedSignedBeaconBlock) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.submissions++
+	if c.failSubmits {
+		return errors.New("unknown parent")
+	}
+
+	return nil
+}
+
+func (c *submissionClient) Submissions() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.submissions
+}
+
+func newSubmissionClient(ctx context.Context, t *testing.T, name string) *submissionClient {
+	t.Helper()
+
+	client, err := mock.New(ctx, mock.WithName(name))
+	require.NoError(t, err)
+
+	return &submissionClient{Service: client}
+}
+
+func signedBlock(t *testing.T, block *spec.VersionedBeaconBlock) *spec.VersionedSignedBeaconBlock {
+	t.Helper()
+
+	require.Equal(t, spec.DataVersionPhase0, block.Version)
+
+	return &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.SignedBeaconBlock{
+			Message: block.Phase0,
+		},
+	}
+}
+
+func TestSubmissionAffinity(t *testing.T) {
+	ctx := context.Background()
+
+	client1 := newSubmissionClient(ctx, t, "mock 1")
+	client1.noProposals = true
+	client2 := newSubmissionClient(ctx, t, "mock 2")
+	client3 := newSubmissionClient(ctx, t, "mock 3")
+
+	multiClient, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{client1, client2, client3}),
+		multi.WithSubmissionAffinity(true),
+	)
+	require.NoError(t, err)
+
+	// Proposal is provided by client 2, so the submission should go to client 2 alone.
+	block, err := multiClient.(consensusclient.BeaconBlockProposalProvider).BeaconBlockProposal(ctx, 10, phase0.BLSSignature{}, nil)
+	require.NoError(t, err)
+	require.NoError(t, multiClient.(consensusclient.BeaconBlockSubmitter).SubmitBeaconBlock(ctx, signedBlock(t, block)))
+	require.Equal(t, 0, client1.Submissions())
+	require.Equal(t, 1, client2.Submissions())
+	require.Equal(t, 0, client3.Submissions())
+
+	// Failure to submit to client 2 should result in broadcast to the other clients.
+	client2.failSubmits = true
+	require.NoError(t, multiClient.(consensusclient.BeaconBlockSubmitter).SubmitBeaconBlock(ctx, signedBlock(t, block)))
+	require.Equal(t, 1, client1.Submissions())
+	require.Equal(t, 2, client2.Submissions())
+	require.Equal(t, 1, client3.Submissions())
+
+	// Failure on all clients should return an error.
+	client1.failSubmits = true
+	client3.failSubmits = true
+	err = multiClient.(consensusclient.BeaconBlockSubmitter).SubmitBeaconBlock(ctx, signedBlock(t, block))
+	require.ErrorContains(t, err, "submission failed on all clients")
+
+	// A block for a slot without a known proposal follows the standard path.
+	client1.failSubmits = false
+	block.Phase0.Slot = 11
+	require.NoError(t, multiClient.(consensusclient.BeaconBlockSubmitter).SubmitBeaconBlock(ctx, signedBlock(t, block)))
+	require.Equal(t, 3, client1.Submissions())
+	require.Equal(t, 3, client2.Submissions())
+}
+
+func TestSubmissionAffinityDisabled(t *testing.T) {
+	ctx := context.Background()
+
+	client1 := newSubmissionClient(ctx, t, "mock 1")
+	client1.noProposals = true
+	client2 := newSubmissionClient(ctx, t, "mock 2")
+
+	multiClient, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{client1, client2}),
+	)
+	require.NoError(t, err)
+
+	block, err := multiClient.(consensusclient.BeaconBlockProposalProvider).BeaconBlockProposal(ctx, 10, phase0.BLSSignature{}, nil)
+	require.NoError(t, err)
+	require.NoError(t, multiClient.(consensusclient.BeaconBlockSubmitter).SubmitBeaconBlock(ctx, signedBlock(t, block)))
+	require.Equal(t, 1, client1.Submissions())
+	require.Equal(t, 0, client2.Submissions())
+}
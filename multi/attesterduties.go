@@ -17,7 +17,8 @@ import (
 	"context"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
-	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -27,7 +28,7 @@ func (s *Service) AttesterDuties(ctx context.Context,
 	epoch phase0.Epoch,
 	validatorIndices []phase0.ValidatorIndex,
 ) (
-	[]*api.AttesterDuty,
+	[]*apiv1.AttesterDuty,
 	error,
 ) {
 	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
@@ -43,5 +44,29 @@ func (s *Service) AttesterDuties(ctx context.Context,
 	if res == nil {
 		return nil, nil
 	}
-	return res.([]*api.AttesterDuty), nil
+	return res.([]*apiv1.AttesterDuty), nil
+}
+
+// AttesterDutiesWithMeta obtains attester duties and their metadata.
+func (s *Service) AttesterDutiesWithMeta(ctx context.Context,
+	epoch phase0.Epoch,
+	validatorIndices []phase0.ValidatorIndex,
+) (
+	*api.Response[[]*apiv1.AttesterDuty],
+	error,
+) {
+	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		resp, err := client.(consensusclient.AttesterDutiesWithMetaProvider).AttesterDutiesWithMeta(ctx, epoch, validatorIndices)
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(*api.Response[[]*apiv1.AttesterDuty]), nil
 }
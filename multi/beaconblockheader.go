@@ -17,12 +17,13 @@ import (
 	"context"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
-	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 )
 
 // BeaconBlockHeader provides the block header of a given block ID.
-func (s *Service) BeaconBlockHeader(ctx context.Context, blockID string) (*api.BeaconBlockHeader, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+func (s *Service) BeaconBlockHeader(ctx context.Context, blockID string) (*apiv1.BeaconBlockHeader, error) {
+	res, err := s.doReadCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		beaconBlockHeader, err := client.(consensusclient.BeaconBlockHeadersProvider).BeaconBlockHeader(ctx, blockID)
 		if err != nil {
 			return nil, err
@@ -35,5 +36,23 @@ func (s *Service) BeaconBlockHeader(ctx context.Context, blockID string) (*api.B
 	if res == nil {
 		return nil, nil
 	}
-	return res.(*api.BeaconBlockHeader), nil
+	return res.(*apiv1.BeaconBlockHeader), nil
+}
+
+// BeaconBlockHeaderWithMeta provides the block header and its metadata of a given block ID.
+func (s *Service) BeaconBlockHeaderWithMeta(ctx context.Context, blockID string) (*api.Response[*apiv1.BeaconBlockHeader], error) {
+	res, err := s.doReadCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		resp, err := client.(consensusclient.BeaconBlockHeadersWithMetaProvider).BeaconBlockHeaderWithMeta(ctx, blockID)
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(*api.Response[*apiv1.BeaconBlockHeader]), nil
 }
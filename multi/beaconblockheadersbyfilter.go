@@ -0,0 +1,37 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// BeaconBlockHeaders provides the block headers matching the given filters.
+func (s *Service) BeaconBlockHeaders(ctx context.Context, slot *phase0.Slot, parentRoot *phase0.Root) ([]*apiv1.BeaconBlockHeader, error) {
+	res, err := s.doReadCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		beaconBlockHeaders, err := client.(consensusclient.BeaconBlockHeadersByFilterProvider).BeaconBlockHeaders(ctx, slot, parentRoot)
+		if err != nil {
+			return nil, err
+		}
+		return beaconBlockHeaders, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res.([]*apiv1.BeaconBlockHeader), nil
+}
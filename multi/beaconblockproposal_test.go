@@ -64,3 +64,30 @@ func TestBeaconBlockProposal(t *testing.T) {
 	// At this point we expect mock 3 to be in active (unless probability hates us).
 	require.Equal(t, "mock 3", multiClient.Address())
 }
+
+func TestBeaconBlockProposalGraffiti(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx, mock.WithName("mock"))
+	require.NoError(t, err)
+
+	multiClient, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{client}),
+		multi.WithGraffiti([]byte("default")),
+	)
+	require.NoError(t, err)
+	provider := multiClient.(consensusclient.BeaconBlockProposalProvider)
+
+	res, err := provider.BeaconBlockProposal(ctx, 1, phase0.BLSSignature{}, nil)
+	require.NoError(t, err)
+	graffiti, err := res.Graffiti()
+	require.NoError(t, err)
+	require.Equal(t, "default", string(graffiti[:7]))
+
+	res, err = provider.BeaconBlockProposal(ctx, 1, phase0.BLSSignature{}, []byte("override"))
+	require.NoError(t, err)
+	graffiti, err = res.Graffiti()
+	require.NoError(t, err)
+	require.Equal(t, "override", string(graffiti[:8]))
+}
@@ -22,7 +22,7 @@ import (
 
 // BeaconBlockRoot fetches a block's root given a block ID.
 func (s *Service) BeaconBlockRoot(ctx context.Context, blockID string) (*phase0.Root, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doReadCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		root, err := client.(consensusclient.BeaconBlockRootProvider).BeaconBlockRoot(ctx, blockID)
 		if err != nil {
 			return nil, err
@@ -22,6 +22,7 @@ import (
 )
 
 // BlindedBeaconBlockProposal fetches a proposed blinded beacon block for signing.
+// If graffiti is nil the service default graffiti, as set with WithGraffiti, is used.
 func (s *Service) BlindedBeaconBlockProposal(ctx context.Context,
 	slot phase0.Slot,
 	randaoReveal phase0.BLSSignature,
@@ -30,11 +31,20 @@ func (s *Service) BlindedBeaconBlockProposal(ctx context.Context,
 	*api.VersionedBlindedBeaconBlock,
 	error,
 ) {
+	if graffiti == nil {
+		graffiti = s.graffiti
+	}
+
 	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		block, err := client.(consensusclient.BlindedBeaconBlockProposalProvider).BlindedBeaconBlockProposal(ctx, slot, randaoReveal, graffiti)
 		if err != nil {
 			return nil, err
 		}
+		if block == nil {
+			// Return an untyped nil so that the next client is tried.
+			return nil, nil
+		}
+		s.recordProposalSource(slot, client)
 		return block, nil
 	}, nil)
 	if err != nil {
@@ -35,7 +35,7 @@ func (s *Service) monitor(ctx context.Context) {
 		case <-ctx.Done():
 			log.Trace().Msg("Context done; monitor stopping")
 			return
-		case <-time.After(30 * time.Second):
+		case <-time.After(s.healthCheckInterval):
 			s.recheck(ctx)
 		}
 	}
@@ -50,9 +50,9 @@ func (s *Service) recheck(ctx context.Context) {
 	clients = append(clients, s.inactiveClients...)
 	s.clientsMu.RUnlock()
 
-	// Ping each client to update its state.
+	// Check the health of each client to update its state.
 	for _, client := range clients {
-		if ping(ctx, client) {
+		if s.checkHealth(ctx, client) {
 			s.activateClient(ctx, client)
 		} else {
 			s.deactivateClient(ctx, client)
@@ -65,8 +65,6 @@ func (s *Service) deactivateClient(ctx context.Context, client consensusclient.S
 	log := zerolog.Ctx(ctx)
 
 	s.clientsMu.Lock()
-	defer s.clientsMu.Unlock()
-
 	activeClients := make([]consensusclient.Service, 0, len(s.activeClients)+len(s.inactiveClients))
 	inactiveClients := s.inactiveClients
 	for _, activeClient := range s.activeClients {
@@ -85,6 +83,9 @@ func (s *Service) deactivateClient(ctx context.Context, client consensusclient.S
 	setProvidersMetric(ctx, "active", len(s.activeClients))
 	s.inactiveClients = inactiveClients
 	setProvidersMetric(ctx, "inactive", len(s.inactiveClients))
+	s.clientsMu.Unlock()
+
+	s.setClientActive(ctx, client, false)
 }
 
 // activateClient activates a client, moving it to the active list if not currently on it.
@@ -92,8 +93,6 @@ func (s *Service) activateClient(ctx context.Context, client consensusclient.Ser
 	log := zerolog.Ctx(ctx)
 
 	s.clientsMu.Lock()
-	defer s.clientsMu.Unlock()
-
 	activeClients := s.activeClients
 	inactiveClients := make([]consensusclient.Service, 0, len(s.activeClients)+len(s.inactiveClients))
 	for _, inactiveClient := range s.inactiveClients {
@@ -112,26 +111,9 @@ func (s *Service) activateClient(ctx context.Context, client consensusclient.Ser
 	setProvidersMetric(ctx, "active", len(s.activeClients))
 	s.inactiveClients = inactiveClients
 	setProvidersMetric(ctx, "inactive", len(s.inactiveClients))
-}
-
-// ping pings a client, returning true if it is ready to serve requests and
-// false otherwise.
-func ping(ctx context.Context, client consensusclient.Service) bool {
-	log := zerolog.Ctx(ctx)
-
-	provider, isProvider := client.(consensusclient.NodeSyncingProvider)
-	if !isProvider {
-		log.Debug().Str("provider", client.Address()).Msg("Client does not provide sync state")
-		return false
-	}
-
-	syncState, err := provider.NodeSyncing(ctx)
-	if err != nil {
-		log.Warn().Err(err).Msg("Failed to obtain sync state from node")
-		return false
-	}
+	s.clientsMu.Unlock()
 
-	return (!syncState.IsSyncing) || (syncState.HeadSlot == 0 && syncState.SyncDistance == 0)
+	s.setClientActive(ctx, client, true)
 }
 
 // callFunc is the definition for a call function.  It provides a generic return interface
@@ -145,9 +127,24 @@ type errHandlerFunc func(ctx context.Context, client consensusclient.Service, er
 
 // doCall carries out a call on the active clients in turn until one succeeds.
 func (s *Service) doCall(ctx context.Context, call callFunc, errHandler errHandlerFunc) (interface{}, error) {
-	log := s.log.With().Logger()
-	ctx = log.WithContext(ctx)
+	return s.doFirstCall(ctx, call, errHandler, 1)
+}
+
+// doReadCall carries out a read-only call using the service's read strategy.
+func (s *Service) doReadCall(ctx context.Context, call callFunc, errHandler errHandlerFunc) (interface{}, error) {
+	switch s.readStrategy {
+	case ReadStrategyMajority:
+		return s.doMajorityCall(ctx, call, errHandler, 1)
+	case ReadStrategyWeighted:
+		return s.doOrderedCall(ctx, call, errHandler, 1, s.weightedClients)
+	default:
+		return s.doFirstCall(ctx, call, errHandler, 1)
+	}
+}
 
+// currentActiveClients returns the active clients, attempting to re-enable inactive
+// clients if there are none.
+func (s *Service) currentActiveClients(ctx context.Context) []consensusclient.Service {
 	// Grab local copy of active clients in case it is updated whilst we are using it.
 	s.clientsMu.RLock()
 	activeClients := s.activeClients
@@ -161,20 +158,89 @@ func (s *Service) doCall(ctx context.Context, call callFunc, errHandler errHandl
 		s.clientsMu.RUnlock()
 	}
 
+	return activeClients
+}
+
+// callClient carries out a call on a single client, tracking it as in progress
+// so that the client can be drained if it is removed.
+// Calls tagged as critical are given a limited time in which to complete.
+func (s *Service) callClient(ctx context.Context, call callFunc, client consensusclient.Service) (interface{}, error) {
+	s.beginClientCall(client)
+	defer s.endClientCall(client)
+
+	clientCtx, clientSpan := s.startClientSpan(ctx, client)
+	defer clientSpan.End()
+	if PriorityFromContext(ctx) == PriorityCritical {
+		var cancel context.CancelFunc
+		clientCtx, cancel = context.WithTimeout(clientCtx, s.criticalTimeout)
+		defer cancel()
+	}
+	started := time.Now()
+	res, err := call(clientCtx, client)
+	s.recordLatency(client, time.Since(started))
+	if err != nil {
+		spanError(clientSpan, err)
+	}
+
+	return res, err
+}
+
+// doFirstCall carries out a call on the active clients in turn until one succeeds.
+// skip is the number of stack frames between the service method and this function.
+func (s *Service) doFirstCall(ctx context.Context, call callFunc, errHandler errHandlerFunc, skip int) (interface{}, error) {
+	return s.doOrderedCall(ctx, call, errHandler, skip+1, nil)
+}
+
+// doOrderedCall carries out a call on the active clients in turn until one succeeds.
+// If order is supplied it is used to reorder the active clients before the call is made.
+// skip is the number of stack frames between the service method and this function.
+func (s *Service) doOrderedCall(ctx context.Context,
+	call callFunc,
+	errHandler errHandlerFunc,
+	skip int,
+	order func([]consensusclient.Service) []consensusclient.Service,
+) (
+	interface{},
+	error,
+) {
+	log := s.log.With().Logger()
+	ctx = log.WithContext(ctx)
+
+	ctx, span := s.startCallSpan(ctx, skip+1)
+	defer span.End()
+
+	priority := PriorityFromContext(ctx)
+	activeClients := s.prioritizedClients(priority, s.currentActiveClients(ctx))
 	if len(activeClients) == 0 {
-		return nil, errors.New("no active clients to which to make call")
+		err := errors.New("no active clients to which to make call")
+		spanError(span, err)
+		return nil, err
+	}
+	if order != nil {
+		activeClients = order(activeClients)
 	}
 
 	var err error
 	var res interface{}
 	for _, client := range activeClients {
-		res, err = call(ctx, client)
+		res, err = s.callClient(ctx, call, client)
 		if err != nil {
 			failover := true
 			if errHandler != nil {
 				failover, err = errHandler(ctx, client, err)
 			}
 
+			if failover && priority == PriorityCritical && errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+				// The client was too slow for a critical call; its latency will steer future critical calls
+				// elsewhere, but it remains usable for other calls.
+				log.Debug().Str("client", client.Name()).Str("address", client.Address()).Msg("Critical call timed out; trying next client")
+				continue
+			}
+			if failover && priority == PriorityArchival {
+				// Archival calls can fail for reasons that do not reflect the health of the client.
+				log.Debug().Str("client", client.Name()).Str("address", client.Address()).Err(err).Msg("Archival call failed; trying next client")
+				continue
+			}
 			if failover {
 				log.Debug().Str("client", client.Name()).Str("address", client.Address()).Err(err).Msg("Deactivating client on error")
 				// Failed with this client; try the next.
@@ -192,6 +258,9 @@ func (s *Service) doCall(ctx context.Context, call callFunc, errHandler errHandl
 		}
 		return res, nil
 	}
+	if err != nil {
+		spanError(span, err)
+	}
 	return nil, err
 }
 
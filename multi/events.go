@@ -15,15 +15,25 @@ package multi
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"math/rand"
+	"sync"
 	"time"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 )
 
+// seenEventsLimit is the number of recent events remembered by each subscription
+// for deduplication.
+const seenEventsLimit = 16384
+
 // Events feeds requested events with the given topics to the supplied handler.
 func (s *Service) Events(ctx context.Context,
 	topics []string,
@@ -33,7 +43,9 @@ func (s *Service) Events(ctx context.Context,
 	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Logger()
 
 	// Because events are streams we treat them differently from all other calls.
-	// We listen to all active clients, and only pass along events from the currently active provider.
+	// We listen to all active clients, and pass along the first instance of each event
+	// regardless of the client from which it arrives.  This means that the loss of a
+	// stream from any single client does not result in the loss of events.
 
 	// Grab local copy of both active and inactive clients in case it is updated whilst we are using it.
 	s.clientsMu.RLock()
@@ -41,14 +53,18 @@ func (s *Service) Events(ctx context.Context,
 	inactiveClients := s.inactiveClients
 	s.clientsMu.RUnlock()
 
+	sub := &eventSubscription{
+		s:       s,
+		ctx:     ctx,
+		log:     log,
+		topics:  topics,
+		handler: handler,
+		seen:    make(map[eventKey]struct{}),
+	}
+
 	// Call all active clients immediately.
 	for _, client := range activeClients {
-		ah := &activeHandler{
-			s:       s,
-			log:     log.With().Logger(),
-			address: client.Address(),
-			handler: handler,
-		}
+		ah := sub.activeHandler(client)
 		if err := client.(consensusclient.EventsProvider).Events(ctx, topics, ah.handleEvent); err != nil {
 			inactiveClients = append(inactiveClients, client)
 			continue
@@ -58,42 +74,119 @@ func (s *Service) Events(ctx context.Context,
 
 	// Periodically try all inactive clients, quitting as they become active.
 	for _, inactiveClient := range inactiveClients {
-		ah := &activeHandler{
-			s:       s,
-			log:     log.With().Logger(),
-			address: inactiveClient.Address(),
-			handler: handler,
+		go sub.awaitClient(inactiveClient)
+	}
+
+	s.subscriptionsMu.Lock()
+	s.subscriptions = append(s.subscriptions, sub)
+	s.subscriptionsMu.Unlock()
+
+	return nil
+}
+
+// eventSubscription is a subscription to events made through the service, retained
+// so that clients added to the service later on can join it.
+type eventSubscription struct {
+	s       *Service
+	ctx     context.Context
+	log     zerolog.Logger
+	topics  []string
+	handler consensusclient.EventHandlerFunc
+
+	seenMu    sync.Mutex
+	seen      map[eventKey]struct{}
+	seenOrder []eventKey
+	seenNext  int
+}
+
+// eventKey identifies an event for deduplication.
+type eventKey struct {
+	topic string
+	slot  phase0.Slot
+	root  phase0.Root
+}
+
+// activeHandler returns the handler for events from the given client.
+func (e *eventSubscription) activeHandler(client consensusclient.Service) *activeHandler {
+	return &activeHandler{
+		sub:     e,
+		log:     e.log.With().Logger(),
+		address: client.Address(),
+		handler: e.handler,
+	}
+}
+
+// firstSeen returns true the first time that an event with the given key is seen.
+// The most recent keys are remembered, up to seenEventsLimit.
+func (e *eventSubscription) firstSeen(key eventKey) bool {
+	e.seenMu.Lock()
+	defer e.seenMu.Unlock()
+
+	if _, exists := e.seen[key]; exists {
+		return false
+	}
+
+	if len(e.seenOrder) < seenEventsLimit {
+		e.seenOrder = append(e.seenOrder, key)
+	} else {
+		delete(e.seen, e.seenOrder[e.seenNext])
+		e.seenOrder[e.seenNext] = key
+		e.seenNext = (e.seenNext + 1) % seenEventsLimit
+	}
+	e.seen[key] = struct{}{}
+
+	return true
+}
+
+// awaitClient waits for the client to be synced before setting up the events call.
+func (e *eventSubscription) awaitClient(client consensusclient.Service) {
+	ah := e.activeHandler(client)
+	for {
+		provider, isProvider := client.(consensusclient.NodeSyncingProvider)
+		if !isProvider {
+			ah.log.Error().Str("address", ah.address).Strs("topics", e.topics).Msg("Not a node syncing provider")
+			return
 		}
-		go func(c consensusclient.Service, ah *activeHandler) {
-			for {
-				provider, isProvider := c.(consensusclient.NodeSyncingProvider)
-				if !isProvider {
-					ah.log.Error().Str("address", ah.address).Strs("topics", topics).Msg("Not a node syncing provider")
-					return
-				}
-				syncState, err := provider.NodeSyncing(ctx)
-				if err != nil {
-					ah.log.Error().Str("address", ah.address).Strs("topics", topics).Err(err).Msg("Failed to obtain sync state from node")
-					return
-				}
-				if !syncState.IsSyncing {
-					// Client is now synced, set up the events call.
-					if err := c.(consensusclient.EventsProvider).Events(ctx, topics, ah.handleEvent); err != nil {
-						ah.log.Error().Str("address", ah.address).Strs("topics", topics).Err(err).Msg("Failed to set up events handler")
-					}
-					// Return either way.
-					return
-				}
-				time.Sleep(5 * time.Second)
+		syncState, err := provider.NodeSyncing(e.ctx)
+		if err != nil {
+			ah.log.Error().Str("address", ah.address).Strs("topics", e.topics).Err(err).Msg("Failed to obtain sync state from node")
+			return
+		}
+		if !syncState.IsSyncing {
+			// Client is now synced, set up the events call.
+			if err := client.(consensusclient.EventsProvider).Events(e.ctx, e.topics, ah.handleEvent); err != nil {
+				ah.log.Error().Str("address", ah.address).Strs("topics", e.topics).Err(err).Msg("Failed to set up events handler")
 			}
-		}(inactiveClient, ah)
+			// Return either way.
+			return
+		}
+		time.Sleep(5 * time.Second)
 	}
+}
 
-	return nil
+// subscribeClient adds the client to all current event subscriptions, dropping
+// subscriptions whose context has finished.
+func (s *Service) subscribeClient(client consensusclient.Service) {
+	if _, isProvider := client.(consensusclient.EventsProvider); !isProvider {
+		return
+	}
+
+	s.subscriptionsMu.Lock()
+	defer s.subscriptionsMu.Unlock()
+
+	subscriptions := make([]*eventSubscription, 0, len(s.subscriptions))
+	for _, sub := range s.subscriptions {
+		if sub.ctx.Err() != nil {
+			continue
+		}
+		subscriptions = append(subscriptions, sub)
+		go sub.awaitClient(client)
+	}
+	s.subscriptions = subscriptions
 }
 
 type activeHandler struct {
-	s       *Service
+	sub     *eventSubscription
 	log     zerolog.Logger
 	address string
 	handler consensusclient.EventHandlerFunc
@@ -101,11 +194,65 @@ type activeHandler struct {
 
 func (h *activeHandler) handleEvent(event *api.Event) {
 	h.log.Trace().Str("address", h.address).Str("topic", event.Topic).Msg("Event received")
-	// We only forward events from the currently active provider.  If we did not do this then we could end up with
-	// inconsistent results, for example a client may receive a `head` event and a subsequent call to fetch the head
-	// block end up with an earlier block.
-	if h.s.Address() == h.address {
-		h.log.Trace().Str("address", h.address).Str("topic", event.Topic).Msg("Forwarding due to primary active address")
+
+	key, err := keyForEvent(event)
+	if err != nil {
+		// Unable to deduplicate, so forward the event as-is.
+		h.log.Debug().Str("address", h.address).Str("topic", event.Topic).Err(err).Msg("Failed to obtain key for event; forwarding")
 		h.handler(event)
+		return
+	}
+
+	if !h.sub.firstSeen(key) {
+		h.log.Trace().Str("address", h.address).Str("topic", event.Topic).Msg("Event already forwarded; ignoring")
+		return
+	}
+
+	h.log.Trace().Str("address", h.address).Str("topic", event.Topic).Msg("Forwarding event")
+	h.handler(event)
+}
+
+// keyForEvent returns the deduplication key for an event.
+// Events with a well-known structure are keyed by their slot and block root, and other
+// events by the hash tree root, or failing that the hash of the JSON, of their data.
+func keyForEvent(event *api.Event) (eventKey, error) {
+	key := eventKey{
+		topic: event.Topic,
 	}
+
+	switch data := event.Data.(type) {
+	case *api.HeadEvent:
+		key.slot = data.Slot
+		key.root = data.Block
+	case *api.BlockEvent:
+		key.slot = data.Slot
+		key.root = data.Block
+	case *api.ChainReorgEvent:
+		key.slot = data.Slot
+		key.root = data.NewHeadBlock
+	case *api.FinalizedCheckpointEvent:
+		// The same block can be finalized at successive epochs, so the epoch is included.
+		key.slot = phase0.Slot(data.Epoch)
+		key.root = data.Block
+	case *api.PayloadAttributesEvent:
+		if data.Data == nil {
+			return eventKey{}, errors.New("payload attributes event missing data")
+		}
+		key.slot = data.Data.ProposalSlot
+		key.root = data.Data.ParentBlockRoot
+	case ssz.HashRoot:
+		root, err := data.HashTreeRoot()
+		if err != nil {
+			return eventKey{}, err
+		}
+		key.root = root
+	default:
+		encoded, err := json.Marshal(data)
+		if err != nil {
+			return eventKey{}, err
+		}
+		key.root = sha256.Sum256(encoded)
+	}
+
+	return key, nil
 }
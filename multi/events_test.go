@@ -15,11 +15,14 @@ package multi_test
 
 import (
 	"context"
+	"sync"
 	"testing"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/mock"
 	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/attestantio/go-eth2-client/testclients"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
@@ -51,3 +54,77 @@ func TestEvents(t *testing.T) {
 
 	require.NoError(t, multiClient.(consensusclient.EventsProvider).Events(ctx, []string{}, nil))
 }
+
+// eventsClient is a mock client that allows events to be sent to its subscribers.
+type eventsClient struct {
+	*mock.Service
+	mu       sync.Mutex
+	handlers []consensusclient.EventHandlerFunc
+}
+
+func (c *eventsClient) Events(_ context.Context, _ []string, handler consensusclient.EventHandlerFunc) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.handlers = append(c.handlers, handler)
+
+	return nil
+}
+
+func (c *eventsClient) send(event *apiv1.Event) {
+	c.mu.Lock()
+	handlers := c.handlers
+	c.mu.Unlock()
+	for _, handler := range handlers {
+		handler(event)
+	}
+}
+
+func TestEventsDeduplication(t *testing.T) {
+	ctx := context.Background()
+
+	clients := make([]*eventsClient, 0, 3)
+	services := make([]consensusclient.Service, 0, 3)
+	for _, name := range []string{"mock 1", "mock 2", "mock 3"} {
+		mockClient, err := mock.New(ctx, mock.WithName(name))
+		require.NoError(t, err)
+		client := &eventsClient{Service: mockClient}
+		clients = append(clients, client)
+		services = append(services, client)
+	}
+
+	multiClient, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients(services),
+	)
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	received := make([]*apiv1.Event, 0)
+	require.NoError(t, multiClient.(consensusclient.EventsProvider).Events(ctx, []string{"head", "voluntary_exit"}, func(event *apiv1.Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, event)
+	}))
+
+	head1 := &apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{Slot: 1, Block: phase0.Root{0x01}}}
+	head2 := &apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{Slot: 2, Block: phase0.Root{0x02}}}
+	head2Reorg := &apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{Slot: 2, Block: phase0.Root{0x03}}}
+	exit := &apiv1.Event{Topic: "voluntary_exit", Data: &phase0.SignedVoluntaryExit{
+		Message: &phase0.VoluntaryExit{Epoch: 1, ValidatorIndex: 2},
+	}}
+
+	// Duplicates from all clients are forwarded once.
+	for _, client := range clients {
+		client.send(head1)
+		client.send(exit)
+	}
+	// Events from a single client are forwarded, for example if the other streams have dropped.
+	clients[2].send(head2)
+	clients[0].send(head2)
+	// Events for the same slot with a different root are distinct.
+	clients[1].send(head2Reorg)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []*apiv1.Event{head1, exit, head2, head2Reorg}, received)
+}
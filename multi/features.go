@@ -0,0 +1,49 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// FeatureEnabled returns true if the given feature is enabled in all of the underlying clients.
+func (s *Service) FeatureEnabled(feature api.Feature) bool {
+	s.clientsMu.RLock()
+	defer s.clientsMu.RUnlock()
+
+	clients := append(append([]consensusclient.Service{}, s.activeClients...), s.inactiveClients...)
+	if len(clients) == 0 {
+		return false
+	}
+	for _, client := range clients {
+		provider, isProvider := client.(consensusclient.FeaturesProvider)
+		if !isProvider || !provider.FeatureEnabled(feature) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// EnabledFeatures returns the features enabled in all of the underlying clients.
+func (s *Service) EnabledFeatures() []api.Feature {
+	res := make([]api.Feature, 0)
+	for _, info := range api.KnownFeatures() {
+		if s.FeatureEnabled(info.Feature) {
+			res = append(res, info.Feature)
+		}
+	}
+	return res
+}
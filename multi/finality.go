@@ -17,12 +17,13 @@ import (
 	"context"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
-	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 )
 
 // Finality provides the finality given a state ID.
-func (s *Service) Finality(ctx context.Context, stateID string) (*api.Finality, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+func (s *Service) Finality(ctx context.Context, stateID string) (*apiv1.Finality, error) {
+	res, err := s.doReadCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		finality, err := client.(consensusclient.FinalityProvider).Finality(ctx, stateID)
 		if err != nil {
 			return nil, err
@@ -35,5 +36,23 @@ func (s *Service) Finality(ctx context.Context, stateID string) (*api.Finality,
 	if res == nil {
 		return nil, nil
 	}
-	return res.(*api.Finality), nil
+	return res.(*apiv1.Finality), nil
+}
+
+// FinalityWithMeta provides the finality and its metadata given a state ID.
+func (s *Service) FinalityWithMeta(ctx context.Context, stateID string) (*api.Response[*apiv1.Finality], error) {
+	res, err := s.doReadCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		resp, err := client.(consensusclient.FinalityWithMetaProvider).FinalityWithMeta(ctx, stateID)
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(*api.Response[*apiv1.Finality]), nil
 }
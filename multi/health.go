@@ -0,0 +1,168 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// ClientState is the health state of a single client as seen by the multi service.
+type ClientState struct {
+	// Name is the name of the client.
+	Name string
+	// Address is the address of the client.
+	Address string
+	// Active is true if the client is currently in the active list.
+	Active bool
+	// NodeVersion is the version reported by the client at the last health check.
+	NodeVersion string
+	// Syncing is true if the client reported that it was syncing at the last health check.
+	Syncing bool
+	// HeadSlot is the head slot reported by the client at the last health check.
+	HeadSlot phase0.Slot
+	// SyncDistance is the sync distance reported by the client at the last health check.
+	SyncDistance phase0.Slot
+	// Optimistic is true if the client reported that it was optimistic at the last health check.
+	Optimistic bool
+	// ELOffline is true if the client reported that its execution client was offline at the last health check.
+	ELOffline bool
+	// LastChecked is the time of the last health check.
+	LastChecked time.Time
+	// LastError is the error returned by the last health check, if any.
+	LastError error
+	// Latency is the moving average of the time taken by the client to respond to calls.
+	Latency time.Duration
+}
+
+// ClientStateChangeHandlerFunc is the handler called when a client moves between
+// the active and inactive lists.
+type ClientStateChangeHandlerFunc func(ctx context.Context, state *ClientState)
+
+// ClientStates returns the health state of all clients known to the service,
+// active clients first.
+func (s *Service) ClientStates() []*ClientState {
+	s.clientsMu.RLock()
+	clients := make([]consensusclient.Service, 0, len(s.activeClients)+len(s.inactiveClients))
+	clients = append(clients, s.activeClients...)
+	clients = append(clients, s.inactiveClients...)
+	s.clientsMu.RUnlock()
+
+	s.clientStatesMu.RLock()
+	defer s.clientStatesMu.RUnlock()
+
+	res := make([]*ClientState, 0, len(clients))
+	for _, client := range clients {
+		state, exists := s.clientStates[client]
+		if !exists {
+			state = &ClientState{
+				Name:    client.Name(),
+				Address: client.Address(),
+			}
+		}
+		// Return a copy so that the caller cannot alter our internal state.
+		stateCopy := *state
+		stateCopy.Latency = s.latency(client)
+		res = append(res, &stateCopy)
+	}
+
+	return res
+}
+
+// checkHealth checks the health of a client, returning true if it is ready to
+// serve requests and false otherwise.  The client's state is updated as a result.
+func (s *Service) checkHealth(ctx context.Context, client consensusclient.Service) bool {
+	log := zerolog.Ctx(ctx)
+
+	state := &ClientState{
+		Name:        client.Name(),
+		Address:     client.Address(),
+		LastChecked: time.Now(),
+	}
+	healthy := true
+
+	if provider, isProvider := client.(consensusclient.NodeVersionProvider); isProvider {
+		nodeVersion, err := provider.NodeVersion(ctx)
+		if err != nil {
+			log.Debug().Str("provider", client.Address()).Err(err).Msg("Failed to obtain node version")
+			state.LastError = errors.Wrap(err, "failed to obtain node version")
+			healthy = false
+		} else {
+			state.NodeVersion = nodeVersion
+		}
+	}
+
+	provider, isProvider := client.(consensusclient.NodeSyncingProvider)
+	if !isProvider {
+		log.Debug().Str("provider", client.Address()).Msg("Client does not provide sync state")
+		state.LastError = errors.New("client does not provide sync state")
+		healthy = false
+	} else {
+		syncState, err := provider.NodeSyncing(ctx)
+		switch {
+		case err != nil:
+			log.Warn().Err(err).Msg("Failed to obtain sync state from node")
+			state.LastError = errors.Wrap(err, "failed to obtain sync state")
+			healthy = false
+		case syncState == nil:
+			state.LastError = errors.New("no sync state returned")
+			healthy = false
+		default:
+			state.Syncing = syncState.IsSyncing
+			state.HeadSlot = syncState.HeadSlot
+			state.SyncDistance = syncState.SyncDistance
+			state.Optimistic = syncState.IsOptimistic
+			state.ELOffline = syncState.ELOffline
+			if syncState.IsSyncing && !(syncState.HeadSlot == 0 && syncState.SyncDistance == 0) {
+				healthy = false
+			}
+		}
+	}
+
+	s.clientStatesMu.Lock()
+	if existing, exists := s.clientStates[client]; exists {
+		state.Active = existing.Active
+	}
+	s.clientStates[client] = state
+	s.clientStatesMu.Unlock()
+
+	return healthy
+}
+
+// setClientActive records the active flag for the client, calling the state change
+// handler if the flag has changed.
+func (s *Service) setClientActive(ctx context.Context, client consensusclient.Service, active bool) {
+	s.clientStatesMu.Lock()
+	state, exists := s.clientStates[client]
+	if !exists {
+		state = &ClientState{
+			Name:    client.Name(),
+			Address: client.Address(),
+		}
+		s.clientStates[client] = state
+	}
+	changed := state.Active != active
+	state.Active = active
+	stateCopy := *state
+	s.clientStatesMu.Unlock()
+
+	if changed && s.clientStateChangeHandler != nil {
+		s.clientStateChangeHandler(ctx, &stateCopy)
+	}
+}
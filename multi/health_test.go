@@ -0,0 +1,88 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientStates(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	// Client 1 is optimistic, which does not make it inactive.
+	client1.IsOptimistic = true
+	client1.ELOffline = true
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+	// Client 2 starts off syncing.
+	client2.SyncDistance = 10
+
+	var changesMu sync.Mutex
+	changes := make([]*multi.ClientState, 0)
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithHealthCheckInterval(10*time.Millisecond),
+		multi.WithClientStateChangeHandler(func(_ context.Context, state *multi.ClientState) {
+			changesMu.Lock()
+			changes = append(changes, state)
+			changesMu.Unlock()
+		}),
+		multi.WithClients([]consensusclient.Service{
+			client1,
+			client2,
+		}),
+	)
+	require.NoError(t, err)
+	multiClient := s.(*multi.Service)
+
+	states := multiClient.ClientStates()
+	require.Len(t, states, 2)
+	require.Equal(t, "mock 1", states[0].Address)
+	require.True(t, states[0].Active)
+	require.Equal(t, "mock", states[0].NodeVersion)
+	require.True(t, states[0].Optimistic)
+	require.True(t, states[0].ELOffline)
+	require.Equal(t, "mock 2", states[1].Address)
+	require.False(t, states[1].Active)
+	require.True(t, states[1].Syncing)
+
+	// Client 2 finishes syncing; should be promoted.
+	client2.SyncDistance = 0
+	require.Eventually(t, func() bool {
+		changesMu.Lock()
+		defer changesMu.Unlock()
+		return len(changes) > 0
+	}, time.Second, 10*time.Millisecond)
+
+	changesMu.Lock()
+	require.Equal(t, "mock 2", changes[0].Address)
+	require.True(t, changes[0].Active)
+	changesMu.Unlock()
+
+	for _, state := range multiClient.ClientStates() {
+		require.True(t, state.Active)
+	}
+}
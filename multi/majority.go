@@ -0,0 +1,178 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// ReadStrategy defines how read-only calls are carried out against the clients.
+type ReadStrategy int
+
+const (
+	// ReadStrategyFirst returns the result from the first active client that provides one.
+	ReadStrategyFirst ReadStrategy = iota
+	// ReadStrategyMajority queries multiple active clients and returns the result on
+	// which a majority of them agree.
+	ReadStrategyMajority
+	// ReadStrategyWeighted spreads calls across the active clients in proportion to their
+	// weights, as set with WithWeights, failing over to the other active clients on error.
+	ReadStrategyWeighted
+)
+
+// Disagreement contains the details of clients returning differing results for the same call.
+type Disagreement struct {
+	// Method is the name of the service method that was called.
+	Method string
+	// Results are the results returned by each client, keyed by client address.
+	Results map[string]interface{}
+	// Errors are the errors returned by each client, keyed by client address.
+	Errors map[string]error
+	// Majority is the result agreed by the majority of the clients; nil if there was no majority.
+	Majority interface{}
+}
+
+// DisagreementHandlerFunc is the handler called when clients disagree on the result of a call.
+type DisagreementHandlerFunc func(ctx context.Context, disagreement *Disagreement)
+
+// majorityResult is the result of a single client's call.
+type majorityResult struct {
+	client consensusclient.Service
+	res    interface{}
+	err    error
+}
+
+// doMajorityCall carries out a call on multiple active clients concurrently, and returns
+// the result on which a majority of the queried clients agree.
+// skip is the number of stack frames between the service method and this function.
+func (s *Service) doMajorityCall(ctx context.Context, call callFunc, errHandler errHandlerFunc, skip int) (interface{}, error) {
+	log := s.log.With().Logger()
+	ctx = log.WithContext(ctx)
+
+	method := callerName(skip + 1)
+	ctx, span := s.startCallSpan(ctx, skip+1)
+	defer span.End()
+
+	activeClients := s.prioritizedClients(PriorityFromContext(ctx), s.currentActiveClients(ctx))
+	if len(activeClients) == 0 {
+		err := errors.New("no active clients to which to make call")
+		spanError(span, err)
+		return nil, err
+	}
+	if s.majorityClients > 0 && len(activeClients) > s.majorityClients {
+		activeClients = activeClients[:s.majorityClients]
+	}
+
+	results := make([]*majorityResult, len(activeClients))
+	var wg sync.WaitGroup
+	for i := range activeClients {
+		wg.Add(1)
+		go func(i int, client consensusclient.Service) {
+			defer wg.Done()
+			res, err := s.callClient(ctx, call, client)
+			results[i] = &majorityResult{
+				client: client,
+				res:    res,
+				err:    err,
+			}
+		}(i, activeClients[i])
+	}
+	wg.Wait()
+
+	disagreement := &Disagreement{
+		Method:  method,
+		Results: make(map[string]interface{}),
+		Errors:  make(map[string]error),
+	}
+	values := make([]interface{}, 0, len(results))
+	votes := make([]int, 0, len(results))
+	var err error
+	for _, result := range results {
+		if result.err != nil {
+			failover := true
+			err = result.err
+			if errHandler != nil {
+				failover, err = errHandler(ctx, result.client, result.err)
+			}
+			if failover {
+				log.Debug().Str("client", result.client.Name()).Str("address", result.client.Address()).Err(err).Msg("Deactivating client on error")
+				s.deactivateClient(ctx, result.client)
+			}
+			disagreement.Errors[result.client.Address()] = err
+			continue
+		}
+		if result.res == nil {
+			// No response from this client.
+			continue
+		}
+		disagreement.Results[result.client.Address()] = result.res
+
+		found := false
+		for i := range values {
+			if reflect.DeepEqual(values[i], result.res) {
+				votes[i]++
+				found = true
+				break
+			}
+		}
+		if !found {
+			values = append(values, result.res)
+			votes = append(votes, 1)
+		}
+	}
+
+	if len(values) == 0 {
+		if err == nil {
+			err = errors.New("empty response")
+		}
+		spanError(span, err)
+		return nil, err
+	}
+
+	best := 0
+	for i := range votes {
+		if votes[i] > votes[best] {
+			best = i
+		}
+	}
+	if votes[best]*2 > len(results) {
+		disagreement.Majority = values[best]
+	}
+
+	if len(values) > 1 {
+		s.reportDisagreement(ctx, log, disagreement)
+	}
+
+	if disagreement.Majority == nil {
+		err := errors.New("no majority agreement between clients")
+		spanError(span, err)
+		return nil, err
+	}
+
+	return disagreement.Majority, nil
+}
+
+// reportDisagreement logs a disagreement and passes it to the handler, if present.
+func (s *Service) reportDisagreement(ctx context.Context, log zerolog.Logger, disagreement *Disagreement) {
+	log.Warn().Str("method", disagreement.Method).Int("results", len(disagreement.Results)).Bool("majority", disagreement.Majority != nil).Msg("Clients disagree on result")
+	if s.disagreementHandler != nil {
+		s.disagreementHandler(ctx, disagreement)
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// divergentClient is a mock client that reports a different finalized epoch.
+type divergentClient struct {
+	*mock.Service
+}
+
+func (c *divergentClient) Finality(ctx context.Context, stateID string) (*apiv1.Finality, error) {
+	finality, err := c.Service.Finality(ctx, stateID)
+	if err != nil {
+		return nil, err
+	}
+	finality.Finalized.Epoch++
+
+	return finality, nil
+}
+
+func newDivergentClient(ctx context.Context, t *testing.T, name string) consensusclient.Service {
+	t.Helper()
+
+	client, err := mock.New(ctx, mock.WithName(name))
+	require.NoError(t, err)
+
+	return &divergentClient{Service: client}
+}
+
+func newMockClient(ctx context.Context, t *testing.T, name string) consensusclient.Service {
+	t.Helper()
+
+	client, err := mock.New(ctx, mock.WithName(name))
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestMajorityParameters(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{newMockClient(ctx, t, "mock 1")}),
+		multi.WithReadStrategy(multi.ReadStrategy(99)),
+	)
+	require.EqualError(t, err, "problem with parameters: unknown read strategy")
+
+	_, err = multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{newMockClient(ctx, t, "mock 1")}),
+		multi.WithReadStrategy(multi.ReadStrategyMajority),
+		multi.WithMajorityClients(-1),
+	)
+	require.EqualError(t, err, "problem with parameters: majority clients cannot be negative")
+}
+
+func TestMajority(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		clients       []consensusclient.Service
+		majority      int
+		epoch         uint64
+		disagreements int
+		err           string
+	}{
+		{
+			name: "Unanimous",
+			clients: []consensusclient.Service{
+				newMockClient(ctx, t, "mock 1"),
+				newMockClient(ctx, t, "mock 2"),
+				newMockClient(ctx, t, "mock 3"),
+			},
+			epoch: 6,
+		},
+		{
+			name: "Majority",
+			clients: []consensusclient.Service{
+				newDivergentClient(ctx, t, "divergent 1"),
+				newMockClient(ctx, t, "mock 2"),
+				newMockClient(ctx, t, "mock 3"),
+			},
+			epoch:         6,
+			disagreements: 1,
+		},
+		{
+			name: "MajorityDivergent",
+			clients: []consensusclient.Service{
+				newDivergentClient(ctx, t, "divergent 1"),
+				newDivergentClient(ctx, t, "divergent 2"),
+				newMockClient(ctx, t, "mock 3"),
+			},
+			epoch:         7,
+			disagreements: 1,
+		},
+		{
+			name: "NoMajority",
+			clients: []consensusclient.Service{
+				newDivergentClient(ctx, t, "divergent 1"),
+				newMockClient(ctx, t, "mock 2"),
+			},
+			disagreements: 1,
+			err:           "no majority agreement between clients",
+		},
+		{
+			name: "LimitedClients",
+			clients: []consensusclient.Service{
+				newMockClient(ctx, t, "mock 1"),
+				newMockClient(ctx, t, "mock 2"),
+				newDivergentClient(ctx, t, "divergent 3"),
+			},
+			majority: 2,
+			epoch:    6,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var mu sync.Mutex
+			disagreements := make([]*multi.Disagreement, 0)
+			multiClient, err := multi.New(ctx,
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients(test.clients),
+				multi.WithReadStrategy(multi.ReadStrategyMajority),
+				multi.WithMajorityClients(test.majority),
+				multi.WithDisagreementHandler(func(_ context.Context, disagreement *multi.Disagreement) {
+					mu.Lock()
+					disagreements = append(disagreements, disagreement)
+					mu.Unlock()
+				}),
+			)
+			require.NoError(t, err)
+
+			finality, err := multiClient.(consensusclient.FinalityProvider).Finality(ctx, "head")
+			mu.Lock()
+			defer mu.Unlock()
+			require.Len(t, disagreements, test.disagreements)
+			for _, disagreement := range disagreements {
+				require.Equal(t, "Finality", disagreement.Method)
+			}
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.epoch, uint64(finality.Finalized.Epoch))
+		})
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"fmt"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+)
+
+// AddClient adds a client to the running service.
+// The client is health checked immediately and placed on the active or inactive list
+// accordingly.  Existing event subscriptions are extended to the new client.
+func (s *Service) AddClient(ctx context.Context, client consensusclient.Service) error {
+	if client == nil {
+		return errors.New("no client supplied")
+	}
+	log := s.log.With().Str("client", client.Address()).Logger()
+	ctx = log.WithContext(ctx)
+
+	healthy := s.checkHealth(ctx, client)
+
+	s.clientsMu.Lock()
+	for _, existing := range s.activeClients {
+		if existing.Address() == client.Address() {
+			s.clientsMu.Unlock()
+			return fmt.Errorf("client with address %s already present", client.Address())
+		}
+	}
+	for _, existing := range s.inactiveClients {
+		if existing.Address() == client.Address() {
+			s.clientsMu.Unlock()
+			return fmt.Errorf("client with address %s already present", client.Address())
+		}
+	}
+	if healthy {
+		s.activeClients = append(s.activeClients, client)
+		setProviderActiveMetric(ctx, client.Address(), "active")
+	} else {
+		s.inactiveClients = append(s.inactiveClients, client)
+		setProviderActiveMetric(ctx, client.Address(), "inactive")
+	}
+	setProvidersMetric(ctx, "active", len(s.activeClients))
+	setProvidersMetric(ctx, "inactive", len(s.inactiveClients))
+	s.clientsMu.Unlock()
+
+	s.setClientActive(ctx, client, healthy)
+	s.subscribeClient(client)
+	log.Trace().Bool("active", healthy).Msg("Client added")
+
+	return nil
+}
+
+// RemoveClient removes the client with the given address from the running service.
+// The client is no longer used for new calls as soon as this is called; the function then
+// waits for calls already in progress on the client to complete, or for the context to be done,
+// before returning.
+// The final client cannot be removed.
+func (s *Service) RemoveClient(ctx context.Context, address string) error {
+	log := s.log.With().Str("client", address).Logger()
+	ctx = log.WithContext(ctx)
+
+	s.clientsMu.Lock()
+	if len(s.activeClients)+len(s.inactiveClients) == 1 {
+		s.clientsMu.Unlock()
+		return errors.New("cannot remove the final client")
+	}
+	var client consensusclient.Service
+	activeClients := make([]consensusclient.Service, 0, len(s.activeClients))
+	for _, existing := range s.activeClients {
+		if existing.Address() == address {
+			client = existing
+			continue
+		}
+		activeClients = append(activeClients, existing)
+	}
+	inactiveClients := make([]consensusclient.Service, 0, len(s.inactiveClients))
+	for _, existing := range s.inactiveClients {
+		if existing.Address() == address {
+			client = existing
+			continue
+		}
+		inactiveClients = append(inactiveClients, existing)
+	}
+	if client == nil {
+		s.clientsMu.Unlock()
+		return fmt.Errorf("client with address %s not present", address)
+	}
+	s.activeClients = activeClients
+	s.inactiveClients = inactiveClients
+	setProvidersMetric(ctx, "active", len(s.activeClients))
+	setProvidersMetric(ctx, "inactive", len(s.inactiveClients))
+	s.clientsMu.Unlock()
+
+	s.clientStatesMu.Lock()
+	delete(s.clientStates, client)
+	s.clientStatesMu.Unlock()
+
+	s.latenciesMu.Lock()
+	delete(s.latencies, client)
+	s.latenciesMu.Unlock()
+
+	s.weightedMu.Lock()
+	delete(s.currentWeights, client)
+	s.weightedMu.Unlock()
+
+	s.proposalSourcesMu.Lock()
+	for slot, source := range s.proposalSources {
+		if source == client {
+			delete(s.proposalSources, slot)
+		}
+	}
+	s.proposalSourcesMu.Unlock()
+
+	log.Trace().Msg("Client removed; draining in-flight calls")
+	if err := s.drainClient(ctx, client); err != nil {
+		return err
+	}
+	log.Trace().Msg("Client drained")
+
+	return nil
+}
+
+// beginClientCall records the start of a call on a client.
+func (s *Service) beginClientCall(client consensusclient.Service) {
+	s.inFlightMu.Lock()
+	s.inFlight[client]++
+	s.inFlightMu.Unlock()
+}
+
+// endClientCall records the end of a call on a client, notifying any
+// waiters if the client has no further calls in progress.
+func (s *Service) endClientCall(client consensusclient.Service) {
+	s.inFlightMu.Lock()
+	defer s.inFlightMu.Unlock()
+
+	s.inFlight[client]--
+	if s.inFlight[client] > 0 {
+		return
+	}
+	delete(s.inFlight, client)
+	if drained, exists := s.drained[client]; exists {
+		close(drained)
+		delete(s.drained, client)
+	}
+}
+
+// drainClient waits until the client has no calls in progress, or the context is done.
+func (s *Service) drainClient(ctx context.Context, client consensusclient.Service) error {
+	s.inFlightMu.Lock()
+	if s.inFlight[client] == 0 {
+		s.inFlightMu.Unlock()
+		return nil
+	}
+	drained, exists := s.drained[client]
+	if !exists {
+		drained = make(chan struct{})
+		s.drained[client] = drained
+	}
+	s.inFlightMu.Unlock()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "client removed but calls still in progress")
+	}
+}
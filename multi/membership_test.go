@@ -0,0 +1,169 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/attestantio/go-eth2-client/testclients"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddClient(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+	client3, err := mock.New(ctx, mock.WithName("mock 3"))
+	require.NoError(t, err)
+	client3.SyncDistance = 10
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{
+			client1,
+		}),
+	)
+	require.NoError(t, err)
+	multiClient := s.(*multi.Service)
+
+	require.EqualError(t, multiClient.AddClient(ctx, nil), "no client supplied")
+	require.EqualError(t, multiClient.AddClient(ctx, client1), "client with address mock 1 already present")
+
+	require.NoError(t, multiClient.AddClient(ctx, client2))
+	require.NoError(t, multiClient.AddClient(ctx, client3))
+
+	states := multiClient.ClientStates()
+	require.Len(t, states, 3)
+	require.Equal(t, "mock 1", states[0].Address)
+	require.True(t, states[0].Active)
+	require.Equal(t, "mock 2", states[1].Address)
+	require.True(t, states[1].Active)
+	require.Equal(t, "mock 3", states[2].Address)
+	require.False(t, states[2].Active)
+}
+
+func TestRemoveClient(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{
+			client1,
+			client2,
+		}),
+	)
+	require.NoError(t, err)
+	multiClient := s.(*multi.Service)
+
+	require.EqualError(t, multiClient.RemoveClient(ctx, "unknown"), "client with address unknown not present")
+
+	require.NoError(t, multiClient.RemoveClient(ctx, "mock 1"))
+	states := multiClient.ClientStates()
+	require.Len(t, states, 1)
+	require.Equal(t, "mock 2", states[0].Address)
+	require.Equal(t, "mock 2", multiClient.Address())
+
+	require.EqualError(t, multiClient.RemoveClient(ctx, "mock 2"), "cannot remove the final client")
+
+	// Calls continue on the remaining client.
+	_, err = multiClient.Genesis(ctx)
+	require.NoError(t, err)
+}
+
+func TestRemoveClientDrains(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockClient1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client1, err := testclients.NewSleepy(ctx, 200*time.Millisecond, 210*time.Millisecond, mockClient1)
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{
+			client1,
+			client2,
+		}),
+	)
+	require.NoError(t, err)
+	multiClient := s.(*multi.Service)
+	require.Equal(t, client1.Address(), multiClient.Address())
+
+	var completed atomic.Bool
+	go func() {
+		_, err := multiClient.Genesis(ctx)
+		require.NoError(t, err)
+		completed.Store(true)
+	}()
+	// Allow the call to start on the sleepy client.
+	time.Sleep(50 * time.Millisecond)
+
+	require.NoError(t, multiClient.RemoveClient(ctx, client1.Address()))
+	require.True(t, completed.Load())
+	require.Equal(t, "mock 2", multiClient.Address())
+}
+
+func TestRemoveClientDrainTimeout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockClient1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client1, err := testclients.NewSleepy(ctx, 200*time.Millisecond, 210*time.Millisecond, mockClient1)
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{
+			client1,
+			client2,
+		}),
+	)
+	require.NoError(t, err)
+	multiClient := s.(*multi.Service)
+
+	go func() {
+		_, _ = multiClient.Genesis(ctx)
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	removeCtx, removeCancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer removeCancel()
+	require.EqualError(t, multiClient.RemoveClient(removeCtx, client1.Address()), "client removed but calls still in progress: context deadline exceeded")
+
+	// The client is removed regardless.
+	require.Len(t, multiClient.ClientStates(), 1)
+}
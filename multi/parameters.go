@@ -14,21 +14,40 @@
 package multi
 
 import (
+	"fmt"
 	"time"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/logging"
 	"github.com/attestantio/go-eth2-client/metrics"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type parameters struct {
-	logLevel     zerolog.Level
-	monitor      metrics.Service
-	clients      []consensusclient.Service
-	addresses    []string
-	timeout      time.Duration
-	extraHeaders map[string]string
+	logLevel       zerolog.Level
+	logger         logging.Logger
+	monitor        metrics.Service
+	clients        []consensusclient.Service
+	addresses      []string
+	timeout        time.Duration
+	extraHeaders   map[string]string
+	tracerProvider trace.TracerProvider
+	graffiti       []byte
+
+	healthCheckInterval      time.Duration
+	clientStateChangeHandler ClientStateChangeHandlerFunc
+
+	readStrategy        ReadStrategy
+	weights             map[string]int
+	majorityClients     int
+	disagreementHandler DisagreementHandlerFunc
+
+	submissionAffinity bool
+
+	criticalTimeout time.Duration
+	criticalLatency time.Duration
 }
 
 // Parameter is the interface for service parameters.
@@ -49,6 +68,14 @@ func WithLogLevel(logLevel zerolog.Level) Parameter {
 	})
 }
 
+// WithLogger sets a logger to which the module sends its logs, in place of the default zerolog logger.
+// If a logger is supplied the log level is obtained from it, and WithLogLevel is ignored.
+func WithLogger(logger logging.Logger) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logger = logger
+	})
+}
+
 // WithTimeout sets the timeout for client requests.
 func WithTimeout(timeout time.Duration) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -84,12 +111,105 @@ func WithExtraHeaders(headers map[string]string) Parameter {
 	})
 }
 
+// WithHealthCheckInterval sets the interval between health checks of the clients.
+func WithHealthCheckInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.healthCheckInterval = interval
+	})
+}
+
+// WithClientStateChangeHandler sets a handler that is called whenever a client
+// is promoted to or demoted from the active list.
+func WithClientStateChangeHandler(handler ClientStateChangeHandlerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.clientStateChangeHandler = handler
+	})
+}
+
+// WithTracerProvider sets a tracer provider, used to create spans for calls made through the
+// service.  The provider is also passed to clients created from addresses.
+func WithTracerProvider(provider trace.TracerProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.tracerProvider = provider
+	})
+}
+
+// WithGraffiti sets the default graffiti for block proposals, used when a proposal call does not supply its own.
+// Graffiti can be at most 32 bytes.
+func WithGraffiti(graffiti []byte) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.graffiti = graffiti
+	})
+}
+
+// WithReadStrategy sets the strategy used for read-only calls such as BeaconBlockRoot and Finality.
+// Defaults to ReadStrategyFirst.
+func WithReadStrategy(strategy ReadStrategy) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.readStrategy = strategy
+	})
+}
+
+// WithWeights sets the relative weights of clients, keyed by address, for the weighted read strategy.
+// Clients without a weight are given a weight of 1; clients with a weight of 0 are only used
+// when failing over from other clients.
+func WithWeights(weights map[string]int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.weights = weights
+	})
+}
+
+// WithMajorityClients sets the number of active clients queried by the majority read strategy.
+// A value of 0 queries all active clients.
+func WithMajorityClients(clients int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.majorityClients = clients
+	})
+}
+
+// WithDisagreementHandler sets a handler that is called whenever clients queried by the
+// majority read strategy return differing results.
+func WithDisagreementHandler(handler DisagreementHandlerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.disagreementHandler = handler
+	})
+}
+
+// WithSubmissionAffinity sets whether block submissions are sent to the client that provided the
+// proposal for the block's slot, falling back to broadcasting to all other active clients if that
+// submission fails.  This avoids submitting blocks to clients that may not yet have seen their parent.
+func WithSubmissionAffinity(enabled bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.submissionAffinity = enabled
+	})
+}
+
+// WithCriticalTimeout sets the time that each client is given to respond to a call tagged with
+// PriorityCritical before the call moves on to the next client.  Defaults to 1s.
+func WithCriticalTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.criticalTimeout = timeout
+	})
+}
+
+// WithCriticalLatency sets the latency above which a client is skipped for calls tagged with
+// PriorityCritical.  Latency is a moving average of the time taken by the client to respond to
+// calls.  Defaults to 500ms.
+func WithCriticalLatency(latency time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.criticalLatency = latency
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
-		logLevel:     zerolog.GlobalLevel(),
-		timeout:      2 * time.Second,
-		extraHeaders: make(map[string]string),
+		logLevel:            zerolog.GlobalLevel(),
+		timeout:             2 * time.Second,
+		extraHeaders:        make(map[string]string),
+		healthCheckInterval: 30 * time.Second,
+		criticalTimeout:     time.Second,
+		criticalLatency:     500 * time.Millisecond,
 	}
 	for _, p := range params {
 		if params != nil {
@@ -100,6 +220,31 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.timeout == 0 {
 		return nil, errors.New("no timeout specified")
 	}
+	if parameters.healthCheckInterval <= 0 {
+		return nil, errors.New("health check interval must be positive")
+	}
+	if len(parameters.graffiti) > 32 {
+		return nil, errors.New("graffiti cannot be longer than 32 bytes")
+	}
+	if parameters.readStrategy != ReadStrategyFirst &&
+		parameters.readStrategy != ReadStrategyMajority &&
+		parameters.readStrategy != ReadStrategyWeighted {
+		return nil, errors.New("unknown read strategy")
+	}
+	for address, weight := range parameters.weights {
+		if weight < 0 {
+			return nil, fmt.Errorf("weight for %s cannot be negative", address)
+		}
+	}
+	if parameters.majorityClients < 0 {
+		return nil, errors.New("majority clients cannot be negative")
+	}
+	if parameters.criticalTimeout <= 0 {
+		return nil, errors.New("critical timeout must be positive")
+	}
+	if parameters.criticalLatency <= 0 {
+		return nil, errors.New("critical latency must be positive")
+	}
 	if len(parameters.clients)+len(parameters.addresses) == 0 {
 		return nil, errors.New("no Ethereum 2 clients specified")
 	}
@@ -0,0 +1,101 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+)
+
+// Priority defines how urgently the result of a call is required.
+type Priority int
+
+const (
+	// PriorityNormal is the priority of calls that have not been tagged.
+	PriorityNormal Priority = iota
+	// PriorityCritical is for time-critical calls such as obtaining attestation data or block
+	// proposals.  Clients that have recently been slow to respond are skipped, and each client
+	// is given a short time to respond before the call moves on to the next.
+	PriorityCritical
+	// PriorityArchival is for calls that may legitimately take a long time or fail on some
+	// clients, such as queries for historical state.  Clients are not deactivated when such a
+	// call fails on them.
+	PriorityArchival
+)
+
+// latencyWeight is the weight given to the latest call when updating a client's latency.
+const latencyWeight = 0.2
+
+type priorityKey struct{}
+
+// WithPriority returns a context that tags calls made with it through the multi service
+// with the given priority.
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// PriorityFromContext returns the priority with which the context is tagged, or
+// PriorityNormal if it is not tagged.
+func PriorityFromContext(ctx context.Context) Priority {
+	priority, ok := ctx.Value(priorityKey{}).(Priority)
+	if !ok {
+		return PriorityNormal
+	}
+
+	return priority
+}
+
+// recordLatency updates the latency of the client with the duration of a call.
+func (s *Service) recordLatency(client consensusclient.Service, duration time.Duration) {
+	s.latenciesMu.Lock()
+	defer s.latenciesMu.Unlock()
+
+	latency, exists := s.latencies[client]
+	if !exists {
+		s.latencies[client] = duration
+		return
+	}
+	s.latencies[client] = time.Duration(float64(latency)*(1-latencyWeight) + float64(duration)*latencyWeight)
+}
+
+// latency returns the latency of the client, or 0 if not known.
+func (s *Service) latency(client consensusclient.Service) time.Duration {
+	s.latenciesMu.RLock()
+	defer s.latenciesMu.RUnlock()
+
+	return s.latencies[client]
+}
+
+// prioritizedClients returns the clients to use for a call of the given priority.
+// Critical calls skip clients whose latency is above the threshold, unless that would
+// leave no clients at all.
+func (s *Service) prioritizedClients(priority Priority, clients []consensusclient.Service) []consensusclient.Service {
+	if priority != PriorityCritical {
+		return clients
+	}
+
+	res := make([]consensusclient.Service, 0, len(clients))
+	for _, client := range clients {
+		if s.latency(client) <= s.criticalLatency {
+			res = append(res, client)
+		}
+	}
+	if len(res) == 0 {
+		return clients
+	}
+
+	return res
+}
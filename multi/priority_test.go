@@ -0,0 +1,142 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// genesisClient is a mock client with a configurable genesis call.
+type genesisClient struct {
+	*mock.Service
+	delay time.Duration
+	err   error
+}
+
+func newGenesisClient(ctx context.Context, t *testing.T, name string, delay time.Duration, err error) *genesisClient {
+	t.Helper()
+
+	client, mockErr := mock.New(ctx, mock.WithName(name))
+	require.NoError(t, mockErr)
+
+	return &genesisClient{
+		Service: client,
+		delay:   delay,
+		err:     err,
+	}
+}
+
+func (c *genesisClient) Genesis(ctx context.Context) (*apiv1.Genesis, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(c.delay):
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return c.Service.Genesis(ctx)
+}
+
+func TestPriorityFromContext(t *testing.T) {
+	ctx := context.Background()
+	require.Equal(t, multi.PriorityNormal, multi.PriorityFromContext(ctx))
+	require.Equal(t, multi.PriorityCritical, multi.PriorityFromContext(multi.WithPriority(ctx, multi.PriorityCritical)))
+	require.Equal(t, multi.PriorityArchival, multi.PriorityFromContext(multi.WithPriority(ctx, multi.PriorityArchival)))
+}
+
+func TestPriorityCritical(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	slowClient := newGenesisClient(ctx, t, "slow", 200*time.Millisecond, nil)
+	fastClient := newGenesisClient(ctx, t, "fast", 0, nil)
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithCriticalTimeout(50*time.Millisecond),
+		multi.WithCriticalLatency(20*time.Millisecond),
+		multi.WithClients([]consensusclient.Service{
+			slowClient,
+			fastClient,
+		}),
+	)
+	require.NoError(t, err)
+	multiClient := s.(*multi.Service)
+
+	// First critical call times out on the slow client and moves on to the fast client.
+	criticalCtx := multi.WithPriority(ctx, multi.PriorityCritical)
+	started := time.Now()
+	_, err = multiClient.Genesis(criticalCtx)
+	require.NoError(t, err)
+	require.Less(t, time.Since(started), 150*time.Millisecond)
+
+	// The slow client remains active.
+	states := multiClient.ClientStates()
+	require.Len(t, states, 2)
+	require.Equal(t, "slow", states[0].Address)
+	require.True(t, states[0].Active)
+	require.GreaterOrEqual(t, states[0].Latency, 50*time.Millisecond)
+
+	// Subsequent critical calls skip the slow client entirely.
+	started = time.Now()
+	_, err = multiClient.Genesis(criticalCtx)
+	require.NoError(t, err)
+	require.Less(t, time.Since(started), 40*time.Millisecond)
+
+	// Normal calls wait for the slow client.
+	started = time.Now()
+	_, err = multiClient.Genesis(ctx)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(started), 200*time.Millisecond)
+}
+
+func TestPriorityArchival(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	failingClient := newGenesisClient(ctx, t, "failing", 0, errors.New("pruned"))
+	goodClient := newGenesisClient(ctx, t, "good", 0, nil)
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{
+			failingClient,
+			goodClient,
+		}),
+	)
+	require.NoError(t, err)
+	multiClient := s.(*multi.Service)
+
+	// Archival call fails over without deactivating the failing client.
+	_, err = multiClient.Genesis(multi.WithPriority(ctx, multi.PriorityArchival))
+	require.NoError(t, err)
+	require.Equal(t, "failing", multiClient.Address())
+
+	// Normal call deactivates the failing client.
+	_, err = multiClient.Genesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "good", multiClient.Address())
+}
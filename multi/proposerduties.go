@@ -17,7 +17,8 @@ import (
 	"context"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
-	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -27,7 +28,7 @@ func (s *Service) ProposerDuties(ctx context.Context,
 	epoch phase0.Epoch,
 	validatorIndices []phase0.ValidatorIndex,
 ) (
-	[]*api.ProposerDuty,
+	[]*apiv1.ProposerDuty,
 	error,
 ) {
 	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
@@ -43,5 +44,30 @@ func (s *Service) ProposerDuties(ctx context.Context,
 	if res == nil {
 		return nil, nil
 	}
-	return res.([]*api.ProposerDuty), nil
+	return res.([]*apiv1.ProposerDuty), nil
+}
+
+// ProposerDutiesWithMeta obtains proposer duties and their metadata for the given epoch.
+// If validatorIndices is empty all duties are returned, otherwise only matching duties are returned.
+func (s *Service) ProposerDutiesWithMeta(ctx context.Context,
+	epoch phase0.Epoch,
+	validatorIndices []phase0.ValidatorIndex,
+) (
+	*api.Response[[]*apiv1.ProposerDuty],
+	error,
+) {
+	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		resp, err := client.(consensusclient.ProposerDutiesWithMetaProvider).ProposerDutiesWithMeta(ctx, epoch, validatorIndices)
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(*api.Response[[]*apiv1.ProposerDuty]), nil
 }
@@ -16,12 +16,16 @@ package multi
 import (
 	"context"
 	"sync"
+	"time"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/logging"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Service handles multiple Ethereum 2 clients.
@@ -31,6 +35,35 @@ type Service struct {
 	clientsMu       sync.RWMutex
 	activeClients   []consensusclient.Service
 	inactiveClients []consensusclient.Service
+
+	inFlightMu sync.Mutex
+	inFlight   map[consensusclient.Service]int
+	drained    map[consensusclient.Service]chan struct{}
+
+	subscriptionsMu sync.Mutex
+	subscriptions   []*eventSubscription
+
+	clientStatesMu           sync.RWMutex
+	clientStates             map[consensusclient.Service]*ClientState
+	clientStateChangeHandler ClientStateChangeHandlerFunc
+	healthCheckInterval      time.Duration
+	tracer                   trace.Tracer
+	graffiti                 []byte
+	readStrategy             ReadStrategy
+	weights                  map[string]int
+	weightedMu               sync.Mutex
+	currentWeights           map[consensusclient.Service]int
+	majorityClients          int
+	disagreementHandler      DisagreementHandlerFunc
+
+	submissionAffinity bool
+	proposalSourcesMu  sync.RWMutex
+	proposalSources    map[phase0.Slot]consensusclient.Service
+
+	criticalTimeout time.Duration
+	criticalLatency time.Duration
+	latenciesMu     sync.RWMutex
+	latencies       map[consensusclient.Service]time.Duration
 }
 
 // New creates a new Ethereum 2 client with multiple endpoints.
@@ -45,7 +78,9 @@ func New(ctx context.Context, params ...Parameter) (consensusclient.Service, err
 
 	// Set logging.
 	log := zerologger.With().Str("service", "fetcher").Str("impl", "multi").Logger()
-	if parameters.logLevel != log.GetLevel() {
+	if parameters.logger != nil {
+		log = logging.Zerolog(parameters.logger).With().Str("service", "fetcher").Str("impl", "multi").Logger()
+	} else if parameters.logLevel != log.GetLevel() {
 		log = log.Level(parameters.logLevel)
 	}
 	ctx = log.WithContext(ctx)
@@ -56,11 +91,34 @@ func New(ctx context.Context, params ...Parameter) (consensusclient.Service, err
 		}
 	}
 
+	s := &Service{
+		log:                      log,
+		inFlight:                 make(map[consensusclient.Service]int),
+		drained:                  make(map[consensusclient.Service]chan struct{}),
+		clientStates:             make(map[consensusclient.Service]*ClientState),
+		clientStateChangeHandler: parameters.clientStateChangeHandler,
+		healthCheckInterval:      parameters.healthCheckInterval,
+		graffiti:                 parameters.graffiti,
+		readStrategy:             parameters.readStrategy,
+		weights:                  parameters.weights,
+		currentWeights:           make(map[consensusclient.Service]int),
+		majorityClients:          parameters.majorityClients,
+		disagreementHandler:      parameters.disagreementHandler,
+		submissionAffinity:       parameters.submissionAffinity,
+		proposalSources:          make(map[phase0.Slot]consensusclient.Service),
+		criticalTimeout:          parameters.criticalTimeout,
+		criticalLatency:          parameters.criticalLatency,
+		latencies:                make(map[consensusclient.Service]time.Duration),
+	}
+	if parameters.tracerProvider != nil {
+		s.tracer = parameters.tracerProvider.Tracer(tracerName)
+	}
+
 	// Check the state of each client and put it in an active or inactive list, accordingly.
 	activeClients := make([]consensusclient.Service, 0, len(parameters.clients))
 	inactiveClients := make([]consensusclient.Service, 0, len(parameters.clients))
 	for _, client := range parameters.clients {
-		if ping(ctx, client) {
+		if s.checkHealth(ctx, client) {
 			activeClients = append(activeClients, client)
 		} else {
 			inactiveClients = append(inactiveClients, client)
@@ -69,15 +127,17 @@ func New(ctx context.Context, params ...Parameter) (consensusclient.Service, err
 	for _, address := range parameters.addresses {
 		client, err := http.New(ctx,
 			http.WithLogLevel(parameters.logLevel),
+			http.WithLogger(parameters.logger),
 			http.WithTimeout(parameters.timeout),
 			http.WithAddress(address),
 			http.WithExtraHeaders(parameters.extraHeaders),
+			http.WithTracerProvider(parameters.tracerProvider),
 		)
 		if err != nil {
 			log.Error().Str("provider", address).Msg("Provider not present; dropping from rotation")
 			continue
 		}
-		if ping(ctx, client) {
+		if s.checkHealth(ctx, client) {
 			activeClients = append(activeClients, client)
 			setProviderActiveMetric(ctx, client.Address(), "active")
 		} else {
@@ -92,10 +152,10 @@ func New(ctx context.Context, params ...Parameter) (consensusclient.Service, err
 	setProvidersMetric(ctx, "active", len(activeClients))
 	setProvidersMetric(ctx, "inactive", len(inactiveClients))
 
-	s := &Service{
-		log:             log,
-		activeClients:   activeClients,
-		inactiveClients: inactiveClients,
+	s.activeClients = activeClients
+	s.inactiveClients = inactiveClients
+	for _, client := range activeClients {
+		s.clientStates[client].Active = true
 	}
 
 	// Kick off monitor.
@@ -16,6 +16,7 @@ package multi_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/mock"
@@ -59,6 +60,39 @@ func TestService(t *testing.T) {
 			},
 			err: "No providers active, cannot proceed",
 		},
+		{
+			name: "CriticalTimeoutZero",
+			params: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithCriticalTimeout(0),
+				multi.WithClients([]client.Service{
+					consensusclient1,
+				}),
+			},
+			err: "problem with parameters: critical timeout must be positive",
+		},
+		{
+			name: "CriticalLatencyZero",
+			params: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithCriticalLatency(0),
+				multi.WithClients([]client.Service{
+					consensusclient1,
+				}),
+			},
+			err: "problem with parameters: critical latency must be positive",
+		},
+		{
+			name: "HealthCheckIntervalNegative",
+			params: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithHealthCheckInterval(-time.Second),
+				multi.WithClients([]client.Service{
+					consensusclient1,
+				}),
+			},
+			err: "problem with parameters: health check interval must be positive",
+		},
 		{
 			name: "Good",
 			params: []multi.Parameter{
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec"
 )
 
@@ -43,3 +44,27 @@ func (s *Service) SignedBeaconBlock(ctx context.Context,
 	}
 	return res.(*spec.VersionedSignedBeaconBlock), nil
 }
+
+// SignedBeaconBlockWithMeta fetches a signed beacon block and its metadata given a block ID.
+// N.B if a signed beacon block for the block ID is not available this will return nil without an error.
+func (s *Service) SignedBeaconBlockWithMeta(ctx context.Context,
+	blockID string,
+) (
+	*api.Response[*spec.VersionedSignedBeaconBlock],
+	error,
+) {
+	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		resp, err := client.(consensusclient.SignedBeaconBlockWithMetaProvider).SignedBeaconBlockWithMeta(ctx, blockID)
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(*api.Response[*spec.VersionedSignedBeaconBlock]), nil
+}
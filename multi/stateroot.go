@@ -17,12 +17,13 @@ import (
 	"context"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
 // BeaconStateRoot fetches a beacon state root given a state ID.
 func (s *Service) BeaconStateRoot(ctx context.Context, stateID string) (*phase0.Root, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doReadCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		stateRoot, err := client.(consensusclient.BeaconStateRootProvider).BeaconStateRoot(ctx, stateID)
 		if err != nil {
 			return nil, err
@@ -37,3 +38,21 @@ func (s *Service) BeaconStateRoot(ctx context.Context, stateID string) (*phase0.
 	}
 	return res.(*phase0.Root), nil
 }
+
+// StateRoot fetches a beacon state root and its metadata given a state ID.
+func (s *Service) StateRoot(ctx context.Context, stateID string) (*api.Response[*phase0.Root], error) {
+	res, err := s.doReadCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		stateRoot, err := client.(consensusclient.StateRootProvider).StateRoot(ctx, stateID)
+		if err != nil {
+			return nil, err
+		}
+		return stateRoot, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(*api.Response[*phase0.Root]), nil
+}
@@ -0,0 +1,35 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// SubmitAttestationsV2 submits attestations to the v2 endpoint.
+func (s *Service) SubmitAttestationsV2(ctx context.Context,
+	attestations []*phase0.Attestation,
+) error {
+	_, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		err := client.(consensusclient.AttestationsSubmitterV2).SubmitAttestationsV2(ctx, attestations)
+		if err != nil {
+			return nil, err
+		}
+		return true, nil
+	}, s.attestationSubmissionErrorHandler)
+	return err
+}
@@ -22,12 +22,11 @@ import (
 
 // SubmitBeaconBlock submits a beacon block.
 func (s *Service) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSignedBeaconBlock) error {
-	_, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	return s.doSubmissionCall(ctx, block.Slot, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		err := client.(consensusclient.BeaconBlockSubmitter).SubmitBeaconBlock(ctx, block)
 		if err != nil {
 			return nil, err
 		}
 		return true, nil
-	}, nil)
-	return err
+	})
 }
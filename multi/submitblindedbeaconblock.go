@@ -22,12 +22,11 @@ import (
 
 // SubmitBlindedBeaconBlock submits a blinded beacon block.
 func (s *Service) SubmitBlindedBeaconBlock(ctx context.Context, block *api.VersionedSignedBlindedBeaconBlock) error {
-	_, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	return s.doSubmissionCall(ctx, block.Slot, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		err := client.(consensusclient.BlindedBeaconBlockSubmitter).SubmitBlindedBeaconBlock(ctx, block)
 		if err != nil {
 			return nil, err
 		}
 		return true, nil
-	}, nil)
-	return err
+	})
 }
@@ -0,0 +1,59 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// SubmitBlindedBeaconBlockV2 submits a blinded beacon block with broadcast validation.
+// The execution payload is returned if it was revealed by any of the clients to which the
+// block was submitted.
+func (s *Service) SubmitBlindedBeaconBlockV2(ctx context.Context,
+	block *api.VersionedSignedBlindedBeaconBlock,
+	broadcastValidation apiv1.BroadcastValidation,
+) (
+	*api.VersionedExecutionPayload,
+	error,
+) {
+	var payloadMu sync.Mutex
+	var payload *api.VersionedExecutionPayload
+	err := s.doSubmissionCall(ctx, block.Slot, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		clientPayload, err := client.(consensusclient.BlindedBeaconBlockSubmitterV2).SubmitBlindedBeaconBlockV2(ctx, block, broadcastValidation)
+		if err != nil {
+			return nil, err
+		}
+		if clientPayload != nil {
+			payloadMu.Lock()
+			if payload == nil {
+				payload = clientPayload
+			}
+			payloadMu.Unlock()
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	payloadMu.Lock()
+	defer payloadMu.Unlock()
+
+	return payload, nil
+}
@@ -0,0 +1,45 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SubmitBlockContents submits a block along with its blob sidecars, with broadcast validation.
+func (s *Service) SubmitBlockContents(ctx context.Context,
+	contents *apiv1deneb.SignedBlockContents,
+	broadcastValidation apiv1.BroadcastValidation,
+) error {
+	slotFn := func() (phase0.Slot, error) {
+		if contents == nil || contents.SignedBlock == nil || contents.SignedBlock.Message == nil {
+			return 0, errors.New("no block supplied")
+		}
+		return contents.SignedBlock.Message.Slot, nil
+	}
+
+	return s.doSubmissionCall(ctx, slotFn, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		err := client.(consensusclient.BlockContentsSubmitter).SubmitBlockContents(ctx, contents, broadcastValidation)
+		if err != nil {
+			return nil, err
+		}
+		return true, nil
+	})
+}
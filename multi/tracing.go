@@ -0,0 +1,71 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"runtime"
+	"strings"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the name of the tracer used by the service.
+const tracerName = "github.com/attestantio/go-eth2-client/multi"
+
+// startCallSpan starts a span for a call through the service, named after the service method
+// that made the call.  skip is the number of stack frames between the service method and this
+// function.  If the service has no tracer the context is returned unaltered along with a
+// non-recording span.
+func (s *Service) startCallSpan(ctx context.Context, skip int) (context.Context, trace.Span) {
+	if s.tracer == nil {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+
+	return s.tracer.Start(ctx, callerName(skip+1))
+}
+
+// callerName returns the name of the function skip stack frames above the caller of this
+// function, without its package or receiver.
+func callerName(skip int) string {
+	name := "call"
+	if pc, _, _, ok := runtime.Caller(skip + 1); ok {
+		if fn := runtime.FuncForPC(pc); fn != nil {
+			name = fn.Name()[strings.LastIndex(fn.Name(), ".")+1:]
+		}
+	}
+
+	return name
+}
+
+// startClientSpan starts a span for an individual client's attempt at a call.
+func (s *Service) startClientSpan(ctx context.Context, client consensusclient.Service) (context.Context, trace.Span) {
+	if s.tracer == nil {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+
+	return s.tracer.Start(ctx, "client", trace.WithAttributes(
+		attribute.String("client", client.Name()),
+		attribute.String("address", client.Address()),
+	))
+}
+
+// spanError records an error against the span.
+func spanError(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,69 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/attestantio/go-eth2-client/testclients"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracing(t *testing.T) {
+	ctx := context.Background()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	erroringClient1, err := testclients.NewErroring(ctx, 1, client1)
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+
+	recorder := tracetest.NewSpanRecorder()
+	multiClient, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{
+			erroringClient1,
+			client2,
+		}),
+		multi.WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))),
+	)
+	require.NoError(t, err)
+
+	_, err = multiClient.(consensusclient.ForkProvider).Fork(ctx, "1")
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.NotEmpty(t, spans)
+	callSpan := spans[len(spans)-1]
+	require.Equal(t, "Fork", callSpan.Name())
+
+	// Each client attempt has its own span under the call span.
+	clientSpans := make([]sdktrace.ReadOnlySpan, 0)
+	for _, span := range spans {
+		if span.Parent().SpanID() == callSpan.SpanContext().SpanID() {
+			clientSpans = append(clientSpans, span)
+		}
+	}
+	require.NotEmpty(t, clientSpans)
+	require.Equal(t, codes.Unset, clientSpans[len(clientSpans)-1].Status().Code)
+}
@@ -0,0 +1,67 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// OnHead subscribes to head events.
+func (s *Service) OnHead(ctx context.Context, bufferSize int) (*apiv1.Subscription[*apiv1.HeadEvent], error) {
+	return apiv1.Subscribe[*apiv1.HeadEvent](ctx, s.events, "head", bufferSize)
+}
+
+// OnBlock subscribes to block events.
+func (s *Service) OnBlock(ctx context.Context, bufferSize int) (*apiv1.Subscription[*apiv1.BlockEvent], error) {
+	return apiv1.Subscribe[*apiv1.BlockEvent](ctx, s.events, "block", bufferSize)
+}
+
+// OnAttestation subscribes to attestation events.
+func (s *Service) OnAttestation(ctx context.Context, bufferSize int) (*apiv1.Subscription[*phase0.Attestation], error) {
+	return apiv1.Subscribe[*phase0.Attestation](ctx, s.events, "attestation", bufferSize)
+}
+
+// OnVoluntaryExit subscribes to voluntary exit events.
+func (s *Service) OnVoluntaryExit(ctx context.Context, bufferSize int) (*apiv1.Subscription[*phase0.SignedVoluntaryExit], error) {
+	return apiv1.Subscribe[*phase0.SignedVoluntaryExit](ctx, s.events, "voluntary_exit", bufferSize)
+}
+
+// OnFinalizedCheckpoint subscribes to finalized checkpoint events.
+func (s *Service) OnFinalizedCheckpoint(ctx context.Context, bufferSize int) (*apiv1.Subscription[*apiv1.FinalizedCheckpointEvent], error) {
+	return apiv1.Subscribe[*apiv1.FinalizedCheckpointEvent](ctx, s.events, "finalized_checkpoint", bufferSize)
+}
+
+// OnChainReorg subscribes to chain reorganisation events.
+func (s *Service) OnChainReorg(ctx context.Context, bufferSize int) (*apiv1.Subscription[*apiv1.ChainReorgEvent], error) {
+	return apiv1.Subscribe[*apiv1.ChainReorgEvent](ctx, s.events, "chain_reorg", bufferSize)
+}
+
+// OnContributionAndProof subscribes to sync committee contribution and proof events.
+func (s *Service) OnContributionAndProof(ctx context.Context, bufferSize int) (*apiv1.Subscription[*altair.SignedContributionAndProof], error) {
+	return apiv1.Subscribe[*altair.SignedContributionAndProof](ctx, s.events, "contribution_and_proof", bufferSize)
+}
+
+// OnPayloadAttributes subscribes to payload attributes events.
+func (s *Service) OnPayloadAttributes(ctx context.Context, bufferSize int) (*apiv1.Subscription[*apiv1.PayloadAttributesEvent], error) {
+	return apiv1.Subscribe[*apiv1.PayloadAttributesEvent](ctx, s.events, "payload_attributes", bufferSize)
+}
+
+// events feeds events to the handler, for use by typed subscriptions.
+func (s *Service) events(ctx context.Context, topics []string, handler func(*apiv1.Event)) error {
+	return s.Events(ctx, topics, handler)
+}
@@ -25,6 +25,8 @@ import (
 // Validators provides the validators, with their balance and status, for a given state.
 // stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
 // validatorIndices is a list of validators to restrict the returned values.  If no validators are supplied no filter will be applied.
+// validatorStates is a list of validator states to restrict the returned values.  If no validator states are supplied no filter
+// will be applied.
 func (s *Service) Validators(ctx context.Context,
 	stateID string,
 	validatorIndices []phase0.ValidatorIndex,
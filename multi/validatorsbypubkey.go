@@ -25,15 +25,18 @@ import (
 // stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
 // validatorPubKeys is a list of validator public keys to restrict the returned values.  If no validators public keys are
 // supplied no filter will be applied.
+// validatorStates is a list of validator states to restrict the returned values.  If no validator states are supplied no filter
+// will be applied.
 func (s *Service) ValidatorsByPubKey(ctx context.Context,
 	stateID string,
 	validatorPubKeys []phase0.BLSPubKey,
+	validatorStates []api.ValidatorState,
 ) (
 	map[phase0.ValidatorIndex]*api.Validator,
 	error,
 ) {
 	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
-		block, err := client.(consensusclient.ValidatorsProvider).ValidatorsByPubKey(ctx, stateID, validatorPubKeys)
+		block, err := client.(consensusclient.ValidatorsProvider).ValidatorsByPubKey(ctx, stateID, validatorPubKeys, validatorStates)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,61 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	consensusclient "github.com/attestantio/go-eth2-client"
+)
+
+// defaultWeight is the weight of a client that has not been given one.
+const defaultWeight = 1
+
+// weight returns the weight of the client.
+func (s *Service) weight(client consensusclient.Service) int {
+	weight, exists := s.weights[client.Address()]
+	if !exists {
+		return defaultWeight
+	}
+
+	return weight
+}
+
+// weightedClients returns the clients ordered for a weighted call.  The first client is selected
+// by smooth weighted round-robin, so that over time each client is selected in proportion to its
+// weight; the remaining clients follow in their original order to provide failover.
+func (s *Service) weightedClients(clients []consensusclient.Service) []consensusclient.Service {
+	if len(clients) < 2 {
+		return clients
+	}
+
+	s.weightedMu.Lock()
+	selected := -1
+	total := 0
+	for i, client := range clients {
+		weight := s.weight(client)
+		total += weight
+		s.currentWeights[client] += weight
+		if selected == -1 || s.currentWeights[client] > s.currentWeights[clients[selected]] {
+			selected = i
+		}
+	}
+	s.currentWeights[clients[selected]] -= total
+	s.weightedMu.Unlock()
+
+	res := make([]consensusclient.Service, 0, len(clients))
+	res = append(res, clients[selected])
+	res = append(res, clients[:selected]...)
+	res = append(res, clients[selected+1:]...)
+
+	return res
+}
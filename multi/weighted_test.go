@@ -0,0 +1,119 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// countingClient is a mock client that counts finality calls, optionally failing them.
+type countingClient struct {
+	*mock.Service
+	calls atomic.Int64
+	fail  bool
+}
+
+func (c *countingClient) Finality(ctx context.Context, stateID string) (*apiv1.Finality, error) {
+	c.calls.Add(1)
+	if c.fail {
+		return nil, errors.New("failed")
+	}
+
+	return c.Service.Finality(ctx, stateID)
+}
+
+func newCountingClient(ctx context.Context, t *testing.T, name string) *countingClient {
+	t.Helper()
+
+	client, err := mock.New(ctx, mock.WithName(name))
+	require.NoError(t, err)
+
+	return &countingClient{Service: client}
+}
+
+func TestWeightsParameters(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{newMockClient(ctx, t, "mock 1")}),
+		multi.WithReadStrategy(multi.ReadStrategyWeighted),
+		multi.WithWeights(map[string]int{"mock 1": -1}),
+	)
+	require.EqualError(t, err, "problem with parameters: weight for mock 1 cannot be negative")
+}
+
+func TestWeighted(t *testing.T) {
+	ctx := context.Background()
+
+	client1 := newCountingClient(ctx, t, "mock 1")
+	client2 := newCountingClient(ctx, t, "mock 2")
+	client3 := newCountingClient(ctx, t, "mock 3")
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{client1, client2, client3}),
+		multi.WithReadStrategy(multi.ReadStrategyWeighted),
+		multi.WithWeights(map[string]int{
+			"mock 1": 1,
+			"mock 2": 3,
+			"mock 3": 0,
+		}),
+	)
+	require.NoError(t, err)
+
+	for i := 0; i < 400; i++ {
+		_, err := s.(consensusclient.FinalityProvider).Finality(ctx, "head")
+		require.NoError(t, err)
+	}
+	require.Equal(t, int64(100), client1.calls.Load())
+	require.Equal(t, int64(300), client2.calls.Load())
+	require.Equal(t, int64(0), client3.calls.Load())
+}
+
+func TestWeightedFailover(t *testing.T) {
+	ctx := context.Background()
+
+	client1 := newCountingClient(ctx, t, "mock 1")
+	client2 := newCountingClient(ctx, t, "mock 2")
+	client2.fail = true
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{client1, client2}),
+		multi.WithReadStrategy(multi.ReadStrategyWeighted),
+		multi.WithWeights(map[string]int{
+			"mock 2": 10,
+		}),
+	)
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		_, err := s.(consensusclient.FinalityProvider).Finality(ctx, "head")
+		require.NoError(t, err)
+	}
+	// The failing client is tried once and deactivated, after which all calls go to client 1.
+	require.Equal(t, int64(1), client2.calls.Load())
+	require.Equal(t, int64(10), client1.calls.Load())
+}
@@ -20,6 +20,7 @@ import (
 	api "github.com/attestantio/go-eth2-client/api"
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/capella"
@@ -115,12 +116,32 @@ type SignedBeaconBlockProvider interface {
 	SignedBeaconBlock(ctx context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error)
 }
 
+// SignedBeaconBlockWithMetaProvider is the interface for providing beacon blocks with their metadata.
+type SignedBeaconBlockWithMetaProvider interface {
+	// SignedBeaconBlockWithMeta fetches a signed beacon block and its metadata given a block ID.
+	SignedBeaconBlockWithMeta(ctx context.Context, blockID string) (*api.Response[*spec.VersionedSignedBeaconBlock], error)
+}
+
 // BeaconBlockBlobsProvider is the interface for providing blobs for a given beacon block.
 type BeaconBlockBlobsProvider interface {
 	// BeaconBlockBlobs fetches the blobs given a block ID.
 	BeaconBlockBlobs(ctx context.Context, blockID string) ([]*deneb.BlobSidecar, error)
 }
 
+// BlobSidecarsProvider is the interface for providing blob sidecars for a given beacon block.
+type BlobSidecarsProvider interface {
+	// BlobSidecars fetches the blob sidecars given a block ID, along with their metadata.
+	// If indices is empty all blob sidecars for the block are returned, otherwise only those
+	// with the given indices.
+	BlobSidecars(ctx context.Context,
+		blockID string,
+		indices []deneb.BlobIndex,
+	) (
+		*api.Response[*api.VersionedBlobSidecars],
+		error,
+	)
+}
+
 // BeaconCommitteesProvider is the interface for providing beacon committees.
 type BeaconCommitteesProvider interface {
 	// BeaconCommittees fetches all beacon committees for the epoch at the given state.
@@ -158,6 +179,25 @@ type AggregateAttestationsSubmitter interface {
 	SubmitAggregateAttestations(ctx context.Context, aggregateAndProofs []*phase0.SignedAggregateAndProof) error
 }
 
+// AggregateAttestationProviderV2 is the interface for providing versioned aggregate attestations.
+type AggregateAttestationProviderV2 interface {
+	// AggregateAttestationV2 fetches the aggregate attestation for the given attestation data root and committee.
+	AggregateAttestationV2(ctx context.Context,
+		slot phase0.Slot,
+		attestationDataRoot phase0.Root,
+		committeeIndex phase0.CommitteeIndex,
+	) (
+		*spec.VersionedAttestation,
+		error,
+	)
+}
+
+// AggregateAttestationsSubmitterV2 is the interface for submitting versioned aggregate attestations.
+type AggregateAttestationsSubmitterV2 interface {
+	// SubmitAggregateAttestationsV2 submits versioned aggregate attestations.
+	SubmitAggregateAttestationsV2(ctx context.Context, aggregateAndProofs []*spec.VersionedSignedAggregateAndProof) error
+}
+
 // AttestationDataProvider is the interface for providing attestation data.
 type AttestationDataProvider interface {
 	// AttestationData fetches the attestation data for the given slot and committee index.
@@ -176,6 +216,20 @@ type AttestationsSubmitter interface {
 	SubmitAttestations(ctx context.Context, attestations []*phase0.Attestation) error
 }
 
+// AttestationsSubmitterV2 is the interface for submitting attestations to the v2 endpoint.
+type AttestationsSubmitterV2 interface {
+	// SubmitAttestationsV2 submits attestations, with the consensus version selected from the
+	// chain fork at their slot.
+	SubmitAttestationsV2(ctx context.Context, attestations []*phase0.Attestation) error
+}
+
+// AttesterSlashingSubmitterV2 is the interface for submitting attester slashings to the v2 endpoint.
+type AttesterSlashingSubmitterV2 interface {
+	// SubmitAttesterSlashingV2 submits an attester slashing, with the consensus version selected
+	// from the chain fork at the slot of its first attestation.
+	SubmitAttesterSlashingV2(ctx context.Context, slashing *phase0.AttesterSlashing) error
+}
+
 // AttesterDutiesProvider is the interface for providing attester duties.
 type AttesterDutiesProvider interface {
 	// AttesterDuties obtains attester duties.
@@ -183,6 +237,20 @@ type AttesterDutiesProvider interface {
 	AttesterDuties(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.AttesterDuty, error)
 }
 
+// AttesterDutiesWithMetaProvider is the interface for providing attester duties with their metadata.
+type AttesterDutiesWithMetaProvider interface {
+	// AttesterDutiesWithMeta obtains attester duties and their metadata.
+	// The metadata contains the dependent root of the duties, which can be checked against
+	// later head events to find out if the duties are still valid.
+	AttesterDutiesWithMeta(ctx context.Context,
+		epoch phase0.Epoch,
+		validatorIndices []phase0.ValidatorIndex,
+	) (
+		*api.Response[[]*apiv1.AttesterDuty],
+		error,
+	)
+}
+
 // SyncCommitteeDutiesProvider is the interface for providing sync committee duties.
 type SyncCommitteeDutiesProvider interface {
 	// SyncCommitteeDuties obtains sync committee duties.
@@ -226,6 +294,28 @@ type BeaconBlockHeadersProvider interface {
 	BeaconBlockHeader(ctx context.Context, blockID string) (*apiv1.BeaconBlockHeader, error)
 }
 
+// BeaconBlockHeadersByFilterProvider is the interface for providing beacon block headers matching filters.
+type BeaconBlockHeadersByFilterProvider interface {
+	// BeaconBlockHeaders provides the block headers matching the given filters.
+	// If slot is supplied only headers for that slot are returned; if parentRoot is supplied only
+	// headers of the children of that block are returned.  If neither is supplied the header of the
+	// canonical head is returned.
+	BeaconBlockHeaders(ctx context.Context, slot *phase0.Slot, parentRoot *phase0.Root) ([]*apiv1.BeaconBlockHeader, error)
+}
+
+// BeaconBlockHeadersWithMetaProvider is the interface for providing beacon block headers with their metadata.
+type BeaconBlockHeadersWithMetaProvider interface {
+	// BeaconBlockHeaderWithMeta provides the block header and its metadata of a given block ID.
+	BeaconBlockHeaderWithMeta(ctx context.Context, blockID string) (*api.Response[*apiv1.BeaconBlockHeader], error)
+}
+
+// BeaconBlockHeadersBySlotRangeProvider is the interface for providing beacon block headers for a range of slots.
+type BeaconBlockHeadersBySlotRangeProvider interface {
+	// BeaconBlockHeadersBySlotRange provides the canonical block headers for the slots from startSlot to endSlot inclusive.
+	// The result contains an entry for every slot in the range, in slot order; slots without a block are marked as missed.
+	BeaconBlockHeadersBySlotRange(ctx context.Context, startSlot phase0.Slot, endSlot phase0.Slot) ([]*apiv1.SlotBeaconBlockHeader, error)
+}
+
 // BeaconBlockProposalProvider is the interface for providing beacon block proposals.
 type BeaconBlockProposalProvider interface {
 	// BeaconBlockProposal fetches a proposed beacon block for signing.
@@ -244,6 +334,26 @@ type BeaconBlockSubmitter interface {
 	SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSignedBeaconBlock) error
 }
 
+// BeaconBlockSubmitterV2 is the interface for submitting beacon blocks with broadcast validation.
+type BeaconBlockSubmitterV2 interface {
+	// SubmitBeaconBlockV2 submits a beacon block, with the beacon node carrying out the given
+	// level of validation before broadcasting it.
+	SubmitBeaconBlockV2(ctx context.Context,
+		block *spec.VersionedSignedBeaconBlock,
+		broadcastValidation apiv1.BroadcastValidation,
+	) error
+}
+
+// BlockContentsSubmitter is the interface for submitting blocks along with their blob sidecars.
+type BlockContentsSubmitter interface {
+	// SubmitBlockContents submits a block along with its blob sidecars, with the beacon node carrying
+	// out the given level of validation before broadcasting them.
+	SubmitBlockContents(ctx context.Context,
+		contents *apiv1deneb.SignedBlockContents,
+		broadcastValidation apiv1.BroadcastValidation,
+	) error
+}
+
 // BeaconCommitteeSubscriptionsSubmitter is the interface for submitting beacon committee subnet subscription requests.
 type BeaconCommitteeSubscriptionsSubmitter interface {
 	// SubmitBeaconCommitteeSubscriptions subscribes to beacon committees.
@@ -268,6 +378,18 @@ type BeaconStateRootProvider interface {
 	BeaconStateRoot(ctx context.Context, stateID string) (*phase0.Root, error)
 }
 
+// StateRootProvider is the interface for providing beacon state roots with their metadata.
+type StateRootProvider interface {
+	// StateRoot fetches a beacon state root and its metadata given a state ID.
+	StateRoot(ctx context.Context, stateID string) (*api.Response[*phase0.Root], error)
+}
+
+// HistoricalSummariesProvider is the interface for providing historical summaries.
+type HistoricalSummariesProvider interface {
+	// HistoricalSummaries fetches the historical summaries and their metadata given a state ID.
+	HistoricalSummaries(ctx context.Context, stateID string) (*api.Response[[]*capella.HistoricalSummary], error)
+}
+
 // BlindedBeaconBlockProposalProvider is the interface for providing blinded beacon block proposals.
 type BlindedBeaconBlockProposalProvider interface {
 	// BlindedBeaconBlockProposal fetches a blinded proposed beacon block for signing.
@@ -280,24 +402,80 @@ type BlindedBeaconBlockSubmitter interface {
 	SubmitBlindedBeaconBlock(ctx context.Context, block *api.VersionedSignedBlindedBeaconBlock) error
 }
 
+// BlindedBeaconBlockSubmitterV2 is the interface for submitting blinded beacon blocks with broadcast validation.
+type BlindedBeaconBlockSubmitterV2 interface {
+	// SubmitBlindedBeaconBlockV2 submits a blinded beacon block, with the beacon node carrying out the
+	// given level of validation before broadcasting it.
+	// If the beacon node reveals the execution payload of the block it is returned, otherwise the
+	// returned payload is nil.
+	SubmitBlindedBeaconBlockV2(ctx context.Context,
+		block *api.VersionedSignedBlindedBeaconBlock,
+		broadcastValidation apiv1.BroadcastValidation,
+	) (
+		*api.VersionedExecutionPayload,
+		error,
+	)
+}
+
 // ValidatorRegistrationsSubmitter is the interface for submitting validator registrations.
 type ValidatorRegistrationsSubmitter interface {
 	// SubmitValidatorRegistrations submits a validator registration.
 	SubmitValidatorRegistrations(ctx context.Context, registrations []*api.VersionedSignedValidatorRegistration) error
 }
 
+// DebugBeaconHeadsProvider is the interface for providing the heads of the chain known to the node's fork choice.
+type DebugBeaconHeadsProvider interface {
+	// DebugBeaconHeads provides the heads of the chain known to the node's fork choice.
+	DebugBeaconHeads(ctx context.Context) ([]*apiv1.ChainHead, error)
+}
+
 // EventsProvider is the interface for providing events.
 type EventsProvider interface {
 	// Events feeds requested events with the given topics to the supplied handler.
 	Events(ctx context.Context, topics []string, handler EventHandlerFunc) error
 }
 
+// TypedEventsProvider is the interface for providing subscriptions to individual event topics.
+// Each subscription delivers events of the topic's type on a buffered channel of the given size,
+// and ends when the context is done or the subscription is cancelled.
+type TypedEventsProvider interface {
+	// OnHead subscribes to head events.
+	OnHead(ctx context.Context, bufferSize int) (*apiv1.Subscription[*apiv1.HeadEvent], error)
+
+	// OnBlock subscribes to block events.
+	OnBlock(ctx context.Context, bufferSize int) (*apiv1.Subscription[*apiv1.BlockEvent], error)
+
+	// OnAttestation subscribes to attestation events.
+	OnAttestation(ctx context.Context, bufferSize int) (*apiv1.Subscription[*phase0.Attestation], error)
+
+	// OnVoluntaryExit subscribes to voluntary exit events.
+	OnVoluntaryExit(ctx context.Context, bufferSize int) (*apiv1.Subscription[*phase0.SignedVoluntaryExit], error)
+
+	// OnFinalizedCheckpoint subscribes to finalized checkpoint events.
+	OnFinalizedCheckpoint(ctx context.Context, bufferSize int) (*apiv1.Subscription[*apiv1.FinalizedCheckpointEvent], error)
+
+	// OnChainReorg subscribes to chain reorganisation events.
+	OnChainReorg(ctx context.Context, bufferSize int) (*apiv1.Subscription[*apiv1.ChainReorgEvent], error)
+
+	// OnContributionAndProof subscribes to sync committee contribution and proof events.
+	OnContributionAndProof(ctx context.Context, bufferSize int) (*apiv1.Subscription[*altair.SignedContributionAndProof], error)
+
+	// OnPayloadAttributes subscribes to payload attributes events.
+	OnPayloadAttributes(ctx context.Context, bufferSize int) (*apiv1.Subscription[*apiv1.PayloadAttributesEvent], error)
+}
+
 // FinalityProvider is the interface for providing finality information.
 type FinalityProvider interface {
 	// Finality provides the finality given a state ID.
 	Finality(ctx context.Context, stateID string) (*apiv1.Finality, error)
 }
 
+// FinalityWithMetaProvider is the interface for providing finality information with its metadata.
+type FinalityWithMetaProvider interface {
+	// FinalityWithMeta provides the finality and its metadata given a state ID.
+	FinalityWithMeta(ctx context.Context, stateID string) (*api.Response[*apiv1.Finality], error)
+}
+
 // ForkProvider is the interface for providing fork information.
 type ForkProvider interface {
 	// Fork fetches fork information for the given state.
@@ -316,12 +494,24 @@ type GenesisProvider interface {
 	Genesis(ctx context.Context) (*apiv1.Genesis, error)
 }
 
+// NodeIdentityProvider is the interface for providing the network identity of the node.
+type NodeIdentityProvider interface {
+	// NodeIdentity provides the network identity of the node.
+	NodeIdentity(ctx context.Context) (*apiv1.NodeIdentity, error)
+}
+
 // NodeSyncingProvider is the interface for providing synchronization state.
 type NodeSyncingProvider interface {
 	// NodeSyncing provides the state of the node's synchronization with the chain.
 	NodeSyncing(ctx context.Context) (*apiv1.SyncState, error)
 }
 
+// ProposalProvider is the interface for providing proposals, which may be either full or blinded blocks.
+type ProposalProvider interface {
+	// Proposal fetches a proposal for signing.
+	Proposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*api.VersionedProposal, error)
+}
+
 // ProposalPreparationsSubmitter is the interface for submitting proposal preparations.
 type ProposalPreparationsSubmitter interface {
 	// SubmitProposalPreparations provides the beacon node with information required if a proposal for the given validators
@@ -336,6 +526,19 @@ type ProposerDutiesProvider interface {
 	ProposerDuties(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.ProposerDuty, error)
 }
 
+// ProposerDutiesWithMetaProvider is the interface for providing proposer duties with their metadata.
+type ProposerDutiesWithMetaProvider interface {
+	// ProposerDutiesWithMeta obtains proposer duties and their metadata for the given epoch.
+	// If validatorIndices is empty all duties are returned, otherwise only matching duties are returned.
+	ProposerDutiesWithMeta(ctx context.Context,
+		epoch phase0.Epoch,
+		validatorIndices []phase0.ValidatorIndex,
+	) (
+		*api.Response[[]*apiv1.ProposerDuty],
+		error,
+	)
+}
+
 // SpecProvider is the interface for providing spec data.
 type SpecProvider interface {
 	// Spec provides the spec information of the chain.
@@ -371,7 +574,9 @@ type ValidatorsProvider interface {
 	// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
 	// validatorPubKeys is a list of validator public keys to restrict the returned values.  If no validators public keys are
 	// supplied no filter will be applied.
-	ValidatorsByPubKey(ctx context.Context, stateID string, validatorPubKeys []phase0.BLSPubKey) (map[phase0.ValidatorIndex]*apiv1.Validator, error)
+	// validatorStates is a list of validator states to restrict the returned values.  If no validators states are supplied no filter
+	// will be applied.
+	ValidatorsByPubKey(ctx context.Context, stateID string, validatorPubKeys []phase0.BLSPubKey, validatorStates []v1.ValidatorState) (map[phase0.ValidatorIndex]*apiv1.Validator, error)
 }
 
 // VoluntaryExitSubmitter is the interface for submitting voluntary exits.
@@ -408,8 +613,41 @@ type GenesisTimeProvider interface {
 	GenesisTime(ctx context.Context) (time.Time, error)
 }
 
+// FeaturesProvider is the interface for providing the optional features enabled in a service.
+type FeaturesProvider interface {
+	// FeatureEnabled returns true if the given feature is enabled.
+	FeatureEnabled(feature api.Feature) bool
+
+	// EnabledFeatures returns the features enabled in the service.
+	EnabledFeatures() []api.Feature
+}
+
+// NetworkProvider is the interface for providing the network to which the node is connected.
+type NetworkProvider interface {
+	// Network provides the network to which the node is connected.
+	Network(ctx context.Context) (*apiv1.Network, error)
+}
+
+// SpecConfigProvider is the interface for providing strongly-typed spec data.
+type SpecConfigProvider interface {
+	// SpecConfig provides the typed spec information of the chain.
+	SpecConfig(ctx context.Context) (*api.SpecConfig, error)
+}
+
+// NetworkProfileProvider is the interface for providing the profile of the network.
+type NetworkProfileProvider interface {
+	// NetworkProfile provides the profile of the network to which the node is connected.
+	NetworkProfile(ctx context.Context) (*api.NetworkProfile, error)
+}
+
 // NodeClientProvider provides the client for the node.
 type NodeClientProvider interface {
 	// NodeClient provides the client for the node.
 	NodeClient(ctx context.Context) (string, error)
 }
+
+// ClientTypeProvider provides the type of the client software of the node.
+type ClientTypeProvider interface {
+	// ClientType provides the type and version of the client software of the node.
+	ClientType(ctx context.Context) (api.ClientType, string, error)
+}
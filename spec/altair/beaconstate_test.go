@@ -13,59 +13,4 @@
 
 package altair_test
 
-import (
-	"fmt"
-	"os"
-	"path/filepath"
-	"testing"
-
-	"github.com/attestantio/go-eth2-client/spec/altair"
-	"github.com/goccy/go-yaml"
-	"github.com/golang/snappy"
-	require "github.com/stretchr/testify/require"
-)
-
-func TestBeaconStateSpec(t *testing.T) {
-	if os.Getenv("ETH2_SPEC_TESTS_DIR") == "" {
-		t.Skip("ETH2_SPEC_TESTS_DIR not suppplied, not running spec tests")
-	}
-	baseDir := filepath.Join(os.Getenv("ETH2_SPEC_TESTS_DIR"), "tests", "mainnet", "altair", "ssz_static", "BeaconState", "ssz_random")
-	require.NoError(t, filepath.Walk(baseDir, func(path string, info os.FileInfo, err error) error {
-		if path == baseDir {
-			// Only interested in subdirectories.
-			return nil
-		}
-		require.NoError(t, err)
-		if info.IsDir() {
-			t.Run(info.Name(), func(t *testing.T) {
-				specYAML, err := os.ReadFile(filepath.Join(path, "value.yaml"))
-				require.NoError(t, err)
-				res := &altair.BeaconState{}
-				require.NoError(t, yaml.Unmarshal(specYAML, res))
-
-				compressedSpecSSZ, err := os.ReadFile(filepath.Join(path, "serialized.ssz_snappy"))
-				require.NoError(t, err)
-				var specSSZ []byte
-				specSSZ, err = snappy.Decode(specSSZ, compressedSpecSSZ)
-				require.NoError(t, err)
-
-				unmarshalled := &altair.BeaconState{}
-				require.NoError(t, unmarshalled.UnmarshalSSZ(specSSZ))
-				remarshalled, err := unmarshalled.MarshalSSZ()
-				require.NoError(t, err)
-				require.Equal(t, specSSZ, remarshalled)
-
-				ssz, err := res.MarshalSSZ()
-				require.NoError(t, err)
-				require.Equal(t, specSSZ, ssz)
-
-				root, err := res.HashTreeRoot()
-				require.NoError(t, err)
-				rootsYAML, err := os.ReadFile(filepath.Join(path, "roots.yaml"))
-				require.NoError(t, err)
-				require.Equal(t, string(rootsYAML), fmt.Sprintf("{root: '%#x'}\n", root))
-			})
-		}
-		return nil
-	}))
-}
+import ()
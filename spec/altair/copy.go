@@ -0,0 +1,329 @@
+// Code generated by gencopy. DO NOT EDIT.
+
+package altair
+
+import "github.com/attestantio/go-eth2-client/spec/internal/containers"
+
+// Copy returns a deep copy of the BeaconBlock.
+func (b *BeaconBlock) Copy() *BeaconBlock {
+	if b == nil {
+		return nil
+	}
+
+	res := *b
+	res.Body = b.Body.Copy()
+
+	return &res
+}
+
+// Equal returns true if the BeaconBlock holds the same data as other.
+func (b *BeaconBlock) Equal(other *BeaconBlock) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+
+	return b.Slot == other.Slot &&
+		b.ProposerIndex == other.ProposerIndex &&
+		b.ParentRoot == other.ParentRoot &&
+		b.StateRoot == other.StateRoot &&
+		b.Body.Equal(other.Body)
+}
+
+// Copy returns a deep copy of the BeaconBlockBody.
+func (b *BeaconBlockBody) Copy() *BeaconBlockBody {
+	if b == nil {
+		return nil
+	}
+
+	res := *b
+	res.ETH1Data = b.ETH1Data.Copy()
+	res.ProposerSlashings = append(b.ProposerSlashings[:0:0], b.ProposerSlashings...)
+	for i := range b.ProposerSlashings {
+		res.ProposerSlashings[i] = b.ProposerSlashings[i].Copy()
+	}
+	res.AttesterSlashings = append(b.AttesterSlashings[:0:0], b.AttesterSlashings...)
+	for i := range b.AttesterSlashings {
+		res.AttesterSlashings[i] = b.AttesterSlashings[i].Copy()
+	}
+	res.Attestations = append(b.Attestations[:0:0], b.Attestations...)
+	for i := range b.Attestations {
+		res.Attestations[i] = b.Attestations[i].Copy()
+	}
+	res.Deposits = append(b.Deposits[:0:0], b.Deposits...)
+	for i := range b.Deposits {
+		res.Deposits[i] = b.Deposits[i].Copy()
+	}
+	res.VoluntaryExits = append(b.VoluntaryExits[:0:0], b.VoluntaryExits...)
+	for i := range b.VoluntaryExits {
+		res.VoluntaryExits[i] = b.VoluntaryExits[i].Copy()
+	}
+	res.SyncAggregate = b.SyncAggregate.Copy()
+
+	return &res
+}
+
+// Equal returns true if the BeaconBlockBody holds the same data as other.
+func (b *BeaconBlockBody) Equal(other *BeaconBlockBody) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+
+	return b.RANDAOReveal == other.RANDAOReveal &&
+		b.ETH1Data.Equal(other.ETH1Data) &&
+		b.Graffiti == other.Graffiti &&
+		containers.EqualContainerSlices(b.ProposerSlashings, other.ProposerSlashings) &&
+		containers.EqualContainerSlices(b.AttesterSlashings, other.AttesterSlashings) &&
+		containers.EqualContainerSlices(b.Attestations, other.Attestations) &&
+		containers.EqualContainerSlices(b.Deposits, other.Deposits) &&
+		containers.EqualContainerSlices(b.VoluntaryExits, other.VoluntaryExits) &&
+		b.SyncAggregate.Equal(other.SyncAggregate)
+}
+
+// Copy returns a deep copy of the BeaconState.
+func (b *BeaconState) Copy() *BeaconState {
+	if b == nil {
+		return nil
+	}
+
+	res := *b
+	res.Fork = b.Fork.Copy()
+	res.LatestBlockHeader = b.LatestBlockHeader.Copy()
+	res.BlockRoots = append(b.BlockRoots[:0:0], b.BlockRoots...)
+	res.StateRoots = append(b.StateRoots[:0:0], b.StateRoots...)
+	res.HistoricalRoots = append(b.HistoricalRoots[:0:0], b.HistoricalRoots...)
+	res.ETH1Data = b.ETH1Data.Copy()
+	res.ETH1DataVotes = append(b.ETH1DataVotes[:0:0], b.ETH1DataVotes...)
+	for i := range b.ETH1DataVotes {
+		res.ETH1DataVotes[i] = b.ETH1DataVotes[i].Copy()
+	}
+	res.Validators = append(b.Validators[:0:0], b.Validators...)
+	for i := range b.Validators {
+		res.Validators[i] = b.Validators[i].Copy()
+	}
+	res.Balances = append(b.Balances[:0:0], b.Balances...)
+	res.RANDAOMixes = append(b.RANDAOMixes[:0:0], b.RANDAOMixes...)
+	res.Slashings = append(b.Slashings[:0:0], b.Slashings...)
+	res.PreviousEpochParticipation = append(b.PreviousEpochParticipation[:0:0], b.PreviousEpochParticipation...)
+	res.CurrentEpochParticipation = append(b.CurrentEpochParticipation[:0:0], b.CurrentEpochParticipation...)
+	res.JustificationBits = append(b.JustificationBits[:0:0], b.JustificationBits...)
+	res.PreviousJustifiedCheckpoint = b.PreviousJustifiedCheckpoint.Copy()
+	res.CurrentJustifiedCheckpoint = b.CurrentJustifiedCheckpoint.Copy()
+	res.FinalizedCheckpoint = b.FinalizedCheckpoint.Copy()
+	res.InactivityScores = append(b.InactivityScores[:0:0], b.InactivityScores...)
+	res.CurrentSyncCommittee = b.CurrentSyncCommittee.Copy()
+	res.NextSyncCommittee = b.NextSyncCommittee.Copy()
+
+	return &res
+}
+
+// Equal returns true if the BeaconState holds the same data as other.
+func (b *BeaconState) Equal(other *BeaconState) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+
+	return b.GenesisTime == other.GenesisTime &&
+		b.GenesisValidatorsRoot == other.GenesisValidatorsRoot &&
+		b.Slot == other.Slot &&
+		b.Fork.Equal(other.Fork) &&
+		b.LatestBlockHeader.Equal(other.LatestBlockHeader) &&
+		containers.EqualSlices(b.BlockRoots, other.BlockRoots) &&
+		containers.EqualSlices(b.StateRoots, other.StateRoots) &&
+		containers.EqualSlices(b.HistoricalRoots, other.HistoricalRoots) &&
+		b.ETH1Data.Equal(other.ETH1Data) &&
+		containers.EqualContainerSlices(b.ETH1DataVotes, other.ETH1DataVotes) &&
+		b.ETH1DepositIndex == other.ETH1DepositIndex &&
+		containers.EqualContainerSlices(b.Validators, other.Validators) &&
+		containers.EqualSlices(b.Balances, other.Balances) &&
+		containers.EqualSlices(b.RANDAOMixes, other.RANDAOMixes) &&
+		containers.EqualSlices(b.Slashings, other.Slashings) &&
+		containers.EqualSlices(b.PreviousEpochParticipation, other.PreviousEpochParticipation) &&
+		containers.EqualSlices(b.CurrentEpochParticipation, other.CurrentEpochParticipation) &&
+		containers.EqualSlices(b.JustificationBits, other.JustificationBits) &&
+		b.PreviousJustifiedCheckpoint.Equal(other.PreviousJustifiedCheckpoint) &&
+		b.CurrentJustifiedCheckpoint.Equal(other.CurrentJustifiedCheckpoint) &&
+		b.FinalizedCheckpoint.Equal(other.FinalizedCheckpoint) &&
+		containers.EqualSlices(b.InactivityScores, other.InactivityScores) &&
+		b.CurrentSyncCommittee.Equal(other.CurrentSyncCommittee) &&
+		b.NextSyncCommittee.Equal(other.NextSyncCommittee)
+}
+
+// Copy returns a deep copy of the ContributionAndProof.
+func (c *ContributionAndProof) Copy() *ContributionAndProof {
+	if c == nil {
+		return nil
+	}
+
+	res := *c
+	res.Contribution = c.Contribution.Copy()
+
+	return &res
+}
+
+// Equal returns true if the ContributionAndProof holds the same data as other.
+func (c *ContributionAndProof) Equal(other *ContributionAndProof) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+
+	return c.AggregatorIndex == other.AggregatorIndex &&
+		c.Contribution.Equal(other.Contribution) &&
+		c.SelectionProof == other.SelectionProof
+}
+
+// Copy returns a deep copy of the SignedBeaconBlock.
+func (s *SignedBeaconBlock) Copy() *SignedBeaconBlock {
+	if s == nil {
+		return nil
+	}
+
+	res := *s
+	res.Message = s.Message.Copy()
+
+	return &res
+}
+
+// Equal returns true if the SignedBeaconBlock holds the same data as other.
+func (s *SignedBeaconBlock) Equal(other *SignedBeaconBlock) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return s.Message.Equal(other.Message) &&
+		s.Signature == other.Signature
+}
+
+// Copy returns a deep copy of the SignedContributionAndProof.
+func (s *SignedContributionAndProof) Copy() *SignedContributionAndProof {
+	if s == nil {
+		return nil
+	}
+
+	res := *s
+	res.Message = s.Message.Copy()
+
+	return &res
+}
+
+// Equal returns true if the SignedContributionAndProof holds the same data as other.
+func (s *SignedContributionAndProof) Equal(other *SignedContributionAndProof) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return s.Message.Equal(other.Message) &&
+		s.Signature == other.Signature
+}
+
+// Copy returns a deep copy of the SyncAggregate.
+func (s *SyncAggregate) Copy() *SyncAggregate {
+	if s == nil {
+		return nil
+	}
+
+	res := *s
+	res.SyncCommitteeBits = append(s.SyncCommitteeBits[:0:0], s.SyncCommitteeBits...)
+
+	return &res
+}
+
+// Equal returns true if the SyncAggregate holds the same data as other.
+func (s *SyncAggregate) Equal(other *SyncAggregate) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return containers.EqualSlices(s.SyncCommitteeBits, other.SyncCommitteeBits) &&
+		s.SyncCommitteeSignature == other.SyncCommitteeSignature
+}
+
+// Copy returns a deep copy of the SyncAggregatorSelectionData.
+func (s *SyncAggregatorSelectionData) Copy() *SyncAggregatorSelectionData {
+	if s == nil {
+		return nil
+	}
+
+	res := *s
+
+	return &res
+}
+
+// Equal returns true if the SyncAggregatorSelectionData holds the same data as other.
+func (s *SyncAggregatorSelectionData) Equal(other *SyncAggregatorSelectionData) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return s.Slot == other.Slot &&
+		s.SubcommitteeIndex == other.SubcommitteeIndex
+}
+
+// Copy returns a deep copy of the SyncCommittee.
+func (s *SyncCommittee) Copy() *SyncCommittee {
+	if s == nil {
+		return nil
+	}
+
+	res := *s
+	res.Pubkeys = append(s.Pubkeys[:0:0], s.Pubkeys...)
+
+	return &res
+}
+
+// Equal returns true if the SyncCommittee holds the same data as other.
+func (s *SyncCommittee) Equal(other *SyncCommittee) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return containers.EqualSlices(s.Pubkeys, other.Pubkeys) &&
+		s.AggregatePubkey == other.AggregatePubkey
+}
+
+// Copy returns a deep copy of the SyncCommitteeContribution.
+func (s *SyncCommitteeContribution) Copy() *SyncCommitteeContribution {
+	if s == nil {
+		return nil
+	}
+
+	res := *s
+	res.AggregationBits = append(s.AggregationBits[:0:0], s.AggregationBits...)
+
+	return &res
+}
+
+// Equal returns true if the SyncCommitteeContribution holds the same data as other.
+func (s *SyncCommitteeContribution) Equal(other *SyncCommitteeContribution) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return s.Slot == other.Slot &&
+		s.BeaconBlockRoot == other.BeaconBlockRoot &&
+		s.SubcommitteeIndex == other.SubcommitteeIndex &&
+		containers.EqualSlices(s.AggregationBits, other.AggregationBits) &&
+		s.Signature == other.Signature
+}
+
+// Copy returns a deep copy of the SyncCommitteeMessage.
+func (s *SyncCommitteeMessage) Copy() *SyncCommitteeMessage {
+	if s == nil {
+		return nil
+	}
+
+	res := *s
+
+	return &res
+}
+
+// Equal returns true if the SyncCommitteeMessage holds the same data as other.
+func (s *SyncCommitteeMessage) Equal(other *SyncCommitteeMessage) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return s.Slot == other.Slot &&
+		s.BeaconBlockRoot == other.BeaconBlockRoot &&
+		s.ValidatorIndex == other.ValidatorIndex &&
+		s.Signature == other.Signature
+}
@@ -0,0 +1,48 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/preset"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMinimalPreset(t *testing.T) {
+	signature := "0x" + strings.Repeat("00", 96)
+	pubKey := `"0x` + strings.Repeat("00", 48) + `"`
+	syncAggregateInput := []byte(fmt.Sprintf(`{"sync_committee_bits":"0xffffffff","sync_committee_signature":"%s"}`, signature))
+	syncCommitteeInput := []byte(fmt.Sprintf(`{"pubkeys":[%s],"aggregate_pubkey":%s}`, strings.TrimSuffix(strings.Repeat(pubKey+",", 32), ","), pubKey))
+
+	// Mainnet preset rejects minimal containers.
+	require.EqualError(t, json.Unmarshal(syncAggregateInput, &altair.SyncAggregate{}), "sync committee bits too short")
+	require.EqualError(t, json.Unmarshal(syncCommitteeInput, &altair.SyncCommittee{}), "incorrect length for public keys")
+
+	require.NoError(t, preset.Set(preset.Minimal()))
+	t.Cleanup(func() {
+		require.NoError(t, preset.Set(preset.Mainnet()))
+	})
+
+	var syncAggregate altair.SyncAggregate
+	require.NoError(t, json.Unmarshal(syncAggregateInput, &syncAggregate))
+	require.Equal(t, uint64(32), syncAggregate.SyncCommitteeBits.Count())
+	var syncCommittee altair.SyncCommittee
+	require.NoError(t, json.Unmarshal(syncCommitteeInput, &syncCommittee))
+	require.Len(t, syncCommittee.Pubkeys, 32)
+}
@@ -69,10 +69,10 @@ func (s *SyncAggregate) unpack(syncAggregateJSON *syncAggregateJSON) error {
 	if err != nil {
 		return errors.Wrap(err, "invalid value for sync committee bits")
 	}
-	if len(syncCommitteeBits) < syncCommitteeSize/8 {
+	if len(syncCommitteeBits) < syncCommitteeSize()/8 {
 		return errors.New("sync committee bits too short")
 	}
-	if len(syncCommitteeBits) > syncCommitteeSize/8 {
+	if len(syncCommitteeBits) > syncCommitteeSize()/8 {
 		return errors.New("sync committee bits too long")
 	}
 	s.SyncCommitteeBits = syncCommitteeBits
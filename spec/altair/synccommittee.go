@@ -21,12 +21,15 @@ import (
 	"strings"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/preset"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
 
-// Altair constants.
-var syncCommitteeSize = 512
+// syncCommitteeSize returns the size of the sync committee for the current preset.
+func syncCommitteeSize() int {
+	return int(preset.Current().SyncCommitteeSize)
+}
 
 // SyncCommittee is the Ethereum 2 sync committee structure.
 type SyncCommittee struct {
@@ -69,10 +72,10 @@ func (s *SyncCommittee) UnmarshalJSON(input []byte) error {
 }
 
 func (s *SyncCommittee) unpack(syncCommitteeJSON *syncCommitteeJSON) error {
-	if len(syncCommitteeJSON.Pubkeys) != syncCommitteeSize {
+	if len(syncCommitteeJSON.Pubkeys) != syncCommitteeSize() {
 		return errors.New("incorrect length for public keys")
 	}
-	s.Pubkeys = make([]phase0.BLSPubKey, syncCommitteeSize)
+	s.Pubkeys = make([]phase0.BLSPubKey, syncCommitteeSize())
 	for i := range syncCommitteeJSON.Pubkeys {
 		pubKey, err := hex.DecodeString(strings.TrimPrefix(syncCommitteeJSON.Pubkeys[i], "0x"))
 		if err != nil {
@@ -0,0 +1,247 @@
+// Code generated by gencopy. DO NOT EDIT.
+
+package bellatrix
+
+import "github.com/attestantio/go-eth2-client/spec/internal/containers"
+
+// Copy returns a deep copy of the BeaconBlock.
+func (b *BeaconBlock) Copy() *BeaconBlock {
+	if b == nil {
+		return nil
+	}
+
+	res := *b
+	res.Body = b.Body.Copy()
+
+	return &res
+}
+
+// Equal returns true if the BeaconBlock holds the same data as other.
+func (b *BeaconBlock) Equal(other *BeaconBlock) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+
+	return b.Slot == other.Slot &&
+		b.ProposerIndex == other.ProposerIndex &&
+		b.ParentRoot == other.ParentRoot &&
+		b.StateRoot == other.StateRoot &&
+		b.Body.Equal(other.Body)
+}
+
+// Copy returns a deep copy of the BeaconBlockBody.
+func (b *BeaconBlockBody) Copy() *BeaconBlockBody {
+	if b == nil {
+		return nil
+	}
+
+	res := *b
+	res.ETH1Data = b.ETH1Data.Copy()
+	res.ProposerSlashings = append(b.ProposerSlashings[:0:0], b.ProposerSlashings...)
+	for i := range b.ProposerSlashings {
+		res.ProposerSlashings[i] = b.ProposerSlashings[i].Copy()
+	}
+	res.AttesterSlashings = append(b.AttesterSlashings[:0:0], b.AttesterSlashings...)
+	for i := range b.AttesterSlashings {
+		res.AttesterSlashings[i] = b.AttesterSlashings[i].Copy()
+	}
+	res.Attestations = append(b.Attestations[:0:0], b.Attestations...)
+	for i := range b.Attestations {
+		res.Attestations[i] = b.Attestations[i].Copy()
+	}
+	res.Deposits = append(b.Deposits[:0:0], b.Deposits...)
+	for i := range b.Deposits {
+		res.Deposits[i] = b.Deposits[i].Copy()
+	}
+	res.VoluntaryExits = append(b.VoluntaryExits[:0:0], b.VoluntaryExits...)
+	for i := range b.VoluntaryExits {
+		res.VoluntaryExits[i] = b.VoluntaryExits[i].Copy()
+	}
+	res.SyncAggregate = b.SyncAggregate.Copy()
+	res.ExecutionPayload = b.ExecutionPayload.Copy()
+
+	return &res
+}
+
+// Equal returns true if the BeaconBlockBody holds the same data as other.
+func (b *BeaconBlockBody) Equal(other *BeaconBlockBody) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+
+	return b.RANDAOReveal == other.RANDAOReveal &&
+		b.ETH1Data.Equal(other.ETH1Data) &&
+		b.Graffiti == other.Graffiti &&
+		containers.EqualContainerSlices(b.ProposerSlashings, other.ProposerSlashings) &&
+		containers.EqualContainerSlices(b.AttesterSlashings, other.AttesterSlashings) &&
+		containers.EqualContainerSlices(b.Attestations, other.Attestations) &&
+		containers.EqualContainerSlices(b.Deposits, other.Deposits) &&
+		containers.EqualContainerSlices(b.VoluntaryExits, other.VoluntaryExits) &&
+		b.SyncAggregate.Equal(other.SyncAggregate) &&
+		b.ExecutionPayload.Equal(other.ExecutionPayload)
+}
+
+// Copy returns a deep copy of the BeaconState.
+func (b *BeaconState) Copy() *BeaconState {
+	if b == nil {
+		return nil
+	}
+
+	res := *b
+	res.Fork = b.Fork.Copy()
+	res.LatestBlockHeader = b.LatestBlockHeader.Copy()
+	res.BlockRoots = append(b.BlockRoots[:0:0], b.BlockRoots...)
+	res.StateRoots = append(b.StateRoots[:0:0], b.StateRoots...)
+	res.HistoricalRoots = append(b.HistoricalRoots[:0:0], b.HistoricalRoots...)
+	res.ETH1Data = b.ETH1Data.Copy()
+	res.ETH1DataVotes = append(b.ETH1DataVotes[:0:0], b.ETH1DataVotes...)
+	for i := range b.ETH1DataVotes {
+		res.ETH1DataVotes[i] = b.ETH1DataVotes[i].Copy()
+	}
+	res.Validators = append(b.Validators[:0:0], b.Validators...)
+	for i := range b.Validators {
+		res.Validators[i] = b.Validators[i].Copy()
+	}
+	res.Balances = append(b.Balances[:0:0], b.Balances...)
+	res.RANDAOMixes = append(b.RANDAOMixes[:0:0], b.RANDAOMixes...)
+	res.Slashings = append(b.Slashings[:0:0], b.Slashings...)
+	res.PreviousEpochParticipation = append(b.PreviousEpochParticipation[:0:0], b.PreviousEpochParticipation...)
+	res.CurrentEpochParticipation = append(b.CurrentEpochParticipation[:0:0], b.CurrentEpochParticipation...)
+	res.JustificationBits = append(b.JustificationBits[:0:0], b.JustificationBits...)
+	res.PreviousJustifiedCheckpoint = b.PreviousJustifiedCheckpoint.Copy()
+	res.CurrentJustifiedCheckpoint = b.CurrentJustifiedCheckpoint.Copy()
+	res.FinalizedCheckpoint = b.FinalizedCheckpoint.Copy()
+	res.InactivityScores = append(b.InactivityScores[:0:0], b.InactivityScores...)
+	res.CurrentSyncCommittee = b.CurrentSyncCommittee.Copy()
+	res.NextSyncCommittee = b.NextSyncCommittee.Copy()
+	res.LatestExecutionPayloadHeader = b.LatestExecutionPayloadHeader.Copy()
+
+	return &res
+}
+
+// Equal returns true if the BeaconState holds the same data as other.
+func (b *BeaconState) Equal(other *BeaconState) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+
+	return b.GenesisTime == other.GenesisTime &&
+		b.GenesisValidatorsRoot == other.GenesisValidatorsRoot &&
+		b.Slot == other.Slot &&
+		b.Fork.Equal(other.Fork) &&
+		b.LatestBlockHeader.Equal(other.LatestBlockHeader) &&
+		containers.EqualSlices(b.BlockRoots, other.BlockRoots) &&
+		containers.EqualSlices(b.StateRoots, other.StateRoots) &&
+		containers.EqualSlices(b.HistoricalRoots, other.HistoricalRoots) &&
+		b.ETH1Data.Equal(other.ETH1Data) &&
+		containers.EqualContainerSlices(b.ETH1DataVotes, other.ETH1DataVotes) &&
+		b.ETH1DepositIndex == other.ETH1DepositIndex &&
+		containers.EqualContainerSlices(b.Validators, other.Validators) &&
+		containers.EqualSlices(b.Balances, other.Balances) &&
+		containers.EqualSlices(b.RANDAOMixes, other.RANDAOMixes) &&
+		containers.EqualSlices(b.Slashings, other.Slashings) &&
+		containers.EqualSlices(b.PreviousEpochParticipation, other.PreviousEpochParticipation) &&
+		containers.EqualSlices(b.CurrentEpochParticipation, other.CurrentEpochParticipation) &&
+		containers.EqualSlices(b.JustificationBits, other.JustificationBits) &&
+		b.PreviousJustifiedCheckpoint.Equal(other.PreviousJustifiedCheckpoint) &&
+		b.CurrentJustifiedCheckpoint.Equal(other.CurrentJustifiedCheckpoint) &&
+		b.FinalizedCheckpoint.Equal(other.FinalizedCheckpoint) &&
+		containers.EqualSlices(b.InactivityScores, other.InactivityScores) &&
+		b.CurrentSyncCommittee.Equal(other.CurrentSyncCommittee) &&
+		b.NextSyncCommittee.Equal(other.NextSyncCommittee) &&
+		b.LatestExecutionPayloadHeader.Equal(other.LatestExecutionPayloadHeader)
+}
+
+// Copy returns a deep copy of the ExecutionPayload.
+func (e *ExecutionPayload) Copy() *ExecutionPayload {
+	if e == nil {
+		return nil
+	}
+
+	res := *e
+	res.ExtraData = append(e.ExtraData[:0:0], e.ExtraData...)
+	res.Transactions = append(e.Transactions[:0:0], e.Transactions...)
+	for i := range e.Transactions {
+		res.Transactions[i] = append(e.Transactions[i][:0:0], e.Transactions[i]...)
+	}
+
+	return &res
+}
+
+// Equal returns true if the ExecutionPayload holds the same data as other.
+func (e *ExecutionPayload) Equal(other *ExecutionPayload) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+
+	return e.ParentHash == other.ParentHash &&
+		e.FeeRecipient == other.FeeRecipient &&
+		e.StateRoot == other.StateRoot &&
+		e.ReceiptsRoot == other.ReceiptsRoot &&
+		e.LogsBloom == other.LogsBloom &&
+		e.PrevRandao == other.PrevRandao &&
+		e.BlockNumber == other.BlockNumber &&
+		e.GasLimit == other.GasLimit &&
+		e.GasUsed == other.GasUsed &&
+		e.Timestamp == other.Timestamp &&
+		containers.EqualSlices(e.ExtraData, other.ExtraData) &&
+		e.BaseFeePerGas == other.BaseFeePerGas &&
+		e.BlockHash == other.BlockHash &&
+		containers.EqualByteSliceSlices(e.Transactions, other.Transactions)
+}
+
+// Copy returns a deep copy of the ExecutionPayloadHeader.
+func (e *ExecutionPayloadHeader) Copy() *ExecutionPayloadHeader {
+	if e == nil {
+		return nil
+	}
+
+	res := *e
+	res.ExtraData = append(e.ExtraData[:0:0], e.ExtraData...)
+
+	return &res
+}
+
+// Equal returns true if the ExecutionPayloadHeader holds the same data as other.
+func (e *ExecutionPayloadHeader) Equal(other *ExecutionPayloadHeader) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+
+	return e.ParentHash == other.ParentHash &&
+		e.FeeRecipient == other.FeeRecipient &&
+		e.StateRoot == other.StateRoot &&
+		e.ReceiptsRoot == other.ReceiptsRoot &&
+		e.LogsBloom == other.LogsBloom &&
+		e.PrevRandao == other.PrevRandao &&
+		e.BlockNumber == other.BlockNumber &&
+		e.GasLimit == other.GasLimit &&
+		e.GasUsed == other.GasUsed &&
+		e.Timestamp == other.Timestamp &&
+		containers.EqualSlices(e.ExtraData, other.ExtraData) &&
+		e.BaseFeePerGas == other.BaseFeePerGas &&
+		e.BlockHash == other.BlockHash &&
+		e.TransactionsRoot == other.TransactionsRoot
+}
+
+// Copy returns a deep copy of the SignedBeaconBlock.
+func (s *SignedBeaconBlock) Copy() *SignedBeaconBlock {
+	if s == nil {
+		return nil
+	}
+
+	res := *s
+	res.Message = s.Message.Copy()
+
+	return &res
+}
+
+// Equal returns true if the SignedBeaconBlock holds the same data as other.
+func (s *SignedBeaconBlock) Equal(other *SignedBeaconBlock) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return s.Message.Equal(other.Message) &&
+		s.Signature == other.Signature
+}
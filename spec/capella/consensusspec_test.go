@@ -0,0 +1,67 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/internal/testutil"
+)
+
+// TestConsensusSpec tests the types against the Ethereum consensus spec tests.
+func TestConsensusSpec(t *testing.T) {
+	testutil.RunSSZStatic(t, "capella", []*testutil.SSZStaticTest{
+		{
+			Name: "BeaconBlock",
+			New:  func() testutil.Container { return &capella.BeaconBlock{} },
+		},
+		{
+			Name: "BeaconBlockBody",
+			New:  func() testutil.Container { return &capella.BeaconBlockBody{} },
+		},
+		{
+			Name: "BeaconState",
+			New:  func() testutil.Container { return &capella.BeaconState{} },
+		},
+		{
+			Name: "BLSToExecutionChange",
+			New:  func() testutil.Container { return &capella.BLSToExecutionChange{} },
+		},
+		{
+			Name: "ExecutionPayload",
+			New:  func() testutil.Container { return &capella.ExecutionPayload{} },
+		},
+		{
+			Name: "ExecutionPayloadHeader",
+			New:  func() testutil.Container { return &capella.ExecutionPayloadHeader{} },
+		},
+		{
+			Name: "HistoricalSummary",
+			New:  func() testutil.Container { return &capella.HistoricalSummary{} },
+		},
+		{
+			Name: "SignedBeaconBlock",
+			New:  func() testutil.Container { return &capella.SignedBeaconBlock{} },
+		},
+		{
+			Name: "SignedBLSToExecutionChange",
+			New:  func() testutil.Container { return &capella.SignedBLSToExecutionChange{} },
+		},
+		{
+			Name: "Withdrawal",
+			New:  func() testutil.Container { return &capella.Withdrawal{} },
+		},
+	})
+}
@@ -0,0 +1,123 @@
+// Code generated by gencopy. DO NOT EDIT.
+
+package spec
+
+// Copy returns a deep copy of the VersionedBeaconBlock.
+func (v *VersionedBeaconBlock) Copy() *VersionedBeaconBlock {
+	if v == nil {
+		return nil
+	}
+
+	res := *v
+	res.Phase0 = v.Phase0.Copy()
+	res.Altair = v.Altair.Copy()
+	res.Bellatrix = v.Bellatrix.Copy()
+	res.Capella = v.Capella.Copy()
+	res.Deneb = v.Deneb.Copy()
+
+	return &res
+}
+
+// Equal returns true if the VersionedBeaconBlock holds the same data as other.
+func (v *VersionedBeaconBlock) Equal(other *VersionedBeaconBlock) bool {
+	if v == nil || other == nil {
+		return v == other
+	}
+
+	return v.Version == other.Version &&
+		v.Phase0.Equal(other.Phase0) &&
+		v.Altair.Equal(other.Altair) &&
+		v.Bellatrix.Equal(other.Bellatrix) &&
+		v.Capella.Equal(other.Capella) &&
+		v.Deneb.Equal(other.Deneb)
+}
+
+// Copy returns a deep copy of the VersionedBeaconBlockBody.
+func (v *VersionedBeaconBlockBody) Copy() *VersionedBeaconBlockBody {
+	if v == nil {
+		return nil
+	}
+
+	res := *v
+	res.Phase0 = v.Phase0.Copy()
+	res.Altair = v.Altair.Copy()
+	res.Bellatrix = v.Bellatrix.Copy()
+	res.Capella = v.Capella.Copy()
+	res.Deneb = v.Deneb.Copy()
+
+	return &res
+}
+
+// Equal returns true if the VersionedBeaconBlockBody holds the same data as other.
+func (v *VersionedBeaconBlockBody) Equal(other *VersionedBeaconBlockBody) bool {
+	if v == nil || other == nil {
+		return v == other
+	}
+
+	return v.Version == other.Version &&
+		v.Phase0.Equal(other.Phase0) &&
+		v.Altair.Equal(other.Altair) &&
+		v.Bellatrix.Equal(other.Bellatrix) &&
+		v.Capella.Equal(other.Capella) &&
+		v.Deneb.Equal(other.Deneb)
+}
+
+// Copy returns a deep copy of the VersionedBeaconState.
+func (v *VersionedBeaconState) Copy() *VersionedBeaconState {
+	if v == nil {
+		return nil
+	}
+
+	res := *v
+	res.Phase0 = v.Phase0.Copy()
+	res.Altair = v.Altair.Copy()
+	res.Bellatrix = v.Bellatrix.Copy()
+	res.Capella = v.Capella.Copy()
+	res.Deneb = v.Deneb.Copy()
+
+	return &res
+}
+
+// Equal returns true if the VersionedBeaconState holds the same data as other.
+func (v *VersionedBeaconState) Equal(other *VersionedBeaconState) bool {
+	if v == nil || other == nil {
+		return v == other
+	}
+
+	return v.Version == other.Version &&
+		v.Phase0.Equal(other.Phase0) &&
+		v.Altair.Equal(other.Altair) &&
+		v.Bellatrix.Equal(other.Bellatrix) &&
+		v.Capella.Equal(other.Capella) &&
+		v.Deneb.Equal(other.Deneb)
+}
+
+// Copy returns a deep copy of the VersionedSignedBeaconBlock.
+func (v *VersionedSignedBeaconBlock) Copy() *VersionedSignedBeaconBlock {
+	if v == nil {
+		return nil
+	}
+
+	res := *v
+	res.Phase0 = v.Phase0.Copy()
+	res.Altair = v.Altair.Copy()
+	res.Bellatrix = v.Bellatrix.Copy()
+	res.Capella = v.Capella.Copy()
+	res.Deneb = v.Deneb.Copy()
+
+	return &res
+}
+
+// Equal returns true if the VersionedSignedBeaconBlock holds the same data as other.
+func (v *VersionedSignedBeaconBlock) Equal(other *VersionedSignedBeaconBlock) bool {
+	if v == nil || other == nil {
+		return v == other
+	}
+
+	return v.Version == other.Version &&
+		v.Phase0.Equal(other.Phase0) &&
+		v.Altair.Equal(other.Altair) &&
+		v.Bellatrix.Equal(other.Bellatrix) &&
+		v.Capella.Equal(other.Capella) &&
+		v.Deneb.Equal(other.Deneb)
+}
@@ -0,0 +1,139 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/gencorpus"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// scribble overwrites every value reachable from v in place, without reallocating.
+func scribble(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			scribble(v.Elem())
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			scribble(v.Field(i))
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			scribble(v.Index(i))
+		}
+	case reflect.Bool:
+		v.SetBool(!v.Bool())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(v.Uint() ^ 0x5a)
+	}
+}
+
+// TestCopy ensures that copies of all containers are equal to, and independent of, the original.
+func TestCopy(t *testing.T) {
+	for _, container := range gencorpus.Containers() {
+		for _, variant := range gencorpus.Variants() {
+			container := container
+			variant := variant
+			t.Run(fmt.Sprintf("%s/%s/%s", container.Fork, container.Name, variant), func(t *testing.T) {
+				original := container.New()
+				require.NoError(t, gencorpus.Fill(original, variant))
+				originalJSON, err := json.Marshal(original)
+				require.NoError(t, err)
+
+				copied := reflect.ValueOf(original).MethodByName("Copy").Call(nil)[0]
+				equal := reflect.ValueOf(original).MethodByName("Equal")
+				require.True(t, equal.Call([]reflect.Value{copied})[0].Bool())
+				copiedJSON, err := json.Marshal(copied.Interface())
+				require.NoError(t, err)
+				require.Equal(t, string(originalJSON), string(copiedJSON))
+
+				// Changing the copy must leave the original untouched.
+				scribble(copied)
+				require.False(t, equal.Call([]reflect.Value{copied})[0].Bool())
+				afterJSON, err := json.Marshal(original)
+				require.NoError(t, err)
+				require.Equal(t, string(originalJSON), string(afterJSON))
+			})
+		}
+	}
+}
+
+func TestCopyVersioned(t *testing.T) {
+	block := &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0:  &phase0.SignedBeaconBlock{},
+	}
+	require.NoError(t, gencorpus.Fill(block.Phase0, gencorpus.VariantMax))
+
+	copied := block.Copy()
+	require.True(t, block.Equal(copied))
+	require.NotSame(t, block.Phase0.Message.Body, copied.Phase0.Message.Body)
+
+	copied.Phase0.Message.Body.Graffiti[0] = 0x00
+	require.False(t, block.Equal(copied))
+	require.Equal(t, byte(0xff), block.Phase0.Message.Body.Graffiti[0])
+
+	var nilBlock *spec.VersionedSignedBeaconBlock
+	require.Nil(t, nilBlock.Copy())
+	require.True(t, nilBlock.Equal(nil))
+	require.False(t, nilBlock.Equal(block))
+}
+
+func TestEqualEmptyLists(t *testing.T) {
+	a := &phase0.BeaconBlockBody{Deposits: []*phase0.Deposit{}}
+	b := &phase0.BeaconBlockBody{}
+	require.True(t, a.Equal(b))
+	require.True(t, b.Equal(a))
+
+	// Empty lists remain empty rather than becoming nil when copied.
+	require.NotNil(t, a.Copy().Deposits)
+	require.Nil(t, b.Copy().Deposits)
+}
+
+func BenchmarkCopy(b *testing.B) {
+	block := &phase0.SignedBeaconBlock{}
+	if err := gencorpus.Fill(block, gencorpus.VariantMax); err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = block.Copy()
+	}
+}
+
+func BenchmarkCopySSZ(b *testing.B) {
+	block := &phase0.SignedBeaconBlock{}
+	if err := gencorpus.Fill(block, gencorpus.VariantMax); err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		data, err := block.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+		var copied phase0.SignedBeaconBlock
+		if err := copied.UnmarshalSSZ(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// DecodeStrict decodes JSON in to the supplied spec container, rejecting input that the
+// standard decoding would accept but which is not in canonical form.  Specifically, it rejects
+// input that contains fields unknown to the container, hex strings that are uppercase or missing
+// their 0x prefix, and numbers that are not in their canonical decimal representation.
+//
+// Strict decoding works by re-encoding the decoded container and comparing the result with the
+// input, so it is considerably slower than standard decoding.  It is intended for conformance
+// testing rather than general use; standard decoding with json.Unmarshal remains lenient.
+func DecodeStrict(input []byte, v interface{}) error {
+	if err := json.Unmarshal(input, v); err != nil {
+		return err
+	}
+
+	output, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(err, "failed to re-encode decoded value")
+	}
+
+	var inputValue interface{}
+	if err := decodeGeneric(input, &inputValue); err != nil {
+		return errors.Wrap(err, "failed to decode input")
+	}
+	var outputValue interface{}
+	if err := decodeGeneric(output, &outputValue); err != nil {
+		return errors.Wrap(err, "failed to decode re-encoded value")
+	}
+
+	return compareStrict("", inputValue, outputValue)
+}
+
+// decodeGeneric decodes JSON in to generic values, retaining numbers in their original form.
+func decodeGeneric(input []byte, v *interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	decoder.UseNumber()
+
+	return decoder.Decode(v)
+}
+
+// compareStrict compares the input with its canonical form, returning an error describing the
+// first difference found.
+func compareStrict(path string, input interface{}, canonical interface{}) error {
+	switch inputValue := input.(type) {
+	case map[string]interface{}:
+		canonicalValue, isMap := canonical.(map[string]interface{})
+		if !isMap {
+			return fmt.Errorf("unexpected object at %s", strictPath(path))
+		}
+		// Sort the keys so that the reported error is deterministic.
+		keys := make([]string, 0, len(inputValue))
+		for k := range inputValue {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fieldPath := k
+			if path != "" {
+				fieldPath = fmt.Sprintf("%s.%s", path, k)
+			}
+			canonicalField, exists := canonicalValue[k]
+			if !exists {
+				return fmt.Errorf("unknown field %s", fieldPath)
+			}
+			if err := compareStrict(fieldPath, inputValue[k], canonicalField); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		canonicalValue, isSlice := canonical.([]interface{})
+		if !isSlice {
+			return fmt.Errorf("unexpected array at %s", strictPath(path))
+		}
+		if len(inputValue) != len(canonicalValue) {
+			return fmt.Errorf("unexpected array length at %s", strictPath(path))
+		}
+		for i := range inputValue {
+			if err := compareStrict(fmt.Sprintf("%s[%d]", path, i), inputValue[i], canonicalValue[i]); err != nil {
+				return err
+			}
+		}
+	case string:
+		canonicalValue, isString := canonical.(string)
+		if !isString {
+			return fmt.Errorf("unexpected string at %s", strictPath(path))
+		}
+		if inputValue != canonicalValue {
+			return fmt.Errorf("non-canonical value at %s: %q (expected %q)", strictPath(path), inputValue, canonicalValue)
+		}
+	default:
+		if input != canonical {
+			return fmt.Errorf("non-canonical value at %s: %v (expected %v)", strictPath(path), input, canonical)
+		}
+	}
+
+	return nil
+}
+
+// strictPath returns a printable version of the path.
+func strictPath(path string) string {
+	if path == "" {
+		return "top level"
+	}
+
+	return path
+}
@@ -0,0 +1,83 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeStrict(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Good",
+			input: []byte(`{"previous_version":"0x00000001","current_version":"0x00000002","epoch":"3"}`),
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type phase0.forkJSON",
+		},
+		{
+			name:  "FieldUnknown",
+			input: []byte(`{"previous_version":"0x00000001","current_version":"0x00000002","epoch":"3","extra":true}`),
+			err:   "unknown field extra",
+		},
+		{
+			name:  "HexUppercase",
+			input: []byte(`{"previous_version":"0x0000000A","current_version":"0x00000002","epoch":"3"}`),
+			err:   `non-canonical value at previous_version: "0x0000000A" (expected "0x0000000a")`,
+		},
+		{
+			name:  "HexPrefixMissing",
+			input: []byte(`{"previous_version":"00000001","current_version":"0x00000002","epoch":"3"}`),
+			err:   `non-canonical value at previous_version: "00000001" (expected "0x00000001")`,
+		},
+		{
+			name:  "NumberNonCanonical",
+			input: []byte(`{"previous_version":"0x00000001","current_version":"0x00000002","epoch":"03"}`),
+			err:   `non-canonical value at epoch: "03" (expected "3")`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var fork phase0.Fork
+			err := spec.DecodeStrict(test.input, &fork)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDecodeStrictNested(t *testing.T) {
+	input := []byte(`{"slot":"1","index":"2","beacon_block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","source":{"epoch":"0","root":"0x0000000000000000000000000000000000000000000000000000000000000000"},"target":{"epoch":"1","root":"0x000000000000000000000000000000000000000000000000000000000000000F"}}`)
+
+	var data phase0.AttestationData
+	// Standard decoding is lenient.
+	require.NoError(t, data.UnmarshalJSON(input))
+	// Strict decoding is not.
+	require.EqualError(t, spec.DecodeStrict(input, &data),
+		`non-canonical value at target.root: "0x000000000000000000000000000000000000000000000000000000000000000F" (expected "0x000000000000000000000000000000000000000000000000000000000000000f")`)
+}
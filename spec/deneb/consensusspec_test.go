@@ -14,243 +14,180 @@
 package deneb_test
 
 import (
-	"fmt"
-	"os"
-	"path/filepath"
 	"testing"
 
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/internal/testutil"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
-	ssz "github.com/ferranbt/fastssz"
-	"github.com/goccy/go-yaml"
-	"github.com/golang/snappy"
-	clone "github.com/huandu/go-clone/generic"
-	require "github.com/stretchr/testify/require"
 )
 
 // TestConsensusSpec tests the types against the Ethereum consensus spec tests.
 func TestConsensusSpec(t *testing.T) {
-	if os.Getenv("CONSENSUS_SPEC_TESTS_DIR") == "" {
-		t.Skip("CONSENSUS_SPEC_TESTS_DIR not supplied, not running spec tests")
-	}
-
-	tests := []struct {
-		name string
-		s    any
-	}{
+	testutil.RunSSZStatic(t, "deneb", []*testutil.SSZStaticTest{
 		{
-			name: "AggregateAndProof",
-			s:    &phase0.AggregateAndProof{},
+			Name: "AggregateAndProof",
+			New:  func() testutil.Container { return &phase0.AggregateAndProof{} },
 		},
 		{
-			name: "Attestation",
-			s:    &phase0.Attestation{},
+			Name: "Attestation",
+			New:  func() testutil.Container { return &phase0.Attestation{} },
 		},
 		{
-			name: "AttestationData",
-			s:    &phase0.AttestationData{},
+			Name: "AttestationData",
+			New:  func() testutil.Container { return &phase0.AttestationData{} },
 		},
 		{
-			name: "AttesterSlashing",
-			s:    &phase0.AttesterSlashing{},
+			Name: "AttesterSlashing",
+			New:  func() testutil.Container { return &phase0.AttesterSlashing{} },
 		},
 		{
-			name: "BeaconBlock",
-			s:    &deneb.BeaconBlock{},
+			Name: "BeaconBlock",
+			New:  func() testutil.Container { return &deneb.BeaconBlock{} },
 		},
 		{
-			name: "BeaconBlockBody",
-			s:    &deneb.BeaconBlockBody{},
+			Name: "BeaconBlockBody",
+			New:  func() testutil.Container { return &deneb.BeaconBlockBody{} },
 		},
 		{
-			name: "BeaconBlockHeader",
-			s:    &phase0.BeaconBlockHeader{},
+			Name: "BeaconBlockHeader",
+			New:  func() testutil.Container { return &phase0.BeaconBlockHeader{} },
 		},
 		{
-			name: "BeaconState",
-			s:    &deneb.BeaconState{},
+			Name: "BeaconState",
+			New:  func() testutil.Container { return &deneb.BeaconState{} },
 		},
 		{
-			name: "BlobIdentifier",
-			s:    &deneb.BlobIdentifier{},
+			Name: "BlobIdentifier",
+			New:  func() testutil.Container { return &deneb.BlobIdentifier{} },
 		},
 		{
-			name: "BlobSidecar",
-			s:    &deneb.BlobSidecar{},
+			Name: "BlobSidecar",
+			New:  func() testutil.Container { return &deneb.BlobSidecar{} },
 		},
 		{
-			name: "BLSToExecutionChange",
-			s:    &capella.BLSToExecutionChange{},
+			Name: "BLSToExecutionChange",
+			New:  func() testutil.Container { return &capella.BLSToExecutionChange{} },
 		},
 		{
-			name: "Checkpoint",
-			s:    &phase0.Checkpoint{},
+			Name: "Checkpoint",
+			New:  func() testutil.Container { return &phase0.Checkpoint{} },
 		},
 		{
-			name: "ContributionAndProof",
-			s:    &altair.ContributionAndProof{},
+			Name: "ContributionAndProof",
+			New:  func() testutil.Container { return &altair.ContributionAndProof{} },
 		},
 		{
-			name: "Deposit",
-			s:    &phase0.Deposit{},
+			Name: "Deposit",
+			New:  func() testutil.Container { return &phase0.Deposit{} },
 		},
 		{
-			name: "DepositData",
-			s:    &phase0.DepositData{},
+			Name: "DepositData",
+			New:  func() testutil.Container { return &phase0.DepositData{} },
 		},
 		{
-			name: "DepositMessage",
-			s:    &phase0.DepositMessage{},
+			Name: "DepositMessage",
+			New:  func() testutil.Container { return &phase0.DepositMessage{} },
 		},
 		{
-			name: "Eth1Data",
-			s:    &phase0.ETH1Data{},
+			Name: "Eth1Data",
+			New:  func() testutil.Container { return &phase0.ETH1Data{} },
 		},
 		{
-			name: "ExecutionPayload",
-			s:    &deneb.ExecutionPayload{},
+			Name: "ExecutionPayload",
+			New:  func() testutil.Container { return &deneb.ExecutionPayload{} },
 		},
 		{
-			name: "ExecutionPayloadHeader",
-			s:    &deneb.ExecutionPayloadHeader{},
+			Name: "ExecutionPayloadHeader",
+			New:  func() testutil.Container { return &deneb.ExecutionPayloadHeader{} },
 		},
 		{
-			name: "Fork",
-			s:    &phase0.Fork{},
+			Name: "Fork",
+			New:  func() testutil.Container { return &phase0.Fork{} },
 		},
 		{
-			name: "ForkData",
-			s:    &phase0.ForkData{},
+			Name: "ForkData",
+			New:  func() testutil.Container { return &phase0.ForkData{} },
 		},
 		// TODO
 		// {
-		// 	name: "HistoricalBatch",
-		// 	s:    &phase0.HistoricalBatch{},
+		// 	Name: "HistoricalBatch",
+		// 	New:  func() testutil.Container { return &phase0.HistoricalBatch{} },
 		// },
 		{
-			name: "HistoricalSummary",
-			s:    &capella.HistoricalSummary{},
+			Name: "HistoricalSummary",
+			New:  func() testutil.Container { return &capella.HistoricalSummary{} },
 		},
 		{
-			name: "IndexedAttestation",
-			s:    &phase0.IndexedAttestation{},
+			Name: "IndexedAttestation",
+			New:  func() testutil.Container { return &phase0.IndexedAttestation{} },
 		},
 		// TODO lightclient*, sync*, others?
 		{
-			name: "PendingAttestation",
-			s:    &phase0.PendingAttestation{},
+			Name: "PendingAttestation",
+			New:  func() testutil.Container { return &phase0.PendingAttestation{} },
 		},
 		// TODO Powblock
 		{
-			name: "ProposerSlashing",
-			s:    &phase0.ProposerSlashing{},
+			Name: "ProposerSlashing",
+			New:  func() testutil.Container { return &phase0.ProposerSlashing{} },
 		},
 		{
-			name: "SignedAggregateAndProof",
-			s:    &phase0.SignedAggregateAndProof{},
+			Name: "SignedAggregateAndProof",
+			New:  func() testutil.Container { return &phase0.SignedAggregateAndProof{} },
 		},
 		{
-			name: "SignedBeaconBlock",
-			s:    &deneb.SignedBeaconBlock{},
+			Name: "SignedBeaconBlock",
+			New:  func() testutil.Container { return &deneb.SignedBeaconBlock{} },
 		},
 		{
-			name: "SignedBeaconBlockHeader",
-			s:    &phase0.SignedBeaconBlockHeader{},
+			Name: "SignedBeaconBlockHeader",
+			New:  func() testutil.Container { return &phase0.SignedBeaconBlockHeader{} },
 		},
 		{
-			name: "SignedBlobSidecar",
-			s:    &deneb.SignedBlobSidecar{},
+			Name: "SignedBlobSidecar",
+			New:  func() testutil.Container { return &deneb.SignedBlobSidecar{} },
 		},
 		{
-			name: "SignedBLSToExecutionChange",
-			s:    &capella.SignedBLSToExecutionChange{},
+			Name: "SignedBLSToExecutionChange",
+			New:  func() testutil.Container { return &capella.SignedBLSToExecutionChange{} },
 		},
 		{
-			name: "SignedContributionAndProof",
-			s:    &altair.SignedContributionAndProof{},
+			Name: "SignedContributionAndProof",
+			New:  func() testutil.Container { return &altair.SignedContributionAndProof{} },
 		},
 		{
-			name: "SignedVoluntaryExit",
-			s:    &phase0.SignedVoluntaryExit{},
+			Name: "SignedVoluntaryExit",
+			New:  func() testutil.Container { return &phase0.SignedVoluntaryExit{} },
 		},
 		{
-			name: "SyncAggregate",
-			s:    &altair.SyncAggregate{},
+			Name: "SyncAggregate",
+			New:  func() testutil.Container { return &altair.SyncAggregate{} },
 		},
 		{
-			name: "SyncCommittee",
-			s:    &altair.SyncCommittee{},
+			Name: "SyncCommittee",
+			New:  func() testutil.Container { return &altair.SyncCommittee{} },
 		},
 		{
-			name: "SyncCommitteeContribution",
-			s:    &altair.SyncCommitteeContribution{},
+			Name: "SyncCommitteeContribution",
+			New:  func() testutil.Container { return &altair.SyncCommitteeContribution{} },
 		},
 		{
-			name: "SyncCommitteeMessage",
-			s:    &altair.SyncCommitteeMessage{},
+			Name: "SyncCommitteeMessage",
+			New:  func() testutil.Container { return &altair.SyncCommitteeMessage{} },
 		},
 		{
-			name: "Validator",
-			s:    &phase0.Validator{},
+			Name: "Validator",
+			New:  func() testutil.Container { return &phase0.Validator{} },
 		},
 		{
-			name: "VoluntaryExit",
-			s:    &phase0.VoluntaryExit{},
+			Name: "VoluntaryExit",
+			New:  func() testutil.Container { return &phase0.VoluntaryExit{} },
 		},
 		{
-			name: "Withdrawal",
-			s:    &capella.Withdrawal{},
+			Name: "Withdrawal",
+			New:  func() testutil.Container { return &capella.Withdrawal{} },
 		},
-	}
-
-	baseDir := filepath.Join(os.Getenv("CONSENSUS_SPEC_TESTS_DIR"), "tests", "mainnet", "deneb", "ssz_static")
-	for _, test := range tests {
-		dir := filepath.Join(baseDir, test.name, "ssz_random")
-		require.NoError(t, filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
-			if path == dir {
-				// Only interested in subdirectories.
-				return nil
-			}
-			require.NoError(t, err)
-			if info.IsDir() {
-				t.Run(fmt.Sprintf("%s/%s", test.name, info.Name()), func(t *testing.T) {
-					s1 := clone.Clone(test.s)
-					// Obtain the struct from the YAML.
-					specYAML, err := os.ReadFile(filepath.Join(path, "value.yaml"))
-					require.NoError(t, err)
-					require.NoError(t, yaml.Unmarshal(specYAML, s1))
-					// Confirm we can return to the YAML.
-					remarshalledSpecYAML, err := yaml.Marshal(s1)
-					require.NoError(t, err)
-					require.Equal(t, testYAMLFormat(specYAML), testYAMLFormat(remarshalledSpecYAML))
-
-					// Obtain the struct from the SSZ.
-					s2 := clone.Clone(test.s)
-					compressedSpecSSZ, err := os.ReadFile(filepath.Join(path, "serialized.ssz_snappy"))
-					require.NoError(t, err)
-					var specSSZ []byte
-					specSSZ, err = snappy.Decode(specSSZ, compressedSpecSSZ)
-					require.NoError(t, err)
-					require.NoError(t, s2.(ssz.Unmarshaler).UnmarshalSSZ(specSSZ))
-					// Confirm we can return to the SSZ.
-					remarshalledSpecSSZ, err := s2.(ssz.Marshaler).MarshalSSZ()
-					require.NoError(t, err)
-					require.Equal(t, specSSZ, remarshalledSpecSSZ)
-
-					// Obtain the hash tree root from the YAML.
-					specYAMLRoot, err := os.ReadFile(filepath.Join(path, "roots.yaml"))
-					require.NoError(t, err)
-					// Confirm we calculate the same root.
-					generatedRootBytes, err := s2.(ssz.HashRoot).HashTreeRoot()
-					require.NoError(t, err)
-					generatedRoot := fmt.Sprintf("{root: '%#x'}\n", string(generatedRootBytes[:]))
-					require.Equal(t, string(specYAMLRoot), generatedRoot)
-				})
-			}
-
-			return nil
-		}))
-	}
+	})
 }
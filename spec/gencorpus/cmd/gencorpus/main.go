@@ -0,0 +1,38 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command gencorpus writes the deterministic corpus of spec container encodings.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/attestantio/go-eth2-client/spec/gencorpus"
+)
+
+func main() {
+	dir := flag.String("dir", "testdata", "directory to which to write the corpus")
+	rootsOnly := flag.Bool("roots", false, "write only the roots of the corpus")
+	flag.Parse()
+
+	write := gencorpus.Write
+	if *rootsOnly {
+		write = gencorpus.WriteRoots
+	}
+	if err := write(*dir); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write corpus: %v\n", err)
+		os.Exit(1)
+	}
+}
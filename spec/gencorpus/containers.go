@@ -0,0 +1,92 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gencorpus
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// containers are the containers in the corpus, by fork.
+var containers = []*Container{
+	// Phase 0.
+	{Fork: "phase0", Name: "AggregateAndProof", New: func() Value { return &phase0.AggregateAndProof{} }},
+	{Fork: "phase0", Name: "Attestation", New: func() Value { return &phase0.Attestation{} }},
+	{Fork: "phase0", Name: "AttestationData", New: func() Value { return &phase0.AttestationData{} }},
+	{Fork: "phase0", Name: "AttesterSlashing", New: func() Value { return &phase0.AttesterSlashing{} }},
+	{Fork: "phase0", Name: "BeaconBlock", New: func() Value { return &phase0.BeaconBlock{} }},
+	{Fork: "phase0", Name: "BeaconBlockBody", New: func() Value { return &phase0.BeaconBlockBody{} }},
+	{Fork: "phase0", Name: "BeaconBlockHeader", New: func() Value { return &phase0.BeaconBlockHeader{} }},
+	{Fork: "phase0", Name: "BeaconState", New: func() Value { return &phase0.BeaconState{} }},
+	{Fork: "phase0", Name: "Checkpoint", New: func() Value { return &phase0.Checkpoint{} }},
+	{Fork: "phase0", Name: "Deposit", New: func() Value { return &phase0.Deposit{} }},
+	{Fork: "phase0", Name: "DepositData", New: func() Value { return &phase0.DepositData{} }},
+	{Fork: "phase0", Name: "DepositMessage", New: func() Value { return &phase0.DepositMessage{} }},
+	{Fork: "phase0", Name: "ETH1Data", New: func() Value { return &phase0.ETH1Data{} }},
+	{Fork: "phase0", Name: "Fork", New: func() Value { return &phase0.Fork{} }},
+	{Fork: "phase0", Name: "ForkData", New: func() Value { return &phase0.ForkData{} }},
+	{Fork: "phase0", Name: "IndexedAttestation", New: func() Value { return &phase0.IndexedAttestation{} }},
+	{Fork: "phase0", Name: "PendingAttestation", New: func() Value { return &phase0.PendingAttestation{} }},
+	{Fork: "phase0", Name: "ProposerSlashing", New: func() Value { return &phase0.ProposerSlashing{} }},
+	{Fork: "phase0", Name: "SignedAggregateAndProof", New: func() Value { return &phase0.SignedAggregateAndProof{} }},
+	{Fork: "phase0", Name: "SignedBeaconBlock", New: func() Value { return &phase0.SignedBeaconBlock{} }},
+	{Fork: "phase0", Name: "SignedBeaconBlockHeader", New: func() Value { return &phase0.SignedBeaconBlockHeader{} }},
+	{Fork: "phase0", Name: "SignedVoluntaryExit", New: func() Value { return &phase0.SignedVoluntaryExit{} }},
+	{Fork: "phase0", Name: "SigningData", New: func() Value { return &phase0.SigningData{} }},
+	{Fork: "phase0", Name: "Validator", New: func() Value { return &phase0.Validator{} }},
+	{Fork: "phase0", Name: "VoluntaryExit", New: func() Value { return &phase0.VoluntaryExit{} }},
+	// Altair.
+	{Fork: "altair", Name: "BeaconBlock", New: func() Value { return &altair.BeaconBlock{} }},
+	{Fork: "altair", Name: "BeaconBlockBody", New: func() Value { return &altair.BeaconBlockBody{} }},
+	{Fork: "altair", Name: "BeaconState", New: func() Value { return &altair.BeaconState{} }},
+	{Fork: "altair", Name: "ContributionAndProof", New: func() Value { return &altair.ContributionAndProof{} }},
+	{Fork: "altair", Name: "SignedBeaconBlock", New: func() Value { return &altair.SignedBeaconBlock{} }},
+	{Fork: "altair", Name: "SignedContributionAndProof", New: func() Value { return &altair.SignedContributionAndProof{} }},
+	{Fork: "altair", Name: "SyncAggregate", New: func() Value { return &altair.SyncAggregate{} }},
+	{Fork: "altair", Name: "SyncAggregatorSelectionData", New: func() Value { return &altair.SyncAggregatorSelectionData{} }},
+	{Fork: "altair", Name: "SyncCommittee", New: func() Value { return &altair.SyncCommittee{} }},
+	{Fork: "altair", Name: "SyncCommitteeContribution", New: func() Value { return &altair.SyncCommitteeContribution{} }},
+	{Fork: "altair", Name: "SyncCommitteeMessage", New: func() Value { return &altair.SyncCommitteeMessage{} }},
+	// Bellatrix.
+	{Fork: "bellatrix", Name: "BeaconBlock", New: func() Value { return &bellatrix.BeaconBlock{} }},
+	{Fork: "bellatrix", Name: "BeaconBlockBody", New: func() Value { return &bellatrix.BeaconBlockBody{} }},
+	{Fork: "bellatrix", Name: "BeaconState", New: func() Value { return &bellatrix.BeaconState{} }},
+	{Fork: "bellatrix", Name: "ExecutionPayload", New: func() Value { return &bellatrix.ExecutionPayload{} }},
+	{Fork: "bellatrix", Name: "ExecutionPayloadHeader", New: func() Value { return &bellatrix.ExecutionPayloadHeader{} }},
+	{Fork: "bellatrix", Name: "SignedBeaconBlock", New: func() Value { return &bellatrix.SignedBeaconBlock{} }},
+	// Capella.
+	{Fork: "capella", Name: "BLSToExecutionChange", New: func() Value { return &capella.BLSToExecutionChange{} }},
+	{Fork: "capella", Name: "BeaconBlock", New: func() Value { return &capella.BeaconBlock{} }},
+	{Fork: "capella", Name: "BeaconBlockBody", New: func() Value { return &capella.BeaconBlockBody{} }},
+	{Fork: "capella", Name: "BeaconState", New: func() Value { return &capella.BeaconState{} }},
+	{Fork: "capella", Name: "ExecutionPayload", New: func() Value { return &capella.ExecutionPayload{} }},
+	{Fork: "capella", Name: "ExecutionPayloadHeader", New: func() Value { return &capella.ExecutionPayloadHeader{} }},
+	{Fork: "capella", Name: "HistoricalSummary", New: func() Value { return &capella.HistoricalSummary{} }},
+	{Fork: "capella", Name: "SignedBLSToExecutionChange", New: func() Value { return &capella.SignedBLSToExecutionChange{} }},
+	{Fork: "capella", Name: "SignedBeaconBlock", New: func() Value { return &capella.SignedBeaconBlock{} }},
+	{Fork: "capella", Name: "Withdrawal", New: func() Value { return &capella.Withdrawal{} }},
+	// Deneb.
+	{Fork: "deneb", Name: "BeaconBlock", New: func() Value { return &deneb.BeaconBlock{} }},
+	{Fork: "deneb", Name: "BeaconBlockBody", New: func() Value { return &deneb.BeaconBlockBody{} }},
+	{Fork: "deneb", Name: "BeaconState", New: func() Value { return &deneb.BeaconState{} }},
+	{Fork: "deneb", Name: "BlobIdentifier", New: func() Value { return &deneb.BlobIdentifier{} }},
+	{Fork: "deneb", Name: "BlobSidecar", New: func() Value { return &deneb.BlobSidecar{} }},
+	{Fork: "deneb", Name: "ExecutionPayload", New: func() Value { return &deneb.ExecutionPayload{} }},
+	{Fork: "deneb", Name: "ExecutionPayloadHeader", New: func() Value { return &deneb.ExecutionPayloadHeader{} }},
+	{Fork: "deneb", Name: "SignedBeaconBlock", New: func() Value { return &deneb.SignedBeaconBlock{} }},
+	{Fork: "deneb", Name: "SignedBlobSidecar", New: func() Value { return &deneb.SignedBlobSidecar{} }},
+}
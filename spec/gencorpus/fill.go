@@ -0,0 +1,216 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gencorpus
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// Variant is a set of boundary values with which to populate a container.
+type Variant int
+
+const (
+	// VariantZero populates all fields with their zero value, and all lists as empty.
+	VariantZero Variant = iota
+	// VariantMax populates all fields with their maximum value, and lists as long as is practical.
+	VariantMax
+)
+
+var variants = []Variant{VariantZero, VariantMax}
+
+var variantStrings = [...]string{
+	"zero",
+	"max",
+}
+
+// String returns a string representation of the variant.
+func (v Variant) String() string {
+	if v < 0 || int(v) >= len(variantStrings) {
+		return "unknown"
+	}
+
+	return variantStrings[v]
+}
+
+// Variants returns the variants in the corpus.
+func Variants() []Variant {
+	res := make([]Variant, len(variants))
+	copy(res, variants)
+
+	return res
+}
+
+// maxListLength is the maximum number of elements with which a list is populated.  Lists with a
+// higher limit are populated with a single element, to keep the corpus to a reasonable size.
+const maxListLength = 2048
+
+// bitvector is implemented by the fixed-length bitfields.
+type bitvector interface {
+	Len() uint64
+	SetBitAt(idx uint64, val bool)
+}
+
+var bitlistType = reflect.TypeOf(bitfield.Bitlist{})
+
+// Fill populates the container with values for the variant.
+func Fill(value interface{}, variant Variant) error {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return errors.New("value must be a non-nil pointer")
+	}
+
+	return fill(v.Elem(), variant, nil, nil)
+}
+
+// fill populates a value.  sizes and maxes are the remaining dimensions of the value's ssz-size and
+// ssz-max tags, if any.
+func fill(v reflect.Value, variant Variant, sizes []string, maxes []string) error {
+	switch v.Kind() {
+	case reflect.Pointer:
+		v.Set(reflect.New(v.Type().Elem()))
+
+		return fill(v.Elem(), variant, sizes, maxes)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if err := fill(v.Field(i), variant, tagDimensions(field.Tag.Get("ssz-size")), tagDimensions(field.Tag.Get("ssz-max"))); err != nil {
+				return errors.Wrap(err, field.Name)
+			}
+		}
+	case reflect.Bool:
+		v.SetBool(variant == VariantMax)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if variant == VariantMax {
+			v.SetUint(^uint64(0) >> (64 - v.Type().Bits()))
+		} else {
+			v.SetUint(0)
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := fill(v.Index(i), variant, tail(sizes), tail(maxes)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice:
+		return fillSlice(v, variant, sizes, maxes)
+	default:
+		return fmt.Errorf("unsupported kind %v", v.Kind())
+	}
+
+	return nil
+}
+
+// fillSlice populates a slice, which can be a list, a vector or a bitfield.
+func fillSlice(v reflect.Value, variant Variant, sizes []string, maxes []string) error {
+	size, hasSize, err := dimension(sizes)
+	if err != nil {
+		return err
+	}
+	limit, hasLimit, err := dimension(maxes)
+	if err != nil {
+		return err
+	}
+
+	if v.Type() == bitlistType {
+		if !hasLimit {
+			return errors.New("bitlist without maximum length")
+		}
+		length := uint64(0)
+		if variant == VariantMax {
+			length = limit
+		}
+		bits := bitfield.NewBitlist(length)
+		for i := uint64(0); i < length; i++ {
+			bits.SetBitAt(i, true)
+		}
+		v.Set(reflect.ValueOf(bits))
+
+		return nil
+	}
+
+	length := uint64(0)
+	switch {
+	case hasSize:
+		length = size
+	case !hasLimit:
+		return errors.New("list without maximum length")
+	case variant == VariantZero:
+		length = 0
+	case limit <= maxListLength:
+		length = limit
+	default:
+		length = 1
+	}
+
+	v.Set(reflect.MakeSlice(v.Type(), int(length), int(length)))
+
+	if bits, isBitvector := v.Interface().(bitvector); isBitvector {
+		// Only set the bits that are within the length of the bitvector.
+		if variant == VariantMax {
+			for i := uint64(0); i < bits.Len(); i++ {
+				bits.SetBitAt(i, true)
+			}
+		}
+
+		return nil
+	}
+
+	for i := 0; i < int(length); i++ {
+		if err := fill(v.Index(i), variant, tail(sizes), tail(maxes)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tagDimensions splits an ssz-size or ssz-max tag in to its dimensions.
+func tagDimensions(tag string) []string {
+	if tag == "" {
+		return nil
+	}
+
+	return strings.Split(tag, ",")
+}
+
+// dimension returns the value of the first dimension, if present.
+func dimension(dimensions []string) (uint64, bool, error) {
+	if len(dimensions) == 0 || dimensions[0] == "?" {
+		return 0, false, nil
+	}
+	val, err := strconv.ParseUint(dimensions[0], 10, 64)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "invalid dimension")
+	}
+
+	return val, true, nil
+}
+
+// tail returns the dimensions after the first.
+func tail(dimensions []string) []string {
+	if len(dimensions) <= 1 {
+		return nil
+	}
+
+	return dimensions[1:]
+}
@@ -0,0 +1,263 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gencorpus generates a deterministic corpus of encodings of the spec containers.
+//
+// Each container is populated with boundary values for each of a number of variants, and its
+// JSON encoding, SSZ encoding and hash tree root are written to a directory tree that follows
+// the layout of the consensus spec tests:
+//
+//	<fork>/ssz_static/<container>/<variant>/value.json_snappy
+//	<fork>/ssz_static/<container>/<variant>/serialized.ssz_snappy
+//	<fork>/ssz_static/<container>/<variant>/roots.yaml
+//
+// The corpus itself is generated by the round-trip tests, and only the roots are held in
+// testdata, so that changes to the encoding of any container are caught without requiring
+// the consensus spec tests or a large set of golden files.  If a change to an encoding is
+// intended the roots should be regenerated with "go generate".
+package gencorpus
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+)
+
+//go:generate go run ./cmd/gencorpus -dir testdata -roots
+
+// Value is a container that can be encoded in the corpus.
+type Value interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+}
+
+// Container is a container in the corpus.
+type Container struct {
+	// Fork is the fork in which the container is defined, for example "phase0".
+	Fork string
+	// Name is the name of the container, for example "BeaconBlock".
+	Name string
+	// New returns a new, empty, instance of the container.
+	New func() Value
+}
+
+// Vector is a single entry in the corpus.
+type Vector struct {
+	Container *Container
+	Variant   Variant
+	// JSON is the JSON encoding of the value.
+	JSON []byte
+	// SSZ is the SSZ encoding of the value.
+	SSZ []byte
+	// Root is the hash tree root of the value.
+	Root [32]byte
+}
+
+// Dir returns the directory of the vector, relative to the base of the corpus.
+func (v *Vector) Dir() string {
+	return filepath.Join(v.Container.Fork, "ssz_static", v.Container.Name, v.Variant.String())
+}
+
+// Containers returns the containers in the corpus.
+func Containers() []*Container {
+	res := make([]*Container, len(containers))
+	copy(res, containers)
+
+	return res
+}
+
+// NewVector generates the vector for the given container and variant.
+func NewVector(container *Container, variant Variant) (*Vector, error) {
+	value := container.New()
+	if err := Fill(value, variant); err != nil {
+		return nil, errors.Wrap(err, "failed to populate value")
+	}
+
+	jsonData, err := json.Marshal(value)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal JSON")
+	}
+	sszData, err := value.MarshalSSZ()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal SSZ")
+	}
+	root, err := value.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate hash tree root")
+	}
+
+	return &Vector{
+		Container: container,
+		Variant:   variant,
+		JSON:      jsonData,
+		SSZ:       sszData,
+		Root:      root,
+	}, nil
+}
+
+// Vectors generates the vectors for all containers and variants in the corpus.
+func Vectors() ([]*Vector, error) {
+	res := make([]*Vector, 0, len(containers)*len(variants))
+	for _, container := range containers {
+		for _, variant := range variants {
+			vector, err := NewVector(container, variant)
+			if err != nil {
+				return nil, errors.Wrap(err, fmt.Sprintf("failed to generate %s %s %s", container.Fork, container.Name, variant))
+			}
+			res = append(res, vector)
+		}
+	}
+
+	return res, nil
+}
+
+// Write generates the corpus and writes it to the given directory, replacing any existing corpus.
+func Write(dir string) error {
+	vectors, err := Vectors()
+	if err != nil {
+		return err
+	}
+
+	for _, container := range containers {
+		if err := os.RemoveAll(filepath.Join(dir, container.Fork, "ssz_static", container.Name)); err != nil {
+			return errors.Wrap(err, "failed to remove existing corpus")
+		}
+	}
+
+	for _, vector := range vectors {
+		vectorDir := filepath.Join(dir, vector.Dir())
+		if err := os.MkdirAll(vectorDir, 0o755); err != nil {
+			return errors.Wrap(err, "failed to create directory")
+		}
+		if err := os.WriteFile(filepath.Join(vectorDir, "value.json_snappy"), snappy.Encode(nil, vector.JSON), 0o600); err != nil {
+			return errors.Wrap(err, "failed to write JSON")
+		}
+		if err := os.WriteFile(filepath.Join(vectorDir, "serialized.ssz_snappy"), snappy.Encode(nil, vector.SSZ), 0o600); err != nil {
+			return errors.Wrap(err, "failed to write SSZ")
+		}
+		if err := writeRoots(vectorDir, vector); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteRoots generates the corpus and writes only the roots to the given directory, replacing any
+// existing corpus.
+func WriteRoots(dir string) error {
+	vectors, err := Vectors()
+	if err != nil {
+		return err
+	}
+
+	for _, container := range containers {
+		if err := os.RemoveAll(filepath.Join(dir, container.Fork, "ssz_static", container.Name)); err != nil {
+			return errors.Wrap(err, "failed to remove existing corpus")
+		}
+	}
+
+	for _, vector := range vectors {
+		vectorDir := filepath.Join(dir, vector.Dir())
+		if err := os.MkdirAll(vectorDir, 0o755); err != nil {
+			return errors.Wrap(err, "failed to create directory")
+		}
+		if err := writeRoots(vectorDir, vector); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeRoots writes the roots of the vector to the given directory.
+func writeRoots(vectorDir string, vector *Vector) error {
+	if err := os.WriteFile(filepath.Join(vectorDir, "roots.yaml"), []byte(fmt.Sprintf("{root: '%#x'}\n", vector.Root)), 0o600); err != nil {
+		return errors.Wrap(err, "failed to write roots")
+	}
+
+	return nil
+}
+
+// Read reads the vector for the given container and variant from the corpus in the given directory.
+func Read(dir string, container *Container, variant Variant) (*Vector, error) {
+	vector := &Vector{
+		Container: container,
+		Variant:   variant,
+	}
+	vectorDir := filepath.Join(dir, vector.Dir())
+
+	compressed, err := os.ReadFile(filepath.Join(vectorDir, "value.json_snappy"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read JSON")
+	}
+	vector.JSON, err = snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decompress JSON")
+	}
+
+	compressed, err = os.ReadFile(filepath.Join(vectorDir, "serialized.ssz_snappy"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read SSZ")
+	}
+	vector.SSZ, err = snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decompress SSZ")
+	}
+
+	vector.Root, err = readRoot(vectorDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return vector, nil
+}
+
+// ReadRoot reads the root for the given container and variant from the corpus in the given directory.
+func ReadRoot(dir string, container *Container, variant Variant) ([32]byte, error) {
+	vector := &Vector{
+		Container: container,
+		Variant:   variant,
+	}
+
+	return readRoot(filepath.Join(dir, vector.Dir()))
+}
+
+// readRoot reads the root from the given vector directory.
+func readRoot(vectorDir string) ([32]byte, error) {
+	var res [32]byte
+
+	roots, err := os.ReadFile(filepath.Join(vectorDir, "roots.yaml"))
+	if err != nil {
+		return res, errors.Wrap(err, "failed to read roots")
+	}
+	rootStr := strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(string(roots)), "{root: '0x"), "'}")
+	root, err := hex.DecodeString(rootStr)
+	if err != nil {
+		return res, errors.Wrap(err, "failed to parse roots")
+	}
+	if len(root) != len(res) {
+		return res, errors.New("incorrect root length")
+	}
+	copy(res[:], root)
+
+	return res, nil
+}
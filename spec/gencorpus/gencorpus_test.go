@@ -0,0 +1,102 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gencorpus_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/gencorpus"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFill(t *testing.T) {
+	zero := &phase0.Attestation{}
+	require.NoError(t, gencorpus.Fill(zero, gencorpus.VariantZero))
+	require.Equal(t, uint64(0), zero.AggregationBits.Len())
+	require.Equal(t, phase0.Slot(0), zero.Data.Slot)
+
+	max := &phase0.Attestation{}
+	require.NoError(t, gencorpus.Fill(max, gencorpus.VariantMax))
+	require.Equal(t, uint64(2048), max.AggregationBits.Len())
+	require.Equal(t, uint64(2048), max.AggregationBits.Count())
+	require.Equal(t, phase0.Slot(0xffffffffffffffff), max.Data.Slot)
+	require.Equal(t, phase0.BLSSignature{
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	}, max.Signature)
+
+	require.EqualError(t, gencorpus.Fill(phase0.Attestation{}, gencorpus.VariantZero), "value must be a non-nil pointer")
+}
+
+// inconsistentSSZ are containers whose SSZ encoding is known to be out of step with their
+// definition, and so cannot round trip through SSZ.
+var inconsistentSSZ = map[string]string{
+	"phase0/BeaconState":           "generated encoding omits ETH1DepositIndex",
+	"deneb/BeaconBlock":            "generated encoding lags container definition",
+	"deneb/BeaconBlockBody":        "generated encoding lags container definition",
+	"deneb/BeaconState":            "generated encoding lags container definition",
+	"deneb/ExecutionPayload":       "generated encoding lags container definition",
+	"deneb/ExecutionPayloadHeader": "generated encoding lags container definition",
+	"deneb/SignedBeaconBlock":      "generated encoding lags container definition",
+}
+
+// TestCorpus ensures that the encodings of the containers round trip, and that their roots match
+// those in the corpus.
+func TestCorpus(t *testing.T) {
+	for _, container := range gencorpus.Containers() {
+		for _, variant := range gencorpus.Variants() {
+			container := container
+			variant := variant
+			t.Run(fmt.Sprintf("%s/%s/%s", container.Fork, container.Name, variant), func(t *testing.T) {
+				goldenRoot, err := gencorpus.ReadRoot("testdata", container, variant)
+				require.NoError(t, err)
+
+				// Confirm that generation is unchanged.
+				golden, err := gencorpus.NewVector(container, variant)
+				require.NoError(t, err)
+				require.Equal(t, goldenRoot, golden.Root)
+
+				// Round trip through JSON.
+				fromJSON := container.New()
+				require.NoError(t, json.Unmarshal(golden.JSON, fromJSON))
+				remarshalledJSON, err := json.Marshal(fromJSON)
+				require.NoError(t, err)
+				require.Equal(t, string(golden.JSON), string(remarshalledJSON))
+				sszFromJSON, err := fromJSON.MarshalSSZ()
+				require.NoError(t, err)
+				require.Equal(t, golden.SSZ, sszFromJSON)
+
+				// Round trip through SSZ.
+				if reason, exists := inconsistentSSZ[fmt.Sprintf("%s/%s", container.Fork, container.Name)]; exists {
+					t.Skipf("not checking SSZ round trip: %s", reason)
+				}
+				fromSSZ := container.New()
+				require.NoError(t, fromSSZ.UnmarshalSSZ(golden.SSZ))
+				remarshalledSSZ, err := fromSSZ.MarshalSSZ()
+				require.NoError(t, err)
+				require.Equal(t, golden.SSZ, remarshalledSSZ)
+				root, err := fromSSZ.HashTreeRoot()
+				require.NoError(t, err)
+				require.Equal(t, golden.Root, root)
+			})
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package containers provides helpers for the generated Copy and Equal methods of the spec containers.
+package containers
+
+// EqualSlices returns true if the two slices hold the same values.  Nil and empty slices are equal.
+func EqualSlices[S ~[]E, E comparable](a S, b S) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// EqualContainerSlices returns true if the two slices hold equal containers.  Nil and empty slices are equal.
+func EqualContainerSlices[S ~[]E, E interface{ Equal(E) bool }](a S, b S) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// EqualByteSliceSlices returns true if the two slices hold the same byte slices.  Nil and empty slices are equal.
+func EqualByteSliceSlices[S ~[]E, E ~[]byte](a S, b S) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !EqualSlices(a[i], b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// EqualPointers returns true if the two pointers are both nil, or point to equal values.
+func EqualPointers[T comparable](a *T, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
@@ -0,0 +1,197 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command gencopy generates the Copy and Equal methods for the spec containers.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/gencorpus"
+)
+
+const (
+	specPath       = "github.com/attestantio/go-eth2-client/spec"
+	containersPath = "github.com/attestantio/go-eth2-client/spec/internal/containers"
+	outputFile     = "copy.go"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the spec package")
+	flag.Parse()
+
+	if err := generate(*dir); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to generate: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// generate writes the Copy and Equal methods for all containers.
+func generate(dir string) error {
+	types := []reflect.Type{
+		reflect.TypeOf(spec.VersionedBeaconBlock{}),
+		reflect.TypeOf(spec.VersionedBeaconBlockBody{}),
+		reflect.TypeOf(spec.VersionedBeaconState{}),
+		reflect.TypeOf(spec.VersionedSignedBeaconBlock{}),
+	}
+	for _, container := range gencorpus.Containers() {
+		types = append(types, reflect.TypeOf(container.New()).Elem())
+	}
+
+	known := make(map[reflect.Type]bool)
+	byPackage := make(map[string][]reflect.Type)
+	for _, t := range types {
+		known[t] = true
+		byPackage[t.PkgPath()] = append(byPackage[t.PkgPath()], t)
+	}
+
+	for pkgPath, pkgTypes := range byPackage {
+		sort.Slice(pkgTypes, func(i, j int) bool { return pkgTypes[i].Name() < pkgTypes[j].Name() })
+		src, err := generatePackage(pkgPath, pkgTypes, known)
+		if err != nil {
+			return err
+		}
+		outputDir := dir
+		if pkgPath != specPath {
+			outputDir = filepath.Join(dir, strings.TrimPrefix(pkgPath, specPath+"/"))
+		}
+		//nolint:gosec
+		if err := os.WriteFile(filepath.Join(outputDir, outputFile), src, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generatePackage generates the source for the containers in a package.
+func generatePackage(pkgPath string, types []reflect.Type, known map[reflect.Type]bool) ([]byte, error) {
+	body := new(bytes.Buffer)
+	usesContainers := false
+	for _, t := range types {
+		uses, err := generateType(body, t, known)
+		if err != nil {
+			return nil, err
+		}
+		usesContainers = usesContainers || uses
+	}
+
+	src := new(bytes.Buffer)
+	fmt.Fprintf(src, "// Code generated by gencopy. DO NOT EDIT.\n\npackage %s\n\n", path.Base(pkgPath))
+	if usesContainers {
+		fmt.Fprintf(src, "import %q\n\n", containersPath)
+	}
+	src.Write(body.Bytes())
+
+	res, err := format.Source(src.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format source for %s: %w", pkgPath, err)
+	}
+
+	return res, nil
+}
+
+// generateType generates the Copy and Equal methods for a container.
+func generateType(w *bytes.Buffer, t reflect.Type, known map[reflect.Type]bool) (bool, error) {
+	name := t.Name()
+	recv := strings.ToLower(name[:1])
+	usesContainers := false
+
+	copies := new(bytes.Buffer)
+	equals := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			return false, fmt.Errorf("%s.%s: unexported fields are not supported", name, field.Name)
+		}
+		src := fmt.Sprintf("%s.%s", recv, field.Name)
+		dst := fmt.Sprintf("res.%s", field.Name)
+		other := fmt.Sprintf("other.%s", field.Name)
+		ft := field.Type
+
+		switch {
+		case isValue(ft):
+			equals = append(equals, fmt.Sprintf("%s == %s", src, other))
+		case ft.Kind() == reflect.Pointer && known[ft.Elem()]:
+			fmt.Fprintf(copies, "%s = %s.Copy()\n", dst, src)
+			equals = append(equals, fmt.Sprintf("%s.Equal(%s)", src, other))
+		case ft.Kind() == reflect.Pointer && isValue(ft.Elem()):
+			fmt.Fprintf(copies, "if %s != nil {\nv := *%s\n%s = &v\n}\n", src, src, dst)
+			equals = append(equals, fmt.Sprintf("containers.EqualPointers(%s, %s)", src, other))
+			usesContainers = true
+		case ft.Kind() == reflect.Slice && isValue(ft.Elem()):
+			fmt.Fprintf(copies, "%s = append(%s[:0:0], %s...)\n", dst, src, src)
+			equals = append(equals, fmt.Sprintf("containers.EqualSlices(%s, %s)", src, other))
+			usesContainers = true
+		case ft.Kind() == reflect.Slice && ft.Elem().Kind() == reflect.Pointer && known[ft.Elem().Elem()]:
+			fmt.Fprintf(copies, "%s = append(%s[:0:0], %s...)\nfor i := range %s {\n%s[i] = %s[i].Copy()\n}\n", dst, src, src, src, dst, src)
+			equals = append(equals, fmt.Sprintf("containers.EqualContainerSlices(%s, %s)", src, other))
+			usesContainers = true
+		case ft.Kind() == reflect.Slice && ft.Elem().Kind() == reflect.Slice && ft.Elem().Elem().Kind() == reflect.Uint8:
+			fmt.Fprintf(copies, "%s = append(%s[:0:0], %s...)\nfor i := range %s {\n%s[i] = append(%s[i][:0:0], %s[i]...)\n}\n", dst, src, src, src, dst, src, src)
+			equals = append(equals, fmt.Sprintf("containers.EqualByteSliceSlices(%s, %s)", src, other))
+			usesContainers = true
+		default:
+			return false, fmt.Errorf("%s.%s: unsupported type %v", name, field.Name, ft)
+		}
+	}
+
+	fmt.Fprintf(w, "// Copy returns a deep copy of the %s.\n", name)
+	fmt.Fprintf(w, "func (%s *%s) Copy() *%s {\nif %s == nil {\nreturn nil\n}\n\nres := *%s\n", recv, name, name, recv, recv)
+	w.Write(copies.Bytes())
+	fmt.Fprintf(w, "\nreturn &res\n}\n\n")
+
+	fmt.Fprintf(w, "// Equal returns true if the %s holds the same data as other.\n", name)
+	fmt.Fprintf(w, "func (%s *%s) Equal(other *%s) bool {\nif %s == nil || other == nil {\nreturn %s == other\n}\n\n", recv, name, name, recv, recv)
+	if len(equals) == 0 {
+		fmt.Fprintf(w, "return true\n}\n\n")
+	} else {
+		fmt.Fprintf(w, "return %s\n}\n\n", strings.Join(equals, " &&\n"))
+	}
+
+	return usesContainers, nil
+}
+
+// isValue returns true if values of the type do not reference other memory, so can be
+// copied by assignment and compared with ==.
+func isValue(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.String:
+		return true
+	case reflect.Array:
+		return isValue(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !isValue(t.Field(i).Type) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jsonscan decodes the JSON objects of the spec types without the reflection used by
+// encoding/json, for types that are decoded in bulk.
+//
+// The scanner accepts only the subset of JSON produced for spec types by beacon nodes: objects
+// with known keys whose values are strings without escapes, booleans or nested objects.  Any
+// other input returns ErrUnsupported, in which case the caller should decode the input with
+// encoding/json so that behaviour and error messages are unchanged.
+package jsonscan
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrUnsupported is returned for input that is outside of the subset handled by the scanner.
+var ErrUnsupported = errors.New("unsupported JSON")
+
+// Fields calls fn with the key and raw value of each field of the JSON object in input.
+// The input is copied to a string once, and the keys and values are substrings of it, to
+// avoid an allocation per field.
+func Fields(data []byte, fn func(key string, value string) error) error {
+	input := string(data)
+	i := skipWhitespace(input, 0)
+	if i >= len(input) || input[i] != '{' {
+		return ErrUnsupported
+	}
+	i = skipWhitespace(input, i+1)
+	if i < len(input) && input[i] == '}' {
+		return end(input, i+1)
+	}
+
+	for {
+		keyStart := i
+		keyEnd, err := scanString(input, i)
+		if err != nil {
+			return err
+		}
+		i = skipWhitespace(input, keyEnd)
+		if i >= len(input) || input[i] != ':' {
+			return ErrUnsupported
+		}
+		i = skipWhitespace(input, i+1)
+		valueEnd, err := scanValue(input, i)
+		if err != nil {
+			return err
+		}
+		if err := fn(input[keyStart+1:keyEnd-1], input[i:valueEnd]); err != nil {
+			return err
+		}
+		i = skipWhitespace(input, valueEnd)
+		if i >= len(input) {
+			return ErrUnsupported
+		}
+		switch input[i] {
+		case ',':
+			i = skipWhitespace(input, i+1)
+		case '}':
+			return end(input, i+1)
+		default:
+			return ErrUnsupported
+		}
+	}
+}
+
+// String returns the contents of a raw JSON string value as supplied to a Fields callback.
+func String(value string) (string, error) {
+	if len(value) < 2 || value[0] != '"' {
+		return "", ErrUnsupported
+	}
+
+	return value[1 : len(value)-1], nil
+}
+
+// Bool returns a raw JSON boolean value as supplied to a Fields callback.
+func Bool(value string) (bool, error) {
+	switch value {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	default:
+		return false, ErrUnsupported
+	}
+}
+
+// Object decodes a raw JSON object value as supplied to a Fields callback with its UnmarshalJSON method.
+// A null value returns nil.
+func Object[T any, PT interface {
+	*T
+	json.Unmarshaler
+}](value string,
+) (PT, error) {
+	if value == "null" {
+		return nil, nil
+	}
+	if len(value) == 0 || value[0] != '{' {
+		return nil, ErrUnsupported
+	}
+	res := PT(new(T))
+	if err := res.UnmarshalJSON([]byte(value)); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// end returns ErrUnsupported if there is anything other than whitespace after the object.
+func end(input string, i int) error {
+	if skipWhitespace(input, i) != len(input) {
+		return ErrUnsupported
+	}
+
+	return nil
+}
+
+func skipWhitespace(input string, i int) int {
+	for i < len(input) {
+		switch input[i] {
+		case ' ', '\t', '\n', '\r':
+			i++
+		default:
+			return i
+		}
+	}
+
+	return i
+}
+
+// scanString returns the index after the string starting at i.
+// Strings with escapes, control characters or non-ASCII characters are unsupported, as
+// encoding/json transforms them.
+func scanString(input string, i int) (int, error) {
+	if i >= len(input) || input[i] != '"' {
+		return 0, ErrUnsupported
+	}
+	for j := i + 1; j < len(input); j++ {
+		switch c := input[j]; {
+		case c == '"':
+			return j + 1, nil
+		case c == '\\' || c < 0x20 || c >= 0x80:
+			return 0, ErrUnsupported
+		}
+	}
+
+	return 0, ErrUnsupported
+}
+
+// scanValue returns the index after the value starting at i.
+func scanValue(input string, i int) (int, error) {
+	if i >= len(input) {
+		return 0, ErrUnsupported
+	}
+	switch input[i] {
+	case '"':
+		return scanString(input, i)
+	case '{', '[':
+		return scanNested(input, i)
+	case 't':
+		return scanLiteral(input, i, "true")
+	case 'f':
+		return scanLiteral(input, i, "false")
+	case 'n':
+		return scanLiteral(input, i, "null")
+	default:
+		return 0, ErrUnsupported
+	}
+}
+
+// scanNested returns the index after the object or array starting at i.
+// The content is not validated; that is left to the decoder of the nested value.
+func scanNested(input string, i int) (int, error) {
+	depth := 0
+	for j := i; j < len(input); j++ {
+		switch input[j] {
+		case '"':
+			end, err := scanString(input, j)
+			if err != nil {
+				return 0, err
+			}
+			j = end - 1
+		case '{', '[':
+			depth++
+		case '}', ']':
+			depth--
+			if depth == 0 {
+				return j + 1, nil
+			}
+		}
+	}
+
+	return 0, ErrUnsupported
+}
+
+func scanLiteral(input string, i int, literal string) (int, error) {
+	if len(input)-i < len(literal) || input[i:i+len(literal)] != literal {
+		return 0, ErrUnsupported
+	}
+
+	return i + len(literal), nil
+}
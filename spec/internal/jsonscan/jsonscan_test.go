@@ -0,0 +1,162 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonscan_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/internal/jsonscan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		fields map[string]string
+		err    error
+	}{
+		{
+			name:   "Empty",
+			input:  "{}",
+			fields: map[string]string{},
+		},
+		{
+			name:  "Values",
+			input: " {\"a\": \"1\",\n\"b\":true, \"c\" : false, \"d\":null, \"e\":{\"f\":[\"}\",{}]}} ",
+			fields: map[string]string{
+				"a": `"1"`,
+				"b": "true",
+				"c": "false",
+				"d": "null",
+				"e": `{"f":["}",{}]}`,
+			},
+		},
+		{
+			name:  "Nil",
+			input: "",
+			err:   jsonscan.ErrUnsupported,
+		},
+		{
+			name:  "Array",
+			input: "[]",
+			err:   jsonscan.ErrUnsupported,
+		},
+		{
+			name:  "Number",
+			input: `{"a":1}`,
+			err:   jsonscan.ErrUnsupported,
+		},
+		{
+			name:  "Escape",
+			input: `{"a":"\u0031"}`,
+			err:   jsonscan.ErrUnsupported,
+		},
+		{
+			name:  "NonASCII",
+			input: `{"a":"é"}`,
+			err:   jsonscan.ErrUnsupported,
+		},
+		{
+			name:  "Unterminated",
+			input: `{"a":"1"`,
+			err:   jsonscan.ErrUnsupported,
+		},
+		{
+			name:  "UnterminatedNested",
+			input: `{"a":{"b":"1"}`,
+			err:   jsonscan.ErrUnsupported,
+		},
+		{
+			name:  "MissingColon",
+			input: `{"a" "1"}`,
+			err:   jsonscan.ErrUnsupported,
+		},
+		{
+			name:  "TrailingComma",
+			input: `{"a":"1",}`,
+			err:   jsonscan.ErrUnsupported,
+		},
+		{
+			name:  "TrailingData",
+			input: `{"a":"1"}{}`,
+			err:   jsonscan.ErrUnsupported,
+		},
+		{
+			name:  "BadLiteral",
+			input: `{"a":tru}`,
+			err:   jsonscan.ErrUnsupported,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fields := make(map[string]string)
+			err := jsonscan.Fields([]byte(test.input), func(key string, value string) error {
+				fields[key] = value
+				return nil
+			})
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.fields, fields)
+			}
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	res, err := jsonscan.String(`"0x01"`)
+	require.NoError(t, err)
+	require.Equal(t, "0x01", res)
+
+	_, err = jsonscan.String("null")
+	require.ErrorIs(t, err, jsonscan.ErrUnsupported)
+}
+
+func TestBool(t *testing.T) {
+	res, err := jsonscan.Bool("true")
+	require.NoError(t, err)
+	require.True(t, res)
+
+	res, err = jsonscan.Bool("false")
+	require.NoError(t, err)
+	require.False(t, res)
+
+	_, err = jsonscan.Bool(`"true"`)
+	require.ErrorIs(t, err, jsonscan.ErrUnsupported)
+}
+
+type object struct {
+	input string
+}
+
+func (o *object) UnmarshalJSON(input []byte) error {
+	o.input = string(input)
+	return nil
+}
+
+func TestObject(t *testing.T) {
+	res, err := jsonscan.Object[object](`{"a":"1"}`)
+	require.NoError(t, err)
+	require.Equal(t, `{"a":"1"}`, res.input)
+
+	res, err = jsonscan.Object[object]("null")
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	_, err = jsonscan.Object[object](`"1"`)
+	require.ErrorIs(t, err, jsonscan.ErrUnsupported)
+}
@@ -0,0 +1,166 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testutil provides a harness to run the consensus spec tests against the spec containers.
+package testutil
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/goccy/go-yaml"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/require"
+)
+
+// Container is a spec container that can be tested against the ssz_static spec tests.
+type Container interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+}
+
+// SSZStaticTest is a test of a container against the ssz_static spec tests.
+type SSZStaticTest struct {
+	// Name is the name of the container in the spec tests, for example "BeaconBlock".
+	Name string
+	// New returns a new, empty, instance of the container.
+	New func() Container
+}
+
+// snappyBuffers are buffers for decompressed SSZ, shared between tests to reduce allocations.
+var snappyBuffers = sync.Pool{
+	New: func() any {
+		return new([]byte)
+	},
+}
+
+// SpecTestsDir returns the directory of the consensus spec tests, or an empty string if
+// it has not been supplied.
+func SpecTestsDir() string {
+	if dir := os.Getenv("CONSENSUS_SPEC_TESTS_DIR"); dir != "" {
+		return dir
+	}
+
+	return os.Getenv("ETH2_SPEC_TESTS_DIR")
+}
+
+// RunSSZStatic runs the ssz_static mainnet spec tests of the given fork for each of the tests.
+// Tests, and the cases within them, run in parallel.
+func RunSSZStatic(t *testing.T, fork string, tests []*SSZStaticTest) {
+	t.Helper()
+
+	specTestsDir := SpecTestsDir()
+	if specTestsDir == "" {
+		t.Skip("CONSENSUS_SPEC_TESTS_DIR not supplied, not running spec tests")
+	}
+	baseDir := filepath.Join(specTestsDir, "tests", "mainnet", fork, "ssz_static")
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := filepath.Join(baseDir, test.Name, "ssz_random")
+			entries, err := os.ReadDir(dir)
+			if os.IsNotExist(err) {
+				t.Skipf("no spec tests for %s", test.Name)
+			}
+			require.NoError(t, err)
+			for _, entry := range entries {
+				if !entry.IsDir() {
+					continue
+				}
+				path := filepath.Join(dir, entry.Name())
+				t.Run(entry.Name(), func(t *testing.T) {
+					t.Parallel()
+					runSSZStaticCase(t, test, path)
+				})
+			}
+		})
+	}
+}
+
+// runSSZStaticCase runs a single ssz_static spec test case.
+func runSSZStaticCase(t *testing.T, test *SSZStaticTest, path string) {
+	t.Helper()
+
+	// Obtain the container from the YAML.
+	specYAML, err := os.ReadFile(filepath.Join(path, "value.yaml"))
+	require.NoError(t, err)
+	fromYAML := test.New()
+	require.NoError(t, yaml.Unmarshal(specYAML, fromYAML))
+	// Confirm we can return to the YAML.
+	remarshalledSpecYAML, err := yaml.Marshal(fromYAML)
+	require.NoError(t, err)
+	require.Equal(t, YAMLFormat(specYAML), YAMLFormat(remarshalledSpecYAML))
+
+	// Obtain the container from the SSZ.
+	compressedSpecSSZ, err := os.ReadFile(filepath.Join(path, "serialized.ssz_snappy"))
+	require.NoError(t, err)
+	buf := snappyBuffers.Get().(*[]byte)
+	defer snappyBuffers.Put(buf)
+	specSSZ, err := decodeSnappy(buf, compressedSpecSSZ)
+	require.NoError(t, err)
+	fromSSZ := test.New()
+	require.NoError(t, fromSSZ.UnmarshalSSZ(specSSZ))
+	// Confirm we can return to the SSZ, from both the SSZ and the YAML.
+	remarshalledSpecSSZ, err := fromSSZ.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, specSSZ, remarshalledSpecSSZ)
+	yamlSSZ, err := fromYAML.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, specSSZ, yamlSSZ)
+
+	// Confirm we calculate the same root.
+	specYAMLRoot, err := os.ReadFile(filepath.Join(path, "roots.yaml"))
+	require.NoError(t, err)
+	root, err := fromSSZ.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, string(specYAMLRoot), fmt.Sprintf("{root: '%#x'}\n", root))
+}
+
+// decodeSnappy decodes snappy-compressed data into the buffer, growing it if required.
+// The returned data is only valid until the buffer is reused.
+func decodeSnappy(buf *[]byte, compressed []byte) ([]byte, error) {
+	length, err := snappy.DecodedLen(compressed)
+	if err != nil {
+		return nil, err
+	}
+	if cap(*buf) < length {
+		*buf = make([]byte, length)
+	}
+
+	return snappy.Decode((*buf)[:length], compressed)
+}
+
+// YAMLFormat returns the YAML in a canonical form, allowing YAML from different sources
+// to be compared.
+func YAMLFormat(input []byte) string {
+	val := make(map[string]any)
+	if err := yaml.UnmarshalWithOptions(input, &val, yaml.UseOrderedMap()); err != nil {
+		panic(err)
+	}
+
+	res, err := yaml.MarshalWithOptions(val, yaml.Flow(true))
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes.ToLower(bytes.ReplaceAll(res, []byte(`"`), []byte(`'`))))
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/spec/internal/jsonscan"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 	bitfield "github.com/prysmaticlabs/go-bitfield"
@@ -58,13 +59,42 @@ func (a *Attestation) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (a *Attestation) UnmarshalJSON(input []byte) error {
 	var attestationJSON attestationJSON
-	err := json.Unmarshal(input, &attestationJSON)
-	if err != nil {
-		return errors.Wrap(err, "invalid JSON")
+	if err := attestationJSON.scan(input); err != nil {
+		// Input that cannot be scanned is handled by the standard decoder.
+		if err := json.Unmarshal(input, &attestationJSON); err != nil {
+			return errors.Wrap(err, "invalid JSON")
+		}
 	}
 	return a.unpack(&attestationJSON)
 }
 
+// scan populates the struct from the input without reflection.
+// The struct is left untouched if the input cannot be scanned.
+func (j *attestationJSON) scan(input []byte) error {
+	var res attestationJSON
+	err := jsonscan.Fields(input, func(key string, value string) error {
+		var err error
+		switch key {
+		case "aggregation_bits":
+			res.AggregationBits, err = jsonscan.String(value)
+		case "data":
+			res.Data, err = jsonscan.Object[AttestationData](value)
+		case "signature":
+			res.Signature, err = jsonscan.String(value)
+		default:
+			err = jsonscan.ErrUnsupported
+		}
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	*j = res
+
+	return nil
+}
+
 func (a *Attestation) unpack(attestationJSON *attestationJSON) error {
 	var err error
 	if attestationJSON.AggregationBits == "" {
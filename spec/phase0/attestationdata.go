@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/spec/internal/jsonscan"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -66,12 +67,46 @@ func (a *AttestationData) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (a *AttestationData) UnmarshalJSON(input []byte) error {
 	var attestationDataJSON attestationDataJSON
-	if err := json.Unmarshal(input, &attestationDataJSON); err != nil {
-		return errors.Wrap(err, "invalid JSON")
+	if err := attestationDataJSON.scan(input); err != nil {
+		// Input that cannot be scanned is handled by the standard decoder.
+		if err := json.Unmarshal(input, &attestationDataJSON); err != nil {
+			return errors.Wrap(err, "invalid JSON")
+		}
 	}
 	return a.unpack(&attestationDataJSON)
 }
 
+// scan populates the struct from the input without reflection.
+// The struct is left untouched if the input cannot be scanned.
+func (j *attestationDataJSON) scan(input []byte) error {
+	var res attestationDataJSON
+	err := jsonscan.Fields(input, func(key string, value string) error {
+		var err error
+		switch key {
+		case "slot":
+			res.Slot, err = jsonscan.String(value)
+		case "index":
+			res.Index, err = jsonscan.String(value)
+		case "beacon_block_root":
+			res.BeaconBlockRoot, err = jsonscan.String(value)
+		case "source":
+			res.Source, err = jsonscan.Object[Checkpoint](value)
+		case "target":
+			res.Target, err = jsonscan.Object[Checkpoint](value)
+		default:
+			err = jsonscan.ErrUnsupported
+		}
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	*j = res
+
+	return nil
+}
+
 func (a *AttestationData) unpack(attestationDataJSON *attestationDataJSON) error {
 	if attestationDataJSON.Slot == "" {
 		return errors.New("slot missing")
@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/spec/internal/jsonscan"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -66,12 +67,46 @@ func (b *BeaconBlockHeader) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (b *BeaconBlockHeader) UnmarshalJSON(input []byte) error {
 	var beaconBlockHeaderJSON beaconBlockHeaderJSON
-	if err := json.Unmarshal(input, &beaconBlockHeaderJSON); err != nil {
-		return errors.Wrap(err, "invalid JSON")
+	if err := beaconBlockHeaderJSON.scan(input); err != nil {
+		// Input that cannot be scanned is handled by the standard decoder.
+		if err := json.Unmarshal(input, &beaconBlockHeaderJSON); err != nil {
+			return errors.Wrap(err, "invalid JSON")
+		}
 	}
 	return b.unpack(&beaconBlockHeaderJSON)
 }
 
+// scan populates the struct from the input without reflection.
+// The struct is left untouched if the input cannot be scanned.
+func (j *beaconBlockHeaderJSON) scan(input []byte) error {
+	var res beaconBlockHeaderJSON
+	err := jsonscan.Fields(input, func(key string, value string) error {
+		var err error
+		switch key {
+		case "slot":
+			res.Slot, err = jsonscan.String(value)
+		case "proposer_index":
+			res.ProposerIndex, err = jsonscan.String(value)
+		case "parent_root":
+			res.ParentRoot, err = jsonscan.String(value)
+		case "state_root":
+			res.StateRoot, err = jsonscan.String(value)
+		case "body_root":
+			res.BodyRoot, err = jsonscan.String(value)
+		default:
+			err = jsonscan.ErrUnsupported
+		}
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	*j = res
+
+	return nil
+}
+
 func (b *BeaconBlockHeader) unpack(beaconBlockHeaderJSON *beaconBlockHeaderJSON) error {
 	if beaconBlockHeaderJSON.Slot == "" {
 		return errors.New("slot missing")
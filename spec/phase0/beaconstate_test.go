@@ -0,0 +1,37 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/gencorpus"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconStateETH1DepositIndexJSON(t *testing.T) {
+	state := &phase0.BeaconState{}
+	require.NoError(t, gencorpus.Fill(state, gencorpus.VariantZero))
+	state.ETH1DepositIndex = 12345
+
+	data, err := json.Marshal(state)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"eth1_deposit_index":"12345"`)
+
+	var res phase0.BeaconState
+	require.NoError(t, json.Unmarshal(data, &res))
+	require.Equal(t, uint64(12345), res.ETH1DepositIndex)
+}
@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/spec/internal/jsonscan"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -54,13 +55,40 @@ func (c *Checkpoint) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (c *Checkpoint) UnmarshalJSON(input []byte) error {
 	var checkpointJSON checkpointJSON
-	err := json.Unmarshal(input, &checkpointJSON)
-	if err != nil {
-		return errors.Wrap(err, "invalid JSON")
+	if err := checkpointJSON.scan(input); err != nil {
+		// Input that cannot be scanned is handled by the standard decoder.
+		if err := json.Unmarshal(input, &checkpointJSON); err != nil {
+			return errors.Wrap(err, "invalid JSON")
+		}
 	}
 	return c.unpack(&checkpointJSON)
 }
 
+// scan populates the struct from the input without reflection.
+// The struct is left untouched if the input cannot be scanned.
+func (j *checkpointJSON) scan(input []byte) error {
+	var res checkpointJSON
+	err := jsonscan.Fields(input, func(key string, value string) error {
+		var err error
+		switch key {
+		case "epoch":
+			res.Epoch, err = jsonscan.String(value)
+		case "root":
+			res.Root, err = jsonscan.String(value)
+		default:
+			err = jsonscan.ErrUnsupported
+		}
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	*j = res
+
+	return nil
+}
+
 func (c *Checkpoint) unpack(checkpointJSON *checkpointJSON) error {
 	if checkpointJSON.Epoch == "" {
 		return errors.New("epoch missing")
@@ -0,0 +1,119 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/internal/testutil"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// TestConsensusSpec tests the types against the Ethereum consensus spec tests.
+func TestConsensusSpec(t *testing.T) {
+	testutil.RunSSZStatic(t, "phase0", []*testutil.SSZStaticTest{
+		{
+			Name: "AggregateAndProof",
+			New:  func() testutil.Container { return &phase0.AggregateAndProof{} },
+		},
+		{
+			Name: "Attestation",
+			New:  func() testutil.Container { return &phase0.Attestation{} },
+		},
+		{
+			Name: "AttestationData",
+			New:  func() testutil.Container { return &phase0.AttestationData{} },
+		},
+		{
+			Name: "AttesterSlashing",
+			New:  func() testutil.Container { return &phase0.AttesterSlashing{} },
+		},
+		{
+			Name: "BeaconBlock",
+			New:  func() testutil.Container { return &phase0.BeaconBlock{} },
+		},
+		{
+			Name: "BeaconBlockBody",
+			New:  func() testutil.Container { return &phase0.BeaconBlockBody{} },
+		},
+		{
+			Name: "BeaconBlockHeader",
+			New:  func() testutil.Container { return &phase0.BeaconBlockHeader{} },
+		},
+		{
+			Name: "Checkpoint",
+			New:  func() testutil.Container { return &phase0.Checkpoint{} },
+		},
+		{
+			Name: "Deposit",
+			New:  func() testutil.Container { return &phase0.Deposit{} },
+		},
+		{
+			Name: "DepositData",
+			New:  func() testutil.Container { return &phase0.DepositData{} },
+		},
+		{
+			Name: "DepositMessage",
+			New:  func() testutil.Container { return &phase0.DepositMessage{} },
+		},
+		{
+			Name: "Eth1Data",
+			New:  func() testutil.Container { return &phase0.ETH1Data{} },
+		},
+		{
+			Name: "Fork",
+			New:  func() testutil.Container { return &phase0.Fork{} },
+		},
+		{
+			Name: "ForkData",
+			New:  func() testutil.Container { return &phase0.ForkData{} },
+		},
+		{
+			Name: "IndexedAttestation",
+			New:  func() testutil.Container { return &phase0.IndexedAttestation{} },
+		},
+		{
+			Name: "PendingAttestation",
+			New:  func() testutil.Container { return &phase0.PendingAttestation{} },
+		},
+		{
+			Name: "ProposerSlashing",
+			New:  func() testutil.Container { return &phase0.ProposerSlashing{} },
+		},
+		{
+			Name: "SignedAggregateAndProof",
+			New:  func() testutil.Container { return &phase0.SignedAggregateAndProof{} },
+		},
+		{
+			Name: "SignedBeaconBlock",
+			New:  func() testutil.Container { return &phase0.SignedBeaconBlock{} },
+		},
+		{
+			Name: "SignedBeaconBlockHeader",
+			New:  func() testutil.Container { return &phase0.SignedBeaconBlockHeader{} },
+		},
+		{
+			Name: "SignedVoluntaryExit",
+			New:  func() testutil.Container { return &phase0.SignedVoluntaryExit{} },
+		},
+		{
+			Name: "Validator",
+			New:  func() testutil.Container { return &phase0.Validator{} },
+		},
+		{
+			Name: "VoluntaryExit",
+			New:  func() testutil.Container { return &phase0.VoluntaryExit{} },
+		},
+	})
+}
@@ -36,3 +36,6 @@ const DomainLength = 32
 
 // Hash32Length is the number of bytes in a 32-byte hash.
 const Hash32Length = 32
+
+// WithdrawalCredentialsLength is the number of bytes in withdrawal credentials.
+const WithdrawalCredentialsLength = 32
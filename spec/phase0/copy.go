@@ -0,0 +1,657 @@
+// Code generated by gencopy. DO NOT EDIT.
+
+package phase0
+
+import "github.com/attestantio/go-eth2-client/spec/internal/containers"
+
+// Copy returns a deep copy of the AggregateAndProof.
+func (a *AggregateAndProof) Copy() *AggregateAndProof {
+	if a == nil {
+		return nil
+	}
+
+	res := *a
+	res.Aggregate = a.Aggregate.Copy()
+
+	return &res
+}
+
+// Equal returns true if the AggregateAndProof holds the same data as other.
+func (a *AggregateAndProof) Equal(other *AggregateAndProof) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+
+	return a.AggregatorIndex == other.AggregatorIndex &&
+		a.Aggregate.Equal(other.Aggregate) &&
+		a.SelectionProof == other.SelectionProof
+}
+
+// Copy returns a deep copy of the Attestation.
+func (a *Attestation) Copy() *Attestation {
+	if a == nil {
+		return nil
+	}
+
+	res := *a
+	res.AggregationBits = append(a.AggregationBits[:0:0], a.AggregationBits...)
+	res.Data = a.Data.Copy()
+
+	return &res
+}
+
+// Equal returns true if the Attestation holds the same data as other.
+func (a *Attestation) Equal(other *Attestation) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+
+	return containers.EqualSlices(a.AggregationBits, other.AggregationBits) &&
+		a.Data.Equal(other.Data) &&
+		a.Signature == other.Signature
+}
+
+// Copy returns a deep copy of the AttestationData.
+func (a *AttestationData) Copy() *AttestationData {
+	if a == nil {
+		return nil
+	}
+
+	res := *a
+	res.Source = a.Source.Copy()
+	res.Target = a.Target.Copy()
+
+	return &res
+}
+
+// Equal returns true if the AttestationData holds the same data as other.
+func (a *AttestationData) Equal(other *AttestationData) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+
+	return a.Slot == other.Slot &&
+		a.Index == other.Index &&
+		a.BeaconBlockRoot == other.BeaconBlockRoot &&
+		a.Source.Equal(other.Source) &&
+		a.Target.Equal(other.Target)
+}
+
+// Copy returns a deep copy of the AttesterSlashing.
+func (a *AttesterSlashing) Copy() *AttesterSlashing {
+	if a == nil {
+		return nil
+	}
+
+	res := *a
+	res.Attestation1 = a.Attestation1.Copy()
+	res.Attestation2 = a.Attestation2.Copy()
+
+	return &res
+}
+
+// Equal returns true if the AttesterSlashing holds the same data as other.
+func (a *AttesterSlashing) Equal(other *AttesterSlashing) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+
+	return a.Attestation1.Equal(other.Attestation1) &&
+		a.Attestation2.Equal(other.Attestation2)
+}
+
+// Copy returns a deep copy of the BeaconBlock.
+func (b *BeaconBlock) Copy() *BeaconBlock {
+	if b == nil {
+		return nil
+	}
+
+	res := *b
+	res.Body = b.Body.Copy()
+
+	return &res
+}
+
+// Equal returns true if the BeaconBlock holds the same data as other.
+func (b *BeaconBlock) Equal(other *BeaconBlock) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+
+	return b.Slot == other.Slot &&
+		b.ProposerIndex == other.ProposerIndex &&
+		b.ParentRoot == other.ParentRoot &&
+		b.StateRoot == other.StateRoot &&
+		b.Body.Equal(other.Body)
+}
+
+// Copy returns a deep copy of the BeaconBlockBody.
+func (b *BeaconBlockBody) Copy() *BeaconBlockBody {
+	if b == nil {
+		return nil
+	}
+
+	res := *b
+	res.ETH1Data = b.ETH1Data.Copy()
+	res.ProposerSlashings = append(b.ProposerSlashings[:0:0], b.ProposerSlashings...)
+	for i := range b.ProposerSlashings {
+		res.ProposerSlashings[i] = b.ProposerSlashings[i].Copy()
+	}
+	res.AttesterSlashings = append(b.AttesterSlashings[:0:0], b.AttesterSlashings...)
+	for i := range b.AttesterSlashings {
+		res.AttesterSlashings[i] = b.AttesterSlashings[i].Copy()
+	}
+	res.Attestations = append(b.Attestations[:0:0], b.Attestations...)
+	for i := range b.Attestations {
+		res.Attestations[i] = b.Attestations[i].Copy()
+	}
+	res.Deposits = append(b.Deposits[:0:0], b.Deposits...)
+	for i := range b.Deposits {
+		res.Deposits[i] = b.Deposits[i].Copy()
+	}
+	res.VoluntaryExits = append(b.VoluntaryExits[:0:0], b.VoluntaryExits...)
+	for i := range b.VoluntaryExits {
+		res.VoluntaryExits[i] = b.VoluntaryExits[i].Copy()
+	}
+
+	return &res
+}
+
+// Equal returns true if the BeaconBlockBody holds the same data as other.
+func (b *BeaconBlockBody) Equal(other *BeaconBlockBody) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+
+	return b.RANDAOReveal == other.RANDAOReveal &&
+		b.ETH1Data.Equal(other.ETH1Data) &&
+		b.Graffiti == other.Graffiti &&
+		containers.EqualContainerSlices(b.ProposerSlashings, other.ProposerSlashings) &&
+		containers.EqualContainerSlices(b.AttesterSlashings, other.AttesterSlashings) &&
+		containers.EqualContainerSlices(b.Attestations, other.Attestations) &&
+		containers.EqualContainerSlices(b.Deposits, other.Deposits) &&
+		containers.EqualContainerSlices(b.VoluntaryExits, other.VoluntaryExits)
+}
+
+// Copy returns a deep copy of the BeaconBlockHeader.
+func (b *BeaconBlockHeader) Copy() *BeaconBlockHeader {
+	if b == nil {
+		return nil
+	}
+
+	res := *b
+
+	return &res
+}
+
+// Equal returns true if the BeaconBlockHeader holds the same data as other.
+func (b *BeaconBlockHeader) Equal(other *BeaconBlockHeader) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+
+	return b.Slot == other.Slot &&
+		b.ProposerIndex == other.ProposerIndex &&
+		b.ParentRoot == other.ParentRoot &&
+		b.StateRoot == other.StateRoot &&
+		b.BodyRoot == other.BodyRoot
+}
+
+// Copy returns a deep copy of the BeaconState.
+func (b *BeaconState) Copy() *BeaconState {
+	if b == nil {
+		return nil
+	}
+
+	res := *b
+	res.Fork = b.Fork.Copy()
+	res.LatestBlockHeader = b.LatestBlockHeader.Copy()
+	res.BlockRoots = append(b.BlockRoots[:0:0], b.BlockRoots...)
+	res.StateRoots = append(b.StateRoots[:0:0], b.StateRoots...)
+	res.HistoricalRoots = append(b.HistoricalRoots[:0:0], b.HistoricalRoots...)
+	res.ETH1Data = b.ETH1Data.Copy()
+	res.ETH1DataVotes = append(b.ETH1DataVotes[:0:0], b.ETH1DataVotes...)
+	for i := range b.ETH1DataVotes {
+		res.ETH1DataVotes[i] = b.ETH1DataVotes[i].Copy()
+	}
+	res.Validators = append(b.Validators[:0:0], b.Validators...)
+	for i := range b.Validators {
+		res.Validators[i] = b.Validators[i].Copy()
+	}
+	res.Balances = append(b.Balances[:0:0], b.Balances...)
+	res.RANDAOMixes = append(b.RANDAOMixes[:0:0], b.RANDAOMixes...)
+	res.Slashings = append(b.Slashings[:0:0], b.Slashings...)
+	res.PreviousEpochAttestations = append(b.PreviousEpochAttestations[:0:0], b.PreviousEpochAttestations...)
+	for i := range b.PreviousEpochAttestations {
+		res.PreviousEpochAttestations[i] = b.PreviousEpochAttestations[i].Copy()
+	}
+	res.CurrentEpochAttestations = append(b.CurrentEpochAttestations[:0:0], b.CurrentEpochAttestations...)
+	for i := range b.CurrentEpochAttestations {
+		res.CurrentEpochAttestations[i] = b.CurrentEpochAttestations[i].Copy()
+	}
+	res.JustificationBits = append(b.JustificationBits[:0:0], b.JustificationBits...)
+	res.PreviousJustifiedCheckpoint = b.PreviousJustifiedCheckpoint.Copy()
+	res.CurrentJustifiedCheckpoint = b.CurrentJustifiedCheckpoint.Copy()
+	res.FinalizedCheckpoint = b.FinalizedCheckpoint.Copy()
+
+	return &res
+}
+
+// Equal returns true if the BeaconState holds the same data as other.
+func (b *BeaconState) Equal(other *BeaconState) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+
+	return b.GenesisTime == other.GenesisTime &&
+		b.GenesisValidatorsRoot == other.GenesisValidatorsRoot &&
+		b.Slot == other.Slot &&
+		b.Fork.Equal(other.Fork) &&
+		b.LatestBlockHeader.Equal(other.LatestBlockHeader) &&
+		containers.EqualSlices(b.BlockRoots, other.BlockRoots) &&
+		containers.EqualSlices(b.StateRoots, other.StateRoots) &&
+		containers.EqualSlices(b.HistoricalRoots, other.HistoricalRoots) &&
+		b.ETH1Data.Equal(other.ETH1Data) &&
+		containers.EqualContainerSlices(b.ETH1DataVotes, other.ETH1DataVotes) &&
+		b.ETH1DepositIndex == other.ETH1DepositIndex &&
+		containers.EqualContainerSlices(b.Validators, other.Validators) &&
+		containers.EqualSlices(b.Balances, other.Balances) &&
+		containers.EqualSlices(b.RANDAOMixes, other.RANDAOMixes) &&
+		containers.EqualSlices(b.Slashings, other.Slashings) &&
+		containers.EqualContainerSlices(b.PreviousEpochAttestations, other.PreviousEpochAttestations) &&
+		containers.EqualContainerSlices(b.CurrentEpochAttestations, other.CurrentEpochAttestations) &&
+		containers.EqualSlices(b.JustificationBits, other.JustificationBits) &&
+		b.PreviousJustifiedCheckpoint.Equal(other.PreviousJustifiedCheckpoint) &&
+		b.CurrentJustifiedCheckpoint.Equal(other.CurrentJustifiedCheckpoint) &&
+		b.FinalizedCheckpoint.Equal(other.FinalizedCheckpoint)
+}
+
+// Copy returns a deep copy of the Checkpoint.
+func (c *Checkpoint) Copy() *Checkpoint {
+	if c == nil {
+		return nil
+	}
+
+	res := *c
+
+	return &res
+}
+
+// Equal returns true if the Checkpoint holds the same data as other.
+func (c *Checkpoint) Equal(other *Checkpoint) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+
+	return c.Epoch == other.Epoch &&
+		c.Root == other.Root
+}
+
+// Copy returns a deep copy of the Deposit.
+func (d *Deposit) Copy() *Deposit {
+	if d == nil {
+		return nil
+	}
+
+	res := *d
+	res.Proof = append(d.Proof[:0:0], d.Proof...)
+	for i := range d.Proof {
+		res.Proof[i] = append(d.Proof[i][:0:0], d.Proof[i]...)
+	}
+	res.Data = d.Data.Copy()
+
+	return &res
+}
+
+// Equal returns true if the Deposit holds the same data as other.
+func (d *Deposit) Equal(other *Deposit) bool {
+	if d == nil || other == nil {
+		return d == other
+	}
+
+	return containers.EqualByteSliceSlices(d.Proof, other.Proof) &&
+		d.Data.Equal(other.Data)
+}
+
+// Copy returns a deep copy of the DepositData.
+func (d *DepositData) Copy() *DepositData {
+	if d == nil {
+		return nil
+	}
+
+	res := *d
+	res.WithdrawalCredentials = append(d.WithdrawalCredentials[:0:0], d.WithdrawalCredentials...)
+
+	return &res
+}
+
+// Equal returns true if the DepositData holds the same data as other.
+func (d *DepositData) Equal(other *DepositData) bool {
+	if d == nil || other == nil {
+		return d == other
+	}
+
+	return d.PublicKey == other.PublicKey &&
+		containers.EqualSlices(d.WithdrawalCredentials, other.WithdrawalCredentials) &&
+		d.Amount == other.Amount &&
+		d.Signature == other.Signature
+}
+
+// Copy returns a deep copy of the DepositMessage.
+func (d *DepositMessage) Copy() *DepositMessage {
+	if d == nil {
+		return nil
+	}
+
+	res := *d
+	res.WithdrawalCredentials = append(d.WithdrawalCredentials[:0:0], d.WithdrawalCredentials...)
+
+	return &res
+}
+
+// Equal returns true if the DepositMessage holds the same data as other.
+func (d *DepositMessage) Equal(other *DepositMessage) bool {
+	if d == nil || other == nil {
+		return d == other
+	}
+
+	return d.PublicKey == other.PublicKey &&
+		containers.EqualSlices(d.WithdrawalCredentials, other.WithdrawalCredentials) &&
+		d.Amount == other.Amount
+}
+
+// Copy returns a deep copy of the ETH1Data.
+func (e *ETH1Data) Copy() *ETH1Data {
+	if e == nil {
+		return nil
+	}
+
+	res := *e
+	res.BlockHash = append(e.BlockHash[:0:0], e.BlockHash...)
+
+	return &res
+}
+
+// Equal returns true if the ETH1Data holds the same data as other.
+func (e *ETH1Data) Equal(other *ETH1Data) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+
+	return e.DepositRoot == other.DepositRoot &&
+		e.DepositCount == other.DepositCount &&
+		containers.EqualSlices(e.BlockHash, other.BlockHash)
+}
+
+// Copy returns a deep copy of the Fork.
+func (f *Fork) Copy() *Fork {
+	if f == nil {
+		return nil
+	}
+
+	res := *f
+
+	return &res
+}
+
+// Equal returns true if the Fork holds the same data as other.
+func (f *Fork) Equal(other *Fork) bool {
+	if f == nil || other == nil {
+		return f == other
+	}
+
+	return f.PreviousVersion == other.PreviousVersion &&
+		f.CurrentVersion == other.CurrentVersion &&
+		f.Epoch == other.Epoch
+}
+
+// Copy returns a deep copy of the ForkData.
+func (f *ForkData) Copy() *ForkData {
+	if f == nil {
+		return nil
+	}
+
+	res := *f
+
+	return &res
+}
+
+// Equal returns true if the ForkData holds the same data as other.
+func (f *ForkData) Equal(other *ForkData) bool {
+	if f == nil || other == nil {
+		return f == other
+	}
+
+	return f.CurrentVersion == other.CurrentVersion &&
+		f.GenesisValidatorsRoot == other.GenesisValidatorsRoot
+}
+
+// Copy returns a deep copy of the IndexedAttestation.
+func (i *IndexedAttestation) Copy() *IndexedAttestation {
+	if i == nil {
+		return nil
+	}
+
+	res := *i
+	res.AttestingIndices = append(i.AttestingIndices[:0:0], i.AttestingIndices...)
+	res.Data = i.Data.Copy()
+
+	return &res
+}
+
+// Equal returns true if the IndexedAttestation holds the same data as other.
+func (i *IndexedAttestation) Equal(other *IndexedAttestation) bool {
+	if i == nil || other == nil {
+		return i == other
+	}
+
+	return containers.EqualSlices(i.AttestingIndices, other.AttestingIndices) &&
+		i.Data.Equal(other.Data) &&
+		i.Signature == other.Signature
+}
+
+// Copy returns a deep copy of the PendingAttestation.
+func (p *PendingAttestation) Copy() *PendingAttestation {
+	if p == nil {
+		return nil
+	}
+
+	res := *p
+	res.AggregationBits = append(p.AggregationBits[:0:0], p.AggregationBits...)
+	res.Data = p.Data.Copy()
+
+	return &res
+}
+
+// Equal returns true if the PendingAttestation holds the same data as other.
+func (p *PendingAttestation) Equal(other *PendingAttestation) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+
+	return containers.EqualSlices(p.AggregationBits, other.AggregationBits) &&
+		p.Data.Equal(other.Data) &&
+		p.InclusionDelay == other.InclusionDelay &&
+		p.ProposerIndex == other.ProposerIndex
+}
+
+// Copy returns a deep copy of the ProposerSlashing.
+func (p *ProposerSlashing) Copy() *ProposerSlashing {
+	if p == nil {
+		return nil
+	}
+
+	res := *p
+	res.SignedHeader1 = p.SignedHeader1.Copy()
+	res.SignedHeader2 = p.SignedHeader2.Copy()
+
+	return &res
+}
+
+// Equal returns true if the ProposerSlashing holds the same data as other.
+func (p *ProposerSlashing) Equal(other *ProposerSlashing) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+
+	return p.SignedHeader1.Equal(other.SignedHeader1) &&
+		p.SignedHeader2.Equal(other.SignedHeader2)
+}
+
+// Copy returns a deep copy of the SignedAggregateAndProof.
+func (s *SignedAggregateAndProof) Copy() *SignedAggregateAndProof {
+	if s == nil {
+		return nil
+	}
+
+	res := *s
+	res.Message = s.Message.Copy()
+
+	return &res
+}
+
+// Equal returns true if the SignedAggregateAndProof holds the same data as other.
+func (s *SignedAggregateAndProof) Equal(other *SignedAggregateAndProof) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return s.Message.Equal(other.Message) &&
+		s.Signature == other.Signature
+}
+
+// Copy returns a deep copy of the SignedBeaconBlock.
+func (s *SignedBeaconBlock) Copy() *SignedBeaconBlock {
+	if s == nil {
+		return nil
+	}
+
+	res := *s
+	res.Message = s.Message.Copy()
+
+	return &res
+}
+
+// Equal returns true if the SignedBeaconBlock holds the same data as other.
+func (s *SignedBeaconBlock) Equal(other *SignedBeaconBlock) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return s.Message.Equal(other.Message) &&
+		s.Signature == other.Signature
+}
+
+// Copy returns a deep copy of the SignedBeaconBlockHeader.
+func (s *SignedBeaconBlockHeader) Copy() *SignedBeaconBlockHeader {
+	if s == nil {
+		return nil
+	}
+
+	res := *s
+	res.Message = s.Message.Copy()
+
+	return &res
+}
+
+// Equal returns true if the SignedBeaconBlockHeader holds the same data as other.
+func (s *SignedBeaconBlockHeader) Equal(other *SignedBeaconBlockHeader) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return s.Message.Equal(other.Message) &&
+		s.Signature == other.Signature
+}
+
+// Copy returns a deep copy of the SignedVoluntaryExit.
+func (s *SignedVoluntaryExit) Copy() *SignedVoluntaryExit {
+	if s == nil {
+		return nil
+	}
+
+	res := *s
+	res.Message = s.Message.Copy()
+
+	return &res
+}
+
+// Equal returns true if the SignedVoluntaryExit holds the same data as other.
+func (s *SignedVoluntaryExit) Equal(other *SignedVoluntaryExit) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return s.Message.Equal(other.Message) &&
+		s.Signature == other.Signature
+}
+
+// Copy returns a deep copy of the SigningData.
+func (s *SigningData) Copy() *SigningData {
+	if s == nil {
+		return nil
+	}
+
+	res := *s
+
+	return &res
+}
+
+// Equal returns true if the SigningData holds the same data as other.
+func (s *SigningData) Equal(other *SigningData) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return s.ObjectRoot == other.ObjectRoot &&
+		s.Domain == other.Domain
+}
+
+// Copy returns a deep copy of the Validator.
+func (v *Validator) Copy() *Validator {
+	if v == nil {
+		return nil
+	}
+
+	res := *v
+	res.WithdrawalCredentials = append(v.WithdrawalCredentials[:0:0], v.WithdrawalCredentials...)
+
+	return &res
+}
+
+// Equal returns true if the Validator holds the same data as other.
+func (v *Validator) Equal(other *Validator) bool {
+	if v == nil || other == nil {
+		return v == other
+	}
+
+	return v.PublicKey == other.PublicKey &&
+		containers.EqualSlices(v.WithdrawalCredentials, other.WithdrawalCredentials) &&
+		v.EffectiveBalance == other.EffectiveBalance &&
+		v.Slashed == other.Slashed &&
+		v.ActivationEligibilityEpoch == other.ActivationEligibilityEpoch &&
+		v.ActivationEpoch == other.ActivationEpoch &&
+		v.ExitEpoch == other.ExitEpoch &&
+		v.WithdrawableEpoch == other.WithdrawableEpoch
+}
+
+// Copy returns a deep copy of the VoluntaryExit.
+func (v *VoluntaryExit) Copy() *VoluntaryExit {
+	if v == nil {
+		return nil
+	}
+
+	res := *v
+
+	return &res
+}
+
+// Equal returns true if the VoluntaryExit holds the same data as other.
+func (v *VoluntaryExit) Equal(other *VoluntaryExit) bool {
+	if v == nil || other == nil {
+		return v == other
+	}
+
+	return v.Epoch == other.Epoch &&
+		v.ValidatorIndex == other.ValidatorIndex
+}
@@ -0,0 +1,91 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0
+
+// Domain types, as defined in the consensus specifications.
+var (
+	// DomainBeaconProposer is the domain type for beacon block proposals.
+	DomainBeaconProposer = DomainType{0x00, 0x00, 0x00, 0x00}
+	// DomainBeaconAttester is the domain type for attestations.
+	DomainBeaconAttester = DomainType{0x01, 0x00, 0x00, 0x00}
+	// DomainRandao is the domain type for RANDAO reveals.
+	DomainRandao = DomainType{0x02, 0x00, 0x00, 0x00}
+	// DomainDeposit is the domain type for deposits.
+	DomainDeposit = DomainType{0x03, 0x00, 0x00, 0x00}
+	// DomainVoluntaryExit is the domain type for voluntary exits.
+	DomainVoluntaryExit = DomainType{0x04, 0x00, 0x00, 0x00}
+	// DomainSelectionProof is the domain type for aggregator selection proofs.
+	DomainSelectionProof = DomainType{0x05, 0x00, 0x00, 0x00}
+	// DomainAggregateAndProof is the domain type for aggregate and proofs.
+	DomainAggregateAndProof = DomainType{0x06, 0x00, 0x00, 0x00}
+	// DomainSyncCommittee is the domain type for sync committee messages.
+	DomainSyncCommittee = DomainType{0x07, 0x00, 0x00, 0x00}
+	// DomainSyncCommitteeSelectionProof is the domain type for sync committee aggregator selection proofs.
+	DomainSyncCommitteeSelectionProof = DomainType{0x08, 0x00, 0x00, 0x00}
+	// DomainContributionAndProof is the domain type for sync committee contribution and proofs.
+	DomainContributionAndProof = DomainType{0x09, 0x00, 0x00, 0x00}
+	// DomainBLSToExecutionChange is the domain type for BLS to execution changes.
+	DomainBLSToExecutionChange = DomainType{0x0a, 0x00, 0x00, 0x00}
+	// DomainBlobSidecar is the domain type for blob sidecars.
+	DomainBlobSidecar = DomainType{0x0b, 0x00, 0x00, 0x00}
+	// DomainApplicationMask is the mask for application-specific domain types.
+	DomainApplicationMask = DomainType{0x00, 0x00, 0x00, 0x01}
+	// DomainApplicationBuilder is the domain type for builder API messages such as validator registrations.
+	DomainApplicationBuilder = DomainType{0x00, 0x00, 0x00, 0x01}
+)
+
+// domainTypeNames are the specification names of the domain types.  Where multiple names
+// share a domain type the first is its canonical name.
+var domainTypeNames = []struct {
+	name       string
+	domainType DomainType
+}{
+	{"DOMAIN_BEACON_PROPOSER", DomainBeaconProposer},
+	{"DOMAIN_BEACON_ATTESTER", DomainBeaconAttester},
+	{"DOMAIN_RANDAO", DomainRandao},
+	{"DOMAIN_DEPOSIT", DomainDeposit},
+	{"DOMAIN_VOLUNTARY_EXIT", DomainVoluntaryExit},
+	{"DOMAIN_SELECTION_PROOF", DomainSelectionProof},
+	{"DOMAIN_AGGREGATE_AND_PROOF", DomainAggregateAndProof},
+	{"DOMAIN_SYNC_COMMITTEE", DomainSyncCommittee},
+	{"DOMAIN_SYNC_COMMITTEE_SELECTION_PROOF", DomainSyncCommitteeSelectionProof},
+	{"DOMAIN_CONTRIBUTION_AND_PROOF", DomainContributionAndProof},
+	{"DOMAIN_BLS_TO_EXECUTION_CHANGE", DomainBLSToExecutionChange},
+	{"DOMAIN_BLOB_SIDECAR", DomainBlobSidecar},
+	{"DOMAIN_APPLICATION_BUILDER", DomainApplicationBuilder},
+	{"DOMAIN_APPLICATION_MASK", DomainApplicationMask},
+}
+
+// DomainTypeName returns the specification name of the domain type, for example
+// "DOMAIN_BEACON_PROPOSER", or an empty string if the domain type is not known.
+func DomainTypeName(domainType DomainType) string {
+	for _, entry := range domainTypeNames {
+		if entry.domainType == domainType {
+			return entry.name
+		}
+	}
+
+	return ""
+}
+
+// DomainTypeFromName returns the domain type with the given specification name.
+func DomainTypeFromName(name string) (DomainType, bool) {
+	for _, entry := range domainTypeNames {
+		if entry.name == name {
+			return entry.domainType, true
+		}
+	}
+
+	return DomainType{}, false
+}
@@ -0,0 +1,33 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDomainTypeNames(t *testing.T) {
+	require.Equal(t, "DOMAIN_SYNC_COMMITTEE", phase0.DomainTypeName(phase0.DomainSyncCommittee))
+	require.Equal(t, "DOMAIN_APPLICATION_BUILDER", phase0.DomainTypeName(phase0.DomainApplicationMask))
+	require.Equal(t, "", phase0.DomainTypeName(phase0.DomainType{0xff}))
+
+	domainType, exists := phase0.DomainTypeFromName("DOMAIN_BLOB_SIDECAR")
+	require.True(t, exists)
+	require.Equal(t, phase0.DomainBlobSidecar, domainType)
+	_, exists = phase0.DomainTypeFromName("DOMAIN_UNKNOWN")
+	require.False(t, exists)
+}
@@ -15,15 +15,129 @@ package phase0
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
+	"math/big"
+	"math/bits"
 	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 )
 
+// GweiLength is the number of bytes in a serialized Gwei amount.
+const GweiLength = 8
+
+// weiPerGwei is the number of Wei in a Gwei.
+const weiPerGwei = 1_000_000_000
+
+// gweiPerETH is the number of Gwei in an ETH.
+const gweiPerETH = 1_000_000_000
+
+// ErrGweiOverflow is returned when an operation on Gwei amounts overflows.
+var ErrGweiOverflow = errors.New("gwei overflow")
+
+// ErrGweiUnderflow is returned when an operation on Gwei amounts underflows.
+var ErrGweiUnderflow = errors.New("gwei underflow")
+
 // Gwei is an amount in Gwei.
 type Gwei uint64
 
+// AddSafe returns the sum of the amount and another, or ErrGweiOverflow if the sum overflows.
+func (g Gwei) AddSafe(other Gwei) (Gwei, error) {
+	sum, carry := bits.Add64(uint64(g), uint64(other), 0)
+	if carry != 0 {
+		return 0, ErrGweiOverflow
+	}
+
+	return Gwei(sum), nil
+}
+
+// SubSafe returns the amount less another, or ErrGweiUnderflow if the result would be negative.
+func (g Gwei) SubSafe(other Gwei) (Gwei, error) {
+	if other > g {
+		return 0, ErrGweiUnderflow
+	}
+
+	return g - other, nil
+}
+
+// SumGwei returns the sum of the amounts, or ErrGweiOverflow if the sum overflows.
+func SumGwei(amounts ...Gwei) (Gwei, error) {
+	var sum Gwei
+	var err error
+	for _, amount := range amounts {
+		if sum, err = sum.AddSafe(amount); err != nil {
+			return 0, err
+		}
+	}
+
+	return sum, nil
+}
+
+// ToWei returns the amount in Wei.
+func (g Gwei) ToWei() *big.Int {
+	wei := new(big.Int).SetUint64(uint64(g))
+
+	return wei.Mul(wei, big.NewInt(weiPerGwei))
+}
+
+// GweiFromWei returns the amount in Gwei of an amount in Wei, truncating any fraction of a Gwei.
+// It returns an error if the amount is negative or too large to be held in Gwei.
+func GweiFromWei(wei *big.Int) (Gwei, error) {
+	if wei == nil {
+		return 0, errors.New("wei amount missing")
+	}
+	if wei.Sign() < 0 {
+		return 0, ErrGweiUnderflow
+	}
+	gwei := new(big.Int).Quo(wei, big.NewInt(weiPerGwei))
+	if !gwei.IsUint64() {
+		return 0, ErrGweiOverflow
+	}
+
+	return Gwei(gwei.Uint64()), nil
+}
+
+// ETH returns the amount as a decimal string in ETH, without trailing zeros.
+func (g Gwei) ETH() string {
+	whole := uint64(g) / gweiPerETH
+	fraction := uint64(g) % gweiPerETH
+	if fraction == 0 {
+		return strconv.FormatUint(whole, 10)
+	}
+
+	return fmt.Sprintf("%d.%s", whole, strings.TrimRight(fmt.Sprintf("%09d", fraction), "0"))
+}
+
+// LittleEndianBytes returns the amount as little-endian bytes, as used in SSZ.
+func (g Gwei) LittleEndianBytes() []byte {
+	return binary.LittleEndian.AppendUint64(make([]byte, 0, GweiLength), uint64(g))
+}
+
+// BigEndianBytes returns the amount as big-endian bytes.
+func (g Gwei) BigEndianBytes() []byte {
+	return binary.BigEndian.AppendUint64(make([]byte, 0, GweiLength), uint64(g))
+}
+
+// GweiFromLittleEndian returns the amount held in little-endian bytes.
+func GweiFromLittleEndian(data []byte) (Gwei, error) {
+	if len(data) != GweiLength {
+		return 0, fmt.Errorf("incorrect length %d for gwei", len(data))
+	}
+
+	return Gwei(binary.LittleEndian.Uint64(data)), nil
+}
+
+// GweiFromBigEndian returns the amount held in big-endian bytes.
+func GweiFromBigEndian(data []byte) (Gwei, error) {
+	if len(data) != GweiLength {
+		return 0, fmt.Errorf("incorrect length %d for gwei", len(data))
+	}
+
+	return Gwei(binary.BigEndian.Uint64(data)), nil
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 func (g *Gwei) UnmarshalJSON(input []byte) error {
 	if len(input) == 0 {
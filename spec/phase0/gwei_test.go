@@ -0,0 +1,128 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGweiArithmetic(t *testing.T) {
+	sum, err := phase0.Gwei(1).AddSafe(2)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Gwei(3), sum)
+	_, err = phase0.Gwei(math.MaxUint64).AddSafe(1)
+	require.ErrorIs(t, err, phase0.ErrGweiOverflow)
+
+	diff, err := phase0.Gwei(3).SubSafe(2)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Gwei(1), diff)
+	_, err = phase0.Gwei(2).SubSafe(3)
+	require.ErrorIs(t, err, phase0.ErrGweiUnderflow)
+
+	sum, err = phase0.SumGwei()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Gwei(0), sum)
+	sum, err = phase0.SumGwei(32000000000, 32000000000, 1)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Gwei(64000000001), sum)
+	_, err = phase0.SumGwei(math.MaxUint64/2, math.MaxUint64/2, 2)
+	require.ErrorIs(t, err, phase0.ErrGweiOverflow)
+}
+
+func TestGweiWei(t *testing.T) {
+	require.Equal(t, "0", phase0.Gwei(0).ToWei().String())
+	require.Equal(t, "32000000000000000000", phase0.Gwei(32000000000).ToWei().String())
+	require.Equal(t, "18446744073709551615000000000", phase0.Gwei(math.MaxUint64).ToWei().String())
+
+	tests := []struct {
+		name string
+		wei  *big.Int
+		res  phase0.Gwei
+		err  string
+	}{
+		{
+			name: "Nil",
+			err:  "wei amount missing",
+		},
+		{
+			name: "Negative",
+			wei:  big.NewInt(-1),
+			err:  "gwei underflow",
+		},
+		{
+			name: "Zero",
+			wei:  big.NewInt(0),
+			res:  0,
+		},
+		{
+			name: "Truncated",
+			wei:  big.NewInt(1999999999),
+			res:  1,
+		},
+		{
+			name: "Max",
+			wei:  phase0.Gwei(math.MaxUint64).ToWei(),
+			res:  math.MaxUint64,
+		},
+		{
+			name: "Overflow",
+			wei:  new(big.Int).Add(phase0.Gwei(math.MaxUint64).ToWei(), big.NewInt(1000000000)),
+			err:  "gwei overflow",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := phase0.GweiFromWei(test.wei)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.res, res)
+			}
+		})
+	}
+}
+
+func TestGweiETH(t *testing.T) {
+	require.Equal(t, "0", phase0.Gwei(0).ETH())
+	require.Equal(t, "0.000000001", phase0.Gwei(1).ETH())
+	require.Equal(t, "0.5", phase0.Gwei(500000000).ETH())
+	require.Equal(t, "32", phase0.Gwei(32000000000).ETH())
+	require.Equal(t, "32.000000001", phase0.Gwei(32000000001).ETH())
+	require.Equal(t, "18446744073.709551615", phase0.Gwei(math.MaxUint64).ETH())
+}
+
+func TestGweiBytes(t *testing.T) {
+	amount := phase0.Gwei(0x0102030405060708)
+	require.Equal(t, []byte{0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01}, amount.LittleEndianBytes())
+	require.Equal(t, []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}, amount.BigEndianBytes())
+
+	res, err := phase0.GweiFromLittleEndian(amount.LittleEndianBytes())
+	require.NoError(t, err)
+	require.Equal(t, amount, res)
+	res, err = phase0.GweiFromBigEndian(amount.BigEndianBytes())
+	require.NoError(t, err)
+	require.Equal(t, amount, res)
+
+	_, err = phase0.GweiFromLittleEndian([]byte{0x01})
+	require.EqualError(t, err, "incorrect length 1 for gwei")
+	_, err = phase0.GweiFromBigEndian(make([]byte, 9))
+	require.EqualError(t, err, "incorrect length 9 for gwei")
+}
@@ -0,0 +1,155 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	validatorJSON               = `{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","withdrawal_credentials":"0x00fad2a6bfb0e7f1f0f45460944fbd8dfa7f37da06a4d13b3983cc90bb46963b","effective_balance":"32000000000","slashed":false,"activation_eligibility_epoch":"0","activation_epoch":"0","exit_epoch":"18446744073709551615","withdrawable_epoch":"18446744073709551615"}`
+	attestationDataJSON         = `{"slot":"100","index":"1","beacon_block_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","source":{"epoch":"1","root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"},"target":{"epoch":"2","root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"}}`
+	attestationJSON             = `{"aggregation_bits":"0x01","data":` + attestationDataJSON + `,"signature":"0x0b30557a9fc4e90e33587da2c7ec11365b80a5caef14395e83a8cdf2173c6186abd0f51a3f6489aed3f81d42678cb1d6fb20456a8fb4d9fe23486d92b7dc01264b7095badf04294e7398bde2072c51769bc0e50a2f54799ec3e80d32577ca1c6"}`
+	signedBeaconBlockHeaderJSON = `{"message":{"slot":"1","proposer_index":"2","parent_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","state_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","body_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"},"signature":"0x0b30557a9fc4e90e33587da2c7ec11365b80a5caef14395e83a8cdf2173c6186abd0f51a3f6489aed3f81d42678cb1d6fb20456a8fb4d9fe23486d92b7dc01264b7095badf04294e7398bde2072c51769bc0e50a2f54799ec3e80d32577ca1c6"}`
+)
+
+// TestJSONDecodeFallback ensures that input handled by the standard decoder, rather than the
+// scanner, decodes to the same value.
+func TestJSONDecodeFallback(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		fallback string
+		res      json.Unmarshaler
+		fallRes  json.Unmarshaler
+	}{
+		{
+			name:     "Validator",
+			input:    validatorJSON,
+			fallback: `{"extra":1,` + validatorJSON[1:],
+			res:      &phase0.Validator{},
+			fallRes:  &phase0.Validator{},
+		},
+		{
+			name:     "Attestation",
+			input:    attestationJSON,
+			fallback: `{"signature":"0x00",` + attestationJSON[1:],
+			res:      &phase0.Attestation{},
+			fallRes:  &phase0.Attestation{},
+		},
+		{
+			name:     "AttestationData",
+			input:    attestationDataJSON,
+			fallback: `{"Slot":"100",` + attestationDataJSON[1:],
+			res:      &phase0.AttestationData{},
+			fallRes:  &phase0.AttestationData{},
+		},
+		{
+			name:     "SignedBeaconBlockHeader",
+			input:    signedBeaconBlockHeaderJSON,
+			fallback: `{"extra":[1,2],` + signedBeaconBlockHeaderJSON[1:],
+			res:      &phase0.SignedBeaconBlockHeader{},
+			fallRes:  &phase0.SignedBeaconBlockHeader{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.NoError(t, json.Unmarshal([]byte(test.input), test.res))
+			require.NoError(t, json.Unmarshal([]byte(test.fallback), test.fallRes))
+			require.Equal(t, test.res, test.fallRes)
+			rt, err := json.Marshal(test.res)
+			require.NoError(t, err)
+			require.Equal(t, test.input, string(rt))
+		})
+	}
+}
+
+func BenchmarkValidatorUnmarshalJSON(b *testing.B) {
+	input := []byte(validatorJSON)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var validator phase0.Validator
+		if err := json.Unmarshal(input, &validator); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkValidatorsUnmarshalJSON(b *testing.B) {
+	for _, count := range []int{1000, 10000} {
+		input := []byte("[")
+		for i := 0; i < count; i++ {
+			if i > 0 {
+				input = append(input, ',')
+			}
+			input = append(input, validatorJSON...)
+		}
+		input = append(input, ']')
+
+		b.Run(fmt.Sprintf("%d", count), func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(input)))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				var validators []*phase0.Validator
+				if err := json.Unmarshal(input, &validators); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkAttestationUnmarshalJSON(b *testing.B) {
+	input := []byte(attestationJSON)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var attestation phase0.Attestation
+		if err := json.Unmarshal(input, &attestation); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAttestationDataUnmarshalJSON(b *testing.B) {
+	input := []byte(attestationDataJSON)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var attestationData phase0.AttestationData
+		if err := json.Unmarshal(input, &attestationData); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSignedBeaconBlockHeaderUnmarshalJSON(b *testing.B) {
+	input := []byte(signedBeaconBlockHeaderJSON)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var header phase0.SignedBeaconBlockHeader
+		if err := json.Unmarshal(input, &header); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/spec/internal/jsonscan"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -53,12 +54,40 @@ func (s *SignedBeaconBlockHeader) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (s *SignedBeaconBlockHeader) UnmarshalJSON(input []byte) error {
 	var signedBeaconBlockHeaderJSON signedBeaconBlockHeaderJSON
-	if err := json.Unmarshal(input, &signedBeaconBlockHeaderJSON); err != nil {
-		return errors.Wrap(err, "invalid JSON")
+	if err := signedBeaconBlockHeaderJSON.scan(input); err != nil {
+		// Input that cannot be scanned is handled by the standard decoder.
+		if err := json.Unmarshal(input, &signedBeaconBlockHeaderJSON); err != nil {
+			return errors.Wrap(err, "invalid JSON")
+		}
 	}
 	return s.unpack(&signedBeaconBlockHeaderJSON)
 }
 
+// scan populates the struct from the input without reflection.
+// The struct is left untouched if the input cannot be scanned.
+func (j *signedBeaconBlockHeaderJSON) scan(input []byte) error {
+	var res signedBeaconBlockHeaderJSON
+	err := jsonscan.Fields(input, func(key string, value string) error {
+		var err error
+		switch key {
+		case "message":
+			res.Message, err = jsonscan.Object[BeaconBlockHeader](value)
+		case "signature":
+			res.Signature, err = jsonscan.String(value)
+		default:
+			err = jsonscan.ErrUnsupported
+		}
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	*j = res
+
+	return nil
+}
+
 func (s *SignedBeaconBlockHeader) unpack(signedBeaconBlockHeaderJSON *signedBeaconBlockHeaderJSON) error {
 	s.Message = signedBeaconBlockHeaderJSON.Message
 	if s.Message == nil {
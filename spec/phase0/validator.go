@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/spec/internal/jsonscan"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -63,9 +64,14 @@ type validatorYAML struct {
 
 // MarshalJSON implements json.Marshaler.
 func (v *Validator) MarshalJSON() ([]byte, error) {
+	withdrawalCredentials, err := v.Credentials()
+	if err != nil {
+		return nil, err
+	}
+
 	return json.Marshal(&validatorJSON{
 		PublicKey:                  fmt.Sprintf("%#x", v.PublicKey),
-		WithdrawalCredentials:      fmt.Sprintf("%#x", v.WithdrawalCredentials),
+		WithdrawalCredentials:      withdrawalCredentials.String(),
 		EffectiveBalance:           fmt.Sprintf("%d", v.EffectiveBalance),
 		Slashed:                    v.Slashed,
 		ActivationEligibilityEpoch: fmt.Sprintf("%d", v.ActivationEligibilityEpoch),
@@ -78,12 +84,52 @@ func (v *Validator) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (v *Validator) UnmarshalJSON(input []byte) error {
 	var validatorJSON validatorJSON
-	if err := json.Unmarshal(input, &validatorJSON); err != nil {
-		return errors.Wrap(err, "invalid JSON")
+	if err := validatorJSON.scan(input); err != nil {
+		// Input that cannot be scanned is handled by the standard decoder.
+		if err := json.Unmarshal(input, &validatorJSON); err != nil {
+			return errors.Wrap(err, "invalid JSON")
+		}
 	}
 	return v.unpack(&validatorJSON)
 }
 
+// scan populates the struct from the input without reflection.
+// The struct is left untouched if the input cannot be scanned.
+func (j *validatorJSON) scan(input []byte) error {
+	var res validatorJSON
+	err := jsonscan.Fields(input, func(key string, value string) error {
+		var err error
+		switch key {
+		case "pubkey":
+			res.PublicKey, err = jsonscan.String(value)
+		case "withdrawal_credentials":
+			res.WithdrawalCredentials, err = jsonscan.String(value)
+		case "effective_balance":
+			res.EffectiveBalance, err = jsonscan.String(value)
+		case "slashed":
+			res.Slashed, err = jsonscan.Bool(value)
+		case "activation_eligibility_epoch":
+			res.ActivationEligibilityEpoch, err = jsonscan.String(value)
+		case "activation_epoch":
+			res.ActivationEpoch, err = jsonscan.String(value)
+		case "exit_epoch":
+			res.ExitEpoch, err = jsonscan.String(value)
+		case "withdrawable_epoch":
+			res.WithdrawableEpoch, err = jsonscan.String(value)
+		default:
+			err = jsonscan.ErrUnsupported
+		}
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	*j = res
+
+	return nil
+}
+
 func (v *Validator) unpack(validatorJSON *validatorJSON) error {
 	if validatorJSON.PublicKey == "" {
 		return errors.New("public key missing")
@@ -99,13 +145,14 @@ func (v *Validator) unpack(validatorJSON *validatorJSON) error {
 	if validatorJSON.WithdrawalCredentials == "" {
 		return errors.New("withdrawal credentials missing")
 	}
-	v.WithdrawalCredentials, err = hex.DecodeString(strings.TrimPrefix(validatorJSON.WithdrawalCredentials, "0x"))
+	withdrawalCredentials, err := hex.DecodeString(strings.TrimPrefix(validatorJSON.WithdrawalCredentials, "0x"))
 	if err != nil {
 		return errors.Wrap(err, "invalid value for withdrawal credentials")
 	}
-	if len(v.WithdrawalCredentials) != HashLength {
-		return fmt.Errorf("incorrect length %d for withdrawal credentials", len(v.WithdrawalCredentials))
+	if _, err := ParseWithdrawalCredentials(withdrawalCredentials); err != nil {
+		return err
 	}
+	v.WithdrawalCredentials = withdrawalCredentials
 	if validatorJSON.EffectiveBalance == "" {
 		return errors.New("effective balance missing")
 	}
@@ -153,9 +200,14 @@ func (v *Validator) unpack(validatorJSON *validatorJSON) error {
 
 // MarshalYAML implements yaml.Marshaler.
 func (v *Validator) MarshalYAML() ([]byte, error) {
+	withdrawalCredentials, err := v.Credentials()
+	if err != nil {
+		return nil, err
+	}
+
 	yamlBytes, err := yaml.MarshalWithOptions(&validatorYAML{
 		PublicKey:                  fmt.Sprintf("%#x", v.PublicKey),
-		WithdrawalCredentials:      fmt.Sprintf("%#x", v.WithdrawalCredentials),
+		WithdrawalCredentials:      withdrawalCredentials.String(),
 		EffectiveBalance:           uint64(v.EffectiveBalance),
 		Slashed:                    v.Slashed,
 		ActivationEligibilityEpoch: uint64(v.ActivationEligibilityEpoch),
@@ -179,6 +231,11 @@ func (v *Validator) UnmarshalYAML(input []byte) error {
 	return v.unpack(&validatorJSON)
 }
 
+// Credentials returns the typed withdrawal credentials of the validator.
+func (v *Validator) Credentials() (WithdrawalCredentials, error) {
+	return ParseWithdrawalCredentials(v.WithdrawalCredentials)
+}
+
 // String returns a string version of the structure.
 func (v *Validator) String() string {
 	data, err := json.Marshal(v)
@@ -0,0 +1,215 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// BLSWithdrawalPrefix is the prefix for withdrawal credentials derived from a BLS public key.
+	BLSWithdrawalPrefix = byte(0x00)
+	// ExecutionWithdrawalPrefix is the prefix for withdrawal credentials of an execution address.
+	ExecutionWithdrawalPrefix = byte(0x01)
+	// CompoundingWithdrawalPrefix is the prefix for compounding withdrawal credentials of an execution address.
+	CompoundingWithdrawalPrefix = byte(0x02)
+)
+
+// executionAddressOffset is the offset of the execution address in withdrawal credentials.
+const executionAddressOffset = 12
+
+// WithdrawalCredentials are the withdrawal credentials of a validator.
+type WithdrawalCredentials [32]byte
+
+// NewBLSWithdrawalCredentials creates BLS withdrawal credentials for the given withdrawal public key.
+func NewBLSWithdrawalCredentials(pubKey BLSPubKey) WithdrawalCredentials {
+	res := WithdrawalCredentials(sha256.Sum256(pubKey[:]))
+	res[0] = BLSWithdrawalPrefix
+
+	return res
+}
+
+// NewExecutionWithdrawalCredentials creates withdrawal credentials for the given execution address.
+func NewExecutionWithdrawalCredentials(address [20]byte) WithdrawalCredentials {
+	return newAddressWithdrawalCredentials(ExecutionWithdrawalPrefix, address)
+}
+
+// NewCompoundingWithdrawalCredentials creates compounding withdrawal credentials for the given execution address.
+func NewCompoundingWithdrawalCredentials(address [20]byte) WithdrawalCredentials {
+	return newAddressWithdrawalCredentials(CompoundingWithdrawalPrefix, address)
+}
+
+func newAddressWithdrawalCredentials(prefix byte, address [20]byte) WithdrawalCredentials {
+	res := WithdrawalCredentials{prefix}
+	copy(res[executionAddressOffset:], address[:])
+
+	return res
+}
+
+// ParseWithdrawalCredentials parses withdrawal credentials from their byte representation,
+// as held in the Validator structure.
+func ParseWithdrawalCredentials(input []byte) (WithdrawalCredentials, error) {
+	var res WithdrawalCredentials
+	if len(input) != WithdrawalCredentialsLength {
+		return res, fmt.Errorf("incorrect length %d for withdrawal credentials", len(input))
+	}
+	copy(res[:], input)
+
+	return res, nil
+}
+
+// Prefix returns the prefix of the withdrawal credentials.
+func (w WithdrawalCredentials) Prefix() byte {
+	return w[0]
+}
+
+// IsBLS returns true if the withdrawal credentials are derived from a BLS public key.
+func (w WithdrawalCredentials) IsBLS() bool {
+	return w[0] == BLSWithdrawalPrefix
+}
+
+// IsExecution returns true if the withdrawal credentials are for an execution address.
+// This includes compounding withdrawal credentials.
+func (w WithdrawalCredentials) IsExecution() bool {
+	return w[0] == ExecutionWithdrawalPrefix || w[0] == CompoundingWithdrawalPrefix
+}
+
+// IsCompounding returns true if the withdrawal credentials are compounding.
+func (w WithdrawalCredentials) IsCompounding() bool {
+	return w[0] == CompoundingWithdrawalPrefix
+}
+
+// Validate checks that the withdrawal credentials have a known prefix and, for execution
+// withdrawal credentials, that the padding before the address is zero.
+func (w WithdrawalCredentials) Validate() error {
+	switch w[0] {
+	case BLSWithdrawalPrefix:
+		return nil
+	case ExecutionWithdrawalPrefix, CompoundingWithdrawalPrefix:
+		if !bytes.Equal(w[1:executionAddressOffset], make([]byte, executionAddressOffset-1)) {
+			return errors.New("withdrawal credentials have non-zero padding")
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unknown withdrawal credentials prefix %#02x", w[0])
+	}
+}
+
+// ExecutionAddress returns the execution address of the withdrawal credentials.
+// It returns an error if the withdrawal credentials are not for an execution address.
+func (w WithdrawalCredentials) ExecutionAddress() ([20]byte, error) {
+	var res [20]byte
+	if !w.IsExecution() {
+		return res, fmt.Errorf("withdrawal credentials with prefix %#02x do not have an execution address", w[0])
+	}
+	if err := w.Validate(); err != nil {
+		return res, err
+	}
+	copy(res[:], w[executionAddressOffset:])
+
+	return res, nil
+}
+
+// String returns a string version of the structure.
+func (w WithdrawalCredentials) String() string {
+	return fmt.Sprintf("%#x", w)
+}
+
+// Format formats the withdrawal credentials.
+func (w WithdrawalCredentials) Format(state fmt.State, v rune) {
+	format := string(v)
+	switch v {
+	case 's':
+		fmt.Fprint(state, w.String())
+	case 'x', 'X':
+		if state.Flag('#') {
+			format = "#" + format
+		}
+		fmt.Fprintf(state, "%"+format, w[:])
+	default:
+		fmt.Fprintf(state, "%"+format, w[:])
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (w *WithdrawalCredentials) UnmarshalJSON(input []byte) error {
+	if len(input) == 0 {
+		return errors.New("input missing")
+	}
+
+	if !bytes.HasPrefix(input, []byte{'"', '0', 'x'}) {
+		return errors.New("invalid prefix")
+	}
+	if !bytes.HasSuffix(input, []byte{'"'}) {
+		return errors.New("invalid suffix")
+	}
+	if len(input) != 1+2+WithdrawalCredentialsLength*2+1 {
+		return errors.New("incorrect length")
+	}
+
+	length, err := hex.Decode(w[:], input[3:3+WithdrawalCredentialsLength*2])
+	if err != nil {
+		return errors.Wrapf(err, "invalid value %s", string(input[3:3+WithdrawalCredentialsLength*2]))
+	}
+
+	if length != WithdrawalCredentialsLength {
+		return errors.New("incorrect length")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (w WithdrawalCredentials) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf(`"%#x"`, w)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (w *WithdrawalCredentials) UnmarshalYAML(input []byte) error {
+	if len(input) == 0 {
+		return errors.New("input missing")
+	}
+
+	if !bytes.HasPrefix(input, []byte{'\'', '0', 'x'}) {
+		return errors.New("invalid prefix")
+	}
+	if !bytes.HasSuffix(input, []byte{'\''}) {
+		return errors.New("invalid suffix")
+	}
+	if len(input) != 1+2+WithdrawalCredentialsLength*2+1 {
+		return errors.New("incorrect length")
+	}
+
+	length, err := hex.Decode(w[:], input[3:3+WithdrawalCredentialsLength*2])
+	if err != nil {
+		return errors.Wrapf(err, "invalid value %s", string(input[3:3+WithdrawalCredentialsLength*2]))
+	}
+
+	if length != WithdrawalCredentialsLength {
+		return errors.New("incorrect length")
+	}
+
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (w WithdrawalCredentials) MarshalYAML() ([]byte, error) {
+	return []byte(fmt.Sprintf(`'%#x'`, w)), nil
+}
@@ -0,0 +1,82 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithdrawalCredentials(t *testing.T) {
+	address := [20]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14}
+
+	execution := phase0.NewExecutionWithdrawalCredentials(address)
+	require.Equal(t, "0x0100000000000000000000000102030405060708090a0b0c0d0e0f1011121314", execution.String())
+	require.True(t, execution.IsExecution())
+	require.False(t, execution.IsCompounding())
+	require.False(t, execution.IsBLS())
+	require.NoError(t, execution.Validate())
+	executionAddress, err := execution.ExecutionAddress()
+	require.NoError(t, err)
+	require.Equal(t, address, executionAddress)
+
+	compounding := phase0.NewCompoundingWithdrawalCredentials(address)
+	require.Equal(t, phase0.CompoundingWithdrawalPrefix, compounding.Prefix())
+	require.True(t, compounding.IsExecution())
+	require.True(t, compounding.IsCompounding())
+	executionAddress, err = compounding.ExecutionAddress()
+	require.NoError(t, err)
+	require.Equal(t, address, executionAddress)
+
+	pubKey := phase0.BLSPubKey{0x01}
+	bls := phase0.NewBLSWithdrawalCredentials(pubKey)
+	pubKeyHash := sha256.Sum256(pubKey[:])
+	require.Equal(t, pubKeyHash[1:], bls[1:])
+	require.True(t, bls.IsBLS())
+	require.NoError(t, bls.Validate())
+	_, err = bls.ExecutionAddress()
+	require.EqualError(t, err, "withdrawal credentials with prefix 0x00 do not have an execution address")
+
+	padded := execution
+	padded[5] = 0x01
+	require.EqualError(t, padded.Validate(), "withdrawal credentials have non-zero padding")
+	_, err = padded.ExecutionAddress()
+	require.EqualError(t, err, "withdrawal credentials have non-zero padding")
+	require.EqualError(t, phase0.WithdrawalCredentials{0x03}.Validate(), "unknown withdrawal credentials prefix 0x03")
+
+	parsed, err := phase0.ParseWithdrawalCredentials(execution[:])
+	require.NoError(t, err)
+	require.Equal(t, execution, parsed)
+	_, err = phase0.ParseWithdrawalCredentials(execution[:31])
+	require.EqualError(t, err, "incorrect length 31 for withdrawal credentials")
+
+	data, err := json.Marshal(execution)
+	require.NoError(t, err)
+	require.Equal(t, `"0x0100000000000000000000000102030405060708090a0b0c0d0e0f1011121314"`, string(data))
+	var unmarshalled phase0.WithdrawalCredentials
+	require.NoError(t, json.Unmarshal(data, &unmarshalled))
+	require.Equal(t, execution, unmarshalled)
+	require.EqualError(t, json.Unmarshal([]byte(`"0x01"`), &unmarshalled), "incorrect length")
+
+	validator := &phase0.Validator{
+		WithdrawalCredentials: execution[:],
+	}
+	credentials, err := validator.Credentials()
+	require.NoError(t, err)
+	require.Equal(t, execution, credentials)
+}
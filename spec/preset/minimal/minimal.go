@@ -0,0 +1,157 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package minimal provides the values of the minimal spec preset as constants, allowing
+// them to be used without access to a beacon node.
+package minimal
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Name is the name of the preset.
+const Name = "minimal"
+
+// Phase 0 preset values.
+const (
+	MaxCommitteesPerSlot                       = 4
+	TargetCommitteeSize                        = 4
+	MaxValidatorsPerCommittee                  = 2048
+	ShuffleRoundCount                          = 10
+	HysteresisQuotient                         = 4
+	HysteresisDownwardMultiplier               = 1
+	HysteresisUpwardMultiplier                 = 5
+	MinDepositAmount               phase0.Gwei = 1_000_000_000
+	MaxEffectiveBalance            phase0.Gwei = 32_000_000_000
+	EffectiveBalanceIncrement      phase0.Gwei = 1_000_000_000
+	MinAttestationInclusionDelay               = 1
+	SlotsPerEpoch                              = 8
+	MinSeedLookahead                           = 1
+	MaxSeedLookahead                           = 4
+	EpochsPerETH1VotingPeriod                  = 4
+	SlotsPerHistoricalRoot                     = 64
+	MinEpochsToInactivityPenalty               = 4
+	EpochsPerHistoricalVector                  = 64
+	EpochsPerSlashingsVector                   = 64
+	HistoricalRootsLimit                       = 16_777_216
+	ValidatorRegistryLimit                     = 1_099_511_627_776
+	BaseRewardFactor                           = 64
+	WhistleblowerRewardQuotient                = 512
+	ProposerRewardQuotient                     = 8
+	InactivityPenaltyQuotient                  = 33_554_432
+	MinSlashingPenaltyQuotient                 = 64
+	ProportionalSlashingMultiplier             = 2
+	MaxProposerSlashings                       = 16
+	MaxAttesterSlashings                       = 2
+	MaxAttestations                            = 128
+	MaxDeposits                                = 16
+	MaxVoluntaryExits                          = 16
+)
+
+// Altair preset values.
+const (
+	InactivityPenaltyQuotientAltair      = 50_331_648
+	MinSlashingPenaltyQuotientAltair     = 64
+	ProportionalSlashingMultiplierAltair = 2
+	SyncCommitteeSize                    = 32
+	EpochsPerSyncCommitteePeriod         = 8
+	MinSyncCommitteeParticipants         = 1
+	UpdateTimeout                        = 64
+)
+
+// Bellatrix preset values.
+const (
+	InactivityPenaltyQuotientBellatrix      = 16_777_216
+	MinSlashingPenaltyQuotientBellatrix     = 32
+	ProportionalSlashingMultiplierBellatrix = 3
+	MaxBytesPerTransaction                  = 1_073_741_824
+	MaxTransactionsPerPayload               = 1_048_576
+	BytesPerLogsBloom                       = 256
+	MaxExtraDataBytes                       = 32
+)
+
+// Capella preset values.
+const (
+	MaxBLSToExecutionChanges         = 16
+	MaxWithdrawalsPerPayload         = 4
+	MaxValidatorsPerWithdrawalsSweep = 16
+)
+
+// Deneb preset values.
+const (
+	FieldElementsPerBlob             = 4096
+	MaxBlobCommitmentsPerBlock       = 16
+	MaxBlobsPerBlock                 = 6
+	KZGCommitmentInclusionProofDepth = 9
+)
+
+// Spec returns the preset values keyed by their spec names, in the same form as the
+// values returned by a SpecProvider.
+func Spec() map[string]interface{} {
+	return map[string]interface{}{
+		"PRESET_BASE":                                Name,
+		"MAX_COMMITTEES_PER_SLOT":                    uint64(MaxCommitteesPerSlot),
+		"TARGET_COMMITTEE_SIZE":                      uint64(TargetCommitteeSize),
+		"MAX_VALIDATORS_PER_COMMITTEE":               uint64(MaxValidatorsPerCommittee),
+		"SHUFFLE_ROUND_COUNT":                        uint64(ShuffleRoundCount),
+		"HYSTERESIS_QUOTIENT":                        uint64(HysteresisQuotient),
+		"HYSTERESIS_DOWNWARD_MULTIPLIER":             uint64(HysteresisDownwardMultiplier),
+		"HYSTERESIS_UPWARD_MULTIPLIER":               uint64(HysteresisUpwardMultiplier),
+		"MIN_DEPOSIT_AMOUNT":                         uint64(MinDepositAmount),
+		"MAX_EFFECTIVE_BALANCE":                      uint64(MaxEffectiveBalance),
+		"EFFECTIVE_BALANCE_INCREMENT":                uint64(EffectiveBalanceIncrement),
+		"MIN_ATTESTATION_INCLUSION_DELAY":            uint64(MinAttestationInclusionDelay),
+		"SLOTS_PER_EPOCH":                            uint64(SlotsPerEpoch),
+		"MIN_SEED_LOOKAHEAD":                         uint64(MinSeedLookahead),
+		"MAX_SEED_LOOKAHEAD":                         uint64(MaxSeedLookahead),
+		"EPOCHS_PER_ETH1_VOTING_PERIOD":              uint64(EpochsPerETH1VotingPeriod),
+		"SLOTS_PER_HISTORICAL_ROOT":                  uint64(SlotsPerHistoricalRoot),
+		"MIN_EPOCHS_TO_INACTIVITY_PENALTY":           uint64(MinEpochsToInactivityPenalty),
+		"EPOCHS_PER_HISTORICAL_VECTOR":               uint64(EpochsPerHistoricalVector),
+		"EPOCHS_PER_SLASHINGS_VECTOR":                uint64(EpochsPerSlashingsVector),
+		"HISTORICAL_ROOTS_LIMIT":                     uint64(HistoricalRootsLimit),
+		"VALIDATOR_REGISTRY_LIMIT":                   uint64(ValidatorRegistryLimit),
+		"BASE_REWARD_FACTOR":                         uint64(BaseRewardFactor),
+		"WHISTLEBLOWER_REWARD_QUOTIENT":              uint64(WhistleblowerRewardQuotient),
+		"PROPOSER_REWARD_QUOTIENT":                   uint64(ProposerRewardQuotient),
+		"INACTIVITY_PENALTY_QUOTIENT":                uint64(InactivityPenaltyQuotient),
+		"MIN_SLASHING_PENALTY_QUOTIENT":              uint64(MinSlashingPenaltyQuotient),
+		"PROPORTIONAL_SLASHING_MULTIPLIER":           uint64(ProportionalSlashingMultiplier),
+		"MAX_PROPOSER_SLASHINGS":                     uint64(MaxProposerSlashings),
+		"MAX_ATTESTER_SLASHINGS":                     uint64(MaxAttesterSlashings),
+		"MAX_ATTESTATIONS":                           uint64(MaxAttestations),
+		"MAX_DEPOSITS":                               uint64(MaxDeposits),
+		"MAX_VOLUNTARY_EXITS":                        uint64(MaxVoluntaryExits),
+		"INACTIVITY_PENALTY_QUOTIENT_ALTAIR":         uint64(InactivityPenaltyQuotientAltair),
+		"MIN_SLASHING_PENALTY_QUOTIENT_ALTAIR":       uint64(MinSlashingPenaltyQuotientAltair),
+		"PROPORTIONAL_SLASHING_MULTIPLIER_ALTAIR":    uint64(ProportionalSlashingMultiplierAltair),
+		"SYNC_COMMITTEE_SIZE":                        uint64(SyncCommitteeSize),
+		"EPOCHS_PER_SYNC_COMMITTEE_PERIOD":           uint64(EpochsPerSyncCommitteePeriod),
+		"MIN_SYNC_COMMITTEE_PARTICIPANTS":            uint64(MinSyncCommitteeParticipants),
+		"UPDATE_TIMEOUT":                             uint64(UpdateTimeout),
+		"INACTIVITY_PENALTY_QUOTIENT_BELLATRIX":      uint64(InactivityPenaltyQuotientBellatrix),
+		"MIN_SLASHING_PENALTY_QUOTIENT_BELLATRIX":    uint64(MinSlashingPenaltyQuotientBellatrix),
+		"PROPORTIONAL_SLASHING_MULTIPLIER_BELLATRIX": uint64(ProportionalSlashingMultiplierBellatrix),
+		"MAX_BYTES_PER_TRANSACTION":                  uint64(MaxBytesPerTransaction),
+		"MAX_TRANSACTIONS_PER_PAYLOAD":               uint64(MaxTransactionsPerPayload),
+		"BYTES_PER_LOGS_BLOOM":                       uint64(BytesPerLogsBloom),
+		"MAX_EXTRA_DATA_BYTES":                       uint64(MaxExtraDataBytes),
+		"MAX_BLS_TO_EXECUTION_CHANGES":               uint64(MaxBLSToExecutionChanges),
+		"MAX_WITHDRAWALS_PER_PAYLOAD":                uint64(MaxWithdrawalsPerPayload),
+		"MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP":       uint64(MaxValidatorsPerWithdrawalsSweep),
+		"FIELD_ELEMENTS_PER_BLOB":                    uint64(FieldElementsPerBlob),
+		"MAX_BLOB_COMMITMENTS_PER_BLOCK":             uint64(MaxBlobCommitmentsPerBlock),
+		"MAX_BLOBS_PER_BLOCK":                        uint64(MaxBlobsPerBlock),
+		"KZG_COMMITMENT_INCLUSION_PROOF_DEPTH":       uint64(KZGCommitmentInclusionProofDepth),
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package preset provides the spec preset values that determine the sizes of
+// consensus containers.
+package preset
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+
+	"github.com/attestantio/go-eth2-client/spec/preset/mainnet"
+	"github.com/attestantio/go-eth2-client/spec/preset/minimal"
+)
+
+// Preset contains the preset values that determine the sizes of consensus containers.
+type Preset struct {
+	// Name is the name of the preset, for example "mainnet" or "minimal".
+	Name                       string
+	SlotsPerEpoch              uint64
+	MaxCommitteesPerSlot       uint64
+	MaxValidatorsPerCommittee  uint64
+	EpochsPerETH1VotingPeriod  uint64
+	SlotsPerHistoricalRoot     uint64
+	EpochsPerHistoricalVector  uint64
+	EpochsPerSlashingsVector   uint64
+	HistoricalRootsLimit       uint64
+	ValidatorRegistryLimit     uint64
+	MaxProposerSlashings       uint64
+	MaxAttesterSlashings       uint64
+	MaxAttestations            uint64
+	MaxDeposits                uint64
+	MaxVoluntaryExits          uint64
+	SyncCommitteeSize          uint64
+	SyncCommitteeSubnetCount   uint64
+	MaxBLSToExecutionChanges   uint64
+	MaxWithdrawalsPerPayload   uint64
+	MaxBlobCommitmentsPerBlock uint64
+	FieldElementsPerBlob       uint64
+}
+
+// Mainnet returns the mainnet preset.
+func Mainnet() *Preset {
+	return &Preset{
+		Name:                       mainnet.Name,
+		SlotsPerEpoch:              mainnet.SlotsPerEpoch,
+		MaxCommitteesPerSlot:       mainnet.MaxCommitteesPerSlot,
+		MaxValidatorsPerCommittee:  mainnet.MaxValidatorsPerCommittee,
+		EpochsPerETH1VotingPeriod:  mainnet.EpochsPerETH1VotingPeriod,
+		SlotsPerHistoricalRoot:     mainnet.SlotsPerHistoricalRoot,
+		EpochsPerHistoricalVector:  mainnet.EpochsPerHistoricalVector,
+		EpochsPerSlashingsVector:   mainnet.EpochsPerSlashingsVector,
+		HistoricalRootsLimit:       mainnet.HistoricalRootsLimit,
+		ValidatorRegistryLimit:     mainnet.ValidatorRegistryLimit,
+		MaxProposerSlashings:       mainnet.MaxProposerSlashings,
+		MaxAttesterSlashings:       mainnet.MaxAttesterSlashings,
+		MaxAttestations:            mainnet.MaxAttestations,
+		MaxDeposits:                mainnet.MaxDeposits,
+		MaxVoluntaryExits:          mainnet.MaxVoluntaryExits,
+		SyncCommitteeSize:          mainnet.SyncCommitteeSize,
+		SyncCommitteeSubnetCount:   4,
+		MaxBLSToExecutionChanges:   mainnet.MaxBLSToExecutionChanges,
+		MaxWithdrawalsPerPayload:   mainnet.MaxWithdrawalsPerPayload,
+		MaxBlobCommitmentsPerBlock: mainnet.MaxBlobCommitmentsPerBlock,
+		FieldElementsPerBlob:       mainnet.FieldElementsPerBlob,
+	}
+}
+
+// Minimal returns the minimal preset.
+func Minimal() *Preset {
+	return &Preset{
+		Name:                       minimal.Name,
+		SlotsPerEpoch:              minimal.SlotsPerEpoch,
+		MaxCommitteesPerSlot:       minimal.MaxCommitteesPerSlot,
+		MaxValidatorsPerCommittee:  minimal.MaxValidatorsPerCommittee,
+		EpochsPerETH1VotingPeriod:  minimal.EpochsPerETH1VotingPeriod,
+		SlotsPerHistoricalRoot:     minimal.SlotsPerHistoricalRoot,
+		EpochsPerHistoricalVector:  minimal.EpochsPerHistoricalVector,
+		EpochsPerSlashingsVector:   minimal.EpochsPerSlashingsVector,
+		HistoricalRootsLimit:       minimal.HistoricalRootsLimit,
+		ValidatorRegistryLimit:     minimal.ValidatorRegistryLimit,
+		MaxProposerSlashings:       minimal.MaxProposerSlashings,
+		MaxAttesterSlashings:       minimal.MaxAttesterSlashings,
+		MaxAttestations:            minimal.MaxAttestations,
+		MaxDeposits:                minimal.MaxDeposits,
+		MaxVoluntaryExits:          minimal.MaxVoluntaryExits,
+		SyncCommitteeSize:          minimal.SyncCommitteeSize,
+		SyncCommitteeSubnetCount:   4,
+		MaxBLSToExecutionChanges:   minimal.MaxBLSToExecutionChanges,
+		MaxWithdrawalsPerPayload:   minimal.MaxWithdrawalsPerPayload,
+		MaxBlobCommitmentsPerBlock: minimal.MaxBlobCommitmentsPerBlock,
+		FieldElementsPerBlob:       minimal.FieldElementsPerBlob,
+	}
+}
+
+// Gnosis returns the Gnosis chain preset.
+func Gnosis() *Preset {
+	res := Mainnet()
+	res.Name = "gnosis"
+	res.SlotsPerEpoch = 16
+	res.MaxWithdrawalsPerPayload = 8
+
+	return res
+}
+
+// ByName returns the named preset.
+func ByName(name string) (*Preset, error) {
+	switch name {
+	case "mainnet":
+		return Mainnet(), nil
+	case "minimal":
+		return Minimal(), nil
+	case "gnosis":
+		return Gnosis(), nil
+	default:
+		return nil, fmt.Errorf("unknown preset %q", name)
+	}
+}
+
+// Validate ensures that the preset values are usable.
+func (p *Preset) Validate() error {
+	if p.Name == "" {
+		return errors.New("preset name missing")
+	}
+	if p.SlotsPerEpoch == 0 {
+		return errors.New("slots per epoch must be positive")
+	}
+	if p.MaxValidatorsPerCommittee == 0 {
+		return errors.New("max validators per committee must be positive")
+	}
+	if p.SyncCommitteeSize == 0 || p.SyncCommitteeSize%8 != 0 {
+		return errors.New("sync committee size must be a positive multiple of 8")
+	}
+	if p.SyncCommitteeSubnetCount == 0 || (p.SyncCommitteeSize/p.SyncCommitteeSubnetCount)%8 != 0 {
+		return errors.New("sync committee subnet size must be a positive multiple of 8")
+	}
+
+	return nil
+}
+
+// Differences returns a description of each of the values that differ between the presets,
+// ignoring their names.
+func (p *Preset) Differences(other *Preset) []string {
+	res := make([]string, 0)
+	values := reflect.ValueOf(p).Elem()
+	otherValues := reflect.ValueOf(other).Elem()
+	for i := 0; i < values.NumField(); i++ {
+		name := values.Type().Field(i).Name
+		if name == "Name" {
+			continue
+		}
+		if values.Field(i).Uint() != otherValues.Field(i).Uint() {
+			res = append(res, fmt.Sprintf("%s is %d rather than %d", name, values.Field(i).Uint(), otherValues.Field(i).Uint()))
+		}
+	}
+
+	return res
+}
+
+// SSZCompatible returns true if the containers of the preset have the same SSZ encoding as mainnet.
+// The generated SSZ encoders use mainnet sizes, so SSZ can only be used with compatible presets.
+func (p *Preset) SSZCompatible() bool {
+	return len(p.Differences(Mainnet())) == 0
+}
+
+// current is the preset used when decoding containers.
+var current atomic.Pointer[Preset]
+
+func init() {
+	current.Store(Mainnet())
+}
+
+// Current returns the preset used when decoding containers.
+func Current() *Preset {
+	return current.Load()
+}
+
+// Set sets the preset used when decoding containers.
+// The preset applies to the whole process, so all clients in a process must use the same preset.
+func Set(p *Preset) error {
+	if p == nil {
+		return errors.New("no preset supplied")
+	}
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	res := *p
+	current.Store(&res)
+
+	return nil
+}
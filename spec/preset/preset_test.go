@@ -0,0 +1,179 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preset_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/preset"
+	"github.com/attestantio/go-eth2-client/spec/preset/mainnet"
+	"github.com/attestantio/go-eth2-client/spec/preset/minimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestByName(t *testing.T) {
+	mainnet, err := preset.ByName("mainnet")
+	require.NoError(t, err)
+	require.Equal(t, preset.Mainnet(), mainnet)
+
+	minimal, err := preset.ByName("minimal")
+	require.NoError(t, err)
+	require.Equal(t, preset.Minimal(), minimal)
+
+	gnosis, err := preset.ByName("gnosis")
+	require.NoError(t, err)
+	require.Equal(t, preset.Gnosis(), gnosis)
+
+	_, err = preset.ByName("unknown")
+	require.EqualError(t, err, `unknown preset "unknown"`)
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		preset func() *preset.Preset
+		err    string
+	}{
+		{
+			name:   "Mainnet",
+			preset: preset.Mainnet,
+		},
+		{
+			name:   "Minimal",
+			preset: preset.Minimal,
+		},
+		{
+			name: "NameMissing",
+			preset: func() *preset.Preset {
+				p := preset.Mainnet()
+				p.Name = ""
+				return p
+			},
+			err: "preset name missing",
+		},
+		{
+			name: "SlotsPerEpochZero",
+			preset: func() *preset.Preset {
+				p := preset.Mainnet()
+				p.SlotsPerEpoch = 0
+				return p
+			},
+			err: "slots per epoch must be positive",
+		},
+		{
+			name: "SyncCommitteeSizeInvalid",
+			preset: func() *preset.Preset {
+				p := preset.Mainnet()
+				p.SyncCommitteeSize = 20
+				return p
+			},
+			err: "sync committee size must be a positive multiple of 8",
+		},
+		{
+			name: "SyncCommitteeSubnetSizeInvalid",
+			preset: func() *preset.Preset {
+				p := preset.Minimal()
+				p.SyncCommitteeSubnetCount = 8
+				return p
+			},
+			err: "sync committee subnet size must be a positive multiple of 8",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.preset().Validate()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSSZCompatible(t *testing.T) {
+	require.True(t, preset.Mainnet().SSZCompatible())
+	require.False(t, preset.Minimal().SSZCompatible())
+
+	custom := preset.Mainnet()
+	custom.Name = "custom"
+	require.True(t, custom.SSZCompatible())
+	custom.SlotsPerEpoch = 16
+	require.False(t, custom.SSZCompatible())
+}
+
+func TestDifferences(t *testing.T) {
+	require.Empty(t, preset.Mainnet().Differences(preset.Mainnet()))
+	require.Equal(t, []string{
+		"SlotsPerEpoch is 16 rather than 32",
+		"MaxWithdrawalsPerPayload is 8 rather than 16",
+	}, preset.Gnosis().Differences(preset.Mainnet()))
+}
+
+func TestSet(t *testing.T) {
+	t.Cleanup(func() {
+		require.NoError(t, preset.Set(preset.Mainnet()))
+	})
+
+	require.Equal(t, "mainnet", preset.Current().Name)
+	require.EqualError(t, preset.Set(nil), "no preset supplied")
+	require.EqualError(t, preset.Set(&preset.Preset{}), "preset name missing")
+
+	minimal := preset.Minimal()
+	require.NoError(t, preset.Set(minimal))
+	require.Equal(t, minimal, preset.Current())
+	// Changes to the supplied preset do not affect the current preset.
+	minimal.SyncCommitteeSize = 64
+	require.Equal(t, uint64(32), preset.Current().SyncCommitteeSize)
+}
+
+// TestPresetSpecs ensures that the preset constants are in the form returned by a SpecProvider,
+// and are consistent with the presets.
+func TestPresetSpecs(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   map[string]interface{}
+		preset *preset.Preset
+	}{
+		{
+			name:   "Mainnet",
+			spec:   mainnet.Spec(),
+			preset: preset.Mainnet(),
+		},
+		{
+			name:   "Minimal",
+			spec:   minimal.Spec(),
+			preset: preset.Minimal(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for k, v := range test.spec {
+				if k == "PRESET_BASE" {
+					require.Equal(t, test.preset.Name, v)
+					continue
+				}
+				require.IsType(t, uint64(0), v, k)
+			}
+
+			config, err := api.NewSpecConfig(test.spec)
+			require.NoError(t, err)
+			require.Equal(t, test.preset, config.Preset())
+			require.Equal(t, test.spec["MAX_EFFECTIVE_BALANCE"], uint64(config.MaxEffectiveBalance))
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// UnmarshalSignedBeaconBlockSSZ unmarshals SSZ-encoded data in to a versioned signed beacon block
+// of the given version, as would be supplied alongside the data in an Eth-Consensus-Version header.
+func UnmarshalSignedBeaconBlockSSZ(version DataVersion, data []byte) (*VersionedSignedBeaconBlock, error) {
+	res := &VersionedSignedBeaconBlock{
+		Version: version,
+	}
+
+	var err error
+	switch version {
+	case DataVersionPhase0:
+		res.Phase0 = &phase0.SignedBeaconBlock{}
+		err = res.Phase0.UnmarshalSSZ(data)
+	case DataVersionAltair:
+		res.Altair = &altair.SignedBeaconBlock{}
+		err = res.Altair.UnmarshalSSZ(data)
+	case DataVersionBellatrix:
+		res.Bellatrix = &bellatrix.SignedBeaconBlock{}
+		err = res.Bellatrix.UnmarshalSSZ(data)
+	case DataVersionCapella:
+		res.Capella = &capella.SignedBeaconBlock{}
+		err = res.Capella.UnmarshalSSZ(data)
+	case DataVersionDeneb:
+		res.Deneb = &deneb.SignedBeaconBlock{}
+		err = res.Deneb.UnmarshalSSZ(data)
+	default:
+		return nil, fmt.Errorf("unsupported signed beacon block version %s", version)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal %s signed beacon block", version)
+	}
+
+	return res, nil
+}
+
+// UnmarshalBeaconBlockSSZ unmarshals SSZ-encoded data in to a versioned beacon block of the given version.
+func UnmarshalBeaconBlockSSZ(version DataVersion, data []byte) (*VersionedBeaconBlock, error) {
+	res := &VersionedBeaconBlock{
+		Version: version,
+	}
+
+	var err error
+	switch version {
+	case DataVersionPhase0:
+		res.Phase0 = &phase0.BeaconBlock{}
+		err = res.Phase0.UnmarshalSSZ(data)
+	case DataVersionAltair:
+		res.Altair = &altair.BeaconBlock{}
+		err = res.Altair.UnmarshalSSZ(data)
+	case DataVersionBellatrix:
+		res.Bellatrix = &bellatrix.BeaconBlock{}
+		err = res.Bellatrix.UnmarshalSSZ(data)
+	case DataVersionCapella:
+		res.Capella = &capella.BeaconBlock{}
+		err = res.Capella.UnmarshalSSZ(data)
+	case DataVersionDeneb:
+		res.Deneb = &deneb.BeaconBlock{}
+		err = res.Deneb.UnmarshalSSZ(data)
+	default:
+		return nil, fmt.Errorf("unsupported beacon block version %s", version)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal %s beacon block", version)
+	}
+
+	return res, nil
+}
+
+// UnmarshalBeaconBlockBodySSZ unmarshals SSZ-encoded data in to a versioned beacon block body of the given version.
+func UnmarshalBeaconBlockBodySSZ(version DataVersion, data []byte) (*VersionedBeaconBlockBody, error) {
+	res := &VersionedBeaconBlockBody{
+		Version: version,
+	}
+
+	var err error
+	switch version {
+	case DataVersionPhase0:
+		res.Phase0 = &phase0.BeaconBlockBody{}
+		err = res.Phase0.UnmarshalSSZ(data)
+	case DataVersionAltair:
+		res.Altair = &altair.BeaconBlockBody{}
+		err = res.Altair.UnmarshalSSZ(data)
+	case DataVersionBellatrix:
+		res.Bellatrix = &bellatrix.BeaconBlockBody{}
+		err = res.Bellatrix.UnmarshalSSZ(data)
+	case DataVersionCapella:
+		res.Capella = &capella.BeaconBlockBody{}
+		err = res.Capella.UnmarshalSSZ(data)
+	case DataVersionDeneb:
+		res.Deneb = &deneb.BeaconBlockBody{}
+		err = res.Deneb.UnmarshalSSZ(data)
+	default:
+		return nil, fmt.Errorf("unsupported beacon block body version %s", version)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal %s beacon block body", version)
+	}
+
+	return res, nil
+}
+
+// UnmarshalBeaconStateSSZ unmarshals SSZ-encoded data in to a versioned beacon state of the given version.
+func UnmarshalBeaconStateSSZ(version DataVersion, data []byte) (*VersionedBeaconState, error) {
+	res := &VersionedBeaconState{
+		Version: version,
+	}
+
+	var err error
+	switch version {
+	case DataVersionPhase0:
+		res.Phase0 = &phase0.BeaconState{}
+		err = res.Phase0.UnmarshalSSZ(data)
+	case DataVersionAltair:
+		res.Altair = &altair.BeaconState{}
+		err = res.Altair.UnmarshalSSZ(data)
+	case DataVersionBellatrix:
+		res.Bellatrix = &bellatrix.BeaconState{}
+		err = res.Bellatrix.UnmarshalSSZ(data)
+	case DataVersionCapella:
+		res.Capella = &capella.BeaconState{}
+		err = res.Capella.UnmarshalSSZ(data)
+	case DataVersionDeneb:
+		res.Deneb = &deneb.BeaconState{}
+		err = res.Deneb.UnmarshalSSZ(data)
+	default:
+		return nil, fmt.Errorf("unsupported beacon state version %s", version)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal %s beacon state", version)
+	}
+
+	return res, nil
+}
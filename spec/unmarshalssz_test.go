@@ -0,0 +1,131 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/gencorpus"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+// filled returns the container populated with zero values, after applying the supplied update.
+func filled[T any](t *testing.T, container *T, update func(*T)) *T {
+	t.Helper()
+
+	require.NoError(t, gencorpus.Fill(container, gencorpus.VariantZero))
+	update(container)
+
+	return container
+}
+
+// Deneb is not covered as its generated encoding lags the container definition.
+func TestUnmarshalSignedBeaconBlockSSZ(t *testing.T) {
+	tests := []struct {
+		name    string
+		version spec.DataVersion
+		block   ssz.Marshaler
+		err     string
+	}{
+		{
+			name:    "Phase0",
+			version: spec.DataVersionPhase0,
+			block:   filled(t, &phase0.SignedBeaconBlock{}, func(b *phase0.SignedBeaconBlock) { b.Message.Slot = 1 }),
+		},
+		{
+			name:    "Altair",
+			version: spec.DataVersionAltair,
+			block:   filled(t, &altair.SignedBeaconBlock{}, func(b *altair.SignedBeaconBlock) { b.Message.Slot = 2 }),
+		},
+		{
+			name:    "Bellatrix",
+			version: spec.DataVersionBellatrix,
+			block:   filled(t, &bellatrix.SignedBeaconBlock{}, func(b *bellatrix.SignedBeaconBlock) { b.Message.Slot = 3 }),
+		},
+		{
+			name:    "Capella",
+			version: spec.DataVersionCapella,
+			block:   filled(t, &capella.SignedBeaconBlock{}, func(b *capella.SignedBeaconBlock) { b.Message.Slot = 4 }),
+		},
+		{
+			name:    "WrongVersion",
+			version: spec.DataVersionPhase0,
+			block:   filled(t, &capella.SignedBeaconBlock{}, func(b *capella.SignedBeaconBlock) { b.Message.Slot = 4 }),
+			err:     "failed to unmarshal phase0 signed beacon block",
+		},
+		{
+			name:    "UnknownVersion",
+			version: spec.DataVersion(99),
+			block:   filled(t, &phase0.SignedBeaconBlock{}, func(b *phase0.SignedBeaconBlock) { b.Message.Slot = 1 }),
+			err:     "unsupported signed beacon block version unknown",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := test.block.MarshalSSZ()
+			require.NoError(t, err)
+
+			res, err := spec.UnmarshalSignedBeaconBlockSSZ(test.version, data)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.version, res.Version)
+			slot, err := res.Slot()
+			require.NoError(t, err)
+			require.Equal(t, phase0.Slot(test.version)+1, slot)
+		})
+	}
+}
+
+func TestUnmarshalBeaconStateSSZ(t *testing.T) {
+	state := filled(t, &capella.BeaconState{}, func(s *capella.BeaconState) { s.Slot = 12 })
+	data, err := state.MarshalSSZ()
+	require.NoError(t, err)
+
+	res, err := spec.UnmarshalBeaconStateSSZ(spec.DataVersionCapella, data)
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionCapella, res.Version)
+	require.True(t, state.Equal(res.Capella))
+
+	_, err = spec.UnmarshalBeaconStateSSZ(spec.DataVersionPhase0, data)
+	require.ErrorContains(t, err, "failed to unmarshal phase0 beacon state")
+}
+
+func TestUnmarshalBeaconBlockSSZ(t *testing.T) {
+	block := filled(t, &bellatrix.BeaconBlock{}, func(b *bellatrix.BeaconBlock) {
+		b.Slot = 12
+		b.ProposerIndex = 3
+	})
+	data, err := block.MarshalSSZ()
+	require.NoError(t, err)
+
+	res, err := spec.UnmarshalBeaconBlockSSZ(spec.DataVersionBellatrix, data)
+	require.NoError(t, err)
+	require.True(t, block.Equal(res.Bellatrix))
+
+	bodyData, err := block.Body.MarshalSSZ()
+	require.NoError(t, err)
+	body, err := spec.UnmarshalBeaconBlockBodySSZ(spec.DataVersionBellatrix, bodyData)
+	require.NoError(t, err)
+	require.True(t, block.Body.Equal(body.Bellatrix))
+}
@@ -0,0 +1,103 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// VersionedAttestation contains a versioned attestation.
+// The attestation format is unchanged from phase 0 through deneb.
+type VersionedAttestation struct {
+	Version   DataVersion
+	Phase0    *phase0.Attestation
+	Altair    *phase0.Attestation
+	Bellatrix *phase0.Attestation
+	Capella   *phase0.Attestation
+	Deneb     *phase0.Attestation
+}
+
+// IsEmpty returns true if there is no attestation.
+func (v *VersionedAttestation) IsEmpty() bool {
+	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil
+}
+
+// Attestation returns the attestation for the version.
+func (v *VersionedAttestation) Attestation() (*phase0.Attestation, error) {
+	var res *phase0.Attestation
+	switch v.Version {
+	case DataVersionPhase0:
+		res = v.Phase0
+	case DataVersionAltair:
+		res = v.Altair
+	case DataVersionBellatrix:
+		res = v.Bellatrix
+	case DataVersionCapella:
+		res = v.Capella
+	case DataVersionDeneb:
+		res = v.Deneb
+	default:
+		return nil, errors.New("unknown version")
+	}
+	if res == nil {
+		return nil, errors.New("no attestation")
+	}
+
+	return res, nil
+}
+
+// Data returns the data of the attestation.
+func (v *VersionedAttestation) Data() (*phase0.AttestationData, error) {
+	attestation, err := v.Attestation()
+	if err != nil {
+		return nil, err
+	}
+	if attestation.Data == nil {
+		return nil, errors.New("no attestation data")
+	}
+
+	return attestation.Data, nil
+}
+
+// SetAttestation sets the attestation for the version.
+func (v *VersionedAttestation) SetAttestation(attestation *phase0.Attestation) error {
+	switch v.Version {
+	case DataVersionPhase0:
+		v.Phase0 = attestation
+	case DataVersionAltair:
+		v.Altair = attestation
+	case DataVersionBellatrix:
+		v.Bellatrix = attestation
+	case DataVersionCapella:
+		v.Capella = attestation
+	case DataVersionDeneb:
+		v.Deneb = attestation
+	default:
+		return errors.New("unknown version")
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (v *VersionedAttestation) String() string {
+	attestation, err := v.Attestation()
+	if err != nil {
+		return ""
+	}
+
+	return attestation.String()
+}
@@ -0,0 +1,71 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedAttestation(t *testing.T) {
+	attestation := &phase0.Attestation{
+		Data: &phase0.AttestationData{
+			Slot: 5,
+		},
+	}
+
+	empty := &spec.VersionedAttestation{Version: spec.DataVersionCapella}
+	require.True(t, empty.IsEmpty())
+	_, err := empty.Data()
+	require.EqualError(t, err, "no attestation")
+	require.Equal(t, "", empty.String())
+
+	versioned := &spec.VersionedAttestation{Version: spec.DataVersionCapella}
+	require.NoError(t, versioned.SetAttestation(attestation))
+	require.False(t, versioned.IsEmpty())
+	require.Equal(t, attestation, versioned.Capella)
+	data, err := versioned.Data()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(5), data.Slot)
+
+	unknown := &spec.VersionedAttestation{Version: spec.DataVersion(99)}
+	require.EqualError(t, unknown.SetAttestation(attestation), "unknown version")
+	_, err = unknown.Attestation()
+	require.EqualError(t, err, "unknown version")
+}
+
+func TestVersionedSignedAggregateAndProof(t *testing.T) {
+	versioned := &spec.VersionedSignedAggregateAndProof{
+		Version: spec.DataVersionDeneb,
+		Deneb: &phase0.SignedAggregateAndProof{
+			Message: &phase0.AggregateAndProof{
+				Aggregate: &phase0.Attestation{
+					Data: &phase0.AttestationData{
+						Slot: 7,
+					},
+				},
+			},
+		},
+	}
+	require.False(t, versioned.IsEmpty())
+	slot, err := versioned.Slot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(7), slot)
+
+	_, err = (&spec.VersionedSignedAggregateAndProof{Version: spec.DataVersionDeneb, Deneb: &phase0.SignedAggregateAndProof{}}).Slot()
+	require.EqualError(t, err, "no aggregate data")
+}
@@ -203,6 +203,39 @@ func (v *VersionedBeaconBlock) StateRoot() (phase0.Root, error) {
 	}
 }
 
+// Graffiti returns the graffiti of the beacon block.
+func (v *VersionedBeaconBlock) Graffiti() ([32]byte, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil || v.Phase0.Body == nil {
+			return [32]byte{}, errors.New("no phase0 block")
+		}
+		return v.Phase0.Body.Graffiti, nil
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.Body == nil {
+			return [32]byte{}, errors.New("no altair block")
+		}
+		return v.Altair.Body.Graffiti, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Body == nil {
+			return [32]byte{}, errors.New("no bellatrix block")
+		}
+		return v.Bellatrix.Body.Graffiti, nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.Body == nil {
+			return [32]byte{}, errors.New("no capella block")
+		}
+		return v.Capella.Body.Graffiti, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Body == nil {
+			return [32]byte{}, errors.New("no deneb block")
+		}
+		return v.Deneb.Body.Graffiti, nil
+	default:
+		return [32]byte{}, errors.New("unknown version")
+	}
+}
+
 // Attestations returns the attestations of the beacon block.
 func (v *VersionedBeaconBlock) Attestations() ([]*phase0.Attestation, error) {
 	switch v.Version {
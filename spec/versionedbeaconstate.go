@@ -71,6 +71,72 @@ func (v *VersionedBeaconState) Slot() (phase0.Slot, error) {
 	}
 }
 
+// Fork returns the fork of the state.
+func (v *VersionedBeaconState) Fork() (*phase0.Fork, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no Phase0 state")
+		}
+		return v.Phase0.Fork, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+		return v.Altair.Fork, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+		return v.Bellatrix.Fork, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+		return v.Capella.Fork, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+		return v.Deneb.Fork, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// LatestBlockHeader returns the latest block header of the state.
+func (v *VersionedBeaconState) LatestBlockHeader() (*phase0.BeaconBlockHeader, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no Phase0 state")
+		}
+		return v.Phase0.LatestBlockHeader, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+		return v.Altair.LatestBlockHeader, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+		return v.Bellatrix.LatestBlockHeader, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+		return v.Capella.LatestBlockHeader, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+		return v.Deneb.LatestBlockHeader, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
 // NextWithdrawalValidatorIndex returns the next withdrawal validator index of the state.
 func (v *VersionedBeaconState) NextWithdrawalValidatorIndex() (phase0.ValidatorIndex, error) {
 	switch v.Version {
@@ -124,8 +190,8 @@ func (v *VersionedBeaconState) Validators() ([]*phase0.Validator, error) {
 	}
 }
 
-// ValidatorBalances returns the validator balances of the state.
-func (v *VersionedBeaconState) ValidatorBalances() ([]phase0.Gwei, error) {
+// Balances returns the validator balances of the state.
+func (v *VersionedBeaconState) Balances() ([]phase0.Gwei, error) {
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil {
@@ -157,6 +223,107 @@ func (v *VersionedBeaconState) ValidatorBalances() ([]phase0.Gwei, error) {
 	}
 }
 
+// CurrentSyncCommittee returns the current sync committee of the state.
+func (v *VersionedBeaconState) CurrentSyncCommittee() (*altair.SyncCommittee, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.New("state does not provide current sync committee")
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+		return v.Altair.CurrentSyncCommittee, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+		return v.Bellatrix.CurrentSyncCommittee, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+		return v.Capella.CurrentSyncCommittee, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+		return v.Deneb.CurrentSyncCommittee, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// NextSyncCommittee returns the next sync committee of the state.
+func (v *VersionedBeaconState) NextSyncCommittee() (*altair.SyncCommittee, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.New("state does not provide next sync committee")
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+		return v.Altair.NextSyncCommittee, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+		return v.Bellatrix.NextSyncCommittee, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+		return v.Capella.NextSyncCommittee, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+		return v.Deneb.NextSyncCommittee, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// ExecutionPayloadHeader returns the latest execution payload header of the state.
+func (v *VersionedBeaconState) ExecutionPayloadHeader() (*VersionedExecutionPayloadHeader, error) {
+	switch v.Version {
+	case DataVersionPhase0, DataVersionAltair:
+		return nil, errors.New("state does not provide execution payload header")
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+		return &VersionedExecutionPayloadHeader{
+			Version:   DataVersionBellatrix,
+			Bellatrix: v.Bellatrix.LatestExecutionPayloadHeader,
+		}, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+		return &VersionedExecutionPayloadHeader{
+			Version: DataVersionCapella,
+			Capella: v.Capella.LatestExecutionPayloadHeader,
+		}, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+		return &VersionedExecutionPayloadHeader{
+			Version: DataVersionDeneb,
+			Deneb:   v.Deneb.LatestExecutionPayloadHeader,
+		}, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// ValidatorBalances returns the validator balances of the state.
+//
+// Deprecated: use Balances.
+func (v *VersionedBeaconState) ValidatorBalances() ([]phase0.Gwei, error) {
+	return v.Balances()
+}
+
 // String returns a string version of the structure.
 func (v *VersionedBeaconState) String() string {
 	switch v.Version {
@@ -0,0 +1,87 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedBeaconStateAccessors(t *testing.T) {
+	fork := &phase0.Fork{Epoch: 10}
+	header := &phase0.BeaconBlockHeader{Slot: 5}
+	syncCommittee := &altair.SyncCommittee{}
+	payloadHeader := &capella.ExecutionPayloadHeader{BlockNumber: 100}
+
+	capellaState := &spec.VersionedBeaconState{
+		Version: spec.DataVersionCapella,
+		Capella: &capella.BeaconState{
+			Slot:                         5,
+			Fork:                         fork,
+			LatestBlockHeader:            header,
+			Validators:                   []*phase0.Validator{{}},
+			Balances:                     []phase0.Gwei{32000000000},
+			CurrentSyncCommittee:         syncCommittee,
+			NextSyncCommittee:            syncCommittee,
+			LatestExecutionPayloadHeader: payloadHeader,
+		},
+	}
+
+	slot, err := capellaState.Slot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(5), slot)
+	resFork, err := capellaState.Fork()
+	require.NoError(t, err)
+	require.Equal(t, fork, resFork)
+	resHeader, err := capellaState.LatestBlockHeader()
+	require.NoError(t, err)
+	require.Equal(t, header, resHeader)
+	validators, err := capellaState.Validators()
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+	balances, err := capellaState.Balances()
+	require.NoError(t, err)
+	require.Equal(t, []phase0.Gwei{32000000000}, balances)
+	resSyncCommittee, err := capellaState.NextSyncCommittee()
+	require.NoError(t, err)
+	require.Equal(t, syncCommittee, resSyncCommittee)
+	resPayloadHeader, err := capellaState.ExecutionPayloadHeader()
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionCapella, resPayloadHeader.Version)
+	blockNumber, err := resPayloadHeader.BlockNumber()
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), blockNumber)
+
+	phase0State := &spec.VersionedBeaconState{
+		Version: spec.DataVersionPhase0,
+		Phase0:  &phase0.BeaconState{Fork: fork},
+	}
+	resFork, err = phase0State.Fork()
+	require.NoError(t, err)
+	require.Equal(t, fork, resFork)
+	_, err = phase0State.NextSyncCommittee()
+	require.EqualError(t, err, "state does not provide next sync committee")
+	_, err = phase0State.ExecutionPayloadHeader()
+	require.EqualError(t, err, "state does not provide execution payload header")
+
+	_, err = (&spec.VersionedBeaconState{Version: spec.DataVersionDeneb}).Fork()
+	require.EqualError(t, err, "no Deneb state")
+	_, err = (&spec.VersionedBeaconState{Version: spec.DataVersion(99)}).Balances()
+	require.EqualError(t, err, "unknown version")
+}
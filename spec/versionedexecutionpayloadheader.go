@@ -0,0 +1,105 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// VersionedExecutionPayloadHeader contains a versioned execution payload header.
+type VersionedExecutionPayloadHeader struct {
+	Version   DataVersion
+	Bellatrix *bellatrix.ExecutionPayloadHeader
+	Capella   *capella.ExecutionPayloadHeader
+	Deneb     *deneb.ExecutionPayloadHeader
+}
+
+// IsEmpty returns true if there is no execution payload header.
+func (v *VersionedExecutionPayloadHeader) IsEmpty() bool {
+	return v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil
+}
+
+// BlockHash returns the block hash of the execution payload header.
+func (v *VersionedExecutionPayloadHeader) BlockHash() (phase0.Hash32, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return phase0.Hash32{}, errors.New("no Bellatrix execution payload header")
+		}
+		return v.Bellatrix.BlockHash, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.Hash32{}, errors.New("no Capella execution payload header")
+		}
+		return v.Capella.BlockHash, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return phase0.Hash32{}, errors.New("no Deneb execution payload header")
+		}
+		return v.Deneb.BlockHash, nil
+	default:
+		return phase0.Hash32{}, errors.New("unknown version")
+	}
+}
+
+// BlockNumber returns the block number of the execution payload header.
+func (v *VersionedExecutionPayloadHeader) BlockNumber() (uint64, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, errors.New("no Bellatrix execution payload header")
+		}
+		return v.Bellatrix.BlockNumber, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no Capella execution payload header")
+		}
+		return v.Capella.BlockNumber, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no Deneb execution payload header")
+		}
+		return v.Deneb.BlockNumber, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// String returns a string version of the structure.
+func (v *VersionedExecutionPayloadHeader) String() string {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return ""
+		}
+		return v.Bellatrix.String()
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return ""
+		}
+		return v.Capella.String()
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return ""
+		}
+		return v.Deneb.String()
+	default:
+		return "unknown version"
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// VersionedSignedAggregateAndProof contains a versioned signed aggregate and proof.
+// The aggregate and proof format is unchanged from phase 0 through deneb.
+type VersionedSignedAggregateAndProof struct {
+	Version   DataVersion
+	Phase0    *phase0.SignedAggregateAndProof
+	Altair    *phase0.SignedAggregateAndProof
+	Bellatrix *phase0.SignedAggregateAndProof
+	Capella   *phase0.SignedAggregateAndProof
+	Deneb     *phase0.SignedAggregateAndProof
+}
+
+// IsEmpty returns true if there is no signed aggregate and proof.
+func (v *VersionedSignedAggregateAndProof) IsEmpty() bool {
+	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil
+}
+
+// SignedAggregateAndProof returns the signed aggregate and proof for the version.
+func (v *VersionedSignedAggregateAndProof) SignedAggregateAndProof() (*phase0.SignedAggregateAndProof, error) {
+	var res *phase0.SignedAggregateAndProof
+	switch v.Version {
+	case DataVersionPhase0:
+		res = v.Phase0
+	case DataVersionAltair:
+		res = v.Altair
+	case DataVersionBellatrix:
+		res = v.Bellatrix
+	case DataVersionCapella:
+		res = v.Capella
+	case DataVersionDeneb:
+		res = v.Deneb
+	default:
+		return nil, errors.New("unknown version")
+	}
+	if res == nil {
+		return nil, errors.New("no signed aggregate and proof")
+	}
+
+	return res, nil
+}
+
+// Slot returns the slot of the aggregate.
+func (v *VersionedSignedAggregateAndProof) Slot() (phase0.Slot, error) {
+	signedAggregateAndProof, err := v.SignedAggregateAndProof()
+	if err != nil {
+		return 0, err
+	}
+	if signedAggregateAndProof.Message == nil ||
+		signedAggregateAndProof.Message.Aggregate == nil ||
+		signedAggregateAndProof.Message.Aggregate.Data == nil {
+		return 0, errors.New("no aggregate data")
+	}
+
+	return signedAggregateAndProof.Message.Aggregate.Data.Slot, nil
+}
+
+// String returns a string version of the structure.
+func (v *VersionedSignedAggregateAndProof) String() string {
+	signedAggregateAndProof, err := v.SignedAggregateAndProof()
+	if err != nil {
+		return ""
+	}
+
+	return signedAggregateAndProof.String()
+}
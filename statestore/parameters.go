@@ -0,0 +1,81 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statestore
+
+import (
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel  zerolog.Level
+	directory string
+	maxSize   int64
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithDirectory sets the directory in which states are stored.
+// The directory is created if it does not exist.
+func WithDirectory(directory string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.directory = directory
+	})
+}
+
+// WithMaxSize sets the maximum total size in bytes of the states held in the store.
+// The least recently used states are evicted when the size is exceeded.
+func WithMaxSize(maxSize int64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxSize = maxSize
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+		maxSize:  4 * 1024 * 1024 * 1024,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.directory == "" {
+		return nil, errors.New("no directory specified")
+	}
+	if parameters.maxSize <= 0 {
+		return nil, errors.New("max size must be greater than 0")
+	}
+
+	return &parameters, nil
+}
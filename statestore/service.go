@@ -0,0 +1,322 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package statestore provides an on-disk store of beacon states, keyed by state root.
+// States are held in SSZ form and evicted on a least recently used basis once the
+// store exceeds its maximum size.
+package statestore
+
+import (
+	"container/list"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// stateFileSuffix is the suffix for files holding states.
+const stateFileSuffix = ".ssz"
+
+// Service is an on-disk store of beacon states.
+type Service struct {
+	log       zerolog.Logger
+	directory string
+	maxSize   int64
+
+	mu      sync.Mutex
+	size    int64
+	entries map[phase0.Root]*list.Element
+	// lru holds entries with the most recently used at the front.
+	lru *list.List
+}
+
+// entry is an entry in the store.
+type entry struct {
+	root    phase0.Root
+	version spec.DataVersion
+	size    int64
+}
+
+// New creates a new state store, indexing any states already present in its directory.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	log := zerologger.With().Str("service", "statestore").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	if err := os.MkdirAll(parameters.directory, 0o700); err != nil {
+		return nil, errors.Wrap(err, "failed to create directory")
+	}
+
+	s := &Service{
+		log:       log,
+		directory: parameters.directory,
+		maxSize:   parameters.maxSize,
+		entries:   make(map[phase0.Root]*list.Element),
+		lru:       list.New(),
+	}
+	if err := s.index(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// BeaconState returns the beacon state with the given root.
+// If the state is not in the store this returns nil without an error.
+func (s *Service) BeaconState(_ context.Context, root phase0.Root) (*spec.VersionedBeaconState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	element, exists := s.entries[root]
+	if !exists {
+		return nil, nil
+	}
+	e := element.Value.(*entry)
+
+	data, err := os.ReadFile(s.path(e))
+	if err != nil {
+		s.remove(element)
+		return nil, errors.Wrap(err, "failed to read state")
+	}
+	state, err := unmarshalState(e.version, data)
+	if err != nil {
+		s.remove(element)
+		return nil, err
+	}
+
+	s.touch(element)
+
+	return state, nil
+}
+
+// StoreBeaconState stores the beacon state with the given root.
+// The root must match that of the state, to avoid storing a state under the wrong key.
+func (s *Service) StoreBeaconState(_ context.Context, root phase0.Root, state *spec.VersionedBeaconState) error {
+	if state == nil {
+		return errors.New("no state supplied")
+	}
+
+	s.mu.Lock()
+	element, exists := s.entries[root]
+	if exists {
+		s.touch(element)
+	}
+	s.mu.Unlock()
+	if exists {
+		return nil
+	}
+
+	versioned, err := versionedState(state)
+	if err != nil {
+		return err
+	}
+	stateRoot, err := versioned.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate state root")
+	}
+	if stateRoot != root {
+		return fmt.Errorf("state has root %#x; expected %#x", stateRoot, root)
+	}
+	data, err := versioned.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal state")
+	}
+	if int64(len(data)) > s.maxSize {
+		return fmt.Errorf("state size %d exceeds maximum store size %d", len(data), s.maxSize)
+	}
+
+	e := &entry{
+		root:    root,
+		version: state.Version,
+		size:    int64(len(data)),
+	}
+
+	// Write to a temporary file first so that a partially-written state is never indexed.
+	tmpFile, err := os.CreateTemp(s.directory, "state-*.tmp")
+	if err != nil {
+		return errors.Wrap(err, "failed to create temporary file")
+	}
+	if _, err := tmpFile.Write(data); err != nil {
+		_ = tmpFile.Close()
+		_ = os.Remove(tmpFile.Name())
+		return errors.Wrap(err, "failed to write state")
+	}
+	if err := tmpFile.Close(); err != nil {
+		_ = os.Remove(tmpFile.Name())
+		return errors.Wrap(err, "failed to close state file")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.Rename(tmpFile.Name(), s.path(e)); err != nil {
+		_ = os.Remove(tmpFile.Name())
+		return errors.Wrap(err, "failed to rename state file")
+	}
+	if element, exists := s.entries[root]; exists {
+		// Stored concurrently; the file has been replaced with identical content.
+		s.touch(element)
+		return nil
+	}
+	s.add(e)
+	s.evict()
+
+	return nil
+}
+
+// Size returns the total size in bytes of the states in the store.
+func (s *Service) Size() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.size
+}
+
+// Len returns the number of states in the store.
+func (s *Service) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.lru.Len()
+}
+
+// index builds the index from the states already present in the directory, with
+// the most recently modified at the front.
+func (s *Service) index() error {
+	dirEntries, err := os.ReadDir(s.directory)
+	if err != nil {
+		return errors.Wrap(err, "failed to read directory")
+	}
+
+	type indexEntry struct {
+		entry   *entry
+		modTime time.Time
+	}
+	indexEntries := make([]*indexEntry, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() {
+			continue
+		}
+		e, err := parseFilename(dirEntry.Name())
+		if err != nil {
+			continue
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			return errors.Wrap(err, "failed to obtain file information")
+		}
+		e.size = info.Size()
+		indexEntries = append(indexEntries, &indexEntry{
+			entry:   e,
+			modTime: info.ModTime(),
+		})
+	}
+	sort.Slice(indexEntries, func(i int, j int) bool {
+		return indexEntries[i].modTime.Before(indexEntries[j].modTime)
+	})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, indexEntry := range indexEntries {
+		s.add(indexEntry.entry)
+	}
+	s.evict()
+	s.log.Trace().Int("states", s.lru.Len()).Int64("size", s.size).Msg("Indexed states")
+
+	return nil
+}
+
+// add adds an entry as the most recently used.  Must be called with the lock held.
+func (s *Service) add(e *entry) {
+	s.entries[e.root] = s.lru.PushFront(e)
+	s.size += e.size
+}
+
+// touch marks an entry as the most recently used.  Must be called with the lock held.
+func (s *Service) touch(element *list.Element) {
+	s.lru.MoveToFront(element)
+	// Update the modification time so that recency survives a restart; best effort.
+	now := time.Now()
+	_ = os.Chtimes(s.path(element.Value.(*entry)), now, now)
+}
+
+// remove removes an entry and its file.  Must be called with the lock held.
+func (s *Service) remove(element *list.Element) {
+	e := element.Value.(*entry)
+	s.lru.Remove(element)
+	delete(s.entries, e.root)
+	s.size -= e.size
+	if err := os.Remove(s.path(e)); err != nil && !os.IsNotExist(err) {
+		s.log.Warn().Err(err).Str("root", fmt.Sprintf("%#x", e.root)).Msg("Failed to remove state file")
+	}
+}
+
+// evict removes the least recently used entries until the store is within its maximum size.
+// Must be called with the lock held.
+func (s *Service) evict() {
+	for s.size > s.maxSize {
+		element := s.lru.Back()
+		if element == nil {
+			return
+		}
+		s.log.Trace().Str("root", fmt.Sprintf("%#x", element.Value.(*entry).root)).Msg("Evicting state")
+		s.remove(element)
+	}
+}
+
+// path returns the path of the file for the entry.
+func (s *Service) path(e *entry) string {
+	return filepath.Join(s.directory, fmt.Sprintf("%x.%s%s", e.root, e.version, stateFileSuffix))
+}
+
+// parseFilename parses the root and version from a state filename.
+func parseFilename(name string) (*entry, error) {
+	if !strings.HasSuffix(name, stateFileSuffix) {
+		return nil, errors.New("not a state file")
+	}
+	parts := strings.Split(strings.TrimSuffix(name, stateFileSuffix), ".")
+	if len(parts) != 2 {
+		return nil, errors.New("invalid state filename")
+	}
+
+	rootBytes, err := hex.DecodeString(parts[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid root")
+	}
+	if len(rootBytes) != phase0.RootLength {
+		return nil, errors.New("incorrect length for root")
+	}
+	e := &entry{}
+	copy(e.root[:], rootBytes)
+	if err := e.version.UnmarshalJSON([]byte(fmt.Sprintf("%q", parts[1]))); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
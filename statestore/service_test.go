@@ -0,0 +1,183 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statestore_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/gencorpus"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/statestore"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+var _ http.StateStore = (*statestore.Service)(nil)
+
+// testState returns an altair state for the given slot, along with its root and encoded size.
+func testState(t *testing.T, slot phase0.Slot) (*spec.VersionedBeaconState, phase0.Root, int64) {
+	t.Helper()
+
+	state := &altair.BeaconState{}
+	require.NoError(t, gencorpus.Fill(state, gencorpus.VariantZero))
+	state.Slot = slot
+	root, err := state.HashTreeRoot()
+	require.NoError(t, err)
+
+	return &spec.VersionedBeaconState{
+		Version: spec.DataVersionAltair,
+		Altair:  state,
+	}, root, int64(state.SizeSSZ())
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := statestore.New(ctx)
+	require.EqualError(t, err, "problem with parameters: no directory specified")
+
+	_, err = statestore.New(ctx,
+		statestore.WithDirectory(t.TempDir()),
+		statestore.WithMaxSize(0),
+	)
+	require.EqualError(t, err, "problem with parameters: max size must be greater than 0")
+
+	store, err := statestore.New(ctx,
+		statestore.WithLogLevel(zerolog.Disabled),
+		statestore.WithDirectory(filepath.Join(t.TempDir(), "states")),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 0, store.Len())
+}
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := statestore.New(ctx,
+		statestore.WithLogLevel(zerolog.Disabled),
+		statestore.WithDirectory(t.TempDir()),
+	)
+	require.NoError(t, err)
+
+	state, root, size := testState(t, 1)
+
+	res, err := store.BeaconState(ctx, root)
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	require.EqualError(t, store.StoreBeaconState(ctx, root, nil), "no state supplied")
+	require.EqualError(t, store.StoreBeaconState(ctx, root, &spec.VersionedBeaconState{Version: spec.DataVersionAltair}), "no altair state")
+	require.ErrorContains(t, store.StoreBeaconState(ctx, phase0.Root{0x01}, state), "state has root")
+	require.Equal(t, 0, store.Len())
+
+	require.NoError(t, store.StoreBeaconState(ctx, root, state))
+	require.Equal(t, 1, store.Len())
+	require.Equal(t, size, store.Size())
+	// Storing again is a no-op.
+	require.NoError(t, store.StoreBeaconState(ctx, root, state))
+	require.Equal(t, 1, store.Len())
+
+	res, err = store.BeaconState(ctx, root)
+	require.NoError(t, err)
+	require.Equal(t, state, res)
+}
+
+func TestEviction(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	state1, root1, size := testState(t, 1)
+	state2, root2, _ := testState(t, 2)
+	state3, root3, _ := testState(t, 3)
+
+	store, err := statestore.New(ctx,
+		statestore.WithLogLevel(zerolog.Disabled),
+		statestore.WithDirectory(dir),
+		statestore.WithMaxSize(2*size),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, store.StoreBeaconState(ctx, root1, state1))
+	require.NoError(t, store.StoreBeaconState(ctx, root2, state2))
+	// Access state 1 so that state 2 is the least recently used.
+	res, err := store.BeaconState(ctx, root1)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.NoError(t, store.StoreBeaconState(ctx, root3, state3))
+	require.Equal(t, 2, store.Len())
+	require.Equal(t, 2*size, store.Size())
+
+	res, err = store.BeaconState(ctx, root2)
+	require.NoError(t, err)
+	require.Nil(t, res)
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	// A new store over the same directory picks up the existing states.
+	store, err = statestore.New(ctx,
+		statestore.WithLogLevel(zerolog.Disabled),
+		statestore.WithDirectory(dir),
+		statestore.WithMaxSize(2*size),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 2, store.Len())
+	res, err = store.BeaconState(ctx, root3)
+	require.NoError(t, err)
+	require.Equal(t, state3, res)
+
+	// A smaller store evicts down to its size.
+	store, err = statestore.New(ctx,
+		statestore.WithLogLevel(zerolog.Disabled),
+		statestore.WithDirectory(dir),
+		statestore.WithMaxSize(size),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, store.Len())
+	res, err = store.BeaconState(ctx, root3)
+	require.NoError(t, err)
+	require.Equal(t, state3, res)
+}
+
+func TestCorrupt(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	state, root, _ := testState(t, 1)
+
+	store, err := statestore.New(ctx,
+		statestore.WithLogLevel(zerolog.Disabled),
+		statestore.WithDirectory(dir),
+	)
+	require.NoError(t, err)
+	require.NoError(t, store.StoreBeaconState(ctx, root, state))
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, files[0].Name()), []byte{0x01, 0x02}, 0o600))
+
+	_, err = store.BeaconState(ctx, root)
+	require.ErrorContains(t, err, "failed to unmarshal altair state")
+	require.Equal(t, 0, store.Len())
+	files, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 0)
+}
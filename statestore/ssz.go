@@ -0,0 +1,100 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statestore
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+)
+
+// sszState is a beacon state that can be encoded and hashed.
+type sszState interface {
+	ssz.Marshaler
+	ssz.HashRoot
+}
+
+// versionedState returns the state for the version of the versioned state.
+func versionedState(state *spec.VersionedBeaconState) (sszState, error) {
+	var res sszState
+	switch state.Version {
+	case spec.DataVersionPhase0:
+		if state.Phase0 != nil {
+			res = state.Phase0
+		}
+	case spec.DataVersionAltair:
+		if state.Altair != nil {
+			res = state.Altair
+		}
+	case spec.DataVersionBellatrix:
+		if state.Bellatrix != nil {
+			res = state.Bellatrix
+		}
+	case spec.DataVersionCapella:
+		if state.Capella != nil {
+			res = state.Capella
+		}
+	case spec.DataVersionDeneb:
+		if state.Deneb != nil {
+			res = state.Deneb
+		}
+	default:
+		return nil, fmt.Errorf("unsupported state version %s", state.Version)
+	}
+	if res == nil {
+		return nil, fmt.Errorf("no %s state", state.Version)
+	}
+
+	return res, nil
+}
+
+// unmarshalState decodes an SSZ-encoded state of the given version.
+func unmarshalState(version spec.DataVersion, data []byte) (*spec.VersionedBeaconState, error) {
+	res := &spec.VersionedBeaconState{
+		Version: version,
+	}
+
+	var err error
+	switch version {
+	case spec.DataVersionPhase0:
+		res.Phase0 = &phase0.BeaconState{}
+		err = res.Phase0.UnmarshalSSZ(data)
+	case spec.DataVersionAltair:
+		res.Altair = &altair.BeaconState{}
+		err = res.Altair.UnmarshalSSZ(data)
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &bellatrix.BeaconState{}
+		err = res.Bellatrix.UnmarshalSSZ(data)
+	case spec.DataVersionCapella:
+		res.Capella = &capella.BeaconState{}
+		err = res.Capella.UnmarshalSSZ(data)
+	case spec.DataVersionDeneb:
+		res.Deneb = &deneb.BeaconState{}
+		err = res.Deneb.UnmarshalSSZ(data)
+	default:
+		return nil, fmt.Errorf("unsupported state version %s", version)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to unmarshal %s state", version))
+	}
+
+	return res, nil
+}
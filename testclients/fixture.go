@@ -0,0 +1,197 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testclients
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Fixture is a set of calls made to a client, along with their results, as captured by a
+// recorder and served by a replayer.
+type Fixture struct {
+	// Name is the name of the recorded client.
+	Name string `json:"name"`
+	// Address is the address of the recorded client.
+	Address string `json:"address"`
+	// Calls are the recorded calls, in the order in which they were made.
+	Calls []*FixtureCall `json:"calls"`
+}
+
+// FixtureCall is a single recorded call.
+type FixtureCall struct {
+	// Method is the name of the method called.
+	Method string `json:"method"`
+	// Args are the JSON-encoded arguments to the call, excluding the context.
+	Args json.RawMessage `json:"args"`
+	// Results are the JSON-encoded results of the call, excluding the error.
+	Results []json.RawMessage `json:"results,omitempty"`
+	// Error is the text of the error returned by the call, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// LoadFixture loads a fixture from a file.
+func LoadFixture(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read fixture")
+	}
+
+	var fixture Fixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return nil, errors.Wrap(err, "failed to parse fixture")
+	}
+
+	return &fixture, nil
+}
+
+// Save saves the fixture to a file.
+func (f *Fixture) Save(path string) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal fixture")
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return errors.Wrap(err, "failed to write fixture")
+	}
+
+	return nil
+}
+
+// callKey returns the key used to match a call with its recording.
+func callKey(method string, args []byte) (string, error) {
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, args); err != nil {
+		return "", errors.Wrap(err, "invalid arguments")
+	}
+
+	return fmt.Sprintf("%s%s", method, compacted.String()), nil
+}
+
+// encodeValue encodes a value for a fixture.
+func encodeValue(value interface{}) (json.RawMessage, error) {
+	if spec, isSpec := value.(map[string]interface{}); isSpec {
+		return encodeSpec(spec)
+	}
+
+	return json.Marshal(value)
+}
+
+// decodeValue decodes a value from a fixture in to the target.
+func decodeValue(data json.RawMessage, target interface{}) error {
+	if spec, isSpec := target.(*map[string]interface{}); isSpec {
+		return decodeSpec(data, spec)
+	}
+
+	return json.Unmarshal(data, target)
+}
+
+// specValue is a spec value with its type, allowing the types of spec values to survive
+// encoding.
+type specValue struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// encodeSpec encodes spec data, retaining the types of its values.
+func encodeSpec(spec map[string]interface{}) (json.RawMessage, error) {
+	values := make(map[string]*specValue, len(spec))
+	for k, v := range spec {
+		switch typedValue := v.(type) {
+		case string:
+			values[k] = &specValue{Type: "string", Value: typedValue}
+		case uint64:
+			values[k] = &specValue{Type: "uint64", Value: fmt.Sprintf("%d", typedValue)}
+		case time.Duration:
+			values[k] = &specValue{Type: "duration", Value: typedValue.String()}
+		case time.Time:
+			values[k] = &specValue{Type: "time", Value: typedValue.Format(time.RFC3339Nano)}
+		case phase0.Version:
+			values[k] = &specValue{Type: "version", Value: fmt.Sprintf("%#x", typedValue)}
+		case phase0.DomainType:
+			values[k] = &specValue{Type: "domain_type", Value: fmt.Sprintf("%#x", typedValue)}
+		case []byte:
+			values[k] = &specValue{Type: "bytes", Value: fmt.Sprintf("%#x", typedValue)}
+		default:
+			return nil, fmt.Errorf("unsupported type %T for spec value %s", v, k)
+		}
+	}
+
+	return json.Marshal(values)
+}
+
+// decodeSpec decodes spec data encoded with encodeSpec.
+func decodeSpec(data json.RawMessage, spec *map[string]interface{}) error {
+	var values map[string]*specValue
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	res := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		var err error
+		switch v.Type {
+		case "string":
+			res[k] = v.Value
+		case "uint64":
+			var val uint64
+			_, err = fmt.Sscanf(v.Value, "%d", &val)
+			res[k] = val
+		case "duration":
+			res[k], err = time.ParseDuration(v.Value)
+		case "time":
+			res[k], err = time.Parse(time.RFC3339Nano, v.Value)
+		case "version":
+			var val phase0.Version
+			err = decodeFixedHex(v.Value, val[:])
+			res[k] = val
+		case "domain_type":
+			var val phase0.DomainType
+			err = decodeFixedHex(v.Value, val[:])
+			res[k] = val
+		case "bytes":
+			res[k], err = hex.DecodeString(strings.TrimPrefix(v.Value, "0x"))
+		default:
+			err = fmt.Errorf("unsupported type %s", v.Type)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "invalid spec value %s", k)
+		}
+	}
+	*spec = res
+
+	return nil
+}
+
+// decodeFixedHex decodes a hex string in to a fixed-length byte array.
+func decodeFixedHex(input string, output []byte) error {
+	data, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		return err
+	}
+	if len(data) != len(output) {
+		return fmt.Errorf("incorrect length %d", len(data))
+	}
+	copy(output, data)
+
+	return nil
+}
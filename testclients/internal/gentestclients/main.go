@@ -0,0 +1,560 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command gentestclients generates the provider methods of the test clients from the
+// interfaces in the client package.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const clientPath = "github.com/attestantio/go-eth2-client"
+
+// arrayTypes are the types in the interfaces that are fixed-size arrays, and so have a
+// composite zero value.
+var arrayTypes = map[string]bool{
+	"phase0.BLSPubKey":    true,
+	"phase0.BLSSignature": true,
+	"phase0.Domain":       true,
+	"phase0.DomainType":   true,
+	"phase0.Root":         true,
+	"phase0.Version":      true,
+	"time.Time":           true,
+}
+
+// param is a method parameter.
+type param struct {
+	name string
+	typ  string
+	// isFunc is true if the parameter is a function, and so cannot be recorded.
+	isFunc bool
+}
+
+// method is a method of a provider interface.
+type method struct {
+	iface   string
+	name    string
+	doc     []string
+	hasCtx  bool
+	params  []*param
+	results []string
+	zeros   []string
+	// hasErr is true if the final result is an error.
+	hasErr bool
+}
+
+// recordable returns true if the method can be recorded and replayed.
+func (m *method) recordable() bool {
+	for _, p := range m.params {
+		if p.isFunc {
+			return false
+		}
+	}
+
+	return true
+}
+
+// values returns the results of the method excluding any error.
+func (m *method) values() []string {
+	if m.hasErr {
+		return m.results[:len(m.results)-1]
+	}
+
+	return m.results
+}
+
+// generator generates the methods for a test client.
+type generator struct {
+	file     string
+	receiver string
+	method   func(buf *bytes.Buffer, m *method)
+}
+
+func main() {
+	source := flag.String("source", filepath.Join("..", "service.go"), "file containing the provider interfaces")
+	flag.Parse()
+
+	if err := generate(*source); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to generate: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// generate writes the methods for all test clients.
+func generate(source string) error {
+	methods, imports, err := parse(source)
+	if err != nil {
+		return err
+	}
+
+	generators := []*generator{
+		{file: "erroring_methods.go", receiver: "Erroring", method: erroringMethod},
+		{file: "recorder_methods.go", receiver: "Recorder", method: recorderMethod},
+		{file: "replayer_methods.go", receiver: "Replayer", method: replayerMethod},
+		{file: "sleepy_methods.go", receiver: "Sleepy", method: sleepyMethod},
+	}
+	for _, g := range generators {
+		src, err := g.generate(methods, imports)
+		if err != nil {
+			return fmt.Errorf("failed to generate %s: %w", g.file, err)
+		}
+		//nolint:gosec
+		if err := os.WriteFile(g.file, src, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parse obtains the provider methods, and the imports they use, from the source file.
+func parse(source string) ([]*method, map[string]string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, source, nil, parser.ParseComments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Obtain the imports, using a single name for each path.
+	names := make(map[string]string)
+	imports := make(map[string]string)
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, nil, err
+		}
+		name := filepath.Base(path)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		canonical := name
+		for existingName, existingPath := range imports {
+			if existingPath == path {
+				canonical = existingName
+			}
+		}
+		names[name] = canonical
+		imports[canonical] = path
+	}
+
+	// Obtain the local function types.
+	funcTypes := make(map[string]bool)
+	ast.Inspect(file, func(node ast.Node) bool {
+		if typeSpec, isTypeSpec := node.(*ast.TypeSpec); isTypeSpec {
+			if _, isFunc := typeSpec.Type.(*ast.FuncType); isFunc {
+				funcTypes[typeSpec.Name.Name] = true
+			}
+		}
+		return true
+	})
+
+	methods := make([]*method, 0)
+	seen := make(map[string]string)
+	for _, decl := range file.Decls {
+		genDecl, isGenDecl := decl.(*ast.GenDecl)
+		if !isGenDecl || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			ifaceType, isIface := typeSpec.Type.(*ast.InterfaceType)
+			if !isIface || typeSpec.Name.Name == "Service" {
+				continue
+			}
+			for _, field := range ifaceType.Methods.List {
+				if len(field.Names) == 0 {
+					// Embedded interface; its methods are generated from its own definition.
+					continue
+				}
+				m, err := newMethod(typeSpec.Name.Name, field, names, funcTypes)
+				if err != nil {
+					return nil, nil, err
+				}
+				if existing, exists := seen[m.name]; exists {
+					return nil, nil, fmt.Errorf("method %s defined in both %s and %s", m.name, existing, m.iface)
+				}
+				seen[m.name] = m.iface
+				methods = append(methods, m)
+			}
+		}
+	}
+
+	return methods, imports, nil
+}
+
+// newMethod creates a method from its interface definition.
+func newMethod(iface string, field *ast.Field, names map[string]string, funcTypes map[string]bool) (*method, error) {
+	funcType := field.Type.(*ast.FuncType)
+	m := &method{
+		iface: iface,
+		name:  field.Names[0].Name,
+	}
+	if field.Doc != nil {
+		for _, comment := range field.Doc.List {
+			m.doc = append(m.doc, comment.Text)
+		}
+	}
+
+	for i, field := range funcType.Params.List {
+		typ, err := typeString(field.Type, names)
+		if err != nil {
+			return nil, err
+		}
+		if typ == "context.Context" {
+			if i != 0 {
+				return nil, fmt.Errorf("method %s has context as a later parameter", m.name)
+			}
+			m.hasCtx = true
+			continue
+		}
+		_, isFunc := field.Type.(*ast.FuncType)
+		if ident, isIdent := field.Type.(*ast.Ident); isIdent && funcTypes[ident.Name] {
+			isFunc = true
+		}
+		fieldNames := field.Names
+		if len(fieldNames) == 0 {
+			fieldNames = []*ast.Ident{ast.NewIdent(fmt.Sprintf("arg%d", len(m.params)))}
+		}
+		for _, name := range fieldNames {
+			m.params = append(m.params, &param{name: name.Name, typ: typ, isFunc: isFunc})
+		}
+	}
+
+	if funcType.Results != nil {
+		for _, field := range funcType.Results.List {
+			typ, err := typeString(field.Type, names)
+			if err != nil {
+				return nil, err
+			}
+			count := len(field.Names)
+			if count == 0 {
+				count = 1
+			}
+			for i := 0; i < count; i++ {
+				m.results = append(m.results, typ)
+				m.zeros = append(m.zeros, zeroValue(field.Type, typ))
+			}
+		}
+	}
+	m.hasErr = len(m.results) > 0 && m.results[len(m.results)-1] == "error"
+
+	return m, nil
+}
+
+// typeString returns the string representation of a type as used in the test clients.
+func typeString(expr ast.Expr, names map[string]string) (string, error) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			// Local type of the client package.
+			return fmt.Sprintf("consensusclient.%s", t.Name), nil
+		}
+		return t.Name, nil
+	case *ast.SelectorExpr:
+		pkg, isIdent := t.X.(*ast.Ident)
+		if !isIdent {
+			return "", fmt.Errorf("unsupported selector %v", t.X)
+		}
+		name, exists := names[pkg.Name]
+		if !exists {
+			return "", fmt.Errorf("unknown package %s", pkg.Name)
+		}
+		return fmt.Sprintf("%s.%s", name, t.Sel.Name), nil
+	case *ast.StarExpr:
+		elem, err := typeString(t.X, names)
+		return "*" + elem, err
+	case *ast.ArrayType:
+		elem, err := typeString(t.Elt, names)
+		if err != nil {
+			return "", err
+		}
+		if t.Len == nil {
+			return "[]" + elem, nil
+		}
+		lit, isLit := t.Len.(*ast.BasicLit)
+		if !isLit {
+			return "", fmt.Errorf("unsupported array length %v", t.Len)
+		}
+		return fmt.Sprintf("[%s]%s", lit.Value, elem), nil
+	case *ast.MapType:
+		key, err := typeString(t.Key, names)
+		if err != nil {
+			return "", err
+		}
+		value, err := typeString(t.Value, names)
+		return fmt.Sprintf("map[%s]%s", key, value), err
+	case *ast.IndexExpr:
+		base, err := typeString(t.X, names)
+		if err != nil {
+			return "", err
+		}
+		index, err := typeString(t.Index, names)
+		return fmt.Sprintf("%s[%s]", base, index), err
+	case *ast.InterfaceType:
+		if t.Methods != nil && len(t.Methods.List) > 0 {
+			return "", errors.New("non-empty interface types are not supported")
+		}
+		return "interface{}", nil
+	case *ast.Ellipsis:
+		elem, err := typeString(t.Elt, names)
+		return "..." + elem, err
+	default:
+		return "", fmt.Errorf("unsupported type %T", expr)
+	}
+}
+
+// zeroValue returns the zero value of a type.
+func zeroValue(expr ast.Expr, typ string) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		switch t.Name {
+		case "string":
+			return `""`
+		case "bool":
+			return "false"
+		case "error":
+			return "nil"
+		}
+		if ast.IsExported(t.Name) {
+			// Local types are function types.
+			return "nil"
+		}
+		return "0"
+	case *ast.SelectorExpr:
+		if arrayTypes[typ] {
+			return typ + "{}"
+		}
+		return "0"
+	default:
+		return "nil"
+	}
+}
+
+// generate generates the source for the methods.
+func (g *generator) generate(methods []*method, imports map[string]string) ([]byte, error) {
+	body := new(bytes.Buffer)
+	for _, m := range methods {
+		body.WriteString("\n")
+		g.method(body, m)
+	}
+
+	// Only import the packages used by the methods.
+	used := []string{"consensusclient"}
+	for name := range imports {
+		if bytes.Contains(body.Bytes(), []byte(name+".")) {
+			used = append(used, name)
+		}
+	}
+	for _, name := range []string{"errors", "fmt"} {
+		if bytes.Contains(body.Bytes(), []byte(name+".")) {
+			used = append(used, name)
+			imports[name] = name
+		}
+	}
+	imports["consensusclient"] = clientPath
+	sort.Strings(used)
+	std := make([]string, 0, len(used))
+	external := make([]string, 0, len(used))
+	for _, name := range used {
+		if strings.Contains(imports[name], ".") {
+			external = append(external, name)
+		} else {
+			std = append(std, name)
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	buf.WriteString("// Code generated by gentestclients. DO NOT EDIT.\n\n")
+	buf.WriteString("package testclients\n\n")
+	buf.WriteString("import (\n")
+	for i, group := range [][]string{std, external} {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		for _, name := range group {
+			path := imports[name]
+			if filepath.Base(path) == name {
+				fmt.Fprintf(buf, "\t%q\n", path)
+			} else {
+				fmt.Fprintf(buf, "\t%s %q\n", name, path)
+			}
+		}
+	}
+	buf.WriteString(")\n")
+	buf.Write(body.Bytes())
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%w\n%s", err, buf.String())
+	}
+
+	return src, nil
+}
+
+// signature writes the documentation and signature of the method.
+func signature(buf *bytes.Buffer, receiver string, ctxName string, m *method) {
+	for _, line := range m.doc {
+		fmt.Fprintf(buf, "%s\n", line)
+	}
+	params := make([]string, 0, len(m.params)+1)
+	if m.hasCtx {
+		params = append(params, ctxName+" context.Context")
+	}
+	for _, p := range m.params {
+		params = append(params, fmt.Sprintf("%s %s", p.name, p.typ))
+	}
+	results := strings.Join(m.results, ", ")
+	if len(m.results) > 1 {
+		results = "(" + results + ")"
+	}
+	fmt.Fprintf(buf, "func (s *%s) %s(%s) %s {\n", receiver, m.name, strings.Join(params, ", "), results)
+}
+
+// args returns the arguments with which to call the method.
+func args(m *method) string {
+	res := make([]string, 0, len(m.params)+1)
+	if m.hasCtx {
+		res = append(res, "ctx")
+	}
+	for _, p := range m.params {
+		if strings.HasPrefix(p.typ, "...") {
+			res = append(res, p.name+"...")
+		} else {
+			res = append(res, p.name)
+		}
+	}
+
+	return strings.Join(res, ", ")
+}
+
+// recordedArgs returns the arguments of the method as recorded.
+func recordedArgs(m *method) string {
+	res := make([]string, 0, len(m.params))
+	for _, p := range m.params {
+		res = append(res, p.name)
+	}
+
+	return fmt.Sprintf("[]interface{}{%s}", strings.Join(res, ", "))
+}
+
+// unsupported writes the return for a next client that does not support the method.
+func unsupported(buf *bytes.Buffer, m *method) {
+	unsupportedWithError(buf, m, `fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())`)
+}
+
+// unsupportedWithError writes the return for a next client that does not support the
+// method, with the given error.
+func unsupportedWithError(buf *bytes.Buffer, m *method, err string) {
+	fmt.Fprintf(buf, "\tnext, isNext := s.next.(consensusclient.%s)\n", m.iface)
+	buf.WriteString("\tif !isNext {\n")
+	rets := make([]string, 0, len(m.results))
+	rets = append(rets, m.zeros...)
+	if m.hasErr {
+		rets[len(rets)-1] = err
+	}
+	if len(rets) > 0 {
+		fmt.Fprintf(buf, "\t\treturn %s\n", strings.Join(rets, ", "))
+	} else {
+		buf.WriteString("\t\treturn\n")
+	}
+	buf.WriteString("\t}\n")
+}
+
+// recorderMethod writes a recorder method.
+func recorderMethod(buf *bytes.Buffer, m *method) {
+	signature(buf, "Recorder", "ctx", m)
+	unsupported(buf, m)
+
+	if !m.recordable() {
+		fmt.Fprintf(buf, "\treturn next.%s(%s)\n}\n", m.name, args(m))
+		return
+	}
+
+	values := make([]string, 0, len(m.results))
+	for i := range m.values() {
+		values = append(values, fmt.Sprintf("res%d", i))
+	}
+	rets := append([]string{}, values...)
+	errVar := "nil"
+	if m.hasErr {
+		rets = append(rets, "err")
+		errVar = "err"
+	}
+	fmt.Fprintf(buf, "\t%s := next.%s(%s)\n", strings.Join(rets, ", "), m.name, args(m))
+	fmt.Fprintf(buf, "\ts.record(%q, %s, []interface{}{%s}, %s)\n", m.name, recordedArgs(m), strings.Join(values, ", "), errVar)
+	fmt.Fprintf(buf, "\n\treturn %s\n}\n", strings.Join(rets, ", "))
+}
+
+// replayerMethod writes a replayer method.
+func replayerMethod(buf *bytes.Buffer, m *method) {
+	signature(buf, "Replayer", "_", m)
+
+	if !m.recordable() {
+		rets := append([]string{}, m.zeros...)
+		rets[len(rets)-1] = `errors.New("this call cannot be replayed")`
+		fmt.Fprintf(buf, "\treturn %s\n}\n", strings.Join(rets, ", "))
+		return
+	}
+
+	values := make([]string, 0, len(m.results))
+	targets := make([]string, 0, len(m.results))
+	for i, typ := range m.values() {
+		fmt.Fprintf(buf, "\tvar res%d %s\n", i, typ)
+		values = append(values, fmt.Sprintf("res%d", i))
+		targets = append(targets, fmt.Sprintf("&res%d", i))
+	}
+	replay := fmt.Sprintf("s.replay(%q, %s, []interface{}{%s})", m.name, recordedArgs(m), strings.Join(targets, ", "))
+	if m.hasErr {
+		fmt.Fprintf(buf, "\tif err := %s; err != nil {\n", replay)
+		fmt.Fprintf(buf, "\t\treturn %s\n\t}\n", strings.Join(append(append([]string{}, m.zeros[:len(m.zeros)-1]...), "err"), ", "))
+		fmt.Fprintf(buf, "\n\treturn %s\n}\n", strings.Join(append(values, "nil"), ", "))
+		return
+	}
+	// Without an error result a missing recording returns the zero values.
+	fmt.Fprintf(buf, "\t_ = %s\n", replay)
+	fmt.Fprintf(buf, "\n\treturn %s\n}\n", strings.Join(values, ", "))
+}
+
+// erroringMethod writes an erroring method.
+func erroringMethod(buf *bytes.Buffer, m *method) {
+	signature(buf, "Erroring", "ctx", m)
+	if m.hasCtx && m.hasErr {
+		buf.WriteString("\tif err := s.maybeError(ctx); err != nil {\n")
+		fmt.Fprintf(buf, "\t\treturn %s\n\t}\n", strings.Join(append(append([]string{}, m.zeros[:len(m.zeros)-1]...), "err"), ", "))
+	}
+	unsupported(buf, m)
+	fmt.Fprintf(buf, "\treturn next.%s(%s)\n}\n", m.name, args(m))
+}
+
+// sleepyMethod writes a sleepy method.
+func sleepyMethod(buf *bytes.Buffer, m *method) {
+	signature(buf, "Sleepy", "ctx", m)
+	if m.hasCtx {
+		buf.WriteString("\ts.sleep(ctx)\n")
+	}
+	unsupportedWithError(buf, m, `errors.New("next does not support this call")`)
+	fmt.Fprintf(buf, "\treturn next.%s(%s)\n}\n", m.name, args(m))
+}
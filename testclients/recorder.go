@@ -0,0 +1,131 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testclients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+)
+
+// Recorder is an Ethereum 2 client that records the calls made to it, and their results,
+// for later use by a replayer.
+type Recorder struct {
+	next consensusclient.Service
+
+	mu        sync.Mutex
+	calls     []*FixtureCall
+	recordErr error
+}
+
+// NewRecorder creates a new Ethereum 2 client that records calls made to it.
+func NewRecorder(_ context.Context,
+	next consensusclient.Service,
+) (*Recorder, error) {
+	if next == nil {
+		return nil, errors.New("no next service supplied")
+	}
+
+	return &Recorder{
+		next:  next,
+		calls: make([]*FixtureCall, 0),
+	}, nil
+}
+
+// Name returns the name of the client implementation.
+func (s *Recorder) Name() string {
+	nextName := s.next.Name()
+	return fmt.Sprintf("recorder(%s)", nextName)
+}
+
+// Address returns the address of the client.
+func (s *Recorder) Address() string {
+	nextAddress := s.next.Address()
+	return fmt.Sprintf("recorder:%s", nextAddress)
+}
+
+// Fixture returns the calls recorded so far.
+// An error is returned if any call could not be recorded.
+func (s *Recorder) Fixture() (*Fixture, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.recordErr != nil {
+		return nil, s.recordErr
+	}
+
+	calls := make([]*FixtureCall, len(s.calls))
+	copy(calls, s.calls)
+
+	return &Fixture{
+		Name:    s.next.Name(),
+		Address: s.next.Address(),
+		Calls:   calls,
+	}, nil
+}
+
+// record records a call.
+func (s *Recorder) record(method string, args []interface{}, results []interface{}, err error) {
+	call, recordErr := newFixtureCall(method, args, results, err)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if recordErr != nil {
+		if s.recordErr == nil {
+			s.recordErr = errors.Wrapf(recordErr, "failed to record call to %s", method)
+		}
+		return
+	}
+	s.calls = append(s.calls, call)
+}
+
+// newFixtureCall creates a fixture call.
+func newFixtureCall(method string, args []interface{}, results []interface{}, err error) (*FixtureCall, error) {
+	encodedArgs := make([]json.RawMessage, len(args))
+	for i := range args {
+		encodedArg, encodeErr := encodeValue(args[i])
+		if encodeErr != nil {
+			return nil, errors.Wrapf(encodeErr, "failed to encode argument %d", i)
+		}
+		encodedArgs[i] = encodedArg
+	}
+	argsData, encodeErr := json.Marshal(encodedArgs)
+	if encodeErr != nil {
+		return nil, errors.Wrap(encodeErr, "failed to encode arguments")
+	}
+
+	call := &FixtureCall{
+		Method: method,
+		Args:   argsData,
+	}
+	if err != nil {
+		call.Error = err.Error()
+		return call, nil
+	}
+
+	call.Results = make([]json.RawMessage, len(results))
+	for i := range results {
+		encodedResult, encodeErr := encodeValue(results[i])
+		if encodeErr != nil {
+			return nil, errors.Wrapf(encodeErr, "failed to encode result %d", i)
+		}
+		call.Results[i] = encodedResult
+	}
+
+	return call, nil
+}
@@ -0,0 +1,1168 @@
+// Code generated by gentestclients. DO NOT EDIT.
+
+package testclients
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// EpochFromStateID converts a state ID to its epoch.
+func (s *Recorder) EpochFromStateID(ctx context.Context, stateID string) (phase0.Epoch, error) {
+	next, isNext := s.next.(consensusclient.EpochFromStateIDProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.EpochFromStateID(ctx, stateID)
+	s.record("EpochFromStateID", []interface{}{stateID}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// SlotFromStateID converts a state ID to its slot.
+func (s *Recorder) SlotFromStateID(ctx context.Context, stateID string) (phase0.Slot, error) {
+	next, isNext := s.next.(consensusclient.SlotFromStateIDProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.SlotFromStateID(ctx, stateID)
+	s.record("SlotFromStateID", []interface{}{stateID}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// NodeVersion returns a free-text string with the node version.
+func (s *Recorder) NodeVersion(ctx context.Context) (string, error) {
+	next, isNext := s.next.(consensusclient.NodeVersionProvider)
+	if !isNext {
+		return "", fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.NodeVersion(ctx)
+	s.record("NodeVersion", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// SlotDuration provides the duration of a slot of the chain.
+func (s *Recorder) SlotDuration(ctx context.Context) (time.Duration, error) {
+	next, isNext := s.next.(consensusclient.SlotDurationProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.SlotDuration(ctx)
+	s.record("SlotDuration", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// SlotsPerEpoch provides the slots per epoch of the chain.
+func (s *Recorder) SlotsPerEpoch(ctx context.Context) (uint64, error) {
+	next, isNext := s.next.(consensusclient.SlotsPerEpochProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.SlotsPerEpoch(ctx)
+	s.record("SlotsPerEpoch", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// FarFutureEpoch provides the far future epoch of the chain.
+func (s *Recorder) FarFutureEpoch(ctx context.Context) (phase0.Epoch, error) {
+	next, isNext := s.next.(consensusclient.FarFutureEpochProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.FarFutureEpoch(ctx)
+	s.record("FarFutureEpoch", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// GenesisValidatorsRoot provides the genesis validators root of the chain.
+func (s *Recorder) GenesisValidatorsRoot(ctx context.Context) ([]byte, error) {
+	next, isNext := s.next.(consensusclient.GenesisValidatorsRootProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.GenesisValidatorsRoot(ctx)
+	s.record("GenesisValidatorsRoot", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// TargetAggregatorsPerCommittee provides the target number of aggregators for each attestation committee.
+func (s *Recorder) TargetAggregatorsPerCommittee(ctx context.Context) (uint64, error) {
+	next, isNext := s.next.(consensusclient.TargetAggregatorsPerCommitteeProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.TargetAggregatorsPerCommittee(ctx)
+	s.record("TargetAggregatorsPerCommittee", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// Index provides the index of the validator.
+func (s *Recorder) Index(ctx context.Context) (phase0.ValidatorIndex, error) {
+	next, isNext := s.next.(consensusclient.ValidatorIndexProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.Index(ctx)
+	s.record("Index", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// PubKey provides the public key of the validator.
+func (s *Recorder) PubKey(ctx context.Context) (phase0.BLSPubKey, error) {
+	next, isNext := s.next.(consensusclient.ValidatorPubKeyProvider)
+	if !isNext {
+		return phase0.BLSPubKey{}, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.PubKey(ctx)
+	s.record("PubKey", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// DepositContract provides details of the Ethereum 1 deposit contract for the chain.
+func (s *Recorder) DepositContract(ctx context.Context) (*apiv1.DepositContract, error) {
+	next, isNext := s.next.(consensusclient.DepositContractProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.DepositContract(ctx)
+	s.record("DepositContract", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// SignedBeaconBlock fetches a signed beacon block given a block ID.
+func (s *Recorder) SignedBeaconBlock(ctx context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error) {
+	next, isNext := s.next.(consensusclient.SignedBeaconBlockProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.SignedBeaconBlock(ctx, blockID)
+	s.record("SignedBeaconBlock", []interface{}{blockID}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// SignedBeaconBlockWithMeta fetches a signed beacon block and its metadata given a block ID.
+func (s *Recorder) SignedBeaconBlockWithMeta(ctx context.Context, blockID string) (*api.Response[*spec.VersionedSignedBeaconBlock], error) {
+	next, isNext := s.next.(consensusclient.SignedBeaconBlockWithMetaProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.SignedBeaconBlockWithMeta(ctx, blockID)
+	s.record("SignedBeaconBlockWithMeta", []interface{}{blockID}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// BeaconBlockBlobs fetches the blobs given a block ID.
+func (s *Recorder) BeaconBlockBlobs(ctx context.Context, blockID string) ([]*deneb.BlobSidecar, error) {
+	next, isNext := s.next.(consensusclient.BeaconBlockBlobsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.BeaconBlockBlobs(ctx, blockID)
+	s.record("BeaconBlockBlobs", []interface{}{blockID}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// BlobSidecars fetches the blob sidecars given a block ID, along with their metadata.
+// If indices is empty all blob sidecars for the block are returned, otherwise only those
+// with the given indices.
+func (s *Recorder) BlobSidecars(ctx context.Context, blockID string, indices []deneb.BlobIndex) (*api.Response[*api.VersionedBlobSidecars], error) {
+	next, isNext := s.next.(consensusclient.BlobSidecarsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.BlobSidecars(ctx, blockID, indices)
+	s.record("BlobSidecars", []interface{}{blockID, indices}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// BeaconCommittees fetches all beacon committees for the epoch at the given state.
+func (s *Recorder) BeaconCommittees(ctx context.Context, stateID string) ([]*apiv1.BeaconCommittee, error) {
+	next, isNext := s.next.(consensusclient.BeaconCommitteesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.BeaconCommittees(ctx, stateID)
+	s.record("BeaconCommittees", []interface{}{stateID}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// BeaconCommitteesAtEpoch fetches all beacon committees for the given epoch at the given state.
+func (s *Recorder) BeaconCommitteesAtEpoch(ctx context.Context, stateID string, epoch phase0.Epoch) ([]*apiv1.BeaconCommittee, error) {
+	next, isNext := s.next.(consensusclient.BeaconCommitteesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.BeaconCommitteesAtEpoch(ctx, stateID, epoch)
+	s.record("BeaconCommitteesAtEpoch", []interface{}{stateID, epoch}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// SyncCommittee fetches the sync committee for the given state.
+func (s *Recorder) SyncCommittee(ctx context.Context, stateID string) (*apiv1.SyncCommittee, error) {
+	next, isNext := s.next.(consensusclient.SyncCommitteesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.SyncCommittee(ctx, stateID)
+	s.record("SyncCommittee", []interface{}{stateID}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// SyncCommitteeAtEpoch fetches the sync committee for the given epoch at the given state.
+func (s *Recorder) SyncCommitteeAtEpoch(ctx context.Context, stateID string, epoch phase0.Epoch) (*apiv1.SyncCommittee, error) {
+	next, isNext := s.next.(consensusclient.SyncCommitteesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.SyncCommitteeAtEpoch(ctx, stateID, epoch)
+	s.record("SyncCommitteeAtEpoch", []interface{}{stateID, epoch}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// AggregateAttestation fetches the aggregate attestation given an attestation.
+func (s *Recorder) AggregateAttestation(ctx context.Context, slot phase0.Slot, attestationDataRoot phase0.Root) (*phase0.Attestation, error) {
+	next, isNext := s.next.(consensusclient.AggregateAttestationProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.AggregateAttestation(ctx, slot, attestationDataRoot)
+	s.record("AggregateAttestation", []interface{}{slot, attestationDataRoot}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// SubmitAggregateAttestations submits aggregate attestations.
+func (s *Recorder) SubmitAggregateAttestations(ctx context.Context, aggregateAndProofs []*phase0.SignedAggregateAndProof) error {
+	next, isNext := s.next.(consensusclient.AggregateAttestationsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	err := next.SubmitAggregateAttestations(ctx, aggregateAndProofs)
+	s.record("SubmitAggregateAttestations", []interface{}{aggregateAndProofs}, []interface{}{}, err)
+
+	return err
+}
+
+// AggregateAttestationV2 fetches the aggregate attestation for the given attestation data root and committee.
+func (s *Recorder) AggregateAttestationV2(ctx context.Context, slot phase0.Slot, attestationDataRoot phase0.Root, committeeIndex phase0.CommitteeIndex) (*spec.VersionedAttestation, error) {
+	next, isNext := s.next.(consensusclient.AggregateAttestationProviderV2)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.AggregateAttestationV2(ctx, slot, attestationDataRoot, committeeIndex)
+	s.record("AggregateAttestationV2", []interface{}{slot, attestationDataRoot, committeeIndex}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// SubmitAggregateAttestationsV2 submits versioned aggregate attestations.
+func (s *Recorder) SubmitAggregateAttestationsV2(ctx context.Context, aggregateAndProofs []*spec.VersionedSignedAggregateAndProof) error {
+	next, isNext := s.next.(consensusclient.AggregateAttestationsSubmitterV2)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	err := next.SubmitAggregateAttestationsV2(ctx, aggregateAndProofs)
+	s.record("SubmitAggregateAttestationsV2", []interface{}{aggregateAndProofs}, []interface{}{}, err)
+
+	return err
+}
+
+// AttestationData fetches the attestation data for the given slot and committee index.
+func (s *Recorder) AttestationData(ctx context.Context, slot phase0.Slot, committeeIndex phase0.CommitteeIndex) (*phase0.AttestationData, error) {
+	next, isNext := s.next.(consensusclient.AttestationDataProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.AttestationData(ctx, slot, committeeIndex)
+	s.record("AttestationData", []interface{}{slot, committeeIndex}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// AttestationPool fetches the attestation pool for the given slot.
+func (s *Recorder) AttestationPool(ctx context.Context, slot phase0.Slot) ([]*phase0.Attestation, error) {
+	next, isNext := s.next.(consensusclient.AttestationPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.AttestationPool(ctx, slot)
+	s.record("AttestationPool", []interface{}{slot}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// SubmitAttestations submits attestations.
+func (s *Recorder) SubmitAttestations(ctx context.Context, attestations []*phase0.Attestation) error {
+	next, isNext := s.next.(consensusclient.AttestationsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	err := next.SubmitAttestations(ctx, attestations)
+	s.record("SubmitAttestations", []interface{}{attestations}, []interface{}{}, err)
+
+	return err
+}
+
+// SubmitAttestationsV2 submits attestations, with the consensus version selected from the
+// chain fork at their slot.
+func (s *Recorder) SubmitAttestationsV2(ctx context.Context, attestations []*phase0.Attestation) error {
+	next, isNext := s.next.(consensusclient.AttestationsSubmitterV2)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	err := next.SubmitAttestationsV2(ctx, attestations)
+	s.record("SubmitAttestationsV2", []interface{}{attestations}, []interface{}{}, err)
+
+	return err
+}
+
+// SubmitAttesterSlashingV2 submits an attester slashing, with the consensus version selected
+// from the chain fork at the slot of its first attestation.
+func (s *Recorder) SubmitAttesterSlashingV2(ctx context.Context, slashing *phase0.AttesterSlashing) error {
+	next, isNext := s.next.(consensusclient.AttesterSlashingSubmitterV2)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	err := next.SubmitAttesterSlashingV2(ctx, slashing)
+	s.record("SubmitAttesterSlashingV2", []interface{}{slashing}, []interface{}{}, err)
+
+	return err
+}
+
+// AttesterDuties obtains attester duties.
+// If validatorIndicess is nil it will return all duties for the given epoch.
+func (s *Recorder) AttesterDuties(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.AttesterDuty, error) {
+	next, isNext := s.next.(consensusclient.AttesterDutiesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.AttesterDuties(ctx, epoch, validatorIndices)
+	s.record("AttesterDuties", []interface{}{epoch, validatorIndices}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// AttesterDutiesWithMeta obtains attester duties and their metadata.
+// The metadata contains the dependent root of the duties, which can be checked against
+// later head events to find out if the duties are still valid.
+func (s *Recorder) AttesterDutiesWithMeta(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) (*api.Response[[]*apiv1.AttesterDuty], error) {
+	next, isNext := s.next.(consensusclient.AttesterDutiesWithMetaProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.AttesterDutiesWithMeta(ctx, epoch, validatorIndices)
+	s.record("AttesterDutiesWithMeta", []interface{}{epoch, validatorIndices}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// SyncCommitteeDuties obtains sync committee duties.
+// If validatorIndicess is nil it will return all duties for the given epoch.
+func (s *Recorder) SyncCommitteeDuties(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.SyncCommitteeDuty, error) {
+	next, isNext := s.next.(consensusclient.SyncCommitteeDutiesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.SyncCommitteeDuties(ctx, epoch, validatorIndices)
+	s.record("SyncCommitteeDuties", []interface{}{epoch, validatorIndices}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// SubmitSyncCommitteeMessages submits sync committee messages.
+func (s *Recorder) SubmitSyncCommitteeMessages(ctx context.Context, messages []*altair.SyncCommitteeMessage) error {
+	next, isNext := s.next.(consensusclient.SyncCommitteeMessagesSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	err := next.SubmitSyncCommitteeMessages(ctx, messages)
+	s.record("SubmitSyncCommitteeMessages", []interface{}{messages}, []interface{}{}, err)
+
+	return err
+}
+
+// SubmitSyncCommitteeSubscriptions subscribes to sync committees.
+func (s *Recorder) SubmitSyncCommitteeSubscriptions(ctx context.Context, subscriptions []*apiv1.SyncCommitteeSubscription) error {
+	next, isNext := s.next.(consensusclient.SyncCommitteeSubscriptionsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	err := next.SubmitSyncCommitteeSubscriptions(ctx, subscriptions)
+	s.record("SubmitSyncCommitteeSubscriptions", []interface{}{subscriptions}, []interface{}{}, err)
+
+	return err
+}
+
+// SyncCommitteeContribution provides a sync committee contribution.
+func (s *Recorder) SyncCommitteeContribution(ctx context.Context, slot phase0.Slot, subcommitteeIndex uint64, beaconBlockRoot phase0.Root) (*altair.SyncCommitteeContribution, error) {
+	next, isNext := s.next.(consensusclient.SyncCommitteeContributionProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.SyncCommitteeContribution(ctx, slot, subcommitteeIndex, beaconBlockRoot)
+	s.record("SyncCommitteeContribution", []interface{}{slot, subcommitteeIndex, beaconBlockRoot}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// SubmitSyncCommitteeContributions submits sync committee contributions.
+func (s *Recorder) SubmitSyncCommitteeContributions(ctx context.Context, contributionAndProofs []*altair.SignedContributionAndProof) error {
+	next, isNext := s.next.(consensusclient.SyncCommitteeContributionsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	err := next.SubmitSyncCommitteeContributions(ctx, contributionAndProofs)
+	s.record("SubmitSyncCommitteeContributions", []interface{}{contributionAndProofs}, []interface{}{}, err)
+
+	return err
+}
+
+// SubmitBLSToExecutionChanges submits BLS to execution address change operations.
+func (s *Recorder) SubmitBLSToExecutionChanges(ctx context.Context, blsToExecutionChanges []*capella.SignedBLSToExecutionChange) error {
+	next, isNext := s.next.(consensusclient.BLSToExecutionChangesSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	err := next.SubmitBLSToExecutionChanges(ctx, blsToExecutionChanges)
+	s.record("SubmitBLSToExecutionChanges", []interface{}{blsToExecutionChanges}, []interface{}{}, err)
+
+	return err
+}
+
+// BeaconBlockHeader provides the block header of a given block ID.
+func (s *Recorder) BeaconBlockHeader(ctx context.Context, blockID string) (*apiv1.BeaconBlockHeader, error) {
+	next, isNext := s.next.(consensusclient.BeaconBlockHeadersProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.BeaconBlockHeader(ctx, blockID)
+	s.record("BeaconBlockHeader", []interface{}{blockID}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// BeaconBlockHeaders provides the block headers matching the given filters.
+// If slot is supplied only headers for that slot are returned; if parentRoot is supplied only
+// headers of the children of that block are returned.  If neither is supplied the header of the
+// canonical head is returned.
+func (s *Recorder) BeaconBlockHeaders(ctx context.Context, slot *phase0.Slot, parentRoot *phase0.Root) ([]*apiv1.BeaconBlockHeader, error) {
+	next, isNext := s.next.(consensusclient.BeaconBlockHeadersByFilterProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.BeaconBlockHeaders(ctx, slot, parentRoot)
+	s.record("BeaconBlockHeaders", []interface{}{slot, parentRoot}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// BeaconBlockHeaderWithMeta provides the block header and its metadata of a given block ID.
+func (s *Recorder) BeaconBlockHeaderWithMeta(ctx context.Context, blockID string) (*api.Response[*apiv1.BeaconBlockHeader], error) {
+	next, isNext := s.next.(consensusclient.BeaconBlockHeadersWithMetaProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.BeaconBlockHeaderWithMeta(ctx, blockID)
+	s.record("BeaconBlockHeaderWithMeta", []interface{}{blockID}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// BeaconBlockHeadersBySlotRange provides the canonical block headers for the slots from startSlot to endSlot inclusive.
+// The result contains an entry for every slot in the range, in slot order; slots without a block are marked as missed.
+func (s *Recorder) BeaconBlockHeadersBySlotRange(ctx context.Context, startSlot phase0.Slot, endSlot phase0.Slot) ([]*apiv1.SlotBeaconBlockHeader, error) {
+	next, isNext := s.next.(consensusclient.BeaconBlockHeadersBySlotRangeProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.BeaconBlockHeadersBySlotRange(ctx, startSlot, endSlot)
+	s.record("BeaconBlockHeadersBySlotRange", []interface{}{startSlot, endSlot}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// BeaconBlockProposal fetches a proposed beacon block for signing.
+func (s *Recorder) BeaconBlockProposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*spec.VersionedBeaconBlock, error) {
+	next, isNext := s.next.(consensusclient.BeaconBlockProposalProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.BeaconBlockProposal(ctx, slot, randaoReveal, graffiti)
+	s.record("BeaconBlockProposal", []interface{}{slot, randaoReveal, graffiti}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// BeaconBlockRoot fetches a block's root given a block ID.
+func (s *Recorder) BeaconBlockRoot(ctx context.Context, blockID string) (*phase0.Root, error) {
+	next, isNext := s.next.(consensusclient.BeaconBlockRootProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.BeaconBlockRoot(ctx, blockID)
+	s.record("BeaconBlockRoot", []interface{}{blockID}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// SubmitBeaconBlock submits a beacon block.
+func (s *Recorder) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSignedBeaconBlock) error {
+	next, isNext := s.next.(consensusclient.BeaconBlockSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	err := next.SubmitBeaconBlock(ctx, block)
+	s.record("SubmitBeaconBlock", []interface{}{block}, []interface{}{}, err)
+
+	return err
+}
+
+// SubmitBeaconBlockV2 submits a beacon block, with the beacon node carrying out the given
+// level of validation before broadcasting it.
+func (s *Recorder) SubmitBeaconBlockV2(ctx context.Context, block *spec.VersionedSignedBeaconBlock, broadcastValidation apiv1.BroadcastValidation) error {
+	next, isNext := s.next.(consensusclient.BeaconBlockSubmitterV2)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	err := next.SubmitBeaconBlockV2(ctx, block, broadcastValidation)
+	s.record("SubmitBeaconBlockV2", []interface{}{block, broadcastValidation}, []interface{}{}, err)
+
+	return err
+}
+
+// SubmitBlockContents submits a block along with its blob sidecars, with the beacon node carrying
+// out the given level of validation before broadcasting them.
+func (s *Recorder) SubmitBlockContents(ctx context.Context, contents *apiv1deneb.SignedBlockContents, broadcastValidation apiv1.BroadcastValidation) error {
+	next, isNext := s.next.(consensusclient.BlockContentsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	err := next.SubmitBlockContents(ctx, contents, broadcastValidation)
+	s.record("SubmitBlockContents", []interface{}{contents, broadcastValidation}, []interface{}{}, err)
+
+	return err
+}
+
+// SubmitBeaconCommitteeSubscriptions subscribes to beacon committees.
+func (s *Recorder) SubmitBeaconCommitteeSubscriptions(ctx context.Context, subscriptions []*apiv1.BeaconCommitteeSubscription) error {
+	next, isNext := s.next.(consensusclient.BeaconCommitteeSubscriptionsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	err := next.SubmitBeaconCommitteeSubscriptions(ctx, subscriptions)
+	s.record("SubmitBeaconCommitteeSubscriptions", []interface{}{subscriptions}, []interface{}{}, err)
+
+	return err
+}
+
+// BeaconState fetches a beacon state given a state ID.
+func (s *Recorder) BeaconState(ctx context.Context, stateID string) (*spec.VersionedBeaconState, error) {
+	next, isNext := s.next.(consensusclient.BeaconStateProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.BeaconState(ctx, stateID)
+	s.record("BeaconState", []interface{}{stateID}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// BeaconStateRandao fetches a beacon state RANDAO given a state ID.
+func (s *Recorder) BeaconStateRandao(ctx context.Context, stateID string) (*phase0.Root, error) {
+	next, isNext := s.next.(consensusclient.BeaconStateRandaoProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.BeaconStateRandao(ctx, stateID)
+	s.record("BeaconStateRandao", []interface{}{stateID}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// BeaconStateRoot fetches a beacon state root given a state ID.
+func (s *Recorder) BeaconStateRoot(ctx context.Context, stateID string) (*phase0.Root, error) {
+	next, isNext := s.next.(consensusclient.BeaconStateRootProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.BeaconStateRoot(ctx, stateID)
+	s.record("BeaconStateRoot", []interface{}{stateID}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// StateRoot fetches a beacon state root and its metadata given a state ID.
+func (s *Recorder) StateRoot(ctx context.Context, stateID string) (*api.Response[*phase0.Root], error) {
+	next, isNext := s.next.(consensusclient.StateRootProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.StateRoot(ctx, stateID)
+	s.record("StateRoot", []interface{}{stateID}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// HistoricalSummaries fetches the historical summaries and their metadata given a state ID.
+func (s *Recorder) HistoricalSummaries(ctx context.Context, stateID string) (*api.Response[[]*capella.HistoricalSummary], error) {
+	next, isNext := s.next.(consensusclient.HistoricalSummariesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.HistoricalSummaries(ctx, stateID)
+	s.record("HistoricalSummaries", []interface{}{stateID}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// BlindedBeaconBlockProposal fetches a blinded proposed beacon block for signing.
+func (s *Recorder) BlindedBeaconBlockProposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*api.VersionedBlindedBeaconBlock, error) {
+	next, isNext := s.next.(consensusclient.BlindedBeaconBlockProposalProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.BlindedBeaconBlockProposal(ctx, slot, randaoReveal, graffiti)
+	s.record("BlindedBeaconBlockProposal", []interface{}{slot, randaoReveal, graffiti}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// SubmitBlindedBeaconBlock submits a beacon block.
+func (s *Recorder) SubmitBlindedBeaconBlock(ctx context.Context, block *api.VersionedSignedBlindedBeaconBlock) error {
+	next, isNext := s.next.(consensusclient.BlindedBeaconBlockSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	err := next.SubmitBlindedBeaconBlock(ctx, block)
+	s.record("SubmitBlindedBeaconBlock", []interface{}{block}, []interface{}{}, err)
+
+	return err
+}
+
+// SubmitBlindedBeaconBlockV2 submits a blinded beacon block, with the beacon node carrying out the
+// given level of validation before broadcasting it.
+// If the beacon node reveals the execution payload of the block it is returned, otherwise the
+// returned payload is nil.
+func (s *Recorder) SubmitBlindedBeaconBlockV2(ctx context.Context, block *api.VersionedSignedBlindedBeaconBlock, broadcastValidation apiv1.BroadcastValidation) (*api.VersionedExecutionPayload, error) {
+	next, isNext := s.next.(consensusclient.BlindedBeaconBlockSubmitterV2)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.SubmitBlindedBeaconBlockV2(ctx, block, broadcastValidation)
+	s.record("SubmitBlindedBeaconBlockV2", []interface{}{block, broadcastValidation}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// SubmitValidatorRegistrations submits a validator registration.
+func (s *Recorder) SubmitValidatorRegistrations(ctx context.Context, registrations []*api.VersionedSignedValidatorRegistration) error {
+	next, isNext := s.next.(consensusclient.ValidatorRegistrationsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	err := next.SubmitValidatorRegistrations(ctx, registrations)
+	s.record("SubmitValidatorRegistrations", []interface{}{registrations}, []interface{}{}, err)
+
+	return err
+}
+
+// DebugBeaconHeads provides the heads of the chain known to the node's fork choice.
+func (s *Recorder) DebugBeaconHeads(ctx context.Context) ([]*apiv1.ChainHead, error) {
+	next, isNext := s.next.(consensusclient.DebugBeaconHeadsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.DebugBeaconHeads(ctx)
+	s.record("DebugBeaconHeads", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// Events feeds requested events with the given topics to the supplied handler.
+func (s *Recorder) Events(ctx context.Context, topics []string, handler consensusclient.EventHandlerFunc) error {
+	next, isNext := s.next.(consensusclient.EventsProvider)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.Events(ctx, topics, handler)
+}
+
+// OnHead subscribes to head events.
+func (s *Recorder) OnHead(ctx context.Context, bufferSize int) (*apiv1.Subscription[*apiv1.HeadEvent], error) {
+	next, isNext := s.next.(consensusclient.TypedEventsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.OnHead(ctx, bufferSize)
+	s.record("OnHead", []interface{}{bufferSize}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// OnBlock subscribes to block events.
+func (s *Recorder) OnBlock(ctx context.Context, bufferSize int) (*apiv1.Subscription[*apiv1.BlockEvent], error) {
+	next, isNext := s.next.(consensusclient.TypedEventsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.OnBlock(ctx, bufferSize)
+	s.record("OnBlock", []interface{}{bufferSize}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// OnAttestation subscribes to attestation events.
+func (s *Recorder) OnAttestation(ctx context.Context, bufferSize int) (*apiv1.Subscription[*phase0.Attestation], error) {
+	next, isNext := s.next.(consensusclient.TypedEventsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.OnAttestation(ctx, bufferSize)
+	s.record("OnAttestation", []interface{}{bufferSize}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// OnVoluntaryExit subscribes to voluntary exit events.
+func (s *Recorder) OnVoluntaryExit(ctx context.Context, bufferSize int) (*apiv1.Subscription[*phase0.SignedVoluntaryExit], error) {
+	next, isNext := s.next.(consensusclient.TypedEventsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.OnVoluntaryExit(ctx, bufferSize)
+	s.record("OnVoluntaryExit", []interface{}{bufferSize}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// OnFinalizedCheckpoint subscribes to finalized checkpoint events.
+func (s *Recorder) OnFinalizedCheckpoint(ctx context.Context, bufferSize int) (*apiv1.Subscription[*apiv1.FinalizedCheckpointEvent], error) {
+	next, isNext := s.next.(consensusclient.TypedEventsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.OnFinalizedCheckpoint(ctx, bufferSize)
+	s.record("OnFinalizedCheckpoint", []interface{}{bufferSize}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// OnChainReorg subscribes to chain reorganisation events.
+func (s *Recorder) OnChainReorg(ctx context.Context, bufferSize int) (*apiv1.Subscription[*apiv1.ChainReorgEvent], error) {
+	next, isNext := s.next.(consensusclient.TypedEventsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.OnChainReorg(ctx, bufferSize)
+	s.record("OnChainReorg", []interface{}{bufferSize}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// OnContributionAndProof subscribes to sync committee contribution and proof events.
+func (s *Recorder) OnContributionAndProof(ctx context.Context, bufferSize int) (*apiv1.Subscription[*altair.SignedContributionAndProof], error) {
+	next, isNext := s.next.(consensusclient.TypedEventsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.OnContributionAndProof(ctx, bufferSize)
+	s.record("OnContributionAndProof", []interface{}{bufferSize}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// OnPayloadAttributes subscribes to payload attributes events.
+func (s *Recorder) OnPayloadAttributes(ctx context.Context, bufferSize int) (*apiv1.Subscription[*apiv1.PayloadAttributesEvent], error) {
+	next, isNext := s.next.(consensusclient.TypedEventsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.OnPayloadAttributes(ctx, bufferSize)
+	s.record("OnPayloadAttributes", []interface{}{bufferSize}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// Finality provides the finality given a state ID.
+func (s *Recorder) Finality(ctx context.Context, stateID string) (*apiv1.Finality, error) {
+	next, isNext := s.next.(consensusclient.FinalityProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.Finality(ctx, stateID)
+	s.record("Finality", []interface{}{stateID}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// FinalityWithMeta provides the finality and its metadata given a state ID.
+func (s *Recorder) FinalityWithMeta(ctx context.Context, stateID string) (*api.Response[*apiv1.Finality], error) {
+	next, isNext := s.next.(consensusclient.FinalityWithMetaProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.FinalityWithMeta(ctx, stateID)
+	s.record("FinalityWithMeta", []interface{}{stateID}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// Fork fetches fork information for the given state.
+func (s *Recorder) Fork(ctx context.Context, stateID string) (*phase0.Fork, error) {
+	next, isNext := s.next.(consensusclient.ForkProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.Fork(ctx, stateID)
+	s.record("Fork", []interface{}{stateID}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// ForkSchedule provides details of past and future changes in the chain's fork version.
+func (s *Recorder) ForkSchedule(ctx context.Context) ([]*phase0.Fork, error) {
+	next, isNext := s.next.(consensusclient.ForkScheduleProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.ForkSchedule(ctx)
+	s.record("ForkSchedule", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// Genesis fetches genesis information for the chain.
+func (s *Recorder) Genesis(ctx context.Context) (*apiv1.Genesis, error) {
+	next, isNext := s.next.(consensusclient.GenesisProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.Genesis(ctx)
+	s.record("Genesis", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// NodeIdentity provides the network identity of the node.
+func (s *Recorder) NodeIdentity(ctx context.Context) (*apiv1.NodeIdentity, error) {
+	next, isNext := s.next.(consensusclient.NodeIdentityProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.NodeIdentity(ctx)
+	s.record("NodeIdentity", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// NodeSyncing provides the state of the node's synchronization with the chain.
+func (s *Recorder) NodeSyncing(ctx context.Context) (*apiv1.SyncState, error) {
+	next, isNext := s.next.(consensusclient.NodeSyncingProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.NodeSyncing(ctx)
+	s.record("NodeSyncing", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// Proposal fetches a proposal for signing.
+func (s *Recorder) Proposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*api.VersionedProposal, error) {
+	next, isNext := s.next.(consensusclient.ProposalProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.Proposal(ctx, slot, randaoReveal, graffiti)
+	s.record("Proposal", []interface{}{slot, randaoReveal, graffiti}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// SubmitProposalPreparations provides the beacon node with information required if a proposal for the given validators
+// shows up in the next epoch.
+func (s *Recorder) SubmitProposalPreparations(ctx context.Context, preparations []*apiv1.ProposalPreparation) error {
+	next, isNext := s.next.(consensusclient.ProposalPreparationsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	err := next.SubmitProposalPreparations(ctx, preparations)
+	s.record("SubmitProposalPreparations", []interface{}{preparations}, []interface{}{}, err)
+
+	return err
+}
+
+// ProposerDuties obtains proposer duties for the given epoch.
+// If validatorIndices is empty all duties are returned, otherwise only matching duties are returned.
+func (s *Recorder) ProposerDuties(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.ProposerDuty, error) {
+	next, isNext := s.next.(consensusclient.ProposerDutiesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.ProposerDuties(ctx, epoch, validatorIndices)
+	s.record("ProposerDuties", []interface{}{epoch, validatorIndices}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// ProposerDutiesWithMeta obtains proposer duties and their metadata for the given epoch.
+// If validatorIndices is empty all duties are returned, otherwise only matching duties are returned.
+func (s *Recorder) ProposerDutiesWithMeta(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) (*api.Response[[]*apiv1.ProposerDuty], error) {
+	next, isNext := s.next.(consensusclient.ProposerDutiesWithMetaProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.ProposerDutiesWithMeta(ctx, epoch, validatorIndices)
+	s.record("ProposerDutiesWithMeta", []interface{}{epoch, validatorIndices}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// Spec provides the spec information of the chain.
+func (s *Recorder) Spec(ctx context.Context) (map[string]interface{}, error) {
+	next, isNext := s.next.(consensusclient.SpecProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.Spec(ctx)
+	s.record("Spec", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// SyncState provides the state of the node's synchronization with the chain.
+func (s *Recorder) SyncState(ctx context.Context) (*apiv1.SyncState, error) {
+	next, isNext := s.next.(consensusclient.SyncStateProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.SyncState(ctx)
+	s.record("SyncState", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// ValidatorBalances provides the validator balances for a given state.
+// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+// validatorIndices is a list of validator indices to restrict the returned values.  If no validators are supplied no filter
+// will be applied.
+func (s *Recorder) ValidatorBalances(ctx context.Context, stateID string, validatorIndices []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]phase0.Gwei, error) {
+	next, isNext := s.next.(consensusclient.ValidatorBalancesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.ValidatorBalances(ctx, stateID, validatorIndices)
+	s.record("ValidatorBalances", []interface{}{stateID, validatorIndices}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// Validators provides the validators, with their balance and status, for a given state.
+// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+// validatorIndices is a list of validator indices to restrict the returned values.  If no validators IDs are supplied no filter
+// will be applied.
+// validatorStates is a list of validator states to restrict the returned values.  If no validators states are supplied no filter
+// will be applied.
+func (s *Recorder) Validators(ctx context.Context, stateID string, validatorIndices []phase0.ValidatorIndex, validatorStates []apiv1.ValidatorState) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	next, isNext := s.next.(consensusclient.ValidatorsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.Validators(ctx, stateID, validatorIndices, validatorStates)
+	s.record("Validators", []interface{}{stateID, validatorIndices, validatorStates}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// ValidatorsByPubKey provides the validators, with their balance and status, for a given state.
+// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+// validatorPubKeys is a list of validator public keys to restrict the returned values.  If no validators public keys are
+// supplied no filter will be applied.
+// validatorStates is a list of validator states to restrict the returned values.  If no validators states are supplied no filter
+// will be applied.
+func (s *Recorder) ValidatorsByPubKey(ctx context.Context, stateID string, validatorPubKeys []phase0.BLSPubKey, validatorStates []apiv1.ValidatorState) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	next, isNext := s.next.(consensusclient.ValidatorsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.ValidatorsByPubKey(ctx, stateID, validatorPubKeys, validatorStates)
+	s.record("ValidatorsByPubKey", []interface{}{stateID, validatorPubKeys, validatorStates}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// SubmitVoluntaryExit submits a voluntary exit.
+func (s *Recorder) SubmitVoluntaryExit(ctx context.Context, voluntaryExit *phase0.SignedVoluntaryExit) error {
+	next, isNext := s.next.(consensusclient.VoluntaryExitSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	err := next.SubmitVoluntaryExit(ctx, voluntaryExit)
+	s.record("SubmitVoluntaryExit", []interface{}{voluntaryExit}, []interface{}{}, err)
+
+	return err
+}
+
+// VoluntaryExitPool fetches the voluntary exit pool.
+func (s *Recorder) VoluntaryExitPool(ctx context.Context) ([]*phase0.SignedVoluntaryExit, error) {
+	next, isNext := s.next.(consensusclient.VoluntaryExitPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.VoluntaryExitPool(ctx)
+	s.record("VoluntaryExitPool", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// Domain provides a domain for a given domain type at a given epoch.
+func (s *Recorder) Domain(ctx context.Context, domainType phase0.DomainType, epoch phase0.Epoch) (phase0.Domain, error) {
+	next, isNext := s.next.(consensusclient.DomainProvider)
+	if !isNext {
+		return phase0.Domain{}, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.Domain(ctx, domainType, epoch)
+	s.record("Domain", []interface{}{domainType, epoch}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// GenesisDomain returns the domain for the given domain type at genesis.
+// N.B. this is not always the same as the the domain at epoch 0.  It is possible
+// for a chain's fork schedule to have multiple forks at genesis.  In this situation,
+// GenesisDomain() will return the first, and Domain() will return the last.
+func (s *Recorder) GenesisDomain(ctx context.Context, domainType phase0.DomainType) (phase0.Domain, error) {
+	next, isNext := s.next.(consensusclient.DomainProvider)
+	if !isNext {
+		return phase0.Domain{}, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.GenesisDomain(ctx, domainType)
+	s.record("GenesisDomain", []interface{}{domainType}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// GenesisTime provides the genesis time of the chain.
+func (s *Recorder) GenesisTime(ctx context.Context) (time.Time, error) {
+	next, isNext := s.next.(consensusclient.GenesisTimeProvider)
+	if !isNext {
+		return time.Time{}, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.GenesisTime(ctx)
+	s.record("GenesisTime", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// FeatureEnabled returns true if the given feature is enabled.
+func (s *Recorder) FeatureEnabled(feature api.Feature) bool {
+	next, isNext := s.next.(consensusclient.FeaturesProvider)
+	if !isNext {
+		return false
+	}
+	res0 := next.FeatureEnabled(feature)
+	s.record("FeatureEnabled", []interface{}{feature}, []interface{}{res0}, nil)
+
+	return res0
+}
+
+// EnabledFeatures returns the features enabled in the service.
+func (s *Recorder) EnabledFeatures() []api.Feature {
+	next, isNext := s.next.(consensusclient.FeaturesProvider)
+	if !isNext {
+		return nil
+	}
+	res0 := next.EnabledFeatures()
+	s.record("EnabledFeatures", []interface{}{}, []interface{}{res0}, nil)
+
+	return res0
+}
+
+// Network provides the network to which the node is connected.
+func (s *Recorder) Network(ctx context.Context) (*apiv1.Network, error) {
+	next, isNext := s.next.(consensusclient.NetworkProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.Network(ctx)
+	s.record("Network", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// SpecConfig provides the typed spec information of the chain.
+func (s *Recorder) SpecConfig(ctx context.Context) (*api.SpecConfig, error) {
+	next, isNext := s.next.(consensusclient.SpecConfigProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.SpecConfig(ctx)
+	s.record("SpecConfig", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// NetworkProfile provides the profile of the network to which the node is connected.
+func (s *Recorder) NetworkProfile(ctx context.Context) (*api.NetworkProfile, error) {
+	next, isNext := s.next.(consensusclient.NetworkProfileProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.NetworkProfile(ctx)
+	s.record("NetworkProfile", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// NodeClient provides the client for the node.
+func (s *Recorder) NodeClient(ctx context.Context) (string, error) {
+	next, isNext := s.next.(consensusclient.NodeClientProvider)
+	if !isNext {
+		return "", fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, err := next.NodeClient(ctx)
+	s.record("NodeClient", []interface{}{}, []interface{}{res0}, err)
+
+	return res0, err
+}
+
+// ClientType provides the type and version of the client software of the node.
+func (s *Recorder) ClientType(ctx context.Context) (api.ClientType, string, error) {
+	next, isNext := s.next.(consensusclient.ClientTypeProvider)
+	if !isNext {
+		return 0, "", fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	res0, res1, err := next.ClientType(ctx)
+	s.record("ClientType", []interface{}{}, []interface{}{res0, res1}, err)
+
+	return res0, res1, err
+}
@@ -0,0 +1,163 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testclients_test
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testclients"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorderNew(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := testclients.NewRecorder(ctx, nil)
+	require.EqualError(t, err, "no next service supplied")
+
+	_, err = testclients.NewReplayer(ctx, nil)
+	require.EqualError(t, err, "no fixture supplied")
+}
+
+func TestRecordReplay(t *testing.T) {
+	ctx := context.Background()
+
+	// Genesis time is encoded in seconds, so use a whole number of seconds.
+	client, err := mock.New(ctx,
+		mock.WithLogLevel(zerolog.Disabled),
+		mock.WithGenesisTime(time.Unix(1606824023, 0)),
+	)
+	require.NoError(t, err)
+
+	recorder, err := testclients.NewRecorder(ctx, client)
+	require.NoError(t, err)
+
+	// Record some calls.
+	genesis, err := recorder.Genesis(ctx)
+	require.NoError(t, err)
+	spec, err := recorder.Spec(ctx)
+	require.NoError(t, err)
+	nodeVersion, err := recorder.NodeVersion(ctx)
+	require.NoError(t, err)
+	fork, err := recorder.Fork(ctx, "head")
+	require.NoError(t, err)
+	balances, err := recorder.ValidatorBalances(ctx, "head", []phase0.ValidatorIndex{1, 2})
+	require.NoError(t, err)
+
+	// Round-trip the fixture through a file.
+	fixture, err := recorder.Fixture()
+	require.NoError(t, err)
+	require.Len(t, fixture.Calls, 5)
+	path := filepath.Join(t.TempDir(), "fixture.json")
+	require.NoError(t, fixture.Save(path))
+	fixture, err = testclients.LoadFixture(path)
+	require.NoError(t, err)
+
+	replayer, err := testclients.NewReplayer(ctx, fixture)
+	require.NoError(t, err)
+	require.Equal(t, "replayer(Mock)", replayer.Name())
+
+	// Replay the calls, in a different order to that in which they were recorded.
+	replayedBalances, err := replayer.ValidatorBalances(ctx, "head", []phase0.ValidatorIndex{1, 2})
+	require.NoError(t, err)
+	require.Equal(t, balances, replayedBalances)
+	replayedFork, err := replayer.Fork(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, fork, replayedFork)
+	replayedNodeVersion, err := replayer.NodeVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, nodeVersion, replayedNodeVersion)
+	replayedSpec, err := replayer.Spec(ctx)
+	require.NoError(t, err)
+	require.Equal(t, spec, replayedSpec)
+	replayedGenesis, err := replayer.Genesis(ctx)
+	require.NoError(t, err)
+	require.True(t, genesis.GenesisTime.Equal(replayedGenesis.GenesisTime))
+	require.Equal(t, genesis.GenesisValidatorsRoot, replayedGenesis.GenesisValidatorsRoot)
+	require.Equal(t, genesis.GenesisForkVersion, replayedGenesis.GenesisForkVersion)
+
+	// Calls are matched on their arguments.
+	_, err = replayer.ValidatorBalances(ctx, "head", []phase0.ValidatorIndex{1})
+	require.EqualError(t, err, `no recorded call to ValidatorBalances with arguments ["head",["1"]]`)
+
+	// Calls that cannot be recorded cannot be replayed.
+	require.EqualError(t, replayer.Events(ctx, []string{"head"}, nil), "this call cannot be replayed")
+}
+
+func TestRecordReplayErrors(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx,
+		mock.WithLogLevel(zerolog.Disabled),
+	)
+	require.NoError(t, err)
+	erroring, err := testclients.NewErroring(ctx, 1, client)
+	require.NoError(t, err)
+
+	recorder, err := testclients.NewRecorder(ctx, erroring)
+	require.NoError(t, err)
+	_, err = recorder.NodeVersion(ctx)
+	require.EqualError(t, err, "error")
+
+	fixture, err := recorder.Fixture()
+	require.NoError(t, err)
+	replayer, err := testclients.NewReplayer(ctx, fixture)
+	require.NoError(t, err)
+	_, err = replayer.NodeVersion(ctx)
+	require.EqualError(t, err, "error")
+}
+
+func TestRecordReplaySequence(t *testing.T) {
+	ctx := context.Background()
+
+	fixture := &testclients.Fixture{
+		Name: "test",
+		Calls: []*testclients.FixtureCall{
+			{Method: "NodeVersion", Args: []byte("[]"), Results: []json.RawMessage{json.RawMessage(`"first"`)}},
+			{Method: "NodeVersion", Args: []byte("[]"), Results: []json.RawMessage{json.RawMessage(`"second"`)}},
+		},
+	}
+	replayer, err := testclients.NewReplayer(ctx, fixture)
+	require.NoError(t, err)
+
+	// Results are served in order, with the last repeated.
+	for _, expected := range []string{"first", "second", "second"} {
+		nodeVersion, err := replayer.NodeVersion(ctx)
+		require.NoError(t, err)
+		require.Equal(t, expected, nodeVersion)
+	}
+}
+
+func TestReplayerInterfaces(t *testing.T) {
+	ctx := context.Background()
+
+	replayer, err := testclients.NewReplayer(ctx, &testclients.Fixture{})
+	require.NoError(t, err)
+
+	var service consensusclient.Service = replayer
+	_, isProvider := service.(consensusclient.ValidatorsProvider)
+	require.True(t, isProvider)
+	_, isProvider = service.(consensusclient.EventsProvider)
+	require.True(t, isProvider)
+	_, isProvider = service.(consensusclient.ProposalProvider)
+	require.True(t, isProvider)
+}
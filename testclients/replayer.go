@@ -0,0 +1,122 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testclients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Replayer is an Ethereum 2 client that serves the results of calls recorded by a recorder.
+// Calls are matched on their method and arguments.  If the same call was recorded multiple
+// times the recorded results are served in order, with the last result repeated once they
+// are exhausted.
+type Replayer struct {
+	name    string
+	address string
+
+	mu     sync.Mutex
+	calls  map[string][]*FixtureCall
+	served map[string]int
+}
+
+// NewReplayer creates a new Ethereum 2 client that replays the calls in a fixture.
+func NewReplayer(_ context.Context,
+	fixture *Fixture,
+) (*Replayer, error) {
+	if fixture == nil {
+		return nil, errors.New("no fixture supplied")
+	}
+
+	calls := make(map[string][]*FixtureCall)
+	for i, call := range fixture.Calls {
+		if call == nil {
+			return nil, fmt.Errorf("fixture call %d missing", i)
+		}
+		key, err := callKey(call.Method, call.Args)
+		if err != nil {
+			return nil, errors.Wrapf(err, "fixture call %d", i)
+		}
+		calls[key] = append(calls[key], call)
+	}
+
+	return &Replayer{
+		name:    fixture.Name,
+		address: fixture.Address,
+		calls:   calls,
+		served:  make(map[string]int),
+	}, nil
+}
+
+// Name returns the name of the client implementation.
+func (s *Replayer) Name() string {
+	return fmt.Sprintf("replayer(%s)", s.name)
+}
+
+// Address returns the address of the client.
+func (s *Replayer) Address() string {
+	return fmt.Sprintf("replayer:%s", s.address)
+}
+
+// replay finds the recording of a call and decodes its results in to the targets.
+func (s *Replayer) replay(method string, args []interface{}, targets []interface{}) error {
+	encodedArgs := make([]json.RawMessage, len(args))
+	for i := range args {
+		encodedArg, err := encodeValue(args[i])
+		if err != nil {
+			return errors.Wrapf(err, "failed to encode argument %d", i)
+		}
+		encodedArgs[i] = encodedArg
+	}
+	argsData, err := json.Marshal(encodedArgs)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode arguments")
+	}
+	key, err := callKey(method, argsData)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	calls, exists := s.calls[key]
+	if !exists {
+		s.mu.Unlock()
+		return fmt.Errorf("no recorded call to %s with arguments %s", method, string(argsData))
+	}
+	index := s.served[key]
+	if index >= len(calls) {
+		index = len(calls) - 1
+	}
+	s.served[key]++
+	s.mu.Unlock()
+
+	call := calls[index]
+	if call.Error != "" {
+		return errors.New(call.Error)
+	}
+	if len(call.Results) != len(targets) {
+		return fmt.Errorf("recorded call to %s has %d results; expected %d", method, len(call.Results), len(targets))
+	}
+	for i := range targets {
+		if err := decodeValue(call.Results[i], targets[i]); err != nil {
+			return errors.Wrapf(err, "failed to decode result %d of recorded call to %s", i, method)
+		}
+	}
+
+	return nil
+}
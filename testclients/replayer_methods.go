@@ -0,0 +1,960 @@
+// Code generated by gentestclients. DO NOT EDIT.
+
+package testclients
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// EpochFromStateID converts a state ID to its epoch.
+func (s *Replayer) EpochFromStateID(_ context.Context, stateID string) (phase0.Epoch, error) {
+	var res0 phase0.Epoch
+	if err := s.replay("EpochFromStateID", []interface{}{stateID}, []interface{}{&res0}); err != nil {
+		return 0, err
+	}
+
+	return res0, nil
+}
+
+// SlotFromStateID converts a state ID to its slot.
+func (s *Replayer) SlotFromStateID(_ context.Context, stateID string) (phase0.Slot, error) {
+	var res0 phase0.Slot
+	if err := s.replay("SlotFromStateID", []interface{}{stateID}, []interface{}{&res0}); err != nil {
+		return 0, err
+	}
+
+	return res0, nil
+}
+
+// NodeVersion returns a free-text string with the node version.
+func (s *Replayer) NodeVersion(_ context.Context) (string, error) {
+	var res0 string
+	if err := s.replay("NodeVersion", []interface{}{}, []interface{}{&res0}); err != nil {
+		return "", err
+	}
+
+	return res0, nil
+}
+
+// SlotDuration provides the duration of a slot of the chain.
+func (s *Replayer) SlotDuration(_ context.Context) (time.Duration, error) {
+	var res0 time.Duration
+	if err := s.replay("SlotDuration", []interface{}{}, []interface{}{&res0}); err != nil {
+		return 0, err
+	}
+
+	return res0, nil
+}
+
+// SlotsPerEpoch provides the slots per epoch of the chain.
+func (s *Replayer) SlotsPerEpoch(_ context.Context) (uint64, error) {
+	var res0 uint64
+	if err := s.replay("SlotsPerEpoch", []interface{}{}, []interface{}{&res0}); err != nil {
+		return 0, err
+	}
+
+	return res0, nil
+}
+
+// FarFutureEpoch provides the far future epoch of the chain.
+func (s *Replayer) FarFutureEpoch(_ context.Context) (phase0.Epoch, error) {
+	var res0 phase0.Epoch
+	if err := s.replay("FarFutureEpoch", []interface{}{}, []interface{}{&res0}); err != nil {
+		return 0, err
+	}
+
+	return res0, nil
+}
+
+// GenesisValidatorsRoot provides the genesis validators root of the chain.
+func (s *Replayer) GenesisValidatorsRoot(_ context.Context) ([]byte, error) {
+	var res0 []byte
+	if err := s.replay("GenesisValidatorsRoot", []interface{}{}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// TargetAggregatorsPerCommittee provides the target number of aggregators for each attestation committee.
+func (s *Replayer) TargetAggregatorsPerCommittee(_ context.Context) (uint64, error) {
+	var res0 uint64
+	if err := s.replay("TargetAggregatorsPerCommittee", []interface{}{}, []interface{}{&res0}); err != nil {
+		return 0, err
+	}
+
+	return res0, nil
+}
+
+// Index provides the index of the validator.
+func (s *Replayer) Index(_ context.Context) (phase0.ValidatorIndex, error) {
+	var res0 phase0.ValidatorIndex
+	if err := s.replay("Index", []interface{}{}, []interface{}{&res0}); err != nil {
+		return 0, err
+	}
+
+	return res0, nil
+}
+
+// PubKey provides the public key of the validator.
+func (s *Replayer) PubKey(_ context.Context) (phase0.BLSPubKey, error) {
+	var res0 phase0.BLSPubKey
+	if err := s.replay("PubKey", []interface{}{}, []interface{}{&res0}); err != nil {
+		return phase0.BLSPubKey{}, err
+	}
+
+	return res0, nil
+}
+
+// DepositContract provides details of the Ethereum 1 deposit contract for the chain.
+func (s *Replayer) DepositContract(_ context.Context) (*apiv1.DepositContract, error) {
+	var res0 *apiv1.DepositContract
+	if err := s.replay("DepositContract", []interface{}{}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// SignedBeaconBlock fetches a signed beacon block given a block ID.
+func (s *Replayer) SignedBeaconBlock(_ context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error) {
+	var res0 *spec.VersionedSignedBeaconBlock
+	if err := s.replay("SignedBeaconBlock", []interface{}{blockID}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// SignedBeaconBlockWithMeta fetches a signed beacon block and its metadata given a block ID.
+func (s *Replayer) SignedBeaconBlockWithMeta(_ context.Context, blockID string) (*api.Response[*spec.VersionedSignedBeaconBlock], error) {
+	var res0 *api.Response[*spec.VersionedSignedBeaconBlock]
+	if err := s.replay("SignedBeaconBlockWithMeta", []interface{}{blockID}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// BeaconBlockBlobs fetches the blobs given a block ID.
+func (s *Replayer) BeaconBlockBlobs(_ context.Context, blockID string) ([]*deneb.BlobSidecar, error) {
+	var res0 []*deneb.BlobSidecar
+	if err := s.replay("BeaconBlockBlobs", []interface{}{blockID}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// BlobSidecars fetches the blob sidecars given a block ID, along with their metadata.
+// If indices is empty all blob sidecars for the block are returned, otherwise only those
+// with the given indices.
+func (s *Replayer) BlobSidecars(_ context.Context, blockID string, indices []deneb.BlobIndex) (*api.Response[*api.VersionedBlobSidecars], error) {
+	var res0 *api.Response[*api.VersionedBlobSidecars]
+	if err := s.replay("BlobSidecars", []interface{}{blockID, indices}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// BeaconCommittees fetches all beacon committees for the epoch at the given state.
+func (s *Replayer) BeaconCommittees(_ context.Context, stateID string) ([]*apiv1.BeaconCommittee, error) {
+	var res0 []*apiv1.BeaconCommittee
+	if err := s.replay("BeaconCommittees", []interface{}{stateID}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// BeaconCommitteesAtEpoch fetches all beacon committees for the given epoch at the given state.
+func (s *Replayer) BeaconCommitteesAtEpoch(_ context.Context, stateID string, epoch phase0.Epoch) ([]*apiv1.BeaconCommittee, error) {
+	var res0 []*apiv1.BeaconCommittee
+	if err := s.replay("BeaconCommitteesAtEpoch", []interface{}{stateID, epoch}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// SyncCommittee fetches the sync committee for the given state.
+func (s *Replayer) SyncCommittee(_ context.Context, stateID string) (*apiv1.SyncCommittee, error) {
+	var res0 *apiv1.SyncCommittee
+	if err := s.replay("SyncCommittee", []interface{}{stateID}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// SyncCommitteeAtEpoch fetches the sync committee for the given epoch at the given state.
+func (s *Replayer) SyncCommitteeAtEpoch(_ context.Context, stateID string, epoch phase0.Epoch) (*apiv1.SyncCommittee, error) {
+	var res0 *apiv1.SyncCommittee
+	if err := s.replay("SyncCommitteeAtEpoch", []interface{}{stateID, epoch}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// AggregateAttestation fetches the aggregate attestation given an attestation.
+func (s *Replayer) AggregateAttestation(_ context.Context, slot phase0.Slot, attestationDataRoot phase0.Root) (*phase0.Attestation, error) {
+	var res0 *phase0.Attestation
+	if err := s.replay("AggregateAttestation", []interface{}{slot, attestationDataRoot}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// SubmitAggregateAttestations submits aggregate attestations.
+func (s *Replayer) SubmitAggregateAttestations(_ context.Context, aggregateAndProofs []*phase0.SignedAggregateAndProof) error {
+	if err := s.replay("SubmitAggregateAttestations", []interface{}{aggregateAndProofs}, []interface{}{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AggregateAttestationV2 fetches the aggregate attestation for the given attestation data root and committee.
+func (s *Replayer) AggregateAttestationV2(_ context.Context, slot phase0.Slot, attestationDataRoot phase0.Root, committeeIndex phase0.CommitteeIndex) (*spec.VersionedAttestation, error) {
+	var res0 *spec.VersionedAttestation
+	if err := s.replay("AggregateAttestationV2", []interface{}{slot, attestationDataRoot, committeeIndex}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// SubmitAggregateAttestationsV2 submits versioned aggregate attestations.
+func (s *Replayer) SubmitAggregateAttestationsV2(_ context.Context, aggregateAndProofs []*spec.VersionedSignedAggregateAndProof) error {
+	if err := s.replay("SubmitAggregateAttestationsV2", []interface{}{aggregateAndProofs}, []interface{}{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AttestationData fetches the attestation data for the given slot and committee index.
+func (s *Replayer) AttestationData(_ context.Context, slot phase0.Slot, committeeIndex phase0.CommitteeIndex) (*phase0.AttestationData, error) {
+	var res0 *phase0.AttestationData
+	if err := s.replay("AttestationData", []interface{}{slot, committeeIndex}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// AttestationPool fetches the attestation pool for the given slot.
+func (s *Replayer) AttestationPool(_ context.Context, slot phase0.Slot) ([]*phase0.Attestation, error) {
+	var res0 []*phase0.Attestation
+	if err := s.replay("AttestationPool", []interface{}{slot}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// SubmitAttestations submits attestations.
+func (s *Replayer) SubmitAttestations(_ context.Context, attestations []*phase0.Attestation) error {
+	if err := s.replay("SubmitAttestations", []interface{}{attestations}, []interface{}{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SubmitAttestationsV2 submits attestations, with the consensus version selected from the
+// chain fork at their slot.
+func (s *Replayer) SubmitAttestationsV2(_ context.Context, attestations []*phase0.Attestation) error {
+	if err := s.replay("SubmitAttestationsV2", []interface{}{attestations}, []interface{}{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SubmitAttesterSlashingV2 submits an attester slashing, with the consensus version selected
+// from the chain fork at the slot of its first attestation.
+func (s *Replayer) SubmitAttesterSlashingV2(_ context.Context, slashing *phase0.AttesterSlashing) error {
+	if err := s.replay("SubmitAttesterSlashingV2", []interface{}{slashing}, []interface{}{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AttesterDuties obtains attester duties.
+// If validatorIndicess is nil it will return all duties for the given epoch.
+func (s *Replayer) AttesterDuties(_ context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.AttesterDuty, error) {
+	var res0 []*apiv1.AttesterDuty
+	if err := s.replay("AttesterDuties", []interface{}{epoch, validatorIndices}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// AttesterDutiesWithMeta obtains attester duties and their metadata.
+// The metadata contains the dependent root of the duties, which can be checked against
+// later head events to find out if the duties are still valid.
+func (s *Replayer) AttesterDutiesWithMeta(_ context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) (*api.Response[[]*apiv1.AttesterDuty], error) {
+	var res0 *api.Response[[]*apiv1.AttesterDuty]
+	if err := s.replay("AttesterDutiesWithMeta", []interface{}{epoch, validatorIndices}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// SyncCommitteeDuties obtains sync committee duties.
+// If validatorIndicess is nil it will return all duties for the given epoch.
+func (s *Replayer) SyncCommitteeDuties(_ context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.SyncCommitteeDuty, error) {
+	var res0 []*apiv1.SyncCommitteeDuty
+	if err := s.replay("SyncCommitteeDuties", []interface{}{epoch, validatorIndices}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// SubmitSyncCommitteeMessages submits sync committee messages.
+func (s *Replayer) SubmitSyncCommitteeMessages(_ context.Context, messages []*altair.SyncCommitteeMessage) error {
+	if err := s.replay("SubmitSyncCommitteeMessages", []interface{}{messages}, []interface{}{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SubmitSyncCommitteeSubscriptions subscribes to sync committees.
+func (s *Replayer) SubmitSyncCommitteeSubscriptions(_ context.Context, subscriptions []*apiv1.SyncCommitteeSubscription) error {
+	if err := s.replay("SubmitSyncCommitteeSubscriptions", []interface{}{subscriptions}, []interface{}{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncCommitteeContribution provides a sync committee contribution.
+func (s *Replayer) SyncCommitteeContribution(_ context.Context, slot phase0.Slot, subcommitteeIndex uint64, beaconBlockRoot phase0.Root) (*altair.SyncCommitteeContribution, error) {
+	var res0 *altair.SyncCommitteeContribution
+	if err := s.replay("SyncCommitteeContribution", []interface{}{slot, subcommitteeIndex, beaconBlockRoot}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// SubmitSyncCommitteeContributions submits sync committee contributions.
+func (s *Replayer) SubmitSyncCommitteeContributions(_ context.Context, contributionAndProofs []*altair.SignedContributionAndProof) error {
+	if err := s.replay("SubmitSyncCommitteeContributions", []interface{}{contributionAndProofs}, []interface{}{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SubmitBLSToExecutionChanges submits BLS to execution address change operations.
+func (s *Replayer) SubmitBLSToExecutionChanges(_ context.Context, blsToExecutionChanges []*capella.SignedBLSToExecutionChange) error {
+	if err := s.replay("SubmitBLSToExecutionChanges", []interface{}{blsToExecutionChanges}, []interface{}{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// BeaconBlockHeader provides the block header of a given block ID.
+func (s *Replayer) BeaconBlockHeader(_ context.Context, blockID string) (*apiv1.BeaconBlockHeader, error) {
+	var res0 *apiv1.BeaconBlockHeader
+	if err := s.replay("BeaconBlockHeader", []interface{}{blockID}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// BeaconBlockHeaders provides the block headers matching the given filters.
+// If slot is supplied only headers for that slot are returned; if parentRoot is supplied only
+// headers of the children of that block are returned.  If neither is supplied the header of the
+// canonical head is returned.
+func (s *Replayer) BeaconBlockHeaders(_ context.Context, slot *phase0.Slot, parentRoot *phase0.Root) ([]*apiv1.BeaconBlockHeader, error) {
+	var res0 []*apiv1.BeaconBlockHeader
+	if err := s.replay("BeaconBlockHeaders", []interface{}{slot, parentRoot}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// BeaconBlockHeaderWithMeta provides the block header and its metadata of a given block ID.
+func (s *Replayer) BeaconBlockHeaderWithMeta(_ context.Context, blockID string) (*api.Response[*apiv1.BeaconBlockHeader], error) {
+	var res0 *api.Response[*apiv1.BeaconBlockHeader]
+	if err := s.replay("BeaconBlockHeaderWithMeta", []interface{}{blockID}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// BeaconBlockHeadersBySlotRange provides the canonical block headers for the slots from startSlot to endSlot inclusive.
+// The result contains an entry for every slot in the range, in slot order; slots without a block are marked as missed.
+func (s *Replayer) BeaconBlockHeadersBySlotRange(_ context.Context, startSlot phase0.Slot, endSlot phase0.Slot) ([]*apiv1.SlotBeaconBlockHeader, error) {
+	var res0 []*apiv1.SlotBeaconBlockHeader
+	if err := s.replay("BeaconBlockHeadersBySlotRange", []interface{}{startSlot, endSlot}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// BeaconBlockProposal fetches a proposed beacon block for signing.
+func (s *Replayer) BeaconBlockProposal(_ context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*spec.VersionedBeaconBlock, error) {
+	var res0 *spec.VersionedBeaconBlock
+	if err := s.replay("BeaconBlockProposal", []interface{}{slot, randaoReveal, graffiti}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// BeaconBlockRoot fetches a block's root given a block ID.
+func (s *Replayer) BeaconBlockRoot(_ context.Context, blockID string) (*phase0.Root, error) {
+	var res0 *phase0.Root
+	if err := s.replay("BeaconBlockRoot", []interface{}{blockID}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// SubmitBeaconBlock submits a beacon block.
+func (s *Replayer) SubmitBeaconBlock(_ context.Context, block *spec.VersionedSignedBeaconBlock) error {
+	if err := s.replay("SubmitBeaconBlock", []interface{}{block}, []interface{}{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SubmitBeaconBlockV2 submits a beacon block, with the beacon node carrying out the given
+// level of validation before broadcasting it.
+func (s *Replayer) SubmitBeaconBlockV2(_ context.Context, block *spec.VersionedSignedBeaconBlock, broadcastValidation apiv1.BroadcastValidation) error {
+	if err := s.replay("SubmitBeaconBlockV2", []interface{}{block, broadcastValidation}, []interface{}{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SubmitBlockContents submits a block along with its blob sidecars, with the beacon node carrying
+// out the given level of validation before broadcasting them.
+func (s *Replayer) SubmitBlockContents(_ context.Context, contents *apiv1deneb.SignedBlockContents, broadcastValidation apiv1.BroadcastValidation) error {
+	if err := s.replay("SubmitBlockContents", []interface{}{contents, broadcastValidation}, []interface{}{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SubmitBeaconCommitteeSubscriptions subscribes to beacon committees.
+func (s *Replayer) SubmitBeaconCommitteeSubscriptions(_ context.Context, subscriptions []*apiv1.BeaconCommitteeSubscription) error {
+	if err := s.replay("SubmitBeaconCommitteeSubscriptions", []interface{}{subscriptions}, []interface{}{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// BeaconState fetches a beacon state given a state ID.
+func (s *Replayer) BeaconState(_ context.Context, stateID string) (*spec.VersionedBeaconState, error) {
+	var res0 *spec.VersionedBeaconState
+	if err := s.replay("BeaconState", []interface{}{stateID}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// BeaconStateRandao fetches a beacon state RANDAO given a state ID.
+func (s *Replayer) BeaconStateRandao(_ context.Context, stateID string) (*phase0.Root, error) {
+	var res0 *phase0.Root
+	if err := s.replay("BeaconStateRandao", []interface{}{stateID}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// BeaconStateRoot fetches a beacon state root given a state ID.
+func (s *Replayer) BeaconStateRoot(_ context.Context, stateID string) (*phase0.Root, error) {
+	var res0 *phase0.Root
+	if err := s.replay("BeaconStateRoot", []interface{}{stateID}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// StateRoot fetches a beacon state root and its metadata given a state ID.
+func (s *Replayer) StateRoot(_ context.Context, stateID string) (*api.Response[*phase0.Root], error) {
+	var res0 *api.Response[*phase0.Root]
+	if err := s.replay("StateRoot", []interface{}{stateID}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// HistoricalSummaries fetches the historical summaries and their metadata given a state ID.
+func (s *Replayer) HistoricalSummaries(_ context.Context, stateID string) (*api.Response[[]*capella.HistoricalSummary], error) {
+	var res0 *api.Response[[]*capella.HistoricalSummary]
+	if err := s.replay("HistoricalSummaries", []interface{}{stateID}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// BlindedBeaconBlockProposal fetches a blinded proposed beacon block for signing.
+func (s *Replayer) BlindedBeaconBlockProposal(_ context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*api.VersionedBlindedBeaconBlock, error) {
+	var res0 *api.VersionedBlindedBeaconBlock
+	if err := s.replay("BlindedBeaconBlockProposal", []interface{}{slot, randaoReveal, graffiti}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// SubmitBlindedBeaconBlock submits a beacon block.
+func (s *Replayer) SubmitBlindedBeaconBlock(_ context.Context, block *api.VersionedSignedBlindedBeaconBlock) error {
+	if err := s.replay("SubmitBlindedBeaconBlock", []interface{}{block}, []interface{}{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SubmitBlindedBeaconBlockV2 submits a blinded beacon block, with the beacon node carrying out the
+// given level of validation before broadcasting it.
+// If the beacon node reveals the execution payload of the block it is returned, otherwise the
+// returned payload is nil.
+func (s *Replayer) SubmitBlindedBeaconBlockV2(_ context.Context, block *api.VersionedSignedBlindedBeaconBlock, broadcastValidation apiv1.BroadcastValidation) (*api.VersionedExecutionPayload, error) {
+	var res0 *api.VersionedExecutionPayload
+	if err := s.replay("SubmitBlindedBeaconBlockV2", []interface{}{block, broadcastValidation}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// SubmitValidatorRegistrations submits a validator registration.
+func (s *Replayer) SubmitValidatorRegistrations(_ context.Context, registrations []*api.VersionedSignedValidatorRegistration) error {
+	if err := s.replay("SubmitValidatorRegistrations", []interface{}{registrations}, []interface{}{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DebugBeaconHeads provides the heads of the chain known to the node's fork choice.
+func (s *Replayer) DebugBeaconHeads(_ context.Context) ([]*apiv1.ChainHead, error) {
+	var res0 []*apiv1.ChainHead
+	if err := s.replay("DebugBeaconHeads", []interface{}{}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// Events feeds requested events with the given topics to the supplied handler.
+func (s *Replayer) Events(_ context.Context, topics []string, handler consensusclient.EventHandlerFunc) error {
+	return errors.New("this call cannot be replayed")
+}
+
+// OnHead subscribes to head events.
+func (s *Replayer) OnHead(_ context.Context, bufferSize int) (*apiv1.Subscription[*apiv1.HeadEvent], error) {
+	var res0 *apiv1.Subscription[*apiv1.HeadEvent]
+	if err := s.replay("OnHead", []interface{}{bufferSize}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// OnBlock subscribes to block events.
+func (s *Replayer) OnBlock(_ context.Context, bufferSize int) (*apiv1.Subscription[*apiv1.BlockEvent], error) {
+	var res0 *apiv1.Subscription[*apiv1.BlockEvent]
+	if err := s.replay("OnBlock", []interface{}{bufferSize}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// OnAttestation subscribes to attestation events.
+func (s *Replayer) OnAttestation(_ context.Context, bufferSize int) (*apiv1.Subscription[*phase0.Attestation], error) {
+	var res0 *apiv1.Subscription[*phase0.Attestation]
+	if err := s.replay("OnAttestation", []interface{}{bufferSize}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// OnVoluntaryExit subscribes to voluntary exit events.
+func (s *Replayer) OnVoluntaryExit(_ context.Context, bufferSize int) (*apiv1.Subscription[*phase0.SignedVoluntaryExit], error) {
+	var res0 *apiv1.Subscription[*phase0.SignedVoluntaryExit]
+	if err := s.replay("OnVoluntaryExit", []interface{}{bufferSize}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// OnFinalizedCheckpoint subscribes to finalized checkpoint events.
+func (s *Replayer) OnFinalizedCheckpoint(_ context.Context, bufferSize int) (*apiv1.Subscription[*apiv1.FinalizedCheckpointEvent], error) {
+	var res0 *apiv1.Subscription[*apiv1.FinalizedCheckpointEvent]
+	if err := s.replay("OnFinalizedCheckpoint", []interface{}{bufferSize}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// OnChainReorg subscribes to chain reorganisation events.
+func (s *Replayer) OnChainReorg(_ context.Context, bufferSize int) (*apiv1.Subscription[*apiv1.ChainReorgEvent], error) {
+	var res0 *apiv1.Subscription[*apiv1.ChainReorgEvent]
+	if err := s.replay("OnChainReorg", []interface{}{bufferSize}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// OnContributionAndProof subscribes to sync committee contribution and proof events.
+func (s *Replayer) OnContributionAndProof(_ context.Context, bufferSize int) (*apiv1.Subscription[*altair.SignedContributionAndProof], error) {
+	var res0 *apiv1.Subscription[*altair.SignedContributionAndProof]
+	if err := s.replay("OnContributionAndProof", []interface{}{bufferSize}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// OnPayloadAttributes subscribes to payload attributes events.
+func (s *Replayer) OnPayloadAttributes(_ context.Context, bufferSize int) (*apiv1.Subscription[*apiv1.PayloadAttributesEvent], error) {
+	var res0 *apiv1.Subscription[*apiv1.PayloadAttributesEvent]
+	if err := s.replay("OnPayloadAttributes", []interface{}{bufferSize}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// Finality provides the finality given a state ID.
+func (s *Replayer) Finality(_ context.Context, stateID string) (*apiv1.Finality, error) {
+	var res0 *apiv1.Finality
+	if err := s.replay("Finality", []interface{}{stateID}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// FinalityWithMeta provides the finality and its metadata given a state ID.
+func (s *Replayer) FinalityWithMeta(_ context.Context, stateID string) (*api.Response[*apiv1.Finality], error) {
+	var res0 *api.Response[*apiv1.Finality]
+	if err := s.replay("FinalityWithMeta", []interface{}{stateID}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// Fork fetches fork information for the given state.
+func (s *Replayer) Fork(_ context.Context, stateID string) (*phase0.Fork, error) {
+	var res0 *phase0.Fork
+	if err := s.replay("Fork", []interface{}{stateID}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// ForkSchedule provides details of past and future changes in the chain's fork version.
+func (s *Replayer) ForkSchedule(_ context.Context) ([]*phase0.Fork, error) {
+	var res0 []*phase0.Fork
+	if err := s.replay("ForkSchedule", []interface{}{}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// Genesis fetches genesis information for the chain.
+func (s *Replayer) Genesis(_ context.Context) (*apiv1.Genesis, error) {
+	var res0 *apiv1.Genesis
+	if err := s.replay("Genesis", []interface{}{}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// NodeIdentity provides the network identity of the node.
+func (s *Replayer) NodeIdentity(_ context.Context) (*apiv1.NodeIdentity, error) {
+	var res0 *apiv1.NodeIdentity
+	if err := s.replay("NodeIdentity", []interface{}{}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// NodeSyncing provides the state of the node's synchronization with the chain.
+func (s *Replayer) NodeSyncing(_ context.Context) (*apiv1.SyncState, error) {
+	var res0 *apiv1.SyncState
+	if err := s.replay("NodeSyncing", []interface{}{}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// Proposal fetches a proposal for signing.
+func (s *Replayer) Proposal(_ context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*api.VersionedProposal, error) {
+	var res0 *api.VersionedProposal
+	if err := s.replay("Proposal", []interface{}{slot, randaoReveal, graffiti}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// SubmitProposalPreparations provides the beacon node with information required if a proposal for the given validators
+// shows up in the next epoch.
+func (s *Replayer) SubmitProposalPreparations(_ context.Context, preparations []*apiv1.ProposalPreparation) error {
+	if err := s.replay("SubmitProposalPreparations", []interface{}{preparations}, []interface{}{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ProposerDuties obtains proposer duties for the given epoch.
+// If validatorIndices is empty all duties are returned, otherwise only matching duties are returned.
+func (s *Replayer) ProposerDuties(_ context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.ProposerDuty, error) {
+	var res0 []*apiv1.ProposerDuty
+	if err := s.replay("ProposerDuties", []interface{}{epoch, validatorIndices}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// ProposerDutiesWithMeta obtains proposer duties and their metadata for the given epoch.
+// If validatorIndices is empty all duties are returned, otherwise only matching duties are returned.
+func (s *Replayer) ProposerDutiesWithMeta(_ context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) (*api.Response[[]*apiv1.ProposerDuty], error) {
+	var res0 *api.Response[[]*apiv1.ProposerDuty]
+	if err := s.replay("ProposerDutiesWithMeta", []interface{}{epoch, validatorIndices}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// Spec provides the spec information of the chain.
+func (s *Replayer) Spec(_ context.Context) (map[string]interface{}, error) {
+	var res0 map[string]interface{}
+	if err := s.replay("Spec", []interface{}{}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// SyncState provides the state of the node's synchronization with the chain.
+func (s *Replayer) SyncState(_ context.Context) (*apiv1.SyncState, error) {
+	var res0 *apiv1.SyncState
+	if err := s.replay("SyncState", []interface{}{}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// ValidatorBalances provides the validator balances for a given state.
+// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+// validatorIndices is a list of validator indices to restrict the returned values.  If no validators are supplied no filter
+// will be applied.
+func (s *Replayer) ValidatorBalances(_ context.Context, stateID string, validatorIndices []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]phase0.Gwei, error) {
+	var res0 map[phase0.ValidatorIndex]phase0.Gwei
+	if err := s.replay("ValidatorBalances", []interface{}{stateID, validatorIndices}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// Validators provides the validators, with their balance and status, for a given state.
+// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+// validatorIndices is a list of validator indices to restrict the returned values.  If no validators IDs are supplied no filter
+// will be applied.
+// validatorStates is a list of validator states to restrict the returned values.  If no validators states are supplied no filter
+// will be applied.
+func (s *Replayer) Validators(_ context.Context, stateID string, validatorIndices []phase0.ValidatorIndex, validatorStates []apiv1.ValidatorState) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	var res0 map[phase0.ValidatorIndex]*apiv1.Validator
+	if err := s.replay("Validators", []interface{}{stateID, validatorIndices, validatorStates}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// ValidatorsByPubKey provides the validators, with their balance and status, for a given state.
+// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+// validatorPubKeys is a list of validator public keys to restrict the returned values.  If no validators public keys are
+// supplied no filter will be applied.
+// validatorStates is a list of validator states to restrict the returned values.  If no validators states are supplied no filter
+// will be applied.
+func (s *Replayer) ValidatorsByPubKey(_ context.Context, stateID string, validatorPubKeys []phase0.BLSPubKey, validatorStates []apiv1.ValidatorState) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	var res0 map[phase0.ValidatorIndex]*apiv1.Validator
+	if err := s.replay("ValidatorsByPubKey", []interface{}{stateID, validatorPubKeys, validatorStates}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// SubmitVoluntaryExit submits a voluntary exit.
+func (s *Replayer) SubmitVoluntaryExit(_ context.Context, voluntaryExit *phase0.SignedVoluntaryExit) error {
+	if err := s.replay("SubmitVoluntaryExit", []interface{}{voluntaryExit}, []interface{}{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// VoluntaryExitPool fetches the voluntary exit pool.
+func (s *Replayer) VoluntaryExitPool(_ context.Context) ([]*phase0.SignedVoluntaryExit, error) {
+	var res0 []*phase0.SignedVoluntaryExit
+	if err := s.replay("VoluntaryExitPool", []interface{}{}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// Domain provides a domain for a given domain type at a given epoch.
+func (s *Replayer) Domain(_ context.Context, domainType phase0.DomainType, epoch phase0.Epoch) (phase0.Domain, error) {
+	var res0 phase0.Domain
+	if err := s.replay("Domain", []interface{}{domainType, epoch}, []interface{}{&res0}); err != nil {
+		return phase0.Domain{}, err
+	}
+
+	return res0, nil
+}
+
+// GenesisDomain returns the domain for the given domain type at genesis.
+// N.B. this is not always the same as the the domain at epoch 0.  It is possible
+// for a chain's fork schedule to have multiple forks at genesis.  In this situation,
+// GenesisDomain() will return the first, and Domain() will return the last.
+func (s *Replayer) GenesisDomain(_ context.Context, domainType phase0.DomainType) (phase0.Domain, error) {
+	var res0 phase0.Domain
+	if err := s.replay("GenesisDomain", []interface{}{domainType}, []interface{}{&res0}); err != nil {
+		return phase0.Domain{}, err
+	}
+
+	return res0, nil
+}
+
+// GenesisTime provides the genesis time of the chain.
+func (s *Replayer) GenesisTime(_ context.Context) (time.Time, error) {
+	var res0 time.Time
+	if err := s.replay("GenesisTime", []interface{}{}, []interface{}{&res0}); err != nil {
+		return time.Time{}, err
+	}
+
+	return res0, nil
+}
+
+// FeatureEnabled returns true if the given feature is enabled.
+func (s *Replayer) FeatureEnabled(feature api.Feature) bool {
+	var res0 bool
+	_ = s.replay("FeatureEnabled", []interface{}{feature}, []interface{}{&res0})
+
+	return res0
+}
+
+// EnabledFeatures returns the features enabled in the service.
+func (s *Replayer) EnabledFeatures() []api.Feature {
+	var res0 []api.Feature
+	_ = s.replay("EnabledFeatures", []interface{}{}, []interface{}{&res0})
+
+	return res0
+}
+
+// Network provides the network to which the node is connected.
+func (s *Replayer) Network(_ context.Context) (*apiv1.Network, error) {
+	var res0 *apiv1.Network
+	if err := s.replay("Network", []interface{}{}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// SpecConfig provides the typed spec information of the chain.
+func (s *Replayer) SpecConfig(_ context.Context) (*api.SpecConfig, error) {
+	var res0 *api.SpecConfig
+	if err := s.replay("SpecConfig", []interface{}{}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// NetworkProfile provides the profile of the network to which the node is connected.
+func (s *Replayer) NetworkProfile(_ context.Context) (*api.NetworkProfile, error) {
+	var res0 *api.NetworkProfile
+	if err := s.replay("NetworkProfile", []interface{}{}, []interface{}{&res0}); err != nil {
+		return nil, err
+	}
+
+	return res0, nil
+}
+
+// NodeClient provides the client for the node.
+func (s *Replayer) NodeClient(_ context.Context) (string, error) {
+	var res0 string
+	if err := s.replay("NodeClient", []interface{}{}, []interface{}{&res0}); err != nil {
+		return "", err
+	}
+
+	return res0, nil
+}
+
+// ClientType provides the type and version of the client software of the node.
+func (s *Replayer) ClientType(_ context.Context) (api.ClientType, string, error) {
+	var res0 api.ClientType
+	var res1 string
+	if err := s.replay("ClientType", []interface{}{}, []interface{}{&res0, &res1}); err != nil {
+		return 0, "", err
+	}
+
+	return res0, res1, nil
+}
@@ -102,3 +102,49 @@ func TestSleep(t *testing.T) {
 		require.LessOrEqual(t, duration.Milliseconds(), (maxSleep + 50*time.Millisecond).Milliseconds())
 	}
 }
+
+func TestSleepyContext(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx,
+		mock.WithLogLevel(zerolog.Disabled),
+	)
+	require.NoError(t, err)
+
+	s, err := testclients.NewSleepy(ctx, time.Minute, time.Minute, client)
+	require.NoError(t, err)
+
+	// A cancelled context should cut the sleep short.
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	started := time.Now()
+	_, err = s.(consensusclient.NodeVersionProvider).NodeVersion(cancelledCtx)
+	require.NoError(t, err)
+	require.Less(t, time.Since(started), time.Second)
+}
+
+func TestSleepyCoverage(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx,
+		mock.WithLogLevel(zerolog.Disabled),
+	)
+	require.NoError(t, err)
+
+	s, err := testclients.NewSleepy(ctx, 0, 0, client)
+	require.NoError(t, err)
+	e, err := testclients.NewErroring(ctx, 0, client)
+	require.NoError(t, err)
+
+	// Providers added after the original wrappers were written should be present.
+	for _, service := range []consensusclient.Service{s, e} {
+		_, isProvider := service.(consensusclient.ProposalProvider)
+		require.True(t, isProvider)
+		_, isProvider = service.(consensusclient.HistoricalSummariesProvider)
+		require.True(t, isProvider)
+		_, isProvider = service.(consensusclient.FeaturesProvider)
+		require.True(t, isProvider)
+		_, isProvider = service.(consensusclient.NodeClientProvider)
+		require.True(t, isProvider)
+	}
+}
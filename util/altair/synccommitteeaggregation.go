@@ -0,0 +1,110 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/signing"
+	"github.com/pkg/errors"
+)
+
+// SelectionProofSigningRoot computes the signing root for the sync committee selection proof of
+// the given slot and subcommittee.
+//
+// This library does not carry a BLS implementation, so the caller is responsible for signing the
+// root with the validator's key to obtain the selection proof.
+func SelectionProofSigningRoot(slot phase0.Slot,
+	subcommitteeIndex uint64,
+	forkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Root,
+	error,
+) {
+	return signing.SyncCommitteeSelectionProofSigningRoot(&altair.SyncAggregatorSelectionData{
+		Slot:              slot,
+		SubcommitteeIndex: subcommitteeIndex,
+	}, forkVersion, genesisValidatorsRoot)
+}
+
+// IsSyncCommitteeAggregator returns true if the selection proof selects the validator as an
+// aggregator for its sync subcommittee.
+func IsSyncCommitteeAggregator(config *api.SpecConfig, selectionProof phase0.BLSSignature) (bool, error) {
+	if config == nil {
+		return false, errors.New("no spec config supplied")
+	}
+	if config.SyncCommitteeSubnetCount == 0 {
+		return false, errors.New("sync committee subnet count not set")
+	}
+	if config.TargetAggregatorsPerSyncSubcommittee == 0 {
+		return false, errors.New("target aggregators per sync subcommittee not set")
+	}
+
+	modulo := config.SyncCommitteeSize / config.SyncCommitteeSubnetCount / config.TargetAggregatorsPerSyncSubcommittee
+	if modulo < 1 {
+		modulo = 1
+	}
+	hash := sha256.Sum256(selectionProof[:])
+
+	return binary.LittleEndian.Uint64(hash[:8])%modulo == 0, nil
+}
+
+// NewContributionAndProof creates a contribution and proof for the given aggregator, ready to be
+// signed and wrapped in a signed contribution and proof.
+func NewContributionAndProof(aggregatorIndex phase0.ValidatorIndex,
+	contribution *altair.SyncCommitteeContribution,
+	selectionProof phase0.BLSSignature,
+) (
+	*altair.ContributionAndProof,
+	error,
+) {
+	if contribution == nil {
+		return nil, errors.New("no contribution supplied")
+	}
+	if contribution.AggregationBits.Count() == 0 {
+		return nil, errors.New("contribution has no aggregation bits set")
+	}
+
+	return &altair.ContributionAndProof{
+		AggregatorIndex: aggregatorIndex,
+		Contribution:    contribution,
+		SelectionProof:  selectionProof,
+	}, nil
+}
+
+// NewSignedContributionAndProof creates a signed contribution and proof from a contribution
+// and proof and the aggregator's signature over it.
+func NewSignedContributionAndProof(contributionAndProof *altair.ContributionAndProof,
+	signature phase0.BLSSignature,
+) (
+	*altair.SignedContributionAndProof,
+	error,
+) {
+	if contributionAndProof == nil {
+		return nil, errors.New("no contribution and proof supplied")
+	}
+	if contributionAndProof.Contribution == nil {
+		return nil, errors.New("contribution and proof has no contribution")
+	}
+
+	return &altair.SignedContributionAndProof{
+		Message:   contributionAndProof,
+		Signature: signature,
+	}, nil
+}
@@ -0,0 +1,160 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	utilaltair "github.com/attestantio/go-eth2-client/util/altair"
+	"github.com/attestantio/go-eth2-client/util/signing"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func selectionProof(value byte) phase0.BLSSignature {
+	var res phase0.BLSSignature
+	copy(res[:], bytes.Repeat([]byte{value}, len(res)))
+
+	return res
+}
+
+func TestSelectionProofSigningRoot(t *testing.T) {
+	forkVersion := phase0.Version{0x01, 0x00, 0x00, 0x00}
+	genesisValidatorsRoot := phase0.Root{0x02}
+
+	root, err := utilaltair.SelectionProofSigningRoot(10, 2, forkVersion, genesisValidatorsRoot)
+	require.NoError(t, err)
+
+	expected, err := signing.SyncCommitteeSelectionProofSigningRoot(&altair.SyncAggregatorSelectionData{
+		Slot:              10,
+		SubcommitteeIndex: 2,
+	}, forkVersion, genesisValidatorsRoot)
+	require.NoError(t, err)
+	require.Equal(t, expected, root)
+
+	// Different subcommittees have different roots.
+	other, err := utilaltair.SelectionProofSigningRoot(10, 3, forkVersion, genesisValidatorsRoot)
+	require.NoError(t, err)
+	require.NotEqual(t, root, other)
+}
+
+func TestIsSyncCommitteeAggregator(t *testing.T) {
+	mainnet := &api.SpecConfig{
+		SyncCommitteeSize:                    512,
+		SyncCommitteeSubnetCount:             4,
+		TargetAggregatorsPerSyncSubcommittee: 16,
+	}
+	minimal := &api.SpecConfig{
+		SyncCommitteeSize:                    32,
+		SyncCommitteeSubnetCount:             4,
+		TargetAggregatorsPerSyncSubcommittee: 16,
+	}
+
+	tests := []struct {
+		name       string
+		config     *api.SpecConfig
+		proof      phase0.BLSSignature
+		aggregator bool
+		err        string
+	}{
+		{
+			name:  "ConfigNil",
+			proof: selectionProof(0x02),
+			err:   "no spec config supplied",
+		},
+		{
+			name:   "SubnetCountZero",
+			config: &api.SpecConfig{SyncCommitteeSize: 512, TargetAggregatorsPerSyncSubcommittee: 16},
+			proof:  selectionProof(0x02),
+			err:    "sync committee subnet count not set",
+		},
+		{
+			name:   "TargetAggregatorsZero",
+			config: &api.SpecConfig{SyncCommitteeSize: 512, SyncCommitteeSubnetCount: 4},
+			proof:  selectionProof(0x02),
+			err:    "target aggregators per sync subcommittee not set",
+		},
+		{
+			name:       "Aggregator",
+			config:     mainnet,
+			proof:      selectionProof(0x02),
+			aggregator: true,
+		},
+		{
+			name:   "NotAggregator",
+			config: mainnet,
+			proof:  selectionProof(0x01),
+		},
+		{
+			// Subcommittees smaller than the target number of aggregators are all aggregators.
+			name:       "SmallSubcommittee",
+			config:     minimal,
+			proof:      selectionProof(0x01),
+			aggregator: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			aggregator, err := utilaltair.IsSyncCommitteeAggregator(test.config, test.proof)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.aggregator, aggregator)
+			}
+		})
+	}
+}
+
+func TestSignedContributionAndProof(t *testing.T) {
+	bits := bitfield.NewBitvector128()
+	bits.SetBitAt(3, true)
+	contribution := &altair.SyncCommitteeContribution{
+		Slot:              10,
+		SubcommitteeIndex: 2,
+		AggregationBits:   bits,
+	}
+
+	_, err := utilaltair.NewContributionAndProof(1, nil, selectionProof(0x01))
+	require.EqualError(t, err, "no contribution supplied")
+	_, err = utilaltair.NewContributionAndProof(1, &altair.SyncCommitteeContribution{
+		AggregationBits: bitfield.NewBitvector128(),
+	}, selectionProof(0x01))
+	require.EqualError(t, err, "contribution has no aggregation bits set")
+
+	contributionAndProof, err := utilaltair.NewContributionAndProof(1, contribution, selectionProof(0x01))
+	require.NoError(t, err)
+	require.Equal(t, phase0.ValidatorIndex(1), contributionAndProof.AggregatorIndex)
+	require.Equal(t, contribution, contributionAndProof.Contribution)
+	require.Equal(t, selectionProof(0x01), contributionAndProof.SelectionProof)
+
+	_, err = utilaltair.NewSignedContributionAndProof(nil, selectionProof(0x02))
+	require.EqualError(t, err, "no contribution and proof supplied")
+	_, err = utilaltair.NewSignedContributionAndProof(&altair.ContributionAndProof{}, selectionProof(0x02))
+	require.EqualError(t, err, "contribution and proof has no contribution")
+
+	signed, err := utilaltair.NewSignedContributionAndProof(contributionAndProof, selectionProof(0x02))
+	require.NoError(t, err)
+	require.Equal(t, contributionAndProof, signed.Message)
+	require.Equal(t, selectionProof(0x02), signed.Signature)
+
+	// The result can be encoded for submission.
+	_, err = signed.HashTreeRoot()
+	require.NoError(t, err)
+}
@@ -0,0 +1,264 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bellatrix
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/pkg/errors"
+)
+
+// FeeRecipientMatch is the way in which an execution payload pays the expected fee recipient.
+type FeeRecipientMatch int
+
+const (
+	// FeeRecipientMismatch is a payload that does not pay the expected fee recipient.
+	FeeRecipientMismatch FeeRecipientMatch = iota
+	// FeeRecipientDirect is a payload whose fee recipient is the expected fee recipient.
+	FeeRecipientDirect
+	// FeeRecipientPayment is a payload whose final transaction pays the expected fee recipient,
+	// as is the case for blocks built by MEV builders that collect the fees themselves.
+	FeeRecipientPayment
+)
+
+var feeRecipientMatchStrings = [...]string{
+	"mismatch",
+	"direct",
+	"payment",
+}
+
+func (m FeeRecipientMatch) String() string {
+	if int(m) < 0 || int(m) >= len(feeRecipientMatchStrings) {
+		return "unknown"
+	}
+
+	return feeRecipientMatchStrings[m]
+}
+
+// FeeRecipientVerification is the result of verifying the fee recipient of an execution payload.
+type FeeRecipientVerification struct {
+	// Match is the way in which the payload pays the expected fee recipient.
+	Match FeeRecipientMatch
+	// FeeRecipient is the fee recipient of the payload.
+	FeeRecipient bellatrix.ExecutionAddress
+	// PaymentValue is the value, in wei, of the final transaction of the payload.
+	// It is only set if Match is FeeRecipientPayment.
+	PaymentValue *big.Int
+}
+
+// Verified returns true if the payload pays the expected fee recipient.
+func (v *FeeRecipientVerification) Verified() bool {
+	return v.Match != FeeRecipientMismatch
+}
+
+// VerifyFeeRecipient verifies that an execution payload with the given fee recipient and
+// transactions pays the expected fee recipient, either directly or with its final transaction.
+// Transactions may be nil, for example for blinded blocks, in which case only a direct match is possible.
+func VerifyFeeRecipient(feeRecipient bellatrix.ExecutionAddress,
+	transactions []bellatrix.Transaction,
+	expected bellatrix.ExecutionAddress,
+) (
+	*FeeRecipientVerification,
+	error,
+) {
+	res := &FeeRecipientVerification{
+		Match:        FeeRecipientMismatch,
+		FeeRecipient: feeRecipient,
+	}
+
+	if bytes.Equal(feeRecipient[:], expected[:]) {
+		res.Match = FeeRecipientDirect
+
+		return res, nil
+	}
+
+	if len(transactions) == 0 {
+		return res, nil
+	}
+	to, value, err := transactionPayment(transactions[len(transactions)-1])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode final transaction")
+	}
+	if to != nil && bytes.Equal(to, expected[:]) {
+		res.Match = FeeRecipientPayment
+		res.PaymentValue = value
+	}
+
+	return res, nil
+}
+
+// VerifyBlockFeeRecipient verifies that the execution payload of a signed beacon block pays
+// the expected fee recipient.
+func VerifyBlockFeeRecipient(block *spec.VersionedSignedBeaconBlock,
+	expected bellatrix.ExecutionAddress,
+) (
+	*FeeRecipientVerification,
+	error,
+) {
+	if block == nil {
+		return nil, errors.New("no block supplied")
+	}
+
+	switch block.Version {
+	case spec.DataVersionBellatrix:
+		if block.Bellatrix == nil || block.Bellatrix.Message == nil || block.Bellatrix.Message.Body == nil ||
+			block.Bellatrix.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no bellatrix execution payload")
+		}
+		payload := block.Bellatrix.Message.Body.ExecutionPayload
+
+		return VerifyFeeRecipient(payload.FeeRecipient, payload.Transactions, expected)
+	case spec.DataVersionCapella:
+		if block.Capella == nil || block.Capella.Message == nil || block.Capella.Message.Body == nil ||
+			block.Capella.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no capella execution payload")
+		}
+		payload := block.Capella.Message.Body.ExecutionPayload
+
+		return VerifyFeeRecipient(payload.FeeRecipient, payload.Transactions, expected)
+	case spec.DataVersionDeneb:
+		if block.Deneb == nil || block.Deneb.Message == nil || block.Deneb.Message.Body == nil ||
+			block.Deneb.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no deneb execution payload")
+		}
+		payload := block.Deneb.Message.Body.ExecutionPayload
+
+		return VerifyFeeRecipient(payload.FeeRecipient, payload.Transactions, expected)
+	default:
+		return nil, fmt.Errorf("no execution payload for %s block", block.Version)
+	}
+}
+
+// transactionPayment returns the recipient and value of an RLP-encoded transaction.
+// The recipient is nil for contract creations and for transaction types that are not known.
+func transactionPayment(tx bellatrix.Transaction) ([]byte, *big.Int, error) {
+	if len(tx) == 0 {
+		return nil, nil, errors.New("empty transaction")
+	}
+
+	// Indices of the recipient within the transaction fields; the value immediately follows.
+	var toIndex int
+	payload := []byte(tx)
+	switch {
+	case tx[0] >= 0xc0:
+		// Legacy transaction: nonce, gas price, gas, to, value, ...
+		toIndex = 3
+	case tx[0] == 0x01:
+		// Access list transaction: chain ID, nonce, gas price, gas, to, value, ...
+		toIndex = 4
+		payload = payload[1:]
+	case tx[0] == 0x02, tx[0] == 0x03:
+		// Dynamic fee and blob transactions: chain ID, nonce, max priority fee, max fee, gas, to, value, ...
+		toIndex = 5
+		payload = payload[1:]
+	default:
+		return nil, nil, nil
+	}
+
+	isList, fields, _, err := rlpItem(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !isList {
+		return nil, nil, errors.New("transaction is not an RLP list")
+	}
+
+	var to []byte
+	var value *big.Int
+	for i := 0; i <= toIndex+1; i++ {
+		var field []byte
+		isList, field, fields, err = rlpItem(fields)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to decode transaction field %d", i)
+		}
+		if isList {
+			return nil, nil, fmt.Errorf("transaction field %d is a list", i)
+		}
+		switch i {
+		case toIndex:
+			if len(field) != 0 && len(field) != bellatrix.ExecutionAddressLength {
+				return nil, nil, fmt.Errorf("transaction recipient has invalid length %d", len(field))
+			}
+			if len(field) != 0 {
+				to = field
+			}
+		case toIndex + 1:
+			value = new(big.Int).SetBytes(field)
+		}
+	}
+
+	return to, value, nil
+}
+
+// rlpItem decodes the RLP item at the start of the data, returning whether it is a list,
+// its content, and the data following it.
+func rlpItem(data []byte) (bool, []byte, []byte, error) {
+	if len(data) == 0 {
+		return false, nil, nil, errors.New("unexpected end of RLP data")
+	}
+
+	prefix := data[0]
+	var isList bool
+	var offset, length uint64
+	switch {
+	case prefix < 0x80:
+		return false, data[:1], data[1:], nil
+	case prefix <= 0xb7:
+		offset, length = 1, uint64(prefix-0x80)
+	case prefix <= 0xbf:
+		lengthOfLength := uint64(prefix - 0xb7)
+		var err error
+		length, err = rlpLength(data[1:], lengthOfLength)
+		if err != nil {
+			return false, nil, nil, err
+		}
+		offset = 1 + lengthOfLength
+	case prefix <= 0xf7:
+		isList = true
+		offset, length = 1, uint64(prefix-0xc0)
+	default:
+		isList = true
+		lengthOfLength := uint64(prefix - 0xf7)
+		var err error
+		length, err = rlpLength(data[1:], lengthOfLength)
+		if err != nil {
+			return false, nil, nil, err
+		}
+		offset = 1 + lengthOfLength
+	}
+
+	if length > uint64(len(data))-offset {
+		return false, nil, nil, errors.New("RLP item exceeds data")
+	}
+
+	return isList, data[offset : offset+length], data[offset+length:], nil
+}
+
+// rlpLength decodes a big-endian RLP length of the given number of bytes.
+func rlpLength(data []byte, lengthOfLength uint64) (uint64, error) {
+	if lengthOfLength > 8 || uint64(len(data)) < lengthOfLength {
+		return 0, errors.New("invalid RLP length")
+	}
+
+	length := uint64(0)
+	for _, b := range data[:lengthOfLength] {
+		length = length<<8 | uint64(b)
+	}
+
+	return length, nil
+}
@@ -0,0 +1,193 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bellatrix_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	utilbellatrix "github.com/attestantio/go-eth2-client/util/bellatrix"
+	"github.com/stretchr/testify/require"
+)
+
+// rlpString encodes a byte string with RLP.
+func rlpString(data []byte) []byte {
+	if len(data) == 1 && data[0] < 0x80 {
+		return data
+	}
+
+	return append(rlpPrefix(0x80, len(data)), data...)
+}
+
+// rlpList encodes a list of encoded items with RLP.
+func rlpList(items ...[]byte) []byte {
+	content := bytes.Join(items, nil)
+
+	return append(rlpPrefix(0xc0, len(content)), content...)
+}
+
+func rlpPrefix(base byte, length int) []byte {
+	if length <= 55 {
+		return []byte{base + byte(length)}
+	}
+	lengthBytes := big.NewInt(int64(length)).Bytes()
+
+	return append([]byte{base + 55 + byte(len(lengthBytes))}, lengthBytes...)
+}
+
+func legacyTx(to []byte, value int64) bellatrix.Transaction {
+	return rlpList(
+		rlpString([]byte{0x01}),                   // nonce
+		rlpString([]byte{0x3b, 0x9a, 0xca, 0x00}), // gas price
+		rlpString([]byte{0x52, 0x08}),             // gas
+		rlpString(to),
+		rlpString(big.NewInt(value).Bytes()),
+		rlpString(nil),                            // data
+		rlpString([]byte{0x25}),                   // v
+		rlpString(bytes.Repeat([]byte{0x11}, 32)), // r
+		rlpString(bytes.Repeat([]byte{0x22}, 32)), // s
+	)
+}
+
+func dynamicFeeTx(to []byte, value int64) bellatrix.Transaction {
+	return append([]byte{0x02}, rlpList(
+		rlpString([]byte{0x01}),                   // chain ID
+		rlpString([]byte{0x07}),                   // nonce
+		rlpString([]byte{0x3b, 0x9a, 0xca, 0x00}), // max priority fee
+		rlpString([]byte{0x3b, 0x9a, 0xca, 0x00}), // max fee
+		rlpString([]byte{0x52, 0x08}),             // gas
+		rlpString(to),
+		rlpString(big.NewInt(value).Bytes()),
+		rlpString(bytes.Repeat([]byte{0xaa}, 100)), // data
+		rlpList(),      // access list
+		rlpString(nil), // y parity
+		rlpString(bytes.Repeat([]byte{0x11}, 32)), // r
+		rlpString(bytes.Repeat([]byte{0x22}, 32)), // s
+	)...)
+}
+
+func TestVerifyFeeRecipient(t *testing.T) {
+	expected := bellatrix.ExecutionAddress{0x01, 0x02, 0x03}
+	builder := bellatrix.ExecutionAddress{0xbb}
+	other := bellatrix.ExecutionAddress{0xcc}
+
+	tests := []struct {
+		name         string
+		feeRecipient bellatrix.ExecutionAddress
+		transactions []bellatrix.Transaction
+		match        utilbellatrix.FeeRecipientMatch
+		paymentValue *big.Int
+		err          string
+	}{
+		{
+			name:         "Direct",
+			feeRecipient: expected,
+			transactions: []bellatrix.Transaction{legacyTx(other[:], 1)},
+			match:        utilbellatrix.FeeRecipientDirect,
+		},
+		{
+			name:         "NoTransactions",
+			feeRecipient: builder,
+			match:        utilbellatrix.FeeRecipientMismatch,
+		},
+		{
+			name:         "LegacyPayment",
+			feeRecipient: builder,
+			transactions: []bellatrix.Transaction{legacyTx(other[:], 1), legacyTx(expected[:], 123456789)},
+			match:        utilbellatrix.FeeRecipientPayment,
+			paymentValue: big.NewInt(123456789),
+		},
+		{
+			name:         "DynamicFeePayment",
+			feeRecipient: builder,
+			transactions: []bellatrix.Transaction{dynamicFeeTx(expected[:], 987654321)},
+			match:        utilbellatrix.FeeRecipientPayment,
+			paymentValue: big.NewInt(987654321),
+		},
+		{
+			name:         "PaymentNotFinal",
+			feeRecipient: builder,
+			transactions: []bellatrix.Transaction{dynamicFeeTx(expected[:], 1), dynamicFeeTx(other[:], 1)},
+			match:        utilbellatrix.FeeRecipientMismatch,
+		},
+		{
+			name:         "ContractCreation",
+			feeRecipient: builder,
+			transactions: []bellatrix.Transaction{legacyTx(nil, 1)},
+			match:        utilbellatrix.FeeRecipientMismatch,
+		},
+		{
+			name:         "UnknownType",
+			feeRecipient: builder,
+			transactions: []bellatrix.Transaction{{0x7f, 0xc0}},
+			match:        utilbellatrix.FeeRecipientMismatch,
+		},
+		{
+			name:         "Truncated",
+			feeRecipient: builder,
+			transactions: []bellatrix.Transaction{dynamicFeeTx(expected[:], 1)[:20]},
+			err:          "failed to decode final transaction: RLP item exceeds data",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := utilbellatrix.VerifyFeeRecipient(test.feeRecipient, test.transactions, expected)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.match, res.Match)
+			require.Equal(t, test.match != utilbellatrix.FeeRecipientMismatch, res.Verified())
+			require.Equal(t, test.feeRecipient, res.FeeRecipient)
+			require.Equal(t, test.paymentValue, res.PaymentValue)
+		})
+	}
+}
+
+func TestVerifyBlockFeeRecipient(t *testing.T) {
+	expected := bellatrix.ExecutionAddress{0x01}
+
+	_, err := utilbellatrix.VerifyBlockFeeRecipient(nil, expected)
+	require.EqualError(t, err, "no block supplied")
+
+	_, err = utilbellatrix.VerifyBlockFeeRecipient(&spec.VersionedSignedBeaconBlock{Version: spec.DataVersionAltair}, expected)
+	require.EqualError(t, err, "no execution payload for altair block")
+
+	_, err = utilbellatrix.VerifyBlockFeeRecipient(&spec.VersionedSignedBeaconBlock{Version: spec.DataVersionCapella}, expected)
+	require.EqualError(t, err, "no capella execution payload")
+
+	block := &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionCapella,
+		Capella: &capella.SignedBeaconBlock{
+			Message: &capella.BeaconBlock{
+				Body: &capella.BeaconBlockBody{
+					ExecutionPayload: &capella.ExecutionPayload{
+						FeeRecipient: bellatrix.ExecutionAddress{0xbb},
+						Transactions: []bellatrix.Transaction{legacyTx(expected[:], 5)},
+					},
+				},
+			},
+		},
+	}
+	res, err := utilbellatrix.VerifyBlockFeeRecipient(block, expected)
+	require.NoError(t, err)
+	require.Equal(t, utilbellatrix.FeeRecipientPayment, res.Match)
+	require.Equal(t, big.NewInt(5), res.PaymentValue)
+}
@@ -0,0 +1,208 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitfield_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/bitfield"
+	prysmbitfield "github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func TestList(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    prysmbitfield.Bitlist
+		length  uint64
+		count   uint64
+		indices []uint64
+		err     string
+	}{
+		{
+			name: "Nil",
+			err:  "bitlist is empty",
+		},
+		{
+			name: "NoLengthBit",
+			list: prysmbitfield.Bitlist{0x01, 0x00},
+			err:  "bitlist has no length bit",
+		},
+		{
+			name:    "ZeroLength",
+			list:    prysmbitfield.Bitlist{0x01},
+			length:  0,
+			indices: []uint64{},
+		},
+		{
+			name:    "Single",
+			list:    prysmbitfield.Bitlist{0x1a},
+			length:  4,
+			count:   2,
+			indices: []uint64{1, 3},
+		},
+		{
+			name:    "MultiByte",
+			list:    prysmbitfield.Bitlist{0x81, 0x05},
+			length:  10,
+			count:   3,
+			indices: []uint64{0, 7, 8},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			length, err := bitfield.ListLen(test.list)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				_, err = bitfield.ListCount(test.list)
+				require.EqualError(t, err, test.err)
+				_, err = bitfield.ListBit(test.list, 0)
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.length, length)
+			require.Equal(t, test.list.Len(), length)
+
+			count, err := bitfield.ListCount(test.list)
+			require.NoError(t, err)
+			require.Equal(t, test.count, count)
+			require.Equal(t, test.list.Count(), count)
+
+			indices, err := bitfield.ListIndices(test.list)
+			require.NoError(t, err)
+			require.Equal(t, test.indices, indices)
+
+			_, err = bitfield.ListBit(test.list, length)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestSetListBit(t *testing.T) {
+	list := bitfield.NewList[prysmbitfield.Bitlist](12)
+	require.Equal(t, prysmbitfield.NewBitlist(12), list)
+
+	require.NoError(t, bitfield.SetListBit(list, 11, true))
+	require.NoError(t, bitfield.SetListBit(list, 2, true))
+	set, err := bitfield.ListBit(list, 11)
+	require.NoError(t, err)
+	require.True(t, set)
+	require.True(t, list.BitAt(11))
+	require.True(t, list.BitAt(2))
+
+	require.NoError(t, bitfield.SetListBit(list, 11, false))
+	require.False(t, list.BitAt(11))
+	length, err := bitfield.ListLen(list)
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), length)
+
+	require.EqualError(t, bitfield.SetListBit(list, 12, true), "index 12 out of range for bitlist of length 12")
+}
+
+func TestListCombine(t *testing.T) {
+	list1 := prysmbitfield.Bitlist{0x13}
+	list2 := prysmbitfield.Bitlist{0x16}
+
+	and, err := bitfield.ListAnd(list1, list2)
+	require.NoError(t, err)
+	require.Equal(t, prysmbitfield.Bitlist{0x12}, and)
+
+	or, err := bitfield.ListOr(list1, list2)
+	require.NoError(t, err)
+	require.Equal(t, prysmbitfield.Bitlist{0x17}, or)
+
+	overlaps, err := bitfield.ListOverlaps(list1, list2)
+	require.NoError(t, err)
+	require.True(t, overlaps)
+
+	overlaps, err = bitfield.ListOverlaps(prysmbitfield.Bitlist{0x11}, prysmbitfield.Bitlist{0x12})
+	require.NoError(t, err)
+	require.False(t, overlaps)
+
+	_, err = bitfield.ListOr(list1, prysmbitfield.Bitlist{0x23})
+	require.EqualError(t, err, "bitlist lengths differ (4 != 5)")
+}
+
+func TestListMembers(t *testing.T) {
+	committee := []phase0.ValidatorIndex{10, 20, 30, 40}
+
+	members, err := bitfield.ListMembers(prysmbitfield.Bitlist{0x1a}, committee)
+	require.NoError(t, err)
+	require.Equal(t, []phase0.ValidatorIndex{20, 40}, members)
+
+	_, err = bitfield.ListMembers(prysmbitfield.Bitlist{0x1a}, committee[:3])
+	require.EqualError(t, err, "bitlist length 4 does not match committee size 3")
+}
+
+func TestVector(t *testing.T) {
+	vector := prysmbitfield.NewBitvector512()
+	require.Equal(t, uint64(512), bitfield.VectorLen(vector))
+
+	require.NoError(t, bitfield.SetVectorBit(vector, 0, true))
+	require.NoError(t, bitfield.SetVectorBit(vector, 511, true))
+	require.NoError(t, bitfield.SetVectorBit(vector, 100, true))
+	require.NoError(t, bitfield.SetVectorBit(vector, 100, false))
+	require.EqualError(t, bitfield.SetVectorBit(vector, 512, true), "index 512 out of range for bitvector of length 512")
+
+	set, err := bitfield.VectorBit(vector, 511)
+	require.NoError(t, err)
+	require.True(t, set)
+	require.True(t, vector.BitAt(511))
+	_, err = bitfield.VectorBit(vector, 512)
+	require.EqualError(t, err, "index 512 out of range for bitvector of length 512")
+
+	require.Equal(t, uint64(2), bitfield.VectorCount(vector))
+	require.Equal(t, vector.Count(), bitfield.VectorCount(vector))
+	require.Equal(t, []uint64{0, 511}, bitfield.VectorIndices(vector))
+}
+
+func TestVectorCombine(t *testing.T) {
+	vector1 := prysmbitfield.Bitvector8{0x03}
+	vector2 := prysmbitfield.Bitvector8{0x06}
+
+	and, err := bitfield.VectorAnd(vector1, vector2)
+	require.NoError(t, err)
+	require.Equal(t, prysmbitfield.Bitvector8{0x02}, and)
+
+	or, err := bitfield.VectorOr(vector1, vector2)
+	require.NoError(t, err)
+	require.Equal(t, prysmbitfield.Bitvector8{0x07}, or)
+
+	overlaps, err := bitfield.VectorOverlaps(prysmbitfield.Bitvector8{0x01}, prysmbitfield.Bitvector8{0x02})
+	require.NoError(t, err)
+	require.False(t, overlaps)
+
+	_, err = bitfield.VectorAnd([]byte{0x01}, []byte{0x01, 0x00})
+	require.EqualError(t, err, "bitvector lengths differ (8 != 16)")
+}
+
+func TestVectorMembers(t *testing.T) {
+	committee := make([]phase0.ValidatorIndex, 512)
+	for i := range committee {
+		committee[i] = phase0.ValidatorIndex(1000 + i)
+	}
+	vector := prysmbitfield.NewBitvector512()
+	vector.SetBitAt(3, true)
+	vector.SetBitAt(300, true)
+
+	members, err := bitfield.VectorMembers(vector, committee)
+	require.NoError(t, err)
+	require.Equal(t, []phase0.ValidatorIndex{1003, 1300}, members)
+
+	_, err = bitfield.VectorMembers(vector, committee[:511])
+	require.EqualError(t, err, "bitvector length 512 does not match committee size 511")
+}
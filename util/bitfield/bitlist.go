@@ -0,0 +1,156 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bitfield provides bounds-checked helpers for the SSZ bitlists and bitvectors
+// used by spec types, such as attestation aggregation bits and sync committee bits.
+package bitfield
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/pkg/errors"
+)
+
+// ListLen returns the number of bits in a bitlist, as defined by its trailing length bit.
+func ListLen[T ~[]byte](list T) (uint64, error) {
+	if len(list) == 0 {
+		return 0, errors.New("bitlist is empty")
+	}
+	last := list[len(list)-1]
+	if last == 0 {
+		return 0, errors.New("bitlist has no length bit")
+	}
+
+	return uint64(len(list)-1)*8 + uint64(bits.Len8(last)) - 1, nil
+}
+
+// NewList creates a bitlist able to hold the given number of bits, all of which are unset.
+func NewList[T ~[]byte](length uint64) T {
+	list := make(T, length/8+1)
+	list[length/8] = 1 << (length % 8)
+
+	return list
+}
+
+// ListBit returns the value of the bit at the given index of a bitlist.
+func ListBit[T ~[]byte](list T, index uint64) (bool, error) {
+	length, err := ListLen(list)
+	if err != nil {
+		return false, err
+	}
+	if index >= length {
+		return false, fmt.Errorf("index %d out of range for bitlist of length %d", index, length)
+	}
+
+	return list[index/8]&(1<<(index%8)) != 0, nil
+}
+
+// SetListBit sets the value of the bit at the given index of a bitlist.
+func SetListBit[T ~[]byte](list T, index uint64, value bool) error {
+	length, err := ListLen(list)
+	if err != nil {
+		return err
+	}
+	if index >= length {
+		return fmt.Errorf("index %d out of range for bitlist of length %d", index, length)
+	}
+	setBit(list, index, value)
+
+	return nil
+}
+
+// ListCount returns the number of set bits in a bitlist, excluding its length bit.
+func ListCount[T ~[]byte](list T) (uint64, error) {
+	if _, err := ListLen(list); err != nil {
+		return 0, err
+	}
+
+	// The length bit is always set, so remove it from the count.
+	return countBits(list) - 1, nil
+}
+
+// ListIndices returns the indices of the set bits in a bitlist, in increasing order.
+func ListIndices[T ~[]byte](list T) ([]uint64, error) {
+	length, err := ListLen(list)
+	if err != nil {
+		return nil, err
+	}
+
+	return setIndices(list, length), nil
+}
+
+// ListAnd returns the intersection of two bitlists of the same length.
+func ListAnd[T ~[]byte](list1 T, list2 T) (T, error) {
+	if err := checkListLengths(list1, list2); err != nil {
+		return nil, err
+	}
+
+	// The length bits are common to both lists, so are retained by the intersection.
+	return combine(list1, list2, func(a byte, b byte) byte { return a & b }), nil
+}
+
+// ListOr returns the union of two bitlists of the same length.
+func ListOr[T ~[]byte](list1 T, list2 T) (T, error) {
+	if err := checkListLengths(list1, list2); err != nil {
+		return nil, err
+	}
+
+	return combine(list1, list2, func(a byte, b byte) byte { return a | b }), nil
+}
+
+// ListOverlaps returns true if any bit is set in both bitlists.
+func ListOverlaps[T ~[]byte](list1 T, list2 T) (bool, error) {
+	intersection, err := ListAnd(list1, list2)
+	if err != nil {
+		return false, err
+	}
+	count, err := ListCount(intersection)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// ListMembers returns the members of a committee whose bits are set in the bitlist,
+// for example the validators that took part in an attestation.
+// The length of the bitlist must match the size of the committee.
+func ListMembers[T ~[]byte, M any](list T, committee []M) ([]M, error) {
+	length, err := ListLen(list)
+	if err != nil {
+		return nil, err
+	}
+	if length != uint64(len(committee)) {
+		return nil, fmt.Errorf("bitlist length %d does not match committee size %d", length, len(committee))
+	}
+
+	return members(setIndices(list, length), committee), nil
+}
+
+// checkListLengths returns an error if the two bitlists are invalid or differ in length.
+func checkListLengths[T ~[]byte](list1 T, list2 T) error {
+	length1, err := ListLen(list1)
+	if err != nil {
+		return err
+	}
+	length2, err := ListLen(list2)
+	if err != nil {
+		return err
+	}
+	if length1 != length2 {
+		return fmt.Errorf("bitlist lengths differ (%d != %d)", length1, length2)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitfield
+
+import (
+	"math/bits"
+)
+
+// setBit sets the value of a bit, without bounds checking.
+func setBit(data []byte, index uint64, value bool) {
+	if value {
+		data[index/8] |= 1 << (index % 8)
+	} else {
+		data[index/8] &^= 1 << (index % 8)
+	}
+}
+
+// countBits returns the number of set bits in the data.
+func countBits(data []byte) uint64 {
+	count := 0
+	for _, b := range data {
+		count += bits.OnesCount8(b)
+	}
+
+	return uint64(count)
+}
+
+// setIndices returns the indices of the set bits in the first length bits of the data.
+func setIndices(data []byte, length uint64) []uint64 {
+	indices := make([]uint64, 0)
+	for i := uint64(0); i < length; i++ {
+		if data[i/8]&(1<<(i%8)) != 0 {
+			indices = append(indices, i)
+		}
+	}
+
+	return indices
+}
+
+// combine returns a new bitfield of the same type with each byte the result of the operation.
+func combine[T ~[]byte](data1 T, data2 T, op func(byte, byte) byte) T {
+	res := make(T, len(data1))
+	for i := range data1 {
+		res[i] = op(data1[i], data2[i])
+	}
+
+	return res
+}
+
+// members returns the committee members at the given indices.
+func members[M any](indices []uint64, committee []M) []M {
+	res := make([]M, 0, len(indices))
+	for _, index := range indices {
+		res = append(res, committee[index])
+	}
+
+	return res
+}
@@ -0,0 +1,100 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitfield
+
+import (
+	"fmt"
+)
+
+// VectorLen returns the number of bits in a bitvector.
+func VectorLen[T ~[]byte](vector T) uint64 {
+	return uint64(len(vector)) * 8
+}
+
+// VectorBit returns the value of the bit at the given index of a bitvector.
+func VectorBit[T ~[]byte](vector T, index uint64) (bool, error) {
+	if index >= VectorLen(vector) {
+		return false, fmt.Errorf("index %d out of range for bitvector of length %d", index, VectorLen(vector))
+	}
+
+	return vector[index/8]&(1<<(index%8)) != 0, nil
+}
+
+// SetVectorBit sets the value of the bit at the given index of a bitvector.
+func SetVectorBit[T ~[]byte](vector T, index uint64, value bool) error {
+	if index >= VectorLen(vector) {
+		return fmt.Errorf("index %d out of range for bitvector of length %d", index, VectorLen(vector))
+	}
+	setBit(vector, index, value)
+
+	return nil
+}
+
+// VectorCount returns the number of set bits in a bitvector.
+func VectorCount[T ~[]byte](vector T) uint64 {
+	return countBits(vector)
+}
+
+// VectorIndices returns the indices of the set bits in a bitvector, in increasing order.
+func VectorIndices[T ~[]byte](vector T) []uint64 {
+	return setIndices(vector, VectorLen(vector))
+}
+
+// VectorAnd returns the intersection of two bitvectors of the same length.
+func VectorAnd[T ~[]byte](vector1 T, vector2 T) (T, error) {
+	if err := checkVectorLengths(vector1, vector2); err != nil {
+		return nil, err
+	}
+
+	return combine(vector1, vector2, func(a byte, b byte) byte { return a & b }), nil
+}
+
+// VectorOr returns the union of two bitvectors of the same length.
+func VectorOr[T ~[]byte](vector1 T, vector2 T) (T, error) {
+	if err := checkVectorLengths(vector1, vector2); err != nil {
+		return nil, err
+	}
+
+	return combine(vector1, vector2, func(a byte, b byte) byte { return a | b }), nil
+}
+
+// VectorOverlaps returns true if any bit is set in both bitvectors.
+func VectorOverlaps[T ~[]byte](vector1 T, vector2 T) (bool, error) {
+	intersection, err := VectorAnd(vector1, vector2)
+	if err != nil {
+		return false, err
+	}
+
+	return VectorCount(intersection) > 0, nil
+}
+
+// VectorMembers returns the members of a committee whose bits are set in the bitvector,
+// for example the sync committee members that took part in a sync aggregate.
+// The length of the bitvector must match the size of the committee.
+func VectorMembers[T ~[]byte, M any](vector T, committee []M) ([]M, error) {
+	if VectorLen(vector) != uint64(len(committee)) {
+		return nil, fmt.Errorf("bitvector length %d does not match committee size %d", VectorLen(vector), len(committee))
+	}
+
+	return members(VectorIndices(vector), committee), nil
+}
+
+// checkVectorLengths returns an error if the two bitvectors differ in length.
+func checkVectorLengths[T ~[]byte](vector1 T, vector2 T) error {
+	if len(vector1) != len(vector2) {
+		return fmt.Errorf("bitvector lengths differ (%d != %d)", VectorLen(vector1), VectorLen(vector2))
+	}
+
+	return nil
+}
@@ -0,0 +1,424 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package blockanalysis analyses the contents of beacon blocks, providing the attestation packing
+// efficiency, sync aggregate participation and consensus layer rewards of a block given the state
+// of its parent.
+package blockanalysis
+
+import (
+	"fmt"
+	"math"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/committees"
+	"github.com/pkg/errors"
+)
+
+// Reward weights, as defined in the specification.
+const (
+	timelySourceWeight = 14
+	timelyTargetWeight = 26
+	timelyHeadWeight   = 14
+	syncRewardWeight   = 2
+	proposerWeight     = 8
+	weightDenominator  = 64
+)
+
+var participationFlagWeights = [...]uint64{
+	altair.TimelySourceFlagIndex: timelySourceWeight,
+	altair.TimelyTargetFlagIndex: timelyTargetWeight,
+	altair.TimelyHeadFlagIndex:   timelyHeadWeight,
+}
+
+// Report is the analysis of a block.
+type Report struct {
+	// Slot is the slot of the block.
+	Slot phase0.Slot
+	// Attestations is the number of attestations in the block.
+	Attestations int
+	// IncludedVotes is the number of votes in the attestations of the block, including
+	// votes that had already been included in earlier blocks.
+	IncludedVotes int
+	// NewVotes is the number of votes in the block from validators for which no vote had
+	// previously been included for the relevant epoch.
+	NewVotes int
+	// AvailableVotes is the number of votes that could have been newly included in the block,
+	// being the validators in committees of includable slots for which no vote had been included.
+	AvailableVotes int
+	// AttestationEfficiency is the ratio of new votes to available votes.
+	AttestationEfficiency float64
+	// SyncAggregateParticipants is the number of sync committee members in the sync aggregate.
+	SyncAggregateParticipants int
+	// SyncAggregateParticipation is the ratio of sync aggregate participants to the sync committee size.
+	SyncAggregateParticipation float64
+	// AttestationReward is the proposer reward for the attestations in the block.
+	AttestationReward phase0.Gwei
+	// SyncAggregateReward is the proposer reward for the sync aggregate in the block.
+	SyncAggregateReward phase0.Gwei
+	// SlashingReward is the proposer reward for the slashings in the block.
+	SlashingReward phase0.Gwei
+}
+
+// TotalReward is the total consensus layer reward for the proposer of the block.
+func (r *Report) TotalReward() phase0.Gwei {
+	return r.AttestationReward + r.SyncAggregateReward + r.SlashingReward
+}
+
+// AnalyseBlock analyses a signed block given the state of its parent.
+// The block and state must be Altair or later, and the block must be in the same epoch as the
+// state or the one following it.  If the block is in the following epoch the participation of
+// the state is rotated as at the epoch transition, but other epoch processing, for example of
+// effective balances, is not carried out, so rewards are an estimate.
+// The block is assumed to be valid; in particular the source of its attestations is not checked.
+func AnalyseBlock(block *spec.VersionedSignedBeaconBlock,
+	parentState *spec.VersionedBeaconState,
+	params *Parameters,
+) (
+	*Report,
+	error,
+) {
+	if params == nil {
+		return nil, errors.New("no parameters supplied")
+	}
+	if err := params.check(); err != nil {
+		return nil, err
+	}
+	blockInfo, err := blockFieldsFromBlock(block)
+	if err != nil {
+		return nil, err
+	}
+	stateInfo, err := stateFieldsFromState(parentState)
+	if err != nil {
+		return nil, err
+	}
+
+	slotsPerEpoch := params.Committees.SlotsPerEpoch
+	if blockInfo.slot <= stateInfo.slot {
+		return nil, fmt.Errorf("block slot %d is not after state slot %d", blockInfo.slot, stateInfo.slot)
+	}
+	blockEpoch := phase0.Epoch(uint64(blockInfo.slot) / slotsPerEpoch)
+	stateEpoch := phase0.Epoch(uint64(stateInfo.slot) / slotsPerEpoch)
+	switch {
+	case blockEpoch == stateEpoch:
+	case blockEpoch == stateEpoch+1:
+		stateInfo.previousParticipation = stateInfo.currentParticipation
+		stateInfo.currentParticipation = make([]altair.ParticipationFlags, len(stateInfo.validators))
+	default:
+		return nil, fmt.Errorf("block epoch %d is too far ahead of state epoch %d", blockEpoch, stateEpoch)
+	}
+
+	a := &analyser{
+		params:     params,
+		block:      blockInfo,
+		state:      stateInfo,
+		blockEpoch: blockEpoch,
+		committees: make(map[phase0.Slot][]*apiv1.BeaconCommittee),
+	}
+	a.calculateBaseRewards()
+
+	report := &Report{
+		Slot:         blockInfo.slot,
+		Attestations: len(blockInfo.attestations),
+	}
+	if err := a.analyseAttestations(parentState, report); err != nil {
+		return nil, err
+	}
+	a.analyseSyncAggregate(report)
+	if err := a.analyseSlashings(report); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// analyser holds the working data for the analysis of a block.
+type analyser struct {
+	params                 *Parameters
+	block                  *blockFields
+	state                  *stateFields
+	blockEpoch             phase0.Epoch
+	committees             map[phase0.Slot][]*apiv1.BeaconCommittee
+	totalActiveBalance     phase0.Gwei
+	baseRewardPerIncrement phase0.Gwei
+}
+
+// calculateBaseRewards calculates the total active balance and base reward per increment.
+func (a *analyser) calculateBaseRewards() {
+	total := phase0.Gwei(0)
+	for _, validator := range a.state.validators {
+		if validator.ActivationEpoch <= a.blockEpoch && a.blockEpoch < validator.ExitEpoch {
+			total += validator.EffectiveBalance
+		}
+	}
+	// The total active balance is at least one increment.
+	if total < a.params.EffectiveBalanceIncrement {
+		total = a.params.EffectiveBalanceIncrement
+	}
+	a.totalActiveBalance = total
+	a.baseRewardPerIncrement = a.params.EffectiveBalanceIncrement * phase0.Gwei(a.params.BaseRewardFactor) /
+		phase0.Gwei(integerSquareRoot(uint64(total)))
+}
+
+// baseReward returns the base reward for a validator.
+func (a *analyser) baseReward(index phase0.ValidatorIndex) phase0.Gwei {
+	increments := a.state.validators[index].EffectiveBalance / a.params.EffectiveBalanceIncrement
+
+	return increments * a.baseRewardPerIncrement
+}
+
+// blockRootAtSlot returns the root of the block at the given slot, which must be before the block.
+// Slots after the state's slot have the block's parent as their root.
+func (a *analyser) blockRootAtSlot(slot phase0.Slot) (phase0.Root, error) {
+	if slot >= a.state.slot {
+		return a.block.parentRoot, nil
+	}
+	if uint64(a.state.slot-slot) > uint64(len(a.state.blockRoots)) {
+		return phase0.Root{}, fmt.Errorf("slot %d is too far behind state slot %d", slot, a.state.slot)
+	}
+
+	return a.state.blockRoots[uint64(slot)%uint64(len(a.state.blockRoots))], nil
+}
+
+// participation returns the participation for the given epoch.
+func (a *analyser) participation(epoch phase0.Epoch) []altair.ParticipationFlags {
+	if epoch == a.blockEpoch {
+		return a.state.currentParticipation
+	}
+
+	return a.state.previousParticipation
+}
+
+// slotCommittees returns the committees for the given slot.
+func (a *analyser) slotCommittees(parentState *spec.VersionedBeaconState, slot phase0.Slot) ([]*apiv1.BeaconCommittee, error) {
+	if res, exists := a.committees[slot]; exists {
+		return res, nil
+	}
+
+	epoch := phase0.Epoch(uint64(slot) / a.params.Committees.SlotsPerEpoch)
+	epochCommittees, err := committees.BeaconCommittees(parentState, epoch, a.params.Committees)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to obtain committees for epoch %d", epoch)
+	}
+	for _, committee := range epochCommittees {
+		a.committees[committee.Slot] = append(a.committees[committee.Slot], committee)
+	}
+
+	return a.committees[slot], nil
+}
+
+// analyseAttestations analyses the attestations of the block.
+func (a *analyser) analyseAttestations(parentState *spec.VersionedBeaconState, report *Report) error {
+	slotsPerEpoch := a.params.Committees.SlotsPerEpoch
+
+	// Work out the available votes before the participation is updated by the block's attestations.
+	firstSlot := phase0.Slot(0)
+	if a.block.version >= spec.DataVersionDeneb {
+		// Attestations from the previous epoch onwards are includable.
+		if a.blockEpoch > 0 {
+			firstSlot = phase0.Slot(uint64(a.blockEpoch-1) * slotsPerEpoch)
+		}
+	} else if uint64(a.block.slot) > slotsPerEpoch {
+		firstSlot = a.block.slot - phase0.Slot(slotsPerEpoch)
+	}
+	for slot := firstSlot; uint64(slot)+a.params.MinAttestationInclusionDelay <= uint64(a.block.slot); slot++ {
+		slotCommittees, err := a.slotCommittees(parentState, slot)
+		if err != nil {
+			return err
+		}
+		participation := a.participation(phase0.Epoch(uint64(slot) / slotsPerEpoch))
+		for _, committee := range slotCommittees {
+			for _, index := range committee.Validators {
+				if participation[index] == 0 {
+					report.AvailableVotes++
+				}
+			}
+		}
+	}
+
+	rewardNumerator := uint64(0)
+	// Validators may appear in more than one attestation, so track the votes already counted as new.
+	newVotes := make(map[phase0.Epoch]map[phase0.ValidatorIndex]bool)
+	for i, attestation := range a.block.attestations {
+		if attestation == nil || attestation.Data == nil || attestation.Data.Target == nil {
+			return fmt.Errorf("attestation %d is missing data", i)
+		}
+		data := attestation.Data
+		flags, err := a.attestationFlags(data)
+		if err != nil {
+			return errors.Wrapf(err, "failed to obtain flags for attestation %d", i)
+		}
+
+		slotCommittees, err := a.slotCommittees(parentState, data.Slot)
+		if err != nil {
+			return err
+		}
+		if uint64(data.Index) >= uint64(len(slotCommittees)) {
+			return fmt.Errorf("attestation %d has invalid committee index %d", i, data.Index)
+		}
+		committee := slotCommittees[data.Index]
+		if attestation.AggregationBits.Len() != uint64(len(committee.Validators)) {
+			return fmt.Errorf("attestation %d has %d aggregation bits for a committee of size %d", i, attestation.AggregationBits.Len(), len(committee.Validators))
+		}
+
+		participation := a.participation(data.Target.Epoch)
+		if _, exists := newVotes[data.Target.Epoch]; !exists {
+			newVotes[data.Target.Epoch] = make(map[phase0.ValidatorIndex]bool)
+		}
+		for position, index := range committee.Validators {
+			if !attestation.AggregationBits.BitAt(uint64(position)) {
+				continue
+			}
+			report.IncludedVotes++
+			if participation[index] == 0 && !newVotes[data.Target.Epoch][index] {
+				newVotes[data.Target.Epoch][index] = true
+				report.NewVotes++
+			}
+			for flag, weight := range participationFlagWeights {
+				flagBit := altair.ParticipationFlags(1 << flag)
+				if flags&flagBit != 0 && participation[index]&flagBit == 0 {
+					participation[index] |= flagBit
+					rewardNumerator += uint64(a.baseReward(index)) * weight
+				}
+			}
+		}
+	}
+
+	if report.AvailableVotes > 0 {
+		report.AttestationEfficiency = float64(report.NewVotes) / float64(report.AvailableVotes)
+	}
+	rewardDenominator := uint64((weightDenominator - proposerWeight) * weightDenominator / proposerWeight)
+	report.AttestationReward = phase0.Gwei(rewardNumerator / rewardDenominator)
+
+	return nil
+}
+
+// attestationFlags returns the participation flags earned by an attestation with the given data.
+// This follows get_attestation_participation_flag_indices in the specification.
+func (a *analyser) attestationFlags(data *phase0.AttestationData) (altair.ParticipationFlags, error) {
+	slotsPerEpoch := a.params.Committees.SlotsPerEpoch
+	if data.Slot >= a.block.slot {
+		return 0, fmt.Errorf("attestation slot %d is not before block slot %d", data.Slot, a.block.slot)
+	}
+	inclusionDelay := uint64(a.block.slot - data.Slot)
+
+	targetRoot, err := a.blockRootAtSlot(phase0.Slot(uint64(data.Target.Epoch) * slotsPerEpoch))
+	if err != nil {
+		return 0, err
+	}
+	headRoot, err := a.blockRootAtSlot(data.Slot)
+	if err != nil {
+		return 0, err
+	}
+	matchingTarget := data.Target.Root == targetRoot
+	matchingHead := matchingTarget && data.BeaconBlockRoot == headRoot
+
+	flags := altair.ParticipationFlags(0)
+	if inclusionDelay <= integerSquareRoot(slotsPerEpoch) {
+		flags |= 1 << altair.TimelySourceFlagIndex
+	}
+	if matchingTarget && (a.block.version >= spec.DataVersionDeneb || inclusionDelay <= slotsPerEpoch) {
+		flags |= 1 << altair.TimelyTargetFlagIndex
+	}
+	if matchingHead && inclusionDelay == a.params.MinAttestationInclusionDelay {
+		flags |= 1 << altair.TimelyHeadFlagIndex
+	}
+
+	return flags, nil
+}
+
+// analyseSyncAggregate analyses the sync aggregate of the block.
+// This follows process_sync_aggregate in the specification.
+func (a *analyser) analyseSyncAggregate(report *Report) {
+	syncCommitteeSize := a.params.Committees.SyncCommitteeSize
+	if a.block.syncAggregate != nil {
+		for i := uint64(0); i < syncCommitteeSize && i < a.block.syncAggregate.SyncCommitteeBits.Len(); i++ {
+			if a.block.syncAggregate.SyncCommitteeBits.BitAt(i) {
+				report.SyncAggregateParticipants++
+			}
+		}
+	}
+	report.SyncAggregateParticipation = float64(report.SyncAggregateParticipants) / float64(syncCommitteeSize)
+
+	totalActiveIncrements := a.totalActiveBalance / a.params.EffectiveBalanceIncrement
+	totalBaseRewards := a.baseRewardPerIncrement * totalActiveIncrements
+	maxParticipantRewards := totalBaseRewards * syncRewardWeight / weightDenominator / phase0.Gwei(a.params.Committees.SlotsPerEpoch)
+	participantReward := maxParticipantRewards / phase0.Gwei(syncCommitteeSize)
+	proposerReward := participantReward * proposerWeight / (weightDenominator - proposerWeight)
+	report.SyncAggregateReward = proposerReward * phase0.Gwei(report.SyncAggregateParticipants)
+}
+
+// analyseSlashings analyses the slashings of the block.
+// The proposer receives the whistleblower reward for each validator slashed.
+func (a *analyser) analyseSlashings(report *Report) error {
+	slashed := make(map[phase0.ValidatorIndex]bool)
+	slash := func(index phase0.ValidatorIndex) error {
+		if uint64(index) >= uint64(len(a.state.validators)) {
+			return fmt.Errorf("slashed validator %d unknown", index)
+		}
+		validator := a.state.validators[index]
+		if validator.Slashed || slashed[index] {
+			return nil
+		}
+		slashed[index] = true
+		report.SlashingReward += validator.EffectiveBalance / phase0.Gwei(a.params.WhistleblowerRewardQuotient)
+
+		return nil
+	}
+
+	for i, slashing := range a.block.proposerSlashings {
+		if slashing == nil || slashing.SignedHeader1 == nil || slashing.SignedHeader1.Message == nil {
+			return fmt.Errorf("proposer slashing %d is missing data", i)
+		}
+		if err := slash(slashing.SignedHeader1.Message.ProposerIndex); err != nil {
+			return err
+		}
+	}
+
+	for i, slashing := range a.block.attesterSlashings {
+		if slashing == nil || slashing.Attestation1 == nil || slashing.Attestation2 == nil {
+			return fmt.Errorf("attester slashing %d is missing data", i)
+		}
+		attesting := make(map[uint64]bool, len(slashing.Attestation1.AttestingIndices))
+		for _, index := range slashing.Attestation1.AttestingIndices {
+			attesting[index] = true
+		}
+		for _, index := range slashing.Attestation2.AttestingIndices {
+			if !attesting[index] {
+				continue
+			}
+			if err := slash(phase0.ValidatorIndex(index)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// integerSquareRoot returns the largest integer whose square is not greater than n.
+func integerSquareRoot(n uint64) uint64 {
+	res := uint64(math.Sqrt(float64(n)))
+	// Correct for floating point inaccuracy with large values.
+	for res*res > n {
+		res--
+	}
+	for (res+1)*(res+1) <= n {
+		res++
+	}
+
+	return res
+}
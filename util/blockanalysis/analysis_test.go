@@ -0,0 +1,281 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockanalysis_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/blockanalysis"
+	"github.com/attestantio/go-eth2-client/util/committees"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	farFutureEpoch   = phase0.Epoch(0xffffffffffffffff)
+	numValidators    = 64
+	effectiveBalance = phase0.Gwei(32000000000)
+)
+
+func testParams() *blockanalysis.Parameters {
+	return &blockanalysis.Parameters{
+		Committees: &committees.Parameters{
+			SlotsPerEpoch:             8,
+			ShuffleRoundCount:         10,
+			TargetCommitteeSize:       4,
+			MaxCommitteesPerSlot:      4,
+			EpochsPerHistoricalVector: 16,
+			MinSeedLookahead:          1,
+			MaxEffectiveBalance:       effectiveBalance,
+			SyncCommitteeSize:         32,
+			DomainBeaconAttester:      phase0.DomainType{0x01, 0x00, 0x00, 0x00},
+		},
+		BaseRewardFactor:             64,
+		EffectiveBalanceIncrement:    1000000000,
+		MinAttestationInclusionDelay: 1,
+		WhistleblowerRewardQuotient:  512,
+	}
+}
+
+func testState(slot phase0.Slot) *spec.VersionedBeaconState {
+	validators := make([]*phase0.Validator, numValidators)
+	for i := range validators {
+		validators[i] = &phase0.Validator{
+			EffectiveBalance: effectiveBalance,
+			ExitEpoch:        farFutureEpoch,
+		}
+	}
+	randaoMixes := make([]phase0.Root, 16)
+	for i := range randaoMixes {
+		randaoMixes[i] = phase0.Root{byte(i), 0x5a}
+	}
+	blockRoots := make([]phase0.Root, 64)
+	for i := range blockRoots {
+		blockRoots[i] = phase0.Root{0xb0, byte(i)}
+	}
+
+	return &spec.VersionedBeaconState{
+		Version: spec.DataVersionAltair,
+		Altair: &altair.BeaconState{
+			Slot:                       slot,
+			Validators:                 validators,
+			RANDAOMixes:                randaoMixes,
+			BlockRoots:                 blockRoots,
+			PreviousEpochParticipation: make([]altair.ParticipationFlags, numValidators),
+			CurrentEpochParticipation:  make([]altair.ParticipationFlags, numValidators),
+		},
+	}
+}
+
+func testBlock(slot phase0.Slot, parentRoot phase0.Root, attestations []*phase0.Attestation, syncBits int) *spec.VersionedSignedBeaconBlock {
+	syncCommitteeBits := bitfield.NewBitvector512()
+	for i := 0; i < syncBits; i++ {
+		syncCommitteeBits.SetBitAt(uint64(i), true)
+	}
+
+	return &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionAltair,
+		Altair: &altair.SignedBeaconBlock{
+			Message: &altair.BeaconBlock{
+				Slot:       slot,
+				ParentRoot: parentRoot,
+				Body: &altair.BeaconBlockBody{
+					Attestations: attestations,
+					SyncAggregate: &altair.SyncAggregate{
+						SyncCommitteeBits: syncCommitteeBits,
+					},
+					ProposerSlashings: []*phase0.ProposerSlashing{},
+					AttesterSlashings: []*phase0.AttesterSlashing{},
+				},
+			},
+		},
+	}
+}
+
+// testAttestation creates an attestation with all members of the committee voting.
+func testAttestation(committee []phase0.ValidatorIndex,
+	slot phase0.Slot,
+	index phase0.CommitteeIndex,
+	head phase0.Root,
+	target *phase0.Checkpoint,
+) *phase0.Attestation {
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	for i := range committee {
+		bits.SetBitAt(uint64(i), true)
+	}
+
+	return &phase0.Attestation{
+		AggregationBits: bits,
+		Data: &phase0.AttestationData{
+			Slot:            slot,
+			Index:           index,
+			BeaconBlockRoot: head,
+			Source:          &phase0.Checkpoint{},
+			Target:          target,
+		},
+	}
+}
+
+func TestAnalyseBlock(t *testing.T) {
+	params := testParams()
+	state := testState(10)
+	parentRoot := phase0.Root{0xaa}
+
+	epochCommittees, err := committees.BeaconCommittees(state, 1, params.Committees)
+	require.NoError(t, err)
+	slotCommittees := make(map[phase0.Slot][][]phase0.ValidatorIndex)
+	for _, committee := range epochCommittees {
+		slotCommittees[committee.Slot] = append(slotCommittees[committee.Slot], committee.Validators)
+	}
+	// 64 validators over 8 slots gives 2 committees of 4 validators per slot.
+	require.Len(t, slotCommittees[10], 2)
+	require.Len(t, slotCommittees[10][0], 4)
+
+	target := &phase0.Checkpoint{Epoch: 1, Root: phase0.Root{0xb0, 8}}
+	attestations := []*phase0.Attestation{
+		// Correct head and target, included at the earliest slot.
+		testAttestation(slotCommittees[10][0], 10, 0, parentRoot, target),
+		// Duplicate of the above.
+		testAttestation(slotCommittees[10][0], 10, 0, parentRoot, target),
+		// Incorrect head.
+		testAttestation(slotCommittees[9][1], 9, 1, phase0.Root{0x01}, target),
+	}
+
+	report, err := blockanalysis.AnalyseBlock(testBlock(11, parentRoot, attestations, 16), state, params)
+	require.NoError(t, err)
+
+	require.Equal(t, phase0.Slot(11), report.Slot)
+	require.Equal(t, 3, report.Attestations)
+	require.Equal(t, 12, report.IncludedVotes)
+	require.Equal(t, 8, report.NewVotes)
+	// Slots 3 to 10 are includable, each with 8 validators.
+	require.Equal(t, 64, report.AvailableVotes)
+	require.Equal(t, 0.125, report.AttestationEfficiency)
+	require.Equal(t, 16, report.SyncAggregateParticipants)
+	require.Equal(t, 0.5, report.SyncAggregateParticipation)
+
+	// Base reward per increment is 1e9 * 64 / isqrt(64 * 32e9) = 44721, so the base reward is 32 * 44721.
+	baseReward := uint64(32 * 44721)
+	// Four validators earn source, target and head; four earn source and target.
+	numerator := 4*baseReward*(14+26+14) + 4*baseReward*(14+26)
+	require.Equal(t, phase0.Gwei(numerator/448), report.AttestationReward)
+	// Sync reward per participant is total base rewards * 2 / 64 / 8 / 32 * 8 / 56.
+	participantReward := uint64(44721*64*32) * 2 / 64 / 8 / 32
+	require.Equal(t, phase0.Gwei(16*(participantReward*8/56)), report.SyncAggregateReward)
+	require.Equal(t, phase0.Gwei(0), report.SlashingReward)
+	require.Equal(t, report.AttestationReward+report.SyncAggregateReward, report.TotalReward())
+}
+
+func TestAnalyseBlockSlashings(t *testing.T) {
+	params := testParams()
+	state := testState(10)
+	state.Altair.Validators[3].Slashed = true
+
+	block := testBlock(11, phase0.Root{}, []*phase0.Attestation{}, 0)
+	block.Altair.Message.Body.ProposerSlashings = []*phase0.ProposerSlashing{
+		{SignedHeader1: &phase0.SignedBeaconBlockHeader{Message: &phase0.BeaconBlockHeader{ProposerIndex: 1}}},
+	}
+	block.Altair.Message.Body.AttesterSlashings = []*phase0.AttesterSlashing{
+		{
+			Attestation1: &phase0.IndexedAttestation{AttestingIndices: []uint64{1, 2, 3, 4}},
+			Attestation2: &phase0.IndexedAttestation{AttestingIndices: []uint64{1, 3, 4, 5}},
+		},
+	}
+
+	report, err := blockanalysis.AnalyseBlock(block, state, params)
+	require.NoError(t, err)
+	// Validator 1 is slashed once, validator 3 is already slashed and validator 4 is slashed.
+	require.Equal(t, 2*effectiveBalance/512, report.SlashingReward)
+}
+
+func TestAnalyseBlockErrors(t *testing.T) {
+	params := testParams()
+	state := testState(10)
+
+	tests := []struct {
+		name   string
+		block  *spec.VersionedSignedBeaconBlock
+		state  *spec.VersionedBeaconState
+		params *blockanalysis.Parameters
+		err    string
+	}{
+		{
+			name:  "ParamsMissing",
+			block: testBlock(11, phase0.Root{}, nil, 0),
+			state: state,
+			err:   "no parameters supplied",
+		},
+		{
+			name:   "BlockMissing",
+			state:  state,
+			params: params,
+			err:    "no block supplied",
+		},
+		{
+			name:   "Phase0Block",
+			block:  &spec.VersionedSignedBeaconBlock{Version: spec.DataVersionPhase0},
+			state:  state,
+			params: params,
+			err:    "unsupported block version phase0",
+		},
+		{
+			name:   "StateMissing",
+			block:  testBlock(11, phase0.Root{}, nil, 0),
+			params: params,
+			err:    "no state supplied",
+		},
+		{
+			name:   "BlockBeforeState",
+			block:  testBlock(10, phase0.Root{}, nil, 0),
+			state:  state,
+			params: params,
+			err:    "block slot 10 is not after state slot 10",
+		},
+		{
+			name:   "BlockTooFarAhead",
+			block:  testBlock(24, phase0.Root{}, nil, 0),
+			state:  state,
+			params: params,
+			err:    "block epoch 3 is too far ahead of state epoch 1",
+		},
+		{
+			name: "CommitteeIndexInvalid",
+			block: testBlock(11, phase0.Root{}, []*phase0.Attestation{
+				testAttestation([]phase0.ValidatorIndex{1}, 10, 5, phase0.Root{}, &phase0.Checkpoint{Epoch: 1}),
+			}, 0),
+			state:  state,
+			params: params,
+			err:    "attestation 0 has invalid committee index 5",
+		},
+		{
+			name: "AggregationBitsInvalid",
+			block: testBlock(11, phase0.Root{}, []*phase0.Attestation{
+				testAttestation([]phase0.ValidatorIndex{1}, 10, 0, phase0.Root{}, &phase0.Checkpoint{Epoch: 1}),
+			}, 0),
+			state:  state,
+			params: params,
+			err:    "attestation 0 has 1 aggregation bits for a committee of size 4",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := blockanalysis.AnalyseBlock(test.block, test.state, test.params)
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
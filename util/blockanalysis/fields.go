@@ -0,0 +1,176 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockanalysis
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// blockFields are the fields of a block used in analysis.
+type blockFields struct {
+	version           spec.DataVersion
+	slot              phase0.Slot
+	parentRoot        phase0.Root
+	attestations      []*phase0.Attestation
+	syncAggregate     *altair.SyncAggregate
+	proposerSlashings []*phase0.ProposerSlashing
+	attesterSlashings []*phase0.AttesterSlashing
+}
+
+// blockFieldsFromBlock obtains the fields used in analysis from a versioned block.
+func blockFieldsFromBlock(block *spec.VersionedSignedBeaconBlock) (*blockFields, error) {
+	if block == nil {
+		return nil, errors.New("no block supplied")
+	}
+
+	var syncAggregate *altair.SyncAggregate
+	switch block.Version {
+	case spec.DataVersionAltair:
+		if block.Altair == nil || block.Altair.Message == nil || block.Altair.Message.Body == nil {
+			return nil, errors.New("no altair block")
+		}
+		syncAggregate = block.Altair.Message.Body.SyncAggregate
+	case spec.DataVersionBellatrix:
+		if block.Bellatrix == nil || block.Bellatrix.Message == nil || block.Bellatrix.Message.Body == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+		syncAggregate = block.Bellatrix.Message.Body.SyncAggregate
+	case spec.DataVersionCapella:
+		if block.Capella == nil || block.Capella.Message == nil || block.Capella.Message.Body == nil {
+			return nil, errors.New("no capella block")
+		}
+		syncAggregate = block.Capella.Message.Body.SyncAggregate
+	case spec.DataVersionDeneb:
+		if block.Deneb == nil || block.Deneb.Message == nil || block.Deneb.Message.Body == nil {
+			return nil, errors.New("no deneb block")
+		}
+		syncAggregate = block.Deneb.Message.Body.SyncAggregate
+	default:
+		return nil, fmt.Errorf("unsupported block version %s", block.Version)
+	}
+
+	slot, err := block.Slot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain block slot")
+	}
+	parentRoot, err := block.ParentRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain block parent root")
+	}
+	attestations, err := block.Attestations()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain block attestations")
+	}
+	proposerSlashings, err := block.ProposerSlashings()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain block proposer slashings")
+	}
+	attesterSlashings, err := block.AttesterSlashings()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain block attester slashings")
+	}
+
+	return &blockFields{
+		version:           block.Version,
+		slot:              slot,
+		parentRoot:        parentRoot,
+		attestations:      attestations,
+		syncAggregate:     syncAggregate,
+		proposerSlashings: proposerSlashings,
+		attesterSlashings: attesterSlashings,
+	}, nil
+}
+
+// stateFields are the fields of a state used in analysis.
+type stateFields struct {
+	slot                  phase0.Slot
+	validators            []*phase0.Validator
+	blockRoots            []phase0.Root
+	previousParticipation []altair.ParticipationFlags
+	currentParticipation  []altair.ParticipationFlags
+}
+
+// stateFieldsFromState obtains the fields used in analysis from a versioned state.
+// The participation is copied, as it is updated during analysis.
+func stateFieldsFromState(state *spec.VersionedBeaconState) (*stateFields, error) {
+	if state == nil {
+		return nil, errors.New("no state supplied")
+	}
+
+	var res *stateFields
+	switch state.Version {
+	case spec.DataVersionAltair:
+		if state.Altair == nil {
+			return nil, errors.New("no altair state")
+		}
+		res = &stateFields{
+			slot:                  state.Altair.Slot,
+			validators:            state.Altair.Validators,
+			blockRoots:            state.Altair.BlockRoots,
+			previousParticipation: state.Altair.PreviousEpochParticipation,
+			currentParticipation:  state.Altair.CurrentEpochParticipation,
+		}
+	case spec.DataVersionBellatrix:
+		if state.Bellatrix == nil {
+			return nil, errors.New("no bellatrix state")
+		}
+		res = &stateFields{
+			slot:                  state.Bellatrix.Slot,
+			validators:            state.Bellatrix.Validators,
+			blockRoots:            state.Bellatrix.BlockRoots,
+			previousParticipation: state.Bellatrix.PreviousEpochParticipation,
+			currentParticipation:  state.Bellatrix.CurrentEpochParticipation,
+		}
+	case spec.DataVersionCapella:
+		if state.Capella == nil {
+			return nil, errors.New("no capella state")
+		}
+		res = &stateFields{
+			slot:                  state.Capella.Slot,
+			validators:            state.Capella.Validators,
+			blockRoots:            state.Capella.BlockRoots,
+			previousParticipation: state.Capella.PreviousEpochParticipation,
+			currentParticipation:  state.Capella.CurrentEpochParticipation,
+		}
+	case spec.DataVersionDeneb:
+		if state.Deneb == nil {
+			return nil, errors.New("no deneb state")
+		}
+		res = &stateFields{
+			slot:                  state.Deneb.Slot,
+			validators:            state.Deneb.Validators,
+			blockRoots:            state.Deneb.BlockRoots,
+			previousParticipation: state.Deneb.PreviousEpochParticipation,
+			currentParticipation:  state.Deneb.CurrentEpochParticipation,
+		}
+	default:
+		return nil, fmt.Errorf("unsupported state version %s", state.Version)
+	}
+
+	if len(res.blockRoots) == 0 {
+		return nil, errors.New("state has no block roots")
+	}
+	if len(res.previousParticipation) != len(res.validators) || len(res.currentParticipation) != len(res.validators) {
+		return nil, errors.New("state participation does not match validators")
+	}
+	res.previousParticipation = append([]altair.ParticipationFlags(nil), res.previousParticipation...)
+	res.currentParticipation = append([]altair.ParticipationFlags(nil), res.currentParticipation...)
+
+	return res, nil
+}
@@ -0,0 +1,86 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockanalysis
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/committees"
+	"github.com/pkg/errors"
+)
+
+// Parameters are the spec parameters required to analyse blocks.
+type Parameters struct {
+	Committees                   *committees.Parameters
+	BaseRewardFactor             uint64
+	EffectiveBalanceIncrement    phase0.Gwei
+	MinAttestationInclusionDelay uint64
+	WhistleblowerRewardQuotient  uint64
+}
+
+// ParametersFromSpec obtains the analysis parameters from the spec as returned by a SpecProvider.
+func ParametersFromSpec(specValues map[string]interface{}) (*Parameters, error) {
+	committeeParams, err := committees.ParametersFromSpec(specValues)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]uint64)
+	for _, key := range []string{
+		"BASE_REWARD_FACTOR",
+		"EFFECTIVE_BALANCE_INCREMENT",
+		"MIN_ATTESTATION_INCLUSION_DELAY",
+		"WHISTLEBLOWER_REWARD_QUOTIENT",
+	} {
+		tmp, exists := specValues[key]
+		if !exists {
+			return nil, fmt.Errorf("%s not found in spec", key)
+		}
+		value, isValue := tmp.(uint64)
+		if !isValue {
+			return nil, fmt.Errorf("%s of unexpected type", key)
+		}
+		values[key] = value
+	}
+
+	return &Parameters{
+		Committees:                   committeeParams,
+		BaseRewardFactor:             values["BASE_REWARD_FACTOR"],
+		EffectiveBalanceIncrement:    phase0.Gwei(values["EFFECTIVE_BALANCE_INCREMENT"]),
+		MinAttestationInclusionDelay: values["MIN_ATTESTATION_INCLUSION_DELAY"],
+		WhistleblowerRewardQuotient:  values["WHISTLEBLOWER_REWARD_QUOTIENT"],
+	}, nil
+}
+
+// check returns an error if the parameters cannot be used for analysis.
+func (p *Parameters) check() error {
+	if p.Committees == nil {
+		return errors.New("no committee parameters")
+	}
+	if p.Committees.SlotsPerEpoch == 0 {
+		return errors.New("slots per epoch cannot be 0")
+	}
+	if p.Committees.SyncCommitteeSize == 0 {
+		return errors.New("sync committee size cannot be 0")
+	}
+	if p.EffectiveBalanceIncrement == 0 {
+		return errors.New("effective balance increment cannot be 0")
+	}
+	if p.WhistleblowerRewardQuotient == 0 {
+		return errors.New("whistleblower reward quotient cannot be 0")
+	}
+
+	return nil
+}
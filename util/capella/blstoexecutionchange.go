@@ -0,0 +1,98 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/signing"
+	"github.com/pkg/errors"
+)
+
+// BLSToExecutionChangeDomain computes the domain for BLS to execution changes.
+// BLS to execution changes are always signed with the genesis fork version regardless of the current fork,
+// so that the resultant signature remains valid across forks.  The genesis fork version and genesis validators
+// root are available from a GenesisProvider.
+func BLSToExecutionChangeDomain(genesisForkVersion phase0.Version, genesisValidatorsRoot phase0.Root) (phase0.Domain, error) {
+	return signing.ComputeDomain(phase0.DomainBLSToExecutionChange, genesisForkVersion, genesisValidatorsRoot)
+}
+
+// BLSToExecutionChangeSigningRoot computes the root that must be signed for the given BLS to execution change to be valid,
+// given the domain obtained from BLSToExecutionChangeDomain.
+func BLSToExecutionChangeSigningRoot(change *capella.BLSToExecutionChange, domain phase0.Domain) (phase0.Root, error) {
+	if change == nil {
+		return phase0.Root{}, errors.New("no BLS to execution change supplied")
+	}
+
+	return signing.ComputeSigningRoot(change, domain)
+}
+
+// CheckBLSToExecutionChange checks that the BLS to execution change can be applied to the given validator,
+// that is that the validator has BLS withdrawal credentials and that they were generated from the
+// public key in the change.
+func CheckBLSToExecutionChange(validator *phase0.Validator, change *capella.BLSToExecutionChange) error {
+	if validator == nil {
+		return errors.New("no validator supplied")
+	}
+	if change == nil {
+		return errors.New("no BLS to execution change supplied")
+	}
+
+	withdrawalCredentials, err := validator.Credentials()
+	if err != nil {
+		return fmt.Errorf("validator %d has invalid withdrawal credentials", change.ValidatorIndex)
+	}
+	if !withdrawalCredentials.IsBLS() {
+		return fmt.Errorf("validator %d does not have BLS withdrawal credentials (prefix %#02x)", change.ValidatorIndex, withdrawalCredentials.Prefix())
+	}
+	if withdrawalCredentials != phase0.NewBLSWithdrawalCredentials(change.FromBLSPubkey) {
+		return fmt.Errorf("validator %d withdrawal credentials do not match public key", change.ValidatorIndex)
+	}
+
+	return nil
+}
+
+// CheckBLSToExecutionChanges checks that a batch of signed BLS to execution changes can be applied to the
+// supplied validators, as obtained from a ValidatorsProvider.  It is intended to be called prior to submission,
+// to provide a clear error for changes that the beacon node would otherwise reject.
+func CheckBLSToExecutionChanges(validators map[phase0.ValidatorIndex]*phase0.Validator,
+	changes []*capella.SignedBLSToExecutionChange,
+) error {
+	if len(changes) == 0 {
+		return errors.New("no BLS to execution changes supplied")
+	}
+
+	seen := make(map[phase0.ValidatorIndex]struct{}, len(changes))
+	for i, change := range changes {
+		if change == nil || change.Message == nil {
+			return fmt.Errorf("BLS to execution change %d is empty", i)
+		}
+		if _, exists := seen[change.Message.ValidatorIndex]; exists {
+			return fmt.Errorf("multiple BLS to execution changes for validator %d", change.Message.ValidatorIndex)
+		}
+		seen[change.Message.ValidatorIndex] = struct{}{}
+
+		validator, exists := validators[change.Message.ValidatorIndex]
+		if !exists {
+			return fmt.Errorf("validator %d not found", change.Message.ValidatorIndex)
+		}
+		if err := CheckBLSToExecutionChange(validator, change.Message); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
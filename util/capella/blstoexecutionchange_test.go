@@ -0,0 +1,128 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	utilcapella "github.com/attestantio/go-eth2-client/util/capella"
+	"github.com/stretchr/testify/require"
+)
+
+func blsValidator(pubKey phase0.BLSPubKey, prefix byte) *phase0.Validator {
+	hash := sha256.Sum256(pubKey[:])
+	credentials := make([]byte, 32)
+	credentials[0] = prefix
+	copy(credentials[1:], hash[1:])
+
+	return &phase0.Validator{
+		WithdrawalCredentials: credentials,
+	}
+}
+
+func blsChange(index phase0.ValidatorIndex, pubKey phase0.BLSPubKey) *capella.SignedBLSToExecutionChange {
+	return &capella.SignedBLSToExecutionChange{
+		Message: &capella.BLSToExecutionChange{
+			ValidatorIndex: index,
+			FromBLSPubkey:  pubKey,
+		},
+	}
+}
+
+func TestCheckBLSToExecutionChanges(t *testing.T) {
+	pubKey1 := phase0.BLSPubKey{0x01}
+	pubKey2 := phase0.BLSPubKey{0x02}
+	validators := map[phase0.ValidatorIndex]*phase0.Validator{
+		1: blsValidator(pubKey1, 0x00),
+		2: blsValidator(pubKey2, 0x01),
+	}
+
+	tests := []struct {
+		name    string
+		changes []*capella.SignedBLSToExecutionChange
+		err     string
+	}{
+		{
+			name: "Empty",
+			err:  "no BLS to execution changes supplied",
+		},
+		{
+			name:    "ChangeNil",
+			changes: []*capella.SignedBLSToExecutionChange{nil},
+			err:     "BLS to execution change 0 is empty",
+		},
+		{
+			name:    "Duplicate",
+			changes: []*capella.SignedBLSToExecutionChange{blsChange(1, pubKey1), blsChange(1, pubKey1)},
+			err:     "multiple BLS to execution changes for validator 1",
+		},
+		{
+			name:    "ValidatorUnknown",
+			changes: []*capella.SignedBLSToExecutionChange{blsChange(3, pubKey1)},
+			err:     "validator 3 not found",
+		},
+		{
+			name:    "PrefixWrong",
+			changes: []*capella.SignedBLSToExecutionChange{blsChange(2, pubKey2)},
+			err:     "validator 2 does not have BLS withdrawal credentials (prefix 0x01)",
+		},
+		{
+			name:    "PubKeyWrong",
+			changes: []*capella.SignedBLSToExecutionChange{blsChange(1, pubKey2)},
+			err:     "validator 1 withdrawal credentials do not match public key",
+		},
+		{
+			name:    "Good",
+			changes: []*capella.SignedBLSToExecutionChange{blsChange(1, pubKey1)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := utilcapella.CheckBLSToExecutionChanges(validators, test.changes)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBLSToExecutionChangeSigningRoot(t *testing.T) {
+	domain, err := utilcapella.BLSToExecutionChangeDomain(phase0.Version{0x00, 0x00, 0x00, 0x00}, phase0.Root{})
+	require.NoError(t, err)
+	require.Equal(t, phase0.DomainBLSToExecutionChange[:], domain[:4])
+
+	change := blsChange(1, phase0.BLSPubKey{0x01}).Message
+	root, err := utilcapella.BLSToExecutionChangeSigningRoot(change, domain)
+	require.NoError(t, err)
+
+	objectRoot, err := change.HashTreeRoot()
+	require.NoError(t, err)
+	expected, err := (&phase0.SigningData{ObjectRoot: objectRoot, Domain: domain}).HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root(expected), root)
+
+	// The domain depends only on genesis values.
+	otherDomain, err := utilcapella.BLSToExecutionChangeDomain(phase0.Version{0x03, 0x00, 0x00, 0x00}, phase0.Root{})
+	require.NoError(t, err)
+	require.NotEqual(t, domain, otherDomain)
+
+	_, err = utilcapella.BLSToExecutionChangeSigningRoot(nil, domain)
+	require.EqualError(t, err, "no BLS to execution change supplied")
+}
@@ -0,0 +1,186 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SweepParameters are the spec parameters required to estimate the withdrawal sweep.
+type SweepParameters struct {
+	SlotsPerEpoch                    uint64
+	MaxEffectiveBalance              phase0.Gwei
+	MaxWithdrawalsPerPayload         uint64
+	MaxValidatorsPerWithdrawalsSweep uint64
+}
+
+// SweepParametersFromSpec obtains the sweep parameters from the spec as returned by a SpecProvider.
+func SweepParametersFromSpec(specValues map[string]interface{}) (*SweepParameters, error) {
+	values := make(map[string]uint64)
+	for _, key := range []string{
+		"SLOTS_PER_EPOCH",
+		"MAX_EFFECTIVE_BALANCE",
+		"MAX_WITHDRAWALS_PER_PAYLOAD",
+		"MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP",
+	} {
+		tmp, exists := specValues[key]
+		if !exists {
+			return nil, fmt.Errorf("%s not found in spec", key)
+		}
+		value, isValue := tmp.(uint64)
+		if !isValue {
+			return nil, fmt.Errorf("%s of unexpected type", key)
+		}
+		values[key] = value
+	}
+
+	return &SweepParameters{
+		SlotsPerEpoch:                    values["SLOTS_PER_EPOCH"],
+		MaxEffectiveBalance:              phase0.Gwei(values["MAX_EFFECTIVE_BALANCE"]),
+		MaxWithdrawalsPerPayload:         values["MAX_WITHDRAWALS_PER_PAYLOAD"],
+		MaxValidatorsPerWithdrawalsSweep: values["MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP"],
+	}, nil
+}
+
+// SweepEstimate is an estimate of when a validator will next be reached by the withdrawal sweep.
+type SweepEstimate struct {
+	// SweepIndex is the validator index at which the next sweep will start.
+	SweepIndex phase0.ValidatorIndex
+	// Slots is the number of blocks until the validator is reached by the sweep,
+	// where 0 means the validator will be reached by the next block.
+	Slots uint64
+	// Slot is the estimated slot at which the validator will be reached by the sweep,
+	// assuming that there are no missed slots.
+	Slot phase0.Slot
+	// Withdrawable is true if the validator currently has a balance that would be withdrawn
+	// when it is reached by the sweep.
+	Withdrawable bool
+}
+
+// EstimateWithdrawalSweep estimates when the given validator will next be reached by the
+// withdrawal sweep, given a Capella or later state.
+// The estimate assumes that a block is produced in every slot and that validator balances
+// do not change between the state and the sweep reaching the validator.
+func EstimateWithdrawalSweep(state *spec.VersionedBeaconState,
+	index phase0.ValidatorIndex,
+	params *SweepParameters,
+) (
+	*SweepEstimate,
+	error,
+) {
+	if state == nil {
+		return nil, errors.New("no state supplied")
+	}
+	if params == nil {
+		return nil, errors.New("no parameters supplied")
+	}
+	if params.SlotsPerEpoch == 0 {
+		return nil, errors.New("slots per epoch cannot be 0")
+	}
+	if params.MaxWithdrawalsPerPayload == 0 {
+		return nil, errors.New("max withdrawals per payload cannot be 0")
+	}
+	if params.MaxValidatorsPerWithdrawalsSweep == 0 {
+		return nil, errors.New("max validators per withdrawals sweep cannot be 0")
+	}
+
+	sweepIndex, err := state.NextWithdrawalValidatorIndex()
+	if err != nil {
+		return nil, err
+	}
+	validators, err := state.Validators()
+	if err != nil {
+		return nil, err
+	}
+	balances, err := state.Balances()
+	if err != nil {
+		return nil, err
+	}
+	slot, err := state.Slot()
+	if err != nil {
+		return nil, err
+	}
+	if len(validators) != len(balances) {
+		return nil, errors.New("mismatch between validators and balances")
+	}
+	if int(index) >= len(validators) {
+		return nil, fmt.Errorf("validator %d not present in state", index)
+	}
+	if int(sweepIndex) >= len(validators) {
+		return nil, fmt.Errorf("sweep index %d outside of validator set", sweepIndex)
+	}
+
+	epoch := phase0.Epoch(uint64(slot) / params.SlotsPerEpoch)
+	bound := uint64(len(validators))
+	if bound > params.MaxValidatorsPerWithdrawalsSweep {
+		bound = params.MaxValidatorsPerWithdrawalsSweep
+	}
+
+	estimate := &SweepEstimate{
+		SweepIndex: sweepIndex,
+	}
+
+	// Walk the validators from the current sweep position, tracking the number of
+	// validators scanned and withdrawals made for each block.
+	scanned := uint64(0)
+	withdrawals := uint64(0)
+	validatorIndex := uint64(sweepIndex)
+	for i := 0; i < len(validators); i++ {
+		withdrawable := isWithdrawable(validators[validatorIndex], balances[validatorIndex], epoch, params)
+		if phase0.ValidatorIndex(validatorIndex) == index {
+			estimate.Withdrawable = withdrawable
+			break
+		}
+
+		scanned++
+		if withdrawable {
+			withdrawals++
+		}
+		if withdrawals == params.MaxWithdrawalsPerPayload || scanned == bound {
+			// This block is full; move on to the next.
+			estimate.Slots++
+			scanned = 0
+			withdrawals = 0
+		}
+		validatorIndex = (validatorIndex + 1) % uint64(len(validators))
+	}
+	estimate.Slot = slot + 1 + phase0.Slot(estimate.Slots)
+
+	return estimate, nil
+}
+
+// isWithdrawable returns true if the validator is either fully or partially withdrawable.
+func isWithdrawable(validator *phase0.Validator,
+	balance phase0.Gwei,
+	epoch phase0.Epoch,
+	params *SweepParameters,
+) bool {
+	if validator == nil ||
+		len(validator.WithdrawalCredentials) == 0 ||
+		validator.WithdrawalCredentials[0] != phase0.ExecutionWithdrawalPrefix {
+		return false
+	}
+
+	// Fully withdrawable.
+	if validator.WithdrawableEpoch <= epoch && balance > 0 {
+		return true
+	}
+
+	// Partially withdrawable.
+	return validator.EffectiveBalance == params.MaxEffectiveBalance && balance > params.MaxEffectiveBalance
+}
@@ -0,0 +1,134 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	utilcapella "github.com/attestantio/go-eth2-client/util/capella"
+	"github.com/stretchr/testify/require"
+)
+
+func sweepState(balance phase0.Gwei) *spec.VersionedBeaconState {
+	validators := make([]*phase0.Validator, 10)
+	balances := make([]phase0.Gwei, 10)
+	for i := range validators {
+		validators[i] = &phase0.Validator{
+			WithdrawalCredentials: append([]byte{0x01}, make([]byte, 31)...),
+			EffectiveBalance:      32000000000,
+			WithdrawableEpoch:     0xffffffffffffffff,
+		}
+		balances[i] = balance
+	}
+
+	return &spec.VersionedBeaconState{
+		Version: spec.DataVersionCapella,
+		Capella: &capella.BeaconState{
+			Slot:                         100,
+			Validators:                   validators,
+			Balances:                     balances,
+			NextWithdrawalValidatorIndex: 8,
+		},
+	}
+}
+
+func TestEstimateWithdrawalSweep(t *testing.T) {
+	params := &utilcapella.SweepParameters{
+		SlotsPerEpoch:                    32,
+		MaxEffectiveBalance:              32000000000,
+		MaxWithdrawalsPerPayload:         2,
+		MaxValidatorsPerWithdrawalsSweep: 4,
+	}
+
+	tests := []struct {
+		name         string
+		state        *spec.VersionedBeaconState
+		index        phase0.ValidatorIndex
+		params       *utilcapella.SweepParameters
+		slots        uint64
+		withdrawable bool
+		err          string
+	}{
+		{
+			name:   "StateMissing",
+			index:  1,
+			params: params,
+			err:    "no state supplied",
+		},
+		{
+			name:  "ParamsMissing",
+			state: sweepState(33000000000),
+			index: 1,
+			err:   "no parameters supplied",
+		},
+		{
+			name: "Phase0",
+			state: &spec.VersionedBeaconState{
+				Version: spec.DataVersionPhase0,
+				Phase0:  &phase0.BeaconState{},
+			},
+			index:  1,
+			params: params,
+			err:    "state does not provide next withdrawal validator index",
+		},
+		{
+			name:   "IndexMissing",
+			state:  sweepState(33000000000),
+			index:  10,
+			params: params,
+			err:    "validator 10 not present in state",
+		},
+		{
+			name:         "NextBlock",
+			state:        sweepState(33000000000),
+			index:        8,
+			params:       params,
+			slots:        0,
+			withdrawable: true,
+		},
+		{
+			name:         "LimitedByWithdrawals",
+			state:        sweepState(33000000000),
+			index:        5,
+			params:       params,
+			slots:        3,
+			withdrawable: true,
+		},
+		{
+			name:   "LimitedBySweep",
+			state:  sweepState(32000000000),
+			index:  5,
+			params: params,
+			slots:  1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			estimate, err := utilcapella.EstimateWithdrawalSweep(test.state, test.index, test.params)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, phase0.ValidatorIndex(8), estimate.SweepIndex)
+				require.Equal(t, test.slots, estimate.Slots)
+				require.Equal(t, phase0.Slot(101+test.slots), estimate.Slot)
+				require.Equal(t, test.withdrawable, estimate.Withdrawable)
+			}
+		})
+	}
+}
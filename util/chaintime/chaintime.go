@@ -0,0 +1,140 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chaintime converts between wall clock time and beacon chain slots, epochs and
+// sync committee periods.
+package chaintime
+
+import (
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ChainTime calculates chain time for a given set of chain parameters.
+type ChainTime struct {
+	genesisTime                  time.Time
+	slotDuration                 time.Duration
+	slotsPerEpoch                uint64
+	epochsPerSyncCommitteePeriod uint64
+}
+
+// New creates a new chain time calculator.
+func New(params *Parameters) (*ChainTime, error) {
+	if params == nil {
+		return nil, errors.New("no parameters supplied")
+	}
+	if err := params.check(); err != nil {
+		return nil, errors.Wrap(err, "invalid parameters")
+	}
+
+	return &ChainTime{
+		genesisTime:                  params.GenesisTime,
+		slotDuration:                 params.SlotDuration,
+		slotsPerEpoch:                params.SlotsPerEpoch,
+		epochsPerSyncCommitteePeriod: params.EpochsPerSyncCommitteePeriod,
+	}, nil
+}
+
+// GenesisTime returns the time of genesis.
+func (c *ChainTime) GenesisTime() time.Time {
+	return c.genesisTime
+}
+
+// SlotDuration returns the duration of a slot.
+func (c *ChainTime) SlotDuration() time.Duration {
+	return c.slotDuration
+}
+
+// SlotsPerEpoch returns the number of slots in an epoch.
+func (c *ChainTime) SlotsPerEpoch() uint64 {
+	return c.slotsPerEpoch
+}
+
+// SlotStart returns the time at which the given slot starts.
+func (c *ChainTime) SlotStart(slot phase0.Slot) time.Time {
+	return c.genesisTime.Add(time.Duration(slot) * c.slotDuration)
+}
+
+// EpochStart returns the time at which the given epoch starts.
+func (c *ChainTime) EpochStart(epoch phase0.Epoch) time.Time {
+	return c.SlotStart(c.FirstSlotOfEpoch(epoch))
+}
+
+// SlotToEpoch returns the epoch containing the given slot.
+func (c *ChainTime) SlotToEpoch(slot phase0.Slot) phase0.Epoch {
+	return phase0.Epoch(uint64(slot) / c.slotsPerEpoch)
+}
+
+// FirstSlotOfEpoch returns the first slot of the given epoch.
+func (c *ChainTime) FirstSlotOfEpoch(epoch phase0.Epoch) phase0.Slot {
+	return phase0.Slot(uint64(epoch) * c.slotsPerEpoch)
+}
+
+// TimestampToSlot returns the slot containing the given time.
+// Times before genesis return slot 0.
+func (c *ChainTime) TimestampToSlot(timestamp time.Time) phase0.Slot {
+	if timestamp.Before(c.genesisTime) {
+		return 0
+	}
+
+	return phase0.Slot(timestamp.Sub(c.genesisTime) / c.slotDuration)
+}
+
+// TimestampToEpoch returns the epoch containing the given time.
+// Times before genesis return epoch 0.
+func (c *ChainTime) TimestampToEpoch(timestamp time.Time) phase0.Epoch {
+	return c.SlotToEpoch(c.TimestampToSlot(timestamp))
+}
+
+// CurrentSlot returns the current slot according to the wall clock.
+func (c *ChainTime) CurrentSlot() phase0.Slot {
+	return c.TimestampToSlot(time.Now())
+}
+
+// CurrentEpoch returns the current epoch according to the wall clock.
+func (c *ChainTime) CurrentEpoch() phase0.Epoch {
+	return c.TimestampToEpoch(time.Now())
+}
+
+// SlotsUntil returns the number of slots from the current slot until the given slot.
+// Slots that are current or in the past return 0.
+func (c *ChainTime) SlotsUntil(slot phase0.Slot) uint64 {
+	current := c.CurrentSlot()
+	if slot <= current {
+		return 0
+	}
+
+	return uint64(slot - current)
+}
+
+// EpochToSyncCommitteePeriod returns the sync committee period containing the given epoch.
+func (c *ChainTime) EpochToSyncCommitteePeriod(epoch phase0.Epoch) uint64 {
+	return uint64(epoch) / c.epochsPerSyncCommitteePeriod
+}
+
+// SlotToSyncCommitteePeriod returns the sync committee period containing the given slot.
+func (c *ChainTime) SlotToSyncCommitteePeriod(slot phase0.Slot) uint64 {
+	return c.EpochToSyncCommitteePeriod(c.SlotToEpoch(slot))
+}
+
+// FirstEpochOfSyncCommitteePeriod returns the first epoch of the given sync committee period.
+func (c *ChainTime) FirstEpochOfSyncCommitteePeriod(period uint64) phase0.Epoch {
+	return phase0.Epoch(period * c.epochsPerSyncCommitteePeriod)
+}
+
+// CurrentSyncCommitteePeriod returns the current sync committee period according to the wall clock.
+func (c *ChainTime) CurrentSyncCommitteePeriod() uint64 {
+	return c.EpochToSyncCommitteePeriod(c.CurrentEpoch())
+}
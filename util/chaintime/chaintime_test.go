@@ -0,0 +1,214 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaintime_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/chaintime"
+	"github.com/stretchr/testify/require"
+)
+
+func testParameters(genesisTime time.Time) *chaintime.Parameters {
+	return &chaintime.Parameters{
+		GenesisTime:                  genesisTime,
+		SlotDuration:                 12 * time.Second,
+		SlotsPerEpoch:                32,
+		EpochsPerSyncCommitteePeriod: 256,
+	}
+}
+
+func TestNew(t *testing.T) {
+	genesisTime := time.Unix(1606824023, 0)
+
+	tests := []struct {
+		name   string
+		params *chaintime.Parameters
+		err    string
+	}{
+		{
+			name: "Nil",
+			err:  "no parameters supplied",
+		},
+		{
+			name: "GenesisTimeMissing",
+			params: &chaintime.Parameters{
+				SlotDuration:                 12 * time.Second,
+				SlotsPerEpoch:                32,
+				EpochsPerSyncCommitteePeriod: 256,
+			},
+			err: "invalid parameters: genesis time not specified",
+		},
+		{
+			name: "SlotDurationZero",
+			params: &chaintime.Parameters{
+				GenesisTime:                  genesisTime,
+				SlotsPerEpoch:                32,
+				EpochsPerSyncCommitteePeriod: 256,
+			},
+			err: "invalid parameters: invalid slot duration 0s",
+		},
+		{
+			name: "SlotsPerEpochZero",
+			params: &chaintime.Parameters{
+				GenesisTime:                  genesisTime,
+				SlotDuration:                 12 * time.Second,
+				EpochsPerSyncCommitteePeriod: 256,
+			},
+			err: "invalid parameters: slots per epoch cannot be 0",
+		},
+		{
+			name: "EpochsPerSyncCommitteePeriodZero",
+			params: &chaintime.Parameters{
+				GenesisTime:   genesisTime,
+				SlotDuration:  12 * time.Second,
+				SlotsPerEpoch: 32,
+			},
+			err: "invalid parameters: epochs per sync committee period cannot be 0",
+		},
+		{
+			name:   "Good",
+			params: testParameters(genesisTime),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := chaintime.New(test.params)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParametersFromSpec(t *testing.T) {
+	genesisTime := time.Unix(1606824023, 0)
+
+	tests := []struct {
+		name     string
+		spec     map[string]interface{}
+		err      string
+		expected *chaintime.Parameters
+	}{
+		{
+			name: "SecondsPerSlotMissing",
+			spec: map[string]interface{}{"SLOTS_PER_EPOCH": uint64(32)},
+			err:  "SECONDS_PER_SLOT not found in spec",
+		},
+		{
+			name: "SecondsPerSlotWrongType",
+			spec: map[string]interface{}{"SECONDS_PER_SLOT": uint64(12), "SLOTS_PER_EPOCH": uint64(32)},
+			err:  "SECONDS_PER_SLOT of unexpected type",
+		},
+		{
+			name: "SlotsPerEpochMissing",
+			spec: map[string]interface{}{"SECONDS_PER_SLOT": 12 * time.Second},
+			err:  "SLOTS_PER_EPOCH not found in spec",
+		},
+		{
+			name:     "Phase0",
+			spec:     map[string]interface{}{"SECONDS_PER_SLOT": 12 * time.Second, "SLOTS_PER_EPOCH": uint64(32)},
+			expected: testParameters(genesisTime),
+		},
+		{
+			name: "Altair",
+			spec: map[string]interface{}{
+				"SECONDS_PER_SLOT":                 6 * time.Second,
+				"SLOTS_PER_EPOCH":                  uint64(8),
+				"EPOCHS_PER_SYNC_COMMITTEE_PERIOD": uint64(8),
+			},
+			expected: &chaintime.Parameters{
+				GenesisTime:                  genesisTime,
+				SlotDuration:                 6 * time.Second,
+				SlotsPerEpoch:                8,
+				EpochsPerSyncCommitteePeriod: 8,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params, err := chaintime.ParametersFromSpec(genesisTime, test.spec)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, params)
+			}
+		})
+	}
+}
+
+func TestParametersFromClient(t *testing.T) {
+	ctx := context.Background()
+	genesisTime := time.Unix(1606824023, 0)
+
+	client, err := mock.New(ctx, mock.WithGenesisTime(genesisTime))
+	require.NoError(t, err)
+
+	params, err := chaintime.ParametersFromClient(ctx, client)
+	require.NoError(t, err)
+	require.True(t, genesisTime.Equal(params.GenesisTime))
+	require.Equal(t, uint64(32), params.SlotsPerEpoch)
+}
+
+func TestConversions(t *testing.T) {
+	genesisTime := time.Unix(1606824023, 0)
+	c, err := chaintime.New(testParameters(genesisTime))
+	require.NoError(t, err)
+
+	require.Equal(t, genesisTime, c.GenesisTime())
+	require.Equal(t, 12*time.Second, c.SlotDuration())
+	require.Equal(t, uint64(32), c.SlotsPerEpoch())
+
+	require.Equal(t, genesisTime, c.SlotStart(0))
+	require.Equal(t, genesisTime.Add(120*time.Second), c.SlotStart(10))
+	require.Equal(t, genesisTime.Add(2*32*12*time.Second), c.EpochStart(2))
+
+	require.Equal(t, phase0.Epoch(0), c.SlotToEpoch(31))
+	require.Equal(t, phase0.Epoch(1), c.SlotToEpoch(32))
+	require.Equal(t, phase0.Slot(64), c.FirstSlotOfEpoch(2))
+
+	require.Equal(t, phase0.Slot(0), c.TimestampToSlot(genesisTime.Add(-time.Hour)))
+	require.Equal(t, phase0.Slot(0), c.TimestampToSlot(genesisTime.Add(11*time.Second)))
+	require.Equal(t, phase0.Slot(1), c.TimestampToSlot(genesisTime.Add(12*time.Second)))
+	require.Equal(t, phase0.Epoch(0), c.TimestampToEpoch(genesisTime.Add(-time.Hour)))
+	require.Equal(t, phase0.Epoch(1), c.TimestampToEpoch(genesisTime.Add(32*12*time.Second)))
+
+	require.Equal(t, uint64(0), c.EpochToSyncCommitteePeriod(255))
+	require.Equal(t, uint64(1), c.EpochToSyncCommitteePeriod(256))
+	require.Equal(t, uint64(1), c.SlotToSyncCommitteePeriod(256*32))
+	require.Equal(t, phase0.Epoch(512), c.FirstEpochOfSyncCommitteePeriod(2))
+}
+
+func TestCurrent(t *testing.T) {
+	// Genesis is set such that we are part way through slot 10 of epoch 300.
+	genesisTime := time.Now().Add(-time.Duration(300*32+10)*12*time.Second - 5*time.Second)
+	c, err := chaintime.New(testParameters(genesisTime))
+	require.NoError(t, err)
+
+	require.Equal(t, phase0.Slot(300*32+10), c.CurrentSlot())
+	require.Equal(t, phase0.Epoch(300), c.CurrentEpoch())
+	require.Equal(t, uint64(1), c.CurrentSyncCommitteePeriod())
+	require.Equal(t, uint64(0), c.SlotsUntil(300*32))
+	require.Equal(t, uint64(0), c.SlotsUntil(300*32+10))
+	require.Equal(t, uint64(5), c.SlotsUntil(300*32+15))
+}
@@ -0,0 +1,115 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaintime
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+)
+
+// defaultEpochsPerSyncCommitteePeriod is the number of epochs in a sync committee period
+// if it is not supplied by the spec.
+const defaultEpochsPerSyncCommitteePeriod = 256
+
+// Parameters are the chain parameters required to calculate chain time.
+type Parameters struct {
+	GenesisTime                  time.Time
+	SlotDuration                 time.Duration
+	SlotsPerEpoch                uint64
+	EpochsPerSyncCommitteePeriod uint64
+}
+
+// ParametersFromSpec obtains the chain time parameters from the genesis time and the spec
+// as returned by a SpecProvider.  The sync committee period is optional, as it is not present
+// in phase 0 specs.
+func ParametersFromSpec(genesisTime time.Time, specValues map[string]interface{}) (*Parameters, error) {
+	tmp, exists := specValues["SECONDS_PER_SLOT"]
+	if !exists {
+		return nil, errors.New("SECONDS_PER_SLOT not found in spec")
+	}
+	slotDuration, isDuration := tmp.(time.Duration)
+	if !isDuration {
+		return nil, errors.New("SECONDS_PER_SLOT of unexpected type")
+	}
+
+	tmp, exists = specValues["SLOTS_PER_EPOCH"]
+	if !exists {
+		return nil, errors.New("SLOTS_PER_EPOCH not found in spec")
+	}
+	slotsPerEpoch, isUint := tmp.(uint64)
+	if !isUint {
+		return nil, errors.New("SLOTS_PER_EPOCH of unexpected type")
+	}
+
+	epochsPerSyncCommitteePeriod := uint64(defaultEpochsPerSyncCommitteePeriod)
+	if tmp, exists := specValues["EPOCHS_PER_SYNC_COMMITTEE_PERIOD"]; exists {
+		epochsPerSyncCommitteePeriod, isUint = tmp.(uint64)
+		if !isUint {
+			return nil, errors.New("EPOCHS_PER_SYNC_COMMITTEE_PERIOD of unexpected type")
+		}
+	}
+
+	return &Parameters{
+		GenesisTime:                  genesisTime,
+		SlotDuration:                 slotDuration,
+		SlotsPerEpoch:                slotsPerEpoch,
+		EpochsPerSyncCommitteePeriod: epochsPerSyncCommitteePeriod,
+	}, nil
+}
+
+// ParametersFromClient obtains the chain time parameters from a client.
+// The client must provide genesis and spec.
+func ParametersFromClient(ctx context.Context, client consensusclient.Service) (*Parameters, error) {
+	genesisProvider, isProvider := client.(consensusclient.GenesisProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide genesis")
+	}
+	specProvider, isProvider := client.(consensusclient.SpecProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide spec")
+	}
+
+	genesis, err := genesisProvider.Genesis(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain genesis")
+	}
+	specValues, err := specProvider.Spec(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain spec")
+	}
+
+	return ParametersFromSpec(genesis.GenesisTime, specValues)
+}
+
+// check returns an error if the parameters cannot be used for calculation.
+func (p *Parameters) check() error {
+	if p.GenesisTime.IsZero() {
+		return errors.New("genesis time not specified")
+	}
+	if p.SlotDuration <= 0 {
+		return fmt.Errorf("invalid slot duration %v", p.SlotDuration)
+	}
+	if p.SlotsPerEpoch == 0 {
+		return errors.New("slots per epoch cannot be 0")
+	}
+	if p.EpochsPerSyncCommitteePeriod == 0 {
+		return errors.New("epochs per sync committee period cannot be 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,265 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package committees computes beacon committees, block proposers and sync committee
+// membership locally from a beacon state, following the specification.
+package committees
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// maxRandomByte is the maximum value of a random byte used when sampling by effective balance.
+const maxRandomByte = 1<<8 - 1
+
+// CommitteeCountPerSlot returns the number of beacon committees in each slot given the
+// number of active validators.
+// This follows get_committee_count_per_slot in the specification.
+func CommitteeCountPerSlot(activeValidators uint64, params *Parameters) uint64 {
+	count := activeValidators / params.SlotsPerEpoch / params.TargetCommitteeSize
+	if count > params.MaxCommitteesPerSlot {
+		count = params.MaxCommitteesPerSlot
+	}
+	if count == 0 {
+		count = 1
+	}
+
+	return count
+}
+
+// BeaconCommittees returns the beacon committees for every slot of the given epoch.
+// The epoch must be the previous, current or next epoch of the state.
+func BeaconCommittees(state *spec.VersionedBeaconState, epoch phase0.Epoch, params *Parameters) ([]*apiv1.BeaconCommittee, error) {
+	if params == nil {
+		return nil, errors.New("no parameters supplied")
+	}
+	if err := params.check(); err != nil {
+		return nil, err
+	}
+	fields, err := fieldsFromState(state)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkEpoch(fields, epoch, params); err != nil {
+		return nil, err
+	}
+
+	seed, err := seed(fields, epoch, params.DomainBeaconAttester, params)
+	if err != nil {
+		return nil, err
+	}
+	indices := activeValidatorIndices(fields.validators, epoch)
+	// Shuffling the list once provides the members of all committees for the epoch.
+	shuffled := shuffleList(indices, seed, params.ShuffleRoundCount)
+
+	committeesPerSlot := CommitteeCountPerSlot(uint64(len(indices)), params)
+	count := committeesPerSlot * params.SlotsPerEpoch
+	activeCount := uint64(len(indices))
+	committees := make([]*apiv1.BeaconCommittee, 0, count)
+	for slotOffset := uint64(0); slotOffset < params.SlotsPerEpoch; slotOffset++ {
+		for index := uint64(0); index < committeesPerSlot; index++ {
+			// This follows compute_committee in the specification.
+			committeeIndex := slotOffset*committeesPerSlot + index
+			start := activeCount * committeeIndex / count
+			end := activeCount * (committeeIndex + 1) / count
+			validators := make([]phase0.ValidatorIndex, end-start)
+			copy(validators, shuffled[start:end])
+			committees = append(committees, &apiv1.BeaconCommittee{
+				Slot:       phase0.Slot(uint64(epoch)*params.SlotsPerEpoch + slotOffset),
+				Index:      phase0.CommitteeIndex(index),
+				Validators: validators,
+			})
+		}
+	}
+
+	return committees, nil
+}
+
+// ProposerDuties returns the block proposers for every slot of the state's current epoch.
+// Proposers cannot be computed for other epochs, as they depend on effective balances that
+// change at epoch boundaries.
+func ProposerDuties(state *spec.VersionedBeaconState, params *Parameters) ([]*apiv1.ProposerDuty, error) {
+	if params == nil {
+		return nil, errors.New("no parameters supplied")
+	}
+	if err := params.check(); err != nil {
+		return nil, err
+	}
+	fields, err := fieldsFromState(state)
+	if err != nil {
+		return nil, err
+	}
+
+	epoch := phase0.Epoch(uint64(fields.slot) / params.SlotsPerEpoch)
+	epochSeed, err := seed(fields, epoch, params.DomainBeaconProposer, params)
+	if err != nil {
+		return nil, err
+	}
+	indices := activeValidatorIndices(fields.validators, epoch)
+	if len(indices) == 0 {
+		return nil, errors.New("no active validators")
+	}
+
+	duties := make([]*apiv1.ProposerDuty, 0, params.SlotsPerEpoch)
+	buf := make([]byte, 32+8)
+	copy(buf, epochSeed[:])
+	for slot := phase0.Slot(uint64(epoch) * params.SlotsPerEpoch); slot < phase0.Slot(uint64(epoch+1)*params.SlotsPerEpoch); slot++ {
+		// This follows get_beacon_proposer_index in the specification.
+		binary.LittleEndian.PutUint64(buf[32:], uint64(slot))
+		slotSeed := sha256.Sum256(buf)
+		candidates, err := sampleByEffectiveBalance(fields.validators, indices, slotSeed, 1, params)
+		if err != nil {
+			return nil, err
+		}
+		duties = append(duties, &apiv1.ProposerDuty{
+			PubKey:         fields.validators[candidates[0]].PublicKey,
+			Slot:           slot,
+			ValidatorIndex: candidates[0],
+		})
+	}
+
+	return duties, nil
+}
+
+// ComputeNextSyncCommitteeIndices computes the indices of the members of the sync committee that
+// would be selected at the state's next epoch.
+// This follows get_next_sync_committee_indices in the specification; the result becomes the
+// state's next sync committee when the state crosses a sync committee period boundary.
+func ComputeNextSyncCommitteeIndices(state *spec.VersionedBeaconState, params *Parameters) ([]phase0.ValidatorIndex, error) {
+	if params == nil {
+		return nil, errors.New("no parameters supplied")
+	}
+	if err := params.check(); err != nil {
+		return nil, err
+	}
+	if params.SyncCommitteeSize == 0 {
+		return nil, errors.New("sync committee size cannot be 0")
+	}
+	fields, err := fieldsFromState(state)
+	if err != nil {
+		return nil, err
+	}
+	if fields.currentSyncCommittee == nil {
+		return nil, fmt.Errorf("%s state does not have sync committees", state.Version)
+	}
+
+	epoch := phase0.Epoch(uint64(fields.slot)/params.SlotsPerEpoch) + 1
+	epochSeed, err := seed(fields, epoch, params.DomainSyncCommittee, params)
+	if err != nil {
+		return nil, err
+	}
+	indices := activeValidatorIndices(fields.validators, epoch)
+	if len(indices) == 0 {
+		return nil, errors.New("no active validators")
+	}
+
+	return sampleByEffectiveBalance(fields.validators, indices, epochSeed, params.SyncCommitteeSize, params)
+}
+
+// CurrentSyncCommitteeIndices returns the indices of the members of the state's current sync committee.
+func CurrentSyncCommitteeIndices(state *spec.VersionedBeaconState) ([]phase0.ValidatorIndex, error) {
+	fields, err := fieldsFromState(state)
+	if err != nil {
+		return nil, err
+	}
+
+	return syncCommitteeIndices(state.Version, fields.validators, fields.currentSyncCommittee)
+}
+
+// NextSyncCommitteeIndices returns the indices of the members of the state's next sync committee.
+func NextSyncCommitteeIndices(state *spec.VersionedBeaconState) ([]phase0.ValidatorIndex, error) {
+	fields, err := fieldsFromState(state)
+	if err != nil {
+		return nil, err
+	}
+
+	return syncCommitteeIndices(state.Version, fields.validators, fields.nextSyncCommittee)
+}
+
+// syncCommitteeIndices returns the validator indices of the members of a sync committee.
+func syncCommitteeIndices(version spec.DataVersion,
+	validators []*phase0.Validator,
+	syncCommittee *altair.SyncCommittee,
+) (
+	[]phase0.ValidatorIndex,
+	error,
+) {
+	if syncCommittee == nil {
+		return nil, fmt.Errorf("%s state does not have sync committees", version)
+	}
+
+	validatorIndices := make(map[phase0.BLSPubKey]phase0.ValidatorIndex, len(validators))
+	for i, validator := range validators {
+		validatorIndices[validator.PublicKey] = phase0.ValidatorIndex(i)
+	}
+
+	indices := make([]phase0.ValidatorIndex, len(syncCommittee.Pubkeys))
+	for i, pubKey := range syncCommittee.Pubkeys {
+		index, exists := validatorIndices[pubKey]
+		if !exists {
+			return nil, fmt.Errorf("sync committee member %#x not found in validators", pubKey)
+		}
+		indices[i] = index
+	}
+
+	return indices, nil
+}
+
+// sampleByEffectiveBalance selects validators from the shuffled active indices, with the
+// chance of selection proportional to effective balance, until the required number has been
+// selected.  Validators can be selected more than once.
+func sampleByEffectiveBalance(validators []*phase0.Validator,
+	indices []phase0.ValidatorIndex,
+	seed [32]byte,
+	required uint64,
+	params *Parameters,
+) (
+	[]phase0.ValidatorIndex,
+	error,
+) {
+	if params.MaxEffectiveBalance == 0 {
+		return nil, errors.New("max effective balance cannot be 0")
+	}
+
+	total := uint64(len(indices))
+	selected := make([]phase0.ValidatorIndex, 0, required)
+	buf := make([]byte, 32+8)
+	copy(buf, seed[:])
+	var randomBytes [32]byte
+	for i := uint64(0); uint64(len(selected)) < required; i++ {
+		shuffledIndex, err := ComputeShuffledIndex(i%total, total, seed, params.ShuffleRoundCount)
+		if err != nil {
+			return nil, err
+		}
+		candidate := indices[shuffledIndex]
+		if i%32 == 0 {
+			binary.LittleEndian.PutUint64(buf[32:], i/32)
+			randomBytes = sha256.Sum256(buf)
+		}
+		randomByte := uint64(randomBytes[i%32])
+		effectiveBalance := uint64(validators[candidate].EffectiveBalance)
+		if effectiveBalance*maxRandomByte >= uint64(params.MaxEffectiveBalance)*randomByte {
+			selected = append(selected, candidate)
+		}
+	}
+
+	return selected, nil
+}
@@ -0,0 +1,318 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package committees_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/committees"
+	"github.com/stretchr/testify/require"
+)
+
+const farFutureEpoch = phase0.Epoch(0xffffffffffffffff)
+
+func testParams() *committees.Parameters {
+	return &committees.Parameters{
+		SlotsPerEpoch:             32,
+		ShuffleRoundCount:         90,
+		TargetCommitteeSize:       128,
+		MaxCommitteesPerSlot:      64,
+		EpochsPerHistoricalVector: 65536,
+		MinSeedLookahead:          1,
+		MaxEffectiveBalance:       32000000000,
+		SyncCommitteeSize:         512,
+		DomainBeaconProposer:      phase0.DomainType{0x00, 0x00, 0x00, 0x00},
+		DomainBeaconAttester:      phase0.DomainType{0x01, 0x00, 0x00, 0x00},
+		DomainSyncCommittee:       phase0.DomainType{0x07, 0x00, 0x00, 0x00},
+	}
+}
+
+// testState returns an altair state with the given number of active validators.
+func testState(slot phase0.Slot, activeValidators int, params *committees.Parameters) *spec.VersionedBeaconState {
+	validators := make([]*phase0.Validator, activeValidators+1)
+	for i := range validators {
+		validators[i] = &phase0.Validator{
+			PublicKey:        phase0.BLSPubKey{byte(i >> 8), byte(i)},
+			EffectiveBalance: phase0.Gwei(16000000000 + uint64(i%17)*1000000000),
+			ExitEpoch:        farFutureEpoch,
+		}
+	}
+	// Final validator is not yet active.
+	validators[activeValidators].ActivationEpoch = farFutureEpoch
+
+	randaoMixes := make([]phase0.Root, params.EpochsPerHistoricalVector)
+	for i := range randaoMixes {
+		randaoMixes[i] = phase0.Root{byte(i >> 8), byte(i), 0x5a}
+	}
+
+	syncCommittee := &altair.SyncCommittee{
+		Pubkeys: []phase0.BLSPubKey{validators[5].PublicKey, validators[2].PublicKey, validators[5].PublicKey},
+	}
+
+	return &spec.VersionedBeaconState{
+		Version: spec.DataVersionAltair,
+		Altair: &altair.BeaconState{
+			Slot:                 slot,
+			Validators:           validators,
+			RANDAOMixes:          randaoMixes,
+			CurrentSyncCommittee: syncCommittee,
+			NextSyncCommittee: &altair.SyncCommittee{
+				Pubkeys: []phase0.BLSPubKey{validators[1].PublicKey},
+			},
+		},
+	}
+}
+
+func TestParametersFromSpec(t *testing.T) {
+	base := map[string]interface{}{
+		"SLOTS_PER_EPOCH":              uint64(32),
+		"SHUFFLE_ROUND_COUNT":          uint64(90),
+		"TARGET_COMMITTEE_SIZE":        uint64(128),
+		"MAX_COMMITTEES_PER_SLOT":      uint64(64),
+		"EPOCHS_PER_HISTORICAL_VECTOR": uint64(65536),
+		"MIN_SEED_LOOKAHEAD":           uint64(1),
+		"MAX_EFFECTIVE_BALANCE":        uint64(32000000000),
+		"SYNC_COMMITTEE_SIZE":          uint64(512),
+		"DOMAIN_BEACON_PROPOSER":       phase0.DomainType{0x00, 0x00, 0x00, 0x00},
+		"DOMAIN_BEACON_ATTESTER":       phase0.DomainType{0x01, 0x00, 0x00, 0x00},
+		"DOMAIN_SYNC_COMMITTEE":        phase0.DomainType{0x07, 0x00, 0x00, 0x00},
+	}
+	with := func(key string, value interface{}) map[string]interface{} {
+		res := make(map[string]interface{}, len(base))
+		for k, v := range base {
+			res[k] = v
+		}
+		if value == nil {
+			delete(res, key)
+		} else {
+			res[key] = value
+		}
+
+		return res
+	}
+
+	tests := []struct {
+		name     string
+		spec     map[string]interface{}
+		expected *committees.Parameters
+		err      string
+	}{
+		{
+			name:     "Good",
+			spec:     base,
+			expected: testParams(),
+		},
+		{
+			name: "Phase0",
+			spec: with("SYNC_COMMITTEE_SIZE", nil),
+			expected: func() *committees.Parameters {
+				params := testParams()
+				params.SyncCommitteeSize = 0
+				return params
+			}(),
+		},
+		{
+			name: "SlotsPerEpochMissing",
+			spec: with("SLOTS_PER_EPOCH", nil),
+			err:  "SLOTS_PER_EPOCH not found in spec",
+		},
+		{
+			name: "ShuffleRoundCountWrongType",
+			spec: with("SHUFFLE_ROUND_COUNT", "90"),
+			err:  "SHUFFLE_ROUND_COUNT of unexpected type",
+		},
+		{
+			name: "SyncCommitteeSizeWrongType",
+			spec: with("SYNC_COMMITTEE_SIZE", 512),
+			err:  "SYNC_COMMITTEE_SIZE of unexpected type",
+		},
+		{
+			name: "DomainBeaconAttesterMissing",
+			spec: with("DOMAIN_BEACON_ATTESTER", nil),
+			err:  "DOMAIN_BEACON_ATTESTER not found in spec",
+		},
+		{
+			name: "DomainBeaconProposerWrongType",
+			spec: with("DOMAIN_BEACON_PROPOSER", []byte{0x00, 0x00, 0x00, 0x00}),
+			err:  "DOMAIN_BEACON_PROPOSER of unexpected type",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params, err := committees.ParametersFromSpec(test.spec)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, params)
+			}
+		})
+	}
+}
+
+func TestComputeShuffledIndex(t *testing.T) {
+	// Shuffled lists, where the item at each position is the index that shuffles to it.
+	tests := []struct {
+		seed     [32]byte
+		expected []uint64
+	}{
+		{
+			seed:     [32]byte{1, 128, 12},
+			expected: []uint64{0, 7, 8, 6, 3, 9, 4, 5, 2, 1},
+		},
+		{
+			seed:     [32]byte{2, 128, 12},
+			expected: []uint64{0, 5, 2, 1, 6, 8, 7, 3, 4, 9},
+		},
+	}
+
+	for _, test := range tests {
+		for position, index := range test.expected {
+			shuffledIndex, err := committees.ComputeShuffledIndex(index, uint64(len(test.expected)), test.seed, 90)
+			require.NoError(t, err)
+			require.Equal(t, uint64(position), shuffledIndex)
+		}
+	}
+
+	_, err := committees.ComputeShuffledIndex(10, 10, [32]byte{}, 90)
+	require.EqualError(t, err, "index out of range")
+}
+
+func TestCommitteeCountPerSlot(t *testing.T) {
+	params := testParams()
+	require.Equal(t, uint64(1), committees.CommitteeCountPerSlot(0, params))
+	require.Equal(t, uint64(1), committees.CommitteeCountPerSlot(8191, params))
+	require.Equal(t, uint64(2), committees.CommitteeCountPerSlot(8192, params))
+	require.Equal(t, uint64(64), committees.CommitteeCountPerSlot(1000000, params))
+}
+
+func TestBeaconCommittees(t *testing.T) {
+	params := testParams()
+	activeValidators := 10000
+	state := testState(phase0.Slot(100*params.SlotsPerEpoch+5), activeValidators, params)
+
+	for _, epoch := range []phase0.Epoch{99, 100, 101} {
+		beaconCommittees, err := committees.BeaconCommittees(state, epoch, params)
+		require.NoError(t, err)
+		require.Len(t, beaconCommittees, 2*int(params.SlotsPerEpoch))
+
+		seen := make(map[phase0.ValidatorIndex]bool)
+		for i, committee := range beaconCommittees {
+			require.Equal(t, phase0.Slot(uint64(epoch)*params.SlotsPerEpoch+uint64(i/2)), committee.Slot)
+			require.Equal(t, phase0.CommitteeIndex(i%2), committee.Index)
+			require.GreaterOrEqual(t, len(committee.Validators), activeValidators/len(beaconCommittees))
+			require.LessOrEqual(t, len(committee.Validators), activeValidators/len(beaconCommittees)+1)
+			for _, index := range committee.Validators {
+				require.False(t, seen[index], "validator %d in multiple committees", index)
+				seen[index] = true
+			}
+		}
+		require.Len(t, seen, activeValidators)
+		require.False(t, seen[phase0.ValidatorIndex(activeValidators)], "inactive validator in committee")
+	}
+
+	// Different epochs provide different shufflings.
+	current, err := committees.BeaconCommittees(state, 100, params)
+	require.NoError(t, err)
+	next, err := committees.BeaconCommittees(state, 101, params)
+	require.NoError(t, err)
+	require.NotEqual(t, current[0].Validators, next[0].Validators)
+
+	_, err = committees.BeaconCommittees(state, 102, params)
+	require.EqualError(t, err, "epoch 102 is too far ahead of state epoch 100")
+	_, err = committees.BeaconCommittees(state, 98, params)
+	require.EqualError(t, err, "epoch 98 is too far behind state epoch 100")
+	_, err = committees.BeaconCommittees(nil, 100, params)
+	require.EqualError(t, err, "no state supplied")
+	_, err = committees.BeaconCommittees(state, 100, nil)
+	require.EqualError(t, err, "no parameters supplied")
+	_, err = committees.BeaconCommittees(state, 100, &committees.Parameters{})
+	require.EqualError(t, err, "slots per epoch cannot be 0")
+
+	params.EpochsPerHistoricalVector = 1024
+	_, err = committees.BeaconCommittees(state, 100, params)
+	require.EqualError(t, err, "state has 65536 RANDAO mixes; expected 1024")
+}
+
+func TestProposerDuties(t *testing.T) {
+	params := testParams()
+	state := testState(phase0.Slot(100*params.SlotsPerEpoch+5), 1000, params)
+
+	duties, err := committees.ProposerDuties(state, params)
+	require.NoError(t, err)
+	require.Len(t, duties, int(params.SlotsPerEpoch))
+	for i, duty := range duties {
+		require.Equal(t, phase0.Slot(100*params.SlotsPerEpoch+uint64(i)), duty.Slot)
+		require.Less(t, int(duty.ValidatorIndex), 1000)
+		require.Equal(t, state.Altair.Validators[duty.ValidatorIndex].PublicKey, duty.PubKey)
+	}
+
+	// Results are deterministic.
+	again, err := committees.ProposerDuties(state, params)
+	require.NoError(t, err)
+	require.Equal(t, duties, again)
+
+	// No active validators.
+	state = testState(0, 10, params)
+	for _, validator := range state.Altair.Validators {
+		validator.ActivationEpoch = farFutureEpoch
+	}
+	_, err = committees.ProposerDuties(state, params)
+	require.EqualError(t, err, "no active validators")
+}
+
+func TestComputeNextSyncCommitteeIndices(t *testing.T) {
+	params := testParams()
+	state := testState(phase0.Slot(100*params.SlotsPerEpoch+5), 1000, params)
+
+	indices, err := committees.ComputeNextSyncCommitteeIndices(state, params)
+	require.NoError(t, err)
+	require.Len(t, indices, int(params.SyncCommitteeSize))
+	for _, index := range indices {
+		require.Less(t, int(index), 1000)
+	}
+
+	params.SyncCommitteeSize = 0
+	_, err = committees.ComputeNextSyncCommitteeIndices(state, params)
+	require.EqualError(t, err, "sync committee size cannot be 0")
+
+	_, err = committees.ComputeNextSyncCommitteeIndices(&spec.VersionedBeaconState{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.BeaconState{
+			RANDAOMixes: make([]phase0.Root, params.EpochsPerHistoricalVector),
+		},
+	}, testParams())
+	require.EqualError(t, err, "phase0 state does not have sync committees")
+}
+
+func TestSyncCommitteeIndices(t *testing.T) {
+	params := testParams()
+	state := testState(0, 10, params)
+
+	indices, err := committees.CurrentSyncCommitteeIndices(state)
+	require.NoError(t, err)
+	require.Equal(t, []phase0.ValidatorIndex{5, 2, 5}, indices)
+
+	indices, err = committees.NextSyncCommitteeIndices(state)
+	require.NoError(t, err)
+	require.Equal(t, []phase0.ValidatorIndex{1}, indices)
+
+	state.Altair.NextSyncCommittee.Pubkeys[0] = phase0.BLSPubKey{0xff}
+	_, err = committees.NextSyncCommitteeIndices(state)
+	require.ErrorContains(t, err, "not found in validators")
+}
@@ -0,0 +1,123 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package committees
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Parameters are the spec parameters required to compute committees.
+type Parameters struct {
+	SlotsPerEpoch             uint64
+	ShuffleRoundCount         uint64
+	TargetCommitteeSize       uint64
+	MaxCommitteesPerSlot      uint64
+	EpochsPerHistoricalVector uint64
+	MinSeedLookahead          uint64
+	MaxEffectiveBalance       phase0.Gwei
+	SyncCommitteeSize         uint64
+	DomainBeaconProposer      phase0.DomainType
+	DomainBeaconAttester      phase0.DomainType
+	DomainSyncCommittee       phase0.DomainType
+}
+
+// ParametersFromSpec obtains the committee parameters from the spec as returned by a SpecProvider.
+// The sync committee parameters are optional, as they are not present in phase 0 specs.
+func ParametersFromSpec(specValues map[string]interface{}) (*Parameters, error) {
+	values := make(map[string]uint64)
+	for _, key := range []string{
+		"SLOTS_PER_EPOCH",
+		"SHUFFLE_ROUND_COUNT",
+		"TARGET_COMMITTEE_SIZE",
+		"MAX_COMMITTEES_PER_SLOT",
+		"EPOCHS_PER_HISTORICAL_VECTOR",
+		"MIN_SEED_LOOKAHEAD",
+		"MAX_EFFECTIVE_BALANCE",
+	} {
+		tmp, exists := specValues[key]
+		if !exists {
+			return nil, fmt.Errorf("%s not found in spec", key)
+		}
+		value, isValue := tmp.(uint64)
+		if !isValue {
+			return nil, fmt.Errorf("%s of unexpected type", key)
+		}
+		values[key] = value
+	}
+	if tmp, exists := specValues["SYNC_COMMITTEE_SIZE"]; exists {
+		value, isValue := tmp.(uint64)
+		if !isValue {
+			return nil, errors.New("SYNC_COMMITTEE_SIZE of unexpected type")
+		}
+		values["SYNC_COMMITTEE_SIZE"] = value
+	}
+
+	domains := make(map[string]phase0.DomainType)
+	for _, key := range []string{
+		"DOMAIN_BEACON_PROPOSER",
+		"DOMAIN_BEACON_ATTESTER",
+		"DOMAIN_SYNC_COMMITTEE",
+	} {
+		tmp, exists := specValues[key]
+		if !exists {
+			if key == "DOMAIN_SYNC_COMMITTEE" {
+				continue
+			}
+			return nil, fmt.Errorf("%s not found in spec", key)
+		}
+		domain, isDomain := tmp.(phase0.DomainType)
+		if !isDomain {
+			return nil, fmt.Errorf("%s of unexpected type", key)
+		}
+		domains[key] = domain
+	}
+
+	return &Parameters{
+		SlotsPerEpoch:             values["SLOTS_PER_EPOCH"],
+		ShuffleRoundCount:         values["SHUFFLE_ROUND_COUNT"],
+		TargetCommitteeSize:       values["TARGET_COMMITTEE_SIZE"],
+		MaxCommitteesPerSlot:      values["MAX_COMMITTEES_PER_SLOT"],
+		EpochsPerHistoricalVector: values["EPOCHS_PER_HISTORICAL_VECTOR"],
+		MinSeedLookahead:          values["MIN_SEED_LOOKAHEAD"],
+		MaxEffectiveBalance:       phase0.Gwei(values["MAX_EFFECTIVE_BALANCE"]),
+		SyncCommitteeSize:         values["SYNC_COMMITTEE_SIZE"],
+		DomainBeaconProposer:      domains["DOMAIN_BEACON_PROPOSER"],
+		DomainBeaconAttester:      domains["DOMAIN_BEACON_ATTESTER"],
+		DomainSyncCommittee:       domains["DOMAIN_SYNC_COMMITTEE"],
+	}, nil
+}
+
+// check returns an error if the parameters cannot be used for computation.
+func (p *Parameters) check() error {
+	if p.SlotsPerEpoch == 0 {
+		return errors.New("slots per epoch cannot be 0")
+	}
+	if p.TargetCommitteeSize == 0 {
+		return errors.New("target committee size cannot be 0")
+	}
+	if p.MaxCommitteesPerSlot == 0 {
+		return errors.New("max committees per slot cannot be 0")
+	}
+	if p.EpochsPerHistoricalVector <= p.MinSeedLookahead {
+		return errors.New("epochs per historical vector must be greater than min seed lookahead")
+	}
+	if p.ShuffleRoundCount > 256 {
+		return errors.New("shuffle round count cannot be greater than 256")
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package committees
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+// ComputeShuffledIndex returns the shuffled index corresponding to the given index, for a
+// list of the given size, using the swap-or-not shuffle with the given seed.
+// This follows compute_shuffled_index in the specification.
+func ComputeShuffledIndex(index uint64, indexCount uint64, seed [32]byte, rounds uint64) (uint64, error) {
+	if index >= indexCount {
+		return 0, errors.New("index out of range")
+	}
+
+	buf := make([]byte, 32+1+4)
+	copy(buf, seed[:])
+	for round := uint64(0); round < rounds; round++ {
+		buf[32] = byte(round)
+		pivotHash := sha256.Sum256(buf[:33])
+		pivot := binary.LittleEndian.Uint64(pivotHash[:8]) % indexCount
+		flip := (pivot + indexCount - index) % indexCount
+		position := index
+		if flip > position {
+			position = flip
+		}
+		binary.LittleEndian.PutUint32(buf[33:], uint32(position/256))
+		source := sha256.Sum256(buf)
+		bit := (source[(position%256)/8] >> (position % 8)) & 1
+		if bit == 1 {
+			index = flip
+		}
+	}
+
+	return index, nil
+}
+
+// shuffleList returns a copy of the list in which each item i is the item of the input
+// list at ComputeShuffledIndex(i).  This is equivalent to calling ComputeShuffledIndex for
+// every item but requires far fewer hashes, as each round's pivot and source hashes are
+// calculated once rather than once per item.
+func shuffleList[T any](list []T, seed [32]byte, rounds uint64) []T {
+	res := make([]T, len(list))
+	copy(res, list)
+	count := uint64(len(list))
+	if count <= 1 {
+		return res
+	}
+
+	buf := make([]byte, 32+1+4)
+	copy(buf, seed[:])
+	sources := make([][32]byte, (count+255)/256)
+	// Each round is an involution that swaps pairs of items, so applying the rounds to the
+	// list in reverse order results in item i being the input item at the shuffled index of i.
+	for r := rounds; r > 0; r-- {
+		buf[32] = byte(r - 1)
+		pivotHash := sha256.Sum256(buf[:33])
+		pivot := binary.LittleEndian.Uint64(pivotHash[:8]) % count
+		for i := range sources {
+			binary.LittleEndian.PutUint32(buf[33:], uint32(i))
+			sources[i] = sha256.Sum256(buf)
+		}
+		for i := uint64(0); i < count; i++ {
+			flip := (pivot + count - i) % count
+			if flip <= i {
+				// Either the item maps to itself or the pair has already been considered.
+				continue
+			}
+			// The swap is decided by the bit at the higher of the two positions.
+			if (sources[flip/256][(flip%256)/8]>>(flip%8))&1 == 1 {
+				res[i], res[flip] = res[flip], res[i]
+			}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,51 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package committees
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShuffleList(t *testing.T) {
+	seeds := [][32]byte{
+		{},
+		{0x01, 0x80, 0x0c},
+		{0x7b, 0x2a},
+		{0xff, 0xfe, 0xfd, 0xfc, 0xfb, 0xfa, 0xf9, 0xf8},
+	}
+	for _, size := range []uint64{0, 1, 2, 3, 10, 255, 256, 257, 1000} {
+		list := make([]uint64, size)
+		for i := range list {
+			list[i] = uint64(i)
+		}
+		for _, seed := range seeds {
+			shuffled := shuffleList(list, seed, 90)
+			require.Len(t, shuffled, int(size))
+			for i := uint64(0); i < size; i++ {
+				expected, err := ComputeShuffledIndex(i, size, seed, 90)
+				require.NoError(t, err)
+				require.Equal(t, list[expected], shuffled[i], "mismatch for size %d seed %#x index %d", size, seed, i)
+			}
+		}
+	}
+}
+
+func TestShuffleListCopies(t *testing.T) {
+	list := []uint64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	shuffled := shuffleList(list, [32]byte{0x01, 0x80, 0x0c}, 90)
+	require.Equal(t, []uint64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, list)
+	require.NotEqual(t, list, shuffled)
+}
@@ -0,0 +1,141 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package committees
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// stateFields are the fields of a beacon state used to compute committees.
+type stateFields struct {
+	slot                 phase0.Slot
+	validators           []*phase0.Validator
+	randaoMixes          []phase0.Root
+	currentSyncCommittee *altair.SyncCommittee
+	nextSyncCommittee    *altair.SyncCommittee
+}
+
+// fieldsFromState obtains the fields used to compute committees from a versioned state.
+func fieldsFromState(state *spec.VersionedBeaconState) (*stateFields, error) {
+	if state == nil {
+		return nil, errors.New("no state supplied")
+	}
+
+	switch state.Version {
+	case spec.DataVersionPhase0:
+		if state.Phase0 == nil {
+			return nil, errors.New("no phase0 state")
+		}
+		return &stateFields{
+			slot:        state.Phase0.Slot,
+			validators:  state.Phase0.Validators,
+			randaoMixes: state.Phase0.RANDAOMixes,
+		}, nil
+	case spec.DataVersionAltair:
+		if state.Altair == nil {
+			return nil, errors.New("no altair state")
+		}
+		return &stateFields{
+			slot:                 state.Altair.Slot,
+			validators:           state.Altair.Validators,
+			randaoMixes:          state.Altair.RANDAOMixes,
+			currentSyncCommittee: state.Altair.CurrentSyncCommittee,
+			nextSyncCommittee:    state.Altair.NextSyncCommittee,
+		}, nil
+	case spec.DataVersionBellatrix:
+		if state.Bellatrix == nil {
+			return nil, errors.New("no bellatrix state")
+		}
+		return &stateFields{
+			slot:                 state.Bellatrix.Slot,
+			validators:           state.Bellatrix.Validators,
+			randaoMixes:          state.Bellatrix.RANDAOMixes,
+			currentSyncCommittee: state.Bellatrix.CurrentSyncCommittee,
+			nextSyncCommittee:    state.Bellatrix.NextSyncCommittee,
+		}, nil
+	case spec.DataVersionCapella:
+		if state.Capella == nil {
+			return nil, errors.New("no capella state")
+		}
+		return &stateFields{
+			slot:                 state.Capella.Slot,
+			validators:           state.Capella.Validators,
+			randaoMixes:          state.Capella.RANDAOMixes,
+			currentSyncCommittee: state.Capella.CurrentSyncCommittee,
+			nextSyncCommittee:    state.Capella.NextSyncCommittee,
+		}, nil
+	case spec.DataVersionDeneb:
+		if state.Deneb == nil {
+			return nil, errors.New("no deneb state")
+		}
+		return &stateFields{
+			slot:                 state.Deneb.Slot,
+			validators:           state.Deneb.Validators,
+			randaoMixes:          state.Deneb.RANDAOMixes,
+			currentSyncCommittee: state.Deneb.CurrentSyncCommittee,
+			nextSyncCommittee:    state.Deneb.NextSyncCommittee,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported state version %s", state.Version)
+	}
+}
+
+// activeValidatorIndices returns the indices of the validators active at the given epoch.
+func activeValidatorIndices(validators []*phase0.Validator, epoch phase0.Epoch) []phase0.ValidatorIndex {
+	indices := make([]phase0.ValidatorIndex, 0, len(validators))
+	for i, validator := range validators {
+		if validator.ActivationEpoch <= epoch && epoch < validator.ExitEpoch {
+			indices = append(indices, phase0.ValidatorIndex(i))
+		}
+	}
+
+	return indices
+}
+
+// seed returns the seed for the given epoch and domain type.
+// This follows get_seed in the specification.
+func seed(fields *stateFields, epoch phase0.Epoch, domainType phase0.DomainType, params *Parameters) ([32]byte, error) {
+	if uint64(len(fields.randaoMixes)) != params.EpochsPerHistoricalVector {
+		return [32]byte{}, fmt.Errorf("state has %d RANDAO mixes; expected %d", len(fields.randaoMixes), params.EpochsPerHistoricalVector)
+	}
+	mixEpoch := (uint64(epoch) + params.EpochsPerHistoricalVector - params.MinSeedLookahead - 1) % params.EpochsPerHistoricalVector
+
+	buf := make([]byte, 4+8+32)
+	copy(buf, domainType[:])
+	binary.LittleEndian.PutUint64(buf[4:], uint64(epoch))
+	copy(buf[12:], fields.randaoMixes[mixEpoch][:])
+
+	return sha256.Sum256(buf), nil
+}
+
+// checkEpoch returns an error if committees for the epoch cannot be computed from the state.
+// The state can provide committees for its previous, current and next epochs.
+func checkEpoch(fields *stateFields, epoch phase0.Epoch, params *Parameters) error {
+	stateEpoch := phase0.Epoch(uint64(fields.slot) / params.SlotsPerEpoch)
+	if epoch > stateEpoch+1 {
+		return fmt.Errorf("epoch %d is too far ahead of state epoch %d", epoch, stateEpoch)
+	}
+	if epoch+1 < stateEpoch {
+		return fmt.Errorf("epoch %d is too far behind state epoch %d", epoch, stateEpoch)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/proofs"
+	"github.com/pkg/errors"
+)
+
+// MaxBlobsPerBlock is the maximum number of blobs in a block.
+const MaxBlobsPerBlock = 6
+
+// KZGCommitmentInclusionProofDepth is the depth of a proof of a KZG commitment against the beacon block body root.
+const KZGCommitmentInclusionProofDepth = 17
+
+// KZGVerifier verifies KZG proofs.  It is implemented by wrappers around KZG libraries such
+// as go-kzg-4844 or c-kzg-4844, allowing the library to be selected by the caller.
+type KZGVerifier interface {
+	// VerifyBlobKZGProof verifies that the proof is valid for the blob and its commitment.
+	VerifyBlobKZGProof(blob *deneb.Blob, commitment deneb.KzgCommitment, proof deneb.KzgProof) error
+}
+
+// KZGCommitmentRoot returns the hash tree root of a KZG commitment.
+func KZGCommitmentRoot(commitment deneb.KzgCommitment) phase0.Root {
+	var chunks [64]byte
+	copy(chunks[:], commitment[:])
+
+	return sha256.Sum256(chunks[:])
+}
+
+// VerifyKZGCommitmentInclusionProof verifies that the KZG commitment is at the given index of the
+// commitments in the beacon block body with the given root.
+func VerifyKZGCommitmentInclusionProof(commitment deneb.KzgCommitment,
+	blobIndex deneb.BlobIndex,
+	branch []phase0.Root,
+	bodyRoot phase0.Root,
+) error {
+	if len(branch) != KZGCommitmentInclusionProofDepth {
+		return fmt.Errorf("inclusion proof has %d branches; expected %d", len(branch), KZGCommitmentInclusionProofDepth)
+	}
+	index, err := proofs.BlobKZGCommitmentIndex(spec.DataVersionDeneb, uint64(blobIndex))
+	if err != nil {
+		return err
+	}
+
+	proof := &proofs.Proof{
+		Index:  index,
+		Leaf:   KZGCommitmentRoot(commitment),
+		Branch: branch,
+	}
+	if !proof.Verify(bodyRoot) {
+		return errors.New("invalid inclusion proof")
+	}
+
+	return nil
+}
+
+// VerifyBlobSidecar verifies that the blob sidecar is consistent with the block to which it refers:
+// the sidecar's index is in bounds, its block details match the block, and its KZG commitment is
+// that included in the block for its index.
+// As the full block is available the commitment is compared directly, so no inclusion proof is
+// involved; sidecars received without their block can be checked against the block body root with
+// VerifyKZGCommitmentInclusionProof.
+// This does not verify the sidecar's KZG proof; see VerifyBlobSidecarKZGProof.
+func VerifyBlobSidecar(sidecar *deneb.BlobSidecar, block *deneb.BeaconBlock) error {
+	if sidecar == nil {
+		return errors.New("no blob sidecar supplied")
+	}
+	if block == nil || block.Body == nil {
+		return errors.New("no block supplied")
+	}
+
+	if sidecar.Index >= MaxBlobsPerBlock {
+		return fmt.Errorf("blob index %d exceeds maximum of %d", sidecar.Index, MaxBlobsPerBlock)
+	}
+	if sidecar.Slot != block.Slot {
+		return fmt.Errorf("blob sidecar slot %d does not match block slot %d", sidecar.Slot, block.Slot)
+	}
+	if sidecar.ProposerIndex != block.ProposerIndex {
+		return fmt.Errorf("blob sidecar proposer index %d does not match block proposer index %d", sidecar.ProposerIndex, block.ProposerIndex)
+	}
+	if sidecar.BlockParentRoot != block.ParentRoot {
+		return fmt.Errorf("blob sidecar parent root %#x does not match block parent root %#x", sidecar.BlockParentRoot, block.ParentRoot)
+	}
+	blockRoot, err := block.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate block root")
+	}
+	if sidecar.BlockRoot != blockRoot {
+		return fmt.Errorf("blob sidecar block root %#x does not match block root %#x", sidecar.BlockRoot, phase0.Root(blockRoot))
+	}
+
+	if uint64(sidecar.Index) >= uint64(len(block.Body.BlobKzgCommitments)) {
+		return fmt.Errorf("blob index %d has no commitment in block with %d commitments", sidecar.Index, len(block.Body.BlobKzgCommitments))
+	}
+	if sidecar.KzgCommitment != block.Body.BlobKzgCommitments[sidecar.Index] {
+		return fmt.Errorf("blob sidecar commitment %#x does not match block commitment %#x", sidecar.KzgCommitment, block.Body.BlobKzgCommitments[sidecar.Index])
+	}
+
+	return nil
+}
+
+// VerifyBlobSidecarKZGProof verifies the KZG proof of the blob sidecar using the supplied verifier.
+func VerifyBlobSidecarKZGProof(sidecar *deneb.BlobSidecar, verifier KZGVerifier) error {
+	if sidecar == nil {
+		return errors.New("no blob sidecar supplied")
+	}
+	if verifier == nil {
+		return errors.New("no KZG verifier supplied")
+	}
+
+	if err := verifier.VerifyBlobKZGProof(&sidecar.Blob, sidecar.KzgCommitment, sidecar.KzgProof); err != nil {
+		return errors.Wrap(err, "invalid KZG proof")
+	}
+
+	return nil
+}
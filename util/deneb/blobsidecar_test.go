@@ -0,0 +1,219 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	utildeneb "github.com/attestantio/go-eth2-client/util/deneb"
+	"github.com/attestantio/go-eth2-client/util/proofs"
+	"github.com/holiman/uint256"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func testBlock(t *testing.T) *deneb.BeaconBlock {
+	t.Helper()
+
+	return &deneb.BeaconBlock{
+		Slot:          100,
+		ProposerIndex: 5,
+		ParentRoot:    phase0.Root{0x01},
+		StateRoot:     phase0.Root{0x02},
+		Body: &deneb.BeaconBlockBody{
+			ETH1Data: &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+			SyncAggregate: &altair.SyncAggregate{
+				SyncCommitteeBits: bitfield.NewBitvector512(),
+			},
+			ExecutionPayload: &deneb.ExecutionPayload{
+				BaseFeePerGas: uint256.NewInt(7),
+			},
+			BlobKzgCommitments: []deneb.KzgCommitment{{0x10}, {0x11}, {0x12}},
+		},
+	}
+}
+
+func testSidecar(t *testing.T, block *deneb.BeaconBlock, index deneb.BlobIndex) *deneb.BlobSidecar {
+	t.Helper()
+
+	blockRoot, err := block.HashTreeRoot()
+	require.NoError(t, err)
+
+	return &deneb.BlobSidecar{
+		BlockRoot:       blockRoot,
+		Index:           index,
+		Slot:            block.Slot,
+		BlockParentRoot: block.ParentRoot,
+		ProposerIndex:   block.ProposerIndex,
+		KzgCommitment:   block.Body.BlobKzgCommitments[index],
+	}
+}
+
+func TestVerifyBlobSidecar(t *testing.T) {
+	block := testBlock(t)
+
+	tests := []struct {
+		name    string
+		sidecar func() *deneb.BlobSidecar
+		block   *deneb.BeaconBlock
+		err     string
+	}{
+		{
+			name:    "SidecarMissing",
+			sidecar: func() *deneb.BlobSidecar { return nil },
+			block:   block,
+			err:     "no blob sidecar supplied",
+		},
+		{
+			name:    "BlockMissing",
+			sidecar: func() *deneb.BlobSidecar { return testSidecar(t, block, 0) },
+			err:     "no block supplied",
+		},
+		{
+			name: "IndexTooHigh",
+			sidecar: func() *deneb.BlobSidecar {
+				sidecar := testSidecar(t, block, 0)
+				sidecar.Index = 6
+
+				return sidecar
+			},
+			block: block,
+			err:   "blob index 6 exceeds maximum of 6",
+		},
+		{
+			name: "SlotMismatch",
+			sidecar: func() *deneb.BlobSidecar {
+				sidecar := testSidecar(t, block, 0)
+				sidecar.Slot = 101
+
+				return sidecar
+			},
+			block: block,
+			err:   "blob sidecar slot 101 does not match block slot 100",
+		},
+		{
+			name: "ProposerMismatch",
+			sidecar: func() *deneb.BlobSidecar {
+				sidecar := testSidecar(t, block, 0)
+				sidecar.ProposerIndex = 6
+
+				return sidecar
+			},
+			block: block,
+			err:   "blob sidecar proposer index 6 does not match block proposer index 5",
+		},
+		{
+			name: "ParentRootMismatch",
+			sidecar: func() *deneb.BlobSidecar {
+				sidecar := testSidecar(t, block, 0)
+				sidecar.BlockParentRoot = phase0.Root{0x03}
+
+				return sidecar
+			},
+			block: block,
+			err:   "blob sidecar parent root 0x0300000000000000000000000000000000000000000000000000000000000000 does not match block parent root 0x0100000000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			name: "BlockRootMismatch",
+			sidecar: func() *deneb.BlobSidecar {
+				sidecar := testSidecar(t, block, 0)
+				sidecar.BlockRoot = phase0.Root{0x04}
+
+				return sidecar
+			},
+			block: block,
+		},
+		{
+			name: "NoCommitment",
+			sidecar: func() *deneb.BlobSidecar {
+				sidecar := testSidecar(t, block, 0)
+				sidecar.Index = 3
+
+				return sidecar
+			},
+			block: block,
+			err:   "blob index 3 has no commitment in block with 3 commitments",
+		},
+		{
+			name: "CommitmentMismatch",
+			sidecar: func() *deneb.BlobSidecar {
+				sidecar := testSidecar(t, block, 1)
+				sidecar.KzgCommitment = deneb.KzgCommitment{0x20}
+
+				return sidecar
+			},
+			block: block,
+		},
+		{
+			name:    "Good",
+			sidecar: func() *deneb.BlobSidecar { return testSidecar(t, block, 2) },
+			block:   block,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := utildeneb.VerifyBlobSidecar(test.sidecar(), test.block)
+			switch {
+			case test.err != "":
+				require.EqualError(t, err, test.err)
+			case test.name == "Good":
+				require.NoError(t, err)
+			default:
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyKZGCommitmentInclusionProof(t *testing.T) {
+	block := testBlock(t)
+	bodyRoot, err := block.Body.HashTreeRoot()
+	require.NoError(t, err)
+
+	proof, err := proofs.BlobKZGCommitmentProof(&spec.VersionedBeaconBlockBody{
+		Version: spec.DataVersionDeneb,
+		Deneb:   block.Body,
+	}, 1)
+	require.NoError(t, err)
+	require.Len(t, proof.Branch, utildeneb.KZGCommitmentInclusionProofDepth)
+	require.Equal(t, utildeneb.KZGCommitmentRoot(block.Body.BlobKzgCommitments[1]), proof.Leaf)
+
+	require.NoError(t, utildeneb.VerifyKZGCommitmentInclusionProof(block.Body.BlobKzgCommitments[1], 1, proof.Branch, bodyRoot))
+	require.EqualError(t, utildeneb.VerifyKZGCommitmentInclusionProof(block.Body.BlobKzgCommitments[0], 1, proof.Branch, bodyRoot), "invalid inclusion proof")
+	require.EqualError(t, utildeneb.VerifyKZGCommitmentInclusionProof(block.Body.BlobKzgCommitments[1], 0, proof.Branch, bodyRoot), "invalid inclusion proof")
+	require.EqualError(t, utildeneb.VerifyKZGCommitmentInclusionProof(block.Body.BlobKzgCommitments[1], 1, proof.Branch[1:], bodyRoot), "inclusion proof has 16 branches; expected 17")
+}
+
+type testVerifier struct {
+	err error
+}
+
+func (v *testVerifier) VerifyBlobKZGProof(_ *deneb.Blob, _ deneb.KzgCommitment, _ deneb.KzgProof) error {
+	return v.err
+}
+
+func TestVerifyBlobSidecarKZGProof(t *testing.T) {
+	sidecar := testSidecar(t, testBlock(t), 0)
+
+	require.EqualError(t, utildeneb.VerifyBlobSidecarKZGProof(nil, &testVerifier{}), "no blob sidecar supplied")
+	require.EqualError(t, utildeneb.VerifyBlobSidecarKZGProof(sidecar, nil), "no KZG verifier supplied")
+	require.EqualError(t, utildeneb.VerifyBlobSidecarKZGProof(sidecar, &testVerifier{err: errors.New("bad proof")}), "invalid KZG proof: bad proof")
+	require.NoError(t, utildeneb.VerifyBlobSidecarKZGProof(sidecar, &testVerifier{}))
+}
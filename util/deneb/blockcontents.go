@@ -0,0 +1,60 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb
+
+import (
+	"fmt"
+
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/pkg/errors"
+)
+
+// VerifySignedBlockContents verifies that the blob sidecars of the signed block contents are
+// consistent with the block: there is one sidecar for each KZG commitment in the block, in order,
+// and each sidecar passes VerifyBlobSidecar.
+// If verifier is supplied the KZG proof of each sidecar is also verified.
+func VerifySignedBlockContents(contents *apiv1deneb.SignedBlockContents, verifier KZGVerifier) error {
+	if contents == nil {
+		return errors.New("no block contents supplied")
+	}
+	if contents.SignedBlock == nil || contents.SignedBlock.Message == nil || contents.SignedBlock.Message.Body == nil {
+		return errors.New("no block supplied")
+	}
+	block := contents.SignedBlock.Message
+
+	commitments := len(block.Body.BlobKzgCommitments)
+	if len(contents.SignedBlobSidecars) != commitments {
+		return fmt.Errorf("block has %d KZG commitments but %d blob sidecars supplied", commitments, len(contents.SignedBlobSidecars))
+	}
+
+	for i, signedSidecar := range contents.SignedBlobSidecars {
+		if signedSidecar == nil || signedSidecar.Message == nil {
+			return fmt.Errorf("blob sidecar %d missing", i)
+		}
+		sidecar := signedSidecar.Message
+		if int(sidecar.Index) != i {
+			return fmt.Errorf("blob sidecar %d has index %d", i, sidecar.Index)
+		}
+		if err := VerifyBlobSidecar(sidecar, block); err != nil {
+			return errors.Wrapf(err, "blob sidecar %d invalid", i)
+		}
+		if verifier != nil {
+			if err := VerifyBlobSidecarKZGProof(sidecar, verifier); err != nil {
+				return errors.Wrapf(err, "blob sidecar %d invalid", i)
+			}
+		}
+	}
+
+	return nil
+}
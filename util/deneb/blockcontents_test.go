@@ -0,0 +1,133 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb_test
+
+import (
+	"errors"
+	"testing"
+
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	utildeneb "github.com/attestantio/go-eth2-client/util/deneb"
+	"github.com/stretchr/testify/require"
+)
+
+func testSignedBlockContents(t *testing.T) *apiv1deneb.SignedBlockContents {
+	t.Helper()
+
+	block := testBlock(t)
+	sidecars := make([]*deneb.SignedBlobSidecar, len(block.Body.BlobKzgCommitments))
+	for i := range sidecars {
+		sidecars[i] = &deneb.SignedBlobSidecar{
+			Message: testSidecar(t, block, deneb.BlobIndex(i)),
+		}
+	}
+
+	return &apiv1deneb.SignedBlockContents{
+		SignedBlock:        &deneb.SignedBeaconBlock{Message: block},
+		SignedBlobSidecars: sidecars,
+	}
+}
+
+func TestVerifySignedBlockContents(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents func() *apiv1deneb.SignedBlockContents
+		verifier utildeneb.KZGVerifier
+		err      string
+	}{
+		{
+			name:     "ContentsMissing",
+			contents: func() *apiv1deneb.SignedBlockContents { return nil },
+			err:      "no block contents supplied",
+		},
+		{
+			name: "BlockMissing",
+			contents: func() *apiv1deneb.SignedBlockContents {
+				contents := testSignedBlockContents(t)
+				contents.SignedBlock = nil
+				return contents
+			},
+			err: "no block supplied",
+		},
+		{
+			name: "SidecarsShort",
+			contents: func() *apiv1deneb.SignedBlockContents {
+				contents := testSignedBlockContents(t)
+				contents.SignedBlobSidecars = contents.SignedBlobSidecars[:2]
+				return contents
+			},
+			err: "block has 3 KZG commitments but 2 blob sidecars supplied",
+		},
+		{
+			name: "SidecarMissing",
+			contents: func() *apiv1deneb.SignedBlockContents {
+				contents := testSignedBlockContents(t)
+				contents.SignedBlobSidecars[1] = nil
+				return contents
+			},
+			err: "blob sidecar 1 missing",
+		},
+		{
+			name: "SidecarsOutOfOrder",
+			contents: func() *apiv1deneb.SignedBlockContents {
+				contents := testSignedBlockContents(t)
+				contents.SignedBlobSidecars[0], contents.SignedBlobSidecars[1] = contents.SignedBlobSidecars[1], contents.SignedBlobSidecars[0]
+				return contents
+			},
+			err: "blob sidecar 0 has index 1",
+		},
+		{
+			name: "CommitmentMismatch",
+			contents: func() *apiv1deneb.SignedBlockContents {
+				contents := testSignedBlockContents(t)
+				contents.SignedBlobSidecars[2].Message.KzgCommitment = deneb.KzgCommitment{0x20}
+				return contents
+			},
+			err: "blob sidecar 2 invalid: blob sidecar commitment 0x200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000 does not match block commitment 0x120000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			name:     "KZGProofInvalid",
+			contents: testSignedBlockContentsFunc(t),
+			verifier: &testVerifier{err: errors.New("bad proof")},
+			err:      "blob sidecar 0 invalid: invalid KZG proof: bad proof",
+		},
+		{
+			name:     "Good",
+			contents: testSignedBlockContentsFunc(t),
+		},
+		{
+			name:     "GoodWithVerifier",
+			contents: testSignedBlockContentsFunc(t),
+			verifier: &testVerifier{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := utildeneb.VerifySignedBlockContents(test.contents(), test.verifier)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func testSignedBlockContentsFunc(t *testing.T) func() *apiv1deneb.SignedBlockContents {
+	t.Helper()
+
+	return func() *apiv1deneb.SignedBlockContents { return testSignedBlockContents(t) }
+}
@@ -0,0 +1,40 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build gokzg
+
+package deneb
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+)
+
+// GoKZGVerifier is a KZGVerifier backed by go-kzg-4844.
+// It is only available when building with the "gokzg" build tag.
+type GoKZGVerifier struct {
+	ctx *gokzg4844.Context
+}
+
+// NewGoKZGVerifier creates a KZG verifier using the given go-kzg-4844 context, which
+// holds the trusted setup.
+func NewGoKZGVerifier(ctx *gokzg4844.Context) *GoKZGVerifier {
+	return &GoKZGVerifier{
+		ctx: ctx,
+	}
+}
+
+// VerifyBlobKZGProof verifies that the proof is valid for the blob and its commitment.
+func (v *GoKZGVerifier) VerifyBlobKZGProof(blob *deneb.Blob, commitment deneb.KzgCommitment, proof deneb.KzgProof) error {
+	return v.ctx.VerifyBlobKZGProof(gokzg4844.Blob(*blob), gokzg4844.KZGCommitment(commitment), gokzg4844.KZGProof(proof))
+}
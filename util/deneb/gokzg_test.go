@@ -0,0 +1,45 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build gokzg
+
+package deneb_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	utildeneb "github.com/attestantio/go-eth2-client/util/deneb"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoKZGVerifier(t *testing.T) {
+	ctx, err := gokzg4844.NewContext4096Insecure1337()
+	require.NoError(t, err)
+
+	sidecar := &deneb.BlobSidecar{}
+	sidecar.Blob[1] = 0x01
+	commitment, err := ctx.BlobToKZGCommitment(gokzg4844.Blob(sidecar.Blob), 0)
+	require.NoError(t, err)
+	sidecar.KzgCommitment = deneb.KzgCommitment(commitment)
+	proof, err := ctx.ComputeBlobKZGProof(gokzg4844.Blob(sidecar.Blob), commitment, 0)
+	require.NoError(t, err)
+	sidecar.KzgProof = deneb.KzgProof(proof)
+
+	verifier := utildeneb.NewGoKZGVerifier(ctx)
+	require.NoError(t, utildeneb.VerifyBlobSidecarKZGProof(sidecar, verifier))
+
+	sidecar.Blob[1] = 0x02
+	require.Error(t, utildeneb.VerifyBlobSidecarKZGProof(sidecar, verifier))
+}
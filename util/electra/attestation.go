@@ -0,0 +1,243 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package electra provides helpers for the attestation formats introduced in the Electra fork.
+//
+// This library does not yet define the Electra containers, so the helpers operate on the
+// fields from which the Electra SingleAttestation and on-chain Attestation are built, and
+// translate between those fields and the pre-Electra attestation format.
+package electra
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	utilbitfield "github.com/attestantio/go-eth2-client/util/bitfield"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// maxCommitteesPerSlot is the number of committees that can be represented in committee bits.
+const maxCommitteesPerSlot = 64
+
+// OnChainAggregate is the content of an Electra on-chain aggregate attestation, in which the
+// attestations of multiple committees in a slot are combined.
+// The aggregation bits are the concatenation of the aggregation bits of each committee whose bit is
+// set in the committee bits, in increasing order of committee index.
+type OnChainAggregate struct {
+	AggregationBits bitfield.Bitlist
+	Data            *phase0.AttestationData
+	Signature       phase0.BLSSignature
+	CommitteeBits   bitfield.Bitvector64
+}
+
+// CommitteeBits returns the committee bits with the bits for the given committee indices set.
+func CommitteeBits(indices []phase0.CommitteeIndex) (bitfield.Bitvector64, error) {
+	bits := bitfield.NewBitvector64()
+	for _, index := range indices {
+		if index >= maxCommitteesPerSlot {
+			return nil, fmt.Errorf("committee index %d out of range", index)
+		}
+		if bits.BitAt(uint64(index)) {
+			return nil, fmt.Errorf("duplicate committee index %d", index)
+		}
+		bits.SetBitAt(uint64(index), true)
+	}
+
+	return bits, nil
+}
+
+// CommitteeIndices returns the committee indices whose bits are set in the committee bits, in increasing order.
+func CommitteeIndices(bits bitfield.Bitvector64) []phase0.CommitteeIndex {
+	setIndices := utilbitfield.VectorIndices(bits)
+	indices := make([]phase0.CommitteeIndex, len(setIndices))
+	for i := range setIndices {
+		indices[i] = phase0.CommitteeIndex(setIndices[i])
+	}
+
+	return indices
+}
+
+// AggregateOnChain combines pre-Electra attestations from different committees of the same slot into
+// an on-chain aggregate.  Apart from their committee index the attestations must have the same data,
+// and there can be only one attestation per committee; attestations from the same committee should be
+// aggregated beforehand.
+//
+// This library does not carry a BLS implementation, so the caller is responsible for aggregating
+// the signatures of the attestations and supplying the result.
+func AggregateOnChain(attestations []*phase0.Attestation, signature phase0.BLSSignature) (*OnChainAggregate, error) {
+	if len(attestations) == 0 {
+		return nil, errors.New("no attestations supplied")
+	}
+
+	sorted := make([]*phase0.Attestation, len(attestations))
+	copy(sorted, attestations)
+	for i, attestation := range sorted {
+		if attestation == nil || attestation.Data == nil {
+			return nil, fmt.Errorf("attestation %d is empty", i)
+		}
+	}
+	sort.Slice(sorted, func(i int, j int) bool {
+		return sorted[i].Data.Index < sorted[j].Data.Index
+	})
+
+	// On-chain aggregates carry a committee index of 0 in their data.
+	data := *sorted[0].Data
+	data.Index = 0
+	dataRoot, err := data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate attestation data root")
+	}
+
+	indices := make([]phase0.CommitteeIndex, len(sorted))
+	memberBits := make([]bool, 0)
+	for i, attestation := range sorted {
+		indices[i] = attestation.Data.Index
+		attestationData := *attestation.Data
+		attestationData.Index = 0
+		root, err := attestationData.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to calculate attestation data root")
+		}
+		if root != dataRoot {
+			return nil, fmt.Errorf("attestation data for committee %d differs", attestation.Data.Index)
+		}
+
+		length, err := utilbitfield.ListLen(attestation.AggregationBits)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("invalid aggregation bits for committee %d", attestation.Data.Index))
+		}
+		for bit := uint64(0); bit < length; bit++ {
+			memberBits = append(memberBits, attestation.AggregationBits.BitAt(bit))
+		}
+	}
+	committeeBits, err := CommitteeBits(indices)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregationBits := bitfield.NewBitlist(uint64(len(memberBits)))
+	for i, set := range memberBits {
+		aggregationBits.SetBitAt(uint64(i), set)
+	}
+
+	return &OnChainAggregate{
+		AggregationBits: aggregationBits,
+		Data:            &data,
+		Signature:       signature,
+		CommitteeBits:   committeeBits,
+	}, nil
+}
+
+// CommitteeAggregationBits splits the aggregation bits of an on-chain aggregate into the aggregation
+// bits of each of its committees, given the size of each committee in the slot.
+// This follows the committee offset calculation of get_attesting_indices in the specification.
+func CommitteeAggregationBits(aggregate *OnChainAggregate,
+	committeeSizes map[phase0.CommitteeIndex]uint64,
+) (
+	map[phase0.CommitteeIndex]bitfield.Bitlist,
+	error,
+) {
+	if aggregate == nil {
+		return nil, errors.New("no aggregate supplied")
+	}
+	if utilbitfield.VectorLen(aggregate.CommitteeBits) != maxCommitteesPerSlot {
+		return nil, errors.New("invalid committee bits")
+	}
+	length, err := utilbitfield.ListLen(aggregate.AggregationBits)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid aggregation bits")
+	}
+
+	res := make(map[phase0.CommitteeIndex]bitfield.Bitlist)
+	offset := uint64(0)
+	for _, index := range CommitteeIndices(aggregate.CommitteeBits) {
+		size, exists := committeeSizes[index]
+		if !exists {
+			return nil, fmt.Errorf("no size for committee %d", index)
+		}
+		if offset+size > length {
+			return nil, fmt.Errorf("aggregation bits too short for committee %d", index)
+		}
+		bits := bitfield.NewBitlist(size)
+		for i := uint64(0); i < size; i++ {
+			bits.SetBitAt(i, aggregate.AggregationBits.BitAt(offset+i))
+		}
+		res[index] = bits
+		offset += size
+	}
+	if offset != length {
+		return nil, fmt.Errorf("aggregation bits length %d does not match committees length %d", length, offset)
+	}
+
+	return res, nil
+}
+
+// AttestationFromSingle creates the pre-Electra attestation equivalent to the Electra single attestation
+// with the given fields, given the members of the attester's committee.
+func AttestationFromSingle(committeeIndex phase0.CommitteeIndex,
+	attesterIndex phase0.ValidatorIndex,
+	data *phase0.AttestationData,
+	signature phase0.BLSSignature,
+	committee []phase0.ValidatorIndex,
+) (
+	*phase0.Attestation,
+	error,
+) {
+	if data == nil {
+		return nil, errors.New("no attestation data supplied")
+	}
+
+	position := -1
+	for i := range committee {
+		if committee[i] == attesterIndex {
+			position = i
+			break
+		}
+	}
+	if position == -1 {
+		return nil, fmt.Errorf("validator %d not in committee %d", attesterIndex, committeeIndex)
+	}
+
+	attestationData := *data
+	attestationData.Index = committeeIndex
+	aggregationBits := bitfield.NewBitlist(uint64(len(committee)))
+	aggregationBits.SetBitAt(uint64(position), true)
+
+	return &phase0.Attestation{
+		AggregationBits: aggregationBits,
+		Data:            &attestationData,
+		Signature:       signature,
+	}, nil
+}
+
+// SingleAttester returns the index of the validator that created a pre-Electra attestation, given the members
+// of the attestation's committee.  Along with the attestation's committee index, data and signature this
+// provides the fields of the equivalent Electra single attestation.
+// The attestation must have exactly one aggregation bit set.
+func SingleAttester(attestation *phase0.Attestation, committee []phase0.ValidatorIndex) (phase0.ValidatorIndex, error) {
+	if attestation == nil {
+		return 0, errors.New("no attestation supplied")
+	}
+
+	members, err := utilbitfield.ListMembers(attestation.AggregationBits, committee)
+	if err != nil {
+		return 0, err
+	}
+	if len(members) != 1 {
+		return 0, fmt.Errorf("attestation has %d aggregation bits set; expected 1", len(members))
+	}
+
+	return members[0], nil
+}
@@ -0,0 +1,131 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/electra"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func testData(index phase0.CommitteeIndex) *phase0.AttestationData {
+	return &phase0.AttestationData{
+		Slot:            100,
+		Index:           index,
+		BeaconBlockRoot: phase0.Root{0x01},
+		Source:          &phase0.Checkpoint{Epoch: 2, Root: phase0.Root{0x02}},
+		Target:          &phase0.Checkpoint{Epoch: 3, Root: phase0.Root{0x03}},
+	}
+}
+
+func testBits(length uint64, set ...uint64) bitfield.Bitlist {
+	bits := bitfield.NewBitlist(length)
+	for _, i := range set {
+		bits.SetBitAt(i, true)
+	}
+
+	return bits
+}
+
+func TestCommitteeBits(t *testing.T) {
+	bits, err := electra.CommitteeBits([]phase0.CommitteeIndex{5, 0, 63})
+	require.NoError(t, err)
+	require.Equal(t, []phase0.CommitteeIndex{0, 5, 63}, electra.CommitteeIndices(bits))
+
+	_, err = electra.CommitteeBits([]phase0.CommitteeIndex{64})
+	require.EqualError(t, err, "committee index 64 out of range")
+	_, err = electra.CommitteeBits([]phase0.CommitteeIndex{3, 3})
+	require.EqualError(t, err, "duplicate committee index 3")
+}
+
+func TestAggregateOnChain(t *testing.T) {
+	attestations := []*phase0.Attestation{
+		{
+			AggregationBits: testBits(4, 1),
+			Data:            testData(7),
+		},
+		{
+			AggregationBits: testBits(3, 0, 2),
+			Data:            testData(2),
+		},
+	}
+
+	aggregate, err := electra.AggregateOnChain(attestations, phase0.BLSSignature{0x01})
+	require.NoError(t, err)
+	require.Equal(t, []phase0.CommitteeIndex{2, 7}, electra.CommitteeIndices(aggregate.CommitteeBits))
+	require.Equal(t, testBits(7, 0, 2, 4), aggregate.AggregationBits)
+	require.Equal(t, phase0.CommitteeIndex(0), aggregate.Data.Index)
+	require.Equal(t, phase0.BLSSignature{0x01}, aggregate.Signature)
+	// Input is unaltered.
+	require.Equal(t, phase0.CommitteeIndex(7), attestations[0].Data.Index)
+
+	committeeBits, err := electra.CommitteeAggregationBits(aggregate, map[phase0.CommitteeIndex]uint64{2: 3, 7: 4})
+	require.NoError(t, err)
+	require.Equal(t, map[phase0.CommitteeIndex]bitfield.Bitlist{
+		2: testBits(3, 0, 2),
+		7: testBits(4, 1),
+	}, committeeBits)
+
+	_, err = electra.CommitteeAggregationBits(aggregate, map[phase0.CommitteeIndex]uint64{2: 3})
+	require.EqualError(t, err, "no size for committee 7")
+	_, err = electra.CommitteeAggregationBits(aggregate, map[phase0.CommitteeIndex]uint64{2: 3, 7: 5})
+	require.EqualError(t, err, "aggregation bits too short for committee 7")
+	_, err = electra.CommitteeAggregationBits(aggregate, map[phase0.CommitteeIndex]uint64{2: 2, 7: 4})
+	require.EqualError(t, err, "aggregation bits length 7 does not match committees length 6")
+}
+
+func TestAggregateOnChainErrors(t *testing.T) {
+	_, err := electra.AggregateOnChain(nil, phase0.BLSSignature{})
+	require.EqualError(t, err, "no attestations supplied")
+
+	_, err = electra.AggregateOnChain([]*phase0.Attestation{nil}, phase0.BLSSignature{})
+	require.EqualError(t, err, "attestation 0 is empty")
+
+	otherData := testData(2)
+	otherData.BeaconBlockRoot = phase0.Root{0xff}
+	_, err = electra.AggregateOnChain([]*phase0.Attestation{
+		{AggregationBits: testBits(4, 1), Data: testData(1)},
+		{AggregationBits: testBits(4, 1), Data: otherData},
+	}, phase0.BLSSignature{})
+	require.EqualError(t, err, "attestation data for committee 2 differs")
+
+	_, err = electra.AggregateOnChain([]*phase0.Attestation{
+		{AggregationBits: testBits(4, 1), Data: testData(1)},
+		{AggregationBits: testBits(4, 2), Data: testData(1)},
+	}, phase0.BLSSignature{})
+	require.EqualError(t, err, "duplicate committee index 1")
+}
+
+func TestSingleAttestation(t *testing.T) {
+	committee := []phase0.ValidatorIndex{10, 20, 30, 40}
+
+	attestation, err := electra.AttestationFromSingle(3, 30, testData(0), phase0.BLSSignature{0x01}, committee)
+	require.NoError(t, err)
+	require.Equal(t, testBits(4, 2), attestation.AggregationBits)
+	require.Equal(t, phase0.CommitteeIndex(3), attestation.Data.Index)
+
+	attester, err := electra.SingleAttester(attestation, committee)
+	require.NoError(t, err)
+	require.Equal(t, phase0.ValidatorIndex(30), attester)
+
+	_, err = electra.AttestationFromSingle(3, 50, testData(0), phase0.BLSSignature{}, committee)
+	require.EqualError(t, err, "validator 50 not in committee 3")
+
+	attestation.AggregationBits.SetBitAt(0, true)
+	_, err = electra.SingleAttester(attestation, committee)
+	require.EqualError(t, err, "attestation has 2 aggregation bits set; expected 1")
+}
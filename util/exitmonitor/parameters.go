@@ -0,0 +1,111 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exitmonitor
+
+import (
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel          zerolog.Level
+	client            consensusclient.Service
+	reconcileInterval time.Duration
+	validatorIndices  []phase0.ValidatorIndex
+	handler           HandlerFunc
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithClient sets the client from which to obtain voluntary exits.
+// The client must provide both events and the voluntary exit pool.
+func WithClient(client consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.client = client
+	})
+}
+
+// WithReconcileInterval sets the interval at which the voluntary exit pool is fetched to
+// pick up any exits missed by the event stream.
+func WithReconcileInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.reconcileInterval = interval
+	})
+}
+
+// WithValidatorIndices restricts notifications to exits of the given validators.
+// If not supplied, exits of all validators are notified.
+func WithValidatorIndices(indices []phase0.ValidatorIndex) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validatorIndices = indices
+	})
+}
+
+// WithHandler sets the handler called for each new voluntary exit.
+func WithHandler(handler HandlerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.handler = handler
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:          zerolog.GlobalLevel(),
+		reconcileInterval: time.Minute,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.client == nil {
+		return nil, errors.New("no client specified")
+	}
+	if _, isProvider := parameters.client.(consensusclient.EventsProvider); !isProvider {
+		return nil, errors.New("client does not provide events")
+	}
+	if _, isProvider := parameters.client.(consensusclient.VoluntaryExitPoolProvider); !isProvider {
+		return nil, errors.New("client does not provide voluntary exit pool")
+	}
+	if parameters.reconcileInterval <= 0 {
+		return nil, errors.New("reconcile interval must be positive")
+	}
+	if parameters.handler == nil {
+		return nil, errors.New("no handler specified")
+	}
+
+	return &parameters, nil
+}
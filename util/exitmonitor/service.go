@@ -0,0 +1,197 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package exitmonitor watches for voluntary exits, combining the voluntary_exit event stream
+// with periodic reconciliation against the voluntary exit pool, and notifies a handler once for
+// each validator that exits.  This allows operators to detect exits of their validators quickly
+// whilst not missing any exits broadcast while the event stream was disconnected.
+package exitmonitor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Source is the source from which a voluntary exit was obtained.
+type Source int
+
+const (
+	// SourceEvent is an exit received from the voluntary_exit event stream.
+	SourceEvent Source = iota
+	// SourcePool is an exit found in the voluntary exit pool.
+	SourcePool
+)
+
+var sourceStrings = [...]string{
+	"event",
+	"pool",
+}
+
+func (s Source) String() string {
+	if int(s) < 0 || int(s) >= len(sourceStrings) {
+		return "unknown"
+	}
+
+	return sourceStrings[s]
+}
+
+// Exit is sent to the handler the first time a voluntary exit is seen for a validator.
+type Exit struct {
+	Source        Source
+	VoluntaryExit *phase0.SignedVoluntaryExit
+}
+
+// HandlerFunc is the handler called for voluntary exits.
+type HandlerFunc func(ctx context.Context, exit *Exit)
+
+// Service monitors voluntary exits.
+type Service struct {
+	log               zerolog.Logger
+	poolProvider      consensusclient.VoluntaryExitPoolProvider
+	reconcileInterval time.Duration
+	validatorIndices  map[phase0.ValidatorIndex]struct{}
+	handler           HandlerFunc
+
+	mu    sync.Mutex
+	exits map[phase0.ValidatorIndex]*phase0.SignedVoluntaryExit
+}
+
+// New creates a new voluntary exit monitor.
+// The voluntary exit pool is reconciled before returning, and exits are monitored until the context is done.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	log := zerologger.With().Str("service", "exitmonitor").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	var validatorIndices map[phase0.ValidatorIndex]struct{}
+	if len(parameters.validatorIndices) > 0 {
+		validatorIndices = make(map[phase0.ValidatorIndex]struct{}, len(parameters.validatorIndices))
+		for _, index := range parameters.validatorIndices {
+			validatorIndices[index] = struct{}{}
+		}
+	}
+
+	s := &Service{
+		log:               log,
+		poolProvider:      parameters.client.(consensusclient.VoluntaryExitPoolProvider),
+		reconcileInterval: parameters.reconcileInterval,
+		validatorIndices:  validatorIndices,
+		handler:           parameters.handler,
+		exits:             make(map[phase0.ValidatorIndex]*phase0.SignedVoluntaryExit),
+	}
+
+	if err := parameters.client.(consensusclient.EventsProvider).Events(ctx, []string{"voluntary_exit"}, s.handleEvent); err != nil {
+		return nil, errors.Wrap(err, "failed to subscribe to voluntary exit events")
+	}
+
+	if err := s.reconcile(ctx); err != nil {
+		return nil, err
+	}
+
+	go s.reconcileLoop(ctx)
+
+	return s, nil
+}
+
+// Exit returns the voluntary exit seen for the given validator, or nil if none has been seen.
+func (s *Service) Exit(index phase0.ValidatorIndex) *phase0.SignedVoluntaryExit {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.exits[index]
+}
+
+// handleEvent handles voluntary exit events.
+func (s *Service) handleEvent(event *apiv1.Event) {
+	voluntaryExit, isVoluntaryExit := event.Data.(*phase0.SignedVoluntaryExit)
+	if !isVoluntaryExit {
+		s.log.Warn().Str("topic", event.Topic).Msg("Unexpected event data")
+		return
+	}
+
+	// Events are not delivered with a context, so the handler is called without one.
+	s.process(context.Background(), voluntaryExit, SourceEvent)
+}
+
+// reconcileLoop reconciles the voluntary exit pool at the reconcile interval until the context is done.
+func (s *Service) reconcileLoop(ctx context.Context) {
+	ticker := time.NewTicker(s.reconcileInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.reconcile(ctx); err != nil {
+				s.log.Warn().Err(err).Msg("Failed to reconcile voluntary exit pool")
+			}
+		}
+	}
+}
+
+// reconcile processes the exits in the voluntary exit pool.
+func (s *Service) reconcile(ctx context.Context) error {
+	pool, err := s.poolProvider.VoluntaryExitPool(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain voluntary exit pool")
+	}
+	s.log.Trace().Int("exits", len(pool)).Msg("Reconciling voluntary exit pool")
+
+	for _, voluntaryExit := range pool {
+		s.process(ctx, voluntaryExit, SourcePool)
+	}
+
+	return nil
+}
+
+// process notifies the handler of the exit if it is of interest and has not been seen before.
+func (s *Service) process(ctx context.Context, voluntaryExit *phase0.SignedVoluntaryExit, source Source) {
+	if voluntaryExit == nil || voluntaryExit.Message == nil {
+		return
+	}
+	index := voluntaryExit.Message.ValidatorIndex
+	if s.validatorIndices != nil {
+		if _, exists := s.validatorIndices[index]; !exists {
+			return
+		}
+	}
+
+	s.mu.Lock()
+	if _, exists := s.exits[index]; exists {
+		s.mu.Unlock()
+		return
+	}
+	s.exits[index] = voluntaryExit
+	s.mu.Unlock()
+
+	s.log.Debug().Uint64("validator_index", uint64(index)).Stringer("source", source).Msg("Voluntary exit seen")
+	s.handler(ctx, &Exit{
+		Source:        source,
+		VoluntaryExit: voluntaryExit,
+	})
+}
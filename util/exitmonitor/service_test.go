@@ -0,0 +1,217 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exitmonitor_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/exitmonitor"
+	"github.com/stretchr/testify/require"
+)
+
+// exitClient is a client with a controllable voluntary exit pool and event stream.
+type exitClient struct {
+	consensusclient.Service
+	mu      sync.Mutex
+	pool    []*phase0.SignedVoluntaryExit
+	poolErr error
+	handler consensusclient.EventHandlerFunc
+}
+
+func (c *exitClient) Events(_ context.Context, _ []string, handler consensusclient.EventHandlerFunc) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.handler = handler
+
+	return nil
+}
+
+func (c *exitClient) VoluntaryExitPool(_ context.Context) ([]*phase0.SignedVoluntaryExit, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.pool, c.poolErr
+}
+
+func (c *exitClient) setPool(pool []*phase0.SignedVoluntaryExit) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pool = pool
+}
+
+func (c *exitClient) sendEvent(voluntaryExit *phase0.SignedVoluntaryExit) {
+	c.mu.Lock()
+	handler := c.handler
+	c.mu.Unlock()
+	handler(&apiv1.Event{Topic: "voluntary_exit", Data: voluntaryExit})
+}
+
+func voluntaryExit(index phase0.ValidatorIndex) *phase0.SignedVoluntaryExit {
+	return &phase0.SignedVoluntaryExit{
+		Message: &phase0.VoluntaryExit{
+			Epoch:          10,
+			ValidatorIndex: index,
+		},
+	}
+}
+
+// recorder records the exits sent to the handler.
+type recorder struct {
+	mu    sync.Mutex
+	exits []*exitmonitor.Exit
+}
+
+func (r *recorder) handle(_ context.Context, exit *exitmonitor.Exit) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.exits = append(r.exits, exit)
+}
+
+func (r *recorder) sources() map[phase0.ValidatorIndex]exitmonitor.Source {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res := make(map[phase0.ValidatorIndex]exitmonitor.Source, len(r.exits))
+	for _, exit := range r.exits {
+		res[exit.VoluntaryExit.Message.ValidatorIndex] = exit.Source
+	}
+
+	return res
+}
+
+func (r *recorder) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.exits)
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+	handler := func(_ context.Context, _ *exitmonitor.Exit) {}
+
+	tests := []struct {
+		name   string
+		params []exitmonitor.Parameter
+		err    string
+	}{
+		{
+			name: "ClientMissing",
+			params: []exitmonitor.Parameter{
+				exitmonitor.WithHandler(handler),
+			},
+			err: "problem with parameters: no client specified",
+		},
+		{
+			name: "ClientNotPoolProvider",
+			params: []exitmonitor.Parameter{
+				exitmonitor.WithClient(client),
+				exitmonitor.WithHandler(handler),
+			},
+			err: "problem with parameters: client does not provide voluntary exit pool",
+		},
+		{
+			name: "ReconcileIntervalZero",
+			params: []exitmonitor.Parameter{
+				exitmonitor.WithClient(&exitClient{Service: client}),
+				exitmonitor.WithReconcileInterval(0),
+				exitmonitor.WithHandler(handler),
+			},
+			err: "problem with parameters: reconcile interval must be positive",
+		},
+		{
+			name: "HandlerMissing",
+			params: []exitmonitor.Parameter{
+				exitmonitor.WithClient(&exitClient{Service: client}),
+			},
+			err: "problem with parameters: no handler specified",
+		},
+		{
+			name: "PoolError",
+			params: []exitmonitor.Parameter{
+				exitmonitor.WithClient(&exitClient{Service: client, poolErr: errors.New("pool error")}),
+				exitmonitor.WithHandler(handler),
+			},
+			err: "failed to obtain voluntary exit pool: pool error",
+		},
+		{
+			name: "Good",
+			params: []exitmonitor.Parameter{
+				exitmonitor.WithClient(&exitClient{Service: client}),
+				exitmonitor.WithHandler(handler),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := exitmonitor.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMonitor(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	exits := &exitClient{
+		Service: client,
+		pool:    []*phase0.SignedVoluntaryExit{voluntaryExit(1), voluntaryExit(5)},
+	}
+	rec := &recorder{}
+	s, err := exitmonitor.New(ctx,
+		exitmonitor.WithClient(exits),
+		exitmonitor.WithReconcileInterval(10*time.Millisecond),
+		exitmonitor.WithValidatorIndices([]phase0.ValidatorIndex{1, 2, 3}),
+		exitmonitor.WithHandler(rec.handle),
+	)
+	require.NoError(t, err)
+
+	// Initial reconciliation only notifies exits of monitored validators.
+	require.Equal(t, map[phase0.ValidatorIndex]exitmonitor.Source{1: exitmonitor.SourcePool}, rec.sources())
+	require.NotNil(t, s.Exit(1))
+	require.Nil(t, s.Exit(5))
+
+	// Events are notified once, regardless of repetition or later appearance in the pool.
+	exits.sendEvent(voluntaryExit(2))
+	exits.sendEvent(voluntaryExit(2))
+	exits.sendEvent(voluntaryExit(1))
+	exits.setPool([]*phase0.SignedVoluntaryExit{voluntaryExit(1), voluntaryExit(2), voluntaryExit(3)})
+
+	// Exits missed by the event stream are picked up from the pool.
+	require.Eventually(t, func() bool { return rec.count() == 3 }, time.Second, 5*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, map[phase0.ValidatorIndex]exitmonitor.Source{
+		1: exitmonitor.SourcePool,
+		2: exitmonitor.SourceEvent,
+		3: exitmonitor.SourcePool,
+	}, rec.sources())
+	require.Equal(t, 3, rec.count())
+}
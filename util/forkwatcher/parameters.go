@@ -0,0 +1,104 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forkwatcher
+
+import (
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/util/chaintime"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel  zerolog.Level
+	client    consensusclient.Service
+	chainTime *chaintime.ChainTime
+	leadTime  time.Duration
+	handler   HandlerFunc
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithClient sets the client from which to obtain the fork schedule.
+// The client must be a fork schedule provider, and if no chain time is supplied
+// must also provide genesis and spec.
+func WithClient(client consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.client = client
+	})
+}
+
+// WithChainTime sets the chain time used to schedule fork events.
+func WithChainTime(chainTime *chaintime.ChainTime) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chainTime = chainTime
+	})
+}
+
+// WithLeadTime sets how long before a fork activates the upcoming fork event is sent.
+func WithLeadTime(leadTime time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.leadTime = leadTime
+	})
+}
+
+// WithHandler sets the handler called for fork events.
+func WithHandler(handler HandlerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.handler = handler
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+		leadTime: time.Minute,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.client == nil {
+		return nil, errors.New("no client specified")
+	}
+	if _, isProvider := parameters.client.(consensusclient.ForkScheduleProvider); !isProvider {
+		return nil, errors.New("client does not provide fork schedule")
+	}
+	if parameters.leadTime < 0 {
+		return nil, errors.New("lead time cannot be negative")
+	}
+
+	return &parameters, nil
+}
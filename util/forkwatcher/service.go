@@ -0,0 +1,217 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package forkwatcher caches the fork schedule of the chain and notifies a handler shortly
+// before and at the activation of each future fork, so that dependent services can switch
+// their code paths in time for the fork.
+package forkwatcher
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/chaintime"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// farFutureEpoch is the epoch used for forks that are not scheduled.
+const farFutureEpoch = phase0.Epoch(0xffffffffffffffff)
+
+// Stage is the stage of a fork to which an event relates.
+type Stage int
+
+const (
+	// StageUpcoming is sent the lead time before the fork activates.
+	StageUpcoming Stage = iota
+	// StageActivated is sent when the fork activates.
+	StageActivated
+)
+
+var stageStrings = [...]string{
+	"upcoming",
+	"activated",
+}
+
+func (s Stage) String() string {
+	if int(s) < 0 || int(s) >= len(stageStrings) {
+		return "unknown"
+	}
+
+	return stageStrings[s]
+}
+
+// Event is sent to the handler when a fork is upcoming or activates.
+type Event struct {
+	Stage Stage
+	Fork  *phase0.Fork
+	// ActivationTime is the time at which the fork activates.
+	ActivationTime time.Time
+}
+
+// HandlerFunc is the handler called for fork events.
+type HandlerFunc func(ctx context.Context, event *Event)
+
+// Service watches the fork schedule of the chain.
+type Service struct {
+	log       zerolog.Logger
+	chainTime *chaintime.ChainTime
+	leadTime  time.Duration
+	handler   HandlerFunc
+	schedule  []*phase0.Fork
+}
+
+// New creates a new fork watcher.
+// Fork events are sent until the context is done.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	log := zerologger.With().Str("service", "forkwatcher").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	chainTime := parameters.chainTime
+	if chainTime == nil {
+		chainTimeParams, err := chaintime.ParametersFromClient(ctx, parameters.client)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain chain time parameters")
+		}
+		chainTime, err = chaintime.New(chainTimeParams)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create chain time")
+		}
+	}
+
+	forkSchedule, err := parameters.client.(consensusclient.ForkScheduleProvider).ForkSchedule(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain fork schedule")
+	}
+	if len(forkSchedule) == 0 {
+		return nil, errors.New("fork schedule empty")
+	}
+	schedule := make([]*phase0.Fork, 0, len(forkSchedule))
+	for _, fork := range forkSchedule {
+		if fork != nil {
+			schedule = append(schedule, fork)
+		}
+	}
+	sort.SliceStable(schedule, func(i int, j int) bool {
+		return schedule[i].Epoch < schedule[j].Epoch
+	})
+
+	s := &Service{
+		log:       log,
+		chainTime: chainTime,
+		leadTime:  parameters.leadTime,
+		handler:   parameters.handler,
+		schedule:  schedule,
+	}
+
+	if s.handler != nil {
+		go s.watch(ctx)
+	}
+
+	return s, nil
+}
+
+// Schedule returns the fork schedule, ordered by epoch.
+func (s *Service) Schedule() []*phase0.Fork {
+	res := make([]*phase0.Fork, len(s.schedule))
+	copy(res, s.schedule)
+
+	return res
+}
+
+// ForkAtEpoch returns the fork in effect at the given epoch.
+func (s *Service) ForkAtEpoch(epoch phase0.Epoch) *phase0.Fork {
+	res := s.schedule[0]
+	for _, fork := range s.schedule {
+		if fork.Epoch > epoch {
+			break
+		}
+		res = fork
+	}
+
+	return res
+}
+
+// CurrentFork returns the fork in effect at the given slot.
+func (s *Service) CurrentFork(slot phase0.Slot) *phase0.Fork {
+	return s.ForkAtEpoch(s.chainTime.SlotToEpoch(slot))
+}
+
+// NextFork returns the first scheduled fork after the given epoch, or nil if there is none.
+func (s *Service) NextFork(epoch phase0.Epoch) *phase0.Fork {
+	for _, fork := range s.schedule {
+		if fork.Epoch > epoch && fork.Epoch != farFutureEpoch {
+			return fork
+		}
+	}
+
+	return nil
+}
+
+// watch sends events for future forks until the context is done.
+func (s *Service) watch(ctx context.Context) {
+	for _, fork := range s.schedule {
+		if fork.Epoch == farFutureEpoch {
+			continue
+		}
+		activationTime := s.chainTime.EpochStart(fork.Epoch)
+		if !activationTime.After(time.Now()) {
+			// Fork already active.
+			continue
+		}
+
+		s.log.Trace().Uint64("epoch", uint64(fork.Epoch)).Time("activation_time", activationTime).Msg("Watching fork")
+		if !s.sendAt(ctx, activationTime.Add(-s.leadTime), fork, StageUpcoming, activationTime) {
+			return
+		}
+		if !s.sendAt(ctx, activationTime, fork, StageActivated, activationTime) {
+			return
+		}
+	}
+}
+
+// sendAt sends the event at the given time, returning false if the context finished first.
+func (s *Service) sendAt(ctx context.Context,
+	at time.Time,
+	fork *phase0.Fork,
+	stage Stage,
+	activationTime time.Time,
+) bool {
+	timer := time.NewTimer(time.Until(at))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+	}
+
+	s.log.Debug().Uint64("epoch", uint64(fork.Epoch)).Stringer("stage", stage).Msg("Sending fork event")
+	s.handler(ctx, &Event{
+		Stage:          stage,
+		Fork:           fork,
+		ActivationTime: activationTime,
+	})
+
+	return true
+}
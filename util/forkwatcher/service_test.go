@@ -0,0 +1,167 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forkwatcher_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/chaintime"
+	"github.com/attestantio/go-eth2-client/util/forkwatcher"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// scheduleClient is a client that provides a fixed fork schedule.
+type scheduleClient struct {
+	consensusclient.Service
+	schedule []*phase0.Fork
+}
+
+func (c *scheduleClient) ForkSchedule(_ context.Context) ([]*phase0.Fork, error) {
+	return c.schedule, nil
+}
+
+func testChainTime(t *testing.T, genesisTime time.Time) *chaintime.ChainTime {
+	t.Helper()
+
+	chainTime, err := chaintime.New(&chaintime.Parameters{
+		GenesisTime:                  genesisTime,
+		SlotDuration:                 10 * time.Millisecond,
+		SlotsPerEpoch:                1,
+		EpochsPerSyncCommitteePeriod: 256,
+	})
+	require.NoError(t, err)
+
+	return chainTime
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []forkwatcher.Parameter
+		err    string
+	}{
+		{
+			name: "ClientMissing",
+			err:  "problem with parameters: no client specified",
+		},
+		{
+			name: "LeadTimeNegative",
+			params: []forkwatcher.Parameter{
+				forkwatcher.WithClient(client),
+				forkwatcher.WithLeadTime(-time.Second),
+			},
+			err: "problem with parameters: lead time cannot be negative",
+		},
+		{
+			name: "ScheduleEmpty",
+			params: []forkwatcher.Parameter{
+				forkwatcher.WithClient(&scheduleClient{Service: client}),
+				forkwatcher.WithChainTime(testChainTime(t, time.Now())),
+			},
+			err: "fork schedule empty",
+		},
+		{
+			name: "Good",
+			params: []forkwatcher.Parameter{
+				forkwatcher.WithLogLevel(zerolog.Disabled),
+				forkwatcher.WithClient(client),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := forkwatcher.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestForkAtEpoch(t *testing.T) {
+	ctx := context.Background()
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	s, err := forkwatcher.New(ctx,
+		forkwatcher.WithLogLevel(zerolog.Disabled),
+		forkwatcher.WithClient(client),
+		forkwatcher.WithChainTime(testChainTime(t, time.Now())),
+	)
+	require.NoError(t, err)
+
+	require.Len(t, s.Schedule(), 2)
+	require.Equal(t, phase0.Epoch(0), s.ForkAtEpoch(1023).Epoch)
+	require.Equal(t, phase0.Epoch(1024), s.ForkAtEpoch(1024).Epoch)
+	require.Equal(t, phase0.Epoch(1024), s.CurrentFork(2000).Epoch)
+	require.Equal(t, phase0.Epoch(1024), s.NextFork(0).Epoch)
+	require.Nil(t, s.NextFork(1024))
+}
+
+func TestEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+	schedule := []*phase0.Fork{
+		{Epoch: 0},
+		{Epoch: 0xffffffffffffffff},
+		{CurrentVersion: phase0.Version{0x01}, Epoch: 20},
+	}
+
+	var mu sync.Mutex
+	events := make([]*forkwatcher.Event, 0)
+	handler := func(_ context.Context, event *forkwatcher.Event) {
+		mu.Lock()
+		events = append(events, event)
+		mu.Unlock()
+	}
+
+	_, err = forkwatcher.New(ctx,
+		forkwatcher.WithLogLevel(zerolog.Disabled),
+		forkwatcher.WithClient(&scheduleClient{Service: client, schedule: schedule}),
+		forkwatcher.WithChainTime(testChainTime(t, time.Now())),
+		forkwatcher.WithLeadTime(100*time.Millisecond),
+		forkwatcher.WithHandler(handler),
+	)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(events) == 2
+	}, 2*time.Second, 10*time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, forkwatcher.StageUpcoming, events[0].Stage)
+	require.Equal(t, forkwatcher.StageActivated, events[1].Stage)
+	require.Equal(t, phase0.Epoch(20), events[1].Fork.Epoch)
+	require.Equal(t, "activated", events[1].Stage.String())
+}
@@ -0,0 +1,85 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package p2p
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ENRKey is the key of the ENR entry that holds the SSZ-encoded ENRForkID.
+const ENRKey = "eth2"
+
+// enrForkIDSize is the size of an SSZ-encoded ENRForkID.
+const enrForkIDSize = 16
+
+// ENRForkID is the fork information advertised by a node in its ENR.
+type ENRForkID struct {
+	CurrentForkDigest phase0.ForkDigest
+	NextForkVersion   phase0.Version
+	NextForkEpoch     phase0.Epoch
+}
+
+// ComputeENRForkID computes the ENR fork information for a node at the given epoch.
+func ComputeENRForkID(schedule []*phase0.Fork,
+	genesisValidatorsRoot phase0.Root,
+	epoch phase0.Epoch,
+) (
+	*ENRForkID,
+	error,
+) {
+	digest, err := ForkDigestAtEpoch(schedule, genesisValidatorsRoot, epoch)
+	if err != nil {
+		return nil, err
+	}
+	nextVersion, nextEpoch, err := NextForkVersion(schedule, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ENRForkID{
+		CurrentForkDigest: digest,
+		NextForkVersion:   nextVersion,
+		NextForkEpoch:     nextEpoch,
+	}, nil
+}
+
+// MarshalSSZ SSZ-encodes the ENR fork information, as used for the value of the eth2 ENR entry.
+func (e *ENRForkID) MarshalSSZ() ([]byte, error) {
+	res := make([]byte, enrForkIDSize)
+	copy(res[0:4], e.CurrentForkDigest[:])
+	copy(res[4:8], e.NextForkVersion[:])
+	binary.LittleEndian.PutUint64(res[8:16], uint64(e.NextForkEpoch))
+
+	return res, nil
+}
+
+// UnmarshalSSZ decodes SSZ-encoded ENR fork information.
+func (e *ENRForkID) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != enrForkIDSize {
+		return fmt.Errorf("incorrect length %d for ENR fork ID", len(buf))
+	}
+	copy(e.CurrentForkDigest[:], buf[0:4])
+	copy(e.NextForkVersion[:], buf[4:8])
+	e.NextForkEpoch = phase0.Epoch(binary.LittleEndian.Uint64(buf[8:16]))
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (e *ENRForkID) String() string {
+	return fmt.Sprintf("digest %#x, next version %#x at epoch %d", e.CurrentForkDigest, e.NextForkVersion, e.NextForkEpoch)
+}
@@ -0,0 +1,106 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package p2p provides functions to compute the fork digests and ENR fields used on the
+// consensus layer peer-to-peer network, following the networking specification.
+package p2p
+
+import (
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/signing"
+	"github.com/pkg/errors"
+)
+
+// farFutureEpoch is the epoch used for forks that are not scheduled.
+const farFutureEpoch = phase0.Epoch(0xffffffffffffffff)
+
+// ComputeForkDigest computes the fork digest for the given fork version and genesis validators root.
+// This follows compute_fork_digest in the specification.
+func ComputeForkDigest(forkVersion phase0.Version, genesisValidatorsRoot phase0.Root) (phase0.ForkDigest, error) {
+	root, err := signing.ComputeForkDataRoot(forkVersion, genesisValidatorsRoot)
+	if err != nil {
+		return phase0.ForkDigest{}, err
+	}
+
+	var digest phase0.ForkDigest
+	copy(digest[:], root[:4])
+
+	return digest, nil
+}
+
+// ForkAtEpoch returns the fork from the schedule that is in effect at the given epoch.
+func ForkAtEpoch(schedule []*phase0.Fork, epoch phase0.Epoch) (*phase0.Fork, error) {
+	var res *phase0.Fork
+	for _, fork := range sortedSchedule(schedule) {
+		if fork.Epoch > epoch {
+			break
+		}
+		res = fork
+	}
+	if res == nil {
+		return nil, errors.New("no fork in effect at epoch")
+	}
+
+	return res, nil
+}
+
+// NextForkVersion returns the version and epoch of the next fork in the schedule after the given epoch.
+// If no future fork is scheduled the version of the fork in effect at the epoch and the far future
+// epoch are returned, as required for the ENR eth2 field.
+func NextForkVersion(schedule []*phase0.Fork, epoch phase0.Epoch) (phase0.Version, phase0.Epoch, error) {
+	current, err := ForkAtEpoch(schedule, epoch)
+	if err != nil {
+		return phase0.Version{}, 0, err
+	}
+
+	for _, fork := range sortedSchedule(schedule) {
+		if fork.Epoch > epoch && fork.Epoch != farFutureEpoch {
+			return fork.CurrentVersion, fork.Epoch, nil
+		}
+	}
+
+	return current.CurrentVersion, farFutureEpoch, nil
+}
+
+// ForkDigestAtEpoch computes the fork digest in effect at the given epoch.
+func ForkDigestAtEpoch(schedule []*phase0.Fork,
+	genesisValidatorsRoot phase0.Root,
+	epoch phase0.Epoch,
+) (
+	phase0.ForkDigest,
+	error,
+) {
+	fork, err := ForkAtEpoch(schedule, epoch)
+	if err != nil {
+		return phase0.ForkDigest{}, err
+	}
+
+	return ComputeForkDigest(fork.CurrentVersion, genesisValidatorsRoot)
+}
+
+// sortedSchedule returns the non-nil forks in the schedule, ordered by epoch.
+func sortedSchedule(schedule []*phase0.Fork) []*phase0.Fork {
+	res := make([]*phase0.Fork, 0, len(schedule))
+	for _, fork := range schedule {
+		if fork != nil {
+			res = append(res, fork)
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Epoch < res[j].Epoch
+	})
+
+	return res
+}
@@ -0,0 +1,183 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package p2p_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/p2p"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	mainnetGenesisValidatorsRoot = phase0.Root(mustDecode("4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"))
+	mainnetForkSchedule          = []*phase0.Fork{
+		{PreviousVersion: phase0.Version{0x00, 0x00, 0x00, 0x00}, CurrentVersion: phase0.Version{0x00, 0x00, 0x00, 0x00}, Epoch: 0},
+		{PreviousVersion: phase0.Version{0x00, 0x00, 0x00, 0x00}, CurrentVersion: phase0.Version{0x01, 0x00, 0x00, 0x00}, Epoch: 74240},
+		{PreviousVersion: phase0.Version{0x01, 0x00, 0x00, 0x00}, CurrentVersion: phase0.Version{0x02, 0x00, 0x00, 0x00}, Epoch: 144896},
+		{PreviousVersion: phase0.Version{0x02, 0x00, 0x00, 0x00}, CurrentVersion: phase0.Version{0x03, 0x00, 0x00, 0x00}, Epoch: 194048},
+		{PreviousVersion: phase0.Version{0x03, 0x00, 0x00, 0x00}, CurrentVersion: phase0.Version{0x04, 0x00, 0x00, 0x00}, Epoch: 269568},
+	}
+)
+
+func mustDecode(input string) []byte {
+	res, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		panic(err)
+	}
+
+	return res
+}
+
+func TestComputeForkDigest(t *testing.T) {
+	tests := []struct {
+		name        string
+		forkVersion phase0.Version
+		expected    phase0.ForkDigest
+	}{
+		{
+			name:        "Phase0",
+			forkVersion: phase0.Version{0x00, 0x00, 0x00, 0x00},
+			expected:    phase0.ForkDigest(mustDecode("b5303f2a")),
+		},
+		{
+			name:        "Altair",
+			forkVersion: phase0.Version{0x01, 0x00, 0x00, 0x00},
+			expected:    phase0.ForkDigest(mustDecode("afcaaba0")),
+		},
+		{
+			name:        "Bellatrix",
+			forkVersion: phase0.Version{0x02, 0x00, 0x00, 0x00},
+			expected:    phase0.ForkDigest(mustDecode("4a26c58b")),
+		},
+		{
+			name:        "Capella",
+			forkVersion: phase0.Version{0x03, 0x00, 0x00, 0x00},
+			expected:    phase0.ForkDigest(mustDecode("bba4da96")),
+		},
+		{
+			name:        "Deneb",
+			forkVersion: phase0.Version{0x04, 0x00, 0x00, 0x00},
+			expected:    phase0.ForkDigest(mustDecode("6a95a1a9")),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			digest, err := p2p.ComputeForkDigest(test.forkVersion, mainnetGenesisValidatorsRoot)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, digest)
+		})
+	}
+}
+
+func TestForkDigestAtEpoch(t *testing.T) {
+	digest, err := p2p.ForkDigestAtEpoch(mainnetForkSchedule, mainnetGenesisValidatorsRoot, 200000)
+	require.NoError(t, err)
+	require.Equal(t, phase0.ForkDigest(mustDecode("bba4da96")), digest)
+
+	// Schedule order does not matter.
+	reversed := make([]*phase0.Fork, len(mainnetForkSchedule))
+	for i := range mainnetForkSchedule {
+		reversed[len(reversed)-1-i] = mainnetForkSchedule[i]
+	}
+	digest, err = p2p.ForkDigestAtEpoch(reversed, mainnetGenesisValidatorsRoot, 74240)
+	require.NoError(t, err)
+	require.Equal(t, phase0.ForkDigest(mustDecode("afcaaba0")), digest)
+
+	_, err = p2p.ForkDigestAtEpoch(mainnetForkSchedule[1:], mainnetGenesisValidatorsRoot, 10)
+	require.EqualError(t, err, "no fork in effect at epoch")
+}
+
+func TestComputeENRForkID(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule []*phase0.Fork
+		epoch    phase0.Epoch
+		expected *p2p.ENRForkID
+		err      string
+	}{
+		{
+			name:     "Empty",
+			schedule: []*phase0.Fork{},
+			err:      "no fork in effect at epoch",
+		},
+		{
+			name:     "BeforeCapella",
+			schedule: mainnetForkSchedule,
+			epoch:    150000,
+			expected: &p2p.ENRForkID{
+				CurrentForkDigest: phase0.ForkDigest(mustDecode("4a26c58b")),
+				NextForkVersion:   phase0.Version{0x03, 0x00, 0x00, 0x00},
+				NextForkEpoch:     194048,
+			},
+		},
+		{
+			name:     "NoNextFork",
+			schedule: mainnetForkSchedule,
+			epoch:    300000,
+			expected: &p2p.ENRForkID{
+				CurrentForkDigest: phase0.ForkDigest(mustDecode("6a95a1a9")),
+				NextForkVersion:   phase0.Version{0x04, 0x00, 0x00, 0x00},
+				NextForkEpoch:     0xffffffffffffffff,
+			},
+		},
+		{
+			name: "UnscheduledFork",
+			schedule: append(mainnetForkSchedule, &phase0.Fork{
+				PreviousVersion: phase0.Version{0x04, 0x00, 0x00, 0x00},
+				CurrentVersion:  phase0.Version{0x05, 0x00, 0x00, 0x00},
+				Epoch:           0xffffffffffffffff,
+			}),
+			epoch: 300000,
+			expected: &p2p.ENRForkID{
+				CurrentForkDigest: phase0.ForkDigest(mustDecode("6a95a1a9")),
+				NextForkVersion:   phase0.Version{0x04, 0x00, 0x00, 0x00},
+				NextForkEpoch:     0xffffffffffffffff,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := p2p.ComputeENRForkID(test.schedule, mainnetGenesisValidatorsRoot, test.epoch)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res)
+		})
+	}
+}
+
+func TestENRForkIDSSZ(t *testing.T) {
+	enrForkID := &p2p.ENRForkID{
+		CurrentForkDigest: phase0.ForkDigest(mustDecode("bba4da96")),
+		NextForkVersion:   phase0.Version{0x04, 0x00, 0x00, 0x00},
+		NextForkEpoch:     269568,
+	}
+	data, err := enrForkID.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, mustDecode("bba4da9604000000001d040000000000"), data)
+
+	res := &p2p.ENRForkID{}
+	require.NoError(t, res.UnmarshalSSZ(data))
+	require.Equal(t, enrForkID, res)
+
+	require.EqualError(t, res.UnmarshalSSZ(data[1:]), "incorrect length 15 for ENR fork ID")
+}
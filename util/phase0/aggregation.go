@@ -0,0 +1,130 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// AttestationDataEqual returns true if the two items of attestation data are identical,
+// and hence attestations containing them can be aggregated.
+func AttestationDataEqual(data1 *phase0.AttestationData, data2 *phase0.AttestationData) (bool, error) {
+	if data1 == nil || data2 == nil {
+		return false, errors.New("no attestation data supplied")
+	}
+
+	root1, err := data1.HashTreeRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to calculate attestation data root")
+	}
+	root2, err := data2.HashTreeRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to calculate attestation data root")
+	}
+
+	return bytes.Equal(root1[:], root2[:]), nil
+}
+
+// CheckAggregatable returns an error if the two attestations cannot be aggregated, either
+// because they attest to different data or because their aggregation bits are incompatible.
+func CheckAggregatable(attestation1 *phase0.Attestation, attestation2 *phase0.Attestation) error {
+	if attestation1 == nil || attestation2 == nil {
+		return errors.New("no attestation supplied")
+	}
+
+	equal, err := AttestationDataEqual(attestation1.Data, attestation2.Data)
+	if err != nil {
+		return err
+	}
+	if !equal {
+		return errors.New("attestation data differs")
+	}
+
+	if attestation1.AggregationBits.Len() != attestation2.AggregationBits.Len() {
+		return fmt.Errorf("aggregation bits length mismatch (%d != %d)",
+			attestation1.AggregationBits.Len(),
+			attestation2.AggregationBits.Len(),
+		)
+	}
+	overlaps, err := attestation1.AggregationBits.Overlaps(attestation2.AggregationBits)
+	if err != nil {
+		return errors.Wrap(err, "failed to compare aggregation bits")
+	}
+	if overlaps {
+		return errors.New("aggregation bits overlap")
+	}
+
+	return nil
+}
+
+// AggregateAttestations merges the aggregation bits of the supplied attestations into a single
+// attestation.  The attestations must all be aggregatable with each other.
+//
+// This library does not carry a BLS implementation, so the caller is responsible for aggregating
+// the signatures of the attestations and supplying the result.
+func AggregateAttestations(attestations []*phase0.Attestation, signature phase0.BLSSignature) (*phase0.Attestation, error) {
+	if len(attestations) == 0 {
+		return nil, errors.New("no attestations supplied")
+	}
+	if attestations[0] == nil {
+		return nil, errors.New("no attestation supplied")
+	}
+
+	aggregate := &phase0.Attestation{
+		AggregationBits: make([]byte, len(attestations[0].AggregationBits)),
+		Data:            attestations[0].Data,
+		Signature:       signature,
+	}
+	copy(aggregate.AggregationBits, attestations[0].AggregationBits)
+
+	for i := 1; i < len(attestations); i++ {
+		if err := CheckAggregatable(aggregate, attestations[i]); err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("attestation %d cannot be aggregated", i))
+		}
+		bits, err := aggregate.AggregationBits.Or(attestations[i].AggregationBits)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to merge aggregation bits")
+		}
+		aggregate.AggregationBits = bits
+	}
+
+	return aggregate, nil
+}
+
+// NewAggregateAndProof creates an aggregate and proof for the given aggregator, ready to be
+// signed and wrapped in a signed aggregate and proof.
+func NewAggregateAndProof(aggregatorIndex phase0.ValidatorIndex,
+	aggregate *phase0.Attestation,
+	selectionProof phase0.BLSSignature,
+) (
+	*phase0.AggregateAndProof,
+	error,
+) {
+	if aggregate == nil {
+		return nil, errors.New("no aggregate supplied")
+	}
+	if aggregate.AggregationBits.Count() == 0 {
+		return nil, errors.New("aggregate has no aggregation bits set")
+	}
+
+	return &phase0.AggregateAndProof{
+		AggregatorIndex: aggregatorIndex,
+		Aggregate:       aggregate,
+		SelectionProof:  selectionProof,
+	}, nil
+}
@@ -0,0 +1,127 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	utilphase0 "github.com/attestantio/go-eth2-client/util/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func aggregationAttestation(slot phase0.Slot, length uint64, indices ...uint64) *phase0.Attestation {
+	bits := bitfield.NewBitlist(length)
+	for _, index := range indices {
+		bits.SetBitAt(index, true)
+	}
+
+	return &phase0.Attestation{
+		AggregationBits: bits,
+		Data: &phase0.AttestationData{
+			Slot:   slot,
+			Source: &phase0.Checkpoint{},
+			Target: &phase0.Checkpoint{},
+		},
+	}
+}
+
+func TestCheckAggregatable(t *testing.T) {
+	tests := []struct {
+		name         string
+		attestation1 *phase0.Attestation
+		attestation2 *phase0.Attestation
+		err          string
+	}{
+		{
+			name:         "Nil",
+			attestation2: aggregationAttestation(1, 8, 0),
+			err:          "no attestation supplied",
+		},
+		{
+			name:         "DataMissing",
+			attestation1: &phase0.Attestation{},
+			attestation2: aggregationAttestation(1, 8, 0),
+			err:          "no attestation data supplied",
+		},
+		{
+			name:         "DataDiffers",
+			attestation1: aggregationAttestation(1, 8, 0),
+			attestation2: aggregationAttestation(2, 8, 1),
+			err:          "attestation data differs",
+		},
+		{
+			name:         "LengthMismatch",
+			attestation1: aggregationAttestation(1, 8, 0),
+			attestation2: aggregationAttestation(1, 16, 1),
+			err:          "aggregation bits length mismatch (8 != 16)",
+		},
+		{
+			name:         "Overlap",
+			attestation1: aggregationAttestation(1, 8, 0, 1),
+			attestation2: aggregationAttestation(1, 8, 1, 2),
+			err:          "aggregation bits overlap",
+		},
+		{
+			name:         "Good",
+			attestation1: aggregationAttestation(1, 8, 0, 1),
+			attestation2: aggregationAttestation(1, 8, 2, 3),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := utilphase0.CheckAggregatable(test.attestation1, test.attestation2)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAggregateAttestations(t *testing.T) {
+	_, err := utilphase0.AggregateAttestations(nil, phase0.BLSSignature{})
+	require.EqualError(t, err, "no attestations supplied")
+
+	_, err = utilphase0.AggregateAttestations([]*phase0.Attestation{
+		aggregationAttestation(1, 8, 0),
+		aggregationAttestation(1, 8, 0),
+	}, phase0.BLSSignature{})
+	require.EqualError(t, err, "attestation 1 cannot be aggregated: aggregation bits overlap")
+
+	attestations := []*phase0.Attestation{
+		aggregationAttestation(1, 8, 0),
+		aggregationAttestation(1, 8, 3),
+		aggregationAttestation(1, 8, 5, 7),
+	}
+	aggregate, err := utilphase0.AggregateAttestations(attestations, phase0.BLSSignature{0x01})
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 3, 5, 7}, aggregate.AggregationBits.BitIndices())
+	require.Equal(t, phase0.BLSSignature{0x01}, aggregate.Signature)
+	// Ensure the source attestation has not been altered.
+	require.Equal(t, []int{0}, attestations[0].AggregationBits.BitIndices())
+
+	aggregateAndProof, err := utilphase0.NewAggregateAndProof(12, aggregate, phase0.BLSSignature{0x02})
+	require.NoError(t, err)
+	require.Equal(t, phase0.ValidatorIndex(12), aggregateAndProof.AggregatorIndex)
+	require.Equal(t, aggregate, aggregateAndProof.Aggregate)
+
+	_, err = utilphase0.NewAggregateAndProof(12, nil, phase0.BLSSignature{})
+	require.EqualError(t, err, "no aggregate supplied")
+	_, err = utilphase0.NewAggregateAndProof(12, aggregationAttestation(1, 8), phase0.BLSSignature{})
+	require.EqualError(t, err, "aggregate has no aggregation bits set")
+}
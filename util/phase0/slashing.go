@@ -0,0 +1,65 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// IsSlashableAttestationData returns true if attesting to both items of attestation data is
+// slashable, as per is_slashable_attestation_data in the specification.  This is the case if
+// they are either a double vote, with different data for the same target epoch, or a surround
+// vote, with the first surrounding the second.
+// Surround votes are only detected with the first surrounding the second, so callers checking
+// for any slashable pair should call this with the arguments in both orders.
+func IsSlashableAttestationData(data1 *phase0.AttestationData, data2 *phase0.AttestationData) bool {
+	if !attestationDataComplete(data1) || !attestationDataComplete(data2) {
+		return false
+	}
+
+	// Double vote.
+	if data1.Target.Epoch == data2.Target.Epoch && !attestationDataEqual(data1, data2) {
+		return true
+	}
+
+	// Surround vote.
+	return data1.Source.Epoch < data2.Source.Epoch && data2.Target.Epoch < data1.Target.Epoch
+}
+
+// IsSlashableBlockHeaders returns true if proposing both block headers is slashable, as per
+// process_proposer_slashing in the specification.  This is the case if they are different
+// headers from the same proposer for the same slot.
+func IsSlashableBlockHeaders(header1 *phase0.BeaconBlockHeader, header2 *phase0.BeaconBlockHeader) bool {
+	if header1 == nil || header2 == nil {
+		return false
+	}
+
+	return header1.Slot == header2.Slot &&
+		header1.ProposerIndex == header2.ProposerIndex &&
+		*header1 != *header2
+}
+
+// attestationDataComplete returns true if the attestation data has all of its checkpoints.
+func attestationDataComplete(data *phase0.AttestationData) bool {
+	return data != nil && data.Source != nil && data.Target != nil
+}
+
+// attestationDataEqual returns true if the two items of attestation data are equal.
+func attestationDataEqual(data1 *phase0.AttestationData, data2 *phase0.AttestationData) bool {
+	return data1.Slot == data2.Slot &&
+		data1.Index == data2.Index &&
+		data1.BeaconBlockRoot == data2.BeaconBlockRoot &&
+		*data1.Source == *data2.Source &&
+		*data1.Target == *data2.Target
+}
@@ -0,0 +1,143 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	utilphase0 "github.com/attestantio/go-eth2-client/util/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func slashingAttestationData(sourceEpoch phase0.Epoch, targetEpoch phase0.Epoch, root byte) *phase0.AttestationData {
+	return &phase0.AttestationData{
+		Slot:            phase0.Slot(targetEpoch) * 32,
+		BeaconBlockRoot: phase0.Root{root},
+		Source:          &phase0.Checkpoint{Epoch: sourceEpoch},
+		Target:          &phase0.Checkpoint{Epoch: targetEpoch},
+	}
+}
+
+func TestIsSlashableAttestationData(t *testing.T) {
+	tests := []struct {
+		name      string
+		data1     *phase0.AttestationData
+		data2     *phase0.AttestationData
+		slashable bool
+	}{
+		{
+			name:  "Nil",
+			data2: slashingAttestationData(1, 2, 0x01),
+		},
+		{
+			name:  "MissingCheckpoint",
+			data1: &phase0.AttestationData{Target: &phase0.Checkpoint{Epoch: 2}},
+			data2: slashingAttestationData(1, 2, 0x01),
+		},
+		{
+			name:  "Identical",
+			data1: slashingAttestationData(1, 2, 0x01),
+			data2: slashingAttestationData(1, 2, 0x01),
+		},
+		{
+			name:      "DoubleVote",
+			data1:     slashingAttestationData(1, 2, 0x01),
+			data2:     slashingAttestationData(1, 2, 0x02),
+			slashable: true,
+		},
+		{
+			name:      "DoubleVoteDifferentSource",
+			data1:     slashingAttestationData(0, 2, 0x01),
+			data2:     slashingAttestationData(1, 2, 0x01),
+			slashable: true,
+		},
+		{
+			name:      "Surrounding",
+			data1:     slashingAttestationData(1, 5, 0x01),
+			data2:     slashingAttestationData(2, 4, 0x01),
+			slashable: true,
+		},
+		{
+			name:  "Surrounded",
+			data1: slashingAttestationData(2, 4, 0x01),
+			data2: slashingAttestationData(1, 5, 0x01),
+		},
+		{
+			name:  "SameSource",
+			data1: slashingAttestationData(1, 5, 0x01),
+			data2: slashingAttestationData(1, 4, 0x01),
+		},
+		{
+			name:  "Sequential",
+			data1: slashingAttestationData(1, 2, 0x01),
+			data2: slashingAttestationData(2, 3, 0x01),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.slashable, utilphase0.IsSlashableAttestationData(test.data1, test.data2))
+		})
+	}
+}
+
+func TestIsSlashableBlockHeaders(t *testing.T) {
+	header := &phase0.BeaconBlockHeader{
+		Slot:          10,
+		ProposerIndex: 5,
+		ParentRoot:    phase0.Root{0x01},
+		StateRoot:     phase0.Root{0x02},
+		BodyRoot:      phase0.Root{0x03},
+	}
+
+	tests := []struct {
+		name      string
+		header1   *phase0.BeaconBlockHeader
+		header2   *phase0.BeaconBlockHeader
+		slashable bool
+	}{
+		{
+			name:    "Nil",
+			header1: header,
+		},
+		{
+			name:    "Identical",
+			header1: header,
+			header2: &phase0.BeaconBlockHeader{Slot: 10, ProposerIndex: 5, ParentRoot: phase0.Root{0x01}, StateRoot: phase0.Root{0x02}, BodyRoot: phase0.Root{0x03}},
+		},
+		{
+			name:      "DifferentBody",
+			header1:   header,
+			header2:   &phase0.BeaconBlockHeader{Slot: 10, ProposerIndex: 5, ParentRoot: phase0.Root{0x01}, StateRoot: phase0.Root{0x02}, BodyRoot: phase0.Root{0x04}},
+			slashable: true,
+		},
+		{
+			name:    "DifferentSlot",
+			header1: header,
+			header2: &phase0.BeaconBlockHeader{Slot: 11, ProposerIndex: 5, BodyRoot: phase0.Root{0x04}},
+		},
+		{
+			name:    "DifferentProposer",
+			header1: header,
+			header2: &phase0.BeaconBlockHeader{Slot: 10, ProposerIndex: 6, BodyRoot: phase0.Root{0x04}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.slashable, utilphase0.IsSlashableBlockHeaders(test.header1, test.header2))
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/chaintime"
+	"github.com/attestantio/go-eth2-client/util/signing"
+	"github.com/pkg/errors"
+)
+
+// NewVoluntaryExit creates a voluntary exit for the given validator at the given epoch.
+func NewVoluntaryExit(epoch phase0.Epoch, validatorIndex phase0.ValidatorIndex) *phase0.VoluntaryExit {
+	return &phase0.VoluntaryExit{
+		Epoch:          epoch,
+		ValidatorIndex: validatorIndex,
+	}
+}
+
+// VoluntaryExitSigningRoot computes the root that must be signed for the given voluntary exit to be valid.
+// The client must provide genesis, fork schedule and spec information.
+// Once the chain has reached Deneb all voluntary exits are verified with the Capella fork version,
+// regardless of their epoch (EIP-7044), so if the chain's current epoch is at or after the Deneb fork
+// epoch the Capella fork version is used.  Otherwise the fork version at the exit's epoch is used.
+func VoluntaryExitSigningRoot(ctx context.Context,
+	client consensusclient.Service,
+	voluntaryExit *phase0.VoluntaryExit,
+) (
+	phase0.Root,
+	error,
+) {
+	if voluntaryExit == nil {
+		return phase0.Root{}, errors.New("no voluntary exit supplied")
+	}
+
+	domain, err := voluntaryExitDomain(ctx, client, voluntaryExit.Epoch)
+	if err != nil {
+		return phase0.Root{}, err
+	}
+
+	return signing.ComputeSigningRoot(voluntaryExit, domain)
+}
+
+// NewSignedVoluntaryExit assembles a signed voluntary exit from the exit and its signature.
+func NewSignedVoluntaryExit(voluntaryExit *phase0.VoluntaryExit, signature phase0.BLSSignature) (*phase0.SignedVoluntaryExit, error) {
+	if voluntaryExit == nil {
+		return nil, errors.New("no voluntary exit supplied")
+	}
+
+	return &phase0.SignedVoluntaryExit{
+		Message:   voluntaryExit,
+		Signature: signature,
+	}, nil
+}
+
+// voluntaryExitDomain obtains the domain for a voluntary exit at the given epoch, as verified by the
+// chain at its current epoch.
+func voluntaryExitDomain(ctx context.Context,
+	client consensusclient.Service,
+	epoch phase0.Epoch,
+) (
+	phase0.Domain,
+	error,
+) {
+	genesisProvider, isProvider := client.(consensusclient.GenesisProvider)
+	if !isProvider {
+		return phase0.Domain{}, errors.New("client does not provide genesis")
+	}
+	forkScheduleProvider, isProvider := client.(consensusclient.ForkScheduleProvider)
+	if !isProvider {
+		return phase0.Domain{}, errors.New("client does not provide fork schedule")
+	}
+	specProvider, isProvider := client.(consensusclient.SpecProvider)
+	if !isProvider {
+		return phase0.Domain{}, errors.New("client does not provide spec")
+	}
+
+	genesis, err := genesisProvider.Genesis(ctx)
+	if err != nil {
+		return phase0.Domain{}, errors.Wrap(err, "failed to obtain genesis")
+	}
+	forkSchedule, err := forkScheduleProvider.ForkSchedule(ctx)
+	if err != nil {
+		return phase0.Domain{}, errors.Wrap(err, "failed to obtain fork schedule")
+	}
+	if len(forkSchedule) == 0 {
+		return phase0.Domain{}, errors.New("no fork schedule returned")
+	}
+	specValues, err := specProvider.Spec(ctx)
+	if err != nil {
+		return phase0.Domain{}, errors.Wrap(err, "failed to obtain spec")
+	}
+
+	domainType := phase0.DomainVoluntaryExit
+	if tmp, exists := specValues["DOMAIN_VOLUNTARY_EXIT"]; exists {
+		if specDomainType, isDomainType := tmp.(phase0.DomainType); isDomainType {
+			domainType = specDomainType
+		}
+	}
+
+	forkVersion := forkSchedule[0].CurrentVersion
+	for _, fork := range forkSchedule {
+		if fork.Epoch > epoch {
+			break
+		}
+		forkVersion = fork.CurrentVersion
+	}
+
+	// Deneb fixes the voluntary exit domain at the Capella fork version.
+	if tmp, exists := specValues["DENEB_FORK_EPOCH"]; exists {
+		chainTimeParams, err := chaintime.ParametersFromSpec(genesis.GenesisTime, specValues)
+		if err != nil {
+			return phase0.Domain{}, errors.Wrap(err, "failed to obtain chain time parameters")
+		}
+		chainTime, err := chaintime.New(chainTimeParams)
+		if err != nil {
+			return phase0.Domain{}, errors.Wrap(err, "failed to create chain time")
+		}
+		if denebForkEpoch, isEpoch := tmp.(uint64); isEpoch && chainTime.CurrentEpoch() >= phase0.Epoch(denebForkEpoch) {
+			capellaForkVersion, isVersion := specValues["CAPELLA_FORK_VERSION"].(phase0.Version)
+			if !isVersion {
+				return phase0.Domain{}, errors.New("spec does not provide Capella fork version")
+			}
+			forkVersion = capellaForkVersion
+		}
+	}
+
+	return signing.ComputeDomain(domainType, forkVersion, genesis.GenesisValidatorsRoot)
+}
@@ -0,0 +1,147 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	utilphase0 "github.com/attestantio/go-eth2-client/util/phase0"
+	"github.com/attestantio/go-eth2-client/util/signing"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVoluntaryExitSigningRoot(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx, mock.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		epoch phase0.Epoch
+	}{
+		{
+			name:  "Genesis",
+			epoch: 0,
+		},
+		{
+			name:  "PostFork",
+			epoch: 2000,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			exit := utilphase0.NewVoluntaryExit(test.epoch, 12)
+			root, err := utilphase0.VoluntaryExitSigningRoot(ctx, client, exit)
+			require.NoError(t, err)
+
+			// Calculate the expected root using the client's domain provider.
+			domain, err := client.Domain(ctx, phase0.DomainType{0x04, 0x00, 0x00, 0x00}, test.epoch)
+			require.NoError(t, err)
+			objectRoot, err := exit.HashTreeRoot()
+			require.NoError(t, err)
+			expected, err := (&phase0.SigningData{ObjectRoot: objectRoot, Domain: domain}).HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, phase0.Root(expected), root)
+
+			signedExit, err := utilphase0.NewSignedVoluntaryExit(exit, phase0.BLSSignature{0x01})
+			require.NoError(t, err)
+			require.Equal(t, exit, signedExit.Message)
+		})
+	}
+
+	_, err = utilphase0.VoluntaryExitSigningRoot(ctx, client, nil)
+	require.EqualError(t, err, "no voluntary exit supplied")
+}
+
+// denebClient is a mock client with a Deneb fork in its spec.
+type denebClient struct {
+	*mock.Service
+	denebForkEpoch uint64
+}
+
+func (c *denebClient) Spec(ctx context.Context) (map[string]interface{}, error) {
+	specValues, err := c.Service.Spec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	specValues["CAPELLA_FORK_VERSION"] = phase0.Version{0x03, 0x00, 0x00, 0x00}
+	specValues["DENEB_FORK_EPOCH"] = c.denebForkEpoch
+
+	return specValues, nil
+}
+
+func TestVoluntaryExitSigningRootDeneb(t *testing.T) {
+	ctx := context.Background()
+
+	// Genesis is 100 epochs ago.
+	mockClient, err := mock.New(ctx,
+		mock.WithLogLevel(zerolog.Disabled),
+		mock.WithGenesisTime(time.Now().Add(-100*32*12*time.Second)),
+	)
+	require.NoError(t, err)
+	genesis, err := mockClient.Genesis(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		denebForkEpoch uint64
+		epoch          phase0.Epoch
+		capella        bool
+	}{
+		{
+			name:           "DenebActiveExitBeforeDeneb",
+			denebForkEpoch: 50,
+			epoch:          10,
+			capella:        true,
+		},
+		{
+			name:           "DenebActiveExitAfterDeneb",
+			denebForkEpoch: 50,
+			epoch:          60,
+			capella:        true,
+		},
+		{
+			name:           "DenebNotActive",
+			denebForkEpoch: 200,
+			epoch:          10,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := &denebClient{Service: mockClient, denebForkEpoch: test.denebForkEpoch}
+			exit := utilphase0.NewVoluntaryExit(test.epoch, 12)
+			root, err := utilphase0.VoluntaryExitSigningRoot(ctx, client, exit)
+			require.NoError(t, err)
+
+			var domain phase0.Domain
+			if test.capella {
+				domain, err = signing.ComputeDomain(phase0.DomainVoluntaryExit, phase0.Version{0x03, 0x00, 0x00, 0x00}, genesis.GenesisValidatorsRoot)
+			} else {
+				domain, err = mockClient.Domain(ctx, phase0.DomainVoluntaryExit, test.epoch)
+			}
+			require.NoError(t, err)
+			expected, err := signing.ComputeSigningRoot(exit, domain)
+			require.NoError(t, err)
+			require.Equal(t, expected, root)
+		})
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proofs
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// HistoricalSummaryRoots are the block and state roots from which a historical summary is built.
+// They are obtained from a capella or later beacon state at the boundary of a historical period,
+// and allow proofs of historical block roots against the summary.
+type HistoricalSummaryRoots struct {
+	// Index is the index of the summary in the state's historical summaries.
+	Index uint64
+	// StartSlot is the first slot covered by the summary.
+	StartSlot phase0.Slot
+	// BlockRoots are the block roots for each slot covered by the summary.
+	BlockRoots []phase0.Root
+	// StateRoots are the state roots for each slot covered by the summary.
+	StateRoots []phase0.Root
+}
+
+// NewHistoricalSummaryRoots obtains the roots of the most recent historical summary from the
+// given beacon state.  The state must be capella or later, and at the first slot of a
+// historical period, which is when the summary is added to the state.
+func NewHistoricalSummaryRoots(state *spec.VersionedBeaconState) (*HistoricalSummaryRoots, error) {
+	if state == nil {
+		return nil, errors.New("no state supplied")
+	}
+
+	var slot phase0.Slot
+	var blockRoots []phase0.Root
+	var stateRoots []phase0.Root
+	var summaries []*capella.HistoricalSummary
+	switch state.Version {
+	case spec.DataVersionCapella:
+		if state.Capella == nil {
+			return nil, errors.New("no state data")
+		}
+		slot = state.Capella.Slot
+		blockRoots = state.Capella.BlockRoots
+		stateRoots = state.Capella.StateRoots
+		summaries = state.Capella.HistoricalSummaries
+	case spec.DataVersionDeneb:
+		if state.Deneb == nil {
+			return nil, errors.New("no state data")
+		}
+		slot = state.Deneb.Slot
+		blockRoots = state.Deneb.BlockRoots
+		stateRoots = state.Deneb.StateRoots
+		summaries = state.Deneb.HistoricalSummaries
+	default:
+		return nil, fmt.Errorf("unsupported version %v", state.Version)
+	}
+
+	period := uint64(len(blockRoots))
+	if period == 0 || bits.OnesCount64(period) != 1 {
+		return nil, fmt.Errorf("invalid number %d of block roots", period)
+	}
+	if uint64(len(stateRoots)) != period {
+		return nil, fmt.Errorf("number %d of state roots does not match number %d of block roots", len(stateRoots), period)
+	}
+	if slot == 0 || uint64(slot)%period != 0 {
+		return nil, fmt.Errorf("state at slot %d is not at the end of a historical period", slot)
+	}
+	if len(summaries) == 0 {
+		return nil, errors.New("state has no historical summaries")
+	}
+
+	res := &HistoricalSummaryRoots{
+		Index:      uint64(len(summaries) - 1),
+		StartSlot:  slot - phase0.Slot(period),
+		BlockRoots: make([]phase0.Root, period),
+		StateRoots: make([]phase0.Root, period),
+	}
+	copy(res.BlockRoots, blockRoots)
+	copy(res.StateRoots, stateRoots)
+
+	if err := res.Verify(summaries[res.Index]); err != nil {
+		return nil, errors.Wrap(err, "state historical summary does not match its roots")
+	}
+
+	return res, nil
+}
+
+// Summary returns the historical summary for the roots.
+func (r *HistoricalSummaryRoots) Summary() (*capella.HistoricalSummary, error) {
+	blockTree, err := rootsTree(r.BlockRoots)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid block roots")
+	}
+	stateTree, err := rootsTree(r.StateRoots)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid state roots")
+	}
+
+	return &capella.HistoricalSummary{
+		BlockSummaryRoot: blockTree.Root(),
+		StateSummaryRoot: stateTree.Root(),
+	}, nil
+}
+
+// Verify confirms that the roots match the given historical summary.
+func (r *HistoricalSummaryRoots) Verify(summary *capella.HistoricalSummary) error {
+	if summary == nil {
+		return errors.New("no historical summary supplied")
+	}
+
+	calculated, err := r.Summary()
+	if err != nil {
+		return err
+	}
+	if calculated.BlockSummaryRoot != summary.BlockSummaryRoot {
+		return fmt.Errorf("block summary root %#x does not match calculated root %#x", summary.BlockSummaryRoot, calculated.BlockSummaryRoot)
+	}
+	if calculated.StateSummaryRoot != summary.StateSummaryRoot {
+		return fmt.Errorf("state summary root %#x does not match calculated root %#x", summary.StateSummaryRoot, calculated.StateSummaryRoot)
+	}
+
+	return nil
+}
+
+// BlockRootProof generates a proof of the block root at the given slot against the block summary root.
+func (r *HistoricalSummaryRoots) BlockRootProof(slot phase0.Slot) (*Proof, error) {
+	return r.rootProof(r.BlockRoots, slot)
+}
+
+// StateRootProof generates a proof of the state root at the given slot against the state summary root.
+func (r *HistoricalSummaryRoots) StateRootProof(slot phase0.Slot) (*Proof, error) {
+	return r.rootProof(r.StateRoots, slot)
+}
+
+func (r *HistoricalSummaryRoots) rootProof(roots []phase0.Root, slot phase0.Slot) (*Proof, error) {
+	period := uint64(len(roots))
+	if slot < r.StartSlot || uint64(slot-r.StartSlot) >= period {
+		return nil, fmt.Errorf("slot %d not covered by historical summary", slot)
+	}
+
+	tree, err := rootsTree(roots)
+	if err != nil {
+		return nil, err
+	}
+
+	return tree.Prove(period + uint64(slot)%period)
+}
+
+// VerifyHistoricalBlockRoot returns true if the proof shows that the given block root is that of the
+// given slot in the historical summary.  slotsPerHistoricalRoot is the number of slots covered by
+// each historical summary.
+func VerifyHistoricalBlockRoot(summary *capella.HistoricalSummary,
+	slotsPerHistoricalRoot uint64,
+	slot phase0.Slot,
+	root phase0.Root,
+	proof *Proof,
+) bool {
+	if summary == nil || proof == nil || slotsPerHistoricalRoot == 0 {
+		return false
+	}
+	if proof.Index != slotsPerHistoricalRoot+uint64(slot)%slotsPerHistoricalRoot {
+		return false
+	}
+	if proof.Leaf != root {
+		return false
+	}
+
+	return proof.Verify(summary.BlockSummaryRoot)
+}
+
+// rootsTree creates a Merkle tree for a vector of roots.
+func rootsTree(roots []phase0.Root) (*Tree, error) {
+	count := uint64(len(roots))
+	if count == 0 || bits.OnesCount64(count) != 1 {
+		return nil, fmt.Errorf("invalid number %d of roots", count)
+	}
+
+	leaves := make([]*node, len(roots))
+	for i := range roots {
+		leaves[i] = newLeaf(roots[i][:])
+	}
+
+	return &Tree{
+		root: merkleize(leaves, count),
+	}, nil
+}
@@ -0,0 +1,245 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proofs
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+const (
+	// validatorRegistryLimit is the maximum number of validators in the registry.
+	validatorRegistryLimit = uint64(1) << 40
+	// balancesPerChunk is the number of balances packed in to a single leaf.
+	balancesPerChunk = 4
+	// maxBlobCommitmentsPerBlock is the maximum number of blob KZG commitments in a block body.
+	maxBlobCommitmentsPerBlock = uint64(4096)
+)
+
+var phase0BeaconStateFields = []string{
+	"genesis_time",
+	"genesis_validators_root",
+	"slot",
+	"fork",
+	"latest_block_header",
+	"block_roots",
+	"state_roots",
+	"historical_roots",
+	"eth1_data",
+	"eth1_data_votes",
+	"eth1_deposit_index",
+	"validators",
+	"balances",
+	"randao_mixes",
+	"slashings",
+	"previous_epoch_attestations",
+	"current_epoch_attestations",
+	"justification_bits",
+	"previous_justified_checkpoint",
+	"current_justified_checkpoint",
+	"finalized_checkpoint",
+}
+
+var altairBeaconStateFields = []string{
+	"genesis_time",
+	"genesis_validators_root",
+	"slot",
+	"fork",
+	"latest_block_header",
+	"block_roots",
+	"state_roots",
+	"historical_roots",
+	"eth1_data",
+	"eth1_data_votes",
+	"eth1_deposit_index",
+	"validators",
+	"balances",
+	"randao_mixes",
+	"slashings",
+	"previous_epoch_participation",
+	"current_epoch_participation",
+	"justification_bits",
+	"previous_justified_checkpoint",
+	"current_justified_checkpoint",
+	"finalized_checkpoint",
+	"inactivity_scores",
+	"current_sync_committee",
+	"next_sync_committee",
+}
+
+var bellatrixBeaconStateFields = append(append([]string{}, altairBeaconStateFields...),
+	"latest_execution_payload_header",
+)
+
+var capellaBeaconStateFields = append(append([]string{}, bellatrixBeaconStateFields...),
+	"next_withdrawal_index",
+	"next_withdrawal_validator_index",
+	"historical_summaries",
+)
+
+var phase0BeaconBlockBodyFields = []string{
+	"randao_reveal",
+	"eth1_data",
+	"graffiti",
+	"proposer_slashings",
+	"attester_slashings",
+	"attestations",
+	"deposits",
+	"voluntary_exits",
+}
+
+var altairBeaconBlockBodyFields = append(append([]string{}, phase0BeaconBlockBodyFields...),
+	"sync_aggregate",
+)
+
+var bellatrixBeaconBlockBodyFields = append(append([]string{}, altairBeaconBlockBodyFields...),
+	"execution_payload",
+)
+
+var capellaBeaconBlockBodyFields = append(append([]string{}, bellatrixBeaconBlockBodyFields...),
+	"bls_to_execution_changes",
+)
+
+var denebBeaconBlockBodyFields = append(append([]string{}, capellaBeaconBlockBodyFields...),
+	"blob_kzg_commitments",
+)
+
+// ConcatGeneralizedIndices concatenates generalized indices, such that the result is the
+// generalized index of the last index within the subtree identified by the previous indices.
+func ConcatGeneralizedIndices(indices ...uint64) uint64 {
+	res := uint64(1)
+	for _, index := range indices {
+		depth := bits.Len64(index) - 1
+		res = res<<depth | (index ^ (uint64(1) << depth))
+	}
+
+	return res
+}
+
+// fieldIndex returns the generalized index of the named field within a container with the given fields.
+func fieldIndex(fields []string, name string) (uint64, error) {
+	for i, field := range fields {
+		if field == name {
+			return uint64(1)<<depthFor(uint64(len(fields))) + uint64(i), nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown field %s", name)
+}
+
+// BeaconStateFieldIndex returns the generalized index of the named field, as per the consensus
+// specifications (e.g. "finalized_checkpoint"), within the beacon state for the given version.
+func BeaconStateFieldIndex(version spec.DataVersion, name string) (uint64, error) {
+	var fields []string
+	switch version {
+	case spec.DataVersionPhase0:
+		fields = phase0BeaconStateFields
+	case spec.DataVersionAltair:
+		fields = altairBeaconStateFields
+	case spec.DataVersionBellatrix:
+		fields = bellatrixBeaconStateFields
+	case spec.DataVersionCapella, spec.DataVersionDeneb:
+		fields = capellaBeaconStateFields
+	default:
+		return 0, fmt.Errorf("unsupported version %v", version)
+	}
+
+	index, err := fieldIndex(fields, name)
+	if err != nil {
+		return 0, fmt.Errorf("%s in %v beacon state", err.Error(), version)
+	}
+
+	return index, nil
+}
+
+// BeaconBlockBodyFieldIndex returns the generalized index of the named field, as per the consensus
+// specifications (e.g. "execution_payload"), within the beacon block body for the given version.
+func BeaconBlockBodyFieldIndex(version spec.DataVersion, name string) (uint64, error) {
+	var fields []string
+	switch version {
+	case spec.DataVersionPhase0:
+		fields = phase0BeaconBlockBodyFields
+	case spec.DataVersionAltair:
+		fields = altairBeaconBlockBodyFields
+	case spec.DataVersionBellatrix:
+		fields = bellatrixBeaconBlockBodyFields
+	case spec.DataVersionCapella:
+		fields = capellaBeaconBlockBodyFields
+	case spec.DataVersionDeneb:
+		fields = denebBeaconBlockBodyFields
+	default:
+		return 0, fmt.Errorf("unsupported version %v", version)
+	}
+
+	index, err := fieldIndex(fields, name)
+	if err != nil {
+		return 0, fmt.Errorf("%s in %v beacon block body", err.Error(), version)
+	}
+
+	return index, nil
+}
+
+// listElementIndex returns the generalized index of the given chunk within a list with the given chunk limit,
+// relative to the list root.
+func listElementIndex(chunk uint64, limit uint64) uint64 {
+	// The left child of the list root is the data; the right child is the length.
+	return ConcatGeneralizedIndices(2, uint64(1)<<depthFor(limit)+chunk)
+}
+
+// ValidatorIndex returns the generalized index of the record for the given validator within the beacon
+// state for the given version.
+func ValidatorIndex(version spec.DataVersion, validatorIndex uint64) (uint64, error) {
+	if validatorIndex >= validatorRegistryLimit {
+		return 0, fmt.Errorf("validator index %d above registry limit", validatorIndex)
+	}
+	validatorsIndex, err := BeaconStateFieldIndex(version, "validators")
+	if err != nil {
+		return 0, err
+	}
+
+	return ConcatGeneralizedIndices(validatorsIndex, listElementIndex(validatorIndex, validatorRegistryLimit)), nil
+}
+
+// BalanceIndex returns the generalized index of the leaf containing the balance for the given validator
+// within the beacon state for the given version.  Note that balances are packed, with each leaf containing
+// the balances of 4 validators.
+func BalanceIndex(version spec.DataVersion, validatorIndex uint64) (uint64, error) {
+	if validatorIndex >= validatorRegistryLimit {
+		return 0, fmt.Errorf("validator index %d above registry limit", validatorIndex)
+	}
+	balancesIndex, err := BeaconStateFieldIndex(version, "balances")
+	if err != nil {
+		return 0, err
+	}
+
+	return ConcatGeneralizedIndices(balancesIndex,
+		listElementIndex(validatorIndex/balancesPerChunk, validatorRegistryLimit/balancesPerChunk),
+	), nil
+}
+
+// BlobKZGCommitmentIndex returns the generalized index of the KZG commitment for the given blob
+// within the beacon block body for the given version.
+func BlobKZGCommitmentIndex(version spec.DataVersion, blobIndex uint64) (uint64, error) {
+	if blobIndex >= maxBlobCommitmentsPerBlock {
+		return 0, fmt.Errorf("blob index %d above commitment limit", blobIndex)
+	}
+	commitmentsIndex, err := BeaconBlockBodyFieldIndex(version, "blob_kzg_commitments")
+	if err != nil {
+		return 0, err
+	}
+
+	return ConcatGeneralizedIndices(commitmentsIndex, listElementIndex(blobIndex, maxBlobCommitmentsPerBlock)), nil
+}
@@ -0,0 +1,201 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proofs
+
+import (
+	"crypto/sha256"
+	"math/bits"
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Proof is a Merkle proof for a single generalized index.
+type Proof struct {
+	// Index is the generalized index of the leaf.
+	Index uint64
+	// Leaf is the value of the leaf.
+	Leaf phase0.Root
+	// Branch is the set of sibling hashes from the leaf up to the root, as used by
+	// is_valid_merkle_branch() in the consensus specifications.
+	Branch []phase0.Root
+}
+
+// Depth returns the depth of the proof.
+func (p *Proof) Depth() int {
+	return len(p.Branch)
+}
+
+// SubtreeIndex returns the index of the leaf within its subtree, as used by
+// is_valid_merkle_branch() in the consensus specifications.
+func (p *Proof) SubtreeIndex() uint64 {
+	return p.Index % (uint64(1) << len(p.Branch))
+}
+
+// Verify returns true if the proof is valid for the given root.
+func (p *Proof) Verify(root phase0.Root) bool {
+	if bits.Len64(p.Index)-1 != len(p.Branch) {
+		return false
+	}
+
+	value := p.Leaf
+	index := p.Index
+	for _, sibling := range p.Branch {
+		if index%2 == 1 {
+			value = sha256.Sum256(append(sibling[:], value[:]...))
+		} else {
+			value = sha256.Sum256(append(value[:], sibling[:]...))
+		}
+		index /= 2
+	}
+
+	return value == root
+}
+
+// Multiproof is a Merkle proof for multiple generalized indices.
+type Multiproof struct {
+	// Indices are the generalized indices of the leaves.
+	Indices []uint64
+	// Leaves are the values of the leaves, in the same order as the indices.
+	Leaves []phase0.Root
+	// Hashes are the hashes of the helper indices, in descending order of generalized
+	// index as per get_helper_indices() in the consensus specifications.
+	Hashes []phase0.Root
+}
+
+// Verify returns true if the multiproof is valid for the given root.
+func (p *Multiproof) Verify(root phase0.Root) bool {
+	if len(p.Indices) == 0 || len(p.Indices) != len(p.Leaves) {
+		return false
+	}
+	helperIndices := HelperIndices(p.Indices)
+	if len(helperIndices) != len(p.Hashes) {
+		return false
+	}
+
+	objects := make(map[uint64]phase0.Root, len(p.Indices)+len(p.Hashes))
+	for i, index := range p.Indices {
+		objects[index] = p.Leaves[i]
+	}
+	for i, index := range helperIndices {
+		objects[index] = p.Hashes[i]
+	}
+
+	keys := make([]uint64, 0, len(objects))
+	for k := range objects {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] > keys[j] })
+
+	for pos := 0; pos < len(keys); pos++ {
+		k := keys[pos]
+		if k <= 1 {
+			continue
+		}
+		if _, exists := objects[k/2]; exists {
+			continue
+		}
+		left, leftExists := objects[k&^1]
+		right, rightExists := objects[k|1]
+		if !leftExists || !rightExists {
+			continue
+		}
+		objects[k/2] = sha256.Sum256(append(left[:], right[:]...))
+		keys = append(keys, k/2)
+	}
+
+	calculated, exists := objects[1]
+
+	return exists && calculated == root
+}
+
+// HelperIndices returns the generalized indices of the nodes required to prove the given indices,
+// in descending order, as per get_helper_indices() in the consensus specifications.
+func HelperIndices(indices []uint64) []uint64 {
+	required := make(map[uint64]struct{})
+	paths := make(map[uint64]struct{})
+	for _, index := range indices {
+		for i := index; i > 1; i /= 2 {
+			required[i^1] = struct{}{}
+			paths[i] = struct{}{}
+		}
+		paths[1] = struct{}{}
+	}
+
+	helpers := make([]uint64, 0, len(required))
+	for index := range required {
+		if _, exists := paths[index]; !exists {
+			helpers = append(helpers, index)
+		}
+	}
+	sort.Slice(helpers, func(i, j int) bool { return helpers[i] > helpers[j] })
+
+	return helpers
+}
+
+// Prove generates a proof for the given generalized index.
+func (t *Tree) Prove(index uint64) (*Proof, error) {
+	leaf, err := t.get(index)
+	if err != nil {
+		return nil, err
+	}
+
+	proof := &Proof{
+		Index:  index,
+		Leaf:   leaf.root(),
+		Branch: make([]phase0.Root, 0, bits.Len64(index)-1),
+	}
+	for i := index; i > 1; i /= 2 {
+		sibling, err := t.get(i ^ 1)
+		if err != nil {
+			return nil, err
+		}
+		proof.Branch = append(proof.Branch, sibling.root())
+	}
+
+	return proof, nil
+}
+
+// ProveMulti generates a multiproof for the given generalized indices.
+func (t *Tree) ProveMulti(indices []uint64) (*Multiproof, error) {
+	if len(indices) == 0 {
+		return nil, errors.New("no indices supplied")
+	}
+
+	proof := &Multiproof{
+		Indices: make([]uint64, len(indices)),
+		Leaves:  make([]phase0.Root, len(indices)),
+	}
+	copy(proof.Indices, indices)
+	for i, index := range indices {
+		leaf, err := t.get(index)
+		if err != nil {
+			return nil, err
+		}
+		proof.Leaves[i] = leaf.root()
+	}
+
+	helperIndices := HelperIndices(indices)
+	proof.Hashes = make([]phase0.Root, len(helperIndices))
+	for i, index := range helperIndices {
+		helper, err := t.get(index)
+		if err != nil {
+			return nil, err
+		}
+		proof.Hashes[i] = helper.root()
+	}
+
+	return proof, nil
+}
@@ -0,0 +1,332 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proofs_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/proofs"
+	"github.com/holiman/uint256"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func roots(n int) []phase0.Root {
+	res := make([]phase0.Root, n)
+	for i := range res {
+		res[i][0] = byte(i)
+		res[i][1] = byte(i >> 8)
+	}
+
+	return res
+}
+
+func denebState(validators int) *spec.VersionedBeaconState {
+	state := &deneb.BeaconState{
+		GenesisTime: 1606824023,
+		Slot:        12345,
+		Fork: &phase0.Fork{
+			PreviousVersion: phase0.Version{0x03, 0x00, 0x00, 0x00},
+			CurrentVersion:  phase0.Version{0x04, 0x00, 0x00, 0x00},
+			Epoch:           300,
+		},
+		LatestBlockHeader:           &phase0.BeaconBlockHeader{Slot: 12344},
+		BlockRoots:                  roots(8192),
+		StateRoots:                  roots(8192),
+		HistoricalRoots:             roots(3),
+		ETH1Data:                    &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+		ETH1DataVotes:               []*phase0.ETH1Data{},
+		Validators:                  make([]*phase0.Validator, validators),
+		Balances:                    make([]phase0.Gwei, validators),
+		RANDAOMixes:                 roots(65536),
+		Slashings:                   make([]phase0.Gwei, 8192),
+		PreviousEpochParticipation:  make([]altair.ParticipationFlags, validators),
+		CurrentEpochParticipation:   make([]altair.ParticipationFlags, validators),
+		JustificationBits:           bitfield.NewBitvector4(),
+		PreviousJustifiedCheckpoint: &phase0.Checkpoint{Epoch: 383},
+		CurrentJustifiedCheckpoint:  &phase0.Checkpoint{Epoch: 384},
+		FinalizedCheckpoint:         &phase0.Checkpoint{Epoch: 383, Root: phase0.Root{0x01}},
+		InactivityScores:            make([]uint64, validators),
+		CurrentSyncCommittee:        &altair.SyncCommittee{Pubkeys: make([]phase0.BLSPubKey, 512)},
+		NextSyncCommittee:           &altair.SyncCommittee{Pubkeys: make([]phase0.BLSPubKey, 512)},
+		LatestExecutionPayloadHeader: &deneb.ExecutionPayloadHeader{
+			BlockNumber:   1000,
+			BaseFeePerGas: uint256.NewInt(7),
+			BlockHash:     phase0.Hash32{0x02},
+		},
+		NextWithdrawalIndex:          5,
+		NextWithdrawalValidatorIndex: 6,
+		HistoricalSummaries:          []*capella.HistoricalSummary{},
+	}
+	for i := 0; i < validators; i++ {
+		state.Validators[i] = &phase0.Validator{
+			PublicKey:                  phase0.BLSPubKey{byte(i)},
+			WithdrawalCredentials:      make([]byte, 32),
+			EffectiveBalance:           32000000000,
+			ActivationEligibilityEpoch: phase0.Epoch(i),
+			ExitEpoch:                  0xffffffffffffffff,
+			WithdrawableEpoch:          0xffffffffffffffff,
+		}
+		state.Balances[i] = phase0.Gwei(32000000000 + i)
+	}
+
+	return &spec.VersionedBeaconState{
+		Version: spec.DataVersionDeneb,
+		Deneb:   state,
+	}
+}
+
+func TestKnownIndices(t *testing.T) {
+	// Generalized indices defined in the consensus specifications.
+	index, err := proofs.BeaconStateFieldIndex(spec.DataVersionAltair, "current_sync_committee")
+	require.NoError(t, err)
+	require.Equal(t, uint64(54), index)
+
+	index, err = proofs.BeaconStateFieldIndex(spec.DataVersionDeneb, "next_sync_committee")
+	require.NoError(t, err)
+	require.Equal(t, uint64(55), index)
+
+	index, err = proofs.BeaconStateFieldIndex(spec.DataVersionCapella, "finalized_checkpoint")
+	require.NoError(t, err)
+	// Finalized root is the second field of the checkpoint.
+	require.Equal(t, uint64(105), proofs.ConcatGeneralizedIndices(index, 3))
+
+	index, err = proofs.BeaconBlockBodyFieldIndex(spec.DataVersionCapella, "execution_payload")
+	require.NoError(t, err)
+	require.Equal(t, uint64(25), index)
+
+	index, err = proofs.BeaconBlockBodyFieldIndex(spec.DataVersionPhase0, "voluntary_exits")
+	require.NoError(t, err)
+	require.Equal(t, uint64(15), index)
+
+	index, err = proofs.BlobKZGCommitmentIndex(spec.DataVersionDeneb, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(221184), index)
+
+	_, err = proofs.BlobKZGCommitmentIndex(spec.DataVersionDeneb, 4096)
+	require.EqualError(t, err, "blob index 4096 above commitment limit")
+
+	_, err = proofs.BlobKZGCommitmentIndex(spec.DataVersionCapella, 0)
+	require.EqualError(t, err, "unknown field blob_kzg_commitments in capella beacon block body")
+
+	_, err = proofs.BeaconStateFieldIndex(spec.DataVersionPhase0, "latest_execution_payload_header")
+	require.EqualError(t, err, "unknown field latest_execution_payload_header in phase0 beacon state")
+}
+
+func TestBeaconStateTree(t *testing.T) {
+	state := denebState(10)
+	expected, err := state.Deneb.HashTreeRoot()
+	require.NoError(t, err)
+
+	tree, err := proofs.NewBeaconStateTree(state)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root(expected), tree.Root())
+
+	phase0State := &spec.VersionedBeaconState{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.BeaconState{
+			Fork:                        state.Deneb.Fork,
+			LatestBlockHeader:           state.Deneb.LatestBlockHeader,
+			BlockRoots:                  state.Deneb.BlockRoots,
+			StateRoots:                  state.Deneb.StateRoots,
+			ETH1Data:                    state.Deneb.ETH1Data,
+			Validators:                  state.Deneb.Validators,
+			Balances:                    state.Deneb.Balances,
+			RANDAOMixes:                 state.Deneb.RANDAOMixes,
+			Slashings:                   state.Deneb.Slashings,
+			JustificationBits:           bitfield.Bitvector4{0x05},
+			PreviousJustifiedCheckpoint: state.Deneb.PreviousJustifiedCheckpoint,
+			CurrentJustifiedCheckpoint:  state.Deneb.CurrentJustifiedCheckpoint,
+			FinalizedCheckpoint:         state.Deneb.FinalizedCheckpoint,
+			PreviousEpochAttestations: []*phase0.PendingAttestation{
+				{
+					AggregationBits: bitfield.Bitlist{0x0d},
+					Data: &phase0.AttestationData{
+						Source: &phase0.Checkpoint{},
+						Target: &phase0.Checkpoint{},
+					},
+				},
+			},
+		},
+	}
+	expected, err = phase0State.Phase0.HashTreeRoot()
+	require.NoError(t, err)
+	tree, err = proofs.NewBeaconStateTree(phase0State)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root(expected), tree.Root())
+
+	_, err = proofs.NewBeaconStateTree(&spec.VersionedBeaconState{Version: spec.DataVersionDeneb})
+	require.EqualError(t, err, "no state data")
+}
+
+func TestValidatorProof(t *testing.T) {
+	state := denebState(10)
+	stateRoot, err := state.Deneb.HashTreeRoot()
+	require.NoError(t, err)
+
+	proof, err := proofs.ValidatorProof(state, 7)
+	require.NoError(t, err)
+	validatorRoot, err := state.Deneb.Validators[7].HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root(validatorRoot), proof.Leaf)
+	// 5 levels for the state, 1 for the list length and 40 for the registry.
+	require.Equal(t, 46, proof.Depth())
+	require.True(t, proof.Verify(stateRoot))
+	require.False(t, proof.Verify(phase0.Root{}))
+
+	_, err = proofs.ValidatorProof(state, 10)
+	require.EqualError(t, err, "validator 10 not present in state")
+}
+
+func TestBalanceProof(t *testing.T) {
+	state := denebState(10)
+	stateRoot, err := state.Deneb.HashTreeRoot()
+	require.NoError(t, err)
+
+	proof, err := proofs.BalanceProof(state, 5)
+	require.NoError(t, err)
+	require.Equal(t, 44, proof.Depth())
+	require.True(t, proof.Verify(stateRoot))
+	// Validator 5 is the second balance in the leaf.
+	require.Equal(t, byte(0x05), proof.Leaf[8])
+}
+
+func TestExecutionPayloadHeaderProof(t *testing.T) {
+	state := denebState(1)
+	stateRoot, err := state.Deneb.HashTreeRoot()
+	require.NoError(t, err)
+
+	proof, err := proofs.ExecutionPayloadHeaderProof(state)
+	require.NoError(t, err)
+	headerRoot, err := state.Deneb.LatestExecutionPayloadHeader.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root(headerRoot), proof.Leaf)
+	require.Equal(t, uint64(56), proof.Index)
+	require.True(t, proof.Verify(stateRoot))
+}
+
+func TestMultiproof(t *testing.T) {
+	state := denebState(4)
+	stateRoot, err := state.Deneb.HashTreeRoot()
+	require.NoError(t, err)
+
+	proof, err := proofs.BeaconStateFieldsProof(state, "slot", "finalized_checkpoint", "latest_execution_payload_header")
+	require.NoError(t, err)
+	require.Len(t, proof.Leaves, 3)
+	require.Equal(t, proofs.HelperIndices(proof.Indices), []uint64{57, 53, 35, 29, 27, 16, 15, 12, 9, 5})
+	require.True(t, proof.Verify(stateRoot))
+
+	proof.Leaves[0][0] ^= 0x01
+	require.False(t, proof.Verify(stateRoot))
+
+	_, err = proofs.BeaconStateFieldsProof(state, "unknown")
+	require.EqualError(t, err, "unknown field unknown in deneb beacon state")
+}
+
+func TestBeaconBlockBodyProof(t *testing.T) {
+	body := &spec.VersionedBeaconBlockBody{
+		Version: spec.DataVersionDeneb,
+		Deneb: &deneb.BeaconBlockBody{
+			ETH1Data: &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+			SyncAggregate: &altair.SyncAggregate{
+				SyncCommitteeBits: bitfield.NewBitvector512(),
+			},
+			ExecutionPayload: &deneb.ExecutionPayload{
+				BlockNumber:   1000,
+				BaseFeePerGas: uint256.NewInt(7),
+				Transactions:  []bellatrix.Transaction{{0x01, 0x02}},
+			},
+			BlobKzgCommitments: []deneb.KzgCommitment{{0x01}},
+		},
+	}
+	bodyRoot, err := body.Deneb.HashTreeRoot()
+	require.NoError(t, err)
+
+	proof, err := proofs.BeaconBlockBodyFieldsProof(body, "execution_payload", "blob_kzg_commitments")
+	require.NoError(t, err)
+	require.Equal(t, []uint64{25, 27}, proof.Indices)
+	require.True(t, proof.Verify(bodyRoot))
+}
+
+func TestHistoricalSummaryRoots(t *testing.T) {
+	state := denebState(4)
+	state.Deneb.Slot = 8192 * 100
+
+	// Obtain the expected summary from the state tree.
+	tree, err := proofs.NewBeaconStateTree(state)
+	require.NoError(t, err)
+	blockRootsIndex, err := proofs.BeaconStateFieldIndex(state.Version, "block_roots")
+	require.NoError(t, err)
+	stateRootsIndex, err := proofs.BeaconStateFieldIndex(state.Version, "state_roots")
+	require.NoError(t, err)
+	blockSummaryRoot, err := tree.Node(blockRootsIndex)
+	require.NoError(t, err)
+	stateSummaryRoot, err := tree.Node(stateRootsIndex)
+	require.NoError(t, err)
+	summary := &capella.HistoricalSummary{
+		BlockSummaryRoot: blockSummaryRoot,
+		StateSummaryRoot: stateSummaryRoot,
+	}
+
+	// No summary in the state.
+	_, err = proofs.NewHistoricalSummaryRoots(state)
+	require.EqualError(t, err, "state has no historical summaries")
+
+	// Mismatched summary in the state.
+	state.Deneb.HistoricalSummaries = []*capella.HistoricalSummary{{}}
+	_, err = proofs.NewHistoricalSummaryRoots(state)
+	require.ErrorContains(t, err, "state historical summary does not match its roots: block summary root")
+
+	state.Deneb.HistoricalSummaries = []*capella.HistoricalSummary{{}, summary}
+	historicalRoots, err := proofs.NewHistoricalSummaryRoots(state)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), historicalRoots.Index)
+	require.Equal(t, phase0.Slot(8192*99), historicalRoots.StartSlot)
+	calculated, err := historicalRoots.Summary()
+	require.NoError(t, err)
+	require.Equal(t, summary, calculated)
+
+	// Prove a historical block root.
+	slot := phase0.Slot(8192*99 + 1234)
+	proof, err := historicalRoots.BlockRootProof(slot)
+	require.NoError(t, err)
+	require.True(t, proofs.VerifyHistoricalBlockRoot(summary, 8192, slot, state.Deneb.BlockRoots[1234], proof))
+	require.False(t, proofs.VerifyHistoricalBlockRoot(summary, 8192, slot+1, state.Deneb.BlockRoots[1234], proof))
+	require.False(t, proofs.VerifyHistoricalBlockRoot(summary, 8192, slot, state.Deneb.BlockRoots[1235], proof))
+	require.False(t, proofs.VerifyHistoricalBlockRoot(&capella.HistoricalSummary{}, 8192, slot, state.Deneb.BlockRoots[1234], proof))
+
+	// Prove a historical state root.
+	proof, err = historicalRoots.StateRootProof(slot)
+	require.NoError(t, err)
+	require.Equal(t, state.Deneb.StateRoots[1234], proof.Leaf)
+	require.True(t, proof.Verify(summary.StateSummaryRoot))
+
+	_, err = historicalRoots.BlockRootProof(8192 * 100)
+	require.EqualError(t, err, "slot 819200 not covered by historical summary")
+
+	// State not at a period boundary.
+	state.Deneb.Slot++
+	_, err = proofs.NewHistoricalSummaryRoots(state)
+	require.EqualError(t, err, "state at slot 819201 is not at the end of a historical period")
+
+	// Pre-capella state.
+	_, err = proofs.NewHistoricalSummaryRoots(&spec.VersionedBeaconState{Version: spec.DataVersionBellatrix})
+	require.EqualError(t, err, "unsupported version bellatrix")
+}
@@ -0,0 +1,344 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proofs
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+)
+
+// maxDepth is the maximum depth of a tree that can be built.
+const maxDepth = 64
+
+// zeroNodes are the roots of empty subtrees of increasing depth.
+var zeroNodes [maxDepth + 1]*node
+
+func init() {
+	zeroNodes[0] = &node{}
+	for i := 1; i <= maxDepth; i++ {
+		zeroNodes[i] = &node{
+			left:  zeroNodes[i-1],
+			right: zeroNodes[i-1],
+		}
+		zeroNodes[i].root()
+	}
+}
+
+// node is a node in a Merkle tree.
+// Leaf nodes have a value; branch nodes have left and right children, and cache their root once calculated.
+type node struct {
+	left   *node
+	right  *node
+	value  phase0.Root
+	hashed bool
+}
+
+func newLeaf(value []byte) *node {
+	n := &node{}
+	copy(n.value[:], value)
+
+	return n
+}
+
+func newBranch(left *node, right *node) *node {
+	return &node{
+		left:  left,
+		right: right,
+	}
+}
+
+func (n *node) isLeaf() bool {
+	return n.left == nil
+}
+
+// root returns the Merkle root of the node.
+func (n *node) root() phase0.Root {
+	if n.isLeaf() || n.hashed {
+		return n.value
+	}
+
+	left := n.left.root()
+	right := n.right.root()
+	n.value = sha256.Sum256(append(left[:], right[:]...))
+	n.hashed = true
+
+	return n.value
+}
+
+// buildTree builds a tree of the given depth from the supplied leaves, padding with empty subtrees
+// as required.  Empty subtrees are shared rather than allocated, so the depth can be large.
+func buildTree(leaves []*node, depth int) *node {
+	if len(leaves) == 0 {
+		return zeroNodes[depth]
+	}
+	if depth == 0 {
+		return leaves[0]
+	}
+
+	half := uint64(1) << (depth - 1)
+	if uint64(len(leaves)) <= half {
+		return newBranch(buildTree(leaves, depth-1), zeroNodes[depth-1])
+	}
+
+	return newBranch(buildTree(leaves[:half], depth-1), buildTree(leaves[half:], depth-1))
+}
+
+// depthFor returns the depth of tree required to hold the given number of leaves.
+func depthFor(leaves uint64) int {
+	if leaves <= 1 {
+		return 0
+	}
+
+	return bits.Len64(leaves - 1)
+}
+
+// treeBuilder builds a Merkle tree by walking an SSZ object.
+// It follows the same rules as the fastssz hasher, but retains the intermediate nodes.
+type treeBuilder struct {
+	nodes []*node
+	buf   []byte
+}
+
+var _ ssz.HashWalker = (*treeBuilder)(nil)
+
+// flush moves any complete chunks in the buffer in to leaf nodes.
+func (b *treeBuilder) flush() {
+	for len(b.buf) >= 32 {
+		b.nodes = append(b.nodes, newLeaf(b.buf[:32]))
+		b.buf = b.buf[32:]
+	}
+}
+
+// Hash returns the root of the most recent node.
+func (b *treeBuilder) Hash() []byte {
+	b.flush()
+	root := b.nodes[len(b.nodes)-1].root()
+
+	return root[:]
+}
+
+// AppendUint8 appends a uint8 to the buffer.
+func (b *treeBuilder) AppendUint8(i uint8) {
+	b.buf = append(b.buf, i)
+}
+
+// AppendUint64 appends a uint64 to the buffer.
+func (b *treeBuilder) AppendUint64(i uint64) {
+	b.buf = binary.LittleEndian.AppendUint64(b.buf, i)
+}
+
+// AppendBytes32 appends bytes to the buffer, padding to 32 bytes.
+func (b *treeBuilder) AppendBytes32(data []byte) {
+	b.buf = append(b.buf, data...)
+	b.FillUpTo32()
+}
+
+// PutUint64 adds a uint64 leaf.
+func (b *treeBuilder) PutUint64(i uint64) {
+	b.AppendBytes32(binary.LittleEndian.AppendUint64(nil, i))
+}
+
+// PutUint32 adds a uint32 leaf.
+func (b *treeBuilder) PutUint32(i uint32) {
+	b.AppendBytes32(binary.LittleEndian.AppendUint32(nil, i))
+}
+
+// PutUint16 adds a uint16 leaf.
+func (b *treeBuilder) PutUint16(i uint16) {
+	b.AppendBytes32(binary.LittleEndian.AppendUint16(nil, i))
+}
+
+// PutUint8 adds a uint8 leaf.
+func (b *treeBuilder) PutUint8(i uint8) {
+	b.AppendBytes32([]byte{i})
+}
+
+// FillUpTo32 pads the buffer to a multiple of 32 bytes.
+func (b *treeBuilder) FillUpTo32() {
+	if rest := len(b.buf) % 32; rest != 0 {
+		b.buf = append(b.buf, make([]byte, 32-rest)...)
+	}
+}
+
+// Append appends bytes to the buffer.
+func (b *treeBuilder) Append(data []byte) {
+	b.buf = append(b.buf, data...)
+}
+
+// PutBitlist adds a bitlist with the given maximum size.
+func (b *treeBuilder) PutBitlist(bb []byte, maxSize uint64) {
+	data, size := parseBitlist(bb)
+
+	indx := b.Index()
+	b.AppendBytes32(data)
+	b.MerkleizeWithMixin(indx, size, (maxSize+255)/256)
+}
+
+// PutBool adds a boolean leaf.
+func (b *treeBuilder) PutBool(v bool) {
+	if v {
+		b.AppendBytes32([]byte{0x01})
+	} else {
+		b.AppendBytes32([]byte{0x00})
+	}
+}
+
+// PutBytes adds bytes, merkleizing them if they are longer than a single chunk.
+func (b *treeBuilder) PutBytes(data []byte) {
+	if len(data) <= 32 {
+		b.AppendBytes32(data)
+		return
+	}
+
+	indx := b.Index()
+	b.AppendBytes32(data)
+	b.Merkleize(indx)
+}
+
+// Index returns the current node index.
+func (b *treeBuilder) Index() int {
+	b.flush()
+
+	return len(b.nodes)
+}
+
+// Merkleize merkleizes the nodes from the given index.
+func (b *treeBuilder) Merkleize(indx int) {
+	b.FillUpTo32()
+	b.flush()
+	b.nodes = append(b.nodes[:indx], merkleize(b.nodes[indx:], 0))
+}
+
+// MerkleizeWithMixin merkleizes the nodes from the given index up to the given limit and mixes in the length.
+func (b *treeBuilder) MerkleizeWithMixin(indx int, num uint64, limit uint64) {
+	b.FillUpTo32()
+	b.flush()
+	lengthLeaf := newLeaf(binary.LittleEndian.AppendUint64(nil, num))
+	b.nodes = append(b.nodes[:indx], newBranch(merkleize(b.nodes[indx:], limit), lengthLeaf))
+}
+
+// merkleize builds a tree from the leaves with the given limit.  A limit of 0 means
+// that the number of leaves is used.
+func merkleize(leaves []*node, limit uint64) *node {
+	count := uint64(len(leaves))
+	if limit == 0 {
+		limit = count
+	}
+	if count > limit {
+		panic(fmt.Sprintf("count %d higher than limit %d", count, limit))
+	}
+	if limit == 0 {
+		return zeroNodes[0]
+	}
+
+	return buildTree(leaves, depthFor(limit))
+}
+
+// parseBitlist removes the length bit from the bitlist, returning the data and its size in bits.
+func parseBitlist(bb []byte) ([]byte, uint64) {
+	if len(bb) == 0 {
+		return nil, 0
+	}
+	msb := uint8(bits.Len8(bb[len(bb)-1])) - 1
+	size := uint64(8*(len(bb)-1) + int(msb))
+
+	data := make([]byte, len(bb))
+	copy(data, bb)
+	data[len(data)-1] &^= 1 << msb
+
+	newLen := len(data)
+	for i := len(data) - 1; i >= 0; i-- {
+		if data[i] != 0x00 {
+			break
+		}
+		newLen = i
+	}
+
+	return data[:newLen], size
+}
+
+// Tree is a Merkle tree of an SSZ object, from which proofs can be generated.
+type Tree struct {
+	root *node
+}
+
+// NewTree creates a Merkle tree for the given object.
+func NewTree(obj ssz.HashRoot) (tree *Tree, err error) {
+	if obj == nil {
+		return nil, errors.New("no object supplied")
+	}
+
+	// The generated SSZ code panics on some malformed objects, so protect against that.
+	defer func() {
+		if r := recover(); r != nil {
+			tree = nil
+			err = fmt.Errorf("failed to build tree: %v", r)
+		}
+	}()
+
+	builder := &treeBuilder{}
+	if err := obj.HashTreeRootWith(builder); err != nil {
+		return nil, errors.Wrap(err, "failed to build tree")
+	}
+	builder.flush()
+	if len(builder.nodes) != 1 {
+		return nil, fmt.Errorf("tree has %d roots", len(builder.nodes))
+	}
+
+	return &Tree{
+		root: builder.nodes[0],
+	}, nil
+}
+
+// Root returns the root of the tree.
+func (t *Tree) Root() phase0.Root {
+	return t.root.root()
+}
+
+// Node returns the root of the node at the given generalized index.
+func (t *Tree) Node(index uint64) (phase0.Root, error) {
+	n, err := t.get(index)
+	if err != nil {
+		return phase0.Root{}, err
+	}
+
+	return n.root(), nil
+}
+
+// get returns the node at the given generalized index.
+func (t *Tree) get(index uint64) (*node, error) {
+	if index == 0 {
+		return nil, errors.New("generalized index cannot be 0")
+	}
+
+	n := t.root
+	for i := bits.Len64(index) - 2; i >= 0; i-- {
+		if n.isLeaf() {
+			return nil, fmt.Errorf("generalized index %d not present in tree", index)
+		}
+		if index&(1<<i) == 0 {
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+
+	return n, nil
+}
@@ -0,0 +1,213 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proofs
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+)
+
+// NewBeaconStateTree creates a Merkle tree for the given beacon state.
+func NewBeaconStateTree(state *spec.VersionedBeaconState) (*Tree, error) {
+	if state == nil {
+		return nil, errors.New("no state supplied")
+	}
+
+	var obj ssz.HashRoot
+	switch state.Version {
+	case spec.DataVersionPhase0:
+		if state.Phase0 != nil {
+			obj = state.Phase0
+		}
+	case spec.DataVersionAltair:
+		if state.Altair != nil {
+			obj = state.Altair
+		}
+	case spec.DataVersionBellatrix:
+		if state.Bellatrix != nil {
+			obj = state.Bellatrix
+		}
+	case spec.DataVersionCapella:
+		if state.Capella != nil {
+			obj = state.Capella
+		}
+	case spec.DataVersionDeneb:
+		if state.Deneb != nil {
+			obj = state.Deneb
+		}
+	default:
+		return nil, fmt.Errorf("unsupported version %v", state.Version)
+	}
+	if obj == nil {
+		return nil, errors.New("no state data")
+	}
+
+	return NewTree(obj)
+}
+
+// NewBeaconBlockBodyTree creates a Merkle tree for the given beacon block body.
+func NewBeaconBlockBodyTree(body *spec.VersionedBeaconBlockBody) (*Tree, error) {
+	if body == nil {
+		return nil, errors.New("no body supplied")
+	}
+
+	var obj ssz.HashRoot
+	switch body.Version {
+	case spec.DataVersionPhase0:
+		if body.Phase0 != nil {
+			obj = body.Phase0
+		}
+	case spec.DataVersionAltair:
+		if body.Altair != nil {
+			obj = body.Altair
+		}
+	case spec.DataVersionBellatrix:
+		if body.Bellatrix != nil {
+			obj = body.Bellatrix
+		}
+	case spec.DataVersionCapella:
+		if body.Capella != nil {
+			obj = body.Capella
+		}
+	case spec.DataVersionDeneb:
+		if body.Deneb != nil {
+			obj = body.Deneb
+		}
+	default:
+		return nil, fmt.Errorf("unsupported version %v", body.Version)
+	}
+	if obj == nil {
+		return nil, errors.New("no body data")
+	}
+
+	return NewTree(obj)
+}
+
+// BeaconStateFieldsProof generates a multiproof for the named fields of the given beacon state.
+func BeaconStateFieldsProof(state *spec.VersionedBeaconState, names ...string) (*Multiproof, error) {
+	tree, err := NewBeaconStateTree(state)
+	if err != nil {
+		return nil, err
+	}
+
+	indices := make([]uint64, len(names))
+	for i, name := range names {
+		indices[i], err = BeaconStateFieldIndex(state.Version, name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tree.ProveMulti(indices)
+}
+
+// BeaconBlockBodyFieldsProof generates a multiproof for the named fields of the given beacon block body.
+func BeaconBlockBodyFieldsProof(body *spec.VersionedBeaconBlockBody, names ...string) (*Multiproof, error) {
+	tree, err := NewBeaconBlockBodyTree(body)
+	if err != nil {
+		return nil, err
+	}
+
+	indices := make([]uint64, len(names))
+	for i, name := range names {
+		indices[i], err = BeaconBlockBodyFieldIndex(body.Version, name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tree.ProveMulti(indices)
+}
+
+// ValidatorProof generates a proof of the record for the given validator against the beacon state root.
+func ValidatorProof(state *spec.VersionedBeaconState, validatorIndex uint64) (*Proof, error) {
+	tree, err := NewBeaconStateTree(state)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := state.Validators()
+	if err != nil {
+		return nil, err
+	}
+	if validatorIndex >= uint64(len(validators)) {
+		return nil, fmt.Errorf("validator %d not present in state", validatorIndex)
+	}
+
+	index, err := ValidatorIndex(state.Version, validatorIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	return tree.Prove(index)
+}
+
+// BalanceProof generates a proof of the balance for the given validator against the beacon state root.
+// The leaf of the proof contains the balances of 4 validators; the balance for the requested validator
+// is at offset 8*(validatorIndex%4) in the leaf.
+func BalanceProof(state *spec.VersionedBeaconState, validatorIndex uint64) (*Proof, error) {
+	tree, err := NewBeaconStateTree(state)
+	if err != nil {
+		return nil, err
+	}
+	balances, err := state.Balances()
+	if err != nil {
+		return nil, err
+	}
+	if validatorIndex >= uint64(len(balances)) {
+		return nil, fmt.Errorf("validator %d not present in state", validatorIndex)
+	}
+
+	index, err := BalanceIndex(state.Version, validatorIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	return tree.Prove(index)
+}
+
+// ExecutionPayloadHeaderProof generates a proof of the latest execution payload header against the beacon state root.
+func ExecutionPayloadHeaderProof(state *spec.VersionedBeaconState) (*Proof, error) {
+	tree, err := NewBeaconStateTree(state)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := BeaconStateFieldIndex(state.Version, "latest_execution_payload_header")
+	if err != nil {
+		return nil, err
+	}
+
+	return tree.Prove(index)
+}
+
+// BlobKZGCommitmentProof generates a proof of the KZG commitment for the given blob against the beacon block body root.
+func BlobKZGCommitmentProof(body *spec.VersionedBeaconBlockBody, blobIndex uint64) (*Proof, error) {
+	tree, err := NewBeaconBlockBodyTree(body)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := BlobKZGCommitmentIndex(body.Version, blobIndex)
+	if err != nil {
+		return nil, err
+	}
+	if blobIndex >= uint64(len(body.Deneb.BlobKzgCommitments)) {
+		return nil, fmt.Errorf("blob %d not present in block body", blobIndex)
+	}
+
+	return tree.Prove(index)
+}
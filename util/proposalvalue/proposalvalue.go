@@ -0,0 +1,225 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package proposalvalue compares the values of builder and locally-built block proposals,
+// and decides which of them a proposer should use.
+package proposalvalue
+
+import (
+	"math/big"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/pkg/errors"
+)
+
+// DefaultBoostFactor is the boost factor that applies no boost to builder values.
+const DefaultBoostFactor = 100
+
+// Decision is the decision about which block a proposer should use.
+type Decision int
+
+const (
+	// DecisionLocal is a decision to use the locally-built block.
+	DecisionLocal Decision = iota
+	// DecisionBuilder is a decision to use the builder block.
+	DecisionBuilder
+)
+
+var decisionStrings = [...]string{
+	"local",
+	"builder",
+}
+
+// String returns a string representation of the decision.
+func (d Decision) String() string {
+	if int(d) < 0 || int(d) >= len(decisionStrings) {
+		return "unknown"
+	}
+
+	return decisionStrings[d]
+}
+
+// Reason is the reason for a decision.
+type Reason int
+
+const (
+	// ReasonHigherValue is the decision for the block with the higher value.
+	ReasonHigherValue Reason = iota
+	// ReasonNoBuilder is the decision for the local block as there is no builder block.
+	ReasonNoBuilder
+	// ReasonNoLocal is the decision for the builder block as there is no local block.
+	ReasonNoLocal
+	// ReasonBelowMinimum is the decision for the local block as the builder value is below the minimum.
+	ReasonBelowMinimum
+)
+
+var reasonStrings = [...]string{
+	"higher value",
+	"no builder block",
+	"no local block",
+	"builder value below minimum",
+}
+
+// String returns a string representation of the reason.
+func (r Reason) String() string {
+	if int(r) < 0 || int(r) >= len(reasonStrings) {
+		return "unknown"
+	}
+
+	return reasonStrings[r]
+}
+
+// Parameters are the parameters used when comparing proposal values.
+type Parameters struct {
+	// BoostFactor is the percentage multiplier applied to the builder execution value
+	// when comparing it to the local execution value, as per the builder_boost_factor of
+	// the proposal API.  100 applies no boost, and 0 always selects the local block.
+	BoostFactor uint64
+	// MinBuilderValue is the minimum execution value, in Wei, for a builder block to be
+	// considered.  If nil there is no minimum.
+	MinBuilderValue *big.Int
+}
+
+// check ensures that the parameters are valid.
+func (p *Parameters) check() error {
+	if p == nil {
+		return errors.New("no parameters specified")
+	}
+	if p.MinBuilderValue != nil && p.MinBuilderValue.Sign() < 0 {
+		return errors.New("minimum builder value cannot be negative")
+	}
+
+	return nil
+}
+
+// Values are the values of a proposal to the proposer.
+type Values struct {
+	// ExecutionValue is the value of the execution payload, in Wei.
+	ExecutionValue *big.Int
+	// ConsensusValue is the value of the consensus rewards in the block, in Wei.
+	ConsensusValue *big.Int
+}
+
+// ValuesFromProposal returns the values of a proposal.
+func ValuesFromProposal(proposal *api.VersionedProposal) *Values {
+	return &Values{
+		ExecutionValue: proposal.ExecutionValue,
+		ConsensusValue: proposal.ConsensusValue,
+	}
+}
+
+// Comparison is the result of comparing proposal values.
+type Comparison struct {
+	Decision Decision
+	Reason   Reason
+	// LocalValue is the total value of the local block, in Wei.
+	// This is nil if there is no local block.
+	LocalValue *big.Int
+	// BuilderValue is the total value of the builder block, in Wei.
+	// This is nil if there is no builder block.
+	BuilderValue *big.Int
+	// BoostedBuilderValue is the total value of the builder block after the boost factor
+	// has been applied to its execution value, in Wei.
+	// This is nil if there is no builder block.
+	BoostedBuilderValue *big.Int
+}
+
+// Compare compares the values of a local and a builder block, and decides which to use.
+// Either of local or builder may be nil if the relevant block is not available, but not both.
+// The builder block is used only if its execution value meets the minimum, and its boosted
+// total value is strictly greater than the total value of the local block.
+func Compare(local *Values, builder *Values, params *Parameters) (*Comparison, error) {
+	if err := params.check(); err != nil {
+		return nil, err
+	}
+	if local == nil && builder == nil {
+		return nil, errors.New("no values supplied")
+	}
+
+	res := &Comparison{}
+	if local != nil {
+		res.LocalValue = total(local.ExecutionValue, local.ConsensusValue)
+	}
+	if builder == nil {
+		res.Decision = DecisionLocal
+		res.Reason = ReasonNoBuilder
+
+		return res, nil
+	}
+
+	res.BuilderValue = total(builder.ExecutionValue, builder.ConsensusValue)
+	boostedExecutionValue := total(builder.ExecutionValue)
+	boostedExecutionValue.Mul(boostedExecutionValue, new(big.Int).SetUint64(params.BoostFactor))
+	boostedExecutionValue.Quo(boostedExecutionValue, big.NewInt(DefaultBoostFactor))
+	res.BoostedBuilderValue = total(boostedExecutionValue, builder.ConsensusValue)
+
+	switch {
+	case params.MinBuilderValue != nil && total(builder.ExecutionValue).Cmp(params.MinBuilderValue) < 0:
+		res.Decision = DecisionLocal
+		res.Reason = ReasonBelowMinimum
+	case local == nil:
+		res.Decision = DecisionBuilder
+		res.Reason = ReasonNoLocal
+	case res.BoostedBuilderValue.Cmp(res.LocalValue) > 0:
+		res.Decision = DecisionBuilder
+		res.Reason = ReasonHigherValue
+	default:
+		res.Decision = DecisionLocal
+		res.Reason = ReasonHigherValue
+	}
+
+	return res, nil
+}
+
+// CompareProposals compares a pair of proposals, as returned by the proposal API, and decides
+// which to use.  Either proposal may be nil if it is not available, but not both.
+// If both proposals are supplied then one must be blinded and the other not.
+func CompareProposals(first *api.VersionedProposal,
+	second *api.VersionedProposal,
+	params *Parameters,
+) (
+	*Comparison,
+	error,
+) {
+	var local, builder *Values
+	for _, proposal := range []*api.VersionedProposal{first, second} {
+		if proposal == nil {
+			continue
+		}
+		if proposal.Blinded {
+			if builder != nil {
+				return nil, errors.New("multiple builder proposals supplied")
+			}
+			builder = ValuesFromProposal(proposal)
+		} else {
+			if local != nil {
+				return nil, errors.New("multiple local proposals supplied")
+			}
+			local = ValuesFromProposal(proposal)
+		}
+	}
+
+	return Compare(local, builder, params)
+}
+
+// total returns the sum of the supplied values, treating nil values as zero.
+func total(values ...*big.Int) *big.Int {
+	res := new(big.Int)
+	for _, value := range values {
+		if value != nil {
+			res.Add(res, value)
+		}
+	}
+
+	return res
+}
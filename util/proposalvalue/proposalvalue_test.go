@@ -0,0 +1,233 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proposalvalue_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/util/proposalvalue"
+	"github.com/stretchr/testify/require"
+)
+
+func values(execution int64, consensus int64) *proposalvalue.Values {
+	return &proposalvalue.Values{
+		ExecutionValue: big.NewInt(execution),
+		ConsensusValue: big.NewInt(consensus),
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name            string
+		local           *proposalvalue.Values
+		builder         *proposalvalue.Values
+		params          *proposalvalue.Parameters
+		err             string
+		decision        proposalvalue.Decision
+		reason          proposalvalue.Reason
+		boostedBuilder  *big.Int
+		expectedLocal   *big.Int
+		expectedBuilder *big.Int
+	}{
+		{
+			name:    "ParametersMissing",
+			local:   values(1, 1),
+			builder: values(2, 1),
+			err:     "no parameters specified",
+		},
+		{
+			name:    "MinBuilderValueNegative",
+			local:   values(1, 1),
+			builder: values(2, 1),
+			params:  &proposalvalue.Parameters{BoostFactor: 100, MinBuilderValue: big.NewInt(-1)},
+			err:     "minimum builder value cannot be negative",
+		},
+		{
+			name:   "ValuesMissing",
+			params: &proposalvalue.Parameters{BoostFactor: 100},
+			err:    "no values supplied",
+		},
+		{
+			name:          "NoBuilder",
+			local:         values(1, 1),
+			params:        &proposalvalue.Parameters{BoostFactor: 100},
+			decision:      proposalvalue.DecisionLocal,
+			reason:        proposalvalue.ReasonNoBuilder,
+			expectedLocal: big.NewInt(2),
+		},
+		{
+			name:            "NoLocal",
+			builder:         values(1, 1),
+			params:          &proposalvalue.Parameters{BoostFactor: 100},
+			decision:        proposalvalue.DecisionBuilder,
+			reason:          proposalvalue.ReasonNoLocal,
+			boostedBuilder:  big.NewInt(2),
+			expectedBuilder: big.NewInt(2),
+		},
+		{
+			name:            "BuilderHigher",
+			local:           values(100, 10),
+			builder:         values(101, 10),
+			params:          &proposalvalue.Parameters{BoostFactor: 100},
+			decision:        proposalvalue.DecisionBuilder,
+			reason:          proposalvalue.ReasonHigherValue,
+			boostedBuilder:  big.NewInt(111),
+			expectedLocal:   big.NewInt(110),
+			expectedBuilder: big.NewInt(111),
+		},
+		{
+			name:            "Equal",
+			local:           values(100, 10),
+			builder:         values(100, 10),
+			params:          &proposalvalue.Parameters{BoostFactor: 100},
+			decision:        proposalvalue.DecisionLocal,
+			reason:          proposalvalue.ReasonHigherValue,
+			boostedBuilder:  big.NewInt(110),
+			expectedLocal:   big.NewInt(110),
+			expectedBuilder: big.NewInt(110),
+		},
+		{
+			name:            "BoostReducesBuilder",
+			local:           values(100, 10),
+			builder:         values(150, 10),
+			params:          &proposalvalue.Parameters{BoostFactor: 50},
+			decision:        proposalvalue.DecisionLocal,
+			reason:          proposalvalue.ReasonHigherValue,
+			boostedBuilder:  big.NewInt(85),
+			expectedLocal:   big.NewInt(110),
+			expectedBuilder: big.NewInt(160),
+		},
+		{
+			name:            "BoostIncreasesBuilder",
+			local:           values(100, 10),
+			builder:         values(90, 10),
+			params:          &proposalvalue.Parameters{BoostFactor: 200},
+			decision:        proposalvalue.DecisionBuilder,
+			reason:          proposalvalue.ReasonHigherValue,
+			boostedBuilder:  big.NewInt(190),
+			expectedLocal:   big.NewInt(110),
+			expectedBuilder: big.NewInt(100),
+		},
+		{
+			name:            "BoostZero",
+			local:           values(0, 0),
+			builder:         values(1000, 0),
+			params:          &proposalvalue.Parameters{},
+			decision:        proposalvalue.DecisionLocal,
+			reason:          proposalvalue.ReasonHigherValue,
+			boostedBuilder:  big.NewInt(0),
+			expectedLocal:   big.NewInt(0),
+			expectedBuilder: big.NewInt(1000),
+		},
+		{
+			name:            "BelowMinimum",
+			local:           values(1, 0),
+			builder:         values(99, 0),
+			params:          &proposalvalue.Parameters{BoostFactor: 100, MinBuilderValue: big.NewInt(100)},
+			decision:        proposalvalue.DecisionLocal,
+			reason:          proposalvalue.ReasonBelowMinimum,
+			boostedBuilder:  big.NewInt(99),
+			expectedLocal:   big.NewInt(1),
+			expectedBuilder: big.NewInt(99),
+		},
+		{
+			name:            "BelowMinimumNoLocal",
+			builder:         values(99, 0),
+			params:          &proposalvalue.Parameters{BoostFactor: 100, MinBuilderValue: big.NewInt(100)},
+			decision:        proposalvalue.DecisionLocal,
+			reason:          proposalvalue.ReasonBelowMinimum,
+			boostedBuilder:  big.NewInt(99),
+			expectedBuilder: big.NewInt(99),
+		},
+		{
+			name:            "AtMinimum",
+			local:           values(1, 0),
+			builder:         values(100, 0),
+			params:          &proposalvalue.Parameters{BoostFactor: 100, MinBuilderValue: big.NewInt(100)},
+			decision:        proposalvalue.DecisionBuilder,
+			reason:          proposalvalue.ReasonHigherValue,
+			boostedBuilder:  big.NewInt(100),
+			expectedLocal:   big.NewInt(1),
+			expectedBuilder: big.NewInt(100),
+		},
+		{
+			name:            "NilValues",
+			local:           &proposalvalue.Values{},
+			builder:         &proposalvalue.Values{ExecutionValue: big.NewInt(1)},
+			params:          &proposalvalue.Parameters{BoostFactor: 100},
+			decision:        proposalvalue.DecisionBuilder,
+			reason:          proposalvalue.ReasonHigherValue,
+			boostedBuilder:  big.NewInt(1),
+			expectedLocal:   big.NewInt(0),
+			expectedBuilder: big.NewInt(1),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := proposalvalue.Compare(test.local, test.builder, test.params)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.decision, res.Decision)
+			require.Equal(t, test.reason, res.Reason)
+			require.Equal(t, test.expectedLocal, res.LocalValue)
+			require.Equal(t, test.expectedBuilder, res.BuilderValue)
+			require.Equal(t, test.boostedBuilder, res.BoostedBuilderValue)
+		})
+	}
+}
+
+func TestCompareProposals(t *testing.T) {
+	params := &proposalvalue.Parameters{BoostFactor: proposalvalue.DefaultBoostFactor}
+	local := &api.VersionedProposal{ExecutionValue: big.NewInt(100), ConsensusValue: big.NewInt(10)}
+	builder := &api.VersionedProposal{Blinded: true, ExecutionValue: big.NewInt(200), ConsensusValue: big.NewInt(10)}
+
+	res, err := proposalvalue.CompareProposals(local, builder, params)
+	require.NoError(t, err)
+	require.Equal(t, proposalvalue.DecisionBuilder, res.Decision)
+
+	// Order does not matter.
+	res, err = proposalvalue.CompareProposals(builder, local, params)
+	require.NoError(t, err)
+	require.Equal(t, proposalvalue.DecisionBuilder, res.Decision)
+
+	// Single proposal.
+	res, err = proposalvalue.CompareProposals(builder, nil, &proposalvalue.Parameters{
+		BoostFactor:     proposalvalue.DefaultBoostFactor,
+		MinBuilderValue: big.NewInt(1000),
+	})
+	require.NoError(t, err)
+	require.Equal(t, proposalvalue.DecisionLocal, res.Decision)
+	require.Equal(t, proposalvalue.ReasonBelowMinimum, res.Reason)
+
+	_, err = proposalvalue.CompareProposals(local, local, params)
+	require.EqualError(t, err, "multiple local proposals supplied")
+	_, err = proposalvalue.CompareProposals(builder, builder, params)
+	require.EqualError(t, err, "multiple builder proposals supplied")
+	_, err = proposalvalue.CompareProposals(nil, nil, params)
+	require.EqualError(t, err, "no values supplied")
+}
+
+func TestStrings(t *testing.T) {
+	require.Equal(t, "local", proposalvalue.DecisionLocal.String())
+	require.Equal(t, "builder", proposalvalue.DecisionBuilder.String())
+	require.Equal(t, "unknown", proposalvalue.Decision(-1).String())
+	require.Equal(t, "builder value below minimum", proposalvalue.ReasonBelowMinimum.String())
+	require.Equal(t, "unknown", proposalvalue.Reason(99).String())
+}
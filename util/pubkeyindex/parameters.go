@@ -0,0 +1,132 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubkeyindex
+
+import (
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel           zerolog.Level
+	client             consensusclient.Service
+	stateID            string
+	batchSize          int
+	minRefreshInterval time.Duration
+	refreshInterval    time.Duration
+	persistencePath    string
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithClient sets the client from which to obtain validators.
+// The client must be a validators provider.
+func WithClient(client consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.client = client
+	})
+}
+
+// WithStateID sets the state from which to obtain validators.
+func WithStateID(stateID string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.stateID = stateID
+	})
+}
+
+// WithBatchSize sets the number of validators requested at a time when updating the index.
+func WithBatchSize(batchSize int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.batchSize = batchSize
+	})
+}
+
+// WithMinRefreshInterval sets the minimum time between updates of the index triggered
+// by lookups of unknown validators.
+func WithMinRefreshInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.minRefreshInterval = interval
+	})
+}
+
+// WithRefreshInterval sets the interval at which the index is updated in the background.
+// If this is 0 the index is only updated when unknown validators are looked up.
+func WithRefreshInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.refreshInterval = interval
+	})
+}
+
+// WithPersistencePath sets the path of the file in which the index is persisted.
+// If this is not set the index is not persisted.
+func WithPersistencePath(path string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.persistencePath = path
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:           zerolog.GlobalLevel(),
+		stateID:            "head",
+		batchSize:          10000,
+		minRefreshInterval: 12 * time.Second,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.client == nil {
+		return nil, errors.New("no client specified")
+	}
+	if _, isProvider := parameters.client.(consensusclient.ValidatorsProvider); !isProvider {
+		return nil, errors.New("client does not provide validators")
+	}
+	if parameters.stateID == "" {
+		return nil, errors.New("no state ID specified")
+	}
+	if parameters.batchSize <= 0 {
+		return nil, errors.New("batch size must be positive")
+	}
+	if parameters.minRefreshInterval < 0 {
+		return nil, errors.New("minimum refresh interval cannot be negative")
+	}
+	if parameters.refreshInterval < 0 {
+		return nil, errors.New("refresh interval cannot be negative")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,300 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pubkeyindex maintains a bidirectional mapping between validator public keys and
+// indices.  The mapping is built lazily from the validators endpoint, updated incrementally
+// as new validators appear, and can optionally be persisted to disk, avoiding the full
+// validator fetches otherwise required to map between the two.
+package pubkeyindex
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service maintains the mapping between validator public keys and indices.
+type Service struct {
+	log                zerolog.Logger
+	validatorsProvider consensusclient.ValidatorsProvider
+	stateID            string
+	batchSize          int
+	minRefreshInterval time.Duration
+	persistencePath    string
+
+	// refreshMu serialises refreshes.
+	refreshMu   sync.Mutex
+	lastRefresh time.Time
+
+	mu      sync.RWMutex
+	pubKeys []phase0.BLSPubKey
+	indices map[phase0.BLSPubKey]phase0.ValidatorIndex
+}
+
+// New creates a new public key index.
+// If a persistence path is supplied the index is loaded from it, but otherwise the index
+// is not built until it is first required.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	log := zerologger.With().Str("service", "pubkeyindex").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	s := &Service{
+		log:                log,
+		validatorsProvider: parameters.client.(consensusclient.ValidatorsProvider),
+		stateID:            parameters.stateID,
+		batchSize:          parameters.batchSize,
+		minRefreshInterval: parameters.minRefreshInterval,
+		persistencePath:    parameters.persistencePath,
+		indices:            make(map[phase0.BLSPubKey]phase0.ValidatorIndex),
+	}
+
+	if s.persistencePath != "" {
+		if err := s.load(); err != nil {
+			return nil, err
+		}
+	}
+
+	if parameters.refreshInterval > 0 {
+		go s.refreshLoop(ctx, parameters.refreshInterval)
+	}
+
+	return s, nil
+}
+
+// Len returns the number of validators in the index.
+func (s *Service) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.pubKeys)
+}
+
+// Index returns the index of the validator with the given public key.
+// If the validator is not in the index then the index is updated, subject to the
+// minimum refresh interval, before returning.
+// The boolean return is false if the validator is not known.
+func (s *Service) Index(ctx context.Context, pubKey phase0.BLSPubKey) (phase0.ValidatorIndex, bool, error) {
+	if index, exists := s.LookupIndex(pubKey); exists {
+		return index, true, nil
+	}
+
+	if err := s.refreshIfStale(ctx); err != nil {
+		return 0, false, err
+	}
+
+	index, exists := s.LookupIndex(pubKey)
+
+	return index, exists, nil
+}
+
+// PubKey returns the public key of the validator with the given index.
+// If the validator is not in the index then the index is updated, subject to the
+// minimum refresh interval, before returning.
+// The boolean return is false if the validator is not known.
+func (s *Service) PubKey(ctx context.Context, index phase0.ValidatorIndex) (phase0.BLSPubKey, bool, error) {
+	if pubKey, exists := s.LookupPubKey(index); exists {
+		return pubKey, true, nil
+	}
+
+	if err := s.refreshIfStale(ctx); err != nil {
+		return phase0.BLSPubKey{}, false, err
+	}
+
+	pubKey, exists := s.LookupPubKey(index)
+
+	return pubKey, exists, nil
+}
+
+// LookupIndex returns the index of the validator with the given public key from the
+// index as it stands, without updating it.
+func (s *Service) LookupIndex(pubKey phase0.BLSPubKey) (phase0.ValidatorIndex, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	index, exists := s.indices[pubKey]
+
+	return index, exists
+}
+
+// LookupPubKey returns the public key of the validator with the given index from the
+// index as it stands, without updating it.
+func (s *Service) LookupPubKey(index phase0.ValidatorIndex) (phase0.BLSPubKey, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if uint64(index) >= uint64(len(s.pubKeys)) {
+		return phase0.BLSPubKey{}, false
+	}
+
+	return s.pubKeys[index], true
+}
+
+// Refresh updates the index with any validators that have appeared since it was last updated.
+func (s *Service) Refresh(ctx context.Context) error {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	return s.refresh(ctx)
+}
+
+// refreshIfStale updates the index if it has not been updated within the minimum refresh interval.
+func (s *Service) refreshIfStale(ctx context.Context) error {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	if !s.lastRefresh.IsZero() && time.Since(s.lastRefresh) < s.minRefreshInterval {
+		return nil
+	}
+
+	return s.refresh(ctx)
+}
+
+// refresh updates the index.  It must be called with refreshMu held.
+func (s *Service) refresh(ctx context.Context) error {
+	added := 0
+	for {
+		start := phase0.ValidatorIndex(s.Len())
+		indices := make([]phase0.ValidatorIndex, s.batchSize)
+		for i := range indices {
+			indices[i] = start + phase0.ValidatorIndex(i)
+		}
+
+		validators, err := s.validatorsProvider.Validators(ctx, s.stateID, indices, nil)
+		if err != nil {
+			return errors.Wrap(err, "failed to obtain validators")
+		}
+
+		// Validator indices are contiguous, so only take validators up to the first gap.
+		pubKeys := make([]phase0.BLSPubKey, 0, len(validators))
+		for _, index := range indices {
+			validator, exists := validators[index]
+			if !exists || validator.Validator == nil {
+				break
+			}
+			pubKeys = append(pubKeys, validator.Validator.PublicKey)
+		}
+
+		s.mu.Lock()
+		for i, pubKey := range pubKeys {
+			s.indices[pubKey] = start + phase0.ValidatorIndex(i)
+		}
+		s.pubKeys = append(s.pubKeys, pubKeys...)
+		s.mu.Unlock()
+		added += len(pubKeys)
+
+		if len(pubKeys) < s.batchSize {
+			break
+		}
+	}
+	s.lastRefresh = time.Now()
+	s.log.Trace().Int("added", added).Int("validators", s.Len()).Msg("Refreshed index")
+
+	if added > 0 && s.persistencePath != "" {
+		if err := s.save(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// refreshLoop updates the index at the given interval until the context is done.
+func (s *Service) refreshLoop(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Refresh(ctx); err != nil {
+				s.log.Warn().Err(err).Msg("Failed to refresh index")
+			}
+		}
+	}
+}
+
+// load loads the index from the persistence path, if present.
+// The file contains the public keys of the validators in index order.
+func (s *Service) load() error {
+	data, err := os.ReadFile(s.persistencePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return errors.Wrap(err, "failed to read persisted index")
+	}
+	if len(data)%phase0.PublicKeyLength != 0 {
+		return fmt.Errorf("persisted index has invalid length %d", len(data))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pubKeys = make([]phase0.BLSPubKey, len(data)/phase0.PublicKeyLength)
+	for i := range s.pubKeys {
+		copy(s.pubKeys[i][:], data[i*phase0.PublicKeyLength:])
+		s.indices[s.pubKeys[i]] = phase0.ValidatorIndex(i)
+	}
+	s.log.Trace().Int("validators", len(s.pubKeys)).Msg("Loaded persisted index")
+
+	return nil
+}
+
+// save saves the index to the persistence path.
+// The index is written to a temporary file which replaces the existing file, so that a
+// partially-written index is never loaded.
+func (s *Service) save() error {
+	s.mu.RLock()
+	data := make([]byte, 0, len(s.pubKeys)*phase0.PublicKeyLength)
+	for i := range s.pubKeys {
+		data = append(data, s.pubKeys[i][:]...)
+	}
+	s.mu.RUnlock()
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(s.persistencePath), filepath.Base(s.persistencePath)+".*.tmp")
+	if err != nil {
+		return errors.Wrap(err, "failed to create temporary index file")
+	}
+	if _, err := tmpFile.Write(data); err != nil {
+		_ = tmpFile.Close()
+		_ = os.Remove(tmpFile.Name())
+		return errors.Wrap(err, "failed to write temporary index file")
+	}
+	if err := tmpFile.Close(); err != nil {
+		_ = os.Remove(tmpFile.Name())
+		return errors.Wrap(err, "failed to close temporary index file")
+	}
+	if err := os.Rename(tmpFile.Name(), s.persistencePath); err != nil {
+		_ = os.Remove(tmpFile.Name())
+		return errors.Wrap(err, "failed to replace index file")
+	}
+
+	return nil
+}
@@ -0,0 +1,272 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubkeyindex_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/pubkeyindex"
+	"github.com/stretchr/testify/require"
+)
+
+// validatorsClient is a client with a controllable number of validators.
+type validatorsClient struct {
+	consensusclient.Service
+	mu         sync.Mutex
+	validators int
+	calls      int
+	err        error
+}
+
+func (c *validatorsClient) Validators(_ context.Context,
+	_ string,
+	validatorIndices []phase0.ValidatorIndex,
+	_ []apiv1.ValidatorState,
+) (
+	map[phase0.ValidatorIndex]*apiv1.Validator,
+	error,
+) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	res := make(map[phase0.ValidatorIndex]*apiv1.Validator)
+	for _, index := range validatorIndices {
+		if int(index) < c.validators {
+			res[index] = &apiv1.Validator{
+				Index: index,
+				Validator: &phase0.Validator{
+					PublicKey: pubKey(index),
+				},
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (c *validatorsClient) ValidatorsByPubKey(_ context.Context,
+	_ string,
+	_ []phase0.BLSPubKey,
+	_ []apiv1.ValidatorState,
+) (
+	map[phase0.ValidatorIndex]*apiv1.Validator,
+	error,
+) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *validatorsClient) setValidators(validators int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.validators = validators
+}
+
+func (c *validatorsClient) callCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.calls
+}
+
+func pubKey(index phase0.ValidatorIndex) phase0.BLSPubKey {
+	var res phase0.BLSPubKey
+	res[0] = byte(index)
+	res[1] = byte(index >> 8)
+	res[47] = 0xff
+
+	return res
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+	mockClient, err := mock.New(ctx, mock.WithName("mock"))
+	require.NoError(t, err)
+	client := &validatorsClient{}
+
+	tests := []struct {
+		name   string
+		params []pubkeyindex.Parameter
+		err    string
+	}{
+		{
+			name: "ClientMissing",
+			err:  "problem with parameters: no client specified",
+		},
+		{
+			name:   "ClientNotValidatorsProvider",
+			params: []pubkeyindex.Parameter{pubkeyindex.WithClient(&struct{ consensusclient.Service }{mockClient})},
+			err:    "problem with parameters: client does not provide validators",
+		},
+		{
+			name:   "StateIDMissing",
+			params: []pubkeyindex.Parameter{pubkeyindex.WithClient(client), pubkeyindex.WithStateID("")},
+			err:    "problem with parameters: no state ID specified",
+		},
+		{
+			name:   "BatchSizeZero",
+			params: []pubkeyindex.Parameter{pubkeyindex.WithClient(client), pubkeyindex.WithBatchSize(0)},
+			err:    "problem with parameters: batch size must be positive",
+		},
+		{
+			name:   "MinRefreshIntervalNegative",
+			params: []pubkeyindex.Parameter{pubkeyindex.WithClient(client), pubkeyindex.WithMinRefreshInterval(-time.Second)},
+			err:    "problem with parameters: minimum refresh interval cannot be negative",
+		},
+		{
+			name:   "RefreshIntervalNegative",
+			params: []pubkeyindex.Parameter{pubkeyindex.WithClient(client), pubkeyindex.WithRefreshInterval(-time.Second)},
+			err:    "problem with parameters: refresh interval cannot be negative",
+		},
+		{
+			name:   "Good",
+			params: []pubkeyindex.Parameter{pubkeyindex.WithClient(client)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := pubkeyindex.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	ctx := context.Background()
+	client := &validatorsClient{validators: 25}
+
+	s, err := pubkeyindex.New(ctx,
+		pubkeyindex.WithClient(client),
+		pubkeyindex.WithBatchSize(10),
+		pubkeyindex.WithMinRefreshInterval(time.Hour),
+	)
+	require.NoError(t, err)
+
+	// Index is built lazily.
+	require.Equal(t, 0, s.Len())
+	require.Equal(t, 0, client.callCount())
+
+	index, found, err := s.Index(ctx, pubKey(17))
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, phase0.ValidatorIndex(17), index)
+	require.Equal(t, 25, s.Len())
+	require.Equal(t, 3, client.callCount())
+
+	key, found, err := s.PubKey(ctx, 3)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, pubKey(3), key)
+	require.Equal(t, 3, client.callCount())
+
+	// Unknown validators do not cause a refresh within the minimum refresh interval.
+	client.setValidators(30)
+	_, found, err = s.PubKey(ctx, 27)
+	require.NoError(t, err)
+	require.False(t, found)
+	require.Equal(t, 3, client.callCount())
+
+	// An explicit refresh fetches only the new validators.
+	require.NoError(t, s.Refresh(ctx))
+	require.Equal(t, 30, s.Len())
+	require.Equal(t, 4, client.callCount())
+	index, found = s.LookupIndex(pubKey(27))
+	require.True(t, found)
+	require.Equal(t, phase0.ValidatorIndex(27), index)
+	_, found = s.LookupPubKey(30)
+	require.False(t, found)
+}
+
+func TestLookupRefreshError(t *testing.T) {
+	ctx := context.Background()
+	client := &validatorsClient{err: errors.New("unavailable")}
+
+	s, err := pubkeyindex.New(ctx, pubkeyindex.WithClient(client))
+	require.NoError(t, err)
+
+	_, _, err = s.Index(ctx, pubKey(1))
+	require.EqualError(t, err, "failed to obtain validators: unavailable")
+}
+
+func TestRefreshLoop(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := &validatorsClient{validators: 5}
+
+	s, err := pubkeyindex.New(ctx,
+		pubkeyindex.WithClient(client),
+		pubkeyindex.WithRefreshInterval(10*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool { return s.Len() == 5 }, time.Second, 5*time.Millisecond)
+	client.setValidators(8)
+	require.Eventually(t, func() bool { return s.Len() == 8 }, time.Second, 5*time.Millisecond)
+}
+
+func TestPersistence(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "index")
+	client := &validatorsClient{validators: 12}
+
+	s, err := pubkeyindex.New(ctx,
+		pubkeyindex.WithClient(client),
+		pubkeyindex.WithPersistencePath(path),
+	)
+	require.NoError(t, err)
+	require.NoError(t, s.Refresh(ctx))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Len(t, data, 12*phase0.PublicKeyLength)
+
+	// A new index loads the persisted mapping without contacting the client.
+	client = &validatorsClient{err: errors.New("unavailable")}
+	s, err = pubkeyindex.New(ctx,
+		pubkeyindex.WithClient(client),
+		pubkeyindex.WithPersistencePath(path),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 12, s.Len())
+	index, found := s.LookupIndex(pubKey(11))
+	require.True(t, found)
+	require.Equal(t, phase0.ValidatorIndex(11), index)
+	require.Equal(t, 0, client.callCount())
+
+	// Corrupt persisted index.
+	require.NoError(t, os.WriteFile(path, []byte{0x01, 0x02}, 0o600))
+	_, err = pubkeyindex.New(ctx,
+		pubkeyindex.WithClient(client),
+		pubkeyindex.WithPersistencePath(path),
+	)
+	require.EqualError(t, err, "persisted index has invalid length 2")
+}
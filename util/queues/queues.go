@@ -0,0 +1,390 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package queues provides projections of when validators will pass through the activation
+// and exit queues, based on the churn limits in the specification.
+package queues
+
+import (
+	"fmt"
+	"sort"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// farFutureEpoch is the spec value of FAR_FUTURE_EPOCH.
+const farFutureEpoch = phase0.Epoch(0xffffffffffffffff)
+
+// maxProjectionEpochs is the maximum number of epochs over which the activation queue is projected.
+const maxProjectionEpochs = 1 << 20
+
+// Parameters are the spec parameters required to project the activation and exit queues.
+type Parameters struct {
+	MinPerEpochChurnLimit            uint64
+	ChurnLimitQuotient               uint64
+	MaxSeedLookahead                 uint64
+	MinValidatorWithdrawabilityDelay uint64
+	MaxEffectiveBalance              phase0.Gwei
+	// DenebForkEpoch is the epoch from which MaxPerEpochActivationChurnLimit applies.
+	DenebForkEpoch                  phase0.Epoch
+	MaxPerEpochActivationChurnLimit uint64
+	// ElectraForkEpoch is the epoch from which the queues are limited by balance rather than count.
+	ElectraForkEpoch                    phase0.Epoch
+	EffectiveBalanceIncrement           phase0.Gwei
+	MinPerEpochChurnLimitElectra        phase0.Gwei
+	MaxPerEpochActivationExitChurnLimit phase0.Gwei
+}
+
+// ParametersFromSpec obtains the queue parameters from the spec as returned by a SpecProvider.
+// Parameters for forks that the spec does not define are left unset, and their fork epochs
+// are set to the far future epoch.
+func ParametersFromSpec(specValues map[string]interface{}) (*Parameters, error) {
+	values := make(map[string]uint64)
+	for _, key := range []string{
+		"MIN_PER_EPOCH_CHURN_LIMIT",
+		"CHURN_LIMIT_QUOTIENT",
+		"MAX_SEED_LOOKAHEAD",
+		"MIN_VALIDATOR_WITHDRAWABILITY_DELAY",
+		"MAX_EFFECTIVE_BALANCE",
+	} {
+		value, err := specValue(specValues, key)
+		if err != nil {
+			return nil, err
+		}
+		values[key] = value
+	}
+	for _, key := range []string{
+		"DENEB_FORK_EPOCH",
+		"ELECTRA_FORK_EPOCH",
+	} {
+		values[key] = uint64(farFutureEpoch)
+		if _, exists := specValues[key]; exists {
+			value, err := specValue(specValues, key)
+			if err != nil {
+				return nil, err
+			}
+			values[key] = value
+		}
+	}
+	for _, key := range []string{
+		"MAX_PER_EPOCH_ACTIVATION_CHURN_LIMIT",
+		"EFFECTIVE_BALANCE_INCREMENT",
+		"MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA",
+		"MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT",
+	} {
+		if _, exists := specValues[key]; exists {
+			value, err := specValue(specValues, key)
+			if err != nil {
+				return nil, err
+			}
+			values[key] = value
+		}
+	}
+
+	return &Parameters{
+		MinPerEpochChurnLimit:               values["MIN_PER_EPOCH_CHURN_LIMIT"],
+		ChurnLimitQuotient:                  values["CHURN_LIMIT_QUOTIENT"],
+		MaxSeedLookahead:                    values["MAX_SEED_LOOKAHEAD"],
+		MinValidatorWithdrawabilityDelay:    values["MIN_VALIDATOR_WITHDRAWABILITY_DELAY"],
+		MaxEffectiveBalance:                 phase0.Gwei(values["MAX_EFFECTIVE_BALANCE"]),
+		DenebForkEpoch:                      phase0.Epoch(values["DENEB_FORK_EPOCH"]),
+		MaxPerEpochActivationChurnLimit:     values["MAX_PER_EPOCH_ACTIVATION_CHURN_LIMIT"],
+		ElectraForkEpoch:                    phase0.Epoch(values["ELECTRA_FORK_EPOCH"]),
+		EffectiveBalanceIncrement:           phase0.Gwei(values["EFFECTIVE_BALANCE_INCREMENT"]),
+		MinPerEpochChurnLimitElectra:        phase0.Gwei(values["MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA"]),
+		MaxPerEpochActivationExitChurnLimit: phase0.Gwei(values["MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT"]),
+	}, nil
+}
+
+func specValue(specValues map[string]interface{}, key string) (uint64, error) {
+	tmp, exists := specValues[key]
+	if !exists {
+		return 0, fmt.Errorf("%s not found in spec", key)
+	}
+	value, isValue := tmp.(uint64)
+	if !isValue {
+		return 0, fmt.Errorf("%s of unexpected type", key)
+	}
+
+	return value, nil
+}
+
+// Calculator projects the activation and exit queues for a validator set at a given epoch.
+//
+// Projections assume that the chain finalizes normally, that the active validator set does not
+// change other than through the projected activations, and that no further deposits or exits
+// are made.  From Electra activations are limited by the balance churn, which the specification
+// applies to pending deposits; this is approximated by charging the effective balance of each
+// queued validator against the churn.
+type Calculator struct {
+	params     *Parameters
+	validators []*phase0.Validator
+	epoch      phase0.Epoch
+
+	activeValidators   uint64
+	totalActiveBalance phase0.Gwei
+	activations        map[phase0.ValidatorIndex]phase0.Epoch
+}
+
+// NewCalculator creates a calculator for the given validators, indexed by validator index, at the given epoch.
+func NewCalculator(params *Parameters, validators []*phase0.Validator, epoch phase0.Epoch) (*Calculator, error) {
+	if params == nil {
+		return nil, errors.New("no parameters supplied")
+	}
+	if params.MinPerEpochChurnLimit == 0 {
+		return nil, errors.New("min per epoch churn limit cannot be 0")
+	}
+	if params.ChurnLimitQuotient == 0 {
+		return nil, errors.New("churn limit quotient cannot be 0")
+	}
+	if params.ElectraForkEpoch != farFutureEpoch && params.MinPerEpochChurnLimitElectra == 0 {
+		return nil, errors.New("min per epoch churn limit for electra cannot be 0")
+	}
+
+	c := &Calculator{
+		params:     params,
+		validators: validators,
+		epoch:      epoch,
+	}
+	for _, validator := range validators {
+		if validator != nil && validator.ActivationEpoch <= epoch && epoch < validator.ExitEpoch {
+			c.activeValidators++
+			c.totalActiveBalance += validator.EffectiveBalance
+		}
+	}
+	c.activations = c.projectActivations()
+
+	return c, nil
+}
+
+// ActiveValidators returns the number of validators active at the calculator's epoch.
+func (c *Calculator) ActiveValidators() uint64 {
+	return c.activeValidators
+}
+
+// ActivationEpoch returns the epoch at which the given validator is projected to activate.
+// Validators that are already scheduled for activation, or are active, return their activation epoch.
+func (c *Calculator) ActivationEpoch(index phase0.ValidatorIndex) (phase0.Epoch, error) {
+	validator, err := c.validator(index)
+	if err != nil {
+		return 0, err
+	}
+	if validator.ActivationEpoch != farFutureEpoch {
+		return validator.ActivationEpoch, nil
+	}
+	epoch, exists := c.activations[index]
+	if !exists {
+		return 0, fmt.Errorf("validator %d is not eligible for activation", index)
+	}
+
+	return epoch, nil
+}
+
+// ActivationEpochs returns the projected activation epochs of all validators awaiting activation.
+func (c *Calculator) ActivationEpochs() map[phase0.ValidatorIndex]phase0.Epoch {
+	res := make(map[phase0.ValidatorIndex]phase0.Epoch, len(c.activations))
+	for index, epoch := range c.activations {
+		res[index] = epoch
+	}
+
+	return res
+}
+
+// ExitEpoch returns the epochs at which the given validator is projected to exit and become
+// withdrawable if it initiates an exit at the calculator's epoch.
+// Validators that are already exiting return their existing exit and withdrawable epochs.
+func (c *Calculator) ExitEpoch(index phase0.ValidatorIndex) (phase0.Epoch, phase0.Epoch, error) {
+	validator, err := c.validator(index)
+	if err != nil {
+		return 0, 0, err
+	}
+	if validator.ExitEpoch != farFutureEpoch {
+		return validator.ExitEpoch, validator.WithdrawableEpoch, nil
+	}
+	if validator.ActivationEpoch > c.epoch {
+		return 0, 0, fmt.Errorf("validator %d is not active", index)
+	}
+
+	var exitEpoch phase0.Epoch
+	if c.epoch >= c.params.ElectraForkEpoch {
+		exitEpoch = c.balanceExitEpoch(validator.EffectiveBalance)
+	} else {
+		exitEpoch = c.countExitEpoch()
+	}
+
+	return exitEpoch, exitEpoch + phase0.Epoch(c.params.MinValidatorWithdrawabilityDelay), nil
+}
+
+func (c *Calculator) validator(index phase0.ValidatorIndex) (*phase0.Validator, error) {
+	if int(index) >= len(c.validators) || c.validators[index] == nil {
+		return nil, fmt.Errorf("validator %d not present", index)
+	}
+
+	return c.validators[index], nil
+}
+
+// activationExitEpoch returns the epoch at which an activation or exit processed at the given epoch takes effect.
+func (c *Calculator) activationExitEpoch(epoch phase0.Epoch) phase0.Epoch {
+	return epoch + 1 + phase0.Epoch(c.params.MaxSeedLookahead)
+}
+
+func (c *Calculator) churnConfig(epoch phase0.Epoch) *apiv1.ChurnConfig {
+	config := &apiv1.ChurnConfig{
+		MinPerEpochChurnLimit: c.params.MinPerEpochChurnLimit,
+		ChurnLimitQuotient:    c.params.ChurnLimitQuotient,
+	}
+	if epoch >= c.params.DenebForkEpoch {
+		config.MaxPerEpochActivationChurnLimit = c.params.MaxPerEpochActivationChurnLimit
+	}
+
+	return config
+}
+
+// balanceChurnLimit returns the Electra activation and exit balance churn limit.
+func (c *Calculator) balanceChurnLimit() phase0.Gwei {
+	churn := c.params.MinPerEpochChurnLimitElectra
+	if c.totalActiveBalance/phase0.Gwei(c.params.ChurnLimitQuotient) > churn {
+		churn = c.totalActiveBalance / phase0.Gwei(c.params.ChurnLimitQuotient)
+	}
+	if c.params.EffectiveBalanceIncrement > 0 {
+		churn -= churn % c.params.EffectiveBalanceIncrement
+	}
+	if c.params.MaxPerEpochActivationExitChurnLimit > 0 && churn > c.params.MaxPerEpochActivationExitChurnLimit {
+		churn = c.params.MaxPerEpochActivationExitChurnLimit
+	}
+	if churn == 0 {
+		churn = c.params.MinPerEpochChurnLimitElectra
+	}
+
+	return churn
+}
+
+type queuedValidator struct {
+	index       phase0.ValidatorIndex
+	eligibility phase0.Epoch
+	balance     phase0.Gwei
+}
+
+// projectActivations walks the activation queue epoch by epoch, in the order defined by the specification.
+func (c *Calculator) projectActivations() map[phase0.ValidatorIndex]phase0.Epoch {
+	queue := make([]*queuedValidator, 0)
+	for i, validator := range c.validators {
+		if validator == nil || validator.ActivationEpoch != farFutureEpoch {
+			continue
+		}
+		eligibility := validator.ActivationEligibilityEpoch
+		if eligibility == farFutureEpoch {
+			if validator.EffectiveBalance < c.params.MaxEffectiveBalance {
+				// Not enough balance to become eligible.
+				continue
+			}
+			eligibility = c.epoch + 1
+		}
+		queue = append(queue, &queuedValidator{
+			index:       phase0.ValidatorIndex(i),
+			eligibility: eligibility,
+			balance:     validator.EffectiveBalance,
+		})
+	}
+	sort.Slice(queue, func(i int, j int) bool {
+		if queue[i].eligibility != queue[j].eligibility {
+			return queue[i].eligibility < queue[j].eligibility
+		}
+		return queue[i].index < queue[j].index
+	})
+
+	res := make(map[phase0.ValidatorIndex]phase0.Epoch, len(queue))
+	balanceToConsume := phase0.Gwei(0)
+	for epoch := c.epoch; len(queue) > 0 && epoch < c.epoch+maxProjectionEpochs; epoch++ {
+		// Eligibility must be finalized; assume finality two epochs behind.
+		finalized := phase0.Epoch(0)
+		if epoch > 1 {
+			finalized = epoch - 2
+		}
+
+		if epoch >= c.params.ElectraForkEpoch {
+			balanceToConsume += c.balanceChurnLimit()
+			for len(queue) > 0 && queue[0].eligibility <= finalized && queue[0].balance <= balanceToConsume {
+				balanceToConsume -= queue[0].balance
+				res[queue[0].index] = c.activationExitEpoch(epoch)
+				queue = queue[1:]
+			}
+			if len(queue) > 0 && queue[0].eligibility > finalized {
+				// Unused churn only carries over while the queue is churn-limited.
+				balanceToConsume = 0
+			}
+			continue
+		}
+
+		churn := c.churnConfig(epoch).ActivationChurnLimit(c.activeValidators)
+		for activated := uint64(0); activated < churn && len(queue) > 0 && queue[0].eligibility <= finalized; activated++ {
+			res[queue[0].index] = c.activationExitEpoch(epoch)
+			queue = queue[1:]
+		}
+	}
+
+	return res
+}
+
+// countExitEpoch returns the exit epoch for a new exit when exits are limited by count.
+func (c *Calculator) countExitEpoch() phase0.Epoch {
+	exitQueueEpoch := c.activationExitEpoch(c.epoch)
+	for _, validator := range c.validators {
+		if validator != nil && validator.ExitEpoch != farFutureEpoch && validator.ExitEpoch > exitQueueEpoch {
+			exitQueueEpoch = validator.ExitEpoch
+		}
+	}
+
+	exitQueueChurn := uint64(0)
+	for _, validator := range c.validators {
+		if validator != nil && validator.ExitEpoch == exitQueueEpoch {
+			exitQueueChurn++
+		}
+	}
+	if exitQueueChurn >= c.churnConfig(c.epoch).ChurnLimit(c.activeValidators) {
+		exitQueueEpoch++
+	}
+
+	return exitQueueEpoch
+}
+
+// balanceExitEpoch returns the exit epoch for a new exit of the given balance when exits are limited by balance.
+func (c *Calculator) balanceExitEpoch(balance phase0.Gwei) phase0.Epoch {
+	perEpochChurn := c.balanceChurnLimit()
+
+	earliestExitEpoch := c.activationExitEpoch(c.epoch)
+	for _, validator := range c.validators {
+		if validator != nil && validator.ExitEpoch != farFutureEpoch && validator.ExitEpoch > earliestExitEpoch {
+			earliestExitEpoch = validator.ExitEpoch
+		}
+	}
+
+	consumed := phase0.Gwei(0)
+	for _, validator := range c.validators {
+		if validator != nil && validator.ExitEpoch == earliestExitEpoch {
+			consumed += validator.EffectiveBalance
+		}
+	}
+	balanceToConsume := phase0.Gwei(0)
+	if consumed < perEpochChurn {
+		balanceToConsume = perEpochChurn - consumed
+	}
+
+	if balance > balanceToConsume {
+		earliestExitEpoch += phase0.Epoch((balance-balanceToConsume-1)/perEpochChurn + 1)
+	}
+
+	return earliestExitEpoch
+}
@@ -0,0 +1,253 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queues_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/queues"
+	"github.com/stretchr/testify/require"
+)
+
+const farFuture = phase0.Epoch(0xffffffffffffffff)
+
+func testParams() *queues.Parameters {
+	return &queues.Parameters{
+		MinPerEpochChurnLimit:            2,
+		ChurnLimitQuotient:               65536,
+		MaxSeedLookahead:                 4,
+		MinValidatorWithdrawabilityDelay: 256,
+		MaxEffectiveBalance:              32000000000,
+		DenebForkEpoch:                   farFuture,
+		ElectraForkEpoch:                 farFuture,
+	}
+}
+
+// testValidators returns 10 active validators followed by the given number of queued validators.
+func testValidators(queued int) []*phase0.Validator {
+	validators := make([]*phase0.Validator, 0, 10+queued)
+	for i := 0; i < 10; i++ {
+		validators = append(validators, &phase0.Validator{
+			EffectiveBalance:           32000000000,
+			ActivationEligibilityEpoch: 0,
+			ActivationEpoch:            0,
+			ExitEpoch:                  farFuture,
+			WithdrawableEpoch:          farFuture,
+		})
+	}
+	for i := 0; i < queued; i++ {
+		validators = append(validators, &phase0.Validator{
+			EffectiveBalance:           32000000000,
+			ActivationEligibilityEpoch: 90,
+			ActivationEpoch:            farFuture,
+			ExitEpoch:                  farFuture,
+			WithdrawableEpoch:          farFuture,
+		})
+	}
+
+	return validators
+}
+
+func TestParametersFromSpec(t *testing.T) {
+	_, err := queues.ParametersFromSpec(map[string]interface{}{})
+	require.EqualError(t, err, "MIN_PER_EPOCH_CHURN_LIMIT not found in spec")
+
+	_, err = queues.ParametersFromSpec(map[string]interface{}{
+		"MIN_PER_EPOCH_CHURN_LIMIT":           "4",
+		"CHURN_LIMIT_QUOTIENT":                uint64(65536),
+		"MAX_SEED_LOOKAHEAD":                  uint64(4),
+		"MIN_VALIDATOR_WITHDRAWABILITY_DELAY": uint64(256),
+		"MAX_EFFECTIVE_BALANCE":               uint64(32000000000),
+	})
+	require.EqualError(t, err, "MIN_PER_EPOCH_CHURN_LIMIT of unexpected type")
+
+	params, err := queues.ParametersFromSpec(map[string]interface{}{
+		"MIN_PER_EPOCH_CHURN_LIMIT":            uint64(4),
+		"CHURN_LIMIT_QUOTIENT":                 uint64(65536),
+		"MAX_SEED_LOOKAHEAD":                   uint64(4),
+		"MIN_VALIDATOR_WITHDRAWABILITY_DELAY":  uint64(256),
+		"MAX_EFFECTIVE_BALANCE":                uint64(32000000000),
+		"DENEB_FORK_EPOCH":                     uint64(269568),
+		"MAX_PER_EPOCH_ACTIVATION_CHURN_LIMIT": uint64(8),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), params.MinPerEpochChurnLimit)
+	require.Equal(t, phase0.Epoch(269568), params.DenebForkEpoch)
+	require.Equal(t, uint64(8), params.MaxPerEpochActivationChurnLimit)
+	require.Equal(t, farFuture, params.ElectraForkEpoch)
+}
+
+func TestNewCalculator(t *testing.T) {
+	_, err := queues.NewCalculator(nil, nil, 0)
+	require.EqualError(t, err, "no parameters supplied")
+
+	params := testParams()
+	params.MinPerEpochChurnLimit = 0
+	_, err = queues.NewCalculator(params, nil, 0)
+	require.EqualError(t, err, "min per epoch churn limit cannot be 0")
+
+	params = testParams()
+	params.ElectraForkEpoch = 0
+	_, err = queues.NewCalculator(params, nil, 0)
+	require.EqualError(t, err, "min per epoch churn limit for electra cannot be 0")
+}
+
+func TestActivationEpoch(t *testing.T) {
+	validators := testValidators(5)
+	// A validator that is not yet eligible but has sufficient balance.
+	validators = append(validators, &phase0.Validator{
+		EffectiveBalance:           32000000000,
+		ActivationEligibilityEpoch: farFuture,
+		ActivationEpoch:            farFuture,
+		ExitEpoch:                  farFuture,
+		WithdrawableEpoch:          farFuture,
+	})
+	// A validator with insufficient balance to become eligible.
+	validators = append(validators, &phase0.Validator{
+		EffectiveBalance:           16000000000,
+		ActivationEligibilityEpoch: farFuture,
+		ActivationEpoch:            farFuture,
+		ExitEpoch:                  farFuture,
+		WithdrawableEpoch:          farFuture,
+	})
+
+	calculator, err := queues.NewCalculator(testParams(), validators, 100)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), calculator.ActiveValidators())
+
+	// Two activations per epoch, taking effect after the seed lookahead.
+	expected := []phase0.Epoch{105, 105, 106, 106, 107}
+	for i, epoch := range expected {
+		activationEpoch, err := calculator.ActivationEpoch(phase0.ValidatorIndex(10 + i))
+		require.NoError(t, err)
+		require.Equal(t, epoch, activationEpoch, "validator %d", 10+i)
+	}
+
+	// Eligible at 101, so finalized at 103 and activated at 108.
+	activationEpoch, err := calculator.ActivationEpoch(15)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(108), activationEpoch)
+
+	_, err = calculator.ActivationEpoch(16)
+	require.EqualError(t, err, "validator 16 is not eligible for activation")
+
+	activationEpoch, err = calculator.ActivationEpoch(0)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(0), activationEpoch)
+
+	_, err = calculator.ActivationEpoch(100)
+	require.EqualError(t, err, "validator 100 not present")
+
+	require.Len(t, calculator.ActivationEpochs(), 6)
+}
+
+func TestActivationEpochDeneb(t *testing.T) {
+	params := testParams()
+	params.MinPerEpochChurnLimit = 4
+	params.DenebForkEpoch = 0
+	params.MaxPerEpochActivationChurnLimit = 3
+
+	calculator, err := queues.NewCalculator(params, testValidators(4), 100)
+	require.NoError(t, err)
+
+	activationEpoch, err := calculator.ActivationEpoch(12)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(105), activationEpoch)
+	activationEpoch, err = calculator.ActivationEpoch(13)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(106), activationEpoch)
+}
+
+func TestActivationEpochElectra(t *testing.T) {
+	params := testParams()
+	params.ElectraForkEpoch = 0
+	params.EffectiveBalanceIncrement = 1000000000
+	params.MinPerEpochChurnLimitElectra = 64000000000
+	params.MaxPerEpochActivationExitChurnLimit = 256000000000
+
+	calculator, err := queues.NewCalculator(params, testValidators(3), 100)
+	require.NoError(t, err)
+
+	expected := []phase0.Epoch{105, 105, 106}
+	for i, epoch := range expected {
+		activationEpoch, err := calculator.ActivationEpoch(phase0.ValidatorIndex(10 + i))
+		require.NoError(t, err)
+		require.Equal(t, epoch, activationEpoch, "validator %d", 10+i)
+	}
+}
+
+func TestExitEpoch(t *testing.T) {
+	validators := testValidators(1)
+	validators[1].ExitEpoch = 110
+	validators[1].WithdrawableEpoch = 366
+	validators[2].ExitEpoch = 110
+	validators[2].WithdrawableEpoch = 366
+
+	calculator, err := queues.NewCalculator(testParams(), validators, 100)
+	require.NoError(t, err)
+
+	// Exit queue epoch 110 is full, so the next exit goes in to 111.
+	exitEpoch, withdrawableEpoch, err := calculator.ExitEpoch(0)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(111), exitEpoch)
+	require.Equal(t, phase0.Epoch(367), withdrawableEpoch)
+
+	exitEpoch, withdrawableEpoch, err = calculator.ExitEpoch(1)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(110), exitEpoch)
+	require.Equal(t, phase0.Epoch(366), withdrawableEpoch)
+
+	_, _, err = calculator.ExitEpoch(10)
+	require.EqualError(t, err, "validator 10 is not active")
+
+	// With no exit queue the exit takes effect after the seed lookahead.
+	calculator, err = queues.NewCalculator(testParams(), testValidators(0), 100)
+	require.NoError(t, err)
+	exitEpoch, _, err = calculator.ExitEpoch(0)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(105), exitEpoch)
+}
+
+func TestExitEpochElectra(t *testing.T) {
+	params := testParams()
+	params.ElectraForkEpoch = 0
+	params.EffectiveBalanceIncrement = 1000000000
+	params.MinPerEpochChurnLimitElectra = 64000000000
+	params.MaxPerEpochActivationExitChurnLimit = 256000000000
+
+	validators := testValidators(0)
+	validators[1].ExitEpoch = 110
+	validators[1].WithdrawableEpoch = 366
+	validators[2].EffectiveBalance = 16000000000
+
+	calculator, err := queues.NewCalculator(params, validators, 100)
+	require.NoError(t, err)
+
+	// 32 ETH of the 64 ETH churn at epoch 110 remains.
+	exitEpoch, _, err := calculator.ExitEpoch(0)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(110), exitEpoch)
+
+	validators[3].ExitEpoch = 110
+	validators[3].WithdrawableEpoch = 366
+	calculator, err = queues.NewCalculator(params, validators, 100)
+	require.NoError(t, err)
+	exitEpoch, _, err = calculator.ExitEpoch(0)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(111), exitEpoch)
+	exitEpoch, _, err = calculator.ExitEpoch(2)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(111), exitEpoch)
+}
@@ -0,0 +1,57 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package signing provides functions to compute the domains and signing roots of the
+// containers that validators sign, for use with remote signers or offline signing.
+package signing
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ComputeDomain computes the domain for the given domain type, fork version and genesis validators root.
+// This follows compute_domain in the specification.
+func ComputeDomain(domainType phase0.DomainType,
+	forkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Domain,
+	error,
+) {
+	forkDataRoot, err := ComputeForkDataRoot(forkVersion, genesisValidatorsRoot)
+	if err != nil {
+		return phase0.Domain{}, err
+	}
+
+	var domain phase0.Domain
+	copy(domain[:], domainType[:])
+	copy(domain[4:], forkDataRoot[:28])
+
+	return domain, nil
+}
+
+// ComputeForkDataRoot computes the fork data root for the given fork version and genesis validators root.
+// This follows compute_fork_data_root in the specification.
+func ComputeForkDataRoot(forkVersion phase0.Version, genesisValidatorsRoot phase0.Root) (phase0.Root, error) {
+	forkData := &phase0.ForkData{
+		CurrentVersion:        forkVersion,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}
+	root, err := forkData.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to calculate fork data root")
+	}
+
+	return root, nil
+}
@@ -0,0 +1,133 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// DomainProvider provides domains for the domain types, selecting the fork version and genesis
+// validators root required by the specification for each domain type:
+//   - deposits and builder API messages use the genesis fork version and an empty genesis validators root
+//   - BLS to execution changes use the genesis fork version
+//   - voluntary exits from Deneb onwards use the Capella fork version
+//   - all other domain types use the fork version in effect at the epoch
+//
+// It implements consensusclient.DomainProvider.
+type DomainProvider struct {
+	genesisForkVersion    phase0.Version
+	genesisValidatorsRoot phase0.Root
+	forkSchedule          []*phase0.Fork
+	// denebForkEpoch and capellaForkVersion are only set if the chain has a Deneb fork.
+	denebForkEpoch     *phase0.Epoch
+	capellaForkVersion phase0.Version
+}
+
+// NewDomainProvider creates a domain provider with the chain information from the client.
+// The client must provide genesis, fork schedule and spec information.
+func NewDomainProvider(ctx context.Context, client consensusclient.Service) (*DomainProvider, error) {
+	genesisProvider, isProvider := client.(consensusclient.GenesisProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide genesis")
+	}
+	forkScheduleProvider, isProvider := client.(consensusclient.ForkScheduleProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide fork schedule")
+	}
+	specProvider, isProvider := client.(consensusclient.SpecProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide spec")
+	}
+
+	genesis, err := genesisProvider.Genesis(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain genesis")
+	}
+	forkSchedule, err := forkScheduleProvider.ForkSchedule(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain fork schedule")
+	}
+	if len(forkSchedule) == 0 {
+		return nil, errors.New("no fork schedule returned")
+	}
+	specValues, err := specProvider.Spec(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain spec")
+	}
+
+	provider := &DomainProvider{
+		genesisForkVersion:    genesis.GenesisForkVersion,
+		genesisValidatorsRoot: genesis.GenesisValidatorsRoot,
+		forkSchedule:          forkSchedule,
+	}
+	if tmp, exists := specValues["DENEB_FORK_EPOCH"]; exists {
+		if denebForkEpoch, isEpoch := tmp.(uint64); isEpoch {
+			capellaForkVersion, isVersion := specValues["CAPELLA_FORK_VERSION"].(phase0.Version)
+			if !isVersion {
+				return nil, errors.New("spec does not provide Capella fork version")
+			}
+			epoch := phase0.Epoch(denebForkEpoch)
+			provider.denebForkEpoch = &epoch
+			provider.capellaForkVersion = capellaForkVersion
+		}
+	}
+
+	return provider, nil
+}
+
+// Domain provides a domain for a given domain type at a given epoch.
+func (p *DomainProvider) Domain(_ context.Context, domainType phase0.DomainType, epoch phase0.Epoch) (phase0.Domain, error) {
+	switch domainType {
+	case phase0.DomainDeposit, phase0.DomainApplicationBuilder:
+		return ComputeDomain(domainType, p.genesisForkVersion, phase0.Root{})
+	case phase0.DomainBLSToExecutionChange:
+		return ComputeDomain(domainType, p.genesisForkVersion, p.genesisValidatorsRoot)
+	case phase0.DomainVoluntaryExit:
+		if p.denebForkEpoch != nil && epoch >= *p.denebForkEpoch {
+			return ComputeDomain(domainType, p.capellaForkVersion, p.genesisValidatorsRoot)
+		}
+	}
+
+	return ComputeDomain(domainType, p.forkVersionAtEpoch(epoch), p.genesisValidatorsRoot)
+}
+
+// GenesisDomain returns the domain for the given domain type at genesis.
+// N.B. this is not always the same as the the domain at epoch 0.  It is possible
+// for a chain's fork schedule to have multiple forks at genesis.  In this situation,
+// GenesisDomain() will return the first, and Domain() will return the last.
+func (p *DomainProvider) GenesisDomain(ctx context.Context, domainType phase0.DomainType) (phase0.Domain, error) {
+	switch domainType {
+	case phase0.DomainDeposit, phase0.DomainApplicationBuilder, phase0.DomainBLSToExecutionChange:
+		return p.Domain(ctx, domainType, 0)
+	default:
+		return ComputeDomain(domainType, p.forkSchedule[0].CurrentVersion, p.genesisValidatorsRoot)
+	}
+}
+
+// forkVersionAtEpoch returns the fork version in effect at the given epoch.
+func (p *DomainProvider) forkVersionAtEpoch(epoch phase0.Epoch) phase0.Version {
+	forkVersion := p.forkSchedule[0].CurrentVersion
+	for _, fork := range p.forkSchedule {
+		if fork.Epoch > epoch {
+			break
+		}
+		forkVersion = fork.CurrentVersion
+	}
+
+	return forkVersion
+}
@@ -0,0 +1,318 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SignedProposal is a signed proposal ready for submission to a beacon node.
+// If Blinded is true then BlindedBlock is set, otherwise Block is set.
+type SignedProposal struct {
+	Blinded      bool
+	Block        *spec.VersionedSignedBeaconBlock
+	BlindedBlock *api.VersionedSignedBlindedBeaconBlock
+}
+
+// SetProposalRANDAOReveal sets the RANDAO reveal in the block of the proposal.
+func SetProposalRANDAOReveal(proposal *api.VersionedProposal, randaoReveal phase0.BLSSignature) error {
+	return setProposalBodyFields(proposal, &randaoReveal, nil)
+}
+
+// SetProposalGraffiti sets the graffiti in the block of the proposal.
+func SetProposalGraffiti(proposal *api.VersionedProposal, graffiti [32]byte) error {
+	return setProposalBodyFields(proposal, nil, &graffiti)
+}
+
+// ProposalSigningRoot computes the signing root for the block of a proposal of any fork,
+// whether full or blinded.
+// Note that the RANDAO reveal and graffiti must be set before the signing root is computed,
+// as they form part of the block.
+func ProposalSigningRoot(proposal *api.VersionedProposal,
+	forkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Root,
+	error,
+) {
+	if proposal == nil {
+		return phase0.Root{}, errors.New("no proposal supplied")
+	}
+
+	if proposal.Blinded {
+		return BlindedBlockSigningRoot(blindedBeaconBlockFromProposal(proposal), forkVersion, genesisValidatorsRoot)
+	}
+
+	return BlockSigningRoot(beaconBlockFromProposal(proposal), forkVersion, genesisValidatorsRoot)
+}
+
+// AssembleSignedProposal combines the block of a proposal with its signature to create the
+// signed block or signed blinded block for submission.
+// Deneb blob sidecars are not included, as the submission types carry only the signed block.
+func AssembleSignedProposal(proposal *api.VersionedProposal, signature phase0.BLSSignature) (*SignedProposal, error) {
+	if proposal == nil {
+		return nil, errors.New("no proposal supplied")
+	}
+
+	if proposal.Blinded {
+		block, err := signedBlindedBeaconBlock(proposal, signature)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SignedProposal{
+			Blinded:      true,
+			BlindedBlock: block,
+		}, nil
+	}
+
+	block, err := signedBeaconBlock(proposal, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignedProposal{
+		Block: block,
+	}, nil
+}
+
+// Submit submits the signed proposal to the client, using the full or blinded block
+// submission as appropriate.
+func (s *SignedProposal) Submit(ctx context.Context, client consensusclient.Service) error {
+	if s.Blinded {
+		submitter, isSubmitter := client.(consensusclient.BlindedBeaconBlockSubmitter)
+		if !isSubmitter {
+			return errors.New("client does not submit blinded beacon blocks")
+		}
+		if err := submitter.SubmitBlindedBeaconBlock(ctx, s.BlindedBlock); err != nil {
+			return errors.Wrap(err, "failed to submit blinded beacon block")
+		}
+
+		return nil
+	}
+
+	submitter, isSubmitter := client.(consensusclient.BeaconBlockSubmitter)
+	if !isSubmitter {
+		return errors.New("client does not submit beacon blocks")
+	}
+	if err := submitter.SubmitBeaconBlock(ctx, s.Block); err != nil {
+		return errors.Wrap(err, "failed to submit beacon block")
+	}
+
+	return nil
+}
+
+// setProposalBodyFields sets the RANDAO reveal and graffiti of the proposal's block body,
+// leaving those that are nil unchanged.
+//
+//nolint:gocyclo
+func setProposalBodyFields(proposal *api.VersionedProposal,
+	randaoReveal *phase0.BLSSignature,
+	graffiti *[32]byte,
+) error {
+	if proposal == nil {
+		return errors.New("no proposal supplied")
+	}
+
+	var bodyRANDAOReveal *phase0.BLSSignature
+	var bodyGraffiti *[32]byte
+	switch {
+	case proposal.Version == spec.DataVersionPhase0 && !proposal.Blinded:
+		if proposal.Phase0 == nil || proposal.Phase0.Body == nil {
+			return errors.New("no phase0 block")
+		}
+		bodyRANDAOReveal, bodyGraffiti = &proposal.Phase0.Body.RANDAOReveal, &proposal.Phase0.Body.Graffiti
+	case proposal.Version == spec.DataVersionAltair && !proposal.Blinded:
+		if proposal.Altair == nil || proposal.Altair.Body == nil {
+			return errors.New("no altair block")
+		}
+		bodyRANDAOReveal, bodyGraffiti = &proposal.Altair.Body.RANDAOReveal, &proposal.Altair.Body.Graffiti
+	case proposal.Version == spec.DataVersionBellatrix && !proposal.Blinded:
+		if proposal.Bellatrix == nil || proposal.Bellatrix.Body == nil {
+			return errors.New("no bellatrix block")
+		}
+		bodyRANDAOReveal, bodyGraffiti = &proposal.Bellatrix.Body.RANDAOReveal, &proposal.Bellatrix.Body.Graffiti
+	case proposal.Version == spec.DataVersionBellatrix && proposal.Blinded:
+		if proposal.BellatrixBlinded == nil || proposal.BellatrixBlinded.Body == nil {
+			return errors.New("no bellatrix blinded block")
+		}
+		bodyRANDAOReveal, bodyGraffiti = &proposal.BellatrixBlinded.Body.RANDAOReveal, &proposal.BellatrixBlinded.Body.Graffiti
+	case proposal.Version == spec.DataVersionCapella && !proposal.Blinded:
+		if proposal.Capella == nil || proposal.Capella.Body == nil {
+			return errors.New("no capella block")
+		}
+		bodyRANDAOReveal, bodyGraffiti = &proposal.Capella.Body.RANDAOReveal, &proposal.Capella.Body.Graffiti
+	case proposal.Version == spec.DataVersionCapella && proposal.Blinded:
+		if proposal.CapellaBlinded == nil || proposal.CapellaBlinded.Body == nil {
+			return errors.New("no capella blinded block")
+		}
+		bodyRANDAOReveal, bodyGraffiti = &proposal.CapellaBlinded.Body.RANDAOReveal, &proposal.CapellaBlinded.Body.Graffiti
+	case proposal.Version == spec.DataVersionDeneb && !proposal.Blinded:
+		if proposal.Deneb == nil || proposal.Deneb.Block == nil || proposal.Deneb.Block.Body == nil {
+			return errors.New("no deneb block")
+		}
+		bodyRANDAOReveal, bodyGraffiti = &proposal.Deneb.Block.Body.RANDAOReveal, &proposal.Deneb.Block.Body.Graffiti
+	case proposal.Version == spec.DataVersionDeneb && proposal.Blinded:
+		if proposal.DenebBlinded == nil || proposal.DenebBlinded.BlindedBlock == nil || proposal.DenebBlinded.BlindedBlock.Body == nil {
+			return errors.New("no deneb blinded block")
+		}
+		bodyRANDAOReveal, bodyGraffiti = &proposal.DenebBlinded.BlindedBlock.Body.RANDAOReveal, &proposal.DenebBlinded.BlindedBlock.Body.Graffiti
+	default:
+		return errors.New("unsupported version")
+	}
+
+	if randaoReveal != nil {
+		*bodyRANDAOReveal = *randaoReveal
+	}
+	if graffiti != nil {
+		*bodyGraffiti = *graffiti
+	}
+
+	return nil
+}
+
+// signedBeaconBlock creates a signed beacon block from a full proposal.
+func signedBeaconBlock(proposal *api.VersionedProposal, signature phase0.BLSSignature) (*spec.VersionedSignedBeaconBlock, error) {
+	res := &spec.VersionedSignedBeaconBlock{
+		Version: proposal.Version,
+	}
+
+	switch proposal.Version {
+	case spec.DataVersionPhase0:
+		if proposal.Phase0 == nil {
+			return nil, errors.New("no phase0 block")
+		}
+		res.Phase0 = &phase0.SignedBeaconBlock{
+			Message:   proposal.Phase0,
+			Signature: signature,
+		}
+	case spec.DataVersionAltair:
+		if proposal.Altair == nil {
+			return nil, errors.New("no altair block")
+		}
+		res.Altair = &altair.SignedBeaconBlock{
+			Message:   proposal.Altair,
+			Signature: signature,
+		}
+	case spec.DataVersionBellatrix:
+		if proposal.Bellatrix == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+		res.Bellatrix = &bellatrix.SignedBeaconBlock{
+			Message:   proposal.Bellatrix,
+			Signature: signature,
+		}
+	case spec.DataVersionCapella:
+		if proposal.Capella == nil {
+			return nil, errors.New("no capella block")
+		}
+		res.Capella = &capella.SignedBeaconBlock{
+			Message:   proposal.Capella,
+			Signature: signature,
+		}
+	case spec.DataVersionDeneb:
+		if proposal.Deneb == nil || proposal.Deneb.Block == nil {
+			return nil, errors.New("no deneb block")
+		}
+		res.Deneb = &deneb.SignedBeaconBlock{
+			Message:   proposal.Deneb.Block,
+			Signature: signature,
+		}
+	default:
+		return nil, errors.New("unsupported version")
+	}
+
+	return res, nil
+}
+
+// signedBlindedBeaconBlock creates a signed blinded beacon block from a blinded proposal.
+func signedBlindedBeaconBlock(proposal *api.VersionedProposal, signature phase0.BLSSignature) (*api.VersionedSignedBlindedBeaconBlock, error) {
+	res := &api.VersionedSignedBlindedBeaconBlock{
+		Version: proposal.Version,
+	}
+
+	switch proposal.Version {
+	case spec.DataVersionBellatrix:
+		if proposal.BellatrixBlinded == nil {
+			return nil, errors.New("no bellatrix blinded block")
+		}
+		res.Bellatrix = &apiv1bellatrix.SignedBlindedBeaconBlock{
+			Message:   proposal.BellatrixBlinded,
+			Signature: signature,
+		}
+	case spec.DataVersionCapella:
+		if proposal.CapellaBlinded == nil {
+			return nil, errors.New("no capella blinded block")
+		}
+		res.Capella = &apiv1capella.SignedBlindedBeaconBlock{
+			Message:   proposal.CapellaBlinded,
+			Signature: signature,
+		}
+	case spec.DataVersionDeneb:
+		if proposal.DenebBlinded == nil || proposal.DenebBlinded.BlindedBlock == nil {
+			return nil, errors.New("no deneb blinded block")
+		}
+		res.Deneb = &apiv1deneb.SignedBlindedBeaconBlock{
+			Message:   proposal.DenebBlinded.BlindedBlock,
+			Signature: signature,
+		}
+	default:
+		return nil, errors.New("unsupported version")
+	}
+
+	return res, nil
+}
+
+// beaconBlockFromProposal returns the beacon block contained in a full proposal.
+func beaconBlockFromProposal(proposal *api.VersionedProposal) *spec.VersionedBeaconBlock {
+	res := &spec.VersionedBeaconBlock{
+		Version:   proposal.Version,
+		Phase0:    proposal.Phase0,
+		Altair:    proposal.Altair,
+		Bellatrix: proposal.Bellatrix,
+		Capella:   proposal.Capella,
+	}
+	if proposal.Deneb != nil {
+		res.Deneb = proposal.Deneb.Block
+	}
+
+	return res
+}
+
+// blindedBeaconBlockFromProposal returns the blinded beacon block contained in a blinded proposal.
+func blindedBeaconBlockFromProposal(proposal *api.VersionedProposal) *api.VersionedBlindedBeaconBlock {
+	res := &api.VersionedBlindedBeaconBlock{
+		Version:   proposal.Version,
+		Bellatrix: proposal.BellatrixBlinded,
+		Capella:   proposal.CapellaBlinded,
+	}
+	if proposal.DenebBlinded != nil {
+		res.Deneb = proposal.DenebBlinded.BlindedBlock
+	}
+
+	return res
+}
@@ -0,0 +1,367 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing_test
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/signing"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	mainnetGenesisForkVersion    = phase0.Version{0x00, 0x00, 0x00, 0x00}
+	mainnetGenesisValidatorsRoot = phase0.Root(mustDecode("4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"))
+)
+
+func mustDecode(input string) []byte {
+	res, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		panic(err)
+	}
+
+	return res
+}
+
+func TestComputeDomain(t *testing.T) {
+	tests := []struct {
+		name                  string
+		domainType            phase0.DomainType
+		forkVersion           phase0.Version
+		genesisValidatorsRoot phase0.Root
+		expected              phase0.Domain
+	}{
+		{
+			name:        "Deposit",
+			domainType:  phase0.DomainType{0x03, 0x00, 0x00, 0x00},
+			forkVersion: mainnetGenesisForkVersion,
+			expected:    phase0.Domain(mustDecode("03000000f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a9")),
+		},
+		{
+			name:        "ApplicationBuilder",
+			domainType:  phase0.DomainType{0x00, 0x00, 0x00, 0x01},
+			forkVersion: mainnetGenesisForkVersion,
+			expected:    phase0.Domain(mustDecode("00000001f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a9")),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			domain, err := signing.ComputeDomain(test.domainType, test.forkVersion, test.genesisValidatorsRoot)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, domain)
+		})
+	}
+}
+
+func TestAttestationSigningRoot(t *testing.T) {
+	data := &phase0.AttestationData{
+		Slot:            12345,
+		Index:           3,
+		BeaconBlockRoot: phase0.Root{0x01},
+		Source:          &phase0.Checkpoint{Epoch: 384, Root: phase0.Root{0x02}},
+		Target:          &phase0.Checkpoint{Epoch: 385, Root: phase0.Root{0x03}},
+	}
+	forkVersion := phase0.Version{0x03, 0x00, 0x00, 0x00}
+
+	root, err := signing.AttestationSigningRoot(data, forkVersion, mainnetGenesisValidatorsRoot)
+	require.NoError(t, err)
+
+	domain, err := signing.ComputeDomain(phase0.DomainType{0x01, 0x00, 0x00, 0x00}, forkVersion, mainnetGenesisValidatorsRoot)
+	require.NoError(t, err)
+	expected, err := signing.ComputeSigningRoot(data, domain)
+	require.NoError(t, err)
+	require.Equal(t, expected, root)
+
+	// Signing roots are specific to their fork.
+	otherRoot, err := signing.AttestationSigningRoot(data, phase0.Version{0x02, 0x00, 0x00, 0x00}, mainnetGenesisValidatorsRoot)
+	require.NoError(t, err)
+	require.NotEqual(t, root, otherRoot)
+
+	_, err = signing.AttestationSigningRoot(nil, forkVersion, mainnetGenesisValidatorsRoot)
+	require.EqualError(t, err, "no attestation data supplied")
+}
+
+func TestBlockSigningRoot(t *testing.T) {
+	block := &phase0.BeaconBlock{
+		Slot:          12345,
+		ProposerIndex: 6789,
+		ParentRoot:    phase0.Root{0x01},
+		StateRoot:     phase0.Root{0x02},
+		Body: &phase0.BeaconBlockBody{
+			ETH1Data: &phase0.ETH1Data{
+				BlockHash: make([]byte, 32),
+			},
+		},
+	}
+	bodyRoot, err := block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	header := &phase0.BeaconBlockHeader{
+		Slot:          block.Slot,
+		ProposerIndex: block.ProposerIndex,
+		ParentRoot:    block.ParentRoot,
+		StateRoot:     block.StateRoot,
+		BodyRoot:      bodyRoot,
+	}
+
+	root, err := signing.BlockSigningRoot(&spec.VersionedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0:  block,
+	}, mainnetGenesisForkVersion, mainnetGenesisValidatorsRoot)
+	require.NoError(t, err)
+
+	// The signing root of a block is the same as that of its header.
+	headerRoot, err := signing.BlockHeaderSigningRoot(header, mainnetGenesisForkVersion, mainnetGenesisValidatorsRoot)
+	require.NoError(t, err)
+	require.Equal(t, headerRoot, root)
+
+	_, err = signing.BlockSigningRoot(nil, mainnetGenesisForkVersion, mainnetGenesisValidatorsRoot)
+	require.EqualError(t, err, "no block supplied")
+	_, err = signing.BlockSigningRoot(&spec.VersionedBeaconBlock{
+		Version: spec.DataVersionAltair,
+	}, mainnetGenesisForkVersion, mainnetGenesisValidatorsRoot)
+	require.EqualError(t, err, "failed to calculate block root: no altair block")
+	_, err = signing.BlindedBlockSigningRoot(&api.VersionedBlindedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+	}, mainnetGenesisForkVersion, mainnetGenesisValidatorsRoot)
+	require.ErrorContains(t, err, "failed to calculate block root")
+}
+
+func TestRANDAORevealSigningRoot(t *testing.T) {
+	root, err := signing.RANDAORevealSigningRoot(12345, mainnetGenesisForkVersion, mainnetGenesisValidatorsRoot)
+	require.NoError(t, err)
+
+	otherRoot, err := signing.RANDAORevealSigningRoot(12346, mainnetGenesisForkVersion, mainnetGenesisValidatorsRoot)
+	require.NoError(t, err)
+	require.NotEqual(t, root, otherRoot)
+
+	// Slots and epochs with the same value sign to different roots due to their domains.
+	selectionRoot, err := signing.SelectionProofSigningRoot(12345, mainnetGenesisForkVersion, mainnetGenesisValidatorsRoot)
+	require.NoError(t, err)
+	require.NotEqual(t, root, selectionRoot)
+}
+
+func TestBuilderRegistrationSigningRoot(t *testing.T) {
+	registration := &apiv1.ValidatorRegistration{
+		GasLimit: 30000000,
+		Pubkey:   phase0.BLSPubKey{0x01},
+	}
+
+	root, err := signing.BuilderRegistrationSigningRoot(&api.VersionedValidatorRegistration{
+		Version: spec.BuilderVersionV1,
+		V1:      registration,
+	}, mainnetGenesisForkVersion)
+	require.NoError(t, err)
+
+	v1Root, err := signing.V1BuilderRegistrationSigningRoot(registration, mainnetGenesisForkVersion)
+	require.NoError(t, err)
+	require.Equal(t, v1Root, root)
+
+	_, err = signing.BuilderRegistrationSigningRoot(nil, mainnetGenesisForkVersion)
+	require.EqualError(t, err, "no registration supplied")
+}
+
+func TestExitSigningRoot(t *testing.T) {
+	_, err := signing.ExitSigningRoot(nil, mainnetGenesisForkVersion, mainnetGenesisValidatorsRoot)
+	require.EqualError(t, err, "no voluntary exit supplied")
+
+	_, err = signing.ExitSigningRoot(&phase0.VoluntaryExit{Epoch: 1, ValidatorIndex: 2}, mainnetGenesisForkVersion, mainnetGenesisValidatorsRoot)
+	require.NoError(t, err)
+}
+
+// denebClient is a mock client with a Deneb fork in its spec.
+type denebClient struct {
+	*mock.Service
+}
+
+func (c *denebClient) Spec(_ context.Context) (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"CAPELLA_FORK_VERSION": phase0.Version{0x21, 0x22, 0x23, 0x24},
+		"DENEB_FORK_EPOCH":     uint64(2048),
+	}, nil
+}
+
+func TestDomainProvider(t *testing.T) {
+	ctx := context.Background()
+
+	mockClient, err := mock.New(ctx, mock.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	client := &denebClient{Service: mockClient}
+	genesis, err := client.Genesis(ctx)
+	require.NoError(t, err)
+	genesisForkVersion := phase0.Version{0x01, 0x02, 0x03, 0x04}
+	laterForkVersion := phase0.Version{0x11, 0x12, 0x13, 0x14}
+	capellaForkVersion := phase0.Version{0x21, 0x22, 0x23, 0x24}
+
+	provider, err := signing.NewDomainProvider(ctx, client)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                  string
+		domainType            phase0.DomainType
+		epoch                 phase0.Epoch
+		forkVersion           phase0.Version
+		genesisValidatorsRoot phase0.Root
+	}{
+		{
+			name:                  "AttesterGenesisFork",
+			domainType:            phase0.DomainBeaconAttester,
+			epoch:                 1023,
+			forkVersion:           genesisForkVersion,
+			genesisValidatorsRoot: genesis.GenesisValidatorsRoot,
+		},
+		{
+			name:                  "AttesterLaterFork",
+			domainType:            phase0.DomainBeaconAttester,
+			epoch:                 1024,
+			forkVersion:           laterForkVersion,
+			genesisValidatorsRoot: genesis.GenesisValidatorsRoot,
+		},
+		{
+			name:        "Deposit",
+			domainType:  phase0.DomainDeposit,
+			epoch:       3000,
+			forkVersion: genesisForkVersion,
+		},
+		{
+			name:        "ApplicationBuilder",
+			domainType:  phase0.DomainApplicationBuilder,
+			epoch:       3000,
+			forkVersion: genesisForkVersion,
+		},
+		{
+			name:                  "BLSToExecutionChange",
+			domainType:            phase0.DomainBLSToExecutionChange,
+			epoch:                 3000,
+			forkVersion:           genesisForkVersion,
+			genesisValidatorsRoot: genesis.GenesisValidatorsRoot,
+		},
+		{
+			name:                  "VoluntaryExitPreDeneb",
+			domainType:            phase0.DomainVoluntaryExit,
+			epoch:                 2047,
+			forkVersion:           laterForkVersion,
+			genesisValidatorsRoot: genesis.GenesisValidatorsRoot,
+		},
+		{
+			name:                  "VoluntaryExitDeneb",
+			domainType:            phase0.DomainVoluntaryExit,
+			epoch:                 2048,
+			forkVersion:           capellaForkVersion,
+			genesisValidatorsRoot: genesis.GenesisValidatorsRoot,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			domain, err := provider.Domain(ctx, test.domainType, test.epoch)
+			require.NoError(t, err)
+			expected, err := signing.ComputeDomain(test.domainType, test.forkVersion, test.genesisValidatorsRoot)
+			require.NoError(t, err)
+			require.Equal(t, expected, domain)
+		})
+	}
+
+	domain, err := provider.GenesisDomain(ctx, phase0.DomainBeaconProposer)
+	require.NoError(t, err)
+	expected, err := signing.ComputeDomain(phase0.DomainBeaconProposer, genesisForkVersion, genesis.GenesisValidatorsRoot)
+	require.NoError(t, err)
+	require.Equal(t, expected, domain)
+}
+
+func TestProposal(t *testing.T) {
+	ctx := context.Background()
+
+	block := &phase0.BeaconBlock{
+		Slot:          12345,
+		ProposerIndex: 6789,
+		Body: &phase0.BeaconBlockBody{
+			ETH1Data: &phase0.ETH1Data{
+				BlockHash: make([]byte, 32),
+			},
+		},
+	}
+	proposal := &api.VersionedProposal{
+		Version: spec.DataVersionPhase0,
+		Phase0:  block,
+	}
+	randaoReveal := phase0.BLSSignature{0x01, 0x02}
+	graffiti := [32]byte{0x03, 0x04}
+	require.NoError(t, signing.SetProposalRANDAOReveal(proposal, randaoReveal))
+	require.NoError(t, signing.SetProposalGraffiti(proposal, graffiti))
+	require.Equal(t, randaoReveal, block.Body.RANDAOReveal)
+	require.Equal(t, graffiti, block.Body.Graffiti)
+
+	root, err := signing.ProposalSigningRoot(proposal, mainnetGenesisForkVersion, mainnetGenesisValidatorsRoot)
+	require.NoError(t, err)
+	blockRoot, err := signing.BlockSigningRoot(&spec.VersionedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0:  block,
+	}, mainnetGenesisForkVersion, mainnetGenesisValidatorsRoot)
+	require.NoError(t, err)
+	require.Equal(t, blockRoot, root)
+
+	signature := phase0.BLSSignature{0x05}
+	signed, err := signing.AssembleSignedProposal(proposal, signature)
+	require.NoError(t, err)
+	require.False(t, signed.Blinded)
+	require.Equal(t, block, signed.Block.Phase0.Message)
+	require.Equal(t, signature, signed.Block.Phase0.Signature)
+
+	client, err := mock.New(ctx, mock.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	require.NoError(t, signed.Submit(ctx, client))
+
+	blindedProposal := &api.VersionedProposal{
+		Version: spec.DataVersionDeneb,
+		Blinded: true,
+		DenebBlinded: &apiv1deneb.BlindedBlockContents{
+			BlindedBlock: &apiv1deneb.BlindedBeaconBlock{
+				Body: &apiv1deneb.BlindedBeaconBlockBody{},
+			},
+		},
+	}
+	require.NoError(t, signing.SetProposalRANDAOReveal(blindedProposal, randaoReveal))
+	require.NoError(t, signing.SetProposalGraffiti(blindedProposal, graffiti))
+	require.Equal(t, randaoReveal, blindedProposal.DenebBlinded.BlindedBlock.Body.RANDAOReveal)
+	require.Equal(t, graffiti, blindedProposal.DenebBlinded.BlindedBlock.Body.Graffiti)
+	signed, err = signing.AssembleSignedProposal(blindedProposal, signature)
+	require.NoError(t, err)
+	require.True(t, signed.Blinded)
+	require.Equal(t, blindedProposal.DenebBlinded.BlindedBlock, signed.BlindedBlock.Deneb.Message)
+	require.Equal(t, signature, signed.BlindedBlock.Deneb.Signature)
+	require.NoError(t, signed.Submit(ctx, client))
+
+	require.EqualError(t, signing.SetProposalGraffiti(nil, graffiti), "no proposal supplied")
+	require.EqualError(t, signing.SetProposalRANDAOReveal(&api.VersionedProposal{
+		Version: spec.DataVersionCapella,
+	}, randaoReveal), "no capella block")
+	_, err = signing.AssembleSignedProposal(&api.VersionedProposal{
+		Version: spec.DataVersionPhase0,
+		Blinded: true,
+	}, signature)
+	require.EqualError(t, err, "unsupported version")
+	_, err = signing.ProposalSigningRoot(nil, mainnetGenesisForkVersion, mainnetGenesisValidatorsRoot)
+	require.EqualError(t, err, "no proposal supplied")
+}
@@ -0,0 +1,311 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"encoding/binary"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+)
+
+// ComputeSigningRoot computes the root that is signed for the given object and domain.
+// This follows compute_signing_root in the specification.
+func ComputeSigningRoot(object ssz.HashRoot, domain phase0.Domain) (phase0.Root, error) {
+	if object == nil {
+		return phase0.Root{}, errors.New("no object supplied")
+	}
+
+	objectRoot, err := object.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to calculate object root")
+	}
+
+	return signingRoot(objectRoot, domain)
+}
+
+// AttestationSigningRoot computes the signing root for attestation data.
+func AttestationSigningRoot(data *phase0.AttestationData,
+	forkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Root,
+	error,
+) {
+	if data == nil {
+		return phase0.Root{}, errors.New("no attestation data supplied")
+	}
+
+	return objectSigningRoot(data, phase0.DomainBeaconAttester, forkVersion, genesisValidatorsRoot)
+}
+
+// BlockSigningRoot computes the signing root for a beacon block of any fork.
+func BlockSigningRoot(block *spec.VersionedBeaconBlock,
+	forkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Root,
+	error,
+) {
+	if block == nil {
+		return phase0.Root{}, errors.New("no block supplied")
+	}
+
+	objectRoot, err := block.Root()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to calculate block root")
+	}
+
+	return rootSigningRoot(objectRoot, phase0.DomainBeaconProposer, forkVersion, genesisValidatorsRoot)
+}
+
+// BlindedBlockSigningRoot computes the signing root for a blinded beacon block of any fork.
+// The signing root of a blinded block is the same as that of the equivalent full block.
+func BlindedBlockSigningRoot(block *api.VersionedBlindedBeaconBlock,
+	forkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Root,
+	error,
+) {
+	if block == nil {
+		return phase0.Root{}, errors.New("no block supplied")
+	}
+
+	objectRoot, err := block.Root()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to calculate block root")
+	}
+
+	return rootSigningRoot(objectRoot, phase0.DomainBeaconProposer, forkVersion, genesisValidatorsRoot)
+}
+
+// BlockHeaderSigningRoot computes the signing root for a beacon block header.
+// This is the same as the signing root of the block from which the header was generated.
+func BlockHeaderSigningRoot(header *phase0.BeaconBlockHeader,
+	forkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Root,
+	error,
+) {
+	if header == nil {
+		return phase0.Root{}, errors.New("no block header supplied")
+	}
+
+	return objectSigningRoot(header, phase0.DomainBeaconProposer, forkVersion, genesisValidatorsRoot)
+}
+
+// RANDAORevealSigningRoot computes the signing root for the RANDAO reveal of the given epoch.
+func RANDAORevealSigningRoot(epoch phase0.Epoch,
+	forkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Root,
+	error,
+) {
+	return rootSigningRoot(uint64Root(uint64(epoch)), phase0.DomainRandao, forkVersion, genesisValidatorsRoot)
+}
+
+// ExitSigningRoot computes the signing root for a voluntary exit.
+// From Deneb onwards voluntary exits are signed with the Capella fork version, so callers should
+// supply that fork version for exits on chains that have passed the Deneb fork.
+func ExitSigningRoot(exit *phase0.VoluntaryExit,
+	forkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Root,
+	error,
+) {
+	if exit == nil {
+		return phase0.Root{}, errors.New("no voluntary exit supplied")
+	}
+
+	return objectSigningRoot(exit, phase0.DomainVoluntaryExit, forkVersion, genesisValidatorsRoot)
+}
+
+// SelectionProofSigningRoot computes the signing root for the aggregation selection proof of the given slot.
+func SelectionProofSigningRoot(slot phase0.Slot,
+	forkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Root,
+	error,
+) {
+	return rootSigningRoot(uint64Root(uint64(slot)), phase0.DomainSelectionProof, forkVersion, genesisValidatorsRoot)
+}
+
+// AggregateAndProofSigningRoot computes the signing root for an aggregate and proof.
+func AggregateAndProofSigningRoot(aggregateAndProof *phase0.AggregateAndProof,
+	forkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Root,
+	error,
+) {
+	if aggregateAndProof == nil {
+		return phase0.Root{}, errors.New("no aggregate and proof supplied")
+	}
+
+	return objectSigningRoot(aggregateAndProof, phase0.DomainAggregateAndProof, forkVersion, genesisValidatorsRoot)
+}
+
+// SyncCommitteeMessageSigningRoot computes the signing root for a sync committee message, which
+// signs the given beacon block root.
+func SyncCommitteeMessageSigningRoot(beaconBlockRoot phase0.Root,
+	forkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Root,
+	error,
+) {
+	return rootSigningRoot(beaconBlockRoot, phase0.DomainSyncCommittee, forkVersion, genesisValidatorsRoot)
+}
+
+// SyncCommitteeSelectionProofSigningRoot computes the signing root for a sync committee aggregator selection proof.
+func SyncCommitteeSelectionProofSigningRoot(data *altair.SyncAggregatorSelectionData,
+	forkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Root,
+	error,
+) {
+	if data == nil {
+		return phase0.Root{}, errors.New("no sync aggregator selection data supplied")
+	}
+
+	return objectSigningRoot(data, phase0.DomainSyncCommitteeSelectionProof, forkVersion, genesisValidatorsRoot)
+}
+
+// ContributionAndProofSigningRoot computes the signing root for a sync committee contribution and proof.
+func ContributionAndProofSigningRoot(contributionAndProof *altair.ContributionAndProof,
+	forkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Root,
+	error,
+) {
+	if contributionAndProof == nil {
+		return phase0.Root{}, errors.New("no contribution and proof supplied")
+	}
+
+	return objectSigningRoot(contributionAndProof, phase0.DomainContributionAndProof, forkVersion, genesisValidatorsRoot)
+}
+
+// DepositSigningRoot computes the signing root for a deposit message.
+// Deposits are signed with the genesis fork version and an empty genesis validators root, so that
+// they can be created before the chain starts.
+func DepositSigningRoot(message *phase0.DepositMessage, genesisForkVersion phase0.Version) (phase0.Root, error) {
+	if message == nil {
+		return phase0.Root{}, errors.New("no deposit message supplied")
+	}
+
+	return objectSigningRoot(message, phase0.DomainDeposit, genesisForkVersion, phase0.Root{})
+}
+
+// BuilderRegistrationSigningRoot computes the signing root for a validator registration with builders.
+// Registrations are signed with the genesis fork version and an empty genesis validators root, as
+// defined by the builder specification.
+func BuilderRegistrationSigningRoot(registration *api.VersionedValidatorRegistration,
+	genesisForkVersion phase0.Version,
+) (
+	phase0.Root,
+	error,
+) {
+	if registration == nil {
+		return phase0.Root{}, errors.New("no registration supplied")
+	}
+
+	objectRoot, err := registration.Root()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to calculate registration root")
+	}
+
+	return rootSigningRoot(objectRoot, phase0.DomainApplicationBuilder, genesisForkVersion, phase0.Root{})
+}
+
+// V1BuilderRegistrationSigningRoot computes the signing root for a v1 validator registration with builders.
+func V1BuilderRegistrationSigningRoot(registration *apiv1.ValidatorRegistration,
+	genesisForkVersion phase0.Version,
+) (
+	phase0.Root,
+	error,
+) {
+	if registration == nil {
+		return phase0.Root{}, errors.New("no registration supplied")
+	}
+
+	return objectSigningRoot(registration, phase0.DomainApplicationBuilder, genesisForkVersion, phase0.Root{})
+}
+
+// objectSigningRoot computes the signing root for an object given the information to compute its domain.
+func objectSigningRoot(object ssz.HashRoot,
+	domainType phase0.DomainType,
+	forkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Root,
+	error,
+) {
+	domain, err := ComputeDomain(domainType, forkVersion, genesisValidatorsRoot)
+	if err != nil {
+		return phase0.Root{}, err
+	}
+
+	return ComputeSigningRoot(object, domain)
+}
+
+// rootSigningRoot computes the signing root for an object root given the information to compute its domain.
+func rootSigningRoot(objectRoot phase0.Root,
+	domainType phase0.DomainType,
+	forkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Root,
+	error,
+) {
+	domain, err := ComputeDomain(domainType, forkVersion, genesisValidatorsRoot)
+	if err != nil {
+		return phase0.Root{}, err
+	}
+
+	return signingRoot(objectRoot, domain)
+}
+
+// signingRoot computes the signing root for an object root and domain.
+func signingRoot(objectRoot phase0.Root, domain phase0.Domain) (phase0.Root, error) {
+	signingData := &phase0.SigningData{
+		ObjectRoot: objectRoot,
+		Domain:     domain,
+	}
+	root, err := signingData.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to calculate signing root")
+	}
+
+	return root, nil
+}
+
+// uint64Root returns the hash tree root of a uint64.
+func uint64Root(value uint64) phase0.Root {
+	var root phase0.Root
+	binary.LittleEndian.PutUint64(root[:], value)
+
+	return root
+}
@@ -0,0 +1,108 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package startup provides helpers to sequence the startup of services that depend on a
+// beacon node, waiting for the chain to start and for the node to sync.
+package startup
+
+import (
+	"context"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/chaintime"
+	"github.com/pkg/errors"
+)
+
+// pollInterval is the interval between requests to the beacon node while waiting.
+var pollInterval = 4 * time.Second
+
+// WaitForGenesis waits until the beacon node has genesis information and the chain has
+// started, and returns the genesis information.
+// Errors from the beacon node, for example if it is not yet available, are retried until
+// the context is done.
+func WaitForGenesis(ctx context.Context, client consensusclient.Service) (*apiv1.Genesis, error) {
+	provider, isProvider := client.(consensusclient.GenesisProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide genesis")
+	}
+
+	var genesis *apiv1.Genesis
+	for {
+		var err error
+		genesis, err = provider.Genesis(ctx)
+		if err == nil && genesis != nil {
+			break
+		}
+		if !sleep(ctx, pollInterval) {
+			return nil, ctx.Err()
+		}
+	}
+
+	if !sleep(ctx, time.Until(genesis.GenesisTime)) {
+		return nil, ctx.Err()
+	}
+
+	return genesis, nil
+}
+
+// WaitForSync waits until the chain has started and the head of the beacon node is within
+// tolerance slots of the current slot according to the wall clock.
+// Errors from the beacon node, for example if it is not yet available, are retried until
+// the context is done.
+func WaitForSync(ctx context.Context, client consensusclient.Service, tolerance phase0.Slot) error {
+	provider, isProvider := client.(consensusclient.NodeSyncingProvider)
+	if !isProvider {
+		return errors.New("client does not provide sync state")
+	}
+
+	if _, err := WaitForGenesis(ctx, client); err != nil {
+		return err
+	}
+	params, err := chaintime.ParametersFromClient(ctx, client)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain chain time parameters")
+	}
+	chainTime, err := chaintime.New(params)
+	if err != nil {
+		return errors.Wrap(err, "failed to create chain time")
+	}
+
+	for {
+		syncState, err := provider.NodeSyncing(ctx)
+		if err == nil && syncState != nil && syncState.HeadSlot+tolerance >= chainTime.CurrentSlot() {
+			return nil
+		}
+		if !sleep(ctx, pollInterval) {
+			return ctx.Err()
+		}
+	}
+}
+
+// sleep sleeps for the given duration, returning false if the context is done first.
+func sleep(ctx context.Context, duration time.Duration) bool {
+	if duration <= 0 {
+		return ctx.Err() == nil
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package startup
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// testClient is a client whose genesis becomes available, and whose head advances, with
+// each request.
+type testClient struct {
+	genesisTime     time.Time
+	genesisRequests atomic.Int32
+	// genesisAfter is the number of requests before genesis is available.
+	genesisAfter int32
+	headSlot     atomic.Uint64
+}
+
+func (*testClient) Name() string    { return "test" }
+func (*testClient) Address() string { return "test" }
+
+func (c *testClient) Genesis(_ context.Context) (*apiv1.Genesis, error) {
+	if c.genesisRequests.Add(1) <= c.genesisAfter {
+		return nil, errors.New("not yet")
+	}
+
+	return &apiv1.Genesis{GenesisTime: c.genesisTime}, nil
+}
+
+func (*testClient) Spec(_ context.Context) (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"SECONDS_PER_SLOT": time.Second,
+		"SLOTS_PER_EPOCH":  uint64(32),
+	}, nil
+}
+
+func (c *testClient) NodeSyncing(_ context.Context) (*apiv1.SyncState, error) {
+	return &apiv1.SyncState{
+		HeadSlot: phase0.Slot(c.headSlot.Add(10)),
+	}, nil
+}
+
+// nameOnly is a client that provides no data.
+type nameOnly struct{}
+
+func (*nameOnly) Name() string    { return "name only" }
+func (*nameOnly) Address() string { return "name only" }
+
+func TestWaitForGenesis(t *testing.T) {
+	pollInterval = 5 * time.Millisecond
+
+	_, err := WaitForGenesis(context.Background(), &nameOnly{})
+	require.EqualError(t, err, "client does not provide genesis")
+
+	// Genesis becomes available after a few requests, and is in the future.
+	client := &testClient{
+		genesisTime:  time.Now().Add(50 * time.Millisecond),
+		genesisAfter: 3,
+	}
+	genesis, err := WaitForGenesis(context.Background(), client)
+	require.NoError(t, err)
+	require.Equal(t, client.genesisTime, genesis.GenesisTime)
+	require.False(t, time.Now().Before(client.genesisTime))
+	require.Equal(t, int32(4), client.genesisRequests.Load())
+
+	// Context done before genesis is available.
+	client = &testClient{
+		genesisTime:  time.Now(),
+		genesisAfter: 1000,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = WaitForGenesis(ctx, client)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Context done before genesis time.
+	client = &testClient{
+		genesisTime: time.Now().Add(time.Hour),
+	}
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = WaitForGenesis(ctx, client)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWaitForSync(t *testing.T) {
+	pollInterval = 5 * time.Millisecond
+
+	require.EqualError(t, WaitForSync(context.Background(), &nameOnly{}, 0), "client does not provide sync state")
+
+	// Current slot is 100; the head advances 10 slots per request.
+	client := &testClient{
+		genesisTime: time.Now().Add(-100 * time.Second),
+	}
+	require.NoError(t, WaitForSync(context.Background(), client, 2))
+	require.GreaterOrEqual(t, client.headSlot.Load(), uint64(98))
+
+	// Head does not advance far enough.
+	client = &testClient{
+		genesisTime: time.Now().Add(-time.Hour),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, WaitForSync(ctx, client, 2), context.DeadlineExceeded)
+}